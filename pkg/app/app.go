@@ -67,7 +67,11 @@ func NewCommon(config config.AppConfigurer) (*common.Common, error) {
 
 	var err error
 	log := newLogger(config)
-	tr, err := i18n.NewTranslationSetFromConfig(log, userConfig.Gui.Language)
+	tr, err := i18n.NewTranslationSetFromConfig(
+		log,
+		userConfig.Gui.Language,
+		i18n.UserTranslationsDir(config.GetUserConfigDir()),
+	)
 	if err != nil {
 		return nil, err
 	}
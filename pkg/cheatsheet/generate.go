@@ -98,6 +98,7 @@ func localisedTitle(tr *i18n.TranslationSet, str string) string {
 		"status":            tr.StatusTitle,
 		"submodules":        tr.SubmodulesTitle,
 		"subCommits":        tr.SubCommitsTitle,
+		"fileHistory":       tr.FileHistoryTitle,
 		"remoteBranches":    tr.RemoteBranchesTitle,
 		"remotes":           tr.RemotesTitle,
 		"reflogCommits":     tr.ReflogCommitsTitle,
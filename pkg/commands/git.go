@@ -32,6 +32,7 @@ type GitCommand struct {
 	Patch       *git_commands.PatchCommands
 	Rebase      *git_commands.RebaseCommands
 	Remote      *git_commands.RemoteCommands
+	RepoHealth  *git_commands.RepoHealthCommands
 	Stash       *git_commands.StashCommands
 	Status      *git_commands.StatusCommands
 	Submodule   *git_commands.SubmoduleCommands
@@ -162,6 +163,7 @@ func NewGitCommandAux(
 	bisectCommands := git_commands.NewBisectCommands(gitCommon)
 	worktreeCommands := git_commands.NewWorktreeCommands(gitCommon)
 	blameCommands := git_commands.NewBlameCommands(gitCommon)
+	repoHealthCommands := git_commands.NewRepoHealthCommands(gitCommon)
 
 	branchLoader := git_commands.NewBranchLoader(cmn, cmd, branchCommands.CurrentBranchInfo, configCommands)
 	commitFileLoader := git_commands.NewCommitFileLoader(cmn, cmd)
@@ -184,6 +186,7 @@ func NewGitCommandAux(
 		Patch:       patchCommands,
 		Rebase:      rebaseCommands,
 		Remote:      remoteCommands,
+		RepoHealth:  repoHealthCommands,
 		Stash:       stashCommands,
 		Status:      statusCommands,
 		Submodule:   submoduleCommands,
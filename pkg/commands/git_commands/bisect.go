@@ -119,6 +119,30 @@ func (self *BisectCommands) Skip(ref string) error {
 	return self.Mark(ref, "skip")
 }
 
+// SkipMany marks all the given commits as untestable in a single step
+func (self *BisectCommands) SkipMany(refs []string) error {
+	cmdArgs := NewGitCmd("bisect").Arg("skip").Arg(refs...).ToArgv()
+
+	return self.cmd.New(cmdArgs).
+		IgnoreEmptyError().
+		StreamOutput().
+		Run()
+}
+
+// GetLog returns the output of 'git bisect log', which records every step of
+// the current bisect and can be fed back into Replay
+func (self *BisectCommands) GetLog() (string, error) {
+	cmdArgs := NewGitCmd("bisect").Arg("log").ToArgv()
+
+	return self.cmd.New(cmdArgs).DontLog().RunWithOutput()
+}
+
+func (self *BisectCommands) Replay(logPath string) error {
+	cmdArgs := NewGitCmd("bisect").Arg("replay", logPath).ToArgv()
+
+	return self.cmd.New(cmdArgs).StreamOutput().Run()
+}
+
 func (self *BisectCommands) Start() error {
 	cmdArgs := NewGitCmd("bisect").Arg("start").ToArgv()
 
@@ -80,6 +80,12 @@ func (self *BisectInfo) OldTerm() string {
 	return self.oldTerm
 }
 
+// tells us whether the bisect was started with terms other than the default
+// 'bad' and 'good'
+func (self *BisectInfo) UsesCustomTerms() bool {
+	return self.newTerm != "bad" || self.oldTerm != "good"
+}
+
 // this is for when we have called `git bisect start`. It does not
 // mean that we have actually started narrowing things down or selecting good/bad commits
 func (self *BisectInfo) Started() bool {
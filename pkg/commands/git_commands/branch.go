@@ -2,13 +2,18 @@ package git_commands
 
 import (
 	"fmt"
+	"regexp"
 	"strings"
 
 	"github.com/jesseduffield/lazygit/pkg/commands/oscommands"
 	"github.com/jesseduffield/lazygit/pkg/utils"
 	"github.com/mgutz/str"
+	"github.com/samber/lo"
 )
 
+// a commit or tree id, for either sha1 or sha256 repos
+var objectIdRegex = regexp.MustCompile(`^[0-9a-f]{40}([0-9a-f]{24})?$`)
+
 type BranchCommands struct {
 	*GitCommon
 }
@@ -87,12 +92,16 @@ func (self *BranchCommands) CurrentBranchName() (string, error) {
 
 // LocalDelete delete branch locally
 func (self *BranchCommands) LocalDelete(branch string, force bool) error {
+	return self.LocalDeleteCmdObj(branch, force).Run()
+}
+
+func (self *BranchCommands) LocalDeleteCmdObj(branch string, force bool) oscommands.ICmdObj {
 	cmdArgs := NewGitCmd("branch").
 		ArgIfElse(force, "-D", "-d").
 		Arg(branch).
 		ToArgv()
 
-	return self.cmd.New(cmdArgs).Run()
+	return self.cmd.New(cmdArgs)
 }
 
 // Checkout checks out a branch (or commit), with --force if you set the force arg to true
@@ -102,6 +111,10 @@ type CheckoutOptions struct {
 }
 
 func (self *BranchCommands) Checkout(branch string, options CheckoutOptions) error {
+	return self.CheckoutCmdObj(branch, options).Run()
+}
+
+func (self *BranchCommands) CheckoutCmdObj(branch string, options CheckoutOptions) oscommands.ICmdObj {
 	cmdArgs := NewGitCmd("checkout").
 		ArgIf(options.Force, "--force").
 		Arg(branch).
@@ -111,8 +124,7 @@ func (self *BranchCommands) Checkout(branch string, options CheckoutOptions) err
 		// prevents git from prompting us for input which would freeze the program
 		// TODO: see if this is actually needed here
 		AddEnvVars("GIT_TERMINAL_PROMPT=0").
-		AddEnvVars(options.EnvVars...).
-		Run()
+		AddEnvVars(options.EnvVars...)
 }
 
 // GetGraph gets the color-formatted graph of the log for the given branch
@@ -188,6 +200,87 @@ func (self *BranchCommands) countDifferences(from, to string) (string, error) {
 	return self.cmd.New(cmdArgs).DontLog().RunWithOutput()
 }
 
+// a commit that one ref has and another one doesn't
+type MissingCommit struct {
+	Hash        string
+	AuthorName  string
+	AuthorEmail string
+	Subject     string
+}
+
+// GetCommitsMissingFrom returns the commits reachable from ref but not from
+// base, newest first. Passing a branch's upstream as ref and the branch itself
+// as base gives the commits that force pushing the branch would throw away.
+func (self *BranchCommands) GetCommitsMissingFrom(ref string, base string) ([]*MissingCommit, error) {
+	cmdArgs := NewGitCmd("log").
+		Arg("--format=%h%x00%an%x00%ae%x00%s").
+		Arg(ref, "--not", base, "--").
+		ToArgv()
+
+	output, err := self.cmd.New(cmdArgs).DontLog().RunWithOutput()
+	if err != nil {
+		return nil, err
+	}
+
+	commits := []*MissingCommit{}
+	for _, line := range utils.SplitLines(output) {
+		fields := strings.SplitN(line, "\x00", 4)
+		if len(fields) < 4 {
+			continue
+		}
+		commits = append(commits, &MissingCommit{
+			Hash:        fields[0],
+			AuthorName:  fields[1],
+			AuthorEmail: fields[2],
+			Subject:     fields[3],
+		})
+	}
+
+	return commits, nil
+}
+
+// GetFilesChangedSinceMergeBase returns the files that ref changed since it
+// diverged from base
+func (self *BranchCommands) GetFilesChangedSinceMergeBase(ref string, base string) ([]string, error) {
+	cmdArgs := NewGitCmd("diff").
+		Arg("--name-only", "-z", "--no-renames").
+		Arg(base + "..." + ref).
+		Arg("--").
+		ToArgv()
+
+	output, err := self.cmd.New(cmdArgs).DontLog().RunWithOutput()
+	if err != nil {
+		return nil, err
+	}
+
+	return lo.Compact(strings.Split(output, "\x00")), nil
+}
+
+// GetMergeConflicts returns the files that merging the two refs would leave
+// conflicted, as worked out by `git merge-tree` without touching the index or
+// worktree. ok is false if we can't tell, because git is too old to do this or
+// can't merge the refs at all.
+func (self *BranchCommands) GetMergeConflicts(ref1 string, ref2 string) (files []string, ok bool) {
+	if !self.version.IsAtLeast(2, 38, 0) {
+		return nil, false
+	}
+
+	cmdArgs := NewGitCmd("merge-tree").
+		Arg("--write-tree", "--name-only", "--no-messages", "-z").
+		Arg(ref1, ref2).
+		ToArgv()
+
+	// git exits with 1 when there are conflicts, so we go by the output, which
+	// is the merged tree's id followed by the conflicted paths
+	output, _ := self.cmd.New(cmdArgs).DontLog().RunWithOutput()
+	fields := strings.Split(output, "\x00")
+	if !objectIdRegex.MatchString(fields[0]) {
+		return nil, false
+	}
+
+	return lo.Uniq(lo.Compact(fields[1:])), true
+}
+
 func (self *BranchCommands) IsHeadDetached() bool {
 	cmdArgs := NewGitCmd("symbolic-ref").Arg("-q", "HEAD").ToArgv()
 
@@ -209,6 +302,7 @@ type MergeOpts struct {
 
 func (self *BranchCommands) Merge(branchName string, opts MergeOpts) error {
 	cmdArgs := NewGitCmd("merge").
+		ConfigIf(self.conflictStyleConfig()).
 		Arg("--no-edit").
 		ArgIf(self.UserConfig.Git.Merging.Args != "", self.UserConfig.Git.Merging.Args).
 		ArgIf(opts.FastForwardOnly, "--ff-only").
@@ -75,6 +75,7 @@ func (self *BranchLoader) Load(reflogCommits []*models.Commit) ([]*models.Branch
 				}
 				if strings.EqualFold(reflogBranch.Name, branch.Name) {
 					branch.Recency = reflogBranch.Recency
+					branch.RecencyUnixTimestamp = reflogBranch.RecencyUnixTimestamp
 					branchesWithRecency = append(branchesWithRecency, branch)
 					branches = utils.Remove(branches, j)
 					continue outer
@@ -202,21 +203,24 @@ func obtainBranch(split []string, storeCommitDateAsRecency bool) *models.Branch
 	pushables, pullables, gone := parseUpstreamInfo(upstreamName, track)
 
 	recency := ""
+	recencyUnixTimestamp := int64(0)
 	if storeCommitDateAsRecency {
 		if unixTimestamp, err := strconv.ParseInt(commitDate, 10, 64); err == nil {
 			recency = utils.UnixToTimeAgo(unixTimestamp)
+			recencyUnixTimestamp = unixTimestamp
 		}
 	}
 
 	return &models.Branch{
-		Name:         name,
-		Recency:      recency,
-		Pushables:    pushables,
-		Pullables:    pullables,
-		UpstreamGone: gone,
-		Head:         headMarker == "*",
-		Subject:      subject,
-		CommitHash:   commitHash,
+		Name:                 name,
+		Recency:              recency,
+		RecencyUnixTimestamp: recencyUnixTimestamp,
+		Pushables:            pushables,
+		Pullables:            pullables,
+		UpstreamGone:         gone,
+		Head:                 headMarker == "*",
+		Subject:              subject,
+		CommitHash:           commitHash,
 	}
 }
 
@@ -266,8 +270,9 @@ func (self *BranchLoader) obtainReflogBranches(reflogCommits []*models.Commit) [
 			if !foundBranches.Includes(branchName) {
 				foundBranches.Add(branchName)
 				reflogBranches = append(reflogBranches, &models.Branch{
-					Recency: recency,
-					Name:    branchName,
+					Recency:              recency,
+					RecencyUnixTimestamp: commit.UnixTimestamp,
+					Name:                 branchName,
 				})
 			}
 		}
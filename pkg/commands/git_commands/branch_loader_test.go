@@ -94,13 +94,14 @@ func TestObtainBranch(t *testing.T) {
 			input:                    []string{"", "a_branch", "", "", "subject", "123", timeStamp},
 			storeCommitDateAsRecency: true,
 			expectedBranch: &models.Branch{
-				Name:       "a_branch",
-				Recency:    "2h",
-				Pushables:  "?",
-				Pullables:  "?",
-				Head:       false,
-				Subject:    "subject",
-				CommitHash: "123",
+				Name:                 "a_branch",
+				Recency:              "2h",
+				RecencyUnixTimestamp: int64(now - 2.5*60*60),
+				Pushables:            "?",
+				Pullables:            "?",
+				Head:                 false,
+				Subject:              "subject",
+				CommitHash:           "123",
 			},
 		},
 	}
@@ -101,11 +101,12 @@ func TestBranchDeleteBranch(t *testing.T) {
 
 func TestBranchMerge(t *testing.T) {
 	scenarios := []struct {
-		testName   string
-		userConfig *config.UserConfig
-		opts       MergeOpts
-		branchName string
-		expected   []string
+		testName      string
+		userConfig    *config.UserConfig
+		conflictStyle string
+		opts          MergeOpts
+		branchName    string
+		expected      []string
 	}{
 		{
 			testName:   "basic",
@@ -134,6 +135,33 @@ func TestBranchMerge(t *testing.T) {
 			branchName: "mybranch",
 			expected:   []string{"merge", "--no-edit", "--ff-only", "mybranch"},
 		},
+		{
+			testName: "conflict style",
+			userConfig: &config.UserConfig{
+				Git: config.GitConfig{
+					Merging: config.MergingConfig{
+						ConflictStyle: "diff3",
+					},
+				},
+			},
+			opts:       MergeOpts{},
+			branchName: "mybranch",
+			expected:   []string{"-c", "merge.conflictStyle=diff3", "merge", "--no-edit", "mybranch"},
+		},
+		{
+			testName: "conflict style picked in the merge conflicts view",
+			userConfig: &config.UserConfig{
+				Git: config.GitConfig{
+					Merging: config.MergingConfig{
+						ConflictStyle: "diff3",
+					},
+				},
+			},
+			conflictStyle: "zdiff3",
+			opts:          MergeOpts{},
+			branchName:    "mybranch",
+			expected:      []string{"-c", "merge.conflictStyle=zdiff3", "merge", "--no-edit", "mybranch"},
+		},
 	}
 
 	for _, s := range scenarios {
@@ -142,6 +170,7 @@ func TestBranchMerge(t *testing.T) {
 			runner := oscommands.NewFakeRunner(t).
 				ExpectGitArgs(s.expected, "", nil)
 			instance := buildBranchCommands(commonDeps{runner: runner, userConfig: s.userConfig})
+			instance.SetConflictStyle(s.conflictStyle)
 
 			assert.NoError(t, instance.Merge(s.branchName, s.opts))
 			runner.CheckForMissingCalls()
@@ -204,6 +233,89 @@ func TestBranchGetAllBranchGraph(t *testing.T) {
 	assert.NoError(t, err)
 }
 
+func TestBranchGetCommitsMissingFrom(t *testing.T) {
+	runner := oscommands.NewFakeRunner(t).ExpectGitArgs([]string{
+		"log", "--format=%h%x00%an%x00%ae%x00%s", "refs/remotes/origin/feature", "--not", "refs/heads/feature", "--",
+	}, "abc1234\x00Jane\x00jane@example.com\x00Fix the thing\ndef5678\x00Me\x00me@example.com\x00Add the thing\n", nil)
+	instance := buildBranchCommands(commonDeps{runner: runner})
+
+	commits, err := instance.GetCommitsMissingFrom("refs/remotes/origin/feature", "refs/heads/feature")
+	assert.NoError(t, err)
+	assert.Equal(t, []*MissingCommit{
+		{Hash: "abc1234", AuthorName: "Jane", AuthorEmail: "jane@example.com", Subject: "Fix the thing"},
+		{Hash: "def5678", AuthorName: "Me", AuthorEmail: "me@example.com", Subject: "Add the thing"},
+	}, commits)
+	runner.CheckForMissingCalls()
+}
+
+func TestBranchGetFilesChangedSinceMergeBase(t *testing.T) {
+	runner := oscommands.NewFakeRunner(t).ExpectGitArgs([]string{
+		"diff", "--name-only", "-z", "--no-renames", "master...feature", "--",
+	}, "dir/a\x00b\x00", nil)
+	instance := buildBranchCommands(commonDeps{runner: runner})
+
+	files, err := instance.GetFilesChangedSinceMergeBase("feature", "master")
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"dir/a", "b"}, files)
+	runner.CheckForMissingCalls()
+}
+
+func TestBranchGetMergeConflicts(t *testing.T) {
+	mergeTreeArgs := []string{"merge-tree", "--write-tree", "--name-only", "--no-messages", "-z", "master", "feature"}
+	treeId := "0ca52e9c9ba2b06f2648fd0f60c52b628c73dd03"
+
+	scenarios := []struct {
+		testName      string
+		runner        *oscommands.FakeCmdObjRunner
+		gitVersion    *GitVersion
+		expectedFiles []string
+		expectedOk    bool
+	}{
+		{
+			testName:      "clean merge",
+			runner:        oscommands.NewFakeRunner(t).ExpectGitArgs(mergeTreeArgs, treeId+"\x00", nil),
+			gitVersion:    &GitVersion{2, 38, 0, ""},
+			expectedFiles: []string{},
+			expectedOk:    true,
+		},
+		{
+			testName: "conflicts",
+			runner: oscommands.NewFakeRunner(t).
+				ExpectGitArgs(mergeTreeArgs, treeId+"\x00dir/a\x00b\x00", errors.New(treeId+"\x00dir/a\x00b\x00")),
+			gitVersion:    &GitVersion{2, 39, 0, ""},
+			expectedFiles: []string{"dir/a", "b"},
+			expectedOk:    true,
+		},
+		{
+			testName: "unrelated histories",
+			runner: oscommands.NewFakeRunner(t).
+				ExpectGitArgs(mergeTreeArgs, "fatal: refusing to merge unrelated histories\n", errors.New("fatal: refusing to merge unrelated histories")),
+			gitVersion:    &GitVersion{2, 38, 0, ""},
+			expectedFiles: nil,
+			expectedOk:    false,
+		},
+		{
+			testName:      "git too old",
+			runner:        oscommands.NewFakeRunner(t),
+			gitVersion:    &GitVersion{2, 37, 0, ""},
+			expectedFiles: nil,
+			expectedOk:    false,
+		},
+	}
+
+	for _, s := range scenarios {
+		s := s
+		t.Run(s.testName, func(t *testing.T) {
+			instance := buildBranchCommands(commonDeps{runner: s.runner, gitVersion: s.gitVersion})
+
+			files, ok := instance.GetMergeConflicts("master", "feature")
+			assert.Equal(t, s.expectedFiles, files)
+			assert.Equal(t, s.expectedOk, ok)
+			s.runner.CheckForMissingCalls()
+		})
+	}
+}
+
 func TestBranchCurrentBranchInfo(t *testing.T) {
 	type scenario struct {
 		testName string
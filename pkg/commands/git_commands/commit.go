@@ -2,10 +2,14 @@ package git_commands
 
 import (
 	"fmt"
+	"sort"
+	"strconv"
 	"strings"
 
 	"github.com/go-errors/errors"
 	"github.com/jesseduffield/lazygit/pkg/commands/oscommands"
+	"github.com/jesseduffield/lazygit/pkg/utils"
+	"github.com/samber/lo"
 )
 
 var ErrInvalidCommitIndex = errors.New("invalid commit index")
@@ -56,14 +60,17 @@ func (self *CommitCommands) AddCoAuthor(sha string, value string) error {
 
 // ResetToCommit reset to commit
 func (self *CommitCommands) ResetToCommit(sha string, strength string, envVars []string) error {
+	return self.ResetToCommitCmdObj(sha, strength, envVars).Run()
+}
+
+func (self *CommitCommands) ResetToCommitCmdObj(sha string, strength string, envVars []string) oscommands.ICmdObj {
 	cmdArgs := NewGitCmd("reset").Arg("--"+strength, sha).ToArgv()
 
 	return self.cmd.New(cmdArgs).
 		// prevents git from prompting us for input which would freeze the program
 		// TODO: see if this is actually needed here
 		AddEnvVars("GIT_TERMINAL_PROMPT=0").
-		AddEnvVars(envVars...).
-		Run()
+		AddEnvVars(envVars...)
 }
 
 func (self *CommitCommands) CommitCmdObj(summary string, description string) oscommands.ICmdObj {
@@ -145,6 +152,46 @@ func (self *CommitCommands) GetCommitMessage(commitSha string) (string, error) {
 	return strings.TrimSpace(message), err
 }
 
+// CommitDetails is what `git show` prints about a commit above its patch
+type CommitDetails struct {
+	// the sha and refs, the parents of a merge, the author and the date
+	Header  string
+	Message string
+	Notes   string
+}
+
+// GetCommitDetails returns the details of a commit separately, so that we can
+// render the message ourselves
+func (self *CommitCommands) GetCommitDetails(commitSha string) (*CommitDetails, error) {
+	cmdArgs := NewGitCmd("log").
+		Arg("--max-count=1", "--decorate", "--color="+self.UserConfig.Git.Paging.ColorArg).
+		Arg("--format=%C(yellow)commit %H%C(auto)%d%C(reset)%x00%p%x00%an <%ae>%x00%ad%x00%B%x00%N").
+		Arg(commitSha).
+		ToArgv()
+
+	output, err := self.cmd.New(cmdArgs).DontLog().RunWithOutput()
+	if err != nil {
+		return nil, err
+	}
+
+	fields := strings.SplitN(output, "\x00", 6)
+	if len(fields) != 6 {
+		return nil, errors.New("unexpected git output")
+	}
+
+	lines := []string{fields[0]}
+	if parents := strings.Fields(fields[1]); len(parents) > 1 {
+		lines = append(lines, "Merge: "+strings.Join(parents, " "))
+	}
+	lines = append(lines, "Author: "+fields[2], "Date:   "+fields[3])
+
+	return &CommitDetails{
+		Header:  strings.Join(lines, "\n"),
+		Message: strings.TrimSpace(fields[4]),
+		Notes:   strings.TrimSpace(fields[5]),
+	}, nil
+}
+
 func (self *CommitCommands) GetCommitSubject(commitSha string) (string, error) {
 	cmdArgs := NewGitCmd("log").
 		Arg("--format=%s", "--max-count=1", commitSha).
@@ -161,6 +208,26 @@ func (self *CommitCommands) GetCommitDiff(commitSha string) (string, error) {
 	return diff, err
 }
 
+// GetCommitPatch returns the changes of the commit in a form that can be fed
+// to `git apply`, regardless of the user's diff config
+func (self *CommitCommands) GetCommitPatch(commitSha string) (string, error) {
+	cmdArgs := NewGitCmd("show").
+		Arg("--format=", "--binary", "--no-color", "--no-ext-diff", "--src-prefix=a/", "--dst-prefix=b/", commitSha).
+		ToArgv()
+
+	return self.cmd.New(cmdArgs).DontLog().RunWithOutput()
+}
+
+// GetCommitDiffStatCmdObj shows the summary of files changed in the commit, as
+// shown by `git show --stat`
+func (self *CommitCommands) GetCommitDiffStatCmdObj(commitSha string) oscommands.ICmdObj {
+	cmdArgs := NewGitCmd("show").
+		Arg("--stat", "--format=", "--color=always", commitSha).
+		ToArgv()
+
+	return self.cmd.New(cmdArgs).DontLog()
+}
+
 type Author struct {
 	Name  string
 	Email string
@@ -185,6 +252,196 @@ func (self *CommitCommands) GetCommitAuthor(commitSha string) (Author, error) {
 	return author, err
 }
 
+type AuthorCommitCount struct {
+	Name  string
+	Count int
+}
+
+// GetAuthorCommitCounts returns how many commits each author has made on the
+// current branch, most commits first. since takes anything git's --since does,
+// e.g. "1 month ago", and is ignored if empty, as is path.
+func (self *CommitCommands) GetAuthorCommitCounts(since string, path string) ([]*AuthorCommitCount, error) {
+	cmdArgs := NewGitCmd("shortlog").
+		Arg("--summary", "--numbered").
+		ArgIf(since != "", "--since="+since).
+		// without a revision, shortlog would read a log from stdin
+		Arg("HEAD").
+		ArgIf(path != "", "--", path).
+		ToArgv()
+
+	output, err := self.cmd.New(cmdArgs).DontLog().RunWithOutput()
+	if err != nil {
+		return nil, err
+	}
+
+	result := []*AuthorCommitCount{}
+	for _, line := range strings.Split(strings.TrimSpace(output), "\n") {
+		countStr, name, found := strings.Cut(strings.TrimSpace(line), "\t")
+		if !found {
+			continue
+		}
+		if count, err := strconv.Atoi(countStr); err == nil {
+			result = append(result, &AuthorCommitCount{Name: name, Count: count})
+		}
+	}
+
+	return result, nil
+}
+
+type FileOwnership struct {
+	Path string
+	// the authors who committed to the file, most commits first
+	Authors []*AuthorCommitCount
+	// the author of the most recent commit touching the file
+	LastAuthor string
+}
+
+func (self *FileOwnership) CommitCount() int {
+	return lo.SumBy(self.Authors, func(author *AuthorCommitCount) int { return author.Count })
+}
+
+// GetFileOwnership returns who committed to each of the given files, and how
+// often, in the given order. Files without any commits get no authors.
+func (self *CommitCommands) GetFileOwnership(paths []string) ([]*FileOwnership, error) {
+	// the paths are passed on stdin rather than on the command line, which
+	// they might not fit on
+	cmdArgs := NewGitCmd("log").
+		Arg("--stdin", "-z", "--format=%x00%an", "--name-only", "--no-renames").
+		ToArgv()
+
+	cmdObj := self.cmd.New(cmdArgs).DontLog()
+	cmdObj.GetCmd().Stdin = strings.NewReader("--\n" + strings.Join(paths, "\n") + "\n")
+	output, err := cmdObj.RunWithOutput()
+	if err != nil {
+		return nil, err
+	}
+
+	ownershipByPath := make(map[string]*FileOwnership, len(paths))
+	result := lo.Map(paths, func(path string, _ int) *FileOwnership {
+		ownership := &FileOwnership{Path: path, Authors: []*AuthorCommitCount{}}
+		ownershipByPath[path] = ownership
+		return ownership
+	})
+
+	// each commit is an empty field, the author's name, and the files it
+	// changed, the first of which is preceded by a newline
+	author := ""
+	atAuthor := false
+	atFirstPath := false
+	for _, field := range strings.Split(output, "\x00") {
+		switch {
+		case field == "":
+			atAuthor = true
+		case atAuthor:
+			author = field
+			atAuthor = false
+			atFirstPath = true
+		default:
+			path := field
+			if atFirstPath {
+				path = strings.TrimPrefix(path, "\n")
+				atFirstPath = false
+			}
+			ownership, ok := ownershipByPath[path]
+			if !ok {
+				continue
+			}
+			// the log is newest first
+			if ownership.LastAuthor == "" {
+				ownership.LastAuthor = author
+			}
+			if count, found := lo.Find(ownership.Authors, func(c *AuthorCommitCount) bool { return c.Name == author }); found {
+				count.Count++
+			} else {
+				ownership.Authors = append(ownership.Authors, &AuthorCommitCount{Name: author, Count: 1})
+			}
+		}
+	}
+
+	for _, ownership := range result {
+		// stable, so that on a tie the most recent author comes first
+		sort.SliceStable(ownership.Authors, func(i, j int) bool {
+			return ownership.Authors[i].Count > ownership.Authors[j].Count
+		})
+	}
+
+	return result, nil
+}
+
+type ContainingRefs struct {
+	LocalBranches  []string
+	RemoteBranches []string
+	Tags           []string
+}
+
+// GetContainingRefs returns the names of all branches (local and remote) and
+// tags whose history includes the given commit
+func (self *CommitCommands) GetContainingRefs(commitSha string) (ContainingRefs, error) {
+	cmdArgs := NewGitCmd("for-each-ref").
+		Arg("--contains="+commitSha, "--format=%(refname)").
+		Arg("refs/heads", "refs/remotes", "refs/tags").
+		ToArgv()
+
+	output, err := self.cmd.New(cmdArgs).DontLog().RunWithOutput()
+	if err != nil {
+		return ContainingRefs{}, err
+	}
+
+	refs := ContainingRefs{}
+	for _, line := range utils.SplitLines(output) {
+		if name, ok := strings.CutPrefix(line, "refs/heads/"); ok {
+			refs.LocalBranches = append(refs.LocalBranches, name)
+		} else if name, ok := strings.CutPrefix(line, "refs/remotes/"); ok {
+			// skip symbolic refs like origin/HEAD
+			if !strings.HasSuffix(name, "/HEAD") {
+				refs.RemoteBranches = append(refs.RemoteBranches, name)
+			}
+		} else if name, ok := strings.CutPrefix(line, "refs/tags/"); ok {
+			refs.Tags = append(refs.Tags, name)
+		}
+	}
+
+	return refs, nil
+}
+
+// GetFollowedPaths returns, for each commit in the history of the file at the
+// given path that is reachable from ref, the path the file had as of that
+// commit. Paths only differ from the given one before a rename.
+func (self *CommitCommands) GetFollowedPaths(ref string, path string) (map[string]string, error) {
+	cmdArgs := NewGitCmd("log").
+		Arg("--follow", "--name-only", "--format=%x00%H").
+		Arg(ref).
+		Arg("--", path).
+		ToArgv()
+
+	output, err := self.cmd.New(cmdArgs).DontLog().RunWithOutput()
+	if err != nil {
+		return nil, err
+	}
+
+	paths := map[string]string{}
+	sha := ""
+	for _, line := range utils.SplitLines(output) {
+		if rest, ok := strings.CutPrefix(line, "\x00"); ok {
+			sha = rest
+		} else if _, seen := paths[sha]; sha != "" && line != "" && !seen {
+			paths[sha] = line
+		}
+	}
+
+	return paths, nil
+}
+
+// GetFileContentAtCommit returns the content of the file at the given path as
+// of the given commit
+func (self *CommitCommands) GetFileContentAtCommit(commitSha string, path string) (string, error) {
+	cmdArgs := NewGitCmd("show").
+		Arg(fmt.Sprintf("%s:%s", commitSha, path)).
+		ToArgv()
+
+	return self.cmd.New(cmdArgs).DontLog().RunWithOutput()
+}
+
 func (self *CommitCommands) GetCommitMessageFirstLine(sha string) (string, error) {
 	return self.GetCommitMessagesFirstLine([]string{sha})
 }
@@ -235,6 +492,17 @@ func (self *CommitCommands) AmendHeadCmdObj() oscommands.ICmdObj {
 }
 
 func (self *CommitCommands) ShowCmdObj(sha string, filterPath string) oscommands.ICmdObj {
+	return self.showCmdObj(sha, filterPath, true)
+}
+
+// ShowPatchCmdObj is like ShowCmdObj, but leaves out the header and message of
+// the commit, so that they can be rendered by us instead (see
+// GetCommitDetails)
+func (self *CommitCommands) ShowPatchCmdObj(sha string, filterPath string) oscommands.ICmdObj {
+	return self.showCmdObj(sha, filterPath, false)
+}
+
+func (self *CommitCommands) showCmdObj(sha string, filterPath string, withMessage bool) oscommands.ICmdObj {
 	contextSize := self.AppState.DiffContextSize
 
 	extDiffCmd := self.UserConfig.Git.Paging.ExternalDiffCommand
@@ -246,6 +514,7 @@ func (self *CommitCommands) ShowCmdObj(sha string, filterPath string) oscommands
 		Arg(fmt.Sprintf("--unified=%d", contextSize)).
 		Arg("--stat").
 		Arg("--decorate").
+		ArgIf(!withMessage, "--format=").
 		Arg("-p").
 		Arg(sha).
 		ArgIf(self.AppState.IgnoreWhitespaceInDiffView, "--ignore-all-space").
@@ -255,15 +524,36 @@ func (self *CommitCommands) ShowCmdObj(sha string, filterPath string) oscommands
 	return self.cmd.New(cmdArgs).DontLog()
 }
 
+// VerifyRevisionRange returns git's error if it can't make sense of the given
+// revision or range of revisions
+func (self *CommitCommands) VerifyRevisionRange(spec string) error {
+	cmdArgs := NewGitCmd("rev-parse").Arg(spec, "--").ToArgv()
+
+	return self.cmd.New(cmdArgs).DontLog().Run()
+}
+
 // Revert reverts the selected commit by sha
 func (self *CommitCommands) Revert(sha string) error {
-	cmdArgs := NewGitCmd("revert").Arg(sha).ToArgv()
+	cmdArgs := NewGitCmd("revert").ConfigIf(self.conflictStyleConfig()).Arg(sha).ToArgv()
+
+	return self.cmd.New(cmdArgs).Run()
+}
+
+// RevertCommits reverts the given commits one after the other, in the order
+// they're given in. If squash is true, the reverts are only staged, so that
+// they can be committed together afterwards.
+func (self *CommitCommands) RevertCommits(shas []string, squash bool) error {
+	cmdArgs := NewGitCmd("revert").ConfigIf(self.conflictStyleConfig()).
+		ArgIfElse(squash, "--no-commit", "--no-edit").
+		Arg(shas...).
+		ToArgv()
 
 	return self.cmd.New(cmdArgs).Run()
 }
 
 func (self *CommitCommands) RevertMerge(sha string, parentNumber int) error {
-	cmdArgs := NewGitCmd("revert").Arg(sha, "-m", fmt.Sprintf("%d", parentNumber)).
+	cmdArgs := NewGitCmd("revert").ConfigIf(self.conflictStyleConfig()).
+		Arg(sha, "-m", fmt.Sprintf("%d", parentNumber)).
 		ToArgv()
 
 	return self.cmd.New(cmdArgs).Run()
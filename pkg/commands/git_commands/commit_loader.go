@@ -12,6 +12,7 @@ import (
 	"sync"
 
 	"github.com/fsmiamoto/git-todo-parser/todo"
+	"github.com/jesseduffield/generics/set"
 	"github.com/jesseduffield/lazygit/pkg/commands/models"
 	"github.com/jesseduffield/lazygit/pkg/commands/oscommands"
 	"github.com/jesseduffield/lazygit/pkg/commands/types/enums"
@@ -107,7 +108,7 @@ func (self *CommitLoader) GetCommits(opts GetCommitsOptions) ([]*models.Commit,
 	go utils.Safe(func() {
 		defer wg.Done()
 
-		ancestor = self.getMergeBase(opts.RefName)
+		ancestor = self.getMergeBase(rangeTip(opts.RefName))
 		if opts.RefToShowDivergenceFrom != "" {
 			remoteAncestor = self.getMergeBase(opts.RefToShowDivergenceFrom)
 		}
@@ -115,7 +116,7 @@ func (self *CommitLoader) GetCommits(opts GetCommitsOptions) ([]*models.Commit,
 
 	passedFirstPushedCommit := false
 	// I can get this before
-	firstPushedCommit, err := self.getFirstPushedCommit(opts.RefForPushedStatus)
+	firstPushedCommit, err := self.getFirstPushedCommit(rangeTip(opts.RefForPushedStatus))
 	if err != nil {
 		// must have no upstream branch so we'll consider everything as pushed
 		passedFirstPushedCommit = true
@@ -144,6 +145,15 @@ func (self *CommitLoader) GetCommits(opts GetCommitsOptions) ([]*models.Commit,
 		return commits, nil
 	}
 
+	if lo.SomeBy(commits, func(commit *models.Commit) bool { return commit.Status == models.StatusUnpushed }) {
+		appliedUpstream := self.getShasAppliedUpstream(rangeTip(opts.RefForPushedStatus))
+		for _, commit := range commits {
+			if appliedUpstream.Includes(commit.Sha) {
+				commit.AppliedUpstream = true
+			}
+		}
+	}
+
 	if opts.RefToShowDivergenceFrom != "" {
 		sort.SliceStable(commits, func(i, j int) bool {
 			// In the divergence view we want incoming commits to come first
@@ -401,13 +411,22 @@ func (self *CommitLoader) getInteractiveRebasingCommits() ([]*models.Commit, err
 		})
 	}
 
+	hasMerges := utils.HasMergeTodos(todos)
 	for _, t := range todos {
-		if t.Command == todo.UpdateRef {
-			t.Msg = strings.TrimPrefix(t.Ref, "refs/heads/")
-		} else if t.Commit == "" {
-			// Command does not have a commit associated, skip
+		if !utils.IsRenderedTodo(t, hasMerges) {
 			continue
 		}
+
+		switch t.Command {
+		case todo.UpdateRef:
+			t.Msg = strings.TrimPrefix(t.Ref, "refs/heads/")
+		case todo.Label, todo.Reset:
+			t.Msg = t.Label
+		case todo.Merge:
+			if t.Msg == "" {
+				t.Msg = t.Label
+			}
+		}
 		commits = utils.Prepend(commits, &models.Commit{
 			Sha:    t.Commit,
 			Name:   t.Msg,
@@ -542,6 +561,22 @@ func setCommitMergedStatuses(ancestor string, commits []*models.Commit) {
 	}
 }
 
+// rangeTip returns the ref at the tip of a range like 'main..feature' or
+// 'main...feature', which is what the merge base and pushed status of its
+// commits are worked out from. Any other ref is returned as is.
+func rangeTip(refName string) string {
+	_, tip, found := strings.Cut(refName, "..")
+	if !found {
+		return refName
+	}
+
+	tip = strings.TrimPrefix(tip, ".")
+	if tip == "" {
+		return "HEAD"
+	}
+	return tip
+}
+
 func (self *CommitLoader) getMergeBase(refName string) string {
 	if self.mainBranches == nil {
 		self.mainBranches = self.getExistingMainBranches()
@@ -648,6 +683,35 @@ func (self *CommitLoader) getFirstPushedCommit(refName string) (string, error) {
 	return ignoringWarnings(output), nil
 }
 
+// getShasAppliedUpstream returns the SHAs of the commits on the ref that
+// haven't been pushed, but whose changes are already in the ref's upstream
+// (e.g. because they were cherry-picked or rebased there).
+func (self *CommitLoader) getShasAppliedUpstream(refName string) *set.Set[string] {
+	result := set.New[string]()
+
+	output, err := self.cmd.New(
+		NewGitCmd("cherry").
+			Arg(strings.TrimPrefix(refName, "refs/heads/") + "@{u}").
+			Arg(refName).
+			ToArgv(),
+	).
+		DontLog().
+		RunWithOutput()
+	if err != nil {
+		return result
+	}
+
+	// each line is '+ <sha>' for a commit that is missing upstream, or
+	// '- <sha>' for one that has an equivalent there
+	for _, line := range utils.SplitLines(output) {
+		if sha, ok := strings.CutPrefix(line, "- "); ok {
+			result.Add(sha)
+		}
+	}
+
+	return result
+}
+
 // getLog gets the git log.
 func (self *CommitLoader) getLogCmd(opts GetCommitsOptions) oscommands.ICmdObj {
 	config := self.UserConfig.Git.Log
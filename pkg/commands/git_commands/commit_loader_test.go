@@ -73,6 +73,8 @@ func TestGetCommits(t *testing.T) {
 				ExpectGitArgs([]string{"merge-base", "mybranch", "mybranch@{u}"}, "b21997d6b4cbdf84b149d8e6a2c4d06a8e9ec164", nil).
 				// here it's actually getting all the commits in a formatted form, one per line
 				ExpectGitArgs([]string{"log", "HEAD", "--topo-order", "--oneline", "--pretty=format:%H%x00%at%x00%aN%x00%ae%x00%D%x00%p%x00%s%x00%m", "--abbrev=40", "--no-show-signature", "--"}, commitsOutput, nil).
+				// here it's checking which unpushed commits already have an equivalent upstream
+				ExpectGitArgs([]string{"cherry", "mybranch@{u}", "mybranch"}, "- 0eea75e8c631fba6b58135697835d58ba4c18dbc\n", nil).
 				// here it's testing which of the configured main branches have an upstream
 				ExpectGitArgs([]string{"rev-parse", "--symbolic-full-name", "master@{u}"}, "refs/remotes/origin/master", nil).       // this one does
 				ExpectGitArgs([]string{"rev-parse", "--symbolic-full-name", "main@{u}"}, "", errors.New("error")).                   // this one doesn't, so it checks origin instead
@@ -97,6 +99,7 @@ func TestGetCommits(t *testing.T) {
 					Parents: []string{
 						"b21997d6b4cbdf84b149",
 					},
+					AppliedUpstream: true,
 				},
 				{
 					Sha:           "b21997d6b4cbdf84b149d8e6a2c4d06a8e9ec164",
@@ -210,6 +213,7 @@ func TestGetCommits(t *testing.T) {
 				ExpectGitArgs([]string{"merge-base", "mybranch", "mybranch@{u}"}, "b21997d6b4cbdf84b149d8e6a2c4d06a8e9ec164", nil).
 				// here it's actually getting all the commits in a formatted form, one per line
 				ExpectGitArgs([]string{"log", "HEAD", "--topo-order", "--oneline", "--pretty=format:%H%x00%at%x00%aN%x00%ae%x00%D%x00%p%x00%s%x00%m", "--abbrev=40", "--no-show-signature", "--"}, singleCommitOutput, nil).
+				ExpectGitArgs([]string{"cherry", "mybranch@{u}", "mybranch"}, "+ 0eea75e8c631fba6b58135697835d58ba4c18dbc\n", nil).
 				// here it's testing which of the configured main branches exist; neither does
 				ExpectGitArgs([]string{"rev-parse", "--symbolic-full-name", "master@{u}"}, "", errors.New("error")).
 				ExpectGitArgs([]string{"rev-parse", "--verify", "--quiet", "refs/remotes/origin/master"}, "", errors.New("error")).
@@ -247,6 +251,7 @@ func TestGetCommits(t *testing.T) {
 				ExpectGitArgs([]string{"merge-base", "mybranch", "mybranch@{u}"}, "b21997d6b4cbdf84b149d8e6a2c4d06a8e9ec164", nil).
 				// here it's actually getting all the commits in a formatted form, one per line
 				ExpectGitArgs([]string{"log", "HEAD", "--topo-order", "--oneline", "--pretty=format:%H%x00%at%x00%aN%x00%ae%x00%D%x00%p%x00%s%x00%m", "--abbrev=40", "--no-show-signature", "--"}, singleCommitOutput, nil).
+				ExpectGitArgs([]string{"cherry", "mybranch@{u}", "mybranch"}, "+ 0eea75e8c631fba6b58135697835d58ba4c18dbc\n", nil).
 				// here it's testing which of the configured main branches exist
 				ExpectGitArgs([]string{"rev-parse", "--symbolic-full-name", "master@{u}"}, "refs/remotes/origin/master", nil).
 				ExpectGitArgs([]string{"rev-parse", "--symbolic-full-name", "main@{u}"}, "", errors.New("error")).
@@ -554,3 +559,20 @@ func TestCommitLoader_setCommitMergedStatuses(t *testing.T) {
 		})
 	}
 }
+
+func TestRangeTip(t *testing.T) {
+	scenarios := []struct {
+		refName  string
+		expected string
+	}{
+		{"refs/heads/mybranch", "refs/heads/mybranch"},
+		{"origin/main..origin/release-1.4", "origin/release-1.4"},
+		{"main...feature", "feature"},
+		{"main..", "HEAD"},
+		{"HEAD~3", "HEAD~3"},
+	}
+
+	for _, s := range scenarios {
+		assert.Equal(t, s.expected, rangeTip(s.refName), s.refName)
+	}
+}
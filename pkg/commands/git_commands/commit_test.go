@@ -3,6 +3,7 @@ package git_commands
 import (
 	"testing"
 
+	"github.com/go-errors/errors"
 	"github.com/jesseduffield/lazygit/pkg/commands/oscommands"
 	"github.com/jesseduffield/lazygit/pkg/config"
 	"github.com/stretchr/testify/assert"
@@ -180,6 +181,63 @@ func TestCommitCreateFixupCommit(t *testing.T) {
 	}
 }
 
+func TestCommitVerifyRevisionRange(t *testing.T) {
+	runner := oscommands.NewFakeRunner(t).
+		ExpectGitArgs([]string{"rev-parse", "origin/main..origin/release-1.4", "--"}, "", nil).
+		ExpectGitArgs([]string{"rev-parse", "nope", "--"}, "", errors.New("fatal: bad revision 'nope'"))
+	instance := buildCommitCommands(commonDeps{runner: runner})
+
+	assert.NoError(t, instance.VerifyRevisionRange("origin/main..origin/release-1.4"))
+	assert.Error(t, instance.VerifyRevisionRange("nope"))
+	runner.CheckForMissingCalls()
+}
+
+func TestCommitRevertCommits(t *testing.T) {
+	type scenario struct {
+		testName string
+		shas     []string
+		squash   bool
+		runner   *oscommands.FakeCmdObjRunner
+	}
+
+	scenarios := []scenario{
+		{
+			testName: "separate reverts",
+			shas:     []string{"abc", "def"},
+			squash:   false,
+			runner: oscommands.NewFakeRunner(t).
+				ExpectGitArgs([]string{"revert", "--no-edit", "abc", "def"}, "", nil),
+		},
+		{
+			testName: "squashed reverts",
+			shas:     []string{"abc", "def"},
+			squash:   true,
+			runner: oscommands.NewFakeRunner(t).
+				ExpectGitArgs([]string{"revert", "--no-commit", "abc", "def"}, "", nil),
+		},
+	}
+
+	for _, s := range scenarios {
+		s := s
+		t.Run(s.testName, func(t *testing.T) {
+			instance := buildCommitCommands(commonDeps{runner: s.runner})
+			assert.NoError(t, instance.RevertCommits(s.shas, s.squash))
+			s.runner.CheckForMissingCalls()
+		})
+	}
+}
+
+func TestCommitGetCommitPatch(t *testing.T) {
+	runner := oscommands.NewFakeRunner(t).
+		ExpectGitArgs([]string{"show", "--format=", "--binary", "--no-color", "--no-ext-diff", "--src-prefix=a/", "--dst-prefix=b/", "abc"}, "diff --git a/file b/file\n", nil)
+	instance := buildCommitCommands(commonDeps{runner: runner})
+
+	patch, err := instance.GetCommitPatch("abc")
+	assert.NoError(t, err)
+	assert.Equal(t, "diff --git a/file b/file\n", patch)
+	runner.CheckForMissingCalls()
+}
+
 func TestCommitShowCmdObj(t *testing.T) {
 	type scenario struct {
 		testName         string
@@ -187,6 +245,7 @@ func TestCommitShowCmdObj(t *testing.T) {
 		contextSize      int
 		ignoreWhitespace bool
 		extDiffCmd       string
+		patchOnly        bool
 		expected         []string
 	}
 
@@ -231,6 +290,15 @@ func TestCommitShowCmdObj(t *testing.T) {
 			extDiffCmd:       "difft --color=always",
 			expected:         []string{"-c", "diff.external=difft --color=always", "show", "--ext-diff", "--submodule", "--color=always", "--unified=3", "--stat", "--decorate", "-p", "1234567890"},
 		},
+		{
+			testName:         "Show patch without the message",
+			filterPath:       "",
+			contextSize:      3,
+			ignoreWhitespace: false,
+			extDiffCmd:       "",
+			patchOnly:        true,
+			expected:         []string{"show", "--no-ext-diff", "--submodule", "--color=always", "--unified=3", "--stat", "--decorate", "--format=", "-p", "1234567890"},
+		},
 	}
 
 	for _, s := range scenarios {
@@ -245,7 +313,55 @@ func TestCommitShowCmdObj(t *testing.T) {
 			runner := oscommands.NewFakeRunner(t).ExpectGitArgs(s.expected, "", nil)
 			instance := buildCommitCommands(commonDeps{userConfig: userConfig, appState: appState, runner: runner})
 
-			assert.NoError(t, instance.ShowCmdObj("1234567890", s.filterPath).Run())
+			cmdObj := instance.ShowCmdObj("1234567890", s.filterPath)
+			if s.patchOnly {
+				cmdObj = instance.ShowPatchCmdObj("1234567890", s.filterPath)
+			}
+			assert.NoError(t, cmdObj.Run())
+			runner.CheckForMissingCalls()
+		})
+	}
+}
+
+func TestCommitGetCommitDetails(t *testing.T) {
+	type scenario struct {
+		testName string
+		output   string
+		expected *CommitDetails
+	}
+
+	scenarios := []scenario{
+		{
+			testName: "Commit with one parent",
+			output:   "commit 1234567890 (HEAD -> master)\x00abc\x00Jane <jane@example.com>\x00Mon Jan 1 00:00:00 2024\x00Fix the thing\n\nIt was broken.\n\n\x00\n",
+			expected: &CommitDetails{
+				Header:  "commit 1234567890 (HEAD -> master)\nAuthor: Jane <jane@example.com>\nDate:   Mon Jan 1 00:00:00 2024",
+				Message: "Fix the thing\n\nIt was broken.",
+				Notes:   "",
+			},
+		},
+		{
+			testName: "Merge commit with notes",
+			output:   "commit 1234567890\x00abc def\x00Jane <jane@example.com>\x00Mon Jan 1 00:00:00 2024\x00Merge branch 'feature'\n\x00a note\n\n",
+			expected: &CommitDetails{
+				Header:  "commit 1234567890\nMerge: abc def\nAuthor: Jane <jane@example.com>\nDate:   Mon Jan 1 00:00:00 2024",
+				Message: "Merge branch 'feature'",
+				Notes:   "a note",
+			},
+		},
+	}
+
+	for _, s := range scenarios {
+		s := s
+		t.Run(s.testName, func(t *testing.T) {
+			runner := oscommands.NewFakeRunner(t).ExpectGitArgs(
+				[]string{"log", "--max-count=1", "--decorate", "--color=always", "--format=%C(yellow)commit %H%C(auto)%d%C(reset)%x00%p%x00%an <%ae>%x00%ad%x00%B%x00%N", "1234567890"},
+				s.output, nil)
+			instance := buildCommitCommands(commonDeps{runner: runner})
+
+			details, err := instance.GetCommitDetails("1234567890")
+			assert.NoError(t, err)
+			assert.Equal(t, s.expected, details)
 			runner.CheckForMissingCalls()
 		})
 	}
@@ -330,3 +446,87 @@ func TestGetCommitMessageFromHistory(t *testing.T) {
 		})
 	}
 }
+
+func TestGetContainingRefs(t *testing.T) {
+	output := "refs/heads/feature\nrefs/heads/master\nrefs/remotes/origin/HEAD\nrefs/remotes/origin/master\nrefs/tags/v1.0\n"
+	runner := oscommands.NewFakeRunner(t).ExpectGitArgs(
+		[]string{"for-each-ref", "--contains=deadbeef", "--format=%(refname)", "refs/heads", "refs/remotes", "refs/tags"},
+		output, nil)
+	instance := buildCommitCommands(commonDeps{runner: runner})
+
+	refs, err := instance.GetContainingRefs("deadbeef")
+	assert.NoError(t, err)
+	assert.Equal(t, ContainingRefs{
+		LocalBranches:  []string{"feature", "master"},
+		RemoteBranches: []string{"origin/master"},
+		Tags:           []string{"v1.0"},
+	}, refs)
+	runner.CheckForMissingCalls()
+}
+
+func TestGetFollowedPaths(t *testing.T) {
+	output := "\x00ccc\n\nnew.txt\n\x00bbb\n\nnew.txt\n\x00aaa\n\nold.txt\n"
+	runner := oscommands.NewFakeRunner(t).ExpectGitArgs(
+		[]string{"log", "--follow", "--name-only", "--format=%x00%H", "HEAD", "--", "new.txt"},
+		output, nil)
+	instance := buildCommitCommands(commonDeps{runner: runner})
+
+	paths, err := instance.GetFollowedPaths("HEAD", "new.txt")
+	assert.NoError(t, err)
+	assert.Equal(t, map[string]string{
+		"ccc": "new.txt",
+		"bbb": "new.txt",
+		"aaa": "old.txt",
+	}, paths)
+	runner.CheckForMissingCalls()
+}
+
+func TestGetAuthorCommitCounts(t *testing.T) {
+	output := "    12\tJesse Duffield\n     3\tJane Doe\n"
+	runner := oscommands.NewFakeRunner(t).ExpectGitArgs(
+		[]string{"shortlog", "--summary", "--numbered", "--since=1 month ago", "HEAD", "--", "pkg"},
+		output, nil)
+	instance := buildCommitCommands(commonDeps{runner: runner})
+
+	counts, err := instance.GetAuthorCommitCounts("1 month ago", "pkg")
+	assert.NoError(t, err)
+	assert.Equal(t, []*AuthorCommitCount{
+		{Name: "Jesse Duffield", Count: 12},
+		{Name: "Jane Doe", Count: 3},
+	}, counts)
+	runner.CheckForMissingCalls()
+}
+
+func TestGetFileOwnership(t *testing.T) {
+	output := "\x00Bob\x00\nmain.go\x00\x00Alice\x00\nmain.go\x00README.md\x00\x00Alice\x00\nmain.go\x00\x00Carol\x00\ndir/ä.go\x00"
+	runner := oscommands.NewFakeRunner(t).ExpectGitArgs(
+		[]string{"log", "--stdin", "-z", "--format=%x00%an", "--name-only", "--no-renames"},
+		output, nil)
+	instance := buildCommitCommands(commonDeps{runner: runner})
+
+	ownership, err := instance.GetFileOwnership([]string{"main.go", "README.md", "new.go", "dir/ä.go"})
+	assert.NoError(t, err)
+	assert.Equal(t, []*FileOwnership{
+		{
+			Path:       "main.go",
+			Authors:    []*AuthorCommitCount{{Name: "Alice", Count: 2}, {Name: "Bob", Count: 1}},
+			LastAuthor: "Bob",
+		},
+		{
+			Path:       "README.md",
+			Authors:    []*AuthorCommitCount{{Name: "Alice", Count: 1}},
+			LastAuthor: "Alice",
+		},
+		{
+			Path:       "new.go",
+			Authors:    []*AuthorCommitCount{},
+			LastAuthor: "",
+		},
+		{
+			Path:       "dir/ä.go",
+			Authors:    []*AuthorCommitCount{{Name: "Carol", Count: 1}},
+			LastAuthor: "Carol",
+		},
+	}, ownership)
+	runner.CheckForMissingCalls()
+}
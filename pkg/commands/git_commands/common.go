@@ -14,6 +14,10 @@ type GitCommon struct {
 	repoPaths *RepoPaths
 	repo      *gogit.Repository
 	config    *ConfigCommands
+	// the conflict marker style picked in the merge conflicts view, which
+	// takes precedence over git.merging.conflictStyle for the rest of the
+	// session
+	conflictStyle string
 }
 
 func NewGitCommon(
@@ -35,3 +39,25 @@ func NewGitCommon(
 		config:    config,
 	}
 }
+
+// SetConflictStyle sets the conflict marker style to use for merges, rebases
+// etc. from now on, instead of the one from the user config
+func (self *GitCommon) SetConflictStyle(style string) {
+	self.conflictStyle = style
+}
+
+// ConflictStyle returns the conflict marker style we pass to git, or "" if we
+// leave it to git's own config
+func (self *GitCommon) ConflictStyle() string {
+	if self.conflictStyle != "" {
+		return self.conflictStyle
+	}
+	return self.UserConfig.Git.Merging.ConflictStyle
+}
+
+// conflictStyleConfig returns the config setting that makes git write conflict
+// markers in the user's chosen style, for use with GitCommandBuilder.ConfigIf
+func (self *GitCommon) conflictStyleConfig() (bool, string) {
+	style := self.ConflictStyle()
+	return style != "", "merge.conflictStyle=" + style
+}
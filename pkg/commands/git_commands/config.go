@@ -108,6 +108,14 @@ func (self *ConfigCommands) GetCoreCommentChar() byte {
 	return '#'
 }
 
+func (self *ConfigCommands) GetSparseCheckout() bool {
+	return self.gitConfig.GetBool("core.sparseCheckout")
+}
+
+func (self *ConfigCommands) GetUserEmail() string {
+	return self.gitConfig.Get("user.email")
+}
+
 func (self *ConfigCommands) GetRebaseUpdateRefs() bool {
 	return self.gitConfig.GetBool("rebase.updateRefs")
 }
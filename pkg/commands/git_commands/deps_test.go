@@ -63,6 +63,10 @@ func buildGitCommon(deps commonDeps) *GitCommon {
 		gitCommon.Common.UserConfig = config.GetDefaultConfig()
 	}
 
+	if gitCommon.Common.AppState == nil {
+		gitCommon.Common.AppState = &config.AppState{}
+	}
+
 	gitCommon.version = deps.gitVersion
 	if gitCommon.version == nil {
 		gitCommon.version = &GitVersion{2, 0, 0, ""}
@@ -113,6 +117,24 @@ func buildSubmoduleCommands(deps commonDeps) *SubmoduleCommands {
 	return NewSubmoduleCommands(gitCommon)
 }
 
+func buildRemoteCommands(deps commonDeps) *RemoteCommands {
+	gitCommon := buildGitCommon(deps)
+
+	return NewRemoteCommands(gitCommon)
+}
+
+func buildRepoHealthCommands(deps commonDeps) *RepoHealthCommands {
+	gitCommon := buildGitCommon(deps)
+
+	return NewRepoHealthCommands(gitCommon)
+}
+
+func buildTagCommands(deps commonDeps) *TagCommands {
+	gitCommon := buildGitCommon(deps)
+
+	return NewTagCommands(gitCommon)
+}
+
 func buildCommitCommands(deps commonDeps) *CommitCommands {
 	gitCommon := buildGitCommon(deps)
 	return NewCommitCommands(gitCommon)
@@ -126,7 +148,7 @@ func buildWorkingTreeCommands(deps commonDeps) *WorkingTreeCommands {
 	return NewWorkingTreeCommands(gitCommon, submoduleCommands, fileLoader)
 }
 
-func buildPatchCommands(deps commonDeps) *PatchCommands { //nolint:golint,unused
+func buildPatchCommands(deps commonDeps) *PatchCommands {
 	gitCommon := buildGitCommon(deps)
 	rebaseCommands := buildRebaseCommands(deps)
 	commitCommands := buildCommitCommands(deps)
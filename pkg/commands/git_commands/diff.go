@@ -41,6 +41,17 @@ func (self *DiffCommands) GetAllDiff(staged bool) (string, error) {
 	).RunWithOutput()
 }
 
+// GetRefsDiff returns the diff between two refs, limited to the given path
+// unless it's empty
+func (self *DiffCommands) GetRefsDiff(from string, to string, reverse bool, path string) (string, error) {
+	return self.cmd.New(
+		self.internalDiffCmdObj(from, to).
+			ArgIf(reverse, "-R").
+			ArgIf(path != "", "--", path).
+			ToArgv(),
+	).RunWithOutput()
+}
+
 type DiffToolCmdOptions struct {
 	// The path to show a diff for. Pass "." for the entire repo.
 	Filepath string
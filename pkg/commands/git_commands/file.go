@@ -164,3 +164,92 @@ func (self *FileCommands) guessDefaultEditor() string {
 
 	return editor
 }
+
+// SetAssumeUnchanged sets or clears the assume-unchanged bit of a tracked file,
+// which tells git not to bother checking the file for changes
+func (self *FileCommands) SetAssumeUnchanged(path string, value bool) error {
+	return self.setIndexFlag(path, "assume-unchanged", value)
+}
+
+// SetSkipWorktree sets or clears the skip-worktree bit of a tracked file, which
+// tells git to keep using the index version even if the file is changed
+func (self *FileCommands) SetSkipWorktree(path string, value bool) error {
+	return self.setIndexFlag(path, "skip-worktree", value)
+}
+
+// GetIndexFlags returns whether the assume-unchanged and skip-worktree bits
+// are set for a tracked file
+func (self *FileCommands) GetIndexFlags(path string) (assumeUnchanged bool, skipWorktree bool, err error) {
+	cmdArgs := NewGitCmd("ls-files").
+		Arg("-v", "--", path).
+		ToArgv()
+
+	output, err := self.cmd.New(cmdArgs).DontLog().RunWithOutput()
+	if err != nil || output == "" {
+		return false, false, err
+	}
+
+	flags := parseIndexFlagsTag(output[0])
+	return flags.assumeUnchanged, flags.skipWorktree, nil
+}
+
+// IsExecutableInIndex returns whether the file is staged with the executable
+// bit set
+func (self *FileCommands) IsExecutableInIndex(path string) (bool, error) {
+	cmdArgs := NewGitCmd("ls-files").
+		Arg("--stage", "--", path).
+		ToArgv()
+
+	output, err := self.cmd.New(cmdArgs).DontLog().RunWithOutput()
+	if err != nil {
+		return false, err
+	}
+
+	return strings.HasPrefix(output, "100755 "), nil
+}
+
+// SetExecutable sets or clears the executable bit of a tracked file in the
+// index. We change the file itself to match, as otherwise (unless
+// core.fileMode is off) the change shows up as reverted in the worktree.
+func (self *FileCommands) SetExecutable(path string, value bool) error {
+	chmodArg := "--chmod=-x"
+	if value {
+		chmodArg = "--chmod=+x"
+	}
+
+	cmdArgs := NewGitCmd("update-index").
+		Arg(chmodArg, "--", path).
+		ToArgv()
+
+	if err := self.cmd.New(cmdArgs).Run(); err != nil {
+		return err
+	}
+
+	// the file may have been deleted in the worktree
+	info, err := self.Fs.Stat(path)
+	if err != nil {
+		return nil
+	}
+
+	mode := info.Mode().Perm()
+	if value {
+		mode |= 0o111
+	} else {
+		mode &^= 0o111
+	}
+
+	return self.Fs.Chmod(path, mode)
+}
+
+func (self *FileCommands) setIndexFlag(path string, flag string, value bool) error {
+	flagArg := "--no-" + flag
+	if value {
+		flagArg = "--" + flag
+	}
+
+	cmdArgs := NewGitCmd("update-index").
+		Arg(flagArg, "--", path).
+		ToArgv()
+
+	return self.cmd.New(cmdArgs).Run()
+}
@@ -3,14 +3,20 @@ package git_commands
 import (
 	"fmt"
 	"path/filepath"
+	"sort"
+	"strconv"
 	"strings"
+	"unicode"
 
 	"github.com/jesseduffield/lazygit/pkg/commands/models"
 	"github.com/jesseduffield/lazygit/pkg/commands/oscommands"
+	"github.com/samber/lo"
+	"github.com/spf13/afero"
 )
 
 type FileLoaderConfig interface {
 	GetShowUntrackedFiles() string
+	GetSparseCheckout() bool
 }
 
 type FileLoader struct {
@@ -31,6 +37,17 @@ func NewFileLoader(gitCommon *GitCommon, cmd oscommands.ICmdObjBuilder, config F
 
 type GetStatusFileOptions struct {
 	NoRenames bool
+	// whether to load the number of added and deleted lines of each file
+	LineCounts bool
+	// whether to load the modification time of each file
+	ModTimes bool
+	// whether to load the size of each file
+	FileSizes bool
+	// whether to load the assume-unchanged and skip-worktree bits, adding the
+	// files that have them but are otherwise unchanged
+	IndexFlags bool
+	// whether to load the modes of files whose mode changed, and of symlinks
+	Modes bool
 }
 
 func (self *FileLoader) GetStatusFiles(opts GetStatusFileOptions) []*models.File {
@@ -64,6 +81,79 @@ func (self *FileLoader) GetStatusFiles(opts GetStatusFileOptions) []*models.File
 		files = append(files, file)
 	}
 
+	if opts.IndexFlags {
+		indexFlags, err := self.getIndexFlags()
+		if err != nil {
+			self.Log.Error(err)
+		}
+		for _, file := range files {
+			if flags, ok := indexFlags[file.Name]; ok {
+				file.AssumeUnchanged = flags.assumeUnchanged
+				file.SkipWorktree = flags.skipWorktree
+				delete(indexFlags, file.Name)
+			}
+		}
+
+		names := lo.Keys(indexFlags)
+		sort.Strings(names)
+		for _, name := range names {
+			file := &models.File{
+				Name:            name,
+				DisplayString:   "   " + name,
+				AssumeUnchanged: indexFlags[name].assumeUnchanged,
+				SkipWorktree:    indexFlags[name].skipWorktree,
+			}
+			models.SetStatusFields(file, "  ")
+			files = append(files, file)
+		}
+	}
+
+	// the line counts and the modes come from the same diff, which we only
+	// need if there are changes to tracked files
+	needModes := opts.Modes && lo.SomeBy(files, func(file *models.File) bool { return file.Tracked })
+	if opts.LineCounts || needModes {
+		stats, err := self.getDiffStats(opts.NoRenames)
+		if err != nil {
+			self.Log.Error(err)
+		}
+		for _, file := range files {
+			fileStats, ok := stats[file.Name]
+			if !ok {
+				continue
+			}
+			if opts.LineCounts {
+				file.LinesAdded = fileStats.added
+				file.LinesDeleted = fileStats.deleted
+			}
+			if opts.Modes && (fileStats.oldMode != fileStats.newMode || fileStats.newMode == models.SymlinkMode) {
+				file.OldMode = fileStats.oldMode
+				file.NewMode = fileStats.newMode
+				file.ModeChangeOnly = file.HasModeChange() && !fileStats.contentChanged
+				if file.NewMode == models.SymlinkMode {
+					file.SymlinkTarget = self.readSymlink(file.Name)
+				}
+			}
+		}
+	}
+
+	if opts.ModTimes || opts.FileSizes {
+		for _, file := range files {
+			// deleted files have no modification time, so they end up last
+			info, err := self.Fs.Stat(file.Name)
+			if err != nil {
+				continue
+			}
+
+			if opts.ModTimes {
+				file.ModTime = info.ModTime()
+			}
+			// submodules and linked worktrees are directories
+			if opts.FileSizes && !info.IsDir() {
+				file.Size = info.Size()
+			}
+		}
+	}
+
 	// Go through the files to see if any of these files are actually worktrees
 	// so that we can render them correctly
 	worktreePaths := linkedWortkreePaths(self.Fs, self.repoPaths.RepoGitDirPath())
@@ -84,6 +174,18 @@ func (self *FileLoader) GetStatusFiles(opts GetStatusFileOptions) []*models.File
 		}
 	}
 
+	// `git status` doesn't descend into untracked folders that have a .git of
+	// their own, so like worktrees they show up as a folder with a trailing slash
+	for _, file := range files {
+		if file.IsWorktree || file.Tracked || !strings.HasSuffix(file.Name, "/") {
+			continue
+		}
+		if _, err := self.Fs.Stat(filepath.Join(file.Name, ".git")); err == nil {
+			file.IsNestedRepo = true
+			file.Name = strings.TrimSuffix(file.Name, "/")
+		}
+	}
+
 	return files
 }
 
@@ -142,3 +244,159 @@ func (c *FileLoader) gitStatus(opts GitStatusOptions) ([]FileStatus, error) {
 
 	return response, nil
 }
+
+type indexFlags struct {
+	assumeUnchanged bool
+	skipWorktree    bool
+}
+
+// getIndexFlags returns the tracked files that have the assume-unchanged or
+// skip-worktree bit set. In a sparse checkout, git sets the skip-worktree bit
+// on every path outside of the sparse patterns; those paths are missing from
+// the worktree, and we leave them out since the user didn't set the bit
+// themselves
+func (self *FileLoader) getIndexFlags() (map[string]indexFlags, error) {
+	cmdArgs := NewGitCmd("ls-files").Arg("-v", "-z").ToArgv()
+	output, err := self.cmd.New(cmdArgs).DontLog().RunWithOutput()
+	if err != nil {
+		return nil, err
+	}
+
+	sparseCheckout := self.config.GetSparseCheckout()
+	result := map[string]indexFlags{}
+	// each entry is a tag followed by a space and the path
+	for _, entry := range strings.Split(output, "\x00") {
+		if len(entry) < 3 {
+			continue
+		}
+
+		flags := parseIndexFlagsTag(entry[0])
+		path := entry[2:]
+		if flags.skipWorktree && sparseCheckout {
+			if _, err := self.Fs.Stat(path); err != nil {
+				continue
+			}
+		}
+		if flags.assumeUnchanged || flags.skipWorktree {
+			result[path] = flags
+		}
+	}
+
+	return result, nil
+}
+
+// parseIndexFlagsTag parses the tag that `git ls-files -v` prints before each
+// path. It is lowercase for assume-unchanged files, and 'S' for skip-worktree
+// ones
+func parseIndexFlagsTag(tag byte) indexFlags {
+	return indexFlags{
+		assumeUnchanged: unicode.IsLower(rune(tag)),
+		skipWorktree:    unicode.ToUpper(rune(tag)) == 'S',
+	}
+}
+
+type diffStats struct {
+	// binary files have no line counts
+	added   int
+	deleted int
+	// whether any lines changed, including in binary files
+	contentChanged bool
+	oldMode        string
+	newMode        string
+}
+
+// getDiffStats returns the number of added and deleted lines of each changed
+// file, compared to HEAD, along with its modes in HEAD and in the worktree.
+// Untracked files aren't included. In a repo without commits there's nothing
+// to compare to, so we return nothing.
+func (self *FileLoader) getDiffStats(noRenames bool) (map[string]*diffStats, error) {
+	cmdArgs := NewGitCmd("diff").
+		Arg("--raw", "--numstat", "-z").
+		ArgIf(noRenames, "--no-renames").
+		Arg("HEAD").
+		ToArgv()
+
+	output, err := self.cmd.New(cmdArgs).DontLog().RunWithOutput()
+	if err != nil {
+		if !self.headExists() {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	result := map[string]*diffStats{}
+	fields := strings.Split(output, "\x00")
+	for i := 0; i < len(fields); i++ {
+		field := fields[i]
+
+		// raw entries look like ':100644 100755 <hash> <hash> M', followed by
+		// the path, or by the old and new paths for renames and copies
+		if strings.HasPrefix(field, ":") {
+			parts := strings.Fields(field[1:])
+			if len(parts) < 5 || i+1 >= len(fields) {
+				continue
+			}
+			i++
+			name := fields[i]
+			if strings.HasPrefix(parts[4], "R") || strings.HasPrefix(parts[4], "C") {
+				if i+1 >= len(fields) {
+					break
+				}
+				i++
+				name = fields[i]
+			}
+
+			result[name] = &diffStats{oldMode: parts[0], newMode: parts[1]}
+			continue
+		}
+
+		// numstat entries come after all the raw ones
+		parts := strings.SplitN(field, "\t", 3)
+		if len(parts) < 3 {
+			continue
+		}
+		name := parts[2]
+		if name == "" {
+			// a rename: the old and new names follow as separate fields
+			if i+2 >= len(fields) {
+				break
+			}
+			name = fields[i+2]
+			i += 2
+		}
+
+		stats, ok := result[name]
+		if !ok {
+			continue
+		}
+		stats.contentChanged = parts[0] != "0" || parts[1] != "0"
+		// binary files have '-' for both counts
+		added, addedErr := strconv.Atoi(parts[0])
+		deleted, deletedErr := strconv.Atoi(parts[1])
+		if addedErr == nil && deletedErr == nil {
+			stats.added = added
+			stats.deleted = deleted
+		}
+	}
+
+	return result, nil
+}
+
+func (self *FileLoader) headExists() bool {
+	cmdArgs := NewGitCmd("rev-parse").Arg("--verify", "--quiet", "HEAD").ToArgv()
+	return self.cmd.New(cmdArgs).DontLog().Run() == nil
+}
+
+func (self *FileLoader) readSymlink(path string) string {
+	linkReader, ok := self.Fs.(afero.LinkReader)
+	if !ok {
+		return ""
+	}
+
+	target, err := linkReader.ReadlinkIfPossible(path)
+	if err != nil {
+		return ""
+	}
+
+	return target
+}
@@ -1,10 +1,13 @@
 package git_commands
 
 import (
+	"errors"
 	"testing"
 
 	"github.com/jesseduffield/lazygit/pkg/commands/models"
 	"github.com/jesseduffield/lazygit/pkg/commands/oscommands"
+	"github.com/samber/lo"
+	"github.com/spf13/afero"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -188,10 +191,252 @@ func TestFileGetStatusFiles(t *testing.T) {
 	}
 }
 
+func TestFileGetStatusFilesWithLineCounts(t *testing.T) {
+	runner := oscommands.NewFakeRunner(t).
+		ExpectGitArgs([]string{"status", "--untracked-files=yes", "--porcelain", "-z"},
+			"M  file1.txt\x00R  after.txt\x00before.txt\x00M  image.png\x00?? file2.txt",
+			nil,
+		).
+		ExpectGitArgs([]string{"diff", "--raw", "--numstat", "-z", "HEAD"},
+			":100644 100644 abc 000 M\x00file1.txt\x00"+
+				":100644 100644 abc abc R090\x00before.txt\x00after.txt\x00"+
+				":100644 100644 abc 000 M\x00image.png\x00"+
+				"3\t1\tfile1.txt\x002\t0\t\x00before.txt\x00after.txt\x00-\t-\timage.png\x00",
+			nil,
+		)
+
+	loader := &FileLoader{
+		GitCommon:   buildGitCommon(commonDeps{}),
+		cmd:         oscommands.NewDummyCmdObjBuilder(runner),
+		config:      &FakeFileLoaderConfig{showUntrackedFiles: "yes"},
+		getFileType: func(string) string { return "file" },
+	}
+
+	files := loader.GetStatusFiles(GetStatusFileOptions{LineCounts: true})
+	counts := lo.Map(files, func(file *models.File, _ int) []int {
+		return []int{file.LinesAdded, file.LinesDeleted}
+	})
+	assert.Equal(t, [][]int{{3, 1}, {2, 0}, {0, 0}, {0, 0}}, counts)
+	runner.CheckForMissingCalls()
+}
+
+func TestFileGetStatusFilesWithoutCommits(t *testing.T) {
+	runner := oscommands.NewFakeRunner(t).
+		ExpectGitArgs([]string{"status", "--untracked-files=yes", "--porcelain", "-z"},
+			"A  file.txt",
+			nil,
+		).
+		ExpectGitArgs([]string{"diff", "--raw", "--numstat", "-z", "HEAD"},
+			"",
+			errors.New("fatal: bad revision 'HEAD'"),
+		).
+		ExpectGitArgs([]string{"rev-parse", "--verify", "--quiet", "HEAD"},
+			"",
+			errors.New("exit status 1"),
+		)
+
+	loader := &FileLoader{
+		GitCommon:   buildGitCommon(commonDeps{}),
+		cmd:         oscommands.NewDummyCmdObjBuilder(runner),
+		config:      &FakeFileLoaderConfig{showUntrackedFiles: "yes"},
+		getFileType: func(string) string { return "file" },
+	}
+
+	files := loader.GetStatusFiles(GetStatusFileOptions{LineCounts: true, Modes: true})
+	assert.Len(t, files, 1)
+	assert.Equal(t, 0, files[0].LinesAdded)
+	assert.Equal(t, "", files[0].NewMode)
+	runner.CheckForMissingCalls()
+}
+
+func TestFileGetStatusFilesWithModesAndOnlyUntrackedFiles(t *testing.T) {
+	// with nothing to compare to HEAD, we don't run the diff at all
+	runner := oscommands.NewFakeRunner(t).
+		ExpectGitArgs([]string{"status", "--untracked-files=yes", "--porcelain", "-z"},
+			"?? file.txt",
+			nil,
+		)
+
+	loader := &FileLoader{
+		GitCommon:   buildGitCommon(commonDeps{}),
+		cmd:         oscommands.NewDummyCmdObjBuilder(runner),
+		config:      &FakeFileLoaderConfig{showUntrackedFiles: "yes"},
+		getFileType: func(string) string { return "file" },
+	}
+
+	files := loader.GetStatusFiles(GetStatusFileOptions{Modes: true})
+	assert.Len(t, files, 1)
+	runner.CheckForMissingCalls()
+}
+
+func TestFileGetStatusFilesWithIndexFlags(t *testing.T) {
+	runner := oscommands.NewFakeRunner(t).
+		ExpectGitArgs([]string{"status", "--untracked-files=yes", "--porcelain", "-z"},
+			" M changed.txt\x00?? untracked.txt",
+			nil,
+		).
+		ExpectGitArgs([]string{"ls-files", "-v", "-z"},
+			"S changed.txt\x00H normal.txt\x00s both.txt\x00h assumed.txt\x00",
+			nil,
+		)
+
+	loader := &FileLoader{
+		GitCommon:   buildGitCommon(commonDeps{}),
+		cmd:         oscommands.NewDummyCmdObjBuilder(runner),
+		config:      &FakeFileLoaderConfig{showUntrackedFiles: "yes"},
+		getFileType: func(string) string { return "file" },
+	}
+
+	files := loader.GetStatusFiles(GetStatusFileOptions{IndexFlags: true})
+	summaries := lo.Map(files, func(file *models.File, _ int) []any {
+		return []any{file.Name, file.ShortStatus, file.AssumeUnchanged, file.SkipWorktree}
+	})
+	assert.Equal(t, [][]any{
+		{"changed.txt", " M", false, true},
+		{"untracked.txt", "??", false, false},
+		{"assumed.txt", "  ", true, false},
+		{"both.txt", "  ", true, true},
+	}, summaries)
+	runner.CheckForMissingCalls()
+}
+
+func TestFileGetStatusFilesWithIndexFlagsInSparseCheckout(t *testing.T) {
+	runner := oscommands.NewFakeRunner(t).
+		ExpectGitArgs([]string{"status", "--untracked-files=yes", "--porcelain", "-z"},
+			"",
+			nil,
+		).
+		ExpectGitArgs([]string{"ls-files", "-v", "-z"},
+			"S skipped.txt\x00S outside/cone.txt\x00h assumed.txt\x00",
+			nil,
+		)
+
+	fs := afero.NewMemMapFs()
+	assert.NoError(t, afero.WriteFile(fs, "skipped.txt", []byte("skipped"), 0o644))
+	assert.NoError(t, afero.WriteFile(fs, "assumed.txt", []byte("assumed"), 0o644))
+
+	loader := &FileLoader{
+		GitCommon:   buildGitCommon(commonDeps{fs: fs}),
+		cmd:         oscommands.NewDummyCmdObjBuilder(runner),
+		config:      &FakeFileLoaderConfig{showUntrackedFiles: "yes", sparseCheckout: true},
+		getFileType: func(string) string { return "file" },
+	}
+
+	files := loader.GetStatusFiles(GetStatusFileOptions{IndexFlags: true})
+	names := lo.Map(files, func(file *models.File, _ int) string { return file.Name })
+	assert.Equal(t, []string{"assumed.txt", "skipped.txt"}, names)
+	runner.CheckForMissingCalls()
+}
+
+func TestFileGetStatusFilesWithModes(t *testing.T) {
+	runner := oscommands.NewFakeRunner(t).
+		ExpectGitArgs([]string{"status", "--untracked-files=yes", "--porcelain", "-z"},
+			"M  script.sh\x00 M both.sh\x00 M link\x00R  after.txt\x00before.txt\x00 M plain.txt",
+			nil,
+		).
+		ExpectGitArgs([]string{"diff", "--raw", "--numstat", "-z", "HEAD"},
+			":100644 100755 abc abc M\x00script.sh\x00"+
+				":100644 100755 abc 000 M\x00both.sh\x00"+
+				":120000 120000 abc 000 M\x00link\x00"+
+				":100755 100644 abc abc R100\x00before.txt\x00after.txt\x00"+
+				":100644 100644 abc 000 M\x00plain.txt\x00"+
+				"0\t0\tscript.sh\x002\t1\tboth.sh\x001\t1\tlink\x000\t0\t\x00before.txt\x00after.txt\x003\t0\tplain.txt\x00",
+			nil,
+		)
+
+	loader := &FileLoader{
+		GitCommon:   buildGitCommon(commonDeps{}),
+		cmd:         oscommands.NewDummyCmdObjBuilder(runner),
+		config:      &FakeFileLoaderConfig{showUntrackedFiles: "yes"},
+		getFileType: func(string) string { return "file" },
+	}
+
+	files := loader.GetStatusFiles(GetStatusFileOptions{Modes: true})
+	modes := lo.Map(files, func(file *models.File, _ int) []any {
+		return []any{file.Name, file.OldMode, file.NewMode, file.ModeChangeOnly}
+	})
+	assert.Equal(t, [][]any{
+		{"script.sh", "100644", "100755", true},
+		{"both.sh", "100644", "100755", false},
+		{"link", "120000", "120000", false},
+		{"after.txt", "100755", "100644", true},
+		{"plain.txt", "", "", false},
+	}, modes)
+	runner.CheckForMissingCalls()
+}
+
+func TestFileGetStatusFilesWithFileSizes(t *testing.T) {
+	runner := oscommands.NewFakeRunner(t).
+		ExpectGitArgs([]string{"status", "--untracked-files=yes", "--porcelain", "-z"},
+			" M small.txt\x00?? large.bin\x00 D deleted.txt\x00?? dir/",
+			nil,
+		)
+
+	fs := afero.NewMemMapFs()
+	assert.NoError(t, afero.WriteFile(fs, "small.txt", []byte("small"), 0o644))
+	assert.NoError(t, afero.WriteFile(fs, "large.bin", make([]byte, 2048), 0o644))
+	assert.NoError(t, fs.MkdirAll("dir", 0o755))
+
+	loader := &FileLoader{
+		GitCommon:   buildGitCommon(commonDeps{fs: fs}),
+		cmd:         oscommands.NewDummyCmdObjBuilder(runner),
+		config:      &FakeFileLoaderConfig{showUntrackedFiles: "yes"},
+		getFileType: func(string) string { return "file" },
+	}
+
+	files := loader.GetStatusFiles(GetStatusFileOptions{FileSizes: true})
+	sizes := lo.Map(files, func(file *models.File, _ int) []any {
+		return []any{file.Name, file.Size}
+	})
+	assert.Equal(t, [][]any{
+		{"small.txt", int64(5)},
+		{"large.bin", int64(2048)},
+		{"deleted.txt", int64(0)},
+		{"dir/", int64(0)},
+	}, sizes)
+	runner.CheckForMissingCalls()
+}
+
+func TestFileGetStatusFilesWithNestedRepos(t *testing.T) {
+	runner := oscommands.NewFakeRunner(t).
+		ExpectGitArgs([]string{"status", "--untracked-files=yes", "--porcelain", "-z"},
+			"?? nested/\x00?? plain/\x00?? file.txt",
+			nil,
+		)
+
+	fs := afero.NewMemMapFs()
+	assert.NoError(t, fs.MkdirAll("nested/.git", 0o755))
+	assert.NoError(t, fs.MkdirAll("plain", 0o755))
+	assert.NoError(t, afero.WriteFile(fs, "file.txt", []byte("file"), 0o644))
+
+	loader := &FileLoader{
+		GitCommon:   buildGitCommon(commonDeps{fs: fs}),
+		cmd:         oscommands.NewDummyCmdObjBuilder(runner),
+		config:      &FakeFileLoaderConfig{showUntrackedFiles: "yes"},
+		getFileType: func(string) string { return "file" },
+	}
+
+	files := loader.GetStatusFiles(GetStatusFileOptions{})
+	nestedRepos := lo.Map(files, func(file *models.File, _ int) []any {
+		return []any{file.Name, file.IsNestedRepo}
+	})
+	assert.Equal(t, [][]any{
+		{"nested", true},
+		{"plain/", false},
+		{"file.txt", false},
+	}, nestedRepos)
+	runner.CheckForMissingCalls()
+}
+
 type FakeFileLoaderConfig struct {
 	showUntrackedFiles string
+	sparseCheckout     bool
 }
 
 func (self *FakeFileLoaderConfig) GetShowUntrackedFiles() string {
 	return self.showUntrackedFiles
 }
+
+func (self *FakeFileLoaderConfig) GetSparseCheckout() bool {
+	return self.sparseCheckout
+}
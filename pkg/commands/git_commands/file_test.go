@@ -1,12 +1,14 @@
 package git_commands
 
 import (
+	"os"
 	"testing"
 
 	"github.com/go-errors/errors"
 	"github.com/jesseduffield/lazygit/pkg/commands/git_config"
 	"github.com/jesseduffield/lazygit/pkg/commands/oscommands"
 	"github.com/jesseduffield/lazygit/pkg/config"
+	"github.com/spf13/afero"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -375,3 +377,110 @@ func TestGuessDefaultEditor(t *testing.T) {
 		assert.Equal(t, s.expectedResult, instance.guessDefaultEditor())
 	}
 }
+
+func TestFileSetIndexFlags(t *testing.T) {
+	type scenario struct {
+		testName string
+		runner   *oscommands.FakeCmdObjRunner
+		run      func(*FileCommands) error
+	}
+
+	scenarios := []scenario{
+		{
+			testName: "set assume-unchanged",
+			runner: oscommands.NewFakeRunner(t).
+				ExpectGitArgs([]string{"update-index", "--assume-unchanged", "--", "test.txt"}, "", nil),
+			run: func(instance *FileCommands) error {
+				return instance.SetAssumeUnchanged("test.txt", true)
+			},
+		},
+		{
+			testName: "clear assume-unchanged",
+			runner: oscommands.NewFakeRunner(t).
+				ExpectGitArgs([]string{"update-index", "--no-assume-unchanged", "--", "test.txt"}, "", nil),
+			run: func(instance *FileCommands) error {
+				return instance.SetAssumeUnchanged("test.txt", false)
+			},
+		},
+		{
+			testName: "set skip-worktree",
+			runner: oscommands.NewFakeRunner(t).
+				ExpectGitArgs([]string{"update-index", "--skip-worktree", "--", "test.txt"}, "", nil),
+			run: func(instance *FileCommands) error {
+				return instance.SetSkipWorktree("test.txt", true)
+			},
+		},
+		{
+			testName: "clear skip-worktree",
+			runner: oscommands.NewFakeRunner(t).
+				ExpectGitArgs([]string{"update-index", "--no-skip-worktree", "--", "test.txt"}, "", nil),
+			run: func(instance *FileCommands) error {
+				return instance.SetSkipWorktree("test.txt", false)
+			},
+		},
+	}
+
+	for _, s := range scenarios {
+		s := s
+		t.Run(s.testName, func(t *testing.T) {
+			instance := buildFileCommands(commonDeps{runner: s.runner})
+			assert.NoError(t, s.run(instance))
+			s.runner.CheckForMissingCalls()
+		})
+	}
+}
+
+func TestFileGetIndexFlags(t *testing.T) {
+	runner := oscommands.NewFakeRunner(t).
+		ExpectGitArgs([]string{"ls-files", "-v", "--", "skipped.txt"}, "S skipped.txt\n", nil).
+		ExpectGitArgs([]string{"ls-files", "-v", "--", "assumed.txt"}, "h assumed.txt\n", nil)
+	instance := buildFileCommands(commonDeps{runner: runner})
+
+	assumeUnchanged, skipWorktree, err := instance.GetIndexFlags("skipped.txt")
+	assert.NoError(t, err)
+	assert.False(t, assumeUnchanged)
+	assert.True(t, skipWorktree)
+
+	assumeUnchanged, skipWorktree, err = instance.GetIndexFlags("assumed.txt")
+	assert.NoError(t, err)
+	assert.True(t, assumeUnchanged)
+	assert.False(t, skipWorktree)
+	runner.CheckForMissingCalls()
+}
+
+func TestFileIsExecutableInIndex(t *testing.T) {
+	runner := oscommands.NewFakeRunner(t).
+		ExpectGitArgs([]string{"ls-files", "--stage", "--", "script.sh"}, "100755 abc 0\tscript.sh\n", nil).
+		ExpectGitArgs([]string{"ls-files", "--stage", "--", "test.txt"}, "100644 def 0\ttest.txt\n", nil)
+	instance := buildFileCommands(commonDeps{runner: runner})
+
+	isExecutable, err := instance.IsExecutableInIndex("script.sh")
+	assert.NoError(t, err)
+	assert.True(t, isExecutable)
+
+	isExecutable, err = instance.IsExecutableInIndex("test.txt")
+	assert.NoError(t, err)
+	assert.False(t, isExecutable)
+	runner.CheckForMissingCalls()
+}
+
+func TestFileSetExecutable(t *testing.T) {
+	runner := oscommands.NewFakeRunner(t).
+		ExpectGitArgs([]string{"update-index", "--chmod=+x", "--", "test.txt"}, "", nil).
+		ExpectGitArgs([]string{"update-index", "--chmod=-x", "--", "test.txt"}, "", nil)
+
+	fs := afero.NewMemMapFs()
+	assert.NoError(t, afero.WriteFile(fs, "test.txt", []byte("test"), 0o644))
+	instance := buildFileCommands(commonDeps{runner: runner, fs: fs})
+
+	assert.NoError(t, instance.SetExecutable("test.txt", true))
+	info, err := fs.Stat("test.txt")
+	assert.NoError(t, err)
+	assert.Equal(t, os.FileMode(0o755), info.Mode().Perm())
+
+	assert.NoError(t, instance.SetExecutable("test.txt", false))
+	info, err = fs.Stat("test.txt")
+	assert.NoError(t, err)
+	assert.Equal(t, os.FileMode(0o644), info.Mode().Perm())
+	runner.CheckForMissingCalls()
+}
@@ -0,0 +1,114 @@
+package git_commands
+
+import (
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/jesseduffield/lazygit/pkg/commands/models"
+)
+
+// Parked patches are stored as patch files in the git dir of the worktree,
+// since unlike stash entries they're made of individual hunks or lines rather
+// than whole files. The first line of each file is the name the user gave it.
+
+func (self *PatchCommands) parkedPatchesDir() string {
+	return filepath.Join(self.repoPaths.WorktreeGitDirPath(), "lazygit", "parked")
+}
+
+// ParkPatch saves the given patch under the given name, and then removes its
+// changes from the working tree. The patch is expected to be one that can be
+// applied in reverse to the working tree.
+func (self *PatchCommands) ParkPatch(name string, patch string) error {
+	path := filepath.Join(self.parkedPatchesDir(), time.Now().Format("20060102150405.000000000")+".patch")
+	if err := self.os.CreateFileWithContent(path, name+"\n"+patch); err != nil {
+		return err
+	}
+
+	if err := self.ApplyPatch(patch, ApplyPatchOpts{Reverse: true}); err != nil {
+		_ = self.os.RemoveFile(path)
+		return err
+	}
+
+	return nil
+}
+
+// GetParkedPatches returns the parked patches, most recently parked first
+func (self *PatchCommands) GetParkedPatches() ([]*models.ParkedPatch, error) {
+	entries, err := os.ReadDir(self.parkedPatchesDir())
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	parkedPatches := []*models.ParkedPatch{}
+	for _, entry := range entries {
+		if entry.IsDir() || !strings.HasSuffix(entry.Name(), ".patch") {
+			continue
+		}
+
+		path := filepath.Join(self.parkedPatchesDir(), entry.Name())
+		name, patch, err := self.readParkedPatch(path)
+		if err != nil {
+			return nil, err
+		}
+
+		parkedPatches = append(parkedPatches, &models.ParkedPatch{
+			Name:  name,
+			Files: filesInPatch(patch),
+			Path:  path,
+		})
+	}
+
+	// the file names start with the time of parking
+	sort.SliceStable(parkedPatches, func(i, j int) bool {
+		return parkedPatches[i].Path > parkedPatches[j].Path
+	})
+
+	return parkedPatches, nil
+}
+
+// UnparkPatch brings the changes of the parked patch back into the working
+// tree, and discards the parked patch if that worked
+func (self *PatchCommands) UnparkPatch(parkedPatch *models.ParkedPatch) error {
+	_, patch, err := self.readParkedPatch(parkedPatch.Path)
+	if err != nil {
+		return err
+	}
+
+	if err := self.ApplyPatch(patch, ApplyPatchOpts{}); err != nil {
+		return err
+	}
+
+	return self.os.RemoveFile(parkedPatch.Path)
+}
+
+func (self *PatchCommands) DropParkedPatch(parkedPatch *models.ParkedPatch) error {
+	return self.os.RemoveFile(parkedPatch.Path)
+}
+
+func (self *PatchCommands) readParkedPatch(path string) (string, string, error) {
+	content, err := os.ReadFile(path)
+	if err != nil {
+		return "", "", err
+	}
+
+	name, patch, _ := strings.Cut(string(content), "\n")
+	return name, patch, nil
+}
+
+var patchFileNameRegexp = regexp.MustCompile(`(?m)^\+\+\+ b/(.*)$`)
+
+func filesInPatch(patch string) []string {
+	files := []string{}
+	for _, match := range patchFileNameRegexp.FindAllStringSubmatch(patch, -1) {
+		files = append(files, match[1])
+	}
+
+	return files
+}
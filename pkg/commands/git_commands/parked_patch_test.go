@@ -0,0 +1,24 @@
+package git_commands
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFilesInPatch(t *testing.T) {
+	patch := `--- a/dir/file1
++++ b/dir/file1
+@@ -1,2 +1,2 @@
+-a
++b
+ c
+--- a/file2
++++ b/file2
+@@ -1 +1 @@
+-+++ b/not a file
+++d
+`
+
+	assert.Equal(t, []string{"dir/file1", "file2"}, filesInPatch(patch))
+}
@@ -1,6 +1,7 @@
 package git_commands
 
 import (
+	"os"
 	"path/filepath"
 	"time"
 
@@ -78,6 +79,24 @@ func (self *PatchCommands) applyPatchFile(filepath string, opts ApplyPatchOpts)
 	return self.cmd.New(cmdArgs).Run()
 }
 
+// PatchAppliesToCommit returns whether the patch file applies cleanly on top of
+// the given commit. It applies the patch to a throwaway index holding the
+// commit's tree, so the real index and worktree are left alone.
+func (self *PatchCommands) PatchAppliesToCommit(patchPath string, sha string) (bool, error) {
+	indexPath := patchPath + "-" + sha + ".index"
+	defer os.Remove(indexPath)
+	envVar := "GIT_INDEX_FILE=" + indexPath
+
+	readTreeCmdArgs := NewGitCmd("read-tree").Arg(sha).ToArgv()
+	if err := self.cmd.New(readTreeCmdArgs).AddEnvVars(envVar).DontLog().Run(); err != nil {
+		return false, err
+	}
+
+	applyCmdArgs := NewGitCmd("apply").Arg("--cached", "--check", patchPath).ToArgv()
+	// a failing check just means the patch doesn't apply
+	return self.cmd.New(applyCmdArgs).AddEnvVars(envVar).DontLog().Run() == nil, nil
+}
+
 func (self *PatchCommands) SaveTemporaryPatch(patch string) (string, error) {
 	filepath := filepath.Join(self.os.GetTempDir(), self.repoPaths.RepoName(), time.Now().Format("Jan _2 15.04.05.000000000")+".patch")
 	self.Log.Infof("saving temporary patch to %s", filepath)
@@ -0,0 +1,56 @@
+package git_commands
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/jesseduffield/lazygit/pkg/commands/oscommands"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPatchAppliesToCommit(t *testing.T) {
+	type scenario struct {
+		testName        string
+		runner          *oscommands.FakeCmdObjRunner
+		expectedApplies bool
+		expectedError   bool
+	}
+
+	scenarios := []scenario{
+		{
+			testName: "patch applies",
+			runner: oscommands.NewFakeRunner(t).
+				ExpectGitArgs([]string{"read-tree", "abc123"}, "", nil).
+				ExpectGitArgs([]string{"apply", "--cached", "--check", "/tmp/fixup.patch"}, "", nil),
+			expectedApplies: true,
+		},
+		{
+			testName: "patch doesn't apply",
+			runner: oscommands.NewFakeRunner(t).
+				ExpectGitArgs([]string{"read-tree", "abc123"}, "", nil).
+				ExpectGitArgs([]string{"apply", "--cached", "--check", "/tmp/fixup.patch"}, "", errors.New("patch does not apply")),
+			expectedApplies: false,
+		},
+		{
+			testName: "commit can't be read",
+			runner: oscommands.NewFakeRunner(t).
+				ExpectGitArgs([]string{"read-tree", "abc123"}, "", errors.New("not a tree object")),
+			expectedError: true,
+		},
+	}
+
+	for _, s := range scenarios {
+		s := s
+		t.Run(s.testName, func(t *testing.T) {
+			instance := buildPatchCommands(commonDeps{runner: s.runner})
+			applies, err := instance.PatchAppliesToCommit("/tmp/fixup.patch", "abc123")
+			if s.expectedError {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, s.expectedApplies, applies)
+			}
+			s.runner.CheckForMissingCalls()
+		})
+	}
+}
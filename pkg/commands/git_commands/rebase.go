@@ -1,7 +1,9 @@
 package git_commands
 
 import (
+	"bytes"
 	"fmt"
+	"os"
 	"path/filepath"
 	"strings"
 
@@ -45,9 +47,16 @@ func (self *RebaseCommands) RewordCommit(commits []*models.Commit, index int, su
 		return err
 	}
 
-	// now the selected commit should be our head so we'll amend it with the new message
+	// now the selected commit should be our head so we'll amend it with the new message.
+	// This runs the repo's prepare-commit-msg and commit-msg hooks just like
+	// rewording the head commit does.
 	err = self.commit.RewordLastCommit(summary, description)
 	if err != nil {
+		// most likely the commit-msg hook rejected the message. The rebase was
+		// only a means to an end, so we don't leave the user stuck in it.
+		if abortErr := self.AbortRebase(); abortErr != nil {
+			self.Log.Error(abortErr)
+		}
 		return err
 	}
 
@@ -166,7 +175,20 @@ func (self *RebaseCommands) InteractiveRebase(commits []*models.Commit, index in
 	}).Run()
 }
 
-func (self *RebaseCommands) EditRebase(branchRef string) error {
+// EditRebase starts an interactive rebase that stops before the first todo.
+// If autosquash is true, fixup!/squash! commits are moved next to their targets
+// and have their actions set accordingly in the todo list.
+func (self *RebaseCommands) EditRebase(branchRef string, autosquash bool) error {
+	return self.editRebase(branchRef, false, autosquash)
+}
+
+// EditRebaseBranch is like EditRebase, but for when the user rebases the
+// checked-out branch onto another ref, so it respects the rebaseMerges config
+func (self *RebaseCommands) EditRebaseBranch(branchRef string, autosquash bool) error {
+	return self.editRebase(branchRef, !self.UserConfig.Git.RebaseMerges, autosquash)
+}
+
+func (self *RebaseCommands) editRebase(branchRef string, flattenMerges bool, autosquash bool) error {
 	msg := utils.ResolvePlaceholderString(
 		self.Tr.Log.EditRebase,
 		map[string]string{
@@ -177,10 +199,12 @@ func (self *RebaseCommands) EditRebase(branchRef string) error {
 	return self.PrepareInteractiveRebaseCommand(PrepareInteractiveRebaseCommandOpts{
 		baseShaOrRoot: branchRef,
 		instruction:   daemon.NewInsertBreakInstruction(),
+		flattenMerges: flattenMerges,
+		autosquash:    autosquash,
 	}).Run()
 }
 
-func (self *RebaseCommands) EditRebaseFromBaseCommit(targetBranchName string, baseCommit string) error {
+func (self *RebaseCommands) EditRebaseFromBaseCommit(targetBranchName string, baseCommit string, autosquash bool) error {
 	msg := utils.ResolvePlaceholderString(
 		self.Tr.Log.EditRebaseFromBaseCommit,
 		map[string]string{
@@ -193,6 +217,8 @@ func (self *RebaseCommands) EditRebaseFromBaseCommit(targetBranchName string, ba
 		baseShaOrRoot: baseCommit,
 		onto:          targetBranchName,
 		instruction:   daemon.NewInsertBreakInstruction(),
+		flattenMerges: !self.UserConfig.Git.RebaseMerges,
+		autosquash:    autosquash,
 	}).Run()
 }
 
@@ -209,6 +235,10 @@ type PrepareInteractiveRebaseCommandOpts struct {
 	instruction                daemon.Instruction
 	overrideEditor             bool
 	keepCommitsThatBecomeEmpty bool
+	// only for rebasing a branch onto another ref; our own todo manipulations
+	// must always keep merges intact
+	flattenMerges bool
+	autosquash    bool
 }
 
 // PrepareInteractiveRebaseCommand returns the cmd for an interactive rebase
@@ -218,12 +248,13 @@ func (self *RebaseCommands) PrepareInteractiveRebaseCommand(opts PrepareInteract
 	ex := oscommands.GetLazygitPath()
 
 	cmdArgs := NewGitCmd("rebase").
+		ConfigIf(self.conflictStyleConfig()).
 		Arg("--interactive").
 		Arg("--autostash").
 		Arg("--keep-empty").
 		ArgIf(opts.keepCommitsThatBecomeEmpty && self.version.IsAtLeast(2, 26, 0), "--empty=keep").
-		Arg("--no-autosquash").
-		ArgIf(self.version.IsAtLeast(2, 22, 0), "--rebase-merges").
+		ArgIfElse(opts.autosquash, "--autosquash", "--no-autosquash").
+		ArgIf(!opts.flattenMerges && self.version.IsAtLeast(2, 22, 0), "--rebase-merges").
 		ArgIf(opts.onto != "", "--onto", opts.onto).
 		Arg(opts.baseShaOrRoot).
 		ToArgv()
@@ -287,6 +318,20 @@ func (self *RebaseCommands) EditRebaseTodo(commit *models.Commit, action todo.To
 		filepath.Join(self.repoPaths.WorktreeGitDirPath(), "rebase-merge/git-rebase-todo"), commit.Sha, commit.Action, action, self.config.GetCoreCommentChar())
 }
 
+// RescheduleFailedExec makes sure that the next thing the rebase does when
+// continuing is to run newCommand in place of the exec command that failed
+func (self *RebaseCommands) RescheduleFailedExec(failedCommand string, newCommand string) error {
+	fileName := filepath.Join(self.repoPaths.WorktreeGitDirPath(), "rebase-merge/git-rebase-todo")
+	return utils.RescheduleExecTodo(fileName, failedCommand, newCommand, self.config.GetCoreCommentChar())
+}
+
+// SkipFailedExec makes sure that continuing the rebase doesn't run the exec
+// command that failed again, even if git rescheduled it
+func (self *RebaseCommands) SkipFailedExec(failedCommand string) error {
+	fileName := filepath.Join(self.repoPaths.WorktreeGitDirPath(), "rebase-merge/git-rebase-todo")
+	return utils.RemoveRescheduledExecTodo(fileName, failedCommand, self.config.GetCoreCommentChar())
+}
+
 // MoveTodoDown moves a rebase todo item down by one position
 func (self *RebaseCommands) MoveTodoDown(commit *models.Commit) error {
 	fileName := filepath.Join(self.repoPaths.WorktreeGitDirPath(), "rebase-merge/git-rebase-todo")
@@ -307,6 +352,7 @@ func (self *RebaseCommands) SquashAllAboveFixupCommits(commit *models.Commit) er
 	}
 
 	cmdArgs := NewGitCmd("rebase").
+		ConfigIf(self.conflictStyleConfig()).
 		Arg("--interactive", "--rebase-merges", "--autostash", "--autosquash", shaOrRoot).
 		ToArgv()
 
@@ -345,18 +391,25 @@ func (self *RebaseCommands) BeginInteractiveRebaseForCommit(
 
 // RebaseBranch interactive rebases onto a branch
 func (self *RebaseCommands) RebaseBranch(branchName string) error {
-	return self.PrepareInteractiveRebaseCommand(PrepareInteractiveRebaseCommandOpts{baseShaOrRoot: branchName}).Run()
+	return self.PrepareInteractiveRebaseCommand(PrepareInteractiveRebaseCommandOpts{
+		baseShaOrRoot: branchName,
+		flattenMerges: !self.UserConfig.Git.RebaseMerges,
+	}).Run()
 }
 
 func (self *RebaseCommands) RebaseBranchFromBaseCommit(targetBranchName string, baseCommit string) error {
 	return self.PrepareInteractiveRebaseCommand(PrepareInteractiveRebaseCommandOpts{
 		baseShaOrRoot: baseCommit,
 		onto:          targetBranchName,
+		flattenMerges: !self.UserConfig.Git.RebaseMerges,
 	}).Run()
 }
 
 func (self *RebaseCommands) GenericMergeOrRebaseActionCmdObj(commandType string, command string) oscommands.ICmdObj {
-	cmdArgs := NewGitCmd(commandType).Arg("--" + command).ToArgv()
+	cmdArgs := NewGitCmd(commandType).
+		ConfigIf(self.conflictStyleConfig()).
+		Arg("--" + command).
+		ToArgv()
 
 	return self.cmd.New(cmdArgs)
 }
@@ -471,6 +524,61 @@ func (self *RebaseCommands) CherryPickCommitsDuringRebase(commits []*models.Comm
 	return utils.PrependStrToTodoFile(filePath, []byte(todo))
 }
 
+// RebaseProgress describes how far an interactive rebase has got. The last of
+// the done todos is the one that the rebase is currently stopped at.
+type RebaseProgress struct {
+	Done      []todo.Todo
+	Remaining []todo.Todo
+}
+
+func (self *RebaseProgress) Total() int {
+	return len(self.Done) + len(self.Remaining)
+}
+
+// GetRebaseProgress reads the todos that an interactive rebase has already
+// performed and the ones it has yet to perform. It returns nil if no
+// interactive rebase is in progress.
+func (self *RebaseCommands) GetRebaseProgress() (*RebaseProgress, error) {
+	dir := filepath.Join(self.repoPaths.WorktreeGitDirPath(), "rebase-merge")
+
+	remaining, err := self.readTodoFile(filepath.Join(dir, "git-rebase-todo"))
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	done, err := self.readTodoFile(filepath.Join(dir, "done"))
+	if err != nil && !os.IsNotExist(err) {
+		return nil, err
+	}
+
+	// git emits a 'label onto'/'reset onto' pair even for a linear rebase;
+	// those are only worth showing when merges are being rebuilt
+	hasMerges := utils.HasMergeTodos(done) || utils.HasMergeTodos(remaining)
+	isStep := func(t todo.Todo, _ int) bool {
+		if t.Command == todo.Label || t.Command == todo.Reset {
+			return hasMerges
+		}
+		return t.Command != todo.Comment && t.Command != todo.NoOp
+	}
+
+	return &RebaseProgress{
+		Done:      lo.Filter(done, isStep),
+		Remaining: lo.Filter(remaining, isStep),
+	}, nil
+}
+
+func (self *RebaseCommands) readTodoFile(path string) ([]todo.Todo, error) {
+	content, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	return todo.Parse(bytes.NewBuffer(content), self.config.GetCoreCommentChar())
+}
+
 // we can't start an interactive rebase from the first commit without passing the
 // '--root' arg
 func getBaseShaOrRoot(commits []*models.Commit, index int) string {
@@ -10,24 +10,27 @@ import (
 	"github.com/jesseduffield/lazygit/pkg/commands/git_config"
 	"github.com/jesseduffield/lazygit/pkg/commands/models"
 	"github.com/jesseduffield/lazygit/pkg/commands/oscommands"
+	"github.com/jesseduffield/lazygit/pkg/config"
 	"github.com/samber/lo"
 	"github.com/stretchr/testify/assert"
 )
 
 func TestRebaseRebaseBranch(t *testing.T) {
 	type scenario struct {
-		testName   string
-		arg        string
-		gitVersion *GitVersion
-		runner     *oscommands.FakeCmdObjRunner
-		test       func(error)
+		testName     string
+		arg          string
+		gitVersion   *GitVersion
+		rebaseMerges bool
+		runner       *oscommands.FakeCmdObjRunner
+		test         func(error)
 	}
 
 	scenarios := []scenario{
 		{
-			testName:   "successful rebase",
-			arg:        "master",
-			gitVersion: &GitVersion{2, 26, 0, ""},
+			testName:     "successful rebase",
+			arg:          "master",
+			gitVersion:   &GitVersion{2, 26, 0, ""},
+			rebaseMerges: true,
 			runner: oscommands.NewFakeRunner(t).
 				ExpectGitArgs([]string{"rebase", "--interactive", "--autostash", "--keep-empty", "--no-autosquash", "--rebase-merges", "master"}, "", nil),
 			test: func(err error) {
@@ -35,9 +38,10 @@ func TestRebaseRebaseBranch(t *testing.T) {
 			},
 		},
 		{
-			testName:   "unsuccessful rebase",
-			arg:        "master",
-			gitVersion: &GitVersion{2, 26, 0, ""},
+			testName:     "unsuccessful rebase",
+			arg:          "master",
+			gitVersion:   &GitVersion{2, 26, 0, ""},
+			rebaseMerges: true,
 			runner: oscommands.NewFakeRunner(t).
 				ExpectGitArgs([]string{"rebase", "--interactive", "--autostash", "--keep-empty", "--no-autosquash", "--rebase-merges", "master"}, "", errors.New("error")),
 			test: func(err error) {
@@ -45,9 +49,10 @@ func TestRebaseRebaseBranch(t *testing.T) {
 			},
 		},
 		{
-			testName:   "successful rebase (< 2.26.0)",
-			arg:        "master",
-			gitVersion: &GitVersion{2, 25, 5, ""},
+			testName:     "successful rebase (< 2.26.0)",
+			arg:          "master",
+			gitVersion:   &GitVersion{2, 25, 5, ""},
+			rebaseMerges: true,
 			runner: oscommands.NewFakeRunner(t).
 				ExpectGitArgs([]string{"rebase", "--interactive", "--autostash", "--keep-empty", "--no-autosquash", "--rebase-merges", "master"}, "", nil),
 			test: func(err error) {
@@ -55,9 +60,21 @@ func TestRebaseRebaseBranch(t *testing.T) {
 			},
 		},
 		{
-			testName:   "successful rebase (< 2.22.0)",
-			arg:        "master",
-			gitVersion: &GitVersion{2, 21, 9, ""},
+			testName:     "successful rebase (< 2.22.0)",
+			arg:          "master",
+			gitVersion:   &GitVersion{2, 21, 9, ""},
+			rebaseMerges: true,
+			runner: oscommands.NewFakeRunner(t).
+				ExpectGitArgs([]string{"rebase", "--interactive", "--autostash", "--keep-empty", "--no-autosquash", "master"}, "", nil),
+			test: func(err error) {
+				assert.NoError(t, err)
+			},
+		},
+		{
+			testName:     "successful rebase, flattening merges",
+			arg:          "master",
+			gitVersion:   &GitVersion{2, 26, 0, ""},
+			rebaseMerges: false,
 			runner: oscommands.NewFakeRunner(t).
 				ExpectGitArgs([]string{"rebase", "--interactive", "--autostash", "--keep-empty", "--no-autosquash", "master"}, "", nil),
 			test: func(err error) {
@@ -69,7 +86,9 @@ func TestRebaseRebaseBranch(t *testing.T) {
 	for _, s := range scenarios {
 		s := s
 		t.Run(s.testName, func(t *testing.T) {
-			instance := buildRebaseCommands(commonDeps{runner: s.runner, gitVersion: s.gitVersion})
+			userConfig := config.GetDefaultConfig()
+			userConfig.Git.RebaseMerges = s.rebaseMerges
+			instance := buildRebaseCommands(commonDeps{runner: s.runner, gitVersion: s.gitVersion, userConfig: userConfig})
 			s.test(instance.RebaseBranch(s.arg))
 		})
 	}
@@ -30,7 +30,8 @@ func (self *ReflogCommitLoader) GetReflogCommits(lastReflogCommit *models.Commit
 		Config("log.showSignature=false").
 		Arg("-g").
 		Arg("--abbrev=40").
-		Arg("--format=%h%x00%ct%x00%gs%x00%p").
+		Arg("--date=unix").
+		Arg("--format=%h%x00%gd%x00%gs%x00%p").
 		ArgIf(filterPath != "", "--follow", "--", filterPath).
 		ToArgv()
 
@@ -43,10 +44,11 @@ func (self *ReflogCommitLoader) GetReflogCommits(lastReflogCommit *models.Commit
 			return false, nil
 		}
 
-		// note that the unix timestamp here is the timestamp of the COMMIT, not the reflog entry itself,
-		// so two consecutive reflog entries may have both the same SHA and therefore same timestamp.
-		// We use the reflog message to disambiguate, and fingers crossed that we never see the same of those
-		// twice in a row. Reason being that it would mean we'd be erroneously exiting early.
+		// note that the unix timestamp here is the timestamp of the reflog entry, which only has a
+		// resolution of one second, so two consecutive reflog entries may have both the same SHA and
+		// the same timestamp. We use the reflog message to disambiguate, and fingers crossed that we
+		// never see the same of those twice in a row. Reason being that it would mean we'd be
+		// erroneously exiting early.
 		if lastReflogCommit != nil && self.sameReflogCommit(commit, lastReflogCommit) {
 			onlyObtainedNewReflogCommits = true
 			// after this point we already have these reflogs loaded so we'll simply return the new ones
@@ -73,7 +75,7 @@ func (self *ReflogCommitLoader) parseLine(line string) (*models.Commit, bool) {
 		return nil, false
 	}
 
-	unixTimestamp, _ := strconv.Atoi(fields[1])
+	unixTimestamp := parseReflogSelectorTimestamp(fields[1])
 
 	parentHashes := fields[3]
 	parents := []string{}
@@ -89,3 +91,14 @@ func (self *ReflogCommitLoader) parseLine(line string) (*models.Commit, bool) {
 		Parents:       parents,
 	}, true
 }
+
+// With --date=unix, the reflog selector has the form HEAD@{1643150483}
+func parseReflogSelectorTimestamp(selector string) int {
+	_, timestamp, found := strings.Cut(selector, "@{")
+	if !found {
+		return 0
+	}
+
+	result, _ := strconv.Atoi(strings.TrimSuffix(timestamp, "}"))
+	return result
+}
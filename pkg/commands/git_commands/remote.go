@@ -5,6 +5,7 @@ import (
 	"strings"
 
 	"github.com/jesseduffield/gocui"
+	"github.com/jesseduffield/lazygit/pkg/commands/oscommands"
 )
 
 type RemoteCommands struct {
@@ -49,11 +50,62 @@ func (self *RemoteCommands) UpdateRemoteUrl(remoteName string, updatedUrl string
 	return self.cmd.New(cmdArgs).Run()
 }
 
+// SetPushDefault makes plain pushes go to the given remote, regardless of which
+// remote the branch being pushed tracks. Together with push.default=simple
+// (git's default) this gives the triangular workflow of pulling from upstream
+// and pushing to a fork.
+func (self *RemoteCommands) SetPushDefault(remoteName string) error {
+	cmdArgs := NewGitCmd("config").
+		Arg("remote.pushDefault", remoteName).
+		ToArgv()
+
+	return self.cmd.New(cmdArgs).Run()
+}
+
+func (self *RemoteCommands) UnsetPushDefault() error {
+	cmdArgs := NewGitCmd("config").
+		Arg("--unset", "remote.pushDefault").
+		ToArgv()
+
+	return self.cmd.New(cmdArgs).Run()
+}
+
+// PruneRemotes deletes the remote-tracking branches of the given remotes whose
+// branches no longer exist on the remote, so that the local branches tracking
+// them show up as having their upstream gone
+func (self *RemoteCommands) PruneRemotes(task gocui.Task, remoteNames []string) error {
+	cmdArgs := NewGitCmd("remote").
+		Arg("prune").
+		Arg(remoteNames...).
+		ToArgv()
+
+	return self.cmd.New(cmdArgs).PromptOnCredentialRequest(task).Run()
+}
+
 func (self *RemoteCommands) DeleteRemoteBranch(task gocui.Task, remoteName string, branchName string) error {
+	return self.DeleteRemoteBranchCmdObj(task, remoteName, branchName).Run()
+}
+
+func (self *RemoteCommands) DeleteRemoteBranchCmdObj(task gocui.Task, remoteName string, branchName string) oscommands.ICmdObj {
 	cmdArgs := NewGitCmd("push").
 		Arg(remoteName, "--delete", branchName).
 		ToArgv()
 
+	return self.cmd.New(cmdArgs).PromptOnCredentialRequest(task)
+}
+
+// RenameRemoteBranch pushes the commit of our remote-tracking branch under the
+// new name and deletes the old name, in a single atomic push. Deleting the old
+// name is guarded by a lease, so that we don't throw away commits that someone
+// pushed to it since we last fetched.
+func (self *RemoteCommands) RenameRemoteBranch(task gocui.Task, remoteName string, oldBranchName string, newBranchName string) error {
+	cmdArgs := NewGitCmd("push").
+		Arg("--atomic", "--force-with-lease=refs/heads/"+oldBranchName).
+		Arg(remoteName).
+		Arg(fmt.Sprintf("refs/remotes/%s/%s:refs/heads/%s", remoteName, oldBranchName, newBranchName)).
+		Arg(":refs/heads/" + oldBranchName).
+		ToArgv()
+
 	return self.cmd.New(cmdArgs).PromptOnCredentialRequest(task).Run()
 }
 
@@ -65,6 +117,28 @@ func (self *RemoteCommands) DeleteRemoteTag(task gocui.Task, remoteName string,
 	return self.cmd.New(cmdArgs).PromptOnCredentialRequest(task).Run()
 }
 
+// notes live outside of refs/heads and refs/tags, so they are never pushed or
+// fetched unless we ask for them explicitly
+const notesRefspec = "refs/notes/*"
+
+func (self *RemoteCommands) PushNotes(task gocui.Task, remoteName string) error {
+	cmdArgs := NewGitCmd("push").
+		Arg(remoteName, notesRefspec).
+		ToArgv()
+
+	return self.cmd.New(cmdArgs).PromptOnCredentialRequest(task).Run()
+}
+
+// FetchNotes fetches the remote's notes straight into our own notes refs. If
+// our notes have diverged from the remote's, git refuses to overwrite them.
+func (self *RemoteCommands) FetchNotes(task gocui.Task, remoteName string) error {
+	cmdArgs := NewGitCmd("fetch").
+		Arg(remoteName, notesRefspec+":"+notesRefspec).
+		ToArgv()
+
+	return self.cmd.New(cmdArgs).PromptOnCredentialRequest(task).Run()
+}
+
 // CheckRemoteBranchExists Returns remote branch
 func (self *RemoteCommands) CheckRemoteBranchExists(branchName string) bool {
 	cmdArgs := NewGitCmd("show-ref").
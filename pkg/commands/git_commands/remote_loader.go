@@ -49,6 +49,8 @@ func (self *RemoteLoader) GetRemotes() ([]*models.Remote, error) {
 		return nil, err
 	}
 
+	pushDefault := self.getPushDefault()
+
 	wg.Wait()
 
 	if remoteBranchesErr != nil {
@@ -60,9 +62,10 @@ func (self *RemoteLoader) GetRemotes() ([]*models.Remote, error) {
 		branches := remoteBranchesByRemoteName[remoteName]
 
 		return &models.Remote{
-			Name:     goGitRemote.Config().Name,
-			Urls:     goGitRemote.Config().URLs,
-			Branches: branches,
+			Name:          goGitRemote.Config().Name,
+			Urls:          goGitRemote.Config().URLs,
+			Branches:      branches,
+			IsPushDefault: remoteName == pushDefault,
 		}
 	})
 
@@ -81,6 +84,22 @@ func (self *RemoteLoader) GetRemotes() ([]*models.Remote, error) {
 	return remotes, nil
 }
 
+// not going through the cached git config, given we can change this setting
+// ourselves
+func (self *RemoteLoader) getPushDefault() string {
+	cmdArgs := NewGitCmd("config").
+		Arg("--get", "remote.pushDefault").
+		ToArgv()
+
+	// git exits with an error if the setting is absent
+	output, err := self.cmd.New(cmdArgs).DontLog().RunWithOutput()
+	if err != nil {
+		return ""
+	}
+
+	return strings.TrimSpace(output)
+}
+
 func (self *RemoteLoader) getRemoteBranchesByRemoteName() (map[string][]*models.RemoteBranch, error) {
 	remoteBranchesByRemoteName := make(map[string][]*models.RemoteBranch)
 
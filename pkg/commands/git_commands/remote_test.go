@@ -0,0 +1,59 @@
+package git_commands
+
+import (
+	"testing"
+
+	"github.com/jesseduffield/gocui"
+	"github.com/jesseduffield/lazygit/pkg/commands/oscommands"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRemotePruneRemotes(t *testing.T) {
+	runner := oscommands.NewFakeRunner(t).
+		ExpectGitArgs([]string{"remote", "prune", "origin", "upstream"}, "", nil)
+	instance := buildRemoteCommands(commonDeps{runner: runner})
+
+	assert.NoError(t, instance.PruneRemotes(gocui.NewFakeTask(), []string{"origin", "upstream"}))
+	runner.CheckForMissingCalls()
+}
+
+func TestRemoteRenameRemoteBranch(t *testing.T) {
+	runner := oscommands.NewFakeRunner(t).
+		ExpectGitArgs([]string{
+			"push", "--atomic", "--force-with-lease=refs/heads/old-name", "origin",
+			"refs/remotes/origin/old-name:refs/heads/new-name", ":refs/heads/old-name",
+		}, "", nil)
+	instance := buildRemoteCommands(commonDeps{runner: runner})
+
+	assert.NoError(t, instance.RenameRemoteBranch(gocui.NewFakeTask(), "origin", "old-name", "new-name"))
+	runner.CheckForMissingCalls()
+}
+
+func TestRemotePushNotes(t *testing.T) {
+	runner := oscommands.NewFakeRunner(t).
+		ExpectGitArgs([]string{"push", "origin", "refs/notes/*"}, "", nil)
+	instance := buildRemoteCommands(commonDeps{runner: runner})
+
+	assert.NoError(t, instance.PushNotes(gocui.NewFakeTask(), "origin"))
+	runner.CheckForMissingCalls()
+}
+
+func TestRemoteFetchNotes(t *testing.T) {
+	runner := oscommands.NewFakeRunner(t).
+		ExpectGitArgs([]string{"fetch", "origin", "refs/notes/*:refs/notes/*"}, "", nil)
+	instance := buildRemoteCommands(commonDeps{runner: runner})
+
+	assert.NoError(t, instance.FetchNotes(gocui.NewFakeTask(), "origin"))
+	runner.CheckForMissingCalls()
+}
+
+func TestRemoteSetPushDefault(t *testing.T) {
+	runner := oscommands.NewFakeRunner(t).
+		ExpectGitArgs([]string{"config", "remote.pushDefault", "fork"}, "", nil).
+		ExpectGitArgs([]string{"config", "--unset", "remote.pushDefault"}, "", nil)
+	instance := buildRemoteCommands(commonDeps{runner: runner})
+
+	assert.NoError(t, instance.SetPushDefault("fork"))
+	assert.NoError(t, instance.UnsetPushDefault())
+	runner.CheckForMissingCalls()
+}
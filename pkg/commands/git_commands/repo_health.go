@@ -0,0 +1,186 @@
+package git_commands
+
+import (
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/samber/lo"
+)
+
+type RepoHealthCommands struct {
+	*GitCommon
+}
+
+func NewRepoHealthCommands(gitCommon *GitCommon) *RepoHealthCommands {
+	return &RepoHealthCommands{
+		GitCommon: gitCommon,
+	}
+}
+
+// ObjectCounts is what `git count-objects -v` reports. Sizes are in bytes
+type ObjectCounts struct {
+	LooseCount  int
+	LooseSize   int64
+	PackedCount int
+	PackCount   int
+	PackSize    int64
+	// files in the object directory that are neither valid loose objects nor
+	// packs
+	GarbageCount int
+	GarbageSize  int64
+}
+
+func (self *ObjectCounts) TotalSize() int64 {
+	return self.LooseSize + self.PackSize + self.GarbageSize
+}
+
+func (self *RepoHealthCommands) CountObjects() (*ObjectCounts, error) {
+	cmdArgs := NewGitCmd("count-objects").Arg("-v").ToArgv()
+
+	output, err := self.cmd.New(cmdArgs).DontLog().RunWithOutput()
+	if err != nil {
+		return nil, err
+	}
+
+	values := map[string]int64{}
+	for _, line := range strings.Split(strings.TrimSpace(output), "\n") {
+		key, value, found := strings.Cut(line, ": ")
+		if !found {
+			continue
+		}
+		if n, err := strconv.ParseInt(value, 10, 64); err == nil {
+			values[key] = n
+		}
+	}
+
+	// sizes are reported in KiB
+	return &ObjectCounts{
+		LooseCount:   int(values["count"]),
+		LooseSize:    values["size"] * 1024,
+		PackedCount:  int(values["in-pack"]),
+		PackCount:    int(values["packs"]),
+		PackSize:     values["size-pack"] * 1024,
+		GarbageCount: int(values["garbage"]),
+		GarbageSize:  values["size-garbage"] * 1024,
+	}, nil
+}
+
+type LargeBlob struct {
+	Sha  string
+	Path string
+	Size int64
+}
+
+// LargeBlobs returns the files in the history of the given refs that are at
+// least minSize bytes big, biggest first. A file that has been changed several
+// times is listed once for each of its big versions, whereas identical content
+// at several paths is only listed under one of them.
+func (self *RepoHealthCommands) LargeBlobs(refs []string, minSize int64) ([]*LargeBlob, error) {
+	sizesBySha := map[string]int64{}
+	cmdArgs := NewGitCmd("cat-file").
+		Arg("--batch-all-objects", "--batch-check=%(objecttype) %(objectsize) %(objectname)").
+		ToArgv()
+	err := self.cmd.New(cmdArgs).DontLog().RunAndProcessLines(func(line string) (bool, error) {
+		fields := strings.Fields(line)
+		if len(fields) != 3 || fields[0] != "blob" {
+			return false, nil
+		}
+		if size, err := strconv.ParseInt(fields[1], 10, 64); err == nil && size >= minSize {
+			sizesBySha[fields[2]] = size
+		}
+		return false, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if len(sizesBySha) == 0 || len(refs) == 0 {
+		return nil, nil
+	}
+
+	// the large blobs that aren't reachable from any of the refs aren't in the
+	// history, so they'll be dropped here
+	result := []*LargeBlob{}
+	cmdObj := self.cmd.New(NewGitCmd("rev-list").Arg("--objects", "--stdin").ToArgv()).DontLog()
+	cmdObj.GetCmd().Stdin = strings.NewReader(strings.Join(refs, "\n") + "\n")
+	err = cmdObj.RunAndProcessLines(func(line string) (bool, error) {
+		sha, path, found := strings.Cut(line, " ")
+		if !found {
+			return false, nil
+		}
+		if size, ok := sizesBySha[sha]; ok {
+			result = append(result, &LargeBlob{Sha: sha, Path: path, Size: size})
+		}
+		return false, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	sort.SliceStable(result, func(i, j int) bool { return result[i].Size > result[j].Size })
+
+	return result, nil
+}
+
+// RefHealth splits the refs into those that point at an existing object and
+// those that don't
+type RefHealth struct {
+	ValidRefs  []string
+	BrokenRefs []string
+}
+
+func (self *RepoHealthCommands) CheckRefs() (*RefHealth, error) {
+	cmdArgs := NewGitCmd("for-each-ref").Arg("--format=%(objectname) %(refname)").ToArgv()
+	output, err := self.cmd.New(cmdArgs).DontLog().RunWithOutput()
+	if err != nil {
+		return nil, err
+	}
+
+	type ref struct{ sha, name string }
+	refs := lo.FilterMap(strings.Split(strings.TrimSpace(output), "\n"), func(line string, _ int) (ref, bool) {
+		sha, name, found := strings.Cut(line, " ")
+		return ref{sha: sha, name: name}, found
+	})
+	if len(refs) == 0 {
+		return &RefHealth{}, nil
+	}
+
+	// cat-file reports '<sha> missing' for each object that doesn't exist, in
+	// the order we pass them in
+	cmdObj := self.cmd.New(NewGitCmd("cat-file").Arg("--batch-check=%(objectname)").ToArgv()).DontLog()
+	cmdObj.GetCmd().Stdin = strings.NewReader(strings.Join(lo.Map(refs, func(r ref, _ int) string { return r.sha }), "\n") + "\n")
+	output, err = cmdObj.RunWithOutput()
+	if err != nil {
+		return nil, err
+	}
+
+	result := &RefHealth{}
+	for i, line := range strings.Split(strings.TrimSpace(output), "\n") {
+		if i >= len(refs) {
+			break
+		}
+		if strings.HasSuffix(line, " missing") {
+			result.BrokenRefs = append(result.BrokenRefs, refs[i].name)
+		} else {
+			result.ValidRefs = append(result.ValidRefs, refs[i].name)
+		}
+	}
+
+	return result, nil
+}
+
+// GarbageCollect packs loose objects and removes unreachable ones that are
+// older than git's expiry period
+func (self *RepoHealthCommands) GarbageCollect() error {
+	cmdArgs := NewGitCmd("gc").ToArgv()
+
+	return self.cmd.New(cmdArgs).Run()
+}
+
+// PruneObjects removes unreachable loose objects right away
+func (self *RepoHealthCommands) PruneObjects() error {
+	cmdArgs := NewGitCmd("prune").ToArgv()
+
+	return self.cmd.New(cmdArgs).Run()
+}
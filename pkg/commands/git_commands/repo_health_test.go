@@ -0,0 +1,62 @@
+package git_commands
+
+import (
+	"testing"
+
+	"github.com/jesseduffield/lazygit/pkg/commands/oscommands"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRepoHealthCountObjects(t *testing.T) {
+	output := "count: 12\nsize: 48\nin-pack: 300\npacks: 2\nsize-pack: 1024\nprune-packable: 0\ngarbage: 1\nsize-garbage: 4\n"
+	runner := oscommands.NewFakeRunner(t).
+		ExpectGitArgs([]string{"count-objects", "-v"}, output, nil)
+	instance := buildRepoHealthCommands(commonDeps{runner: runner})
+
+	counts, err := instance.CountObjects()
+	assert.NoError(t, err)
+	assert.Equal(t, &ObjectCounts{
+		LooseCount:   12,
+		LooseSize:    48 * 1024,
+		PackedCount:  300,
+		PackCount:    2,
+		PackSize:     1024 * 1024,
+		GarbageCount: 1,
+		GarbageSize:  4 * 1024,
+	}, counts)
+	assert.EqualValues(t, (48+1024+4)*1024, counts.TotalSize())
+	runner.CheckForMissingCalls()
+}
+
+func TestRepoHealthLargeBlobs(t *testing.T) {
+	runner := oscommands.NewFakeRunner(t).
+		ExpectGitArgs([]string{"cat-file", "--batch-all-objects", "--batch-check=%(objecttype) %(objectsize) %(objectname)"},
+			"commit 200 c1\ntree 100 t1\nblob 10 small\nblob 5000 big\nblob 9000 bigger\nblob 7000 unreachable\n", nil).
+		ExpectGitArgs([]string{"rev-list", "--objects", "--stdin"},
+			"c1\nt1 \nsmall src/small.txt\nbig assets/big.bin\nbigger assets/bigger.bin\n", nil)
+	instance := buildRepoHealthCommands(commonDeps{runner: runner})
+
+	blobs, err := instance.LargeBlobs([]string{"refs/heads/master"}, 1000)
+	assert.NoError(t, err)
+	assert.Equal(t, []*LargeBlob{
+		{Sha: "bigger", Path: "assets/bigger.bin", Size: 9000},
+		{Sha: "big", Path: "assets/big.bin", Size: 5000},
+	}, blobs)
+	runner.CheckForMissingCalls()
+}
+
+func TestRepoHealthCheckRefs(t *testing.T) {
+	runner := oscommands.NewFakeRunner(t).
+		ExpectGitArgs([]string{"for-each-ref", "--format=%(objectname) %(refname)"},
+			"aaa refs/heads/master\nbbb refs/heads/broken\nccc refs/tags/v1\n", nil).
+		ExpectGitArgs([]string{"cat-file", "--batch-check=%(objectname)"}, "aaa\nbbb missing\nccc\n", nil)
+	instance := buildRepoHealthCommands(commonDeps{runner: runner})
+
+	refHealth, err := instance.CheckRefs()
+	assert.NoError(t, err)
+	assert.Equal(t, &RefHealth{
+		ValidRefs:  []string{"refs/heads/master", "refs/tags/v1"},
+		BrokenRefs: []string{"refs/heads/broken"},
+	}, refHealth)
+	runner.CheckForMissingCalls()
+}
@@ -5,6 +5,7 @@ import (
 	"strings"
 
 	"github.com/jesseduffield/lazygit/pkg/commands/oscommands"
+	"github.com/samber/lo"
 )
 
 type StashCommands struct {
@@ -39,14 +40,26 @@ func (self *StashCommands) Drop(index int) error {
 }
 
 func (self *StashCommands) Pop(index int) error {
-	cmdArgs := NewGitCmd("stash").Arg("pop", fmt.Sprintf("stash@{%d}", index)).
+	cmdArgs := NewGitCmd("stash").ConfigIf(self.conflictStyleConfig()).
+		Arg("pop", fmt.Sprintf("stash@{%d}", index)).
 		ToArgv()
 
 	return self.cmd.New(cmdArgs).Run()
 }
 
 func (self *StashCommands) Apply(index int) error {
-	cmdArgs := NewGitCmd("stash").Arg("apply", fmt.Sprintf("stash@{%d}", index)).
+	cmdArgs := NewGitCmd("stash").ConfigIf(self.conflictStyleConfig()).
+		Arg("apply", fmt.Sprintf("stash@{%d}", index)).
+		ToArgv()
+
+	return self.cmd.New(cmdArgs).Run()
+}
+
+// ApplyWithIndex applies the stash entry like Apply, but also restores which
+// changes were staged when the entry was made
+func (self *StashCommands) ApplyWithIndex(index int) error {
+	cmdArgs := NewGitCmd("stash").ConfigIf(self.conflictStyleConfig()).
+		Arg("apply", "--index", fmt.Sprintf("stash@{%d}", index)).
 		ToArgv()
 
 	return self.cmd.New(cmdArgs).Run()
@@ -93,6 +106,26 @@ func (self *StashCommands) ShowStashEntryCmdObj(index int) oscommands.ICmdObj {
 	return self.cmd.New(cmdArgs).DontLog()
 }
 
+// ShowStashEntriesCmdObj shows the diffs of several stash entries one after the
+// other. Like 'git stash show', it diffs each entry against its first parent,
+// which is the commit that was checked out when the entry was made.
+func (self *StashCommands) ShowStashEntriesCmdObj(indices []int) oscommands.ICmdObj {
+	cmdArgs := NewGitCmd("show").
+		Arg("-p").
+		Arg("--stat").
+		Arg("-m", "--first-parent").
+		Arg("--format=%C(yellow)%h%C(reset) %s").
+		Arg(fmt.Sprintf("--color=%s", self.UserConfig.Git.Paging.ColorArg)).
+		Arg(fmt.Sprintf("--unified=%d", self.AppState.DiffContextSize)).
+		ArgIf(self.AppState.IgnoreWhitespaceInDiffView, "--ignore-all-space").
+		Arg(lo.Map(indices, func(index int, _ int) string {
+			return fmt.Sprintf("stash@{%d}", index)
+		})...).
+		ToArgv()
+
+	return self.cmd.New(cmdArgs).DontLog()
+}
+
 func (self *StashCommands) StashAndKeepIndex(message string) error {
 	cmdArgs := NewGitCmd("stash").Arg("push", "--keep-index", "-m", message).
 		ToArgv()
@@ -120,9 +153,16 @@ func (self *StashCommands) StashUnstagedChanges(message string) error {
 	return nil
 }
 
-// SaveStagedChanges stashes only the currently staged changes. This takes a few steps
+// SaveStagedChanges stashes only the currently staged changes. Git can do this
+// itself since 2.35; before that it takes a few steps
 // shoutouts to Joe on https://stackoverflow.com/questions/14759748/stashing-only-staged-changes-in-git-is-it-possible
 func (self *StashCommands) SaveStagedChanges(message string) error {
+	if self.version.IsAtLeast(2, 35, 0) {
+		return self.cmd.New(
+			NewGitCmd("stash").Arg("push", "--staged", "-m", message).ToArgv(),
+		).Run()
+	}
+
 	// wrap in 'writing', which uses a mutex
 	if err := self.cmd.New(
 		NewGitCmd("stash").Arg("--keep-index").ToArgv(),
@@ -177,6 +217,33 @@ func (self *StashCommands) StashIncludeUntrackedChanges(message string) error {
 	).Run()
 }
 
+// StashPaths stashes the changes to the given paths only, untracked files
+// included
+func (self *StashCommands) StashPaths(message string, paths []string) error {
+	return self.cmd.New(
+		NewGitCmd("stash").Arg("push", "--include-untracked", "-m", message, "--").Arg(paths...).
+			ToArgv(),
+	).Run()
+}
+
+// StashAllChangesIncludingIgnored stashes everything, including ignored files
+// like build output and local config, leaving a pristine working tree behind
+func (self *StashCommands) StashAllChangesIncludingIgnored(message string) error {
+	return self.cmd.New(
+		NewGitCmd("stash").Arg("push", "--all", "-m", message).
+			ToArgv(),
+	).Run()
+}
+
+// Branch creates a branch at the commit the stash entry was made on, checks it
+// out and applies the entry there, dropping it if that goes cleanly
+func (self *StashCommands) Branch(branchName string, index int) error {
+	cmdArgs := NewGitCmd("stash").Arg("branch", branchName, fmt.Sprintf("stash@{%d}", index)).
+		ToArgv()
+
+	return self.cmd.New(cmdArgs).Run()
+}
+
 func (self *StashCommands) Rename(index int, message string) error {
 	sha, err := self.Sha(index)
 	if err != nil {
@@ -92,6 +92,7 @@ func (c *StashLoader) stashEntryFromLine(line string, index int) *models.StashEn
 
 	model.Name = msg
 	model.Recency = utils.UnixToTimeAgo(t)
+	model.UnixTimestamp = t
 
 	return model
 }
@@ -26,6 +26,15 @@ func TestStashApply(t *testing.T) {
 	runner.CheckForMissingCalls()
 }
 
+func TestStashApplyWithIndex(t *testing.T) {
+	runner := oscommands.NewFakeRunner(t).
+		ExpectGitArgs([]string{"stash", "apply", "--index", "stash@{1}"}, "", nil)
+	instance := buildStashCommands(commonDeps{runner: runner})
+
+	assert.NoError(t, instance.ApplyWithIndex(1))
+	runner.CheckForMissingCalls()
+}
+
 func TestStashPop(t *testing.T) {
 	runner := oscommands.NewFakeRunner(t).
 		ExpectGitArgs([]string{"stash", "pop", "stash@{1}"}, "", nil)
@@ -44,6 +53,42 @@ func TestStashSave(t *testing.T) {
 	runner.CheckForMissingCalls()
 }
 
+func TestStashSaveStagedChanges(t *testing.T) {
+	runner := oscommands.NewFakeRunner(t).
+		ExpectGitArgs([]string{"stash", "push", "--staged", "-m", "A stash message"}, "", nil)
+	instance := buildStashCommands(commonDeps{runner: runner, gitVersion: &GitVersion{2, 35, 0, ""}})
+
+	assert.NoError(t, instance.SaveStagedChanges("A stash message"))
+	runner.CheckForMissingCalls()
+}
+
+func TestStashAllChangesIncludingIgnored(t *testing.T) {
+	runner := oscommands.NewFakeRunner(t).
+		ExpectGitArgs([]string{"stash", "push", "--all", "-m", "A stash message"}, "", nil)
+	instance := buildStashCommands(commonDeps{runner: runner})
+
+	assert.NoError(t, instance.StashAllChangesIncludingIgnored("A stash message"))
+	runner.CheckForMissingCalls()
+}
+
+func TestStashPaths(t *testing.T) {
+	runner := oscommands.NewFakeRunner(t).
+		ExpectGitArgs([]string{"stash", "push", "--include-untracked", "-m", "A stash message", "--", "dir", "file.txt"}, "", nil)
+	instance := buildStashCommands(commonDeps{runner: runner})
+
+	assert.NoError(t, instance.StashPaths("A stash message", []string{"dir", "file.txt"}))
+	runner.CheckForMissingCalls()
+}
+
+func TestStashBranch(t *testing.T) {
+	runner := oscommands.NewFakeRunner(t).
+		ExpectGitArgs([]string{"stash", "branch", "new-branch", "stash@{1}"}, "", nil)
+	instance := buildStashCommands(commonDeps{runner: runner})
+
+	assert.NoError(t, instance.Branch("new-branch", 1))
+	runner.CheckForMissingCalls()
+}
+
 func TestStashStore(t *testing.T) {
 	type scenario struct {
 		testName string
@@ -145,6 +190,18 @@ func TestStashStashEntryCmdObj(t *testing.T) {
 	}
 }
 
+func TestStashShowStashEntriesCmdObj(t *testing.T) {
+	userConfig := config.GetDefaultConfig()
+	appState := &config.AppState{}
+	appState.DiffContextSize = 3
+	instance := buildStashCommands(commonDeps{userConfig: userConfig, appState: appState})
+
+	assert.Equal(t,
+		[]string{"git", "show", "-p", "--stat", "-m", "--first-parent", "--format=%C(yellow)%h%C(reset) %s", "--color=always", "--unified=3", "stash@{1}", "stash@{2}"},
+		instance.ShowStashEntriesCmdObj([]int{1, 2}).Args(),
+	)
+}
+
 func TestStashRename(t *testing.T) {
 	type scenario struct {
 		testName         string
@@ -6,8 +6,11 @@ import (
 	"strconv"
 	"strings"
 
+	"github.com/fsmiamoto/git-todo-parser/todo"
+	"github.com/jesseduffield/lazygit/pkg/commands/models"
 	"github.com/jesseduffield/lazygit/pkg/commands/oscommands"
 	"github.com/jesseduffield/lazygit/pkg/commands/types/enums"
+	"github.com/jesseduffield/lazygit/pkg/utils"
 )
 
 type StatusCommands struct {
@@ -46,6 +49,12 @@ func (self *StatusCommands) WorkingTreeState() enums.RebaseMode {
 	if merging {
 		return enums.REBASE_MODE_MERGING
 	}
+	if self.IsInCherryPick() {
+		return enums.REBASE_MODE_CHERRY_PICKING
+	}
+	if self.IsInRevert() {
+		return enums.REBASE_MODE_REVERTING
+	}
 	return enums.REBASE_MODE_NONE
 }
 
@@ -78,6 +87,110 @@ func (self *StatusCommands) IsInMergeState() (bool, error) {
 	return self.os.FileExists(filepath.Join(self.repoPaths.WorktreeGitDirPath(), "MERGE_HEAD"))
 }
 
+// IsInCherryPick states whether git has stopped in the middle of a cherry-pick
+// that was started outside of lazygit (we cherry-pick via interactive rebases
+// ourselves)
+func (self *StatusCommands) IsInCherryPick() bool {
+	return self.isInSequencerOperation("CHERRY_PICK_HEAD", todo.Pick)
+}
+
+// IsInRevert states whether git has stopped in the middle of reverting one or
+// more commits
+func (self *StatusCommands) IsInRevert() bool {
+	return self.isInSequencerOperation("REVERT_HEAD", todo.Revert)
+}
+
+func (self *StatusCommands) isInSequencerOperation(headFile string, command todo.TodoCommand) bool {
+	if exists, _ := self.os.FileExists(filepath.Join(self.repoPaths.WorktreeGitDirPath(), headFile)); exists {
+		return true
+	}
+
+	todos, err := self.sequencerTodos()
+	return err == nil && len(todos) > 0 && todos[0].Command == command
+}
+
+// SequencerState describes how far git has got in cherry-picking or reverting
+// a sequence of commits
+type SequencerState struct {
+	// commits that have already been applied, oldest first
+	Done []*models.Commit
+	// the commit that git stopped at, if any. When the user has already
+	// committed their resolution of a conflict there is none.
+	Current *models.Commit
+	// commits that are yet to be applied, in the order they will be applied
+	Remaining []*models.Commit
+}
+
+func (self *StatusCommands) SequencerState() (*SequencerState, error) {
+	state := &SequencerState{}
+
+	// sequencer/head holds the commit that was checked out before we started,
+	// so anything on top of it has already been applied
+	if bytesContent, err := os.ReadFile(filepath.Join(self.repoPaths.WorktreeGitDirPath(), "sequencer", "head")); err == nil {
+		commits, err := self.commitsWithSubjects(
+			NewGitCmd("log").Arg("--reverse", strings.TrimSpace(string(bytesContent))+"..HEAD"),
+		)
+		if err != nil {
+			return nil, err
+		}
+		state.Done = commits
+	}
+
+	for _, headFile := range []string{"CHERRY_PICK_HEAD", "REVERT_HEAD"} {
+		bytesContent, err := os.ReadFile(filepath.Join(self.repoPaths.WorktreeGitDirPath(), headFile))
+		if err != nil {
+			continue
+		}
+		commits, err := self.commitsWithSubjects(
+			NewGitCmd("log").Arg("-1", strings.TrimSpace(string(bytesContent))),
+		)
+		if err != nil {
+			return nil, err
+		}
+		if len(commits) > 0 {
+			state.Current = commits[0]
+		}
+	}
+
+	// the todo file is missing when only a single commit is being picked
+	todos, _ := self.sequencerTodos()
+	for i, t := range todos {
+		// git only removes the commit it stopped at from the todo file once it
+		// moves on from it
+		if i == 0 && state.Current != nil && strings.HasPrefix(state.Current.Sha, t.Commit) {
+			continue
+		}
+		state.Remaining = append(state.Remaining, &models.Commit{
+			Sha:    t.Commit,
+			Name:   t.Msg,
+			Action: t.Command,
+		})
+	}
+
+	return state, nil
+}
+
+func (self *StatusCommands) commitsWithSubjects(cmd *GitCommandBuilder) ([]*models.Commit, error) {
+	output, err := self.cmd.New(cmd.Arg("--format=%H%x00%s").ToArgv()).DontLog().RunWithOutput()
+	if err != nil {
+		return nil, err
+	}
+
+	commits := []*models.Commit{}
+	for _, line := range utils.SplitLines(output) {
+		sha, subject, _ := strings.Cut(line, "\x00")
+		commits = append(commits, &models.Commit{Sha: sha, Name: subject})
+	}
+	return commits, nil
+}
+
+func (self *StatusCommands) sequencerTodos() ([]todo.Todo, error) {
+	return utils.ReadRebaseTodoFile(
+		filepath.Join(self.repoPaths.WorktreeGitDirPath(), "sequencer", "todo"),
+		self.config.GetCoreCommentChar(),
+	)
+}
+
 // Full ref (e.g. "refs/heads/mybranch") of the branch that is currently
 // being rebased, or empty string when we're not in a rebase
 func (self *StatusCommands) BranchBeingRebased() string {
@@ -88,3 +201,134 @@ func (self *StatusCommands) BranchBeingRebased() string {
 	}
 	return ""
 }
+
+// RebaseState describes the rebase that's in progress
+type RebaseState struct {
+	// short name of the branch being rebased; empty when rebasing a detached head
+	Branch string
+	// the commit the branch is being rebased onto
+	Onto *models.Commit
+	// the commit the rebase stopped at, if it stopped because of a conflict or
+	// an edit todo
+	StoppedAt *models.Commit
+	// how many steps of the rebase have been performed so far, and in total
+	Step  int
+	Total int
+	// the reflog message of the checkout that started the rebase, e.g.
+	// "rebase (start): checkout master"
+	StartedBy string
+}
+
+func (self *StatusCommands) RebaseState() (*RebaseState, error) {
+	gitDir := self.repoPaths.WorktreeGitDirPath()
+
+	// the merge backend (used for interactive rebases and, nowadays, by
+	// default) keeps its state in rebase-merge; the apply backend in
+	// rebase-apply, under different file names
+	dir, stepFile, totalFile := filepath.Join(gitDir, "rebase-merge"), "msgnum", "end"
+	if exists, _ := self.os.FileExists(filepath.Join(gitDir, "rebase-apply")); exists {
+		dir, stepFile, totalFile = filepath.Join(gitDir, "rebase-apply"), "next", "last"
+	}
+
+	state := &RebaseState{
+		Branch: strings.TrimPrefix(self.BranchBeingRebased(), "refs/heads/"),
+		Step:   readIntFile(filepath.Join(dir, stepFile)),
+		Total:  readIntFile(filepath.Join(dir, totalFile)),
+		StartedBy: self.latestReflogMessage(func(msg string) bool {
+			return strings.HasPrefix(msg, "rebase") && strings.Contains(msg, "(start)")
+		}),
+	}
+	if state.Branch == "detached HEAD" {
+		state.Branch = ""
+	}
+
+	var err error
+	if state.Onto, err = self.commitFromFile(filepath.Join(dir, "onto")); err != nil {
+		return nil, err
+	}
+	// git writes REBASE_HEAD whenever a rebase stops at a commit, and removes
+	// it again when continuing
+	if state.StoppedAt, err = self.commitFromFile(filepath.Join(gitDir, "REBASE_HEAD")); err != nil {
+		return nil, err
+	}
+
+	return state, nil
+}
+
+// MergeState describes the merge that's in progress
+type MergeState struct {
+	// the commits being merged into HEAD; more than one for an octopus merge
+	Heads []*models.Commit
+	// the first line of the commit message git has prepared, e.g. "Merge
+	// branch 'feature'"
+	Message string
+}
+
+func (self *StatusCommands) MergeState() (*MergeState, error) {
+	gitDir := self.repoPaths.WorktreeGitDirPath()
+
+	state := &MergeState{}
+
+	if bytesContent, err := os.ReadFile(filepath.Join(gitDir, "MERGE_HEAD")); err == nil {
+		shas := strings.Fields(string(bytesContent))
+		if len(shas) > 0 {
+			commits, err := self.commitsWithSubjects(
+				NewGitCmd("log").Arg("--no-walk=unsorted").Arg(shas...),
+			)
+			if err != nil {
+				return nil, err
+			}
+			state.Heads = commits
+		}
+	}
+
+	if bytesContent, err := os.ReadFile(filepath.Join(gitDir, "MERGE_MSG")); err == nil {
+		state.Message, _, _ = strings.Cut(strings.TrimSpace(string(bytesContent)), "\n")
+	}
+
+	return state, nil
+}
+
+func (self *StatusCommands) commitFromFile(path string) (*models.Commit, error) {
+	bytesContent, err := os.ReadFile(path)
+	if err != nil {
+		return nil, nil
+	}
+
+	commits, err := self.commitsWithSubjects(
+		NewGitCmd("log").Arg("-1", strings.TrimSpace(string(bytesContent))),
+	)
+	if err != nil || len(commits) == 0 {
+		return nil, err
+	}
+	return commits[0], nil
+}
+
+// latestReflogMessage returns the message of the most recent entry of HEAD's
+// reflog that satisfies the given predicate, or "" if there is none. Reading
+// the file directly saves us running a git command on every render.
+func (self *StatusCommands) latestReflogMessage(predicate func(msg string) bool) string {
+	bytesContent, err := os.ReadFile(filepath.Join(self.repoPaths.WorktreeGitDirPath(), "logs", "HEAD"))
+	if err != nil {
+		return ""
+	}
+
+	lines := utils.SplitLines(string(bytesContent))
+	for i := len(lines) - 1; i >= 0; i-- {
+		// each line is "<old sha> <new sha> <committer> <timestamp>\t<message>"
+		_, msg, ok := strings.Cut(lines[i], "\t")
+		if ok && predicate(msg) {
+			return msg
+		}
+	}
+	return ""
+}
+
+func readIntFile(path string) int {
+	bytesContent, err := os.ReadFile(path)
+	if err != nil {
+		return 0
+	}
+	value, _ := strconv.Atoi(strings.TrimSpace(string(bytesContent)))
+	return value
+}
@@ -15,6 +15,8 @@ import (
 // [submodule "mysubmodule"]
 //   path = blah/mysubmodule
 //   url = git@github.com:subbo.git
+//   branch = main
+//   update = rebase
 
 type SubmoduleCommands struct {
 	*GitCommon
@@ -66,6 +68,10 @@ func (self *SubmoduleCommands) GetConfigs() ([]*models.SubmoduleConfig, error) {
 				lastConfig.Path = path
 			} else if url, ok := firstMatch(line, `\s*url\s*=\s*(.*)\s*`); ok {
 				lastConfig.Url = url
+			} else if branch, ok := firstMatch(line, `\s*branch\s*=\s*(.*)\s*`); ok {
+				lastConfig.Branch = branch
+			} else if update, ok := firstMatch(line, `\s*update\s*=\s*(.*)\s*`); ok {
+				lastConfig.UpdateStrategy = update
 			}
 		}
 	}
@@ -158,6 +164,22 @@ func (self *SubmoduleCommands) Add(name string, path string, url string) error {
 	return self.cmd.New(cmdArgs).Run()
 }
 
+// returns the URL of the origin remote of a repo nested within ours, or an
+// empty string if it has none
+func (self *SubmoduleCommands) GetNestedRepoUrl(path string) string {
+	cmdArgs := NewGitCmd("config").
+		Dir(path).
+		Arg("--get", "remote.origin.url").
+		ToArgv()
+
+	url, err := self.cmd.New(cmdArgs).DontLog().RunWithOutput()
+	if err != nil {
+		return ""
+	}
+
+	return strings.TrimSpace(url)
+}
+
 func (self *SubmoduleCommands) UpdateUrl(name string, path string, newUrl string) error {
 	setUrlCmdStr := NewGitCmd("config").
 		Arg(
@@ -180,6 +202,36 @@ func (self *SubmoduleCommands) UpdateUrl(name string, path string, newUrl string
 	return nil
 }
 
+// SetBranch sets the branch that the submodule tracks when updating with
+// --remote. An empty branch removes the setting so that the remote's default
+// branch is used.
+func (self *SubmoduleCommands) SetBranch(submodule *models.SubmoduleConfig, branch string) error {
+	key := "submodule." + submodule.Name + ".branch"
+	cmdArgs := NewGitCmd("config").Arg("--file", ".gitmodules").
+		ArgIf(branch == "", "--unset", key).
+		ArgIf(branch != "", key, branch).
+		ToArgv()
+
+	return self.cmd.New(cmdArgs).Run()
+}
+
+// SetUpdateStrategy sets how the submodule is updated (checkout, rebase or
+// merge). Git only reads this from .gitmodules when the submodule is first
+// initialised, so we set it in the repo's config too.
+func (self *SubmoduleCommands) SetUpdateStrategy(submodule *models.SubmoduleConfig, strategy string) error {
+	key := "submodule." + submodule.Name + ".update"
+
+	if err := self.cmd.New(
+		NewGitCmd("config").Arg("--file", ".gitmodules", key, strategy).ToArgv(),
+	).Run(); err != nil {
+		return err
+	}
+
+	return self.cmd.New(
+		NewGitCmd("config").Arg(key, strategy).ToArgv(),
+	).Run()
+}
+
 func (self *SubmoduleCommands) Init(path string) error {
 	cmdArgs := NewGitCmd("submodule").Arg("init", "--", path).
 		ToArgv()
@@ -194,6 +246,15 @@ func (self *SubmoduleCommands) Update(path string) error {
 	return self.cmd.New(cmdArgs).Run()
 }
 
+// UpdateFromRemoteCmdObj updates the submodule to the latest commit of its
+// tracked branch on the remote, using its configured update strategy.
+func (self *SubmoduleCommands) UpdateFromRemoteCmdObj(path string) oscommands.ICmdObj {
+	cmdArgs := NewGitCmd("submodule").Arg("update", "--init", "--remote", "--", path).
+		ToArgv()
+
+	return self.cmd.New(cmdArgs)
+}
+
 func (self *SubmoduleCommands) BulkInitCmdObj() oscommands.ICmdObj {
 	cmdArgs := NewGitCmd("submodule").Arg("init").
 		ToArgv()
@@ -208,6 +269,13 @@ func (self *SubmoduleCommands) BulkUpdateCmdObj() oscommands.ICmdObj {
 	return self.cmd.New(cmdArgs)
 }
 
+func (self *SubmoduleCommands) BulkUpdateFromRemoteCmdObj() oscommands.ICmdObj {
+	cmdArgs := NewGitCmd("submodule").Arg("update", "--remote").
+		ToArgv()
+
+	return self.cmd.New(cmdArgs)
+}
+
 func (self *SubmoduleCommands) ForceBulkUpdateCmdObj() oscommands.ICmdObj {
 	cmdArgs := NewGitCmd("submodule").Arg("update", "--force").
 		ToArgv()
@@ -222,6 +290,16 @@ func (self *SubmoduleCommands) BulkDeinitCmdObj() oscommands.ICmdObj {
 	return self.cmd.New(cmdArgs)
 }
 
+// ForeachCmdObj runs the given shell command in each submodule, including
+// nested ones. Git prints which submodule it's entering before running the
+// command there, so the output is grouped by submodule.
+func (self *SubmoduleCommands) ForeachCmdObj(command string) oscommands.ICmdObj {
+	cmdArgs := NewGitCmd("submodule").Arg("foreach", "--recursive", command).
+		ToArgv()
+
+	return self.cmd.New(cmdArgs)
+}
+
 func (self *SubmoduleCommands) ResetSubmodules(submodules []*models.SubmoduleConfig) error {
 	for _, submodule := range submodules {
 		if err := self.Stash(submodule); err != nil {
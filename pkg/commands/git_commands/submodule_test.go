@@ -0,0 +1,64 @@
+package git_commands
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/jesseduffield/lazygit/pkg/commands/models"
+	"github.com/jesseduffield/lazygit/pkg/commands/oscommands"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSubmoduleSetBranch(t *testing.T) {
+	type scenario struct {
+		testName string
+		branch   string
+		expected []string
+	}
+
+	scenarios := []scenario{
+		{
+			testName: "set branch",
+			branch:   "develop",
+			expected: []string{"config", "--file", ".gitmodules", "submodule.my_submodule.branch", "develop"},
+		},
+		{
+			testName: "unset branch",
+			branch:   "",
+			expected: []string{"config", "--file", ".gitmodules", "--unset", "submodule.my_submodule.branch"},
+		},
+	}
+
+	for _, s := range scenarios {
+		s := s
+		t.Run(s.testName, func(t *testing.T) {
+			runner := oscommands.NewFakeRunner(t).
+				ExpectGitArgs(s.expected, "", nil)
+			instance := buildSubmoduleCommands(commonDeps{runner: runner})
+
+			assert.NoError(t, instance.SetBranch(&models.SubmoduleConfig{Name: "my_submodule"}, s.branch))
+			runner.CheckForMissingCalls()
+		})
+	}
+}
+
+func TestSubmoduleSetUpdateStrategy(t *testing.T) {
+	runner := oscommands.NewFakeRunner(t).
+		ExpectGitArgs([]string{"config", "--file", ".gitmodules", "submodule.my_submodule.update", "rebase"}, "", nil).
+		ExpectGitArgs([]string{"config", "submodule.my_submodule.update", "rebase"}, "", nil)
+	instance := buildSubmoduleCommands(commonDeps{runner: runner})
+
+	assert.NoError(t, instance.SetUpdateStrategy(&models.SubmoduleConfig{Name: "my_submodule"}, "rebase"))
+	runner.CheckForMissingCalls()
+}
+
+func TestSubmoduleGetNestedRepoUrl(t *testing.T) {
+	runner := oscommands.NewFakeRunner(t).
+		ExpectGitArgs([]string{"-C", "nested", "config", "--get", "remote.origin.url"}, "git@github.com:nested.git\n", nil).
+		ExpectGitArgs([]string{"-C", "no-remote", "config", "--get", "remote.origin.url"}, "", errors.New("exit status 1"))
+	instance := buildSubmoduleCommands(commonDeps{runner: runner})
+
+	assert.Equal(t, "git@github.com:nested.git", instance.GetNestedRepoUrl("nested"))
+	assert.Equal(t, "", instance.GetNestedRepoUrl("no-remote"))
+	runner.CheckForMissingCalls()
+}
@@ -90,6 +90,7 @@ type PullOptions struct {
 
 func (self *SyncCommands) Pull(task gocui.Task, opts PullOptions) error {
 	cmdArgs := NewGitCmd("pull").
+		ConfigIf(self.conflictStyleConfig()).
 		Arg("--no-edit").
 		ArgIf(opts.FastForwardOnly, "--ff-only").
 		ArgIf(opts.RemoteName != "", opts.RemoteName).
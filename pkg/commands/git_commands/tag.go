@@ -1,6 +1,11 @@
 package git_commands
 
-import "github.com/jesseduffield/gocui"
+import (
+	"strings"
+
+	"github.com/jesseduffield/gocui"
+	"github.com/jesseduffield/lazygit/pkg/commands/models"
+)
 
 type TagCommands struct {
 	*GitCommon
@@ -32,6 +37,20 @@ func (self *TagCommands) CreateAnnotated(tagName, ref, msg string, force bool) e
 	return self.cmd.New(cmdArgs).Run()
 }
 
+// Move points an existing tag at a different commit by recreating it there.
+// An annotated tag keeps its message, but a signed tag loses its signature.
+func (self *TagCommands) Move(tagName string, ref string) error {
+	annotation, err := self.GetAnnotation(tagName)
+	if err != nil {
+		return err
+	}
+
+	if annotation != nil {
+		return self.CreateAnnotated(tagName, ref, annotation.Message, true)
+	}
+	return self.CreateLightweight(tagName, ref, true)
+}
+
 func (self *TagCommands) HasTag(tagName string) bool {
 	cmdArgs := NewGitCmd("show-ref").
 		Arg("--tags", "--quiet", "--verify", "--").
@@ -41,6 +60,48 @@ func (self *TagCommands) HasTag(tagName string) bool {
 	return self.cmd.New(cmdArgs).Run() == nil
 }
 
+// GetAnnotation returns the tagger, date, message and signature of an
+// annotated tag, or nil if it's a lightweight tag
+func (self *TagCommands) GetAnnotation(tagName string) (*models.TagAnnotation, error) {
+	cmdArgs := NewGitCmd("for-each-ref").
+		Arg("--format=%(objecttype)%00%(taggername) %(taggeremail)%00%(taggerdate)%00%(contents:subject)%00%(contents:body)%00%(contents:signature)").
+		Arg("refs/tags/" + tagName).
+		ToArgv()
+
+	output, err := self.cmd.New(cmdArgs).DontLog().RunWithOutput()
+	if err != nil {
+		return nil, err
+	}
+
+	fields := strings.Split(strings.TrimSuffix(output, "\n"), "\x00")
+	if len(fields) < 6 || fields[0] != "tag" {
+		return nil, nil
+	}
+
+	message := fields[3]
+	if body := strings.TrimSpace(fields[4]); body != "" {
+		message += "\n\n" + body
+	}
+
+	return &models.TagAnnotation{
+		Tagger:    fields[1],
+		Date:      fields[2],
+		Message:   message,
+		Signature: strings.TrimSpace(fields[5]),
+	}, nil
+}
+
+// VerifySignature checks the signature of an annotated tag. It returns what
+// gpg (or ssh) had to say about it, including which key made the signature,
+// and an error if the signature is bad or can't be verified.
+func (self *TagCommands) VerifySignature(tagName string) (string, error) {
+	cmdArgs := NewGitCmd("verify-tag").Arg("--", tagName).
+		ToArgv()
+
+	stdout, stderr, err := self.cmd.New(cmdArgs).DontLog().RunWithOutputs()
+	return strings.TrimSpace(stdout + stderr), err
+}
+
 func (self *TagCommands) LocalDelete(tagName string) error {
 	cmdArgs := NewGitCmd("tag").Arg("-d", tagName).
 		ToArgv()
@@ -54,3 +115,12 @@ func (self *TagCommands) Push(task gocui.Task, remoteName string, tagName string
 
 	return self.cmd.New(cmdArgs).PromptOnCredentialRequest(task).Run()
 }
+
+// ForcePush replaces the remote's copy of the tag with our own, which is
+// needed after moving a tag that has already been pushed
+func (self *TagCommands) ForcePush(task gocui.Task, remoteName string, tagName string) error {
+	cmdArgs := NewGitCmd("push").Arg("--force", remoteName, "tag", tagName).
+		ToArgv()
+
+	return self.cmd.New(cmdArgs).PromptOnCredentialRequest(task).Run()
+}
@@ -0,0 +1,144 @@
+package git_commands
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/jesseduffield/lazygit/pkg/commands/models"
+	"github.com/jesseduffield/lazygit/pkg/commands/oscommands"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTagGetAnnotation(t *testing.T) {
+	expectedArgs := []string{
+		"for-each-ref",
+		"--format=%(objecttype)%00%(taggername) %(taggeremail)%00%(taggerdate)%00%(contents:subject)%00%(contents:body)%00%(contents:signature)",
+		"refs/tags/v1.0",
+	}
+
+	type scenario struct {
+		testName string
+		output   string
+		expected *models.TagAnnotation
+	}
+
+	scenarios := []scenario{
+		{
+			testName: "lightweight tag",
+			output:   "commit\x00 \x00\x00initial commit\x00\x00\n",
+			expected: nil,
+		},
+		{
+			testName: "annotated tag",
+			output:   "tag\x00Jesse Duffield <jesse@example.com>\x00Thu Oct 15 11:46:01 2026 +0000\x00release 1.0\x00with some notes\n\x00\n",
+			expected: &models.TagAnnotation{
+				Tagger:  "Jesse Duffield <jesse@example.com>",
+				Date:    "Thu Oct 15 11:46:01 2026 +0000",
+				Message: "release 1.0\n\nwith some notes",
+			},
+		},
+		{
+			testName: "signed tag",
+			output:   "tag\x00Jesse Duffield <jesse@example.com>\x00Thu Oct 15 11:46:01 2026 +0000\x00release 1.0\x00\x00-----BEGIN PGP SIGNATURE-----\nabc\n-----END PGP SIGNATURE-----\n\n",
+			expected: &models.TagAnnotation{
+				Tagger:    "Jesse Duffield <jesse@example.com>",
+				Date:      "Thu Oct 15 11:46:01 2026 +0000",
+				Message:   "release 1.0",
+				Signature: "-----BEGIN PGP SIGNATURE-----\nabc\n-----END PGP SIGNATURE-----",
+			},
+		},
+	}
+
+	for _, s := range scenarios {
+		s := s
+		t.Run(s.testName, func(t *testing.T) {
+			runner := oscommands.NewFakeRunner(t).
+				ExpectGitArgs(expectedArgs, s.output, nil)
+			instance := buildTagCommands(commonDeps{runner: runner})
+
+			annotation, err := instance.GetAnnotation("v1.0")
+			assert.NoError(t, err)
+			assert.Equal(t, s.expected, annotation)
+			runner.CheckForMissingCalls()
+		})
+	}
+}
+
+func TestTagVerifySignature(t *testing.T) {
+	type scenario struct {
+		testName       string
+		runner         *oscommands.FakeCmdObjRunner
+		expectedOutput string
+		expectedError  bool
+	}
+
+	scenarios := []scenario{
+		{
+			testName: "good signature",
+			runner: oscommands.NewFakeRunner(t).
+				ExpectGitArgs([]string{"verify-tag", "--", "v1.0"}, "Good \"git\" signature for jesse@example.com with ED25519 key SHA256:abc\n", nil),
+			expectedOutput: "Good \"git\" signature for jesse@example.com with ED25519 key SHA256:abc",
+			expectedError:  false,
+		},
+		{
+			testName: "bad signature",
+			runner: oscommands.NewFakeRunner(t).
+				ExpectGitArgs([]string{"verify-tag", "--", "v1.0"}, "", errors.New("error: no signature found")),
+			expectedError: true,
+		},
+	}
+
+	for _, s := range scenarios {
+		s := s
+		t.Run(s.testName, func(t *testing.T) {
+			instance := buildTagCommands(commonDeps{runner: s.runner})
+
+			output, err := instance.VerifySignature("v1.0")
+			if s.expectedError {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, s.expectedOutput, output)
+			}
+			s.runner.CheckForMissingCalls()
+		})
+	}
+}
+
+func TestTagMove(t *testing.T) {
+	annotationArgs := []string{
+		"for-each-ref",
+		"--format=%(objecttype)%00%(taggername) %(taggeremail)%00%(taggerdate)%00%(contents:subject)%00%(contents:body)%00%(contents:signature)",
+		"refs/tags/v1.0",
+	}
+
+	type scenario struct {
+		testName string
+		runner   *oscommands.FakeCmdObjRunner
+	}
+
+	scenarios := []scenario{
+		{
+			testName: "lightweight tag",
+			runner: oscommands.NewFakeRunner(t).
+				ExpectGitArgs(annotationArgs, "commit\x00 \x00\x00initial commit\x00\x00\n", nil).
+				ExpectGitArgs([]string{"tag", "--force", "--", "v1.0", "abc123"}, "", nil),
+		},
+		{
+			testName: "annotated tag keeps its message",
+			runner: oscommands.NewFakeRunner(t).
+				ExpectGitArgs(annotationArgs, "tag\x00CI <CI@example.com>\x00Thu Oct 15 11:46:01 2026 +0000\x00release 1.0\x00\x00\n", nil).
+				ExpectGitArgs([]string{"tag", "v1.0", "--force", "abc123", "-m", "release 1.0"}, "", nil),
+		},
+	}
+
+	for _, s := range scenarios {
+		s := s
+		t.Run(s.testName, func(t *testing.T) {
+			instance := buildTagCommands(commonDeps{runner: s.runner})
+
+			assert.NoError(t, instance.Move("v1.0", "abc123"))
+			s.runner.CheckForMissingCalls()
+		})
+	}
+}
@@ -0,0 +1,206 @@
+package git_commands
+
+import (
+	"io/fs"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/jesseduffield/lazygit/pkg/commands/models"
+	"github.com/samber/lo"
+)
+
+// Like parked patches, the trash lives in the git dir of the worktree. Each
+// entry is a directory named after the time of the discard, holding the list
+// of discarded paths and copies of those of them that existed. A path without
+// a copy didn't exist, i.e. the discard brought the file back.
+
+const (
+	trashTimeFormat = "20060102150405.000000000"
+	trashPathsFile  = "paths"
+	trashFilesDir   = "files"
+	// older entries are deleted when a new one is added
+	maxTrashEntries = 100
+)
+
+func (self *WorkingTreeCommands) trashDir() string {
+	return filepath.Join(self.repoPaths.WorktreeGitDirPath(), "lazygit", "trash")
+}
+
+// TrashFiles copies the given files, as they are in the worktree, into a new
+// trash entry. Their staged versions aren't kept. Directories are copied file by file, except for ones that are
+// repos of their own, i.e. submodules and nested repos.
+func (self *WorkingTreeCommands) TrashFiles(paths []string) error {
+	entryPath := filepath.Join(self.trashDir(), time.Now().Format(trashTimeFormat))
+
+	trashedPaths := []string{}
+	trashFile := func(path string, info fs.FileInfo) error {
+		if err := copyWorktreeFile(path, filepath.Join(entryPath, trashFilesDir, path), info); err != nil {
+			return err
+		}
+		trashedPaths = append(trashedPaths, path)
+		return nil
+	}
+
+	for _, path := range paths {
+		path = strings.TrimSuffix(path, "/")
+		info, err := os.Lstat(path)
+		if os.IsNotExist(err) {
+			trashedPaths = append(trashedPaths, path)
+			continue
+		}
+		if err == nil {
+			if info.IsDir() {
+				err = trashDirFiles(path, trashFile)
+			} else {
+				err = trashFile(path, info)
+			}
+		}
+		if err != nil {
+			_ = os.RemoveAll(entryPath)
+			return err
+		}
+	}
+
+	if len(trashedPaths) == 0 {
+		return nil
+	}
+
+	if err := os.MkdirAll(entryPath, 0o755); err != nil {
+		return err
+	}
+	if err := os.WriteFile(filepath.Join(entryPath, trashPathsFile), []byte(strings.Join(trashedPaths, "\x00")), 0o644); err != nil {
+		_ = os.RemoveAll(entryPath)
+		return err
+	}
+
+	return self.pruneTrash()
+}
+
+func trashDirFiles(dir string, trashFile func(string, fs.FileInfo) error) error {
+	return filepath.WalkDir(dir, func(path string, entry fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+
+		if entry.IsDir() {
+			if _, err := os.Lstat(filepath.Join(path, ".git")); err == nil {
+				return filepath.SkipDir
+			}
+			return nil
+		}
+
+		info, err := entry.Info()
+		if err != nil {
+			return err
+		}
+		return trashFile(filepath.ToSlash(path), info)
+	})
+}
+
+// GetTrashEntries returns the entries of the trash, most recent first
+func (self *WorkingTreeCommands) GetTrashEntries() ([]*models.TrashEntry, error) {
+	dirEntries, err := os.ReadDir(self.trashDir())
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	trashEntries := []*models.TrashEntry{}
+	for _, dirEntry := range dirEntries {
+		discardTime, err := time.ParseInLocation(trashTimeFormat, dirEntry.Name(), time.Local)
+		if !dirEntry.IsDir() || err != nil {
+			continue
+		}
+
+		path := filepath.Join(self.trashDir(), dirEntry.Name())
+		content, err := os.ReadFile(filepath.Join(path, trashPathsFile))
+		if err != nil {
+			return nil, err
+		}
+
+		trashEntries = append(trashEntries, &models.TrashEntry{
+			Files: strings.Split(string(content), "\x00"),
+			Time:  discardTime,
+			Path:  path,
+		})
+	}
+
+	sort.SliceStable(trashEntries, func(i, j int) bool {
+		return trashEntries[i].Time.After(trashEntries[j].Time)
+	})
+
+	return trashEntries, nil
+}
+
+// RestoreTrashEntry puts the files of the entry back the way they were before
+// the discard, overwriting any changes made to them since, and then removes
+// the entry from the trash
+func (self *WorkingTreeCommands) RestoreTrashEntry(trashEntry *models.TrashEntry) error {
+	for _, path := range trashEntry.Files {
+		copyPath := filepath.Join(trashEntry.Path, trashFilesDir, path)
+		info, err := os.Lstat(copyPath)
+		if os.IsNotExist(err) {
+			if err := os.Remove(path); err != nil && !os.IsNotExist(err) {
+				return err
+			}
+			continue
+		}
+		if err != nil {
+			return err
+		}
+
+		if err := copyWorktreeFile(copyPath, path, info); err != nil {
+			return err
+		}
+	}
+
+	return self.DropTrashEntry(trashEntry)
+}
+
+func (self *WorkingTreeCommands) DropTrashEntry(trashEntry *models.TrashEntry) error {
+	return os.RemoveAll(trashEntry.Path)
+}
+
+func (self *WorkingTreeCommands) pruneTrash() error {
+	trashEntries, err := self.GetTrashEntries()
+	if err != nil || len(trashEntries) <= maxTrashEntries {
+		return err
+	}
+
+	for _, trashEntry := range lo.Drop(trashEntries, maxTrashEntries) {
+		if err := self.DropTrashEntry(trashEntry); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// copies a file or symlink, keeping its permissions
+func copyWorktreeFile(from string, to string, info fs.FileInfo) error {
+	if err := os.MkdirAll(filepath.Dir(to), 0o755); err != nil {
+		return err
+	}
+	if err := os.Remove(to); err != nil && !os.IsNotExist(err) {
+		return err
+	}
+
+	if info.Mode()&os.ModeSymlink != 0 {
+		target, err := os.Readlink(from)
+		if err != nil {
+			return err
+		}
+		return os.Symlink(target, to)
+	}
+
+	content, err := os.ReadFile(from)
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(to, content, info.Mode().Perm())
+}
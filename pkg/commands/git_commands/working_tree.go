@@ -3,10 +3,17 @@ package git_commands
 import (
 	"fmt"
 	"os"
+	"path"
+	"path/filepath"
+	"regexp"
+	"strconv"
+	"strings"
 
 	"github.com/go-errors/errors"
 	"github.com/jesseduffield/lazygit/pkg/commands/models"
 	"github.com/jesseduffield/lazygit/pkg/commands/oscommands"
+	"github.com/jesseduffield/lazygit/pkg/utils"
+	"github.com/samber/lo"
 )
 
 type WorkingTreeCommands struct {
@@ -42,6 +49,15 @@ func (self *WorkingTreeCommands) StageFiles(paths []string) error {
 	return self.cmd.New(cmdArgs).Run()
 }
 
+// IntentToAdd records that we'll add the untracked file later, so that it shows
+// up in diffs as an added file whose lines can be staged individually. Removing
+// it from the index again with UnStageFile makes it untracked again.
+func (self *WorkingTreeCommands) IntentToAdd(path string) error {
+	cmdArgs := NewGitCmd("add").Arg("--intent-to-add", "--", path).ToArgv()
+
+	return self.cmd.New(cmdArgs).Run()
+}
+
 // StageAll stages all files
 func (self *WorkingTreeCommands) StageAll() error {
 	cmdArgs := NewGitCmd("add").Arg("-A").ToArgv()
@@ -74,6 +90,36 @@ func (self *WorkingTreeCommands) UnStageFile(fileNames []string, reset bool) err
 	return nil
 }
 
+// UnstageFiles unstages several files in one go. As with UnStageFile, newly
+// added files are removed from the index rather than reset
+func (self *WorkingTreeCommands) UnstageFiles(files []*models.File) error {
+	trackedNames := []string{}
+	addedNames := []string{}
+	for _, file := range files {
+		if file.Tracked {
+			trackedNames = append(trackedNames, file.Names()...)
+		} else {
+			addedNames = append(addedNames, file.Names()...)
+		}
+	}
+
+	if len(trackedNames) > 0 {
+		cmdArgs := NewGitCmd("reset").Arg("HEAD", "--").Arg(trackedNames...).ToArgv()
+		if err := self.cmd.New(cmdArgs).Run(); err != nil {
+			return err
+		}
+	}
+
+	if len(addedNames) > 0 {
+		cmdArgs := NewGitCmd("rm").Arg("--cached", "--force", "--").Arg(addedNames...).ToArgv()
+		if err := self.cmd.New(cmdArgs).Run(); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
 func (self *WorkingTreeCommands) BeforeAndAfterFileForRename(file *models.File) (*models.File, *models.File, error) {
 	if !file.IsRename() {
 		return nil, nil, errors.New("Expected renamed file")
@@ -168,6 +214,58 @@ func (self *WorkingTreeCommands) DiscardAllFileChanges(file *models.File) error
 	return self.DiscardUnstagedFileChanges(file)
 }
 
+// DiscardAllFilesChanges discards the changes of several files. Plain changes
+// to tracked files are discarded with a single git call; files that need
+// special handling are discarded one at a time.
+func (self *WorkingTreeCommands) DiscardAllFilesChanges(files []*models.File) error {
+	plainPaths := []string{}
+	for _, file := range files {
+		if file.Tracked && !file.Added && !file.IsRename() && !file.HasMergeConflicts {
+			plainPaths = append(plainPaths, file.Name)
+			continue
+		}
+
+		if err := self.DiscardAllFileChanges(file); err != nil {
+			return err
+		}
+	}
+
+	if len(plainPaths) == 0 {
+		return nil
+	}
+
+	// checking out from HEAD resets both the index and the worktree
+	cmdArgs := NewGitCmd("checkout").Arg("HEAD", "--").Arg(plainPaths...).ToArgv()
+	return self.cmd.New(cmdArgs).Run()
+}
+
+// DiscardUnstagedFilesChanges discards the unstaged changes of several files,
+// deleting the untracked ones
+func (self *WorkingTreeCommands) DiscardUnstagedFilesChanges(files []*models.File) error {
+	paths := []string{}
+	for _, file := range files {
+		if !file.HasUnstagedChanges {
+			continue
+		}
+
+		if file.ShortStatus == "??" {
+			if err := self.os.RemoveFile(file.Name); err != nil {
+				return err
+			}
+			continue
+		}
+
+		paths = append(paths, file.Name)
+	}
+
+	if len(paths) == 0 {
+		return nil
+	}
+
+	cmdArgs := NewGitCmd("checkout").Arg("--").Arg(paths...).ToArgv()
+	return self.cmd.New(cmdArgs).Run()
+}
+
 type IFileNode interface {
 	ForEachFile(cb func(*models.File) error) error
 	GetFilePathsMatching(test func(*models.File) bool) []string
@@ -223,6 +321,161 @@ func (self *WorkingTreeCommands) Exclude(filename string) error {
 	return self.os.AppendLineToFile(".git/info/exclude", filename)
 }
 
+// each line of `git check-ignore --verbose` looks like
+// <source>:<line number>:<pattern><TAB><path>
+var ignoreRuleRegexp = regexp.MustCompile(`^(.*?):(\d+):(.*)\t`)
+
+// GetMatchingIgnoreRules returns the ignore rules that decide whether the given
+// path is ignored, along with the ones deciding it for its parent directories
+// (since ignoring a directory ignores everything in it). Tracked files are
+// checked too.
+func (self *WorkingTreeCommands) GetMatchingIgnoreRules(name string) ([]*models.IgnoreRule, error) {
+	paths := []string{name}
+	for dir := path.Dir(name); dir != "." && dir != "/"; dir = path.Dir(dir) {
+		paths = append(paths, dir)
+	}
+
+	cmdArgs := NewGitCmd("check-ignore").
+		Arg("--verbose", "--no-index").
+		Arg(paths...).
+		ToArgv()
+
+	output, stderr, err := self.cmd.New(cmdArgs).DontLog().RunWithOutputs()
+	if err != nil {
+		// check-ignore exits with 1 when nothing matches
+		if output == "" && strings.TrimSpace(stderr) == "" {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	rules := []*models.IgnoreRule{}
+	for _, line := range utils.SplitLines(output) {
+		match := ignoreRuleRegexp.FindStringSubmatch(line)
+		if match == nil {
+			continue
+		}
+
+		lineNumber, err := strconv.Atoi(match[2])
+		if err != nil {
+			continue
+		}
+
+		rule := &models.IgnoreRule{Source: match[1], LineNumber: lineNumber, Pattern: match[3]}
+		if !lo.ContainsBy(rules, func(existing *models.IgnoreRule) bool { return *existing == *rule }) {
+			rules = append(rules, rule)
+		}
+	}
+	return rules, nil
+}
+
+// IsRepoIgnoreFile tells whether the given ignore file (as reported by git) is
+// one of the repo's own, i.e. a .gitignore file in the worktree or the repo's
+// info/exclude file, as opposed to a global one like core.excludesFile, which
+// other repos depend on too
+func (self *WorkingTreeCommands) IsRepoIgnoreFile(source string) bool {
+	absPath := source
+	if !filepath.IsAbs(absPath) {
+		absPath = filepath.Join(self.repoPaths.WorktreePath(), source)
+	}
+	absPath = filepath.Clean(absPath)
+
+	if absPath == filepath.Join(self.repoPaths.RepoGitDirPath(), "info", "exclude") {
+		return true
+	}
+
+	relPath, err := filepath.Rel(self.repoPaths.WorktreePath(), absPath)
+	if err != nil || relPath == ".." || strings.HasPrefix(relPath, ".."+string(filepath.Separator)) {
+		return false
+	}
+	return filepath.Base(absPath) == ".gitignore"
+}
+
+// RemoveIgnoreRule removes the rule's line from its file, as long as the line
+// still holds the rule and the file is one of the repo's own
+func (self *WorkingTreeCommands) RemoveIgnoreRule(rule *models.IgnoreRule) error {
+	if !self.IsRepoIgnoreFile(rule.Source) {
+		return errors.New(self.Tr.CantRemoveGlobalIgnoreRule)
+	}
+
+	content, err := os.ReadFile(rule.Source)
+	if err != nil {
+		return err
+	}
+
+	lines := strings.SplitAfter(string(content), "\n")
+	lineIdx := rule.LineNumber - 1
+	if lineIdx >= len(lines) || strings.TrimSpace(lines[lineIdx]) != strings.TrimSpace(rule.Pattern) {
+		return errors.New(self.Tr.IgnoreRuleChanged)
+	}
+
+	newContent := strings.Join(append(lines[:lineIdx], lines[lineIdx+1:]...), "")
+	return self.os.CreateFileWithContent(rule.Source, newContent)
+}
+
+// IgnoredDirectories returns the directories whose contents are ignored as a
+// whole (e.g. node_modules), relative to the worktree root
+func (self *WorkingTreeCommands) IgnoredDirectories() ([]string, error) {
+	cmdArgs := NewGitCmd("ls-files").
+		Arg("--others", "--ignored", "--exclude-standard", "--directory", "-z").
+		ToArgv()
+
+	output, err := self.cmd.New(cmdArgs).DontLog().RunWithOutput()
+	if err != nil {
+		return nil, err
+	}
+
+	dirs := []string{}
+	for _, name := range strings.Split(output, "\x00") {
+		// with --directory, ignored directories are listed with a trailing slash
+		if strings.HasSuffix(name, "/") {
+			dirs = append(dirs, strings.TrimSuffix(name, "/"))
+		}
+	}
+	return dirs, nil
+}
+
+// LfsTrackedFiles returns those of the given paths that are tracked with
+// git-lfs, i.e. whose filter attribute is "lfs"
+func (self *WorkingTreeCommands) LfsTrackedFiles(paths []string) ([]string, error) {
+	cmdArgs := NewGitCmd("check-attr").
+		Arg("-z", "filter", "--").
+		Arg(paths...).
+		ToArgv()
+
+	output, err := self.cmd.New(cmdArgs).DontLog().RunWithOutput()
+	if err != nil {
+		return nil, err
+	}
+
+	// the output is a sequence of <path> NUL <attribute> NUL <value> NUL
+	fields := strings.Split(output, "\x00")
+	result := []string{}
+	for i := 0; i+2 < len(fields); i += 3 {
+		if fields[i+2] == "lfs" {
+			result = append(result, fields[i])
+		}
+	}
+	return result, nil
+}
+
+// IsIgnored returns whether the given path is ignored by git
+func (self *WorkingTreeCommands) IsIgnored(name string) (bool, error) {
+	cmdArgs := NewGitCmd("check-ignore").
+		Arg("--quiet", "--", name).
+		ToArgv()
+
+	_, stderr, err := self.cmd.New(cmdArgs).DontLog().RunWithOutputs()
+	if err != nil {
+		// check-ignore exits with 1 when the path isn't ignored
+		if strings.TrimSpace(stderr) == "" {
+			return false, nil
+		}
+		return false, err
+	}
+	return true, nil
+}
+
 // WorktreeFileDiff returns the diff of a file
 func (self *WorkingTreeCommands) WorktreeFileDiff(file *models.File, plain bool, cached bool) string {
 	// for now we assume an error means the file was deleted
@@ -260,6 +513,81 @@ func (self *WorkingTreeCommands) WorktreeFileDiffCmdObj(node models.IFile, plain
 	return self.cmd.New(cmdArgs).DontLog()
 }
 
+// WorktreePathsDiffCmdObj shows the changes of several tracked paths at once,
+// e.g. for a section of the files panel
+func (self *WorkingTreeCommands) WorktreePathsDiffCmdObj(paths []string, cached bool) oscommands.ICmdObj {
+	extDiffCmd := self.UserConfig.Git.Paging.ExternalDiffCommand
+	useExtDiff := extDiffCmd != ""
+
+	cmdArgs := NewGitCmd("diff").
+		ConfigIf(useExtDiff, "diff.external="+extDiffCmd).
+		ArgIfElse(useExtDiff, "--ext-diff", "--no-ext-diff").
+		Arg("--submodule").
+		Arg(fmt.Sprintf("--unified=%d", self.AppState.DiffContextSize)).
+		Arg(fmt.Sprintf("--color=%s", self.UserConfig.Git.Paging.ColorArg)).
+		ArgIf(self.AppState.IgnoreWhitespaceInDiffView, "--ignore-all-space").
+		ArgIf(cached, "--cached").
+		Arg("--").
+		Arg(paths...).
+		ToArgv()
+
+	return self.cmd.New(cmdArgs).DontLog()
+}
+
+// WorktreeFilesDiffCmdObj shows the changes of the given files at once, e.g.
+// for a directory of the files panel. Git won't diff untracked files together
+// with tracked ones, so for the unstaged changes we mark them as intent-to-add
+// in a throwaway copy of the index, which leaves the real index alone. Copying
+// the index can take a while in a big repo, so that's left to the returned
+// prepare function (nil if there's nothing to prepare), which is to be called
+// off the UI thread before running the command.
+func (self *WorkingTreeCommands) WorktreeFilesDiffCmdObj(trackedPaths []string, untrackedPaths []string, cached bool) (cmdObj oscommands.ICmdObj, prepare func() error) {
+	if cached || len(untrackedPaths) == 0 {
+		return self.WorktreePathsDiffCmdObj(trackedPaths, cached), nil
+	}
+
+	indexPath := filepath.Join(self.os.GetTempDir(), self.repoPaths.RepoName(), "untracked-diff.index")
+	envVar := "GIT_INDEX_FILE=" + indexPath
+
+	prepare = func() error {
+		if err := self.copyIndex(indexPath); err != nil {
+			return err
+		}
+
+		addCmdArgs := NewGitCmd("add").Arg("--intent-to-add", "--").Arg(untrackedPaths...).ToArgv()
+		return self.cmd.New(addCmdArgs).AddEnvVars(envVar).DontLog().Run()
+	}
+
+	return self.WorktreePathsDiffCmdObj(append(trackedPaths, untrackedPaths...), false).AddEnvVars(envVar), prepare
+}
+
+// copyIndex copies the index to the given path. The copy is moved into place
+// so that a diff still reading the previous one isn't disturbed.
+func (self *WorkingTreeCommands) copyIndex(indexPath string) error {
+	if err := os.MkdirAll(filepath.Dir(indexPath), 0o755); err != nil {
+		return err
+	}
+
+	content, err := os.ReadFile(filepath.Join(self.repoPaths.WorktreeGitDirPath(), "index"))
+	if os.IsNotExist(err) {
+		// in a brand-new repo there's no index yet, and git will start an empty one
+		if err := os.Remove(indexPath); err != nil && !os.IsNotExist(err) {
+			return err
+		}
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	tempPath := indexPath + ".new"
+	if err := os.WriteFile(tempPath, content, 0o644); err != nil {
+		return err
+	}
+
+	return os.Rename(tempPath, indexPath)
+}
+
 // ShowFileDiff get the diff of specified from and to. Typically this will be used for a single commit so it'll be 123abc^..123abc
 // but when we're in diff mode it could be any 'from' to any 'to'. The reverse flag is also here thanks to diff mode.
 func (self *WorkingTreeCommands) ShowFileDiff(from string, to string, reverse bool, fileName string, plain bool) (string, error) {
@@ -303,6 +631,15 @@ func (self *WorkingTreeCommands) CheckoutFile(commitSha, fileName string) error
 	return self.cmd.New(cmdArgs).Run()
 }
 
+// RecreateConflictMarkers rewrites the conflict markers of an unmerged file in
+// the given style (merge, diff3 or zdiff3), discarding any edits made to it
+func (self *WorkingTreeCommands) RecreateConflictMarkers(path string, style string) error {
+	cmdArgs := NewGitCmd("checkout").Arg("--conflict="+style, "--", path).
+		ToArgv()
+
+	return self.cmd.New(cmdArgs).Run()
+}
+
 // DiscardAnyUnstagedFileChanges discards any unstaged file changes via `git checkout -- .`
 func (self *WorkingTreeCommands) DiscardAnyUnstagedFileChanges() error {
 	cmdArgs := NewGitCmd("checkout").Arg("--", ".").
@@ -321,9 +658,30 @@ func (self *WorkingTreeCommands) RemoveTrackedFiles(name string) error {
 
 // RemoveUntrackedFiles runs `git clean -fd`
 func (self *WorkingTreeCommands) RemoveUntrackedFiles() error {
+	return self.RemoveUntrackedFilesCmdObj().Run()
+}
+
+func (self *WorkingTreeCommands) RemoveUntrackedFilesCmdObj() oscommands.ICmdObj {
 	cmdArgs := NewGitCmd("clean").Arg("-fd").ToArgv()
 
-	return self.cmd.New(cmdArgs).Run()
+	return self.cmd.New(cmdArgs)
+}
+
+// UntrackedFilesToRemove returns the paths that `git clean -fd` would remove,
+// by asking git to do a dry run of it
+func (self *WorkingTreeCommands) UntrackedFilesToRemove() ([]string, error) {
+	cmdArgs := NewGitCmd("clean").Arg("-fd", "--dry-run").ToArgv()
+
+	output, err := self.cmd.New(cmdArgs).DontLog().RunWithOutput()
+	if err != nil {
+		return nil, err
+	}
+
+	paths := []string{}
+	for _, line := range utils.SplitLines(output) {
+		paths = append(paths, strings.TrimPrefix(line, "Would remove "))
+	}
+	return paths, nil
 }
 
 // ResetAndClean removes all unstaged changes and removes all untracked files
@@ -348,10 +706,14 @@ func (self *WorkingTreeCommands) ResetAndClean() error {
 
 // ResetHardHead runs `git reset --hard`
 func (self *WorkingTreeCommands) ResetHard(ref string) error {
+	return self.ResetHardCmdObj(ref).Run()
+}
+
+func (self *WorkingTreeCommands) ResetHardCmdObj(ref string) oscommands.ICmdObj {
 	cmdArgs := NewGitCmd("reset").Arg("--hard", ref).
 		ToArgv()
 
-	return self.cmd.New(cmdArgs).Run()
+	return self.cmd.New(cmdArgs)
 }
 
 // ResetSoft runs `git reset --soft HEAD`
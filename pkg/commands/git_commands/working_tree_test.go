@@ -31,6 +31,16 @@ func TestWorkingTreeStageFiles(t *testing.T) {
 	runner.CheckForMissingCalls()
 }
 
+func TestWorkingTreeIntentToAdd(t *testing.T) {
+	runner := oscommands.NewFakeRunner(t).
+		ExpectGitArgs([]string{"add", "--intent-to-add", "--", "test.txt"}, "", nil)
+
+	instance := buildWorkingTreeCommands(commonDeps{runner: runner})
+
+	assert.NoError(t, instance.IntentToAdd("test.txt"))
+	runner.CheckForMissingCalls()
+}
+
 func TestWorkingTreeUnstageFile(t *testing.T) {
 	type scenario struct {
 		testName string
@@ -69,6 +79,20 @@ func TestWorkingTreeUnstageFile(t *testing.T) {
 	}
 }
 
+func TestWorkingTreeUnstageFiles(t *testing.T) {
+	runner := oscommands.NewFakeRunner(t).
+		ExpectGitArgs([]string{"reset", "HEAD", "--", "a.txt", "new.txt", "old.txt"}, "", nil).
+		ExpectGitArgs([]string{"rm", "--cached", "--force", "--", "b.txt"}, "", nil)
+
+	instance := buildWorkingTreeCommands(commonDeps{runner: runner})
+	assert.NoError(t, instance.UnstageFiles([]*models.File{
+		{Name: "a.txt", Tracked: true},
+		{Name: "b.txt", Tracked: false},
+		{Name: "new.txt", PreviousName: "old.txt", Tracked: true},
+	}))
+	runner.CheckForMissingCalls()
+}
+
 // these tests don't cover everything, in part because we already have an integration
 // test which does cover everything. I don't want to unnecessarily assert on the 'how'
 // when the 'what' is what matters
@@ -205,6 +229,47 @@ func TestWorkingTreeDiscardAllFileChanges(t *testing.T) {
 	}
 }
 
+func TestWorkingTreeDiscardAllFilesChanges(t *testing.T) {
+	runner := oscommands.NewFakeRunner(t).
+		ExpectGitArgs([]string{"reset", "--", "added.txt"}, "", nil).
+		ExpectGitArgs([]string{"checkout", "HEAD", "--", "modified.txt", "staged.txt"}, "", nil)
+	removedFiles := []string{}
+	removeFile := func(path string) error {
+		removedFiles = append(removedFiles, path)
+		return nil
+	}
+
+	instance := buildWorkingTreeCommands(commonDeps{runner: runner, removeFile: removeFile})
+	assert.NoError(t, instance.DiscardAllFilesChanges([]*models.File{
+		{Name: "modified.txt", Tracked: true, HasUnstagedChanges: true},
+		{Name: "added.txt", Added: true, HasStagedChanges: true},
+		{Name: "untracked.txt", Added: true, HasUnstagedChanges: true},
+		{Name: "staged.txt", Tracked: true, HasStagedChanges: true},
+	}))
+	assert.Equal(t, []string{"added.txt", "untracked.txt"}, removedFiles)
+	runner.CheckForMissingCalls()
+}
+
+func TestWorkingTreeDiscardUnstagedFilesChanges(t *testing.T) {
+	runner := oscommands.NewFakeRunner(t).
+		ExpectGitArgs([]string{"checkout", "--", "modified.txt", "added-and-modified.txt"}, "", nil)
+	removedFiles := []string{}
+	removeFile := func(path string) error {
+		removedFiles = append(removedFiles, path)
+		return nil
+	}
+
+	instance := buildWorkingTreeCommands(commonDeps{runner: runner, removeFile: removeFile})
+	assert.NoError(t, instance.DiscardUnstagedFilesChanges([]*models.File{
+		{Name: "modified.txt", ShortStatus: "MM", HasStagedChanges: true, HasUnstagedChanges: true},
+		{Name: "untracked.txt", ShortStatus: "??", HasUnstagedChanges: true},
+		{Name: "staged.txt", ShortStatus: "M ", HasStagedChanges: true},
+		{Name: "added-and-modified.txt", ShortStatus: "AM", HasStagedChanges: true, HasUnstagedChanges: true},
+	}))
+	assert.Equal(t, []string{"untracked.txt"}, removedFiles)
+	runner.CheckForMissingCalls()
+}
+
 func TestWorkingTreeDiff(t *testing.T) {
 	type scenario struct {
 		testName         string
@@ -321,6 +386,44 @@ func TestWorkingTreeDiff(t *testing.T) {
 	}
 }
 
+func TestWorkingTreePathsDiff(t *testing.T) {
+	type scenario struct {
+		testName         string
+		paths            []string
+		cached           bool
+		ignoreWhitespace bool
+		expectedArgs     []string
+	}
+
+	scenarios := []scenario{
+		{
+			testName:     "Unstaged",
+			paths:        []string{"dir/a.txt", "b.txt"},
+			cached:       false,
+			expectedArgs: []string{"git", "diff", "--no-ext-diff", "--submodule", "--unified=3", "--color=always", "--", "dir/a.txt", "b.txt"},
+		},
+		{
+			testName:         "Staged, ignoring whitespace",
+			paths:            []string{"b.txt"},
+			cached:           true,
+			ignoreWhitespace: true,
+			expectedArgs:     []string{"git", "diff", "--no-ext-diff", "--submodule", "--unified=3", "--color=always", "--ignore-all-space", "--cached", "--", "b.txt"},
+		},
+	}
+
+	for _, s := range scenarios {
+		s := s
+		t.Run(s.testName, func(t *testing.T) {
+			appState := &config.AppState{}
+			appState.IgnoreWhitespaceInDiffView = s.ignoreWhitespace
+			appState.DiffContextSize = 3
+
+			instance := buildWorkingTreeCommands(commonDeps{userConfig: config.GetDefaultConfig(), appState: appState})
+			assert.Equal(t, s.expectedArgs, instance.WorktreePathsDiffCmdObj(s.paths, s.cached).Args())
+		})
+	}
+}
+
 func TestWorkingTreeShowFileDiff(t *testing.T) {
 	type scenario struct {
 		testName         string
@@ -518,6 +621,40 @@ func TestWorkingTreeRemoveUntrackedFiles(t *testing.T) {
 	}
 }
 
+func TestWorkingTreeUntrackedFilesToRemove(t *testing.T) {
+	type scenario struct {
+		testName      string
+		runner        *oscommands.FakeCmdObjRunner
+		expectedPaths []string
+	}
+
+	scenarios := []scenario{
+		{
+			testName: "nothing to remove",
+			runner: oscommands.NewFakeRunner(t).
+				ExpectGitArgs([]string{"clean", "-fd", "--dry-run"}, "", nil),
+			expectedPaths: []string{},
+		},
+		{
+			testName: "files and directories",
+			runner: oscommands.NewFakeRunner(t).
+				ExpectGitArgs([]string{"clean", "-fd", "--dry-run"}, "Would remove dir/\nWould remove file with spaces\n", nil),
+			expectedPaths: []string{"dir/", "file with spaces"},
+		},
+	}
+
+	for _, s := range scenarios {
+		s := s
+		t.Run(s.testName, func(t *testing.T) {
+			instance := buildWorkingTreeCommands(commonDeps{runner: s.runner})
+			paths, err := instance.UntrackedFilesToRemove()
+			assert.NoError(t, err)
+			assert.Equal(t, s.expectedPaths, paths)
+			s.runner.CheckForMissingCalls()
+		})
+	}
+}
+
 func TestWorkingTreeResetHard(t *testing.T) {
 	type scenario struct {
 		testName string
@@ -546,3 +683,138 @@ func TestWorkingTreeResetHard(t *testing.T) {
 		})
 	}
 }
+
+func TestWorkingTreeGetMatchingIgnoreRules(t *testing.T) {
+	type scenario struct {
+		testName      string
+		runner        *oscommands.FakeCmdObjRunner
+		expectedRules []*models.IgnoreRule
+	}
+
+	scenarios := []scenario{
+		{
+			testName: "no matching rules",
+			runner: oscommands.NewFakeRunner(t).
+				ExpectGitArgs([]string{"check-ignore", "--verbose", "--no-index", "dir/file.txt", "dir"}, "", errors.New("exit status 1")),
+			expectedRules: nil,
+		},
+		{
+			testName: "rules matching the file and its directory",
+			runner: oscommands.NewFakeRunner(t).
+				ExpectGitArgs(
+					[]string{"check-ignore", "--verbose", "--no-index", "dir/file.txt", "dir"},
+					".gitignore:3:*.txt\tdir/file.txt\n.git/info/exclude:1:/dir/\tdir\n",
+					nil,
+				),
+			expectedRules: []*models.IgnoreRule{
+				{Source: ".gitignore", LineNumber: 3, Pattern: "*.txt"},
+				{Source: ".git/info/exclude", LineNumber: 1, Pattern: "/dir/"},
+			},
+		},
+	}
+
+	for _, s := range scenarios {
+		s := s
+		t.Run(s.testName, func(t *testing.T) {
+			instance := buildWorkingTreeCommands(commonDeps{runner: s.runner})
+			rules, err := instance.GetMatchingIgnoreRules("dir/file.txt")
+			assert.NoError(t, err)
+			assert.Equal(t, s.expectedRules, rules)
+			s.runner.CheckForMissingCalls()
+		})
+	}
+}
+
+func TestWorkingTreeIsRepoIgnoreFile(t *testing.T) {
+	scenarios := []struct {
+		source   string
+		expected bool
+	}{
+		{source: ".gitignore", expected: true},
+		{source: "dir/.gitignore", expected: true},
+		{source: "/repo/dir/.gitignore", expected: true},
+		{source: ".git/info/exclude", expected: true},
+		{source: "/home/user/.config/git/ignore", expected: false},
+		{source: "/home/user/.gitignore", expected: false},
+		{source: "../other/.gitignore", expected: false},
+		{source: "dir/global-ignore", expected: false},
+	}
+
+	instance := buildWorkingTreeCommands(commonDeps{repoPaths: MockRepoPaths("/repo")})
+	for _, s := range scenarios {
+		s := s
+		t.Run(s.source, func(t *testing.T) {
+			assert.Equal(t, s.expected, instance.IsRepoIgnoreFile(s.source))
+		})
+	}
+}
+
+func TestWorkingTreeRemoveIgnoreRuleFromGlobalFile(t *testing.T) {
+	instance := buildWorkingTreeCommands(commonDeps{repoPaths: MockRepoPaths("/repo")})
+	err := instance.RemoveIgnoreRule(&models.IgnoreRule{Source: "/home/user/.config/git/ignore", LineNumber: 1, Pattern: "*.log"})
+	assert.EqualError(t, err, instance.Tr.CantRemoveGlobalIgnoreRule)
+}
+
+func TestWorkingTreeIgnoredDirectories(t *testing.T) {
+	runner := oscommands.NewFakeRunner(t).
+		ExpectGitArgs(
+			[]string{"ls-files", "--others", "--ignored", "--exclude-standard", "--directory", "-z"},
+			"node_modules/\x00build.log\x00dir/tmp/\x00",
+			nil,
+		)
+
+	instance := buildWorkingTreeCommands(commonDeps{runner: runner})
+	dirs, err := instance.IgnoredDirectories()
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"node_modules", "dir/tmp"}, dirs)
+	runner.CheckForMissingCalls()
+}
+
+func TestWorkingTreeLfsTrackedFiles(t *testing.T) {
+	runner := oscommands.NewFakeRunner(t).
+		ExpectGitArgs(
+			[]string{"check-attr", "-z", "filter", "--", "video.mp4", "data.bin"},
+			"video.mp4\x00filter\x00lfs\x00data.bin\x00filter\x00unspecified\x00",
+			nil,
+		)
+
+	instance := buildWorkingTreeCommands(commonDeps{runner: runner})
+	files, err := instance.LfsTrackedFiles([]string{"video.mp4", "data.bin"})
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"video.mp4"}, files)
+	runner.CheckForMissingCalls()
+}
+
+func TestWorkingTreeIsIgnored(t *testing.T) {
+	type scenario struct {
+		testName        string
+		runner          *oscommands.FakeCmdObjRunner
+		expectedIgnored bool
+	}
+
+	scenarios := []scenario{
+		{
+			testName: "ignored",
+			runner: oscommands.NewFakeRunner(t).
+				ExpectGitArgs([]string{"check-ignore", "--quiet", "--", "node_modules"}, "", nil),
+			expectedIgnored: true,
+		},
+		{
+			testName: "not ignored",
+			runner: oscommands.NewFakeRunner(t).
+				ExpectGitArgs([]string{"check-ignore", "--quiet", "--", "node_modules"}, "", errors.New("exit status 1")),
+			expectedIgnored: false,
+		},
+	}
+
+	for _, s := range scenarios {
+		s := s
+		t.Run(s.testName, func(t *testing.T) {
+			instance := buildWorkingTreeCommands(commonDeps{runner: s.runner})
+			ignored, err := instance.IsIgnored("node_modules")
+			assert.NoError(t, err)
+			assert.Equal(t, s.expectedIgnored, ignored)
+			s.runner.CheckForMissingCalls()
+		})
+	}
+}
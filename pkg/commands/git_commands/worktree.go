@@ -1,9 +1,13 @@
 package git_commands
 
 import (
+	iofs "io/fs"
 	"path/filepath"
+	"strings"
 
+	"github.com/go-errors/errors"
 	"github.com/jesseduffield/lazygit/pkg/commands/models"
+	"github.com/spf13/afero"
 )
 
 type WorktreeCommands struct {
@@ -48,6 +52,58 @@ func (self *WorktreeCommands) Delete(worktreePath string, force bool) error {
 	return self.cmd.New(cmdArgs).Run()
 }
 
+// Prune removes the administrative files of worktrees whose directories no
+// longer exist
+func (self *WorktreeCommands) Prune() error {
+	cmdArgs := NewGitCmd("worktree").Arg("prune").ToArgv()
+
+	return self.cmd.New(cmdArgs).Run()
+}
+
+// Repair fixes the links between the repo and its worktrees after either of
+// them has been moved. Worktrees that were moved need to be passed by their new
+// path, because git has no way of finding them otherwise.
+func (self *WorktreeCommands) Repair(worktreePaths ...string) error {
+	cmdArgs := NewGitCmd("worktree").Arg("repair").Arg(worktreePaths...).ToArgv()
+
+	return self.cmd.New(cmdArgs).Run()
+}
+
+// IsDirty tells us whether the worktree has any changes, including untracked
+// files
+func (self *WorktreeCommands) IsDirty(worktreePath string) (bool, error) {
+	cmdArgs := NewGitCmd("status").Arg("--porcelain").Dir(worktreePath).ToArgv()
+
+	output, err := self.cmd.New(cmdArgs).DontLog().RunWithOutput()
+	if err != nil {
+		return false, err
+	}
+
+	return strings.TrimSpace(output) != "", nil
+}
+
+// DiskUsage adds up the sizes of all the files in the worktree's directory.
+// It's approximate because it doesn't account for the size of directories
+// themselves or for files shared through hard links.
+func (self *WorktreeCommands) DiskUsage(worktreePath string) (int64, error) {
+	var total int64
+	err := afero.Walk(self.Fs, worktreePath, func(path string, info iofs.FileInfo, err error) error {
+		if err != nil {
+			// files can disappear while we're walking; that's fine for an estimate
+			if errors.Is(err, iofs.ErrNotExist) {
+				return nil
+			}
+			return err
+		}
+		if info.Mode().IsRegular() {
+			total += info.Size()
+		}
+		return nil
+	})
+
+	return total, err
+}
+
 func (self *WorktreeCommands) Detach(worktreePath string) error {
 	cmdArgs := NewGitCmd("checkout").Arg("--detach").GitDir(filepath.Join(worktreePath, ".git")).ToArgv()
 
@@ -73,6 +73,9 @@ func (self *WorktreeLoader) GetWorktrees() ([]*models.Worktree, error) {
 		} else if strings.HasPrefix(splitLine, "branch ") {
 			branch := strings.SplitN(splitLine, " ", 2)[1]
 			current.Branch = strings.TrimPrefix(branch, "refs/heads/")
+		} else if splitLine == "locked" || strings.HasPrefix(splitLine, "locked ") {
+			current.IsLocked = true
+			current.LockReason = strings.TrimPrefix(strings.TrimPrefix(splitLine, "locked"), " ")
 		}
 	}
 
@@ -94,6 +94,54 @@ branch refs/heads/mybranch-worktree
 			},
 			expectedErr: "",
 		},
+		{
+			testName: "Locked worktree",
+			repoPaths: &RepoPaths{
+				repoPath:     "/path/to/repo",
+				worktreePath: "/path/to/repo",
+			},
+			before: func(runner *oscommands.FakeCmdObjRunner, fs afero.Fs) {
+				runner.ExpectGitArgs([]string{"worktree", "list", "--porcelain"},
+					`worktree /path/to/repo
+HEAD d85cc9d281fa6ae1665c68365fc70e75e82a042d
+branch refs/heads/mybranch
+
+worktree /path/to/repo-worktree
+HEAD 775955775e79b8f5b4c4b56f82fbf657e2d5e4de
+branch refs/heads/mybranch-worktree
+locked on a usb stick
+`,
+					nil)
+
+				_ = fs.MkdirAll("/path/to/repo/.git", 0o755)
+				_ = fs.MkdirAll("/path/to/repo-worktree", 0o755)
+				_ = fs.MkdirAll("/path/to/repo/.git/worktrees/repo-worktree", 0o755)
+				_ = afero.WriteFile(fs, "/path/to/repo-worktree/.git", []byte("gitdir: /path/to/repo/.git/worktrees/repo-worktree"), 0o755)
+			},
+			expectedWorktrees: []*models.Worktree{
+				{
+					IsMain:        true,
+					IsCurrent:     true,
+					Path:          "/path/to/repo",
+					IsPathMissing: false,
+					GitDir:        "/path/to/repo/.git",
+					Branch:        "mybranch",
+					Name:          "repo",
+				},
+				{
+					IsMain:        false,
+					IsCurrent:     false,
+					Path:          "/path/to/repo-worktree",
+					IsPathMissing: false,
+					GitDir:        "/path/to/repo/.git/worktrees/repo-worktree",
+					Branch:        "mybranch-worktree",
+					IsLocked:      true,
+					LockReason:    "on a usb stick",
+					Name:          "repo-worktree",
+				},
+			},
+			expectedErr: "",
+		},
 		{
 			testName: "Worktree missing path",
 			repoPaths: &RepoPaths{
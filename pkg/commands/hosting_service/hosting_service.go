@@ -62,6 +62,46 @@ func (self *HostingServiceMgr) GetCommitURL(commitSha string) (string, error) {
 	return pullRequestURL, nil
 }
 
+// GetIssueURL returns the url of the issue with the given number, for services
+// that have an issue tracker
+func (self *HostingServiceMgr) GetIssueURL(issueNumber string) (string, error) {
+	gitService, err := self.getService()
+	if err != nil {
+		return "", err
+	}
+
+	if gitService.issueURL == "" {
+		return "", errors.New(self.tr.IssuesNotSupported)
+	}
+
+	return gitService.getIssueURL(issueNumber), nil
+}
+
+// matches the owner part of a remote url, keeping everything around it. The
+// owner may contain slashes, e.g. for GitLab subgroups
+var forkableUrlRegexes = []*regexp.Regexp{
+	regexp.MustCompile(`^(?P<prefix>(?:https?|ssh)://[^/]+/)(?P<owner>.+)(?P<suffix>/[^/]+)$`),
+	regexp.MustCompile(`^(?P<prefix>[^/]*?@[^/:]+:)(?P<owner>.+)(?P<suffix>/[^/]+)$`),
+}
+
+// GetForkURL returns the url of the given user's fork of the repo, using the
+// same protocol as the remote url. Forks always live directly under the user's
+// namespace, so any groups that the original repo is nested in are dropped.
+func (self *HostingServiceMgr) GetForkURL(owner string) (string, error) {
+	if _, err := self.getServiceDomain(self.remoteURL); err != nil {
+		return "", err
+	}
+
+	for _, re := range forkableUrlRegexes {
+		match := utils.FindNamedMatches(re, self.remoteURL)
+		if match != nil {
+			return match["prefix"] + owner + match["suffix"], nil
+		}
+	}
+
+	return "", errors.New("Failed to parse repo information from url")
+}
+
 func (self *HostingServiceMgr) getService() (*Service, error) {
 	serviceDomain, err := self.getServiceDomain(self.remoteURL)
 	if err != nil {
@@ -142,7 +182,9 @@ type ServiceDefinition struct {
 	pullRequestURLIntoDefaultBranch string
 	pullRequestURLIntoTargetBranch  string
 	commitURL                       string
-	regexStrings                    []string
+	// empty if the service has no issue tracker
+	issueURL     string
+	regexStrings []string
 
 	// can expect 'webdomain' to be passed in. Otherwise, you get to pick what we match in the regex
 	repoURLTemplate string
@@ -178,6 +220,10 @@ func (self *Service) getCommitURL(commitSha string) string {
 	return self.resolveUrl(self.commitURL, map[string]string{"CommitSha": commitSha})
 }
 
+func (self *Service) getIssueURL(issueNumber string) string {
+	return self.resolveUrl(self.issueURL, map[string]string{"IssueNumber": issueNumber})
+}
+
 func (self *Service) resolveUrl(templateString string, args map[string]string) string {
 	return self.repoURL + utils.ResolvePlaceholderString(templateString, args)
 }
@@ -423,3 +423,94 @@ func TestGetPullRequestURL(t *testing.T) {
 		})
 	}
 }
+
+func TestGetForkURL(t *testing.T) {
+	scenarios := []struct {
+		testName      string
+		remoteUrl     string
+		expectedUrl   string
+		expectedError string
+	}{
+		{
+			testName:    "github over ssh",
+			remoteUrl:   "git@github.com:jesseduffield/lazygit.git",
+			expectedUrl: "git@github.com:me/lazygit.git",
+		},
+		{
+			testName:    "github over https",
+			remoteUrl:   "https://github.com/jesseduffield/lazygit",
+			expectedUrl: "https://github.com/me/lazygit",
+		},
+		{
+			testName:    "gitlab subgroup",
+			remoteUrl:   "ssh://git@gitlab.com/group/subgroup/project.git",
+			expectedUrl: "ssh://git@gitlab.com/me/project.git",
+		},
+		{
+			testName:      "unsupported service",
+			remoteUrl:     "git@example.com:jesseduffield/lazygit.git",
+			expectedError: "Unsupported git service",
+		},
+	}
+
+	for _, s := range scenarios {
+		s := s
+		t.Run(s.testName, func(t *testing.T) {
+			tr := i18n.EnglishTranslationSet()
+			hostingServiceMgr := NewHostingServiceMgr(&fakes.FakeFieldLogger{}, &tr, s.remoteUrl, nil)
+			url, err := hostingServiceMgr.GetForkURL("me")
+			if s.expectedError != "" {
+				assert.EqualError(t, err, s.expectedError)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, s.expectedUrl, url)
+			}
+		})
+	}
+}
+
+func TestGetIssueURL(t *testing.T) {
+	scenarios := []struct {
+		testName      string
+		remoteUrl     string
+		expectedUrl   string
+		expectedError string
+	}{
+		{
+			testName:    "github",
+			remoteUrl:   "git@github.com:jesseduffield/lazygit.git",
+			expectedUrl: "https://github.com/jesseduffield/lazygit/issues/123",
+		},
+		{
+			testName:    "gitlab",
+			remoteUrl:   "https://gitlab.com/group/project.git",
+			expectedUrl: "https://gitlab.com/group/project/-/issues/123",
+		},
+		{
+			testName:      "bitbucket server has no issues",
+			remoteUrl:     "ssh://git@bitbucket.example.com/proj/repo.git",
+			expectedError: "Issue links are not supported for this git service",
+		},
+		{
+			testName:      "unsupported service",
+			remoteUrl:     "git@example.com:jesseduffield/lazygit.git",
+			expectedError: "Unsupported git service",
+		},
+	}
+
+	for _, s := range scenarios {
+		s := s
+		t.Run(s.testName, func(t *testing.T) {
+			tr := i18n.EnglishTranslationSet()
+			configServices := map[string]string{"bitbucket.example.com": "bitbucketServer:bitbucket.example.com"}
+			hostingServiceMgr := NewHostingServiceMgr(&fakes.FakeFieldLogger{}, &tr, s.remoteUrl, configServices)
+			url, err := hostingServiceMgr.GetIssueURL("123")
+			if s.expectedError != "" {
+				assert.EqualError(t, err, s.expectedError)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, s.expectedUrl, url)
+			}
+		})
+	}
+}
@@ -10,6 +10,8 @@ type Branch struct {
 	DisplayName string
 	// indicator of when the branch was last checked out e.g. '2d', '3m'
 	Recency string
+	// the unix timestamp that Recency was derived from, or 0 if unknown
+	RecencyUnixTimestamp int64
 	// how many commits ahead we are from the remote branch (how many commits we can push)
 	Pushables string
 	// how many commits behind we are from the remote branch (how many commits we can pull)
@@ -0,0 +1,79 @@
+package models
+
+import (
+	"fmt"
+	"strings"
+	"time"
+)
+
+// CommandLogEntry : A command that we've shown in the command log
+type CommandLogEntry struct {
+	// the action the command was run as part of, e.g. 'Stage file'
+	Action  string
+	Command string
+	// false if the command can't be run on the command line as is, e.g. when
+	// it merely describes something we did ourselves, like writing a file
+	IsCommandLine bool
+	// the arguments, environment and working directory the command was run
+	// with, so that it can be re-run exactly as it was. Args is nil for entries
+	// that aren't a single command we ran, e.g. pipelines.
+	Args []string
+	Env  []string
+	Dir  string
+	// position of the entry in the log, to tell apart identical commands
+	Index int
+	// false while the command is still running, and for entries that aren't
+	// commands we ran ourselves
+	Finished bool
+	Duration time.Duration
+	ExitCode int
+}
+
+func (e *CommandLogEntry) ID() string {
+	return fmt.Sprintf("%d", e.Index)
+}
+
+func (e *CommandLogEntry) URN() string {
+	return "command-" + e.ID()
+}
+
+func (e *CommandLogEntry) Description() string {
+	return e.Command
+}
+
+func (e *CommandLogEntry) Failed() bool {
+	return e.Finished && e.ExitCode != 0
+}
+
+// IsSlow reports whether the command took longer than the given threshold. A
+// threshold of zero means no command counts as slow.
+func (e *CommandLogEntry) IsSlow(threshold time.Duration) bool {
+	return e.Finished && threshold > 0 && e.Duration >= threshold
+}
+
+// Subsystem is the part of git a command deals with, e.g. 'fetch' for
+// 'git -c credential.helper= fetch --all'. For commands other than git it's
+// the program that was run, and for entries that aren't commands it's blank.
+func (e *CommandLogEntry) Subsystem() string {
+	fields := strings.Fields(e.Command)
+	if !e.IsCommandLine || len(fields) == 0 {
+		return ""
+	}
+
+	if fields[0] != "git" {
+		return fields[0]
+	}
+
+	for i := 1; i < len(fields); i++ {
+		switch {
+		case fields[i] == "-c" || fields[i] == "-C":
+			// skip the option's argument
+			i++
+		case strings.HasPrefix(fields[i], "-"):
+		default:
+			return fields[i]
+		}
+	}
+
+	return fields[0]
+}
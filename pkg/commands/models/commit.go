@@ -54,6 +54,10 @@ type Commit struct {
 	UnixTimestamp int64
 	Divergence    Divergence // set to DivergenceNone unless we are showing the divergence view
 
+	// True for an unpushed commit whose patch already exists upstream (as
+	// reported by `git cherry`), meaning a rebase onto the upstream will drop it
+	AppliedUpstream bool
+
 	// SHAs of parent commits (will be multiple if it's a merge commit)
 	Parents []string
 }
@@ -1,10 +1,20 @@
 package models
 
 import (
+	"time"
+
 	"github.com/jesseduffield/lazygit/pkg/utils"
 	"github.com/samber/lo"
 )
 
+const (
+	// the mode git reports for the missing side of an added or deleted file
+	NoMode         = "000000"
+	RegularMode    = "100644"
+	ExecutableMode = "100755"
+	SymlinkMode    = "120000"
+)
+
 // File : A file from git status
 // duplicating this for now
 type File struct {
@@ -20,8 +30,37 @@ type File struct {
 	DisplayString           string
 	ShortStatus             string // e.g. 'AD', ' A', 'M ', '??'
 
+	// These are only loaded when the files panel is sorted by them or shows
+	// them. The line counts are relative to HEAD, so they include staged and
+	// unstaged changes. The size is that of the file in the worktree
+	LinesAdded   int
+	LinesDeleted int
+	ModTime      time.Time
+	Size         int64
+
+	// the number of conflicts still marked in a file with inline merge
+	// conflicts
+	ConflictCount int
+
 	// If true, this must be a worktree folder
 	IsWorktree bool
+
+	// If true, this is an untracked folder with a repo of its own that isn't
+	// registered as a submodule
+	IsNestedRepo bool
+
+	// The modes of the file in HEAD and in the worktree, e.g. "100644" and
+	// "100755". Only loaded for files whose mode changed and for symlinks,
+	// along with the target of changed symlinks
+	OldMode        string
+	NewMode        string
+	ModeChangeOnly bool
+	SymlinkTarget  string
+
+	// Set with `git update-index`. Files with either of these usually have no
+	// status, but we still show them so that the bits can be cleared again
+	AssumeUnchanged bool
+	SkipWorktree    bool
 }
 
 // sometimes we need to deal with either a node (which contains a file) or an actual file
@@ -34,6 +73,18 @@ type IFile interface {
 	GetIsFile() bool
 }
 
+// HasModeChange is true if the executable bit was flipped or the file was
+// turned into a symlink or back, but not if it was added or deleted
+func (f *File) HasModeChange() bool {
+	return f.OldMode != f.NewMode && f.OldMode != "" && f.OldMode != NoMode && f.NewMode != "" && f.NewMode != NoMode
+}
+
+// IsSymlinkChange is true if the file was and still is a symlink, so it's the
+// target that changed
+func (f *File) IsSymlinkChange() bool {
+	return f.OldMode == SymlinkMode && f.NewMode == SymlinkMode
+}
+
 func (f *File) IsRename() bool {
 	return f.PreviousName != ""
 }
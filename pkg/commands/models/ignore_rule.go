@@ -0,0 +1,16 @@
+package models
+
+import "fmt"
+
+// IgnoreRule is a line of a .gitignore (or exclude) file that matches a path
+type IgnoreRule struct {
+	// the file the rule is in, as reported by git
+	Source string
+	// 1-based
+	LineNumber int
+	Pattern    string
+}
+
+func (self *IgnoreRule) Location() string {
+	return fmt.Sprintf("%s:%d", self.Source, self.LineNumber)
+}
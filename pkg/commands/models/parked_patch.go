@@ -0,0 +1,16 @@
+package models
+
+import "strings"
+
+// ParkedPatch is a set of changes (whole hunks or individual lines) that the
+// user has temporarily moved out of the working tree, to bring back later
+type ParkedPatch struct {
+	Name  string
+	Files []string
+	// the file the patch is stored in
+	Path string
+}
+
+func (self *ParkedPatch) Description() string {
+	return self.Name + " (" + strings.Join(self.Files, ", ") + ")"
+}
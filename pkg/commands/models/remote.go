@@ -5,6 +5,9 @@ type Remote struct {
 	Name     string
 	Urls     []string
 	Branches []*RemoteBranch
+
+	// whether remote.pushDefault points at this remote
+	IsPushDefault bool
 }
 
 func (r *Remote) RefName() string {
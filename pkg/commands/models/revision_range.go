@@ -0,0 +1,56 @@
+package models
+
+import "strings"
+
+// RevisionRange is whatever the user typed to browse commits of: a single
+// revision like 'v1.2' or 'HEAD~3', or a range like
+// 'origin/main..origin/release-1.4', as understood by `git log`
+type RevisionRange struct {
+	Spec string
+}
+
+func (r *RevisionRange) FullRefName() string {
+	return r.Spec
+}
+
+// RefName is the tip of the range, or the revision itself
+func (r *RevisionRange) RefName() string {
+	if _, tip, ok := r.split(); ok {
+		return tip
+	}
+	return r.Spec
+}
+
+// ParentRefName is the base of the range, so that diffing it against RefName
+// gives the changes of the whole range. For 'a...b' that's 'a' too, rather
+// than their merge base.
+func (r *RevisionRange) ParentRefName() string {
+	if base, _, ok := r.split(); ok {
+		return base
+	}
+	return r.Spec + "^"
+}
+
+func (r *RevisionRange) Description() string {
+	return r.Spec
+}
+
+// split splits 'base..tip' or 'base...tip' into its ends, either of which
+// defaults to HEAD when left out, like git does
+func (r *RevisionRange) split() (string, string, bool) {
+	base, tip, ok := strings.Cut(r.Spec, "...")
+	if !ok {
+		base, tip, ok = strings.Cut(r.Spec, "..")
+	}
+	if !ok {
+		return "", "", false
+	}
+
+	if base == "" {
+		base = "HEAD"
+	}
+	if tip == "" {
+		tip = "HEAD"
+	}
+	return base, tip, true
+}
@@ -4,6 +4,12 @@ type SubmoduleConfig struct {
 	Name string
 	Path string
 	Url  string
+
+	// The branch to track when updating with --remote. Empty means the
+	// remote's default branch.
+	Branch string
+	// One of checkout, rebase, merge or none. Empty means checkout.
+	UpdateStrategy string
 }
 
 func (r *SubmoduleConfig) RefName() string {
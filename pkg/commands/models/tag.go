@@ -6,6 +6,30 @@ type Tag struct {
 	// this is either the first line of the message of an annotated tag, or the
 	// first line of a commit message for a lightweight tag
 	Message string
+
+	SignatureStatus TagSignatureStatus
+}
+
+type TagSignatureStatus int
+
+const (
+	// we haven't looked at the tag yet. Verifying a signature can be slow, so
+	// we only do it for tags that get selected
+	TagSignatureUnknown TagSignatureStatus = iota
+	TagSignatureUnsigned
+	// the tag is signed but we haven't verified the signature yet
+	TagSignatureUnverified
+	TagSignatureGood
+	TagSignatureBad
+)
+
+// TagAnnotation is the content of an annotated tag object
+type TagAnnotation struct {
+	Tagger  string
+	Date    string
+	Message string
+	// the armored signature, if the tag is signed
+	Signature string
 }
 
 func (t *Tag) FullRefName() string {
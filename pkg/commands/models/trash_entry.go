@@ -0,0 +1,20 @@
+package models
+
+import (
+	"strings"
+	"time"
+)
+
+// TrashEntry holds copies of files as they were before their changes got
+// discarded, so that the discard can be undone
+type TrashEntry struct {
+	Files []string
+	// when the changes were discarded
+	Time time.Time
+	// the directory the entry is stored in
+	Path string
+}
+
+func (self *TrashEntry) Description() string {
+	return strings.Join(self.Files, ", ")
+}
@@ -19,6 +19,11 @@ type Worktree struct {
 	// * the worktree is mid-rebase on the branch
 	// * the worktree is mid-bisect on the branch
 	Branch string
+	// if true, the worktree has been locked with 'git worktree lock', which stops
+	// it from being pruned, moved or removed
+	IsLocked bool
+	// the reason given when locking the worktree, if any
+	LockReason string
 	// based on the path, but uniquified. Not the same name that git uses in the worktrees/ folder (no good reason for this,
 	// I just prefer my naming convention better)
 	Name string
@@ -4,6 +4,7 @@ import (
 	"bufio"
 	"bytes"
 	"io"
+	"os/exec"
 	"regexp"
 	"strings"
 	"time"
@@ -104,7 +105,10 @@ func (self *cmdObjRunner) RunWithOutputAux(cmdObj ICmdObj) (string, error) {
 	}
 
 	t := time.Now()
-	output, err := sanitisedCommandOutput(cmdObj.GetCmd().CombinedOutput())
+	rawOutput, err := cmdObj.GetCmd().CombinedOutput()
+	self.logCmdObjResult(cmdObj, time.Since(t), err)
+
+	output, err := sanitisedCommandOutput(rawOutput, err)
 	if err != nil {
 		self.log.WithField("command", cmdObj.ToString()).Error(output)
 	}
@@ -129,6 +133,7 @@ func (self *cmdObjRunner) RunWithOutputsAux(cmdObj ICmdObj) (string, string, err
 	err := cmd.Run()
 
 	self.log.Infof("%s (%s)", cmdObj.ToString(), time.Since(t))
+	self.logCmdObjResult(cmdObj, time.Since(t), err)
 
 	stdout := outBuffer.String()
 	stderr, err := sanitisedCommandOutput(errBuffer.Bytes(), err)
@@ -166,6 +171,7 @@ func (self *cmdObjRunner) RunAndProcessLines(cmdObj ICmdObj, onLine func(line st
 		return err
 	}
 
+	stopped := false
 	for scanner.Scan() {
 		line := scanner.Text()
 		stop, err := onLine(line)
@@ -174,19 +180,46 @@ func (self *cmdObjRunner) RunAndProcessLines(cmdObj ICmdObj, onLine func(line st
 		}
 		if stop {
 			_ = Kill(cmd)
+			stopped = true
 			break
 		}
 	}
 
-	_ = cmd.Wait()
+	waitErr := cmd.Wait()
+	if stopped {
+		// we killed the command ourselves, so it didn't fail
+		waitErr = nil
+	}
 
 	self.log.Infof("%s (%s)", cmdObj.ToString(), time.Since(t))
+	self.logCmdObjResult(cmdObj, time.Since(t), waitErr)
 
 	return nil
 }
 
 func (self *cmdObjRunner) logCmdObj(cmdObj ICmdObj) {
-	self.guiIO.logCommandFn(cmdObj.ToString(), true)
+	self.guiIO.logCmdObjFn(cmdObj)
+}
+
+func (self *cmdObjRunner) logCmdObjResult(cmdObj ICmdObj, duration time.Duration, err error) {
+	if !cmdObj.ShouldLog() {
+		return
+	}
+
+	self.guiIO.logCommandResultFn(cmdObj.ToString(), duration, exitCodeFromError(err))
+}
+
+func exitCodeFromError(err error) int {
+	if err == nil {
+		return 0
+	}
+
+	var exitErr *exec.ExitError
+	if errors.As(err, &exitErr) {
+		return exitErr.ExitCode()
+	}
+
+	return -1
 }
 
 func sanitisedCommandOutput(output []byte, err error) (string, error) {
@@ -255,6 +288,7 @@ func (self *cmdObjRunner) runAndStreamAux(
 	err = cmd.Wait()
 
 	self.log.Infof("%s (%s)", cmdObj.ToString(), time.Since(t))
+	self.logCmdObjResult(cmdObj, time.Since(t), err)
 
 	if err != nil {
 		errStr := stderr.String()
@@ -2,6 +2,7 @@ package oscommands
 
 import (
 	"io"
+	"time"
 
 	"github.com/sirupsen/logrus"
 )
@@ -19,6 +20,13 @@ type guiIO struct {
 	// depending on whether we're directly outputting a command we're about to run that
 	// will be run on the command line, or if we're using something from Go's standard lib.
 	logCommandFn func(str string, isCommandLineCommand bool)
+	// this is like logCommandFn, but for a command object we're about to run, so
+	// that the GUI can hold on to its args and env e.g. to re-run it later
+	logCmdObjFn func(cmdObj ICmdObj)
+	// this is called once a command logged via logCommandFn has finished, so that
+	// the GUI can show how long it took and whether it failed. An exit code of
+	// -1 means the command didn't exit normally e.g. it couldn't be started.
+	logCommandResultFn func(str string, duration time.Duration, exitCode int)
 	// this is for us to directly write the output of a command. We will do this for
 	// certain commands like 'git push'. The GUI will write this to a command output panel.
 	// We need a new cmd writer per command, hence it being a function.
@@ -32,12 +40,16 @@ type guiIO struct {
 func NewGuiIO(
 	log *logrus.Entry,
 	logCommandFn func(string, bool),
+	logCmdObjFn func(ICmdObj),
+	logCommandResultFn func(string, time.Duration, int),
 	newCmdWriterFn func() io.Writer,
 	promptForCredentialFn func(CredentialType) <-chan string,
 ) *guiIO {
 	return &guiIO{
 		log:                   log,
 		logCommandFn:          logCommandFn,
+		logCmdObjFn:           logCmdObjFn,
+		logCommandResultFn:    logCommandResultFn,
 		newCmdWriterFn:        newCmdWriterFn,
 		promptForCredentialFn: promptForCredentialFn,
 	}
@@ -49,6 +61,8 @@ func NewNullGuiIO(log *logrus.Entry) *guiIO {
 	return &guiIO{
 		log:                   log,
 		logCommandFn:          func(string, bool) {},
+		logCmdObjFn:           func(ICmdObj) {},
+		logCommandResultFn:    func(string, time.Duration, int) {},
 		newCmdWriterFn:        func() io.Writer { return io.Discard },
 		promptForCredentialFn: failPromptFn,
 	}
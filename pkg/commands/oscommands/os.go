@@ -93,6 +93,45 @@ func (c *OSCommand) OpenFile(filename string) error {
 	return c.Cmd.NewShell(command).Run()
 }
 
+// OpenInTerminalCmdObj returns the command for opening a new terminal window
+// running lazygit in the given directory, as configured in os.openInTerminal
+func (c *OSCommand) OpenInTerminalCmdObj(dir string) ICmdObj {
+	lazygitPath, err := os.Executable()
+	if err != nil {
+		lazygitPath = "lazygit"
+	}
+	templateValues := map[string]string{
+		"dir":     c.Quote(dir),
+		"lazygit": c.Quote(lazygitPath),
+	}
+
+	command := utils.ResolvePlaceholderString(c.UserConfig.OS.OpenInTerminal, templateValues)
+	return c.Cmd.NewShell(command)
+}
+
+// OpenInTerminal starts the command for opening a new terminal window in the
+// given directory. Some terminals only return once their window is closed, so
+// we don't wait for it.
+func (c *OSCommand) OpenInTerminal(dir string) error {
+	cmdObj := c.OpenInTerminalCmdObj(dir)
+	c.guiIO.logCmdObjFn(cmdObj)
+
+	cmd := cmdObj.GetCmd()
+	if err := cmd.Start(); err != nil {
+		return err
+	}
+
+	go utils.Safe(func() {
+		// we still wait so that the process gets reaped, but how the terminal
+		// exits is up to the user, so it's not worth reporting
+		if err := cmd.Wait(); err != nil {
+			c.Log.Warnf("Terminal command exited with error: %v", err)
+		}
+	})
+
+	return nil
+}
+
 func (c *OSCommand) OpenLink(link string) error {
 	commandTemplate := c.UserConfig.OS.OpenLink
 	if commandTemplate == "" {
@@ -194,3 +194,13 @@ func TestOSCommandAppendLineToFile(t *testing.T) {
 		_ = os.RemoveAll(s.path)
 	}
 }
+
+func TestOSCommandOpenInTerminalCmdObj(t *testing.T) {
+	osCommand := NewDummyOSCommand()
+	osCommand.Platform.OS = "linux"
+	osCommand.UserConfig.OS.OpenInTerminal = "kitty --directory {{dir}} lazygit"
+
+	args := osCommand.OpenInTerminalCmdObj("/path/to/my worktree").Args()
+
+	assert.Equal(t, "kitty --directory \"/path/to/my worktree\" lazygit", args[len(args)-1])
+}
@@ -21,6 +21,8 @@ type patchPresenter struct {
 	lastLineIndex int
 	// line indices for tagged lines (e.g. lines added to a custom patch)
 	incLineIndices *set.Set[int]
+	// if non-zero, the width of the two columns of a split diff, see FormatViewOpts
+	splitWidth int
 }
 
 // formats the patch as a plain string
@@ -51,6 +53,11 @@ type FormatViewOpts struct {
 	LastLineIndex int
 	// line indices for tagged lines (e.g. lines added to a custom patch)
 	IncLineIndices *set.Set[int]
+	// if non-zero, the patch is laid out in two columns of this total width,
+	// with removed lines on the left and added ones on the right. Each line of
+	// the patch keeps its own row, so that line indices still match the rows
+	// of the view and lines can be selected as usual
+	SplitWidth int
 }
 
 // formats the patch for rendering within a view, meaning it's coloured and
@@ -67,6 +74,7 @@ func formatView(patch *Patch, opts FormatViewOpts) string {
 		firstLineIndex: opts.FirstLineIndex,
 		lastLineIndex:  opts.LastLineIndex,
 		incLineIndices: includedLineIndices,
+		splitWidth:     opts.SplitWidth,
 	}
 	return presenter.format()
 }
@@ -118,13 +126,32 @@ func (self *patchPresenter) format() string {
 		)
 
 		for _, line := range hunk.bodyLines {
-			appendFormattedLine(line.Content, self.patchLineStyle(line))
+			if self.splitWidth > 0 {
+				appendLine(self.formatSplitLine(line, lineIdx))
+			} else {
+				appendFormattedLine(line.Content, self.patchLineStyle(line))
+			}
 		}
 	}
 
 	return stringBuilder.String()
 }
 
+func (self *patchPresenter) formatSplitLine(patchLine *PatchLine, index int) string {
+	formattedLine := self.formatLine(patchLine.Content, self.patchLineStyle(patchLine), index)
+
+	switch patchLine.Kind {
+	case ADDITION:
+		return SplitRow("", formattedLine, self.splitWidth)
+	case DELETION:
+		return SplitRow(formattedLine, "", self.splitWidth)
+	case CONTEXT:
+		return SplitRow(formattedLine, formattedLine, self.splitWidth)
+	default:
+		return formattedLine
+	}
+}
+
 func (self *patchPresenter) patchLineStyle(patchLine *PatchLine) style.TextStyle {
 	switch patchLine.Kind {
 	case ADDITION:
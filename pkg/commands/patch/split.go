@@ -0,0 +1,258 @@
+package patch
+
+import (
+	"bufio"
+	"io"
+	"regexp"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/mattn/go-runewidth"
+)
+
+const (
+	splitColumnSeparator = "│"
+	// gocui renders tabs up to the next multiple of this. We expand them
+	// ourselves so that they can't push the right column out of place
+	tabStop = 4
+	// resets colors, so that they don't bleed from one column into the next
+	resetColor = "\x1b[0m"
+)
+
+// SplitRow lays out the old and the new version of a line next to each other,
+// each taking up half of the given width. Either of them may be empty, and
+// both may contain color codes.
+func SplitRow(left string, right string, width int) string {
+	columnWidth := (width - len([]rune(splitColumnSeparator))) / 2
+	return fitToWidth(left, columnWidth, true) + splitColumnSeparator + fitToWidth(right, columnWidth, false)
+}
+
+// truncates str to the given number of cells, not counting color codes, and
+// pads it with spaces up to the width if pad is true
+func fitToWidth(str string, width int, pad bool) string {
+	result := &strings.Builder{}
+	cells := 0
+	hasColor := false
+
+	for i := 0; i < len(str); {
+		if escapeLen := escapeSequenceLength(str[i:]); escapeLen > 0 {
+			result.WriteString(str[i : i+escapeLen])
+			hasColor = true
+			i += escapeLen
+			continue
+		}
+
+		r, size := utf8.DecodeRuneInString(str[i:])
+		i += size
+
+		if r == '\t' {
+			spaces := tabStop - cells%tabStop
+			if cells+spaces > width {
+				spaces = width - cells
+			}
+			result.WriteString(strings.Repeat(" ", spaces))
+			cells += spaces
+		} else if r < ' ' {
+			// other control characters, like the carriage return of a CRLF
+			// line ending, would mess up the layout
+			continue
+		} else {
+			runeWidth := runewidth.RuneWidth(r)
+			if cells+runeWidth > width {
+				break
+			}
+			result.WriteRune(r)
+			cells += runeWidth
+		}
+
+		if cells >= width {
+			break
+		}
+	}
+
+	if hasColor {
+		result.WriteString(resetColor)
+	}
+	if pad && cells < width {
+		result.WriteString(strings.Repeat(" ", width-cells))
+	}
+
+	return result.String()
+}
+
+// returns the length of the ANSI escape sequence at the start of str, or 0 if
+// there is none. Handles both CSI sequences (colors) and OSC sequences
+// (hyperlinks)
+func escapeSequenceLength(str string) int {
+	if len(str) < 2 || str[0] != '\x1b' {
+		return 0
+	}
+
+	switch str[1] {
+	case '[':
+		for i := 2; i < len(str); i++ {
+			if str[i] >= 0x40 && str[i] <= 0x7e {
+				return i + 1
+			}
+		}
+	case ']':
+		for i := 2; i < len(str); i++ {
+			if str[i] == '\x07' {
+				return i + 1
+			}
+			if str[i] == '\x1b' && i+1 < len(str) && str[i+1] == '\\' {
+				return i + 2
+			}
+		}
+	default:
+		return 0
+	}
+
+	// unterminated, so it swallows the rest of the string
+	return len(str)
+}
+
+// strips color codes and hyperlinks from the line
+func stripEscapeSequences(line string) string {
+	result := &strings.Builder{}
+	for i := 0; i < len(line); {
+		if escapeLen := escapeSequenceLength(line[i:]); escapeLen > 0 {
+			i += escapeLen
+			continue
+		}
+		result.WriteByte(line[i])
+		i++
+	}
+	return result.String()
+}
+
+// combined diffs of merge commits start their hunks with '@@@' and have a
+// column per parent, which we can't lay out in two columns, so we only match
+// regular hunk headers
+var splitHunkHeaderRegexp = regexp.MustCompile(`^@@ -\d+(?:,(\d+))? \+\d+(?:,(\d+))? @@`)
+
+// returns the number of old and new lines of the hunk that the line is the
+// header of
+func parseHunkLineCounts(line string) (int, int, bool) {
+	match := splitHunkHeaderRegexp.FindStringSubmatch(stripEscapeSequences(line))
+	if match == nil {
+		return 0, 0, false
+	}
+
+	count := func(str string) int {
+		// the count is left out if it's 1
+		if str == "" {
+			return 1
+		}
+		n, _ := strconv.Atoi(str)
+		return n
+	}
+
+	return count(match[1]), count(match[2]), true
+}
+
+// splitDiffReader reads the (possibly colored) output of a git command and
+// lays out the hunks of any diffs in it side by side: removed lines on the
+// left, added ones on the right, and unchanged ones on both sides. Runs of
+// removed lines are aligned with the added lines that follow them. Everything
+// outside of hunks, like commit messages or file headers, is passed through
+// as is.
+//
+// It pulls lines from the source as they're asked for rather than converting
+// everything upfront, so that it works with the lazy loading of the main view.
+type splitDiffReader struct {
+	source *bufio.Reader
+	width  int
+
+	// how many more lines of the current hunk there are on either side
+	oldLinesLeft int
+	newLinesLeft int
+	deletions    []string
+	additions    []string
+
+	output []byte
+	err    error
+}
+
+func NewSplitDiffReader(source io.Reader, width int) io.Reader {
+	return &splitDiffReader{
+		source: bufio.NewReader(source),
+		width:  width,
+	}
+}
+
+func (self *splitDiffReader) Read(p []byte) (int, error) {
+	for len(self.output) == 0 && self.err == nil {
+		line, err := self.source.ReadString('\n')
+		if line != "" {
+			self.processLine(strings.TrimSuffix(line, "\n"))
+		}
+		if err != nil {
+			self.flushChanges()
+			self.err = err
+		}
+	}
+
+	if len(self.output) > 0 {
+		n := copy(p, self.output)
+		self.output = self.output[n:]
+		return n, nil
+	}
+
+	return 0, self.err
+}
+
+func (self *splitDiffReader) processLine(line string) {
+	if self.oldLinesLeft > 0 || self.newLinesLeft > 0 {
+		plainLine := stripEscapeSequences(line)
+		switch {
+		case strings.HasPrefix(plainLine, "-") && self.oldLinesLeft > 0:
+			if len(self.additions) > 0 {
+				self.flushChanges()
+			}
+			self.deletions = append(self.deletions, line)
+			self.oldLinesLeft--
+			return
+		case strings.HasPrefix(plainLine, "+") && self.newLinesLeft > 0:
+			self.additions = append(self.additions, line)
+			self.newLinesLeft--
+			return
+		case strings.HasPrefix(plainLine, " ") || plainLine == "":
+			self.flushChanges()
+			self.writeLine(SplitRow(line, line, self.width))
+			self.oldLinesLeft--
+			self.newLinesLeft--
+			return
+		case strings.HasPrefix(plainLine, "\\"):
+			// "\ No newline at end of file", which doesn't count as a line
+			self.flushChanges()
+			self.writeLine(line)
+			return
+		}
+	}
+
+	self.flushChanges()
+	self.oldLinesLeft, self.newLinesLeft, _ = parseHunkLineCounts(line)
+	self.writeLine(line)
+}
+
+func (self *splitDiffReader) flushChanges() {
+	for i := 0; i < len(self.deletions) || i < len(self.additions); i++ {
+		left, right := "", ""
+		if i < len(self.deletions) {
+			left = self.deletions[i]
+		}
+		if i < len(self.additions) {
+			right = self.additions[i]
+		}
+		self.writeLine(SplitRow(left, right, self.width))
+	}
+
+	self.deletions = nil
+	self.additions = nil
+}
+
+func (self *splitDiffReader) writeLine(line string) {
+	self.output = append(self.output, line+"\n"...)
+}
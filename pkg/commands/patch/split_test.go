@@ -0,0 +1,197 @@
+package patch
+
+import (
+	"io"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSplitRow(t *testing.T) {
+	scenarios := []struct {
+		name     string
+		left     string
+		right    string
+		width    int
+		expected string
+	}{
+		{
+			name:     "pads the left column",
+			left:     "-a",
+			right:    "+b",
+			width:    13,
+			expected: "-a    │+b",
+		},
+		{
+			name:     "truncates both columns",
+			left:     "-abcdefghij",
+			right:    "+abcdefghij",
+			width:    13,
+			expected: "-abcde│+abcde",
+		},
+		{
+			name:     "empty side",
+			left:     "",
+			right:    "+b",
+			width:    13,
+			expected: "      │+b",
+		},
+		{
+			name:     "keeps colors out of the width and resets them",
+			left:     "\x1b[31m-a\x1b[m",
+			right:    "\x1b[32m+abcdefgh\x1b[m",
+			width:    13,
+			expected: "\x1b[31m-a\x1b[m\x1b[0m    │\x1b[32m+abcde\x1b[0m",
+		},
+		{
+			name:     "expands tabs",
+			left:     "-\ta",
+			right:    "+\t\tb",
+			width:    13,
+			expected: "-   a │+     ",
+		},
+		{
+			name:     "drops carriage returns",
+			left:     "-a\r",
+			right:    "+b\r",
+			width:    13,
+			expected: "-a    │+b",
+		},
+		{
+			name:     "wide characters",
+			left:     "-日本語",
+			right:    "+日本語",
+			width:    13,
+			expected: "-日本 │+日本",
+		},
+	}
+
+	for _, s := range scenarios {
+		s := s
+		t.Run(s.name, func(t *testing.T) {
+			assert.Equal(t, s.expected, SplitRow(s.left, s.right, s.width))
+		})
+	}
+}
+
+func TestSplitDiffReader(t *testing.T) {
+	scenarios := []struct {
+		name     string
+		input    string
+		width    int
+		expected string
+	}{
+		{
+			name:  "simple diff",
+			input: simpleDiff,
+			width: 21,
+			expected: `diff --git a/filename b/filename
+index dcd3485..1ba5540 100644
+--- a/filename
++++ b/filename
+@@ -1,5 +1,5 @@
+ apple    │ apple
+-orange   │+grape
+ ...      │ ...
+ ...      │ ...
+ ...      │ ...
+`,
+		},
+		{
+			name: "unbalanced changes and several hunks",
+			input: `commit 1234
+Author: me
+
+    subject
+
+diff --git a/filename b/filename
+--- a/filename
++++ b/filename
+@@ -1,4 +1,2 @@ apple
+-a
+-b
+-c
++d
+ e
+@@ -8 +6,3 @@
+ f
++g
++h
+--
+2.40.0
+`,
+			width: 21,
+			expected: `commit 1234
+Author: me
+
+    subject
+
+diff --git a/filename b/filename
+--- a/filename
++++ b/filename
+@@ -1,4 +1,2 @@ apple
+-a        │+d
+-b        │
+-c        │
+ e        │ e
+@@ -8 +6,3 @@
+ f        │ f
+          │+g
+          │+h
+--
+2.40.0
+`,
+		},
+		{
+			name:  "missing newline at end of file",
+			input: addNewlineToEndOfFile,
+			width: 21,
+			expected: `diff --git a/filename b/filename
+index 80a73f1..e48a11c 100644
+--- a/filename
++++ b/filename
+@@ -60,4 +60,4 @@ grape
+ ...      │ ...
+ ...      │ ...
+ ...      │ ...
+-last line│
+\ No newline at end of file
+          │+last line
+`,
+		},
+		{
+			name: "combined diffs are left as they are",
+			input: `@@@ -1,2 -1,2 +1,2 @@@
+- a
+ +b
+`,
+			width: 21,
+			expected: `@@@ -1,2 -1,2 +1,2 @@@
+- a
+ +b
+`,
+		},
+	}
+
+	for _, s := range scenarios {
+		s := s
+		t.Run(s.name, func(t *testing.T) {
+			// read one byte at a time to make sure that nothing depends on how
+			// the output is chunked
+			reader := NewSplitDiffReader(strings.NewReader(s.input), s.width)
+			result := &strings.Builder{}
+			buf := make([]byte, 1)
+			for {
+				n, err := reader.Read(buf)
+				result.Write(buf[:n])
+				if err == io.EOF {
+					break
+				}
+				assert.NoError(t, err)
+			}
+
+			assert.Equal(t, s.expected, result.String())
+		})
+	}
+}
@@ -11,4 +11,8 @@ const (
 	// REBASE_MODE_REBASING is a general state that captures both REBASE_MODE_NORMAL and REBASE_MODE_INTERACTIVE
 	REBASE_MODE_REBASING
 	REBASE_MODE_MERGING
+	// this means a cherry-pick started outside of lazygit has stopped midway
+	REBASE_MODE_CHERRY_PICKING
+	// this means git has stopped midway through reverting one or more commits
+	REBASE_MODE_REVERTING
 )
@@ -322,6 +322,62 @@ type AppState struct {
 	CustomCommandsHistory      []string
 	HideCommandLog             bool
 	IgnoreWhitespaceInDiffView bool
+	SplitDiffView              bool
+	DiffContextSize            int
+	LocalBranchSortOrder       string
+	RemoteBranchSortOrder      string
+	// the sort order of the files panel, keyed by repo path
+	FileSortOrders map[string]string
+	// the layout of the files panel, keyed by worktree path
+	FileTreeStates map[string]*FileTreeState
+	// whether interactive rebases started by the user pass --autosquash
+	AutosquashInteractiveRebase bool
+	// the UI state each repo was left in, keyed by worktree path. Only used
+	// when gui.persistSessionState is enabled
+	SessionStates map[string]*SessionState
+	// the keybinding conflicts we've already told the user about, so that we
+	// only report them again if new ones come up
+	ReportedKeybindingConflicts []string
+}
+
+// SessionState is the UI state of a repo that we restore when the repo is
+// opened again in a new session
+type SessionState struct {
+	// the key of the side context that was focused
+	CurrentContext string
+	// ID of the selected item per context key, falling back to the selected
+	// line index if the item no longer exists
+	SelectedItemIds  map[string]string
+	SelectedLineIdxs map[string]int
+	// vertical scroll position per context key
+	OriginYs map[string]int
+	// active filter per context key
+	Filters map[string]string
+	// the ref we're diffing against, if diff mode was on
+	DiffRef     string
+	DiffReverse bool
+}
+
+// FileTreeState is the layout of a repo's files panel, which we restore when
+// the repo is opened again
+type FileTreeState struct {
+	ShowTree          bool
+	GroupByChangeType bool
+	// directories collapsed in the tree view
+	CollapsedPaths []string
+}
+
+// UIStateExport is what we write to a file when the user exports the UI state
+// of a repo, so that they can import it on another machine
+type UIStateExport struct {
+	Session  *SessionState
+	FileTree *FileTreeState
+
+	// layout tweaks that aren't specific to the repo but which the user most
+	// likely wants to bring along
+	HideCommandLog             bool
+	IgnoreWhitespaceInDiffView bool
+	SplitDiffView              bool
 	DiffContextSize            int
 	LocalBranchSortOrder       string
 	RemoteBranchSortOrder      string
@@ -38,6 +38,87 @@ type UserConfig struct {
 	NotARepository string `yaml:"notARepository" jsonschema:"enum=prompt,enum=create,enum=skip,enum=quit"`
 	// If true, display a confirmation when subprocess terminates. This allows you to view the output of the subprocess before returning to Lazygit.
 	PromptToReturnFromSubprocess bool `yaml:"promptToReturnFromSubprocess"`
+	// How risky actions are confirmed. See https://github.com/jesseduffield/lazygit/blob/master/docs/Config.md#confirmation-levels
+	Confirmations ConfirmationsConfig `yaml:"confirmations"`
+}
+
+type ConfirmationsConfig struct {
+	// How the actions of each risk tier are confirmed
+	Tiers ConfirmationTiersConfig `yaml:"tiers"`
+	// Moves actions to another risk tier, e.g. 'deleteUnmergedBranch: high'
+	Actions map[string]string `yaml:"actions"`
+	// Glob patterns of branches that force pushing is confirmed for as if
+	// they were shared with others, e.g. 'release/*'
+	ProtectedBranches []string `yaml:"protectedBranches"`
+}
+
+type ConfirmationTiersConfig struct {
+	// One of 'none' | 'prompt' | 'typeToConfirm'
+	Low string `yaml:"low" jsonschema:"enum=none,enum=prompt,enum=typeToConfirm"`
+	// One of 'none' | 'prompt' | 'typeToConfirm'
+	Medium string `yaml:"medium" jsonschema:"enum=none,enum=prompt,enum=typeToConfirm"`
+	// One of 'none' | 'prompt' | 'typeToConfirm'
+	High string `yaml:"high" jsonschema:"enum=none,enum=prompt,enum=typeToConfirm"`
+}
+
+const (
+	ConfirmationLevelNone          = "none"
+	ConfirmationLevelPrompt        = "prompt"
+	ConfirmationLevelTypeToConfirm = "typeToConfirm"
+)
+
+// The actions whose confirmation can be configured
+const (
+	ConfirmDeleteBranch         = "deleteBranch"
+	ConfirmDeleteUnmergedBranch = "deleteUnmergedBranch"
+	ConfirmDeleteRemoteBranch   = "deleteRemoteBranch"
+	ConfirmDeleteTag            = "deleteTag"
+	ConfirmDeleteRemoteTag      = "deleteRemoteTag"
+	ConfirmDropCommit           = "dropCommit"
+	ConfirmDropStash            = "dropStash"
+	ConfirmForcePush            = "forcePush"
+	// force pushing a branch that others have pushed to as well, that exists
+	// on several remotes, or that matches one of the protected branch patterns
+	ConfirmForcePushSharedBranch = "forcePushSharedBranch"
+)
+
+// the tier of each action unless the user moves it to another one
+var defaultConfirmationTiers = map[string]string{
+	ConfirmDeleteBranch:          "low",
+	ConfirmDeleteUnmergedBranch:  "medium",
+	ConfirmDeleteRemoteBranch:    "medium",
+	ConfirmDeleteTag:             "low",
+	ConfirmDeleteRemoteTag:       "medium",
+	ConfirmDropCommit:            "medium",
+	ConfirmDropStash:             "medium",
+	ConfirmForcePush:             "medium",
+	ConfirmForcePushSharedBranch: "high",
+}
+
+// LevelFor returns how the given action is to be confirmed. We'd rather ask
+// once too often, so anything we don't recognise gets a prompt.
+func (self *ConfirmationsConfig) LevelFor(action string) string {
+	tier, ok := self.Actions[action]
+	if !ok {
+		tier = defaultConfirmationTiers[action]
+	}
+
+	var level string
+	switch tier {
+	case "low":
+		level = self.Tiers.Low
+	case "medium":
+		level = self.Tiers.Medium
+	case "high":
+		level = self.Tiers.High
+	}
+
+	switch level {
+	case ConfirmationLevelNone, ConfirmationLevelTypeToConfirm:
+		return level
+	default:
+		return ConfirmationLevelPrompt
+	}
 }
 
 type RefresherConfig struct {
@@ -47,11 +128,17 @@ type RefresherConfig struct {
 	// Re-fetch interval in seconds.
 	// Auto-fetch can be disabled via option 'git.autoFetch'.
 	FetchInterval int `yaml:"fetchInterval" jsonschema:"minimum=0"`
+	// If true, watch the worktree and .git directory for changes and refresh the affected panels straight away, rather than waiting for the next periodic refresh.
+	// Has no effect if 'git.autoRefresh' is false.
+	WatchFiles bool `yaml:"watchFiles"`
 }
 
 type GuiConfig struct {
 	// See https://github.com/jesseduffield/lazygit/blob/master/docs/Config.md#custom-author-color
 	AuthorColors map[string]string `yaml:"authorColors"`
+	// If true, show author initials in the commits view as a block in the author's color rather than as colored text.
+	// This makes it easier to scan history with many authors.
+	AuthorInitialsBlock bool `yaml:"authorInitialsBlock"`
 	// See https://github.com/jesseduffield/lazygit/blob/master/docs/Config.md#custom-branch-color
 	BranchColors map[string]string `yaml:"branchColors"`
 	// The number of lines you scroll by when scrolling the main window
@@ -89,23 +176,36 @@ type GuiConfig struct {
 	// - 'left': split the window horizontally (side panel on the left, main view on the right)
 	// - 'top': split the window vertically (side panel on top, main view below)
 	EnlargedSideViewLocation string `yaml:"enlargedSideViewLocation"`
-	// One of 'auto' (default) | 'en' | 'zh-CN' | 'zh-TW' | 'pl' | 'nl' | 'ja' | 'ko' | 'ru'
-	Language string `yaml:"language" jsonschema:"enum=auto,enum=en,enum=zh-TW,enum=zh-CN,enum=pl,enum=nl,enum=ja,enum=ko,enum=ru"`
+	// One of 'auto' (default) | 'en' | 'zh-CN' | 'zh-TW' | 'pl' | 'nl' | 'ja' | 'ko' | 'ru',
+	// or the code of a language for which you've put a translations file into
+	// the 'translations' directory of your config dir
+	Language string `yaml:"language" jsonschema:"example=auto,example=en,example=zh-TW,example=zh-CN,example=pl,example=nl,example=ja,example=ko,example=ru"`
 	// Format used when displaying time e.g. commit time.
 	// Uses Go's time format syntax: https://pkg.go.dev/time#Time.Format
 	TimeFormat string `yaml:"timeFormat"`
 	// Format used when displaying time if the time is less than 24 hours ago.
 	// Uses Go's time format syntax: https://pkg.go.dev/time#Time.Format
 	ShortTimeFormat string `yaml:"shortTimeFormat"`
+	// Formats used when displaying time in individual views, in place of timeFormat and shortTimeFormat.
+	// Formats that are left empty fall back to timeFormat and shortTimeFormat.
+	ViewTimeFormats ViewTimeFormatsConfig `yaml:"viewTimeFormats"`
+	// Whether dates are displayed as absolute dates (using timeFormat and shortTimeFormat) or relative to now (e.g. '2d') in each view.
+	// This can be toggled from within Lazygit with '<c-a>', but that will not change the default.
+	DateDisplay DateDisplayConfig `yaml:"dateDisplay"`
 	// Config relating to colors and styles.
 	// See https://github.com/jesseduffield/lazygit/blob/master/docs/Config.md#color-attributes
 	Theme ThemeConfig `yaml:"theme"`
+	// The glyphs and colors showing how branches and commits relate to their upstream.
+	// See https://github.com/jesseduffield/lazygit/blob/master/docs/Config.md#status-glyphs
+	StatusGlyphs StatusGlyphsConfig `yaml:"statusGlyphs"`
 	// Config relating to the commit length indicator
 	CommitLength CommitLengthConfig `yaml:"commitLength"`
-	// If true, show the '5 of 20' footer at the bottom of list views
+	// If true, show the '5 of 20' footer at the bottom of list views, and the scroll position (e.g. '42%') at the bottom of the main view
 	ShowListFooter bool `yaml:"showListFooter"`
 	// If true, display the files in the file views as a tree. If false, display the files as a flat list.
-	// This can be toggled from within Lazygit with the '~' key, but that will not change the default.
+	// This can be toggled from within Lazygit with the '~' key, but that will not change the default. The
+	// files panel remembers its view mode and collapsed directories per repo, so this only applies to repos
+	// opened for the first time.
 	ShowFileTree bool `yaml:"showFileTree"`
 	// If true, show a random tip in the command log when Lazygit starts
 	ShowRandomTip bool `yaml:"showRandomTip"`
@@ -123,8 +223,17 @@ type GuiConfig struct {
 	NerdFontsVersion string `yaml:"nerdFontsVersion" jsonschema:"enum=2,enum=3,enum="`
 	// If true, show commit hashes alongside branch names in the branches view.
 	ShowBranchCommitHash bool `yaml:"showBranchCommitHash"`
+	// If true, highlight the author of commits authored by the configured user.email in the commits and sub-commits views.
+	// The color can be changed with 'theme.ownCommitAuthorColor'.
+	HighlightOwnCommits bool `yaml:"highlightOwnCommits"`
+	// Number of characters of the commit hash to show in the commits, reflog and branches views.
+	CommitHashLength int `yaml:"commitHashLength" jsonschema:"minimum=1"`
+	// If true, keep a slim list of the files on the left when the staging or custom patch view is maximized, so that you can click a file to jump to it.
+	ShowFileOutline bool `yaml:"showFileOutline"`
 	// Height of the command log view
 	CommandLogSize int `yaml:"commandLogSize" jsonschema:"minimum=0"`
+	// Commands taking at least this many milliseconds are highlighted in the command log. 0 disables the highlighting.
+	SlowCommandThreshold int `yaml:"slowCommandThreshold" jsonschema:"minimum=0"`
 	// Whether to split the main window when viewing file changes.
 	// One of: 'auto' | 'always'
 	// If 'auto', only split the main window when a file has both staged and unstaged changes
@@ -141,6 +250,29 @@ type GuiConfig struct {
 	// Whether to stack UI components on top of each other.
 	// One of 'auto' (default) | 'always' | 'never'
 	PortraitMode string `yaml:"portraitMode"`
+	// Whether to show a compressed overview of the commits list beside the
+	// commits panel, with one cell per bucket of commits, to help with
+	// orientation when scrolling through long histories.
+	// One of 'none' (default) | 'branch' | 'author'
+	// 'branch' colours each cell by the branch its commits belong to, 'author' by the commits' author.
+	CommitsMinimap string `yaml:"commitsMinimap" jsonschema:"enum=none,enum=branch,enum=author"`
+	// If true, show separator lines in the commits panel marking which commits
+	// are yet to be pushed, which are already pushed, and how many commits are
+	// waiting upstream to be pulled.
+	ShowDivergenceMarkers bool `yaml:"showDivergenceMarkers"`
+	// If true, show a panel below the main view during an interactive rebase,
+	// listing the todos that are done, the current one, and the remaining ones.
+	ShowRebaseProgress bool `yaml:"showRebaseProgress"`
+	// If true, remember the selected panel and items, scroll positions, filters
+	// and diff mode of each repo when quitting, and restore them the next time
+	// the repo is opened.
+	PersistSessionState bool `yaml:"persistSessionState"`
+	// Presenter mode shows the keys that are pressed along with the actions they
+	// trigger, for screencasts and pairing sessions. It can be toggled at
+	// runtime with the togglePresenterMode keybinding.
+	PresenterMode PresenterModeConfig `yaml:"presenterMode"`
+	// Settings of the files panel
+	Files FilesPanelConfig `yaml:"files"`
 }
 
 func (GuiConfig) JSONSchemaExtend(schema *jsonschema.Schema) {
@@ -152,6 +284,89 @@ func (GuiConfig) JSONSchemaExtend(schema *jsonschema.Schema) {
 	panelWindowSize.AdditionalProperties = jsonschema.FalseSchema
 }
 
+type DateDisplayConfig struct {
+	// One of 'absolute' (default) | 'relative'
+	Commits string `yaml:"commits" jsonschema:"enum=absolute,enum=relative"`
+	// One of 'absolute' (default) | 'relative'
+	Reflog string `yaml:"reflog" jsonschema:"enum=absolute,enum=relative"`
+	// One of 'relative' (default) | 'absolute'
+	Branches string `yaml:"branches" jsonschema:"enum=absolute,enum=relative"`
+	// One of 'relative' (default) | 'absolute'
+	Stash string `yaml:"stash" jsonschema:"enum=absolute,enum=relative"`
+}
+
+type ViewTimeFormatsConfig struct {
+	// Time formats used in the commits view, and in other views listing commits
+	Commits TimeFormatConfig `yaml:"commits"`
+	// Time formats used in the reflog view
+	Reflog TimeFormatConfig `yaml:"reflog"`
+	// Time formats used in the branches view
+	Branches TimeFormatConfig `yaml:"branches"`
+	// Time formats used in the stash view
+	Stash TimeFormatConfig `yaml:"stash"`
+}
+
+type TimeFormatConfig struct {
+	// Format used when displaying time e.g. commit time. Falls back to gui.timeFormat if empty.
+	// Uses Go's time format syntax: https://pkg.go.dev/time#Time.Format
+	TimeFormat string `yaml:"timeFormat"`
+	// Format used when displaying time if the time is less than 24 hours ago. Falls back to gui.shortTimeFormat if empty.
+	// Uses Go's time format syntax: https://pkg.go.dev/time#Time.Format
+	ShortTimeFormat string `yaml:"shortTimeFormat"`
+}
+
+// TimeFormatsFor returns the time format and short time format to use in a
+// view, given the view's own formats
+func (self *GuiConfig) TimeFormatsFor(viewTimeFormats TimeFormatConfig) (string, string) {
+	timeFormat := viewTimeFormats.TimeFormat
+	if timeFormat == "" {
+		timeFormat = self.TimeFormat
+	}
+
+	shortTimeFormat := viewTimeFormats.ShortTimeFormat
+	if shortTimeFormat == "" {
+		shortTimeFormat = self.ShortTimeFormat
+	}
+
+	return timeFormat, shortTimeFormat
+}
+
+type PresenterModeConfig struct {
+	// If true, start in presenter mode
+	Enabled bool `yaml:"enabled"`
+	// Milliseconds to wait before running an action in presenter mode, so that viewers can see which key was pressed first
+	ActionDelay int `yaml:"actionDelay" jsonschema:"minimum=0"`
+	// Milliseconds that each pressed key stays on screen
+	KeyDisplayDuration int `yaml:"keyDisplayDuration" jsonschema:"minimum=0"`
+}
+
+type FilesPanelConfig struct {
+	// If true, show the number of added and deleted lines (compared to HEAD)
+	// next to each file, and their sums next to each directory
+	ShowNumstat bool `yaml:"showNumstat"`
+	// If true, show the size of each file next to it. Files over
+	// largeFileThreshold show their size regardless
+	ShowFileSizes bool `yaml:"showFileSizes"`
+	// Files at least this big (e.g. '5MB') are highlighted in yellow, and
+	// staging them suggests tracking them with git-lfs instead. Empty to disable
+	LargeFileThreshold string `yaml:"largeFileThreshold"`
+	// Files at least this big are highlighted in red. Empty to disable
+	HugeFileThreshold string `yaml:"hugeFileThreshold"`
+	// If true, split the files into sections for conflicts, staged, unstaged
+	// and untracked changes, rather than showing them all together. Can be
+	// toggled from the files panel
+	GroupByChangeType bool `yaml:"groupByChangeType"`
+	// If true, keep a copy of files in lazygit's trash before discarding
+	// their changes or deleting them, so that the discard can be undone from
+	// the files panel. Only the worktree version of each file is kept, so
+	// discarded staged changes that differ from it can't be restored
+	TrashDiscardedChanges bool `yaml:"trashDiscardedChanges"`
+	// If true, show which files have the assume-unchanged or skip-worktree bit
+	// set, including otherwise unchanged ones. This runs `git ls-files` on
+	// every refresh, which can be slow in large repos
+	ShowIndexFlags bool `yaml:"showIndexFlags"`
+}
+
 type ThemeConfig struct {
 	// Border color of focused window
 	ActiveBorderColor []string `yaml:"activeBorderColor" jsonschema:"minItems=1,uniqueItems=true"`
@@ -179,6 +394,36 @@ type ThemeConfig struct {
 	UnstagedChangesColor []string `yaml:"unstagedChangesColor" jsonschema:"minItems=1,uniqueItems=true"`
 	// Default text color
 	DefaultFgColor []string `yaml:"defaultFgColor" jsonschema:"minItems=1,uniqueItems=true"`
+	// Color of the author of your own commits, if 'gui.highlightOwnCommits' is true
+	OwnCommitAuthorColor []string `yaml:"ownCommitAuthorColor" jsonschema:"minItems=1,uniqueItems=true"`
+}
+
+type StatusGlyphsConfig struct {
+	// Shown before the number of commits a branch is ahead of its upstream, and next to the commits that only exist on the local side when viewing the divergence from the upstream
+	Ahead string `yaml:"ahead"`
+	// Shown before the number of commits a branch is behind its upstream, and next to the commits that only exist on the upstream when viewing the divergence from it
+	Behind string `yaml:"behind"`
+	// Shown for branches that are in sync with their upstream
+	InSync string `yaml:"inSync"`
+	// Shown for branches whose upstream hasn't been fetched yet
+	UpstreamNotFetched string `yaml:"upstreamNotFetched"`
+	// Shown next to the hash of commits that are merged into one of the main branches.
+	// Empty by default, as the color of the hash already tells
+	Merged string `yaml:"merged"`
+	// Color of the number of commits a branch is ahead of or behind its upstream
+	AheadBehindColor []string `yaml:"aheadBehindColor" jsonschema:"minItems=1,uniqueItems=true"`
+	// Color of the status of branches that are in sync with their upstream
+	InSyncColor []string `yaml:"inSyncColor" jsonschema:"minItems=1,uniqueItems=true"`
+	// Color of the status of branches whose upstream is gone
+	UpstreamGoneColor []string `yaml:"upstreamGoneColor" jsonschema:"minItems=1,uniqueItems=true"`
+	// Color of the status of branches whose upstream hasn't been fetched yet
+	UpstreamNotFetchedColor []string `yaml:"upstreamNotFetchedColor" jsonschema:"minItems=1,uniqueItems=true"`
+	// Color of the hash of commits that haven't been pushed yet
+	UnpushedCommitColor []string `yaml:"unpushedCommitColor" jsonschema:"minItems=1,uniqueItems=true"`
+	// Color of the hash of commits that have been pushed, but aren't merged into one of the main branches yet
+	PushedCommitColor []string `yaml:"pushedCommitColor" jsonschema:"minItems=1,uniqueItems=true"`
+	// Color of the hash of commits that are merged into one of the main branches
+	MergedCommitColor []string `yaml:"mergedCommitColor" jsonschema:"minItems=1,uniqueItems=true"`
 }
 
 type CommitLengthConfig struct {
@@ -193,6 +438,8 @@ type GitConfig struct {
 	Commit CommitConfig `yaml:"commit"`
 	// Config relating to merging
 	Merging MergingConfig `yaml:"merging"`
+	// If true, keep the merge commits of a branch (and the structure around them) when rebasing it onto another ref, by passing --rebase-merges. If false, the rebased branch is flattened into a linear history.
+	RebaseMerges bool `yaml:"rebaseMerges"`
 	// list of branches that are considered 'main' branches, used when displaying commits
 	MainBranches []string `yaml:"mainBranches" jsonschema:"uniqueItems=true"`
 	// Prefix to use when skipping hooks. E.g. if set to 'WIP', then pre-commit hooks will be skipped when the commit message starts with 'WIP'
@@ -213,9 +460,16 @@ type GitConfig struct {
 	DisableForcePushing bool `yaml:"disableForcePushing"`
 	// See https://github.com/jesseduffield/lazygit/blob/master/docs/Config.md#predefined-commit-message-prefix
 	CommitPrefixes map[string]CommitPrefixConfig `yaml:"commitPrefixes"`
+	// Patterns of issue references in commit messages and branch names, such as 'JIRA-456', along with the URL that each one links to.
+	// References like '#123' link to the issue tracker of the 'origin' remote's git service without any config.
+	// See https://github.com/jesseduffield/lazygit/blob/master/docs/Config.md#issue-links
+	IssueLinks []IssueLinkConfig `yaml:"issueLinks"`
 	// If true, parse emoji strings in commit messages e.g. render :rocket: as 🚀
 	// (This should really be under 'gui', not 'git')
 	ParseEmoji bool `yaml:"parseEmoji"`
+	// Commands to suggest when running a command in each submodule, e.g.
+	// 'git pull' or 'git status --short'
+	SubmoduleForeachCommands []string `yaml:"submoduleForeachCommands"`
 	// Config for showing the log in the commits view
 	Log LogConfig `yaml:"log"`
 }
@@ -247,6 +501,17 @@ type PagingConfig struct {
 type CommitConfig struct {
 	// If true, pass '--signoff' flag when committing
 	SignOff bool `yaml:"signOff"`
+	// The trailers that can be added from the commit message panel
+	Trailers []CommitTrailerConfig `yaml:"trailers"`
+}
+
+type CommitTrailerConfig struct {
+	// The trailer's key, e.g. 'Reviewed-by'
+	Key string `yaml:"key"`
+	// Values to suggest when entering the trailer's value
+	Suggestions []string `yaml:"suggestions"`
+	// If true, the authors of the repo's commits are suggested too
+	SuggestAuthors bool `yaml:"suggestAuthors"`
 }
 
 type MergingConfig struct {
@@ -255,6 +520,13 @@ type MergingConfig struct {
 	ManualCommit bool `yaml:"manualCommit"`
 	// Extra args passed to `git merge`, e.g. --no-ff
 	Args string `yaml:"args" jsonschema:"example=--no-ff"`
+	// The style in which git writes conflict markers when lazygit runs a merge, rebase, revert, pull or stash apply.
+	// 'diff3' and 'zdiff3' also show the base version of each conflict, which can then be picked as a resolution.
+	// 'zdiff3' requires git 2.35 or later. Leave empty to use git's own merge.conflictStyle setting.
+	ConflictStyle string `yaml:"conflictStyle" jsonschema:"enum=,enum=merge,enum=diff3,enum=zdiff3"`
+	// What to do once every conflict of a merge/rebase/cherry-pick/revert has been resolved and staged.
+	// 'prompt' asks whether to continue, 'continue' continues straight away, and 'none' does nothing.
+	OnConflictsResolved string `yaml:"onConflictsResolved" jsonschema:"enum=prompt,enum=continue,enum=none"`
 }
 
 type LogConfig struct {
@@ -276,6 +548,13 @@ type CommitPrefixConfig struct {
 	Replace string `yaml:"replace" jsonschema:"example=[$1] ,minLength=1"`
 }
 
+type IssueLinkConfig struct {
+	// Regex matching an issue reference. E.g. to match 'JIRA-456' use "\\bJIRA-\\d+\\b"
+	Pattern string `yaml:"pattern" jsonschema:"example=\\bJIRA-\\d+\\b,minLength=1"`
+	// URL of the issue, in which $0 is replaced by the whole reference, and $1, $2 etc. by the regex's capture groups
+	Url string `yaml:"url" jsonschema:"example=https://jira.example.com/browse/$0,minLength=1"`
+}
+
 type UpdateConfig struct {
 	// One of: 'prompt' (default) | 'background' | 'never'
 	Method string `yaml:"method" jsonschema:"enum=prompt,enum=background,enum=never"`
@@ -314,6 +593,7 @@ type KeybindingUniversalConfig struct {
 	ScrollRight                  string   `yaml:"scrollRight"`
 	GotoTop                      string   `yaml:"gotoTop"`
 	GotoBottom                   string   `yaml:"gotoBottom"`
+	ToggleRangeSelect            string   `yaml:"toggleRangeSelect"`
 	PrevBlock                    string   `yaml:"prevBlock"`
 	NextBlock                    string   `yaml:"nextBlock"`
 	PrevBlockAlt                 string   `yaml:"prevBlock-alt"`
@@ -360,15 +640,29 @@ type KeybindingUniversalConfig struct {
 	SubmitEditorText             string   `yaml:"submitEditorText"`
 	ExtrasMenu                   string   `yaml:"extrasMenu"`
 	ToggleWhitespaceInDiffView   string   `yaml:"toggleWhitespaceInDiffView"`
+	ToggleSplitDiffView          string   `yaml:"toggleSplitDiffView"`
 	IncreaseContextInDiffView    string   `yaml:"increaseContextInDiffView"`
 	DecreaseContextInDiffView    string   `yaml:"decreaseContextInDiffView"`
 	OpenDiffTool                 string   `yaml:"openDiffTool"`
+	ToggleDateDisplay            string   `yaml:"toggleDateDisplay"`
+	OpenFuzzyFinder              string   `yaml:"openFuzzyFinder"`
+	TogglePresenterMode          string   `yaml:"togglePresenterMode"`
+	NextConflictedFile           string   `yaml:"nextConflictedFile"`
+	PrevConflictedFile           string   `yaml:"prevConflictedFile"`
+	ToggleDryRun                 string   `yaml:"toggleDryRun"`
 }
 
 type KeybindingStatusConfig struct {
 	CheckForUpdate      string `yaml:"checkForUpdate"`
 	RecentRepos         string `yaml:"recentRepos"`
 	AllBranchesLogGraph string `yaml:"allBranchesLogGraph"`
+	UIState             string `yaml:"uiState"`
+	ChangeLanguage      string `yaml:"changeLanguage"`
+	ContinueOperation   string `yaml:"continueOperation"`
+	SkipOperationStep   string `yaml:"skipOperationStep"`
+	AbortOperation      string `yaml:"abortOperation"`
+	RepoHealth          string `yaml:"repoHealth"`
+	AuthorStats         string `yaml:"authorStats"`
 }
 
 type KeybindingFilesConfig struct {
@@ -377,6 +671,7 @@ type KeybindingFilesConfig struct {
 	AmendLastCommit          string `yaml:"amendLastCommit"`
 	CommitChangesWithEditor  string `yaml:"commitChangesWithEditor"`
 	FindBaseCommitForFixup   string `yaml:"findBaseCommitForFixup"`
+	QuickFixup               string `yaml:"quickFixup"`
 	ConfirmDiscard           string `yaml:"confirmDiscard"`
 	IgnoreFile               string `yaml:"ignoreFile"`
 	RefreshFiles             string `yaml:"refreshFiles"`
@@ -389,28 +684,52 @@ type KeybindingFilesConfig struct {
 	OpenMergeTool            string `yaml:"openMergeTool"`
 	OpenStatusFilter         string `yaml:"openStatusFilter"`
 	CopyFileInfoToClipboard  string `yaml:"copyFileInfoToClipboard"`
+	FilterCommitsByPath      string `yaml:"filterCommitsByPath"`
+	ViewFileHistory          string `yaml:"viewFileHistory"`
+	CycleSortOrder           string `yaml:"cycleSortOrder"`
+	ViewParkedChanges        string `yaml:"viewParkedChanges"`
+	ToggleAssumeUnchanged    string `yaml:"toggleAssumeUnchanged"`
+	ToggleSkipWorktree       string `yaml:"toggleSkipWorktree"`
+	ToggleExecutable         string `yaml:"toggleExecutable"`
+	ViewTrash                string `yaml:"viewTrash"`
+	StageByPattern           string `yaml:"stageByPattern"`
+	ToggleGroupByChangeType  string `yaml:"toggleGroupByChangeType"`
+	CollapseAll              string `yaml:"collapseAll"`
+	ExpandAll                string `yaml:"expandAll"`
+	CollapseToLevel          string `yaml:"collapseToLevel"`
+	ViewFileOwnership        string `yaml:"viewFileOwnership"`
 }
 
 type KeybindingBranchesConfig struct {
-	CreatePullRequest      string `yaml:"createPullRequest"`
-	ViewPullRequestOptions string `yaml:"viewPullRequestOptions"`
-	CopyPullRequestURL     string `yaml:"copyPullRequestURL"`
-	CheckoutBranchByName   string `yaml:"checkoutBranchByName"`
-	ForceCheckoutBranch    string `yaml:"forceCheckoutBranch"`
-	RebaseBranch           string `yaml:"rebaseBranch"`
-	RenameBranch           string `yaml:"renameBranch"`
-	MergeIntoCurrentBranch string `yaml:"mergeIntoCurrentBranch"`
-	ViewGitFlowOptions     string `yaml:"viewGitFlowOptions"`
-	FastForward            string `yaml:"fastForward"`
-	CreateTag              string `yaml:"createTag"`
-	PushTag                string `yaml:"pushTag"`
-	SetUpstream            string `yaml:"setUpstream"`
-	FetchRemote            string `yaml:"fetchRemote"`
-	SortOrder              string `yaml:"sortOrder"`
+	CreatePullRequest       string `yaml:"createPullRequest"`
+	ViewPullRequestOptions  string `yaml:"viewPullRequestOptions"`
+	CopyPullRequestURL      string `yaml:"copyPullRequestURL"`
+	CheckoutBranchByName    string `yaml:"checkoutBranchByName"`
+	ForceCheckoutBranch     string `yaml:"forceCheckoutBranch"`
+	RebaseBranch            string `yaml:"rebaseBranch"`
+	RenameBranch            string `yaml:"renameBranch"`
+	MergeIntoCurrentBranch  string `yaml:"mergeIntoCurrentBranch"`
+	ViewGitFlowOptions      string `yaml:"viewGitFlowOptions"`
+	FastForward             string `yaml:"fastForward"`
+	CreateTag               string `yaml:"createTag"`
+	PushTag                 string `yaml:"pushTag"`
+	VerifyTag               string `yaml:"verifyTag"`
+	SetUpstream             string `yaml:"setUpstream"`
+	FetchRemote             string `yaml:"fetchRemote"`
+	SyncNotes               string `yaml:"syncNotes"`
+	SortOrder               string `yaml:"sortOrder"`
+	CleanUpGoneBranches     string `yaml:"cleanUpGoneBranches"`
+	AddForkRemote           string `yaml:"addForkRemote"`
+	ToggleDefaultPushRemote string `yaml:"toggleDefaultPushRemote"`
+	OpenLink                string `yaml:"openLink"`
+	CompareBranch           string `yaml:"compareBranch"`
 }
 
 type KeybindingWorktreesConfig struct {
 	ViewWorktreeOptions string `yaml:"viewWorktreeOptions"`
+	PruneWorktrees      string `yaml:"pruneWorktrees"`
+	RepairWorktree      string `yaml:"repairWorktree"`
+	OpenInTerminal      string `yaml:"openInTerminal"`
 }
 
 type KeybindingCommitsConfig struct {
@@ -427,23 +746,32 @@ type KeybindingCommitsConfig struct {
 	ResetCommitAuthor              string `yaml:"resetCommitAuthor"`
 	PickCommit                     string `yaml:"pickCommit"`
 	RevertCommit                   string `yaml:"revertCommit"`
+	RevertCommitIntoWorkingTree    string `yaml:"revertCommitIntoWorkingTree"`
 	CherryPickCopy                 string `yaml:"cherryPickCopy"`
 	CherryPickCopyRange            string `yaml:"cherryPickCopyRange"`
 	PasteCommits                   string `yaml:"pasteCommits"`
 	MarkCommitAsBaseForRebase      string `yaml:"markCommitAsBaseForRebase"`
+	RebaseOntoCommit               string `yaml:"rebaseOntoCommit"`
 	CreateTag                      string `yaml:"tagCommit"`
+	MoveTag                        string `yaml:"moveTag"`
 	CheckoutCommit                 string `yaml:"checkoutCommit"`
 	ResetCherryPick                string `yaml:"resetCherryPick"`
 	CopyCommitAttributeToClipboard string `yaml:"copyCommitAttributeToClipboard"`
 	OpenLogMenu                    string `yaml:"openLogMenu"`
+	PeekCommit                     string `yaml:"peekCommit"`
 	OpenInBrowser                  string `yaml:"openInBrowser"`
+	OpenLink                       string `yaml:"openLink"`
 	ViewBisectOptions              string `yaml:"viewBisectOptions"`
 	StartInteractiveRebase         string `yaml:"startInteractiveRebase"`
+	ViewReflogDateOptions          string `yaml:"viewReflogDateOptions"`
+	ShowContainingRefs             string `yaml:"showContainingRefs"`
 }
 
 type KeybindingStashConfig struct {
-	PopStash    string `yaml:"popStash"`
-	RenameStash string `yaml:"renameStash"`
+	PopStash            string `yaml:"popStash"`
+	ApplyStashWithIndex string `yaml:"applyStashWithIndex"`
+	RenameStash         string `yaml:"renameStash"`
+	BranchFromStash     string `yaml:"branchFromStash"`
 }
 
 type KeybindingCommitFilesConfig struct {
@@ -455,17 +783,23 @@ type KeybindingMainConfig struct {
 	ToggleDragSelectAlt string `yaml:"toggleDragSelect-alt"`
 	ToggleSelectHunk    string `yaml:"toggleSelectHunk"`
 	PickBothHunks       string `yaml:"pickBothHunks"`
+	ToggleConflictStyle string `yaml:"toggleConflictStyle"`
 	EditSelectHunk      string `yaml:"editSelectHunk"`
+	SplitHunk           string `yaml:"splitHunk"`
+	ParkSelection       string `yaml:"parkSelection"`
+	ApplySelection      string `yaml:"applySelection"`
 }
 
 type KeybindingSubmodulesConfig struct {
-	Init     string `yaml:"init"`
-	Update   string `yaml:"update"`
-	BulkMenu string `yaml:"bulkMenu"`
+	Init          string `yaml:"init"`
+	Update        string `yaml:"update"`
+	UpdateOptions string `yaml:"updateOptions"`
+	BulkMenu      string `yaml:"bulkMenu"`
 }
 
 type KeybindingCommitMessageConfig struct {
 	SwitchToEditor string `yaml:"switchToEditor"`
+	EditTrailers   string `yaml:"editTrailers"`
 }
 
 // OSConfig contains config on the level of the os
@@ -500,6 +834,12 @@ type OSConfig struct {
 	// Command for opening a link. Should contain "{{link}}".
 	OpenLink string `yaml:"openLink,omitempty"`
 
+	// Command for opening a new terminal window running lazygit in a given
+	// directory. Should contain "{{dir}}", and may contain "{{lazygit}}" for
+	// the path of the running lazygit executable. Unset by default because
+	// there's no terminal we could count on being installed.
+	OpenInTerminal string `yaml:"openInTerminal,omitempty"`
+
 	// --------
 
 	// The following configs are all deprecated and kept for backward
@@ -535,8 +875,8 @@ type CustomCommandAfterHook struct {
 type CustomCommand struct {
 	// The key to trigger the command. Use a single letter or one of the values from https://github.com/jesseduffield/lazygit/blob/master/docs/keybindings/Custom_Keybindings.md
 	Key string `yaml:"key"`
-	// The context in which to listen for the key
-	Context string `yaml:"context" jsonschema:"enum=status,enum=files,enum=worktrees,enum=localBranches,enum=remotes,enum=remoteBranches,enum=tags,enum=commits,enum=reflogCommits,enum=subCommits,enum=commitFiles,enum=stash,enum=global"`
+	// The context in which to listen for the key, e.g. 'commits'. Use a comma-separated list (e.g. 'commits, subCommits') to bind the key in several contexts, or 'global' to bind it everywhere
+	Context string `yaml:"context" jsonschema:"example=status,example=files,example=commits,example=commits\\, subCommits,example=global"`
 	// The command to run (using Go template syntax for placeholder values)
 	Command string `yaml:"command" jsonschema:"example=git fetch {{.Form.Remote}} {{.Form.Branch}} && git checkout FETCH_HEAD"`
 	// If true, run the command in a subprocess (e.g. if the command requires user input)
@@ -597,6 +937,18 @@ type CustomCommandSuggestions struct {
 	Preset string `yaml:"preset" jsonschema:"enum=authors,enum=branches,enum=files,enum=refs,enum=remotes,enum=remoteBranches,enum=tags"`
 	// Command to run such that each line in the output becomes a suggestion. Mutually exclusive with 'preset' field.
 	Command string `yaml:"command" jsonschema:"example=git fetch {{.Form.Remote}} {{.Form.Branch}} && git checkout FETCH_HEAD"`
+	// The regexp to run specifying groups which are going to be kept from the command's output.
+	// Only for suggestions from a command.
+	Filter string `yaml:"filter" jsonschema:"example=^(?P<key>[A-Z]+-[0-9]+)\\s+(?P<summary>.*)$"`
+	// How to format matched groups from the filter to construct a suggestion's value.
+	// Only for suggestions from a command.
+	ValueFormat string `yaml:"valueFormat" jsonschema:"example={{ .key }}"`
+	// Like valueFormat but for the labels. If `labelFormat` is not specified, `valueFormat` is shown instead.
+	// Only for suggestions from a command.
+	LabelFormat string `yaml:"labelFormat" jsonschema:"example={{ .key | green }} {{ .summary }}"`
+	// If greater than zero, the suggestions are reused for this many seconds instead of running the command again each time the prompt is shown.
+	// Only for suggestions from a command.
+	CacheSeconds int `yaml:"cacheSeconds" jsonschema:"minimum=0"`
 }
 
 type CustomCommandMenuOption struct {
@@ -611,6 +963,7 @@ type CustomCommandMenuOption struct {
 func GetDefaultConfig() *UserConfig {
 	return &UserConfig{
 		Gui: GuiConfig{
+			AuthorInitialsBlock:      false,
 			ScrollHeight:             2,
 			ScrollPastBottom:         true,
 			ScrollOffMargin:          2,
@@ -625,6 +978,12 @@ func GetDefaultConfig() *UserConfig {
 			Language:                 "auto",
 			TimeFormat:               "02 Jan 06",
 			ShortTimeFormat:          time.Kitchen,
+			DateDisplay: DateDisplayConfig{
+				Commits:  "absolute",
+				Reflog:   "absolute",
+				Branches: "relative",
+				Stash:    "relative",
+			},
 			Theme: ThemeConfig{
 				ActiveBorderColor:          []string{"green", "bold"},
 				SearchingActiveBorderColor: []string{"cyan", "bold"},
@@ -638,6 +997,21 @@ func GetDefaultConfig() *UserConfig {
 				MarkedBaseCommitFgColor:    []string{"blue"},
 				UnstagedChangesColor:       []string{"red"},
 				DefaultFgColor:             []string{"default"},
+				OwnCommitAuthorColor:       []string{"green", "bold"},
+			},
+			StatusGlyphs: StatusGlyphsConfig{
+				Ahead:                   "↑",
+				Behind:                  "↓",
+				InSync:                  "✓",
+				UpstreamNotFetched:      "?",
+				Merged:                  "",
+				AheadBehindColor:        []string{"yellow"},
+				InSyncColor:             []string{"green"},
+				UpstreamGoneColor:       []string{"red"},
+				UpstreamNotFetchedColor: []string{"magenta"},
+				UnpushedCommitColor:     []string{"red"},
+				PushedCommitColor:       []string{"yellow"},
+				MergedCommitColor:       []string{"green"},
 			},
 			CommitLength:              CommitLengthConfig{Show: true},
 			SkipNoStagedFilesWarning:  false,
@@ -650,12 +1024,34 @@ func GetDefaultConfig() *UserConfig {
 			ShowIcons:                 false,
 			NerdFontsVersion:          "",
 			ShowBranchCommitHash:      false,
+			HighlightOwnCommits:       false,
+			CommitHashLength:          8,
+			ShowFileOutline:           false,
 			CommandLogSize:            8,
+			SlowCommandThreshold:      1000,
 			SplitDiff:                 "auto",
 			SkipRewordInEditorWarning: false,
 			Border:                    "rounded",
 			AnimateExplosion:          true,
 			PortraitMode:              "auto",
+			CommitsMinimap:            "none",
+			ShowDivergenceMarkers:     false,
+			ShowRebaseProgress:        true,
+			PersistSessionState:       false,
+			PresenterMode: PresenterModeConfig{
+				Enabled:            false,
+				ActionDelay:        400,
+				KeyDisplayDuration: 3000,
+			},
+			Files: FilesPanelConfig{
+				ShowNumstat:           false,
+				ShowFileSizes:         false,
+				LargeFileThreshold:    "5MB",
+				HugeFileThreshold:     "50MB",
+				GroupByChangeType:     false,
+				TrashDiscardedChanges: false,
+				ShowIndexFlags:        false,
+			},
 		},
 		Git: GitConfig{
 			Paging: PagingConfig{
@@ -666,11 +1062,19 @@ func GetDefaultConfig() *UserConfig {
 			},
 			Commit: CommitConfig{
 				SignOff: false,
+				Trailers: []CommitTrailerConfig{
+					{Key: "Reviewed-by", SuggestAuthors: true},
+					{Key: "Ticket"},
+					{Key: "Refs"},
+				},
 			},
 			Merging: MergingConfig{
-				ManualCommit: false,
-				Args:         "",
+				ManualCommit:        false,
+				Args:                "",
+				ConflictStyle:       "",
+				OnConflictsResolved: "prompt",
 			},
+			RebaseMerges: true,
 			Log: LogConfig{
 				Order:          "topo-order",
 				ShowGraph:      "when-maximised",
@@ -685,11 +1089,13 @@ func GetDefaultConfig() *UserConfig {
 			AllBranchesLogCmd:   "git log --graph --all --color=always --abbrev-commit --decorate --date=relative  --pretty=medium",
 			DisableForcePushing: false,
 			CommitPrefixes:      map[string]CommitPrefixConfig(nil),
+			IssueLinks:          []IssueLinkConfig(nil),
 			ParseEmoji:          false,
 		},
 		Refresher: RefresherConfig{
 			RefreshInterval: 10,
 			FetchInterval:   60,
+			WatchFiles:      false,
 		},
 		Update: UpdateConfig{
 			Method: "prompt",
@@ -714,6 +1120,7 @@ func GetDefaultConfig() *UserConfig {
 				ScrollRight:                  "L",
 				GotoTop:                      "<",
 				GotoBottom:                   ">",
+				ToggleRangeSelect:            "V",
 				PrevBlock:                    "<left>",
 				NextBlock:                    "<right>",
 				PrevBlockAlt:                 "h",
@@ -760,14 +1167,28 @@ func GetDefaultConfig() *UserConfig {
 				SubmitEditorText:             "<enter>",
 				ExtrasMenu:                   "@",
 				ToggleWhitespaceInDiffView:   "<c-w>",
+				ToggleSplitDiffView:          "<c-v>",
 				IncreaseContextInDiffView:    "}",
 				DecreaseContextInDiffView:    "{",
 				OpenDiffTool:                 "<c-t>",
+				ToggleDateDisplay:            "<c-a>",
+				OpenFuzzyFinder:              "<c-g>",
+				TogglePresenterMode:          "<c-x>",
+				NextConflictedFile:           "<c-n>",
+				PrevConflictedFile:           "<c-q>",
+				ToggleDryRun:                 "!",
 			},
 			Status: KeybindingStatusConfig{
 				CheckForUpdate:      "u",
 				RecentRepos:         "<enter>",
 				AllBranchesLogGraph: "a",
+				UIState:             "S",
+				ChangeLanguage:      "i",
+				ContinueOperation:   "c",
+				SkipOperationStep:   "s",
+				AbortOperation:      "A",
+				RepoHealth:          "D",
+				AuthorStats:         "C",
 			},
 			Files: KeybindingFilesConfig{
 				CommitChanges:            "c",
@@ -775,6 +1196,7 @@ func GetDefaultConfig() *UserConfig {
 				AmendLastCommit:          "A",
 				CommitChangesWithEditor:  "C",
 				FindBaseCommitForFixup:   "<c-f>",
+				QuickFixup:               "F",
 				IgnoreFile:               "i",
 				RefreshFiles:             "r",
 				StashAllChanges:          "s",
@@ -787,26 +1209,50 @@ func GetDefaultConfig() *UserConfig {
 				OpenStatusFilter:         "<c-b>",
 				ConfirmDiscard:           "x",
 				CopyFileInfoToClipboard:  "y",
+				FilterCommitsByPath:      "<c-l>",
+				ViewFileHistory:          "T",
+				CycleSortOrder:           "O",
+				ViewParkedChanges:        "Z",
+				ToggleAssumeUnchanged:    "u",
+				ToggleSkipWorktree:       "U",
+				ToggleExecutable:         "E",
+				ViewTrash:                "t",
+				StageByPattern:           "*",
+				ToggleGroupByChangeType:  "G",
+				CollapseAll:              "-",
+				ExpandAll:                "=",
+				CollapseToLevel:          "|",
+				ViewFileOwnership:        "b",
 			},
 			Branches: KeybindingBranchesConfig{
-				CopyPullRequestURL:     "<c-y>",
-				CreatePullRequest:      "o",
-				ViewPullRequestOptions: "O",
-				CheckoutBranchByName:   "c",
-				ForceCheckoutBranch:    "F",
-				RebaseBranch:           "r",
-				RenameBranch:           "R",
-				MergeIntoCurrentBranch: "M",
-				ViewGitFlowOptions:     "i",
-				FastForward:            "f",
-				CreateTag:              "T",
-				PushTag:                "P",
-				SetUpstream:            "u",
-				FetchRemote:            "f",
-				SortOrder:              "s",
+				CopyPullRequestURL:      "<c-y>",
+				CreatePullRequest:       "o",
+				ViewPullRequestOptions:  "O",
+				CheckoutBranchByName:    "c",
+				ForceCheckoutBranch:     "F",
+				RebaseBranch:            "r",
+				RenameBranch:            "R",
+				MergeIntoCurrentBranch:  "M",
+				ViewGitFlowOptions:      "i",
+				FastForward:             "f",
+				CreateTag:               "T",
+				PushTag:                 "P",
+				VerifyTag:               "v",
+				SetUpstream:             "u",
+				FetchRemote:             "f",
+				SyncNotes:               "N",
+				SortOrder:               "s",
+				CleanUpGoneBranches:     "D",
+				AddForkRemote:           "F",
+				ToggleDefaultPushRemote: "t",
+				OpenLink:                "<c-b>",
+				CompareBranch:           "C",
 			},
 			Worktrees: KeybindingWorktreesConfig{
 				ViewWorktreeOptions: "w",
+				PruneWorktrees:      "c",
+				RepairWorktree:      "r",
+				OpenInTerminal:      "t",
 			},
 			Commits: KeybindingCommitsConfig{
 				SquashDown:                     "s",
@@ -822,22 +1268,31 @@ func GetDefaultConfig() *UserConfig {
 				ResetCommitAuthor:              "a",
 				PickCommit:                     "p",
 				RevertCommit:                   "t",
+				RevertCommitIntoWorkingTree:    "X",
 				CherryPickCopy:                 "c",
 				CherryPickCopyRange:            "C",
 				PasteCommits:                   "v",
 				MarkCommitAsBaseForRebase:      "B",
+				RebaseOntoCommit:               "O",
 				CreateTag:                      "T",
+				MoveTag:                        "M",
 				CheckoutCommit:                 "<space>",
 				ResetCherryPick:                "<c-R>",
 				CopyCommitAttributeToClipboard: "y",
 				OpenLogMenu:                    "<c-l>",
+				PeekCommit:                     "I",
 				OpenInBrowser:                  "o",
+				OpenLink:                       "<c-b>",
 				ViewBisectOptions:              "b",
 				StartInteractiveRebase:         "i",
+				ViewReflogDateOptions:          "D",
+				ShowContainingRefs:             "G",
 			},
 			Stash: KeybindingStashConfig{
-				PopStash:    "g",
-				RenameStash: "r",
+				PopStash:            "g",
+				ApplyStashWithIndex: "i",
+				RenameStash:         "r",
+				BranchFromStash:     "b",
 			},
 			CommitFiles: KeybindingCommitFilesConfig{
 				CheckoutCommitFile: "c",
@@ -847,15 +1302,21 @@ func GetDefaultConfig() *UserConfig {
 				ToggleDragSelectAlt: "V",
 				ToggleSelectHunk:    "a",
 				PickBothHunks:       "b",
+				ToggleConflictStyle: "s",
 				EditSelectHunk:      "E",
+				SplitHunk:           "s",
+				ParkSelection:       "Z",
+				ApplySelection:      "A",
 			},
 			Submodules: KeybindingSubmodulesConfig{
-				Init:     "i",
-				Update:   "u",
-				BulkMenu: "b",
+				Init:          "i",
+				Update:        "u",
+				UpdateOptions: "o",
+				BulkMenu:      "b",
 			},
 			CommitMessage: KeybindingCommitMessageConfig{
 				SwitchToEditor: "<c-o>",
+				EditTrailers:   "<c-t>",
 			},
 		},
 		OS:                           OSConfig{},
@@ -864,5 +1325,14 @@ func GetDefaultConfig() *UserConfig {
 		Services:                     map[string]string(nil),
 		NotARepository:               "prompt",
 		PromptToReturnFromSubprocess: true,
+		Confirmations: ConfirmationsConfig{
+			Tiers: ConfirmationTiersConfig{
+				Low:    ConfirmationLevelNone,
+				Medium: ConfirmationLevelPrompt,
+				High:   ConfirmationLevelTypeToConfirm,
+			},
+			Actions:           map[string]string(nil),
+			ProtectedBranches: []string{},
+		},
 	}
 }
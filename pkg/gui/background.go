@@ -5,6 +5,7 @@ import (
 	"time"
 
 	"github.com/jesseduffield/gocui"
+	"github.com/jesseduffield/lazygit/pkg/gui/filewatcher"
 	"github.com/jesseduffield/lazygit/pkg/gui/types"
 	"github.com/jesseduffield/lazygit/pkg/utils"
 )
@@ -16,6 +17,9 @@ type BackgroundRoutineMgr struct {
 	// we typically want to pause some things that are running like background
 	// file refreshes
 	pauseBackgroundRefreshes bool
+
+	// nil unless 'refresher.watchFiles' is enabled
+	fileWatcher *filewatcher.Watcher
 }
 
 func (self *BackgroundRoutineMgr) PauseBackgroundRefreshes(pause bool) {
@@ -45,6 +49,10 @@ func (self *BackgroundRoutineMgr) startBackgroundRoutines() {
 				"Value of config option 'refresher.refreshInterval' (%d) is invalid, disabling auto-refresh",
 				refreshInterval)
 		}
+
+		if userConfig.Refresher.WatchFiles {
+			self.startFileWatcher()
+		}
 	}
 }
 
@@ -76,6 +84,42 @@ func (self *BackgroundRoutineMgr) startBackgroundFilesRefresh(refreshInterval in
 	})
 }
 
+// The file watcher complements the periodic refresh: it picks up most changes
+// straight away, and the periodic refresh catches whatever it misses (e.g.
+// directories beyond the watch limit)
+func (self *BackgroundRoutineMgr) startFileWatcher() {
+	watcher, err := filewatcher.New(self.gui.c.Log, func(scopes []types.RefreshableView) {
+		if self.pauseBackgroundRefreshes {
+			return
+		}
+
+		_ = self.gui.c.Refresh(types.RefreshOptions{Scope: scopes, Mode: types.ASYNC})
+	})
+	if err != nil {
+		self.gui.c.Log.Errorf("Failed to start file watcher: %v", err)
+		return
+	}
+
+	self.fileWatcher = watcher
+	go utils.Safe(func() {
+		<-self.gui.stopChan
+		watcher.Close()
+	})
+
+	self.WatchCurrentRepo()
+}
+
+// WatchCurrentRepo points the file watcher at the current repo. It needs
+// calling whenever we switch repos.
+func (self *BackgroundRoutineMgr) WatchCurrentRepo() {
+	if self.fileWatcher == nil {
+		return
+	}
+
+	git := self.gui.git
+	go utils.Safe(func() { self.fileWatcher.Watch(git.RepoPaths, git.WorkingTree) })
+}
+
 func (self *BackgroundRoutineMgr) goEvery(interval time.Duration, stop chan struct{}, function func() error) {
 	done := make(chan struct{})
 	go utils.Safe(func() {
@@ -3,13 +3,18 @@ package gui
 import (
 	"fmt"
 	"math/rand"
+	"strconv"
 	"strings"
 	"time"
 
+	"github.com/jesseduffield/lazygit/pkg/commands/models"
+	"github.com/jesseduffield/lazygit/pkg/commands/oscommands"
 	"github.com/jesseduffield/lazygit/pkg/constants"
 	"github.com/jesseduffield/lazygit/pkg/gui/keybindings"
+	"github.com/jesseduffield/lazygit/pkg/gui/presentation"
 	"github.com/jesseduffield/lazygit/pkg/gui/style"
 	"github.com/jesseduffield/lazygit/pkg/theme"
+	"github.com/jesseduffield/lazygit/pkg/utils"
 )
 
 // our UI command log looks like this:
@@ -31,14 +36,41 @@ func (gui *Gui) LogAction(action string) {
 	gui.Views.Extras.Autoscroll = true
 
 	gui.GuiLog = append(gui.GuiLog, action)
-	fmt.Fprint(gui.Views.Extras, "\n"+style.FgYellow.Sprint(action))
+
+	gui.commandLogMutex.Lock()
+	gui.commandLogAction = action
+	gui.commandLogMutex.Unlock()
+
+	gui.writeToCommandLog("\n" + style.FgYellow.Sprint(action))
 }
 
 func (gui *Gui) LogCommand(cmdStr string, commandLine bool) {
+	gui.logCommandEntry(&models.CommandLogEntry{
+		Command:       cmdStr,
+		IsCommandLine: commandLine,
+	})
+}
+
+// LogCmdObj logs a command we're about to run, keeping what's needed to re-run
+// it from the command log
+func (gui *Gui) LogCmdObj(cmdObj oscommands.ICmdObj) {
+	gui.logCommandEntry(&models.CommandLogEntry{
+		Command:       cmdObj.ToString(),
+		IsCommandLine: true,
+		Args:          cmdObj.Args(),
+		Env:           cmdObj.GetEnvVars(),
+		Dir:           cmdObj.GetCmd().Dir,
+	})
+}
+
+func (gui *Gui) logCommandEntry(entry *models.CommandLogEntry) {
 	if gui.Views.Extras == nil {
 		return
 	}
 
+	cmdStr := entry.Command
+	commandLine := entry.IsCommandLine
+
 	gui.Views.Extras.Autoscroll = true
 
 	textStyle := theme.DefaultTextColor
@@ -48,8 +80,100 @@ func (gui *Gui) LogCommand(cmdStr string, commandLine bool) {
 		textStyle = style.FgMagenta
 	}
 	gui.GuiLog = append(gui.GuiLog, cmdStr)
+
+	gui.commandLogMutex.Lock()
+	entry.Action = gui.commandLogAction
+	entry.Index = len(gui.CommandLogEntries)
+	gui.CommandLogEntries = append(gui.CommandLogEntries, entry)
+	gui.commandLogMutex.Unlock()
+
 	indentedCmdStr := "  " + strings.Replace(cmdStr, "\n", "\n  ", -1)
-	fmt.Fprint(gui.Views.Extras, "\n"+textStyle.Sprint(indentedCmdStr))
+	gui.writeToCommandLog("\n" + textStyle.Sprint(indentedCmdStr))
+}
+
+// LogCommandResult records how long a command logged via LogCommand took and
+// how it exited. In the plain log we only mention slow or failed commands, to
+// keep the noise down; the list view shows the result of every command.
+func (gui *Gui) LogCommandResult(cmdStr string, duration time.Duration, exitCode int) {
+	if gui.Views.Extras == nil {
+		return
+	}
+
+	gui.commandLogMutex.Lock()
+	// commands can run concurrently, so we find the most recent entry for this
+	// command that hasn't finished yet. The entry is replaced rather than
+	// updated, because the list view may be reading the old one.
+	var entry *models.CommandLogEntry
+	for i := len(gui.CommandLogEntries) - 1; i >= 0; i-- {
+		existing := gui.CommandLogEntries[i]
+		if existing.Command == cmdStr && !existing.Finished {
+			updated := *existing
+			updated.Finished = true
+			updated.Duration = duration
+			updated.ExitCode = exitCode
+			gui.CommandLogEntries[i] = &updated
+			entry = &updated
+			break
+		}
+	}
+	gui.commandLogMutex.Unlock()
+
+	if entry == nil {
+		return
+	}
+
+	slowThreshold := time.Duration(gui.c.UserConfig.Gui.SlowCommandThreshold) * time.Millisecond
+	command := utils.TruncateWithEllipsis(strings.Split(cmdStr, "\n")[0], 50)
+	formattedDuration := presentation.FormatCommandDuration(duration)
+	if entry.Failed() {
+		gui.writeToCommandLog("\n" + style.FgRed.Sprint("  "+utils.ResolvePlaceholderString(
+			gui.c.Tr.FailedCommandLog,
+			map[string]string{
+				"command":  command,
+				"exitCode": strconv.Itoa(exitCode),
+				"duration": formattedDuration,
+			},
+		)))
+	} else if entry.IsSlow(slowThreshold) {
+		gui.writeToCommandLog("\n" + style.FgYellow.Sprint("  "+utils.ResolvePlaceholderString(
+			gui.c.Tr.SlowCommandLog,
+			map[string]string{
+				"command":  command,
+				"duration": formattedDuration,
+			},
+		)))
+	} else if gui.State != nil && gui.State.Contexts.CommandLog.IsShowingList() {
+		gui.c.OnUIThread(func() error {
+			return gui.c.PostRefreshUpdate(gui.State.Contexts.CommandLog)
+		})
+	}
+}
+
+// writeToCommandLog appends to the plain text log. While the command log is
+// focused we're showing the list of commands instead, so we just re-render
+// that, and the plain log is restored when the command log loses focus.
+func (gui *Gui) writeToCommandLog(str string) {
+	gui.commandLogMutex.Lock()
+	gui.commandLogContent.WriteString(str)
+	gui.commandLogMutex.Unlock()
+
+	if gui.State != nil && gui.State.Contexts.CommandLog.IsShowingList() {
+		gui.c.OnUIThread(func() error {
+			return gui.c.PostRefreshUpdate(gui.State.Contexts.CommandLog)
+		})
+		return
+	}
+
+	fmt.Fprint(gui.Views.Extras, str)
+}
+
+type commandLogWriter struct {
+	gui *Gui
+}
+
+func (self *commandLogWriter) Write(p []byte) (int, error) {
+	self.gui.writeToCommandLog(string(p))
+	return len(p), nil
 }
 
 func (gui *Gui) printCommandLogHeader() {
@@ -57,15 +181,14 @@ func (gui *Gui) printCommandLogHeader() {
 		gui.c.Tr.CommandLogHeader,
 		keybindings.Label(gui.c.UserConfig.Keybinding.Universal.ExtrasMenu),
 	)
-	fmt.Fprintln(gui.Views.Extras, style.FgCyan.Sprint(introStr))
+	gui.writeToCommandLog(style.FgCyan.Sprint(introStr) + "\n")
 
 	if gui.c.UserConfig.Gui.ShowRandomTip {
-		fmt.Fprintf(
-			gui.Views.Extras,
+		gui.writeToCommandLog(fmt.Sprintf(
 			"%s: %s",
 			style.FgYellow.Sprint(gui.c.Tr.RandomTip),
 			style.FgGreen.Sprint(gui.getRandomTip()),
-		)
+		))
 	}
 }
 
@@ -200,10 +200,12 @@ func (self *ContextMgr) RemoveContexts(contextsToRemove []types.Context) error {
 func (self *ContextMgr) deactivateContext(c types.Context, opts types.OnFocusLostOpts) error {
 	view, _ := self.gui.c.GocuiGui().View(c.GetViewName())
 
-	if opts.NewContextKey != context.SEARCH_CONTEXT_KEY {
-		if c.GetKind() == types.MAIN_CONTEXT || c.GetKind() == types.TEMPORARY_POPUP {
-			self.gui.helpers.Search.CancelSearchIfSearching(c)
-		}
+	// We keep searches in other contexts alive (along with their highlights) so
+	// that the user can come back to them; they're cleared with escape.
+	// Temporary popups are gone once we leave them though, so there's nothing to
+	// come back to.
+	if opts.NewContextKey != context.SEARCH_CONTEXT_KEY && c.GetKind() == types.TEMPORARY_POPUP {
+		self.gui.helpers.Search.CancelSearchIfSearching(c)
 	}
 
 	// if we are the kind of context that is sent to back upon deactivation, we should do that
@@ -34,6 +34,8 @@ func NewBranchesContext(c *ContextCommon) *BranchesContext {
 			c.Tr,
 			c.UserConfig,
 			c.Model().Worktrees,
+			showRelativeDates(c, c.UserConfig.Gui.DateDisplay.Branches),
+			c.Model().Linker,
 		)
 	}
 
@@ -0,0 +1,103 @@
+package context
+
+import (
+	"time"
+
+	"github.com/jesseduffield/lazygit/pkg/commands/models"
+	"github.com/jesseduffield/lazygit/pkg/gui/presentation"
+	"github.com/jesseduffield/lazygit/pkg/gui/types"
+	"github.com/samber/lo"
+)
+
+// The command log is normally a plain text view that we append to as commands
+// are run, including their output. When it's focused it turns into a list of
+// the commands that were run, so that they can be selected, copied, re-run and
+// filtered by subsystem.
+type CommandLogContext struct {
+	*ListViewModel[*models.CommandLogEntry]
+	*ListContextTrait
+
+	// true while the list of commands is shown in place of the plain log
+	showingList bool
+	// if not blank, we only list commands of this subsystem e.g. 'fetch'
+	subsystemFilter string
+}
+
+var _ types.IListContext = (*CommandLogContext)(nil)
+
+func NewCommandLogContext(c *ContextCommon) *CommandLogContext {
+	var self *CommandLogContext
+
+	viewModel := NewListViewModel(func() []*models.CommandLogEntry {
+		entries := c.State().GetCommandLogEntries()
+		if self.subsystemFilter == "" {
+			return entries
+		}
+
+		return lo.Filter(entries, func(entry *models.CommandLogEntry, _ int) bool {
+			return entry.Subsystem() == self.subsystemFilter
+		})
+	})
+
+	getDisplayStrings := func(_ int, _ int) [][]string {
+		return presentation.GetCommandLogDisplayStrings(
+			viewModel.GetItems(),
+			time.Duration(c.UserConfig.Gui.SlowCommandThreshold)*time.Millisecond,
+		)
+	}
+
+	self = &CommandLogContext{
+		ListViewModel: viewModel,
+		ListContextTrait: &ListContextTrait{
+			Context: NewSimpleContext(NewBaseContext(NewBaseContextOpts{
+				Kind:       types.EXTRAS_CONTEXT,
+				View:       c.Views().Extras,
+				WindowName: "extras",
+				Key:        COMMAND_LOG_CONTEXT_KEY,
+				Focusable:  true,
+			})),
+			ListRenderer: ListRenderer{
+				list:              viewModel,
+				getDisplayStrings: getDisplayStrings,
+			},
+			c: c,
+		},
+	}
+
+	return self
+}
+
+func (self *CommandLogContext) GetSelectedItemId() string {
+	item := self.GetSelected()
+	if item == nil {
+		return ""
+	}
+
+	return item.ID()
+}
+
+// while the plain log is shown, its content is written by the gui as commands
+// are logged, so there's nothing for us to render
+func (self *CommandLogContext) HandleRender() error {
+	if !self.showingList {
+		return nil
+	}
+
+	return self.ListContextTrait.HandleRender()
+}
+
+func (self *CommandLogContext) IsShowingList() bool {
+	return self.showingList
+}
+
+func (self *CommandLogContext) SetShowingList(value bool) {
+	self.showingList = value
+}
+
+func (self *CommandLogContext) GetSubsystemFilter() string {
+	return self.subsystemFilter
+}
+
+func (self *CommandLogContext) SetSubsystemFilter(value string) {
+	self.subsystemFilter = value
+}
@@ -20,6 +20,7 @@ const (
 	LOCAL_COMMITS_CONTEXT_KEY            types.ContextKey = "commits"
 	REFLOG_COMMITS_CONTEXT_KEY           types.ContextKey = "reflogCommits"
 	SUB_COMMITS_CONTEXT_KEY              types.ContextKey = "subCommits"
+	FILE_HISTORY_CONTEXT_KEY             types.ContextKey = "fileHistory"
 	COMMIT_FILES_CONTEXT_KEY             types.ContextKey = "commitFiles"
 	STASH_CONTEXT_KEY                    types.ContextKey = "stash"
 	NORMAL_MAIN_CONTEXT_KEY              types.ContextKey = "normal"
@@ -31,13 +32,15 @@ const (
 	MERGE_CONFLICTS_CONTEXT_KEY          types.ContextKey = "mergeConflicts"
 
 	// these shouldn't really be needed for anything but I'm giving them unique keys nonetheless
-	OPTIONS_CONTEXT_KEY        types.ContextKey = "options"
-	APP_STATUS_CONTEXT_KEY     types.ContextKey = "appStatus"
-	SEARCH_PREFIX_CONTEXT_KEY  types.ContextKey = "searchPrefix"
-	INFORMATION_CONTEXT_KEY    types.ContextKey = "information"
-	LIMIT_CONTEXT_KEY          types.ContextKey = "limit"
-	STATUS_SPACER1_CONTEXT_KEY types.ContextKey = "statusSpacer1"
-	STATUS_SPACER2_CONTEXT_KEY types.ContextKey = "statusSpacer2"
+	OPTIONS_CONTEXT_KEY         types.ContextKey = "options"
+	APP_STATUS_CONTEXT_KEY      types.ContextKey = "appStatus"
+	SEARCH_PREFIX_CONTEXT_KEY   types.ContextKey = "searchPrefix"
+	INFORMATION_CONTEXT_KEY     types.ContextKey = "information"
+	LIMIT_CONTEXT_KEY           types.ContextKey = "limit"
+	STATUS_SPACER1_CONTEXT_KEY  types.ContextKey = "statusSpacer1"
+	STATUS_SPACER2_CONTEXT_KEY  types.ContextKey = "statusSpacer2"
+	COMMITS_MINIMAP_CONTEXT_KEY types.ContextKey = "commitsMinimap"
+	REBASE_PROGRESS_CONTEXT_KEY types.ContextKey = "rebaseProgress"
 
 	MENU_CONTEXT_KEY               types.ContextKey = "menu"
 	CONFIRMATION_CONTEXT_KEY       types.ContextKey = "confirmation"
@@ -61,6 +64,7 @@ var AllContextKeys = []types.ContextKey{
 	LOCAL_COMMITS_CONTEXT_KEY,
 	REFLOG_COMMITS_CONTEXT_KEY,
 	SUB_COMMITS_CONTEXT_KEY,
+	FILE_HISTORY_CONTEXT_KEY,
 	COMMIT_FILES_CONTEXT_KEY,
 	STASH_CONTEXT_KEY,
 	NORMAL_MAIN_CONTEXT_KEY,
@@ -96,6 +100,7 @@ type ContextTree struct {
 	RemoteBranches              *RemoteBranchesContext
 	ReflogCommits               *ReflogCommitsContext
 	SubCommits                  *SubCommitsContext
+	FileHistory                 *FileHistoryContext
 	Stash                       *StashContext
 	Suggestions                 *SuggestionsContext
 	Normal                      types.Context
@@ -108,17 +113,19 @@ type ContextTree struct {
 	Confirmation                *ConfirmationContext
 	CommitMessage               *CommitMessageContext
 	CommitDescription           types.Context
-	CommandLog                  types.Context
+	CommandLog                  *CommandLogContext
 
 	// display contexts
-	AppStatus     types.Context
-	Options       types.Context
-	SearchPrefix  types.Context
-	Search        types.Context
-	Information   types.Context
-	Limit         types.Context
-	StatusSpacer1 types.Context
-	StatusSpacer2 types.Context
+	AppStatus      types.Context
+	Options        types.Context
+	SearchPrefix   types.Context
+	Search         types.Context
+	Information    types.Context
+	Limit          types.Context
+	StatusSpacer1  types.Context
+	StatusSpacer2  types.Context
+	CommitsMinimap types.Context
+	RebaseProgress types.Context
 }
 
 // the order of this decides which context is initially at the top of its window
@@ -129,6 +136,7 @@ func (self *ContextTree) Flatten() []types.Context {
 		self.Snake,
 		self.Submodules,
 		self.Worktrees,
+		self.FileHistory,
 		self.Files,
 		self.SubCommits,
 		self.Remotes,
@@ -162,6 +170,8 @@ func (self *ContextTree) Flatten() []types.Context {
 		self.Limit,
 		self.StatusSpacer1,
 		self.StatusSpacer2,
+		self.CommitsMinimap,
+		self.RebaseProgress,
 	}
 }
 
@@ -0,0 +1,128 @@
+package context
+
+import (
+	"time"
+
+	"github.com/jesseduffield/lazygit/pkg/commands/git_commands"
+	"github.com/jesseduffield/lazygit/pkg/commands/models"
+	"github.com/jesseduffield/lazygit/pkg/gui/presentation"
+	"github.com/jesseduffield/lazygit/pkg/gui/types"
+)
+
+// The commits that touched a single file, following it across renames. It is
+// opened from the files or commit files panel and shown in the same window.
+type FileHistoryContext struct {
+	*FileHistoryViewModel
+	*ListContextTrait
+	*DynamicTitleBuilder
+}
+
+var _ types.IListContext = (*FileHistoryContext)(nil)
+
+func NewFileHistoryContext(c *ContextCommon) *FileHistoryContext {
+	viewModel := &FileHistoryViewModel{
+		ListViewModel: NewListViewModel(
+			func() []*models.Commit { return c.Model().FileHistoryCommits },
+		),
+	}
+
+	getDisplayStrings := func(startIdx int, endIdx int) [][]string {
+		selectedCommitSha := ""
+		if c.CurrentContext().GetKey() == FILE_HISTORY_CONTEXT_KEY {
+			selectedCommit := viewModel.GetSelected()
+			if selectedCommit != nil {
+				selectedCommitSha = selectedCommit.Sha
+			}
+		}
+		ownAuthorEmail := ""
+		if c.UserConfig.Gui.HighlightOwnCommits {
+			ownAuthorEmail = c.Git().Config.GetUserEmail()
+		}
+
+		timeFormat, shortTimeFormat := c.UserConfig.Gui.TimeFormatsFor(c.UserConfig.Gui.ViewTimeFormats.Commits)
+		return presentation.GetCommitListDisplayStrings(
+			c.Common,
+			c.Model().FileHistoryCommits,
+			[]*models.Branch{},
+			"",
+			false,
+			c.State().GetRepoState().GetScreenMode() != types.SCREEN_NORMAL,
+			c.Modes().CherryPicking.SelectedShaSet(),
+			c.Modes().Diffing.Ref,
+			"",
+			timeFormat,
+			shortTimeFormat,
+			showRelativeDates(c, c.UserConfig.Gui.DateDisplay.Commits),
+			time.Now(),
+			c.UserConfig.Git.ParseEmoji,
+			selectedCommitSha,
+			startIdx,
+			endIdx,
+			// the graph of a path-limited log is misleading
+			false,
+			git_commands.NewNullBisectInfo(),
+			false,
+			ownAuthorEmail,
+			c.Model().Linker,
+		)
+	}
+
+	return &FileHistoryContext{
+		FileHistoryViewModel: viewModel,
+		DynamicTitleBuilder:  NewDynamicTitleBuilder(c.Tr.FileHistoryDynamicTitle),
+		ListContextTrait: &ListContextTrait{
+			Context: NewSimpleContext(NewBaseContext(NewBaseContextOpts{
+				View:                       c.Views().FileHistory,
+				WindowName:                 "files",
+				Key:                        FILE_HISTORY_CONTEXT_KEY,
+				Kind:                       types.SIDE_CONTEXT,
+				Focusable:                  true,
+				Transient:                  true,
+				NeedsRerenderOnWidthChange: true,
+			})),
+			ListRenderer: ListRenderer{
+				list:              viewModel,
+				getDisplayStrings: getDisplayStrings,
+			},
+			c:                       c,
+			refreshViewportOnChange: true,
+		},
+	}
+}
+
+type FileHistoryViewModel struct {
+	*ListViewModel[*models.Commit]
+
+	// the path of the file as of the commit the history starts from
+	path string
+	// the path of the file as of each commit, which differs from the path
+	// above for commits from before the file was renamed
+	pathsBySha map[string]string
+}
+
+func (self *FileHistoryViewModel) SetPath(path string, pathsBySha map[string]string) {
+	self.path = path
+	self.pathsBySha = pathsBySha
+}
+
+func (self *FileHistoryViewModel) GetPath() string {
+	return self.path
+}
+
+// returns the path that the file had as of the given commit
+func (self *FileHistoryViewModel) GetPathAt(commit *models.Commit) string {
+	if path, ok := self.pathsBySha[commit.Sha]; ok {
+		return path
+	}
+
+	return self.path
+}
+
+func (self *FileHistoryContext) GetSelectedItemId() string {
+	item := self.GetSelected()
+	if item == nil {
+		return ""
+	}
+
+	return item.ID()
+}
@@ -4,6 +4,7 @@ import (
 	"strings"
 
 	"github.com/jesseduffield/lazygit/pkg/gui/types"
+	"github.com/jesseduffield/lazygit/pkg/theme"
 	"github.com/jesseduffield/lazygit/pkg/utils"
 	"github.com/samber/lo"
 	"golang.org/x/exp/slices"
@@ -87,10 +88,28 @@ func (self *ListRenderer) renderLines(startIdx int, endIdx int) string {
 	lines, columnPositions := utils.RenderDisplayStrings(
 		self.getDisplayStrings(startModelIdx, endModelIdx),
 		columnAlignments)
+	self.highlightSelectedRange(lines, startModelIdx)
 	lines = self.insertNonModelItems(nonModelItems, endIdx, startIdx, lines, columnPositions)
 	return strings.Join(lines, "\n")
 }
 
+// the view only highlights the line the cursor is on, so when a range of lines
+// is selected we highlight the others ourselves
+func (self *ListRenderer) highlightSelectedRange(lines []string, startModelIdx int) {
+	rangeSelect, ok := self.list.(types.IRangeSelect)
+	if !ok || !rangeSelect.IsSelectingRange() {
+		return
+	}
+
+	rangeStart, rangeEnd := rangeSelect.GetSelectionRange()
+	for i := range lines {
+		modelIdx := startModelIdx + i
+		if modelIdx >= rangeStart && modelIdx <= rangeEnd && modelIdx != self.list.GetSelectedLineIdx() {
+			lines[i] = theme.SelectedRangeBgColor.Sprint(utils.Decolorise(lines[i]))
+		}
+	}
+}
+
 func (self *ListRenderer) prepareConversionArrays(nonModelItems []*NonModelItem) {
 	self.numNonModelItems = len(nonModelItems)
 	self.viewIndicesByModelIndex = lo.Range(self.list.Len() + 1)
@@ -32,6 +32,16 @@ func (self *ListViewModel[T]) GetSelected() T {
 	return self.getModel()[self.GetSelectedLineIdx()]
 }
 
+// returns the selected items, which in lists without range select is just the
+// selected item
+func (self *ListViewModel[T]) GetSelectedItems() []T {
+	if self.Len() == 0 {
+		return []T{}
+	}
+
+	return []T{self.GetSelected()}
+}
+
 func (self *ListViewModel[T]) GetItems() []T {
 	return self.getModel()
 }
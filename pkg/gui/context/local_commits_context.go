@@ -1,13 +1,18 @@
 package context
 
 import (
+	"fmt"
 	"log"
+	"strconv"
 	"time"
 
 	"github.com/jesseduffield/lazygit/pkg/commands/models"
 	"github.com/jesseduffield/lazygit/pkg/commands/types/enums"
+	"github.com/jesseduffield/lazygit/pkg/gui/context/traits"
 	"github.com/jesseduffield/lazygit/pkg/gui/presentation"
 	"github.com/jesseduffield/lazygit/pkg/gui/types"
+	"github.com/jesseduffield/lazygit/pkg/utils"
+	"github.com/samber/lo"
 )
 
 type LocalCommitsContext struct {
@@ -39,7 +44,12 @@ func NewLocalCommitsContext(c *ContextCommon) *LocalCommitsContext {
 
 		showYouAreHereLabel := c.Model().WorkingTreeStateAtLastCommitRefresh == enums.REBASE_MODE_REBASING
 		showBranchMarkerForHeadCommit := c.Git().Config.GetRebaseUpdateRefs()
+		ownAuthorEmail := ""
+		if c.UserConfig.Gui.HighlightOwnCommits {
+			ownAuthorEmail = c.Git().Config.GetUserEmail()
+		}
 
+		timeFormat, shortTimeFormat := c.UserConfig.Gui.TimeFormatsFor(c.UserConfig.Gui.ViewTimeFormats.Commits)
 		return presentation.GetCommitListDisplayStrings(
 			c.Common,
 			c.Model().Commits,
@@ -50,8 +60,9 @@ func NewLocalCommitsContext(c *ContextCommon) *LocalCommitsContext {
 			c.Modes().CherryPicking.SelectedShaSet(),
 			c.Modes().Diffing.Ref,
 			c.Modes().MarkedBaseCommit.GetSha(),
-			c.UserConfig.Gui.TimeFormat,
-			c.UserConfig.Gui.ShortTimeFormat,
+			timeFormat,
+			shortTimeFormat,
+			showRelativeDates(c, c.UserConfig.Gui.DateDisplay.Commits),
 			time.Now(),
 			c.UserConfig.Git.ParseEmoji,
 			selectedCommitSha,
@@ -60,9 +71,19 @@ func NewLocalCommitsContext(c *ContextCommon) *LocalCommitsContext {
 			shouldShowGraph(c),
 			c.Model().BisectInfo,
 			showYouAreHereLabel,
+			ownAuthorEmail,
+			c.Model().Linker,
 		)
 	}
 
+	getNonModelItems := func() []*NonModelItem {
+		if !c.UserConfig.Gui.ShowDivergenceMarkers || viewModel.GetShowWholeGitGraph() {
+			return []*NonModelItem{}
+		}
+
+		return getDivergenceMarkers(c, c.Model().Commits)
+	}
+
 	ctx := &LocalCommitsContext{
 		LocalCommitsViewModel: viewModel,
 		SearchTrait:           NewSearchTrait(c),
@@ -78,6 +99,7 @@ func NewLocalCommitsContext(c *ContextCommon) *LocalCommitsContext {
 			ListRenderer: ListRenderer{
 				list:              viewModel,
 				getDisplayStrings: getDisplayStrings,
+				getNonModelItems:  getNonModelItems,
 			},
 			c:                       c,
 			refreshViewportOnChange: true,
@@ -92,6 +114,64 @@ func NewLocalCommitsContext(c *ContextCommon) *LocalCommitsContext {
 	return ctx
 }
 
+// getDivergenceMarkers returns separator lines showing how the checked-out
+// branch relates to its upstream: the number of incoming commits at the top,
+// then a line above the commits that are yet to be pushed, and another above
+// the first commit that has been pushed.
+func getDivergenceMarkers(c *ContextCommon, commits []*models.Commit) []*NonModelItem {
+	branch, ok := lo.Find(c.Model().Branches, func(b *models.Branch) bool { return b.Head })
+	if !ok || !branch.IsTrackingRemote() || branch.UpstreamGone {
+		return []*NonModelItem{}
+	}
+
+	ahead, behind := c.UserConfig.Gui.StatusGlyphs.Ahead, c.UserConfig.Gui.StatusGlyphs.Behind
+	result := []*NonModelItem{}
+	marker := func(index int, text string) {
+		result = append(result, &NonModelItem{Index: index, Content: fmt.Sprintf(c.Tr.ListSectionSeparator, text)})
+	}
+
+	_, firstUnpushedIdx, hasUnpushed := lo.FindIndexOf(commits, func(commit *models.Commit) bool {
+		return commit.Status == models.StatusUnpushed
+	})
+	incoming, _ := strconv.Atoi(branch.Pullables)
+
+	if incoming > 0 {
+		// the todos of an ongoing rebase are listed above the branch's commits,
+		// so the incoming marker goes below them
+		_, firstRealCommitIdx, found := lo.FindIndexOf(commits, func(commit *models.Commit) bool {
+			return !commit.IsTODO()
+		})
+		if !found {
+			firstRealCommitIdx = len(commits)
+		}
+		marker(firstRealCommitIdx, utils.ResolvePlaceholderString(
+			c.Tr.DivergenceMarkerIncoming, map[string]string{
+				"behind":   behind,
+				"count":    branch.Pullables,
+				"upstream": branch.ShortUpstreamRefName(),
+			}))
+	}
+
+	if !hasUnpushed {
+		return result
+	}
+
+	unpushed := lo.CountBy(commits, func(commit *models.Commit) bool {
+		return commit.Status == models.StatusUnpushed
+	})
+	marker(firstUnpushedIdx, utils.ResolvePlaceholderString(
+		c.Tr.DivergenceMarkerToPush, map[string]string{"ahead": ahead, "count": strconv.Itoa(unpushed)}))
+
+	_, firstPushedIdx, hasPushed := lo.FindIndexOf(commits, func(commit *models.Commit) bool {
+		return commit.Status == models.StatusPushed || commit.Status == models.StatusMerged
+	})
+	if hasPushed {
+		marker(firstPushedIdx, c.Tr.DivergenceMarkerPushed)
+	}
+
+	return result
+}
+
 func (self *LocalCommitsContext) GetSelectedItemId() string {
 	item := self.GetSelected()
 	if item == nil {
@@ -103,6 +183,7 @@ func (self *LocalCommitsContext) GetSelectedItemId() string {
 
 type LocalCommitsViewModel struct {
 	*ListViewModel[*models.Commit]
+	*traits.RangeSelect
 
 	// If this is true we limit the amount of commits we load, for the sake of keeping things fast.
 	// If the user attempts to scroll past the end of the list, we will load more commits.
@@ -118,10 +199,21 @@ func NewLocalCommitsViewModel(getModel func() []*models.Commit, c *ContextCommon
 		limitCommits:      true,
 		showWholeGitGraph: c.UserConfig.Git.Log.ShowWholeGraph,
 	}
+	self.RangeSelect = traits.NewRangeSelect(self.ListViewModel)
 
 	return self
 }
 
+// returns the commits in the selected range, newest first
+func (self *LocalCommitsViewModel) GetSelectedItems() []*models.Commit {
+	if self.Len() == 0 {
+		return []*models.Commit{}
+	}
+
+	startIdx, endIdx := self.GetSelectionRange()
+	return self.GetItems()[startIdx : endIdx+1]
+}
+
 func (self *LocalCommitsContext) CanRebase() bool {
 	return true
 }
@@ -160,6 +252,13 @@ func (self *LocalCommitsViewModel) GetCommits() []*models.Commit {
 	return self.getModel()
 }
 
+// showRelativeDates tells us whether a view whose date display is configured
+// with the given value should currently show relative dates, taking into
+// account the runtime toggle
+func showRelativeDates(c *ContextCommon, configValue string) bool {
+	return (configValue == "relative") != c.State().GetRepoState().GetDateDisplayToggled()
+}
+
 func shouldShowGraph(c *ContextCommon) bool {
 	if c.Modes().Filtering.Active() {
 		return false
@@ -127,6 +127,12 @@ func (self *MenuViewModel) GetNonModelItems() []*NonModelItem {
 
 	result := []*NonModelItem{}
 	menuItems := self.FilteredListViewModel.GetItems()
+	// align section titles with the labels, which come after the key column if
+	// there is one
+	column := 0
+	if lo.SomeBy(menuItems, func(item *types.MenuItem) bool { return item.Key != nil }) {
+		column = 1
+	}
 	var prevSection *types.MenuSection = nil
 	for i, menuItem := range menuItems {
 		menuItem := menuItem
@@ -134,14 +140,14 @@ func (self *MenuViewModel) GetNonModelItems() []*NonModelItem {
 			if prevSection != nil {
 				result = append(result, &NonModelItem{
 					Index:   i,
-					Column:  1,
+					Column:  column,
 					Content: "",
 				})
 			}
 
 			result = append(result, &NonModelItem{
 				Index:   i,
-				Column:  1,
+				Column:  column,
 				Content: style.FgGreen.SetBold().Sprintf("--- %s ---", menuItem.Section.Title),
 			})
 			prevSection = menuItem.Section
@@ -181,6 +187,14 @@ func (self *MenuContext) OnMenuPress(selectedItem *types.MenuItem) error {
 		return nil
 	}
 
+	if selectedItem != nil && selectedItem.KeepOpen {
+		if err := selectedItem.OnPress(); err != nil {
+			return err
+		}
+
+		return self.HandleRender()
+	}
+
 	if err := self.c.PopContext(); err != nil {
 		return err
 	}
@@ -123,7 +123,12 @@ func (self *PatchExplorerContext) GetContentToRender(isFocused bool) string {
 		return ""
 	}
 
-	return self.GetState().RenderForLineIndices(isFocused, self.GetIncludedLineIndices())
+	splitWidth := 0
+	if self.c.GetAppState().SplitDiffView {
+		splitWidth = self.GetView().InnerWidth()
+	}
+
+	return self.GetState().RenderForLineIndices(isFocused, self.GetIncludedLineIndices(), splitWidth)
 }
 
 func (self *PatchExplorerContext) NavigateTo(isFocused bool, selectedLineIdx int) error {
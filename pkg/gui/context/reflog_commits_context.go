@@ -1,16 +1,40 @@
 package context
 
 import (
+	"fmt"
 	"time"
 
 	"github.com/jesseduffield/lazygit/pkg/commands/models"
 	"github.com/jesseduffield/lazygit/pkg/gui/presentation"
 	"github.com/jesseduffield/lazygit/pkg/gui/types"
+	"github.com/jesseduffield/lazygit/pkg/utils"
+	"github.com/samber/lo"
 )
 
 type ReflogCommitsContext struct {
 	*FilteredListViewModel[*models.Commit]
 	*ListContextTrait
+
+	dateOptions *reflogDateOptions
+}
+
+type reflogDateOptions struct {
+	// if non-zero, only entries in [from, to) are shown
+	from time.Time
+	to   time.Time
+
+	groupByDay bool
+}
+
+func (self *reflogDateOptions) filter(commits []*models.Commit) []*models.Commit {
+	if self.from.IsZero() {
+		return commits
+	}
+
+	return lo.Filter(commits, func(commit *models.Commit, _ int) bool {
+		date := time.Unix(commit.UnixTimestamp, 0)
+		return !date.Before(self.from) && date.Before(self.to)
+	})
 }
 
 var (
@@ -19,28 +43,56 @@ var (
 )
 
 func NewReflogCommitsContext(c *ContextCommon) *ReflogCommitsContext {
+	dateOptions := &reflogDateOptions{}
 	viewModel := NewFilteredListViewModel(
-		func() []*models.Commit { return c.Model().FilteredReflogCommits },
+		func() []*models.Commit { return dateOptions.filter(c.Model().FilteredReflogCommits) },
 		func(commit *models.Commit) []string {
 			return []string{commit.ShortSha(), commit.Name}
 		},
 	)
 
 	getDisplayStrings := func(_ int, _ int) [][]string {
+		timeFormat, shortTimeFormat := c.UserConfig.Gui.TimeFormatsFor(c.UserConfig.Gui.ViewTimeFormats.Reflog)
 		return presentation.GetReflogCommitListDisplayStrings(
 			viewModel.GetItems(),
 			c.State().GetRepoState().GetScreenMode() != types.SCREEN_NORMAL,
 			c.Modes().CherryPicking.SelectedShaSet(),
 			c.Modes().Diffing.Ref,
 			time.Now(),
-			c.UserConfig.Gui.TimeFormat,
-			c.UserConfig.Gui.ShortTimeFormat,
+			timeFormat,
+			shortTimeFormat,
+			showRelativeDates(c, c.UserConfig.Gui.DateDisplay.Reflog),
 			c.UserConfig.Git.ParseEmoji,
+			c.UserConfig.Gui.CommitHashLength,
 		)
 	}
 
+	getNonModelItems := func() []*NonModelItem {
+		if !dateOptions.groupByDay {
+			return nil
+		}
+
+		now := time.Now()
+		result := []*NonModelItem{}
+		var lastDay time.Time
+		for i, commit := range viewModel.GetItems() {
+			day := utils.StartOfDay(time.Unix(commit.UnixTimestamp, 0))
+			if i > 0 && day.Equal(lastDay) {
+				continue
+			}
+			lastDay = day
+			result = append(result, &NonModelItem{
+				Index:   i,
+				Content: fmt.Sprintf(c.Tr.ListSectionSeparator, formatReflogDay(c, now, day)),
+			})
+		}
+
+		return result
+	}
+
 	return &ReflogCommitsContext{
 		FilteredListViewModel: viewModel,
+		dateOptions:           dateOptions,
 		ListContextTrait: &ListContextTrait{
 			Context: NewSimpleContext(NewBaseContext(NewBaseContextOpts{
 				View:                       c.Views().ReflogCommits,
@@ -53,12 +105,44 @@ func NewReflogCommitsContext(c *ContextCommon) *ReflogCommitsContext {
 			ListRenderer: ListRenderer{
 				list:              viewModel,
 				getDisplayStrings: getDisplayStrings,
+				getNonModelItems:  getNonModelItems,
 			},
 			c: c,
 		},
 	}
 }
 
+func formatReflogDay(c *ContextCommon, now time.Time, day time.Time) string {
+	today := utils.StartOfDay(now)
+	switch {
+	case day.Equal(today):
+		return c.Tr.Today
+	case day.Equal(today.AddDate(0, 0, -1)):
+		return c.Tr.Yesterday
+	default:
+		return day.Format(c.Tr.ReflogDayFormat)
+	}
+}
+
+func (self *ReflogCommitsContext) GroupByDay() bool {
+	return self.dateOptions.groupByDay
+}
+
+func (self *ReflogCommitsContext) SetGroupByDay(value bool) {
+	self.dateOptions.groupByDay = value
+}
+
+// SetDateRange restricts the entries shown to those in [from, to). Pass zero
+// times to show all entries again.
+func (self *ReflogCommitsContext) SetDateRange(from time.Time, to time.Time) {
+	self.dateOptions.from = from
+	self.dateOptions.to = to
+}
+
+func (self *ReflogCommitsContext) IsFilteringByDate() bool {
+	return !self.dateOptions.from.IsZero()
+}
+
 func (self *ReflogCommitsContext) GetSelectedItemId() string {
 	item := self.GetSelected()
 	if item == nil {
@@ -25,7 +25,7 @@ func NewRemotesContext(c *ContextCommon) *RemotesContext {
 	)
 
 	getDisplayStrings := func(_ int, _ int) [][]string {
-		return presentation.GetRemoteListDisplayStrings(viewModel.GetItems(), c.Modes().Diffing.Ref)
+		return presentation.GetRemoteListDisplayStrings(viewModel.GetItems(), c.Modes().Diffing.Ref, c.Tr)
 	}
 
 	return &RemotesContext{
@@ -35,6 +35,7 @@ func NewContextTree(c *ContextCommon) *ContextTree {
 		CommitFiles:    commitFilesContext,
 		ReflogCommits:  NewReflogCommitsContext(c),
 		SubCommits:     NewSubCommitsContext(c),
+		FileHistory:    NewFileHistoryContext(c),
 		Branches:       NewBranchesContext(c),
 		Tags:           NewTagsContext(c),
 		Stash:          NewStashContext(c),
@@ -120,15 +121,7 @@ func NewContextTree(c *ContextCommon) *ContextTree {
 				Focusable:  true,
 			}),
 		),
-		CommandLog: NewSimpleContext(
-			NewBaseContext(NewBaseContextOpts{
-				Kind:       types.EXTRAS_CONTEXT,
-				View:       c.Views().Extras,
-				WindowName: "extras",
-				Key:        COMMAND_LOG_CONTEXT_KEY,
-				Focusable:  true,
-			}),
-		),
+		CommandLog: NewCommandLogContext(c),
 		Snake: NewSimpleContext(
 			NewBaseContext(NewBaseContextOpts{
 				Kind:       types.SIDE_CONTEXT,
@@ -138,12 +131,14 @@ func NewContextTree(c *ContextCommon) *ContextTree {
 				Focusable:  true,
 			}),
 		),
-		Options:       NewDisplayContext(OPTIONS_CONTEXT_KEY, c.Views().Options, "options"),
-		AppStatus:     NewDisplayContext(APP_STATUS_CONTEXT_KEY, c.Views().AppStatus, "appStatus"),
-		SearchPrefix:  NewDisplayContext(SEARCH_PREFIX_CONTEXT_KEY, c.Views().SearchPrefix, "searchPrefix"),
-		Information:   NewDisplayContext(INFORMATION_CONTEXT_KEY, c.Views().Information, "information"),
-		Limit:         NewDisplayContext(LIMIT_CONTEXT_KEY, c.Views().Limit, "limit"),
-		StatusSpacer1: NewDisplayContext(STATUS_SPACER1_CONTEXT_KEY, c.Views().StatusSpacer1, "statusSpacer1"),
-		StatusSpacer2: NewDisplayContext(STATUS_SPACER2_CONTEXT_KEY, c.Views().StatusSpacer2, "statusSpacer2"),
+		Options:        NewDisplayContext(OPTIONS_CONTEXT_KEY, c.Views().Options, "options"),
+		AppStatus:      NewDisplayContext(APP_STATUS_CONTEXT_KEY, c.Views().AppStatus, "appStatus"),
+		SearchPrefix:   NewDisplayContext(SEARCH_PREFIX_CONTEXT_KEY, c.Views().SearchPrefix, "searchPrefix"),
+		Information:    NewDisplayContext(INFORMATION_CONTEXT_KEY, c.Views().Information, "information"),
+		Limit:          NewDisplayContext(LIMIT_CONTEXT_KEY, c.Views().Limit, "limit"),
+		StatusSpacer1:  NewDisplayContext(STATUS_SPACER1_CONTEXT_KEY, c.Views().StatusSpacer1, "statusSpacer1"),
+		StatusSpacer2:  NewDisplayContext(STATUS_SPACER2_CONTEXT_KEY, c.Views().StatusSpacer2, "statusSpacer2"),
+		CommitsMinimap: NewDisplayContext(COMMITS_MINIMAP_CONTEXT_KEY, c.Views().CommitsMinimap, "commitsMinimap"),
+		RebaseProgress: NewDisplayContext(REBASE_PROGRESS_CONTEXT_KEY, c.Views().RebaseProgress, "rebaseProgress"),
 	}
 }
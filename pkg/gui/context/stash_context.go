@@ -1,16 +1,25 @@
 package context
 
 import (
+	"time"
+
 	"github.com/jesseduffield/lazygit/pkg/commands/models"
+	"github.com/jesseduffield/lazygit/pkg/gui/context/traits"
 	"github.com/jesseduffield/lazygit/pkg/gui/presentation"
 	"github.com/jesseduffield/lazygit/pkg/gui/types"
 )
 
 type StashContext struct {
-	*FilteredListViewModel[*models.StashEntry]
+	*StashViewModel
 	*ListContextTrait
 }
 
+// you can select a range of stash entries to drop them all at once
+type StashViewModel struct {
+	*FilteredListViewModel[*models.StashEntry]
+	*traits.RangeSelect
+}
+
 var (
 	_ types.IListContext    = (*StashContext)(nil)
 	_ types.DiffableContext = (*StashContext)(nil)
@@ -19,19 +28,31 @@ var (
 func NewStashContext(
 	c *ContextCommon,
 ) *StashContext {
-	viewModel := NewFilteredListViewModel(
+	filteredListViewModel := NewFilteredListViewModel(
 		func() []*models.StashEntry { return c.Model().StashEntries },
 		func(stashEntry *models.StashEntry) []string {
 			return []string{stashEntry.Name}
 		},
 	)
+	viewModel := &StashViewModel{
+		FilteredListViewModel: filteredListViewModel,
+		RangeSelect:           traits.NewRangeSelect(filteredListViewModel),
+	}
 
 	getDisplayStrings := func(_ int, _ int) [][]string {
-		return presentation.GetStashEntryListDisplayStrings(viewModel.GetItems(), c.Modes().Diffing.Ref)
+		timeFormat, shortTimeFormat := c.UserConfig.Gui.TimeFormatsFor(c.UserConfig.Gui.ViewTimeFormats.Stash)
+		return presentation.GetStashEntryListDisplayStrings(
+			viewModel.GetItems(),
+			c.Modes().Diffing.Ref,
+			time.Now(),
+			timeFormat,
+			shortTimeFormat,
+			showRelativeDates(c, c.UserConfig.Gui.DateDisplay.Stash),
+		)
 	}
 
 	return &StashContext{
-		FilteredListViewModel: viewModel,
+		StashViewModel: viewModel,
 		ListContextTrait: &ListContextTrait{
 			Context: NewSimpleContext(NewBaseContext(NewBaseContextOpts{
 				View:       c.Views().Stash,
@@ -56,6 +56,12 @@ func NewSubCommitsContext(
 			branches = c.Model().Branches
 		}
 		showBranchMarkerForHeadCommit := c.Git().Config.GetRebaseUpdateRefs()
+		ownAuthorEmail := ""
+		if c.UserConfig.Gui.HighlightOwnCommits {
+			ownAuthorEmail = c.Git().Config.GetUserEmail()
+		}
+
+		timeFormat, shortTimeFormat := c.UserConfig.Gui.TimeFormatsFor(c.UserConfig.Gui.ViewTimeFormats.Commits)
 		return presentation.GetCommitListDisplayStrings(
 			c.Common,
 			c.Model().SubCommits,
@@ -66,8 +72,9 @@ func NewSubCommitsContext(
 			c.Modes().CherryPicking.SelectedShaSet(),
 			c.Modes().Diffing.Ref,
 			"",
-			c.UserConfig.Gui.TimeFormat,
-			c.UserConfig.Gui.ShortTimeFormat,
+			timeFormat,
+			shortTimeFormat,
+			showRelativeDates(c, c.UserConfig.Gui.DateDisplay.Commits),
 			time.Now(),
 			c.UserConfig.Git.ParseEmoji,
 			selectedCommitSha,
@@ -78,12 +85,19 @@ func NewSubCommitsContext(
 			shouldShowGraph(c) && viewModel.GetRefToShowDivergenceFrom() == "",
 			git_commands.NewNullBisectInfo(),
 			false,
+			ownAuthorEmail,
+			c.Model().Linker,
 		)
 	}
 
 	getNonModelItems := func() []*NonModelItem {
 		result := []*NonModelItem{}
 		if viewModel.GetRefToShowDivergenceFrom() != "" {
+			localHeader, remoteHeader := c.Tr.DivergenceSectionHeaderLocal, c.Tr.DivergenceSectionHeaderRemote
+			if viewModel.divergenceSectionHeaderLocal != "" {
+				localHeader, remoteHeader = viewModel.divergenceSectionHeaderLocal, viewModel.divergenceSectionHeaderRemote
+			}
+
 			_, upstreamIdx, found := lo.FindIndexOf(
 				c.Model().SubCommits, func(c *models.Commit) bool { return c.Divergence == models.DivergenceRight })
 			if !found {
@@ -91,7 +105,7 @@ func NewSubCommitsContext(
 			}
 			result = append(result, &NonModelItem{
 				Index:   upstreamIdx,
-				Content: fmt.Sprintf("--- %s ---", c.Tr.DivergenceSectionHeaderRemote),
+				Content: fmt.Sprintf("--- %s ---", remoteHeader),
 			})
 
 			_, localIdx, found := lo.FindIndexOf(
@@ -101,7 +115,7 @@ func NewSubCommitsContext(
 			}
 			result = append(result, &NonModelItem{
 				Index:   localIdx,
-				Content: fmt.Sprintf("--- %s ---", c.Tr.DivergenceSectionHeaderLocal),
+				Content: fmt.Sprintf("--- %s ---", localHeader),
 			})
 		}
 
@@ -145,6 +159,10 @@ type SubCommitsViewModel struct {
 	// name of the ref that the sub-commits are shown for
 	ref                     types.Ref
 	refToShowDivergenceFrom string
+	// if set, these replace the 'Local' and 'Remote' headers of the sections of
+	// the divergence view, e.g. when comparing two branches
+	divergenceSectionHeaderLocal  string
+	divergenceSectionHeaderRemote string
 	*ListViewModel[*models.Commit]
 
 	limitCommits    bool
@@ -167,6 +185,11 @@ func (self *SubCommitsViewModel) GetRefToShowDivergenceFrom() string {
 	return self.refToShowDivergenceFrom
 }
 
+func (self *SubCommitsViewModel) SetDivergenceSectionHeaders(local string, remote string) {
+	self.divergenceSectionHeaderLocal = local
+	self.divergenceSectionHeaderRemote = remote
+}
+
 func (self *SubCommitsViewModel) SetShowBranchHeads(value bool) {
 	self.showBranchHeads = value
 }
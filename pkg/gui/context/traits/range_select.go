@@ -0,0 +1,54 @@
+package traits
+
+import (
+	"github.com/jesseduffield/lazygit/pkg/gui/types"
+	"github.com/jesseduffield/lazygit/pkg/utils"
+)
+
+type HasSelectedLine interface {
+	HasLength
+	GetSelectedLineIdx() int
+}
+
+// RangeSelect lets the user select a range of lines in a list rather than a
+// single line. Only lists whose actions can work on several items at once
+// have one.
+type RangeSelect struct {
+	list HasSelectedLine
+
+	// the end of the range that stays put while the selected line moves
+	rangeStartIdx  int
+	selectingRange bool
+}
+
+func NewRangeSelect(list HasSelectedLine) *RangeSelect {
+	return &RangeSelect{list: list}
+}
+
+var _ types.IRangeSelect = (*RangeSelect)(nil)
+
+func (self *RangeSelect) ToggleRangeSelect() {
+	self.selectingRange = !self.selectingRange
+	self.rangeStartIdx = self.list.GetSelectedLineIdx()
+}
+
+func (self *RangeSelect) CancelRangeSelect() {
+	self.selectingRange = false
+}
+
+func (self *RangeSelect) IsSelectingRange() bool {
+	return self.selectingRange
+}
+
+// GetSelectionRange returns the first and last index of the selected lines,
+// which are the same when we're not selecting a range
+func (self *RangeSelect) GetSelectionRange() (int, int) {
+	selectedIdx := self.list.GetSelectedLineIdx()
+	if !self.selectingRange {
+		return selectedIdx, selectedIdx
+	}
+
+	// the list may have shrunk since we started selecting
+	rangeStartIdx := utils.Clamp(self.rangeStartIdx, 0, utils.Max(self.list.Len()-1, 0))
+	return utils.Min(rangeStartIdx, selectedIdx), utils.Max(rangeStartIdx, selectedIdx)
+}
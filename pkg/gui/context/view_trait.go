@@ -1,8 +1,11 @@
 package context
 
 import (
+	"fmt"
+
 	"github.com/jesseduffield/gocui"
 	"github.com/jesseduffield/lazygit/pkg/gui/types"
+	"github.com/jesseduffield/lazygit/pkg/i18n"
 )
 
 const HORIZONTAL_SCROLL_FACTOR = 3
@@ -38,6 +41,29 @@ func (self *ViewTrait) SetFooter(value string) {
 	self.view.Footer = value
 }
 
+// SetScrollPositionFooter sets the footer to show how far the view has been
+// scrolled through its content, e.g. '42%'. This is for views like the main
+// view which have no selected line to report the position of.
+func (self *ViewTrait) SetScrollPositionFooter(tr *i18n.TranslationSet) {
+	self.view.Footer = formatScrollPositionFooter(tr, self.view.OriginY(), self.view.InnerHeight()+1, self.view.ViewLinesHeight())
+}
+
+func formatScrollPositionFooter(tr *i18n.TranslationSet, originY int, height int, totalLines int) string {
+	if totalLines <= height {
+		return ""
+	}
+
+	if originY <= 0 {
+		return tr.ScrollPositionTop
+	}
+
+	if originY+height >= totalLines {
+		return tr.ScrollPositionBottom
+	}
+
+	return fmt.Sprintf("%d%%", originY*100/(totalLines-height))
+}
+
 func (self *ViewTrait) SetOriginX(value int) {
 	_ = self.view.SetOriginX(value)
 }
@@ -0,0 +1,34 @@
+package context
+
+import (
+	"testing"
+
+	"github.com/jesseduffield/lazygit/pkg/i18n"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFormatScrollPositionFooter(t *testing.T) {
+	scenarios := []struct {
+		name       string
+		originY    int
+		height     int
+		totalLines int
+		expected   string
+	}{
+		{"content fits in view", 0, 10, 5, ""},
+		{"content exactly fills view", 0, 10, 10, ""},
+		{"scrolled to top", 0, 10, 100, "Top"},
+		{"scrolled to bottom", 90, 10, 100, "Bot"},
+		{"scrolled past bottom", 95, 10, 100, "Bot"},
+		{"scrolled halfway", 45, 10, 100, "50%"},
+		{"scrolled a little", 1, 10, 5010, "0%"},
+	}
+
+	tr := i18n.EnglishTranslationSet()
+
+	for _, s := range scenarios {
+		t.Run(s.name, func(t *testing.T) {
+			assert.Equal(t, s.expected, formatScrollPositionFooter(&tr, s.originY, s.height, s.totalLines))
+		})
+	}
+}
@@ -11,27 +11,33 @@ import (
 type WorkingTreeContext struct {
 	*filetree.FileTreeViewModel
 	*ListContextTrait
-	*SearchTrait
+	*SearchHistory
 }
 
-var _ types.IListContext = (*WorkingTreeContext)(nil)
+var (
+	_ types.IListContext       = (*WorkingTreeContext)(nil)
+	_ types.IFilterableContext = (*WorkingTreeContext)(nil)
+)
 
 func NewWorkingTreeContext(c *ContextCommon) *WorkingTreeContext {
 	viewModel := filetree.NewFileTreeViewModel(
 		func() []*models.File { return c.Model().Files },
 		c.Log,
 		c.UserConfig.Gui.ShowFileTree,
+		c.UserConfig.Gui.Files.GroupByChangeType,
 	)
 
 	getDisplayStrings := func(_ int, _ int) [][]string {
-		lines := presentation.RenderFileTree(viewModel, c.Modes().Diffing.Ref, c.Model().Submodules)
+		// invalid thresholds are logged when refreshing the files
+		fileSizes, _ := presentation.NewFileSizeOptions(c.UserConfig.Gui.Files)
+		lines := presentation.RenderFileTree(viewModel, c.Modes().Diffing.Ref, c.Model().Submodules, c.UserConfig.Gui.Files.ShowNumstat, fileSizes, c.Tr)
 		return lo.Map(lines, func(line string, _ int) []string {
 			return []string{line}
 		})
 	}
 
 	ctx := &WorkingTreeContext{
-		SearchTrait:       NewSearchTrait(c),
+		SearchHistory:     NewSearchHistory(),
 		FileTreeViewModel: viewModel,
 		ListContextTrait: &ListContextTrait{
 			Context: NewSimpleContext(NewBaseContext(NewBaseContextOpts{
@@ -49,11 +55,6 @@ func NewWorkingTreeContext(c *ContextCommon) *WorkingTreeContext {
 		},
 	}
 
-	ctx.GetView().SetOnSelectItem(ctx.SearchTrait.onSelectItemWrapper(func(selectedLineIdx int) error {
-		ctx.GetList().SetSelectedLineIdx(selectedLineIdx)
-		return ctx.HandleFocus(types.OnFocusOpts{})
-	}))
-
 	return ctx
 }
 
@@ -65,3 +66,26 @@ func (self *WorkingTreeContext) GetSelectedItemId() string {
 
 	return item.ID()
 }
+
+// used for type switch
+func (self *WorkingTreeContext) IsFilterableContext() {}
+
+func (self *WorkingTreeContext) SetFilter(filter string) {
+	self.SetTextFilter(filter)
+}
+
+func (self *WorkingTreeContext) GetFilter() string {
+	return self.GetTextFilter()
+}
+
+func (self *WorkingTreeContext) ClearFilter() {
+	self.SetTextFilter("")
+}
+
+// the filter is applied whenever the tree is built, which happens on every
+// refresh of the files anyway
+func (self *WorkingTreeContext) ReApplyFilter() {}
+
+func (self *WorkingTreeContext) IsFiltering() bool {
+	return self.GetTextFilter() != ""
+}
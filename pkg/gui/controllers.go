@@ -10,6 +10,7 @@ import (
 	"github.com/jesseduffield/lazygit/pkg/gui/services/custom_commands"
 	"github.com/jesseduffield/lazygit/pkg/gui/status"
 	"github.com/jesseduffield/lazygit/pkg/gui/types"
+	"github.com/jesseduffield/lazygit/pkg/i18n"
 )
 
 func (gui *Gui) Helpers() *helpers.Helpers {
@@ -20,11 +21,12 @@ func (gui *Gui) resetHelpersAndControllers() {
 	helperCommon := gui.c
 	recordDirectoryHelper := helpers.NewRecordDirectoryHelper(helperCommon)
 	reposHelper := helpers.NewRecentReposHelper(helperCommon, recordDirectoryHelper, gui.onNewRepo)
-	refsHelper := helpers.NewRefsHelper(helperCommon)
+	dryRunHelper := helpers.NewDryRunHelper(helperCommon)
+	refsHelper := helpers.NewRefsHelper(helperCommon, dryRunHelper)
 	suggestionsHelper := helpers.NewSuggestionsHelper(helperCommon)
 	worktreeHelper := helpers.NewWorktreeHelper(helperCommon, reposHelper, refsHelper, suggestionsHelper)
 
-	rebaseHelper := helpers.NewMergeAndRebaseHelper(helperCommon, refsHelper)
+	rebaseHelper := helpers.NewMergeAndRebaseHelper(helperCommon, refsHelper, suggestionsHelper)
 
 	setCommitSummary := gui.getCommitMessageSetTextareaTextFn(func() *gocui.View { return gui.Views.CommitMessage })
 	setCommitDescription := gui.getCommitMessageSetTextareaTextFn(func() *gocui.View { return gui.Views.CommitDescription })
@@ -48,6 +50,7 @@ func (gui *Gui) resetHelpersAndControllers() {
 	stagingHelper := helpers.NewStagingHelper(helperCommon)
 	mergeConflictsHelper := helpers.NewMergeConflictsHelper(helperCommon)
 	searchHelper := helpers.NewSearchHelper(helperCommon)
+	tagsHelper := helpers.NewTagsHelper(helperCommon, commitsHelper)
 
 	refreshHelper := helpers.NewRefreshHelper(
 		helperCommon,
@@ -58,6 +61,7 @@ func (gui *Gui) resetHelpersAndControllers() {
 		mergeConflictsHelper,
 		worktreeHelper,
 		searchHelper,
+		tagsHelper,
 	)
 	diffHelper := helpers.NewDiffHelper(helperCommon)
 	cherryPickHelper := helpers.NewCherryPickHelper(
@@ -65,6 +69,8 @@ func (gui *Gui) resetHelpersAndControllers() {
 		rebaseHelper,
 	)
 	bisectHelper := helpers.NewBisectHelper(helperCommon)
+	fileTreeStateHelper := helpers.NewFileTreeStateHelper(helperCommon)
+	actionConfirmationHelper := helpers.NewActionConfirmationHelper(helperCommon)
 	windowHelper := helpers.NewWindowHelper(helperCommon, viewHelper)
 	modeHelper := helpers.NewModeHelper(
 		helperCommon,
@@ -95,8 +101,8 @@ func (gui *Gui) resetHelpersAndControllers() {
 		Suggestions:     suggestionsHelper,
 		Files:           helpers.NewFilesHelper(helperCommon),
 		WorkingTree:     helpers.NewWorkingTreeHelper(helperCommon, refsHelper, commitsHelper, gpgHelper),
-		Tags:            helpers.NewTagsHelper(helperCommon, commitsHelper),
-		BranchesHelper:  helpers.NewBranchesHelper(helperCommon),
+		Tags:            tagsHelper,
+		BranchesHelper:  helpers.NewBranchesHelper(helperCommon, actionConfirmationHelper, dryRunHelper),
 		GPG:             helpers.NewGpgHelper(helperCommon),
 		MergeAndRebase:  rebaseHelper,
 		MergeConflicts:  mergeConflictsHelper,
@@ -126,6 +132,24 @@ func (gui *Gui) resetHelpersAndControllers() {
 		Search:     searchHelper,
 		Worktree:   worktreeHelper,
 		SubCommits: helpers.NewSubCommitsHelper(helperCommon, refreshHelper, setSubCommits),
+
+		CommitPeek:          helpers.NewCommitPeekHelper(helperCommon),
+		CommitsMinimap:      helpers.NewCommitsMinimapHelper(helperCommon, windowHelper),
+		RebaseProgress:      helpers.NewRebaseProgressHelper(helperCommon),
+		SessionState:        helpers.NewSessionStateHelper(helperCommon, fileTreeStateHelper),
+		KeybindingConflicts: helpers.NewKeybindingConflictsHelper(helperCommon),
+		PresenterMode:       helpers.NewPresenterModeHelper(helperCommon),
+		Language: helpers.NewLanguageHelper(
+			helperCommon,
+			i18n.UserTranslationsDir(gui.Config.GetUserConfigDir()),
+			gui.Language,
+			gui.onLanguageChanged,
+		),
+		GoneBranches:       helpers.NewGoneBranchesHelper(helperCommon, refsHelper, dryRunHelper),
+		FileHistory:        helpers.NewFileHistoryHelper(helperCommon, refreshHelper),
+		DryRun:             dryRunHelper,
+		FileTreeState:      fileTreeStateHelper,
+		ActionConfirmation: actionConfirmationHelper,
 	}
 
 	gui.CustomCommandsClient = custom_commands.NewClient(
@@ -182,6 +206,7 @@ func (gui *Gui) resetHelpersAndControllers() {
 	snakeController := controllers.NewSnakeController(common)
 	reflogCommitsController := controllers.NewReflogCommitsController(common)
 	subCommitsController := controllers.NewSubCommitsController(common)
+	fileHistoryController := controllers.NewFileHistoryController(common)
 	statusController := controllers.NewStatusController(common)
 	commandLogController := controllers.NewCommandLogController(common)
 	confirmationController := controllers.NewConfirmationController(common)
@@ -214,6 +239,7 @@ func (gui *Gui) resetHelpersAndControllers() {
 		gui.State.Contexts.LocalCommits,
 		gui.State.Contexts.CommitFiles,
 		gui.State.Contexts.SubCommits,
+		gui.State.Contexts.FileHistory,
 		gui.State.Contexts.Stash,
 	} {
 		controllers.AttachControllers(context, sideWindowControllerFactory.Create(context))
@@ -268,6 +294,10 @@ func (gui *Gui) resetHelpersAndControllers() {
 		subCommitsController,
 	)
 
+	controllers.AttachControllers(gui.State.Contexts.FileHistory,
+		fileHistoryController,
+	)
+
 	// TODO: add scroll controllers for main panels (need to bring some more functionality across for that e.g. reading more from the currently displayed git command)
 	controllers.AttachControllers(gui.State.Contexts.Staging,
 		stagingController,
@@ -5,7 +5,10 @@ import (
 
 	"github.com/jesseduffield/lazygit/pkg/commands/git_commands"
 	"github.com/jesseduffield/lazygit/pkg/commands/models"
+	"github.com/jesseduffield/lazygit/pkg/commands/types/enums"
 	"github.com/jesseduffield/lazygit/pkg/gui/types"
+	"github.com/jesseduffield/lazygit/pkg/utils"
+	"github.com/samber/lo"
 )
 
 // This controller is for all contexts that contain a list of commits.
@@ -51,6 +54,12 @@ func (self *BasicCommitsController) GetKeybindings(opts types.KeybindingsOpts) [
 			Handler:     self.checkSelected(self.openInBrowser),
 			Description: self.c.Tr.OpenCommitInBrowser,
 		},
+		{
+			Key:         opts.GetKey(opts.Config.Commits.OpenLink),
+			Handler:     self.checkSelected(self.openLink),
+			Description: self.c.Tr.OpenLink,
+			Tooltip:     self.c.Tr.OpenLinkTooltip,
+		},
 		{
 			Key:         opts.GetKey(opts.Config.Universal.New),
 			Handler:     self.checkSelected(self.newBranch),
@@ -62,6 +71,14 @@ func (self *BasicCommitsController) GetKeybindings(opts types.KeybindingsOpts) [
 			Description: self.c.Tr.ViewResetOptions,
 			OpensMenu:   true,
 		},
+		{
+			Key:               opts.GetKey(opts.Config.Commits.RebaseOntoCommit),
+			Handler:           opts.Guards.OutsideFilterMode(self.checkSelected(self.rebaseOnto)),
+			GetDisabledReason: self.getDisabledReasonForRebaseOnto,
+			Description:       self.c.Tr.RebaseOntoCommit,
+			Tooltip:           self.c.Tr.RebaseOntoCommitTooltip,
+			OpensMenu:         true,
+		},
 		{
 			Key:         opts.GetKey(opts.Config.Commits.CherryPickCopy),
 			Handler:     self.checkSelected(self.copy),
@@ -77,6 +94,13 @@ func (self *BasicCommitsController) GetKeybindings(opts types.KeybindingsOpts) [
 			Handler:     self.c.Helpers().CherryPick.Reset,
 			Description: self.c.Tr.ResetCherryPick,
 		},
+		{
+			Key:         opts.GetKey(opts.Config.Commits.ShowContainingRefs),
+			Handler:     self.checkSelected(self.showContainingRefs),
+			Description: self.c.Tr.ShowContainingRefs,
+			Tooltip:     self.c.Tr.ShowContainingRefsTooltip,
+			OpensMenu:   true,
+		},
 		{
 			Key:         opts.GetKey(opts.Config.Universal.OpenDiffTool),
 			Handler:     self.checkSelected(self.openDiffTool),
@@ -109,8 +133,22 @@ func (self *BasicCommitsController) copyCommitAttribute(commit *models.Commit) e
 			{
 				Label: self.c.Tr.CommitSha,
 				OnPress: func() error {
-					return self.copyCommitSHAToClipboard(commit)
+					return self.copyCommitSHAToClipboard(commit.Sha)
+				},
+			},
+			{
+				Label: self.c.Tr.AbbreviatedCommitSha,
+				OnPress: func() error {
+					return self.copyCommitSHAToClipboard(self.abbreviatedSha(commit))
+				},
+				Key: 'h',
+			},
+			{
+				Label: self.c.Tr.CommitReference,
+				OnPress: func() error {
+					return self.copyCommitReferenceToClipboard(commit)
 				},
+				Key: 'r',
 			},
 			{
 				Label: self.c.Tr.CommitSubject,
@@ -151,9 +189,9 @@ func (self *BasicCommitsController) copyCommitAttribute(commit *models.Commit) e
 	})
 }
 
-func (self *BasicCommitsController) copyCommitSHAToClipboard(commit *models.Commit) error {
+func (self *BasicCommitsController) copyCommitSHAToClipboard(sha string) error {
 	self.c.LogAction(self.c.Tr.Actions.CopyCommitSHAToClipboard)
-	if err := self.c.OS().CopyToClipboard(commit.Sha); err != nil {
+	if err := self.c.OS().CopyToClipboard(sha); err != nil {
 		return self.c.Error(err)
 	}
 
@@ -161,6 +199,24 @@ func (self *BasicCommitsController) copyCommitSHAToClipboard(commit *models.Comm
 	return nil
 }
 
+// copies the commit in the format commonly used to refer to other commits in
+// commit messages, e.g. 'abcd1234 ("Fix the thing")'
+func (self *BasicCommitsController) copyCommitReferenceToClipboard(commit *models.Commit) error {
+	reference := fmt.Sprintf("%s (\"%s\")", self.abbreviatedSha(commit), commit.Name)
+
+	self.c.LogAction(self.c.Tr.Actions.CopyCommitReferenceToClipboard)
+	if err := self.c.OS().CopyToClipboard(reference); err != nil {
+		return self.c.Error(err)
+	}
+
+	self.c.Toast(self.c.Tr.CommitReferenceCopiedToClipboard)
+	return nil
+}
+
+func (self *BasicCommitsController) abbreviatedSha(commit *models.Commit) string {
+	return utils.ShortShaOfLength(commit.Sha, self.c.UserConfig.Gui.CommitHashLength)
+}
+
 func (self *BasicCommitsController) copyCommitURLToClipboard(commit *models.Commit) error {
 	url, err := self.c.Helpers().Host.GetCommitURL(commit.Sha)
 	if err != nil {
@@ -252,6 +308,31 @@ func (self *BasicCommitsController) openInBrowser(commit *models.Commit) error {
 	return nil
 }
 
+func (self *BasicCommitsController) openLink(commit *models.Commit) error {
+	message, err := self.c.Git().Commit.GetCommitMessage(commit.Sha)
+	if err != nil {
+		return self.c.Error(err)
+	}
+
+	return (&OpenLinkAction{c: self.c}).Call(message)
+}
+
+func (self *BasicCommitsController) rebaseOnto(commit *models.Commit) error {
+	return self.c.Helpers().MergeAndRebase.RebaseOntoCommit(commit.Sha)
+}
+
+func (self *BasicCommitsController) getDisabledReasonForRebaseOnto() *types.DisabledReason {
+	if self.c.Model().WorkingTreeStateAtLastCommitRefresh != enums.REBASE_MODE_NONE {
+		return &types.DisabledReason{Text: self.c.Tr.AlreadyRebasing}
+	}
+
+	if commit := self.context.GetSelected(); commit != nil && commit.IsTODO() {
+		return &types.DisabledReason{Text: self.c.Tr.CannotRebaseOntoTodoCommit}
+	}
+
+	return nil
+}
+
 func (self *BasicCommitsController) newBranch(commit *models.Commit) error {
 	return self.c.Helpers().Refs.NewBranch(commit.RefName(), commit.Description(), "")
 }
@@ -279,6 +360,88 @@ func (self *BasicCommitsController) copyRange(*models.Commit) error {
 	return self.c.Helpers().CherryPick.CopyRange(self.context.GetSelectedLineIdx(), self.context.GetCommits(), self.context)
 }
 
+func (self *BasicCommitsController) showContainingRefs(commit *models.Commit) error {
+	refs, err := self.c.Git().Commit.GetContainingRefs(commit.Sha)
+	if err != nil {
+		return self.c.Error(err)
+	}
+
+	// jumping to a ref works the same way as picking it in the fuzzy finder
+	jump := &FuzzyFinderAction{c: self.c}
+
+	menuItems := []*types.MenuItem{}
+
+	localBranchesSection := &types.MenuSection{Title: self.c.Tr.LocalBranchesTitle}
+	for _, name := range refs.LocalBranches {
+		branch, ok := lo.Find(self.c.Model().Branches, func(b *models.Branch) bool { return b.Name == name })
+		menuItems = append(menuItems, &types.MenuItem{
+			Label:   name,
+			Section: localBranchesSection,
+			OnPress: func() error {
+				if !ok {
+					return nil
+				}
+				return jump.selectBranch(branch)
+			},
+		})
+	}
+
+	remoteBranchesSection := &types.MenuSection{Title: self.c.Tr.RemoteBranchesTitle}
+	for _, name := range refs.RemoteBranches {
+		remote, branch, ok := self.findRemoteBranch(name)
+		menuItems = append(menuItems, &types.MenuItem{
+			Label:   name,
+			Section: remoteBranchesSection,
+			OnPress: func() error {
+				if !ok {
+					return nil
+				}
+				return jump.selectRemoteBranch(remote, branch)
+			},
+		})
+	}
+
+	tagsSection := &types.MenuSection{Title: self.c.Tr.TagsTitle}
+	for _, name := range refs.Tags {
+		tag, ok := lo.Find(self.c.Model().Tags, func(t *models.Tag) bool { return t.Name == name })
+		menuItems = append(menuItems, &types.MenuItem{
+			Label:   name,
+			Section: tagsSection,
+			OnPress: func() error {
+				if !ok {
+					return nil
+				}
+				return jump.selectTag(tag)
+			},
+		})
+	}
+
+	if len(menuItems) == 0 {
+		return self.c.ErrorMsg(self.c.Tr.NoRefsContainCommit)
+	}
+
+	return self.c.Menu(types.CreateMenuOptions{
+		Title: utils.ResolvePlaceholderString(self.c.Tr.ContainingRefsTitle, map[string]string{
+			"sha": self.abbreviatedSha(commit),
+		}),
+		Items: menuItems,
+	})
+}
+
+// remote names may themselves contain slashes, so rather than splitting the
+// name we look for the remote branch whose full name matches
+func (self *BasicCommitsController) findRemoteBranch(fullName string) (*models.Remote, *models.RemoteBranch, bool) {
+	for _, remote := range self.c.Model().Remotes {
+		for _, branch := range remote.Branches {
+			if branch.FullName() == fullName {
+				return remote, branch, true
+			}
+		}
+	}
+
+	return nil, nil, false
+}
+
 func (self *BasicCommitsController) openDiffTool(commit *models.Commit) error {
 	to := commit.RefName()
 	from, reverse := self.c.Modes().Diffing.GetFromAndReverseArgsForDiff(commit.ParentRefName())
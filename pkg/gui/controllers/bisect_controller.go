@@ -111,7 +111,22 @@ func (self *BisectController) openMidBisectMenu(info *git_commands.BisectInfo, c
 			Key: 's',
 		},
 	}
-	if info.GetCurrentSha() != "" && info.GetCurrentSha() != commit.Sha {
+	if self.context().IsSelectingRange() {
+		selectedShas := lo.Map(self.selectedCommits(), func(commit *models.Commit, _ int) string { return commit.Sha })
+		menuItems = append(menuItems, lo.ToPtr(types.MenuItem{
+			Label: fmt.Sprintf(self.c.Tr.Bisect.SkipSelectedRange, len(selectedShas)),
+			OnPress: func() error {
+				self.c.LogAction(self.c.Tr.Actions.BisectSkip)
+				if err := self.c.Git().Bisect.SkipMany(selectedShas); err != nil {
+					return self.c.Error(err)
+				}
+
+				self.context().CancelRangeSelect()
+				return self.afterMark(selectCurrentAfter, waitToReselect)
+			},
+			Key: 'S',
+		}))
+	} else if info.GetCurrentSha() != "" && info.GetCurrentSha() != commit.Sha {
 		menuItems = append(menuItems, lo.ToPtr(types.MenuItem{
 			Label: fmt.Sprintf(self.c.Tr.Bisect.SkipSelected, commit.ShortSha()),
 			OnPress: func() error {
@@ -125,6 +140,12 @@ func (self *BisectController) openMidBisectMenu(info *git_commands.BisectInfo, c
 			Key: 'S',
 		}))
 	}
+	menuItems = append(menuItems, lo.ToPtr(types.MenuItem{
+		Label:   self.c.Tr.Bisect.ExportLog,
+		OnPress: self.exportLog,
+		Key:     'e',
+		Tooltip: self.c.Tr.Bisect.ExportLogTooltip,
+	}))
 	menuItems = append(menuItems, lo.ToPtr(types.MenuItem{
 		Label: self.c.Tr.Bisect.ResetOption,
 		OnPress: func() error {
@@ -189,7 +210,13 @@ func (self *BisectController) openStartBisectMenu(info *git_commands.BisectInfo,
 										return self.c.Error(err)
 									}
 
-									return self.c.Helpers().Bisect.PostBisectCommandRefresh()
+									if err := self.c.Helpers().Bisect.PostBisectCommandRefresh(); err != nil {
+										return err
+									}
+
+									// Go straight on to marking the selected commit, so
+									// that the user sees their new terms in action
+									return self.openMenu(commit)
 								},
 							})
 						},
@@ -197,6 +224,48 @@ func (self *BisectController) openStartBisectMenu(info *git_commands.BisectInfo,
 				},
 				Key: 't',
 			},
+			{
+				Label:   self.c.Tr.Bisect.ReplayLog,
+				OnPress: self.replayLog,
+				Key:     'p',
+				Tooltip: self.c.Tr.Bisect.ReplayLogTooltip,
+			},
+		},
+	})
+}
+
+func (self *BisectController) exportLog() error {
+	return self.c.Prompt(types.PromptOpts{
+		Title:               self.c.Tr.Bisect.ExportLogPrompt,
+		FindSuggestionsFunc: self.c.Helpers().Suggestions.GetFilePathSuggestionsFunc(),
+		HandleConfirm: func(path string) error {
+			log, err := self.c.Git().Bisect.GetLog()
+			if err != nil {
+				return self.c.Error(err)
+			}
+
+			self.c.LogAction(self.c.Tr.Actions.ExportBisectLog)
+			if err := self.c.OS().CreateFileWithContent(path, log); err != nil {
+				return self.c.Error(err)
+			}
+
+			self.c.Toast(self.c.Tr.Bisect.ExportedLogToast)
+			return nil
+		},
+	})
+}
+
+func (self *BisectController) replayLog() error {
+	return self.c.Prompt(types.PromptOpts{
+		Title:               self.c.Tr.Bisect.ReplayLogPrompt,
+		FindSuggestionsFunc: self.c.Helpers().Suggestions.GetFilePathSuggestionsFunc(),
+		HandleConfirm: func(path string) error {
+			self.c.LogAction(self.c.Tr.Actions.ReplayBisectLog)
+			if err := self.c.Git().Bisect.Replay(path); err != nil {
+				return self.c.Error(err)
+			}
+
+			return self.afterMark(true, !self.c.Git().Bisect.ReachableFromStart(self.c.Git().Bisect.GetInfo()))
 		},
 	})
 }
@@ -273,6 +342,16 @@ func (self *BisectController) selectCurrentBisectCommit() {
 	}
 }
 
+func (self *BisectController) selectedCommits() []*models.Commit {
+	startIdx, endIdx := self.context().GetSelectionRange()
+	commits := self.c.Model().Commits
+	if startIdx < 0 || endIdx >= len(commits) {
+		return nil
+	}
+
+	return commits[startIdx : endIdx+1]
+}
+
 func (self *BisectController) checkSelected(callback func(*models.Commit) error) func() error {
 	return func() error {
 		commit := self.context().GetSelected()
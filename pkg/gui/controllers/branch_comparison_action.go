@@ -0,0 +1,233 @@
+package controllers
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/jesseduffield/gocui"
+	"github.com/jesseduffield/lazygit/pkg/commands/git_commands"
+	"github.com/jesseduffield/lazygit/pkg/commands/models"
+	"github.com/jesseduffield/lazygit/pkg/gui/controllers/helpers"
+	"github.com/jesseduffield/lazygit/pkg/gui/style"
+	"github.com/jesseduffield/lazygit/pkg/gui/types"
+	"github.com/jesseduffield/lazygit/pkg/utils"
+	"github.com/samber/lo"
+)
+
+// BranchComparisonAction compares a branch with another ref, showing the
+// commits only on either side, the files both of them changed and whether
+// merging them would conflict, along with actions for bringing them together
+type BranchComparisonAction struct {
+	c *ControllerCommon
+}
+
+// how many commits and files we list in the tooltips
+const branchComparisonListLimit = 10
+
+type branchComparison struct {
+	otherRef         string
+	commits          []*git_commands.MissingCommit
+	otherCommits     []*git_commands.MissingCommit
+	overlappingFiles []string
+	conflictingFiles []string
+	// false if we couldn't tell whether merging would conflict
+	conflictsKnown bool
+}
+
+func (self *BranchComparisonAction) Call(branch *models.Branch) error {
+	initialContent := ""
+	if checkedOutRef := self.c.Helpers().Refs.GetCheckedOutRef(); checkedOutRef != nil && checkedOutRef.Name != branch.Name {
+		initialContent = checkedOutRef.Name
+	}
+
+	return self.c.Prompt(types.PromptOpts{
+		Title: utils.ResolvePlaceholderString(self.c.Tr.CompareBranchWith, map[string]string{
+			"branch": branch.Name,
+		}),
+		InitialContent:      initialContent,
+		FindSuggestionsFunc: self.c.Helpers().Suggestions.GetRefsSuggestionsFunc(),
+		HandleConfirm: func(response string) error {
+			otherRef := strings.TrimSpace(response)
+			if otherRef == "" {
+				return nil
+			}
+			if otherRef == branch.Name {
+				return self.c.ErrorMsg(self.c.Tr.CantCompareBranchWithItself)
+			}
+
+			return self.c.WithWaitingStatus(self.c.Tr.ComparingBranchesStatus, func(gocui.Task) error {
+				comparison, err := self.compare(branch, otherRef)
+				if err != nil {
+					return self.c.Error(err)
+				}
+
+				self.c.OnUIThread(func() error {
+					return self.showMenu(branch, comparison)
+				})
+				return nil
+			})
+		},
+	})
+}
+
+func (self *BranchComparisonAction) compare(branch *models.Branch, otherRef string) (*branchComparison, error) {
+	ref := branch.FullRefName()
+
+	commits, err := self.c.Git().Branch.GetCommitsMissingFrom(ref, otherRef)
+	if err != nil {
+		return nil, err
+	}
+
+	otherCommits, err := self.c.Git().Branch.GetCommitsMissingFrom(otherRef, ref)
+	if err != nil {
+		return nil, err
+	}
+
+	changedFiles, err := self.c.Git().Branch.GetFilesChangedSinceMergeBase(ref, otherRef)
+	if err != nil {
+		return nil, err
+	}
+
+	otherChangedFiles, err := self.c.Git().Branch.GetFilesChangedSinceMergeBase(otherRef, ref)
+	if err != nil {
+		return nil, err
+	}
+
+	conflictingFiles, conflictsKnown := self.c.Git().Branch.GetMergeConflicts(ref, otherRef)
+
+	return &branchComparison{
+		otherRef:     otherRef,
+		commits:      commits,
+		otherCommits: otherCommits,
+		overlappingFiles: lo.Filter(changedFiles, func(file string, _ int) bool {
+			return lo.Contains(otherChangedFiles, file)
+		}),
+		conflictingFiles: conflictingFiles,
+		conflictsKnown:   conflictsKnown,
+	}, nil
+}
+
+func (self *BranchComparisonAction) showMenu(branch *models.Branch, comparison *branchComparison) error {
+	summarySection := &types.MenuSection{Title: self.c.Tr.BranchComparisonSummary}
+	actionsSection := &types.MenuSection{Title: self.c.Tr.BranchComparisonActions}
+
+	// the summary is just for showing, so pressing its items does nothing
+	summaryItem := func(label string, value string, valueStyle style.TextStyle, tooltip string) *types.MenuItem {
+		return &types.MenuItem{
+			LabelColumns: []string{label, valueStyle.Sprint(value)},
+			OnPress:      func() error { return nil },
+			KeepOpen:     true,
+			Tooltip:      tooltip,
+			Section:      summarySection,
+		}
+	}
+
+	commitsItem := func(ref string, commits []*git_commands.MissingCommit) *types.MenuItem {
+		label := utils.ResolvePlaceholderString(self.c.Tr.CommitsOnlyOn, map[string]string{"ref": ref})
+		return summaryItem(label, strconv.Itoa(len(commits)), style.FgCyan,
+			self.list(lo.Map(commits, func(commit *git_commands.MissingCommit, _ int) string {
+				return commit.Hash + " " + commit.Subject
+			})))
+	}
+
+	conflictsItem := summaryItem(self.c.Tr.MergeConflicts, self.c.Tr.MergeConflictsUnknown, style.FgYellow,
+		self.c.Tr.MergeConflictsUnknownTooltip)
+	if comparison.conflictsKnown {
+		conflictsItem = summaryItem(self.c.Tr.MergeConflicts, strconv.Itoa(len(comparison.conflictingFiles)),
+			lo.Ternary(len(comparison.conflictingFiles) == 0, style.FgGreen, style.FgRed),
+			self.c.Tr.MergeConflictsTooltip+self.list(comparison.conflictingFiles))
+	}
+
+	menuItems := []*types.MenuItem{
+		commitsItem(branch.Name, comparison.commits),
+		commitsItem(comparison.otherRef, comparison.otherCommits),
+		summaryItem(self.c.Tr.FilesChangedOnBothSides, strconv.Itoa(len(comparison.overlappingFiles)),
+			lo.Ternary(len(comparison.overlappingFiles) == 0, style.FgGreen, style.FgYellow),
+			self.c.Tr.FilesChangedOnBothSidesTooltip+self.list(comparison.overlappingFiles)),
+		conflictsItem,
+		{
+			Label:   self.c.Tr.ViewComparedCommits,
+			Tooltip: self.c.Tr.ViewComparedCommitsTooltip,
+			OnPress: func() error {
+				return self.c.Helpers().SubCommits.ViewSubCommits(helpers.ViewSubCommitsOpts{
+					Ref:                           branch,
+					RefToShowDivergenceFrom:       comparison.otherRef,
+					DivergenceSectionHeaderLocal:  branch.Name,
+					DivergenceSectionHeaderRemote: comparison.otherRef,
+					TitleRef:                      fmt.Sprintf("%s <-> %s", branch.Name, comparison.otherRef),
+					Context:                       self.c.Contexts().Branches,
+					ShowBranchHeads:               false,
+				})
+			},
+			Key:     'v',
+			Section: actionsSection,
+		},
+	}
+
+	// merging and rebasing work on the checked-out branch, so one of the two
+	// has to be it
+	checkedOutBranch := ""
+	if checkedOutRef := self.c.Helpers().Refs.GetCheckedOutRef(); checkedOutRef != nil {
+		checkedOutBranch = checkedOutRef.Name
+	}
+	otherSide := ""
+	switch checkedOutBranch {
+	case branch.Name:
+		otherSide = comparison.otherRef
+	case comparison.otherRef:
+		otherSide = branch.Name
+	}
+
+	var disabledReason *types.DisabledReason
+	if otherSide == "" {
+		disabledReason = &types.DisabledReason{Text: self.c.Tr.CheckOutComparedBranchFirst}
+		checkedOutBranch, otherSide = comparison.otherRef, branch.Name
+	}
+	placeholders := map[string]string{"ref": otherSide, "checkedOutBranch": checkedOutBranch}
+
+	menuItems = append(menuItems,
+		&types.MenuItem{
+			Label: utils.ResolvePlaceholderString(self.c.Tr.MergeRefIntoCheckedOutBranch, placeholders),
+			OnPress: func() error {
+				return self.c.Helpers().MergeAndRebase.MergeRefIntoCheckedOutBranch(otherSide)
+			},
+			DisabledReason: disabledReason,
+			Key:            'm',
+			Section:        actionsSection,
+		},
+		&types.MenuItem{
+			Label: utils.ResolvePlaceholderString(self.c.Tr.RebaseCheckedOutBranchOntoRef, placeholders),
+			OnPress: func() error {
+				return self.c.Helpers().MergeAndRebase.RebaseOntoRef(otherSide)
+			},
+			DisabledReason: disabledReason,
+			Key:            'r',
+			Section:        actionsSection,
+		},
+	)
+
+	return self.c.Menu(types.CreateMenuOptions{
+		Title: utils.ResolvePlaceholderString(self.c.Tr.BranchComparisonTitle, map[string]string{
+			"branch":   branch.Name,
+			"otherRef": comparison.otherRef,
+		}),
+		Items: menuItems,
+	})
+}
+
+// lists the first few of the items, to be appended to a tooltip
+func (self *BranchComparisonAction) list(items []string) string {
+	if len(items) == 0 {
+		return ""
+	}
+
+	lines := lo.Map(items[:utils.Min(len(items), branchComparisonListLimit)], func(item string, _ int) string {
+		return "- " + item
+	})
+	if len(items) > branchComparisonListLimit {
+		lines = append(lines, fmt.Sprintf("… (%d more)", len(items)-branchComparisonListLimit))
+	}
+
+	return "\n\n" + strings.Join(lines, "\n")
+}
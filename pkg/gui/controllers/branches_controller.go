@@ -8,6 +8,8 @@ import (
 	"github.com/jesseduffield/gocui"
 	"github.com/jesseduffield/lazygit/pkg/commands/git_commands"
 	"github.com/jesseduffield/lazygit/pkg/commands/models"
+	"github.com/jesseduffield/lazygit/pkg/commands/oscommands"
+	"github.com/jesseduffield/lazygit/pkg/config"
 	"github.com/jesseduffield/lazygit/pkg/gui/context"
 	"github.com/jesseduffield/lazygit/pkg/gui/controllers/helpers"
 	"github.com/jesseduffield/lazygit/pkg/gui/types"
@@ -55,6 +57,12 @@ func (self *BranchesController) GetKeybindings(opts types.KeybindingsOpts) []*ty
 			Description: self.c.Tr.CreatePullRequestOptions,
 			OpensMenu:   true,
 		},
+		{
+			Key:         opts.GetKey(opts.Config.Branches.OpenLink),
+			Handler:     self.checkSelected(self.openLink),
+			Description: self.c.Tr.OpenLink,
+			Tooltip:     self.c.Tr.OpenLinkTooltip,
+		},
 		{
 			Key:         opts.GetKey(opts.Config.Branches.CopyPullRequestURL),
 			Handler:     self.copyPullRequestURL,
@@ -109,6 +117,20 @@ func (self *BranchesController) GetKeybindings(opts types.KeybindingsOpts) []*ty
 			Description: self.c.Tr.ViewResetOptions,
 			OpensMenu:   true,
 		},
+		{
+			Key:         opts.GetKey(opts.Config.Branches.CleanUpGoneBranches),
+			Handler:     self.c.Helpers().GoneBranches.CleanUp,
+			Description: self.c.Tr.CleanUpGoneBranches,
+			Tooltip:     self.c.Tr.CleanUpGoneBranchesTooltip,
+			OpensMenu:   true,
+		},
+		{
+			Key:         opts.GetKey(opts.Config.Branches.CompareBranch),
+			Handler:     self.checkSelected(self.compareBranch),
+			Description: self.c.Tr.CompareBranch,
+			Tooltip:     self.c.Tr.CompareBranchTooltip,
+			OpensMenu:   true,
+		},
 		{
 			Key:         opts.GetKey(opts.Config.Branches.RenameBranch),
 			Handler:     self.checkSelectedAndReal(self.rename),
@@ -346,6 +368,10 @@ func (self *BranchesController) handleCreatePullRequest(selectedBranch *models.B
 	return self.createPullRequest(selectedBranch.UpstreamBranch, "")
 }
 
+func (self *BranchesController) openLink(branch *models.Branch) error {
+	return (&OpenLinkAction{c: self.c}).Call(branch.Name)
+}
+
 func (self *BranchesController) handleCreatePullRequestMenu(selectedBranch *models.Branch) error {
 	checkedOutBranch := self.c.Helpers().Refs.GetCheckedOutRef()
 
@@ -476,16 +502,37 @@ func (self *BranchesController) localDelete(branch *models.Branch) error {
 		return self.promptWorktreeBranchDelete(branch)
 	}
 
-	return self.c.WithWaitingStatus(self.c.Tr.DeletingStatus, func(_ gocui.Task) error {
-		self.c.LogAction(self.c.Tr.Actions.DeleteLocalBranch)
-		err := self.c.Git().Branch.LocalDelete(branch.Name, false)
-		if err != nil && strings.Contains(err.Error(), "git branch -D ") {
-			return self.forceDelete(branch)
-		}
-		if err != nil {
-			return self.c.Error(err)
-		}
-		return self.c.Refresh(types.RefreshOptions{Mode: types.ASYNC, Scope: []types.RefreshableView{types.BRANCHES}})
+	placeholders := map[string]string{"selectedBranchName": branch.Name}
+	return self.c.Helpers().ActionConfirmation.Confirm(helpers.ConfirmActionOpts{
+		Action:           config.ConfirmDeleteBranch,
+		Title:            utils.ResolvePlaceholderString(self.c.Tr.DeleteBranchTitle, placeholders),
+		Prompt:           utils.ResolvePlaceholderString(self.c.Tr.DeleteLocalBranchPrompt, placeholders),
+		ConfirmationText: branch.Name,
+		HandleConfirm: func() error {
+			if self.c.Helpers().DryRun.IsEnabled() {
+				return self.showLocalDeleteDryRun(branch)
+			}
+
+			return self.c.WithWaitingStatus(self.c.Tr.DeletingStatus, func(_ gocui.Task) error {
+				self.c.LogAction(self.c.Tr.Actions.DeleteLocalBranch)
+				err := self.c.Git().Branch.LocalDelete(branch.Name, false)
+				if err != nil && strings.Contains(err.Error(), "git branch -D ") {
+					return self.forceDelete(branch)
+				}
+				if err != nil {
+					return self.c.Error(err)
+				}
+				return self.c.Refresh(types.RefreshOptions{Mode: types.ASYNC, Scope: []types.RefreshableView{types.BRANCHES}})
+			})
+		},
+	})
+}
+
+func (self *BranchesController) showLocalDeleteDryRun(branch *models.Branch) error {
+	// without force, like the first attempt at deleting the branch below
+	return self.c.Helpers().DryRun.Show(helpers.DryRunPreview{
+		CmdObjs:  []oscommands.ICmdObj{self.c.Git().Branch.LocalDeleteCmdObj(branch.Name, false)},
+		Affected: []string{self.c.Helpers().DryRun.BranchDeletedAffected(branch.Name, branch.CommitHash)},
 	})
 }
 
@@ -502,9 +549,11 @@ func (self *BranchesController) forceDelete(branch *models.Branch) error {
 		},
 	)
 
-	return self.c.Confirm(types.ConfirmOpts{
-		Title:  title,
-		Prompt: message,
+	return self.c.Helpers().ActionConfirmation.Confirm(helpers.ConfirmActionOpts{
+		Action:           config.ConfirmDeleteUnmergedBranch,
+		Title:            title,
+		Prompt:           message,
+		ConfirmationText: branch.Name,
 		HandleConfirm: func() error {
 			if err := self.c.Git().Branch.LocalDelete(branch.Name, true); err != nil {
 				return self.c.ErrorMsg(err.Error())
@@ -639,50 +688,187 @@ func (self *BranchesController) createResetMenu(selectedBranch *models.Branch) e
 	return self.c.Helpers().Refs.CreateGitResetMenu(selectedBranch.Name)
 }
 
+func (self *BranchesController) compareBranch(branch *models.Branch) error {
+	return (&BranchComparisonAction{c: self.c}).Call(branch)
+}
+
 func (self *BranchesController) rename(branch *models.Branch) error {
-	promptForNewName := func() error {
-		return self.c.Prompt(types.PromptOpts{
-			Title:          self.c.Tr.NewBranchNamePrompt + " " + branch.Name + ":",
-			InitialContent: branch.Name,
-			HandleConfirm: func(newBranchName string) error {
-				self.c.LogAction(self.c.Tr.Actions.RenameBranch)
-				if err := self.c.Git().Branch.Rename(branch.Name, helpers.SanitizedBranchName(newBranchName)); err != nil {
-					return self.c.Error(err)
-				}
+	if !branch.IsTrackingRemote() {
+		return self.promptForNewBranchName(branch, func(newBranchName string) error {
+			return self.renameLocalBranch(branch, newBranchName)
+		})
+	}
 
-				// need to find where the branch is now so that we can re-select it. That means we need to refetch the branches synchronously and then find our branch
-				_ = self.c.Refresh(types.RefreshOptions{
-					Mode:  types.SYNC,
-					Scope: []types.RefreshableView{types.BRANCHES, types.WORKTREES},
-				})
+	renameOnRemoteItem := &types.MenuItem{
+		Label: self.c.Tr.RenameLocalAndRemoteBranch,
+		Key:   'r',
+		OnPress: func() error {
+			return self.promptForNewBranchName(branch, func(newBranchName string) error {
+				return self.confirmRenameOnRemote(branch, newBranchName)
+			})
+		},
+		Tooltip: utils.ResolvePlaceholderString(self.c.Tr.RenameLocalAndRemoteTooltip, map[string]string{
+			"remote": branch.UpstreamRemote,
+		}),
+	}
+	// we push the commit of the remote-tracking branch under the new name, so
+	// we need to have it
+	if branch.UpstreamGone || !branch.RemoteBranchStoredLocally() {
+		renameOnRemoteItem.DisabledReason = &types.DisabledReason{Text: self.c.Tr.UpstreamNotSetError}
+	}
 
-				// now that we've got our stuff again we need to find that branch and reselect it.
-				for i, newBranch := range self.c.Model().Branches {
-					if newBranch.Name == newBranchName {
-						self.context().SetSelectedLineIdx(i)
-						if err := self.context().HandleRender(); err != nil {
-							return err
-						}
+	return self.c.Menu(types.CreateMenuOptions{
+		Title: self.c.Tr.RenameBranch,
+		Items: []*types.MenuItem{
+			{
+				Label: self.c.Tr.RenameLocalBranchOnly,
+				Key:   'l',
+				OnPress: func() error {
+					return self.promptForNewBranchName(branch, func(newBranchName string) error {
+						return self.renameLocalBranch(branch, newBranchName)
+					})
+				},
+			},
+			renameOnRemoteItem,
+		},
+	})
+}
+
+func (self *BranchesController) promptForNewBranchName(branch *models.Branch, handleNewName func(string) error) error {
+	return self.c.Prompt(types.PromptOpts{
+		Title:          self.c.Tr.NewBranchNamePrompt + " " + branch.Name + ":",
+		InitialContent: branch.Name,
+		HandleConfirm: func(newBranchName string) error {
+			return handleNewName(helpers.SanitizedBranchName(newBranchName))
+		},
+	})
+}
+
+func (self *BranchesController) renameLocalBranch(branch *models.Branch, newBranchName string) error {
+	self.c.LogAction(self.c.Tr.Actions.RenameBranch)
+	if err := self.c.Git().Branch.Rename(branch.Name, newBranchName); err != nil {
+		return self.c.Error(err)
+	}
+
+	return self.refreshAndSelectBranch(newBranchName)
+}
+
+// confirmRenameOnRemote lists the refs that renaming the branch on the remote
+// will change, before changing any of them
+func (self *BranchesController) confirmRenameOnRemote(branch *models.Branch, newBranchName string) error {
+	remoteName := branch.UpstreamRemote
+	oldUpstreamName := branch.UpstreamBranch
+
+	// we rename the remote branch first, so we have to rule out anything that
+	// would make the local rename fail afterwards
+	if newBranchName != branch.Name && self.localBranchExists(newBranchName) {
+		return self.c.ErrorMsg(utils.ResolvePlaceholderString(self.c.Tr.LocalBranchAlreadyExists, map[string]string{
+			"branch": newBranchName,
+		}))
+	}
+
+	if newBranchName != oldUpstreamName && self.remoteBranchExists(remoteName, newBranchName) {
+		return self.c.ErrorMsg(utils.ResolvePlaceholderString(self.c.Tr.RemoteBranchAlreadyExists, map[string]string{
+			"branch": newBranchName,
+			"remote": remoteName,
+		}))
+	}
+
+	changes := []string{}
+	if newBranchName != branch.Name {
+		changes = append(changes, utils.ResolvePlaceholderString(self.c.Tr.RenameBranchLocalRefChange, map[string]string{
+			"from": "refs/heads/" + branch.Name,
+			"to":   "refs/heads/" + newBranchName,
+		}))
+	}
+	if newBranchName != oldUpstreamName {
+		changes = append(changes,
+			utils.ResolvePlaceholderString(self.c.Tr.RenameBranchRemoteRefChange, map[string]string{
+				"remote": remoteName,
+				"from":   "refs/heads/" + oldUpstreamName,
+				"to":     "refs/heads/" + newBranchName,
+			}),
+			utils.ResolvePlaceholderString(self.c.Tr.RenameBranchUpstreamChange, map[string]string{
+				"from": remoteName + "/" + oldUpstreamName,
+				"to":   remoteName + "/" + newBranchName,
+			}),
+		)
+	}
+	if len(changes) == 0 {
+		return nil
+	}
+
+	return self.c.Confirm(types.ConfirmOpts{
+		Title: self.c.Tr.RenameLocalAndRemoteBranch,
+		Prompt: utils.ResolvePlaceholderString(self.c.Tr.RenameBranchOnRemotePrompt, map[string]string{
+			"changes": "- " + strings.Join(changes, "\n- "),
+		}),
+		HandleConfirm: func() error {
+			return self.c.WithWaitingStatus(self.c.Tr.RenamingBranchStatus, func(task gocui.Task) error {
+				self.c.LogAction(self.c.Tr.Actions.RenameBranchOnRemote)
+
+				// we start with the remote, because that's what's most likely
+				// to fail, in which case we haven't changed anything yet
+				if newBranchName != oldUpstreamName {
+					if err := self.c.Git().Remote.RenameRemoteBranch(task, remoteName, oldUpstreamName, newBranchName); err != nil {
+						return self.c.Error(err)
+					}
+				}
+				if newBranchName != branch.Name {
+					if err := self.c.Git().Branch.Rename(branch.Name, newBranchName); err != nil {
+						return self.c.Error(err)
 					}
 				}
+				if err := self.c.Git().Branch.SetUpstream(remoteName, newBranchName, newBranchName); err != nil {
+					return self.c.Error(err)
+				}
 
+				self.c.OnUIThread(func() error {
+					return self.refreshAndSelectBranch(newBranchName)
+				})
 				return nil
-			},
-		})
-	}
+			})
+		},
+	})
+}
 
-	// I could do an explicit check here for whether the branch is tracking a remote branch
-	// but if we've selected it we'll already know that via Pullables and Pullables.
-	// Bit of a hack but I'm lazy.
-	if !branch.IsTrackingRemote() {
-		return promptForNewName()
+func (self *BranchesController) localBranchExists(branchName string) bool {
+	return lo.ContainsBy(self.c.Model().Branches, func(branch *models.Branch) bool {
+		return branch.Name == branchName
+	})
+}
+
+func (self *BranchesController) remoteBranchExists(remoteName string, branchName string) bool {
+	remote, ok := lo.Find(self.c.Model().Remotes, func(remote *models.Remote) bool {
+		return remote.Name == remoteName
+	})
+	if !ok {
+		return false
 	}
 
-	return self.c.Confirm(types.ConfirmOpts{
-		Title:         self.c.Tr.RenameBranch,
-		Prompt:        self.c.Tr.RenameBranchWarning,
-		HandleConfirm: promptForNewName,
+	return lo.ContainsBy(remote.Branches, func(remoteBranch *models.RemoteBranch) bool {
+		return remoteBranch.Name == branchName
+	})
+}
+
+func (self *BranchesController) refreshAndSelectBranch(branchName string) error {
+	// need to find where the branch is now so that we can re-select it. That means we need to refetch the branches synchronously and then find our branch
+	_ = self.c.Refresh(types.RefreshOptions{
+		Mode:  types.SYNC,
+		Scope: []types.RefreshableView{types.BRANCHES, types.REMOTES, types.WORKTREES},
 	})
+
+	// now that we've got our stuff again we need to find that branch and reselect it.
+	for i, newBranch := range self.c.Model().Branches {
+		if newBranch.Name == branchName {
+			self.context().SetSelectedLineIdx(i)
+			if err := self.context().HandleRender(); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
 }
 
 func (self *BranchesController) newBranch(selectedBranch *models.Branch) error {
@@ -1,7 +1,14 @@
 package controllers
 
 import (
+	"fmt"
+
+	"github.com/jesseduffield/gocui"
+	"github.com/jesseduffield/lazygit/pkg/commands/models"
+	"github.com/jesseduffield/lazygit/pkg/gui/context"
 	"github.com/jesseduffield/lazygit/pkg/gui/types"
+	"github.com/jesseduffield/lazygit/pkg/utils"
+	"github.com/samber/lo"
 )
 
 type CommandLogController struct {
@@ -21,14 +28,96 @@ func NewCommandLogController(
 }
 
 func (self *CommandLogController) GetKeybindings(opts types.KeybindingsOpts) []*types.Binding {
-	bindings := []*types.Binding{}
+	bindings := []*types.Binding{
+		{
+			Key:         opts.GetKey(opts.Config.Universal.CopyToClipboard),
+			Handler:     self.checkSelected(self.copyCommand),
+			Description: self.c.Tr.CopyCommandToClipboard,
+		},
+		{
+			Key:         opts.GetKey(opts.Config.Universal.Select),
+			Handler:     self.checkSelected(self.rerunCommand),
+			Description: self.c.Tr.RerunCommand,
+			Tooltip:     self.c.Tr.RerunCommandTooltip,
+		},
+		{
+			Key:         opts.GetKey(opts.Config.Universal.FilteringMenu),
+			Handler:     self.openSubsystemFilterMenu,
+			Description: self.c.Tr.FilterCommandLogBySubsystem,
+			OpensMenu:   true,
+		},
+	}
 
 	return bindings
 }
 
+// these take precedence over the list controller's mouse bindings, so that
+// the plain log can be scrolled while it isn't focused
+func (self *CommandLogController) GetMouseKeybindings(opts types.KeybindingsOpts) []*gocui.ViewMouseBinding {
+	return []*gocui.ViewMouseBinding{
+		{
+			ViewName: self.context().GetViewName(),
+			Key:      gocui.MouseWheelUp,
+			Handler: func(gocui.ViewMouseBindingOpts) error {
+				self.c.Views().Extras.Autoscroll = false
+				self.context().GetViewTrait().ScrollUp(self.c.UserConfig.Gui.ScrollHeight)
+				return nil
+			},
+		},
+		{
+			ViewName: self.context().GetViewName(),
+			Key:      gocui.MouseWheelDown,
+			Handler: func(gocui.ViewMouseBindingOpts) error {
+				self.c.Views().Extras.Autoscroll = false
+				self.context().GetViewTrait().ScrollDown(self.c.UserConfig.Gui.ScrollHeight)
+				return nil
+			},
+		},
+		{
+			ViewName: self.context().GetViewName(),
+			Key:      gocui.MouseLeft,
+			Handler:  self.onClick,
+		},
+	}
+}
+
+func (self *CommandLogController) GetOnFocus() func(types.OnFocusOpts) error {
+	return func(types.OnFocusOpts) error {
+		if self.context().IsShowingList() {
+			return nil
+		}
+
+		// we came from a side context, which we return to on escape
+		self.context().SetParentContext(self.c.CurrentSideContext())
+
+		view := self.c.Views().Extras
+		view.Autoscroll = false
+		view.Wrap = false
+
+		self.context().SetShowingList(true)
+		// like the plain log, we start at the most recent command
+		self.context().SetSelectedLineIdx(self.context().Len() - 1)
+		if err := self.context().HandleRender(); err != nil {
+			return err
+		}
+		self.context().FocusLine()
+
+		return nil
+	}
+}
+
 func (self *CommandLogController) GetOnFocusLost() func(types.OnFocusLostOpts) error {
 	return func(types.OnFocusLostOpts) error {
-		self.c.Views().Extras.Autoscroll = true
+		self.context().SetShowingList(false)
+		self.context().SetSubsystemFilter("")
+
+		view := self.c.Views().Extras
+		view.Title = self.c.Tr.CommandLog
+		view.Footer = ""
+		view.Wrap = true
+		view.Autoscroll = true
+		self.c.SetViewContent(view, self.c.State().GetCommandLogContent())
+
 		return nil
 	}
 }
@@ -37,6 +126,97 @@ func (self *CommandLogController) Context() types.Context {
 	return self.context()
 }
 
-func (self *CommandLogController) context() types.Context {
+func (self *CommandLogController) context() *context.CommandLogContext {
 	return self.c.Contexts().CommandLog
 }
+
+func (self *CommandLogController) onClick(opts gocui.ViewMouseBindingOpts) error {
+	if !self.context().IsShowingList() {
+		return self.c.PushContext(self.context())
+	}
+
+	idx := self.context().ViewIndexToModelIndex(opts.Y)
+	if idx >= self.context().Len() {
+		return nil
+	}
+
+	self.context().SetSelectedLineIdx(idx)
+	return self.context().HandleFocus(types.OnFocusOpts{})
+}
+
+func (self *CommandLogController) copyCommand(entry *models.CommandLogEntry) error {
+	if err := self.c.OS().CopyToClipboard(entry.Command); err != nil {
+		return self.c.Error(err)
+	}
+
+	self.c.Toast(self.c.Tr.CommandCopiedToClipboard)
+	return nil
+}
+
+func (self *CommandLogController) rerunCommand(entry *models.CommandLogEntry) error {
+	if entry.Args == nil {
+		return self.c.ErrorMsg(self.c.Tr.CannotRerunCommand)
+	}
+
+	return self.c.Confirm(types.ConfirmOpts{
+		Title:  self.c.Tr.RerunCommand,
+		Prompt: utils.ResolvePlaceholderString(self.c.Tr.RerunCommandPrompt, map[string]string{"command": entry.Command}),
+		HandleConfirm: func() error {
+			self.c.LogAction(self.c.Tr.Actions.RerunCommand)
+			// we run the exact args rather than the command string, which is only
+			// meant for display and would need re-quoting for the shell
+			cmdObj := self.c.OS().Cmd.New(entry.Args).SetWd(entry.Dir)
+			cmdObj.GetCmd().Env = entry.Env
+			return self.c.RunSubprocessAndRefresh(cmdObj)
+		},
+	})
+}
+
+func (self *CommandLogController) openSubsystemFilterMenu() error {
+	subsystems := lo.Uniq(lo.FilterMap(self.c.State().GetCommandLogEntries(), func(entry *models.CommandLogEntry, _ int) (string, bool) {
+		return entry.Subsystem(), entry.Subsystem() != ""
+	}))
+
+	setFilter := func(subsystem string) error {
+		self.context().SetSubsystemFilter(subsystem)
+
+		title := self.c.Tr.CommandLog
+		if subsystem != "" {
+			title = fmt.Sprintf("%s (%s)", title, subsystem)
+		}
+		self.c.Views().Extras.Title = title
+
+		self.context().SetSelectedLineIdx(self.context().Len() - 1)
+		return self.c.PostRefreshUpdate(self.context())
+	}
+
+	menuItems := []*types.MenuItem{
+		{
+			Label:   self.c.Tr.AllSubsystems,
+			OnPress: func() error { return setFilter("") },
+		},
+	}
+	for _, subsystem := range subsystems {
+		subsystem := subsystem
+		menuItems = append(menuItems, &types.MenuItem{
+			Label:   subsystem,
+			OnPress: func() error { return setFilter(subsystem) },
+		})
+	}
+
+	return self.c.Menu(types.CreateMenuOptions{
+		Title: self.c.Tr.FilterCommandLogBySubsystem,
+		Items: menuItems,
+	})
+}
+
+func (self *CommandLogController) checkSelected(callback func(*models.CommandLogEntry) error) func() error {
+	return func() error {
+		entry := self.context().GetSelected()
+		if entry == nil {
+			return nil
+		}
+
+		return callback(entry)
+	}
+}
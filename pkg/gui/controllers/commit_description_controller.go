@@ -39,6 +39,13 @@ func (self *CommitDescriptionController) GetKeybindings(opts types.KeybindingsOp
 			Key:     opts.GetKey(opts.Config.CommitMessage.SwitchToEditor),
 			Handler: self.switchToEditor,
 		},
+		{
+			Key:         opts.GetKey(opts.Config.CommitMessage.EditTrailers),
+			Handler:     self.editTrailers,
+			Description: self.c.Tr.EditTrailers,
+			Tooltip:     self.c.Tr.EditTrailersTooltip,
+			OpensMenu:   true,
+		},
 	}
 
 	return bindings
@@ -67,3 +74,7 @@ func (self *CommitDescriptionController) confirm() error {
 func (self *CommitDescriptionController) switchToEditor() error {
 	return self.c.Helpers().Commits.SwitchToEditor()
 }
+
+func (self *CommitDescriptionController) editTrailers() error {
+	return (&CommitTrailersAction{c: self.c}).Call()
+}
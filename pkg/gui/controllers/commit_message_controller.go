@@ -50,6 +50,13 @@ func (self *CommitMessageController) GetKeybindings(opts types.KeybindingsOpts)
 			Key:     opts.GetKey(opts.Config.CommitMessage.SwitchToEditor),
 			Handler: self.switchToEditor,
 		},
+		{
+			Key:         opts.GetKey(opts.Config.CommitMessage.EditTrailers),
+			Handler:     self.editTrailers,
+			Description: self.c.Tr.EditTrailers,
+			Tooltip:     self.c.Tr.EditTrailersTooltip,
+			OpensMenu:   true,
+		},
 	}
 
 	return bindings
@@ -92,6 +99,10 @@ func (self *CommitMessageController) switchToEditor() error {
 	return self.c.Helpers().Commits.SwitchToEditor()
 }
 
+func (self *CommitMessageController) editTrailers() error {
+	return (&CommitTrailersAction{c: self.c}).Call()
+}
+
 func (self *CommitMessageController) handleCommitIndexChange(value int) error {
 	currentIndex := self.context().GetSelectedIndex()
 	newIndex := currentIndex + value
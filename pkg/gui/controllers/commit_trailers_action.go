@@ -0,0 +1,60 @@
+package controllers
+
+import (
+	"strings"
+
+	"github.com/jesseduffield/lazygit/pkg/config"
+	"github.com/jesseduffield/lazygit/pkg/gui/types"
+	"github.com/jesseduffield/lazygit/pkg/utils"
+	"github.com/samber/lo"
+)
+
+// CommitTrailersAction lets the user add and remove trailers in the commit
+// message panel
+type CommitTrailersAction struct {
+	c *ControllerCommon
+}
+
+func (self *CommitTrailersAction) Call() error {
+	addItems := lo.Map(self.c.UserConfig.Git.Commit.Trailers, func(trailer config.CommitTrailerConfig, _ int) *types.MenuItem {
+		return &types.MenuItem{
+			Label: utils.ResolvePlaceholderString(self.c.Tr.AddTrailer, map[string]string{"key": trailer.Key}),
+			OnPress: func() error {
+				return self.promptForValue(trailer)
+			},
+		}
+	})
+
+	removeItems := lo.Map(self.c.Helpers().Commits.GetTrailers(), func(trailer string, _ int) *types.MenuItem {
+		return &types.MenuItem{
+			Label: utils.ResolvePlaceholderString(self.c.Tr.RemoveTrailer, map[string]string{"trailer": trailer}),
+			OnPress: func() error {
+				self.c.Helpers().Commits.RemoveTrailer(trailer)
+				return nil
+			},
+		}
+	})
+
+	return self.c.Menu(types.CreateMenuOptions{
+		Title: self.c.Tr.EditTrailers,
+		Items: append(addItems, removeItems...),
+	})
+}
+
+func (self *CommitTrailersAction) promptForValue(trailer config.CommitTrailerConfig) error {
+	var findSuggestionsFunc func(string) []*types.Suggestion
+	if len(trailer.Suggestions) > 0 || trailer.SuggestAuthors {
+		findSuggestionsFunc = self.c.Helpers().Suggestions.GetTrailerValueSuggestionsFunc(trailer.Suggestions, trailer.SuggestAuthors)
+	}
+
+	return self.c.Prompt(types.PromptOpts{
+		Title:               trailer.Key,
+		FindSuggestionsFunc: findSuggestionsFunc,
+		HandleConfirm: func(value string) error {
+			if value = strings.TrimSpace(value); value != "" {
+				self.c.Helpers().Commits.AddTrailer(trailer.Key, value)
+			}
+			return nil
+		},
+	})
+}
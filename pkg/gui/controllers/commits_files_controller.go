@@ -8,6 +8,7 @@ import (
 	"github.com/jesseduffield/lazygit/pkg/gui/context"
 	"github.com/jesseduffield/lazygit/pkg/gui/filetree"
 	"github.com/jesseduffield/lazygit/pkg/gui/types"
+	"github.com/samber/lo"
 )
 
 type CommitFilesController struct {
@@ -27,6 +28,8 @@ func NewCommitFilesController(
 }
 
 func (self *CommitFilesController) GetKeybindings(opts types.KeybindingsOpts) []*types.Binding {
+	collapseAction := &FileTreeCollapseAction{c: self.c, tree: self.context().CommitFileTreeViewModel, context: self.context()}
+
 	bindings := []*types.Binding{
 		{
 			Key:         opts.GetKey(opts.Config.CommitFiles.CheckoutCommitFile),
@@ -53,9 +56,15 @@ func (self *CommitFilesController) GetKeybindings(opts types.KeybindingsOpts) []
 			Handler:     self.checkSelected(self.openDiffTool),
 			Description: self.c.Tr.OpenDiffTool,
 		},
+		{
+			Key:         opts.GetKey(opts.Config.Files.CopyFileInfoToClipboard),
+			Handler:     self.openCopyMenu,
+			Description: self.c.Tr.CopyToClipboardMenu,
+			OpensMenu:   true,
+		},
 		{
 			Key:         opts.GetKey(opts.Config.Universal.Select),
-			Handler:     self.checkSelected(self.toggleForPatch),
+			Handler:     self.withSelectedNodes(self.toggleForPatch),
 			Description: self.c.Tr.ToggleAddToPatch,
 		},
 		{
@@ -73,6 +82,39 @@ func (self *CommitFilesController) GetKeybindings(opts types.KeybindingsOpts) []
 			Handler:     self.toggleTreeView,
 			Description: self.c.Tr.ToggleTreeView,
 		},
+		{
+			Key:         opts.GetKey(opts.Config.Files.CollapseAll),
+			Handler:     collapseAction.CollapseAll,
+			Description: self.c.Tr.CollapseAll,
+			Tooltip:     self.c.Tr.CollapseAllTooltip,
+		},
+		{
+			Key:         opts.GetKey(opts.Config.Files.ExpandAll),
+			Handler:     collapseAction.ExpandAll,
+			Description: self.c.Tr.ExpandAll,
+			Tooltip:     self.c.Tr.ExpandAllTooltip,
+		},
+		{
+			Key:               opts.GetKey(opts.Config.Files.CollapseToLevel),
+			Handler:           collapseAction.OpenCollapseToLevelMenu,
+			GetDisabledReason: collapseAction.GetDisabledReason,
+			Description:       self.c.Tr.CollapseToLevel,
+			Tooltip:           self.c.Tr.CollapseToLevelTooltip,
+			OpensMenu:         true,
+		},
+		{
+			Key:         opts.GetKey(opts.Config.Files.FilterCommitsByPath),
+			Handler:     self.checkSelected(self.filterCommitsByPath),
+			Description: self.c.Tr.FilterCommitsByPath,
+			Tooltip:     self.c.Tr.FilterCommitsByPathTooltip,
+		},
+		{
+			Key:               opts.GetKey(opts.Config.Files.ViewFileHistory),
+			Handler:           self.checkSelected(self.viewFileHistory),
+			GetDisabledReason: self.getDisabledReasonForFileHistory,
+			Description:       self.c.Tr.ViewFileHistory,
+			Tooltip:           self.c.Tr.ViewFileHistoryTooltip,
+		},
 	}
 
 	return bindings
@@ -100,6 +142,17 @@ func (self *CommitFilesController) checkSelected(callback func(*filetree.CommitF
 	}
 }
 
+func (self *CommitFilesController) withSelectedNodes(callback func([]*filetree.CommitFileNode) error) func() error {
+	return func() error {
+		nodes := self.context().GetSelectedNodes()
+		if len(nodes) == 0 {
+			return nil
+		}
+
+		return callback(nodes)
+	}
+}
+
 func (self *CommitFilesController) Context() types.Context {
 	return self.context()
 }
@@ -139,6 +192,55 @@ func (self *CommitFilesController) GetOnRenderToMain() func() error {
 	}
 }
 
+func (self *CommitFilesController) openCopyMenu() error {
+	node := self.context().GetSelected()
+
+	var disabledReason *types.DisabledReason
+	selectedPath := ""
+	if node == nil {
+		disabledReason = &types.DisabledReason{Text: self.c.Tr.NoContentToCopyError}
+	} else {
+		selectedPath = node.GetPath()
+	}
+
+	copyDiff := func(path string, toast string) error {
+		ref := self.context().GetRef()
+		from, reverse := self.c.Modes().Diffing.GetFromAndReverseArgsForDiff(ref.ParentRefName())
+		diff, err := self.c.Git().Diff.GetRefsDiff(from, ref.RefName(), reverse, path)
+		if err != nil {
+			return self.c.Error(err)
+		}
+		if err := self.c.OS().CopyToClipboard(diff); err != nil {
+			return self.c.Error(err)
+		}
+		self.c.Toast(toast)
+		return nil
+	}
+
+	copySelectedDiffItem := &types.MenuItem{
+		Label:   self.c.Tr.CopySelectedDiff,
+		Tooltip: self.c.Tr.CopyCommitFileDiffTooltip,
+		OnPress: func() error {
+			return copyDiff(selectedPath, self.c.Tr.FileDiffCopiedToast)
+		},
+		Key:            's',
+		DisabledReason: disabledReason,
+	}
+	copyAllDiffItem := &types.MenuItem{
+		Label:   self.c.Tr.CopyAllFilesDiff,
+		Tooltip: self.c.Tr.CopyCommitFileDiffTooltip,
+		OnPress: func() error {
+			return copyDiff("", self.c.Tr.AllFilesDiffCopiedToast)
+		},
+		Key: 'a',
+	}
+
+	return self.c.Menu(types.CreateMenuOptions{
+		Title: self.c.Tr.CopyToClipboardMenu,
+		Items: append((&CopyPathMenuAction{c: self.c}).MenuItems(selectedPath, disabledReason), copySelectedDiffItem, copyAllDiffItem),
+	})
+}
+
 func (self *CommitFilesController) onClickMain(opts gocui.ViewMouseBindingOpts) error {
 	node := self.context().GetSelected()
 	if node == nil {
@@ -207,6 +309,22 @@ func (self *CommitFilesController) edit(node *filetree.CommitFileNode) error {
 	return self.c.Helpers().Files.EditFile(node.GetPath())
 }
 
+func (self *CommitFilesController) filterCommitsByPath(node *filetree.CommitFileNode) error {
+	return (&FilteringMenuAction{c: self.c}).setFiltering(node.GetPath())
+}
+
+func (self *CommitFilesController) viewFileHistory(node *filetree.CommitFileNode) error {
+	return self.c.Helpers().FileHistory.ViewFileHistory(node.GetPath(), self.context().GetRef().RefName(), self.context())
+}
+
+func (self *CommitFilesController) getDisabledReasonForFileHistory() *types.DisabledReason {
+	if node := self.context().GetSelected(); node != nil && node.File == nil {
+		return &types.DisabledReason{Text: self.c.Tr.CantFlagDirectory}
+	}
+
+	return nil
+}
+
 func (self *CommitFilesController) openDiffTool(node *filetree.CommitFileNode) error {
 	ref := self.context().GetRef()
 	to := ref.RefName()
@@ -223,7 +341,7 @@ func (self *CommitFilesController) openDiffTool(node *filetree.CommitFileNode) e
 	return err
 }
 
-func (self *CommitFilesController) toggleForPatch(node *filetree.CommitFileNode) error {
+func (self *CommitFilesController) toggleForPatch(nodes []*filetree.CommitFileNode) error {
 	toggle := func() error {
 		return self.c.WithWaitingStatus(self.c.Tr.UpdatingPatch, func(gocui.Task) error {
 			if !self.c.Git().Patch.PatchBuilder.Active() {
@@ -234,19 +352,23 @@ func (self *CommitFilesController) toggleForPatch(node *filetree.CommitFileNode)
 
 			// if there is any file that hasn't been fully added we'll fully add everything,
 			// otherwise we'll remove everything
-			adding := node.SomeFile(func(file *models.CommitFile) bool {
-				return self.c.Git().Patch.PatchBuilder.GetFileStatus(file.Name, self.context().GetRef().RefName()) != patch.WHOLE
+			adding := lo.SomeBy(nodes, func(node *filetree.CommitFileNode) bool {
+				return node.SomeFile(func(file *models.CommitFile) bool {
+					return self.c.Git().Patch.PatchBuilder.GetFileStatus(file.Name, self.context().GetRef().RefName()) != patch.WHOLE
+				})
 			})
 
-			err := node.ForEachFile(func(file *models.CommitFile) error {
-				if adding {
-					return self.c.Git().Patch.PatchBuilder.AddFileWhole(file.Name)
-				} else {
-					return self.c.Git().Patch.PatchBuilder.RemoveFile(file.Name)
+			for _, node := range nodes {
+				err := node.ForEachFile(func(file *models.CommitFile) error {
+					if adding {
+						return self.c.Git().Patch.PatchBuilder.AddFileWhole(file.Name)
+					} else {
+						return self.c.Git().Patch.PatchBuilder.RemoveFile(file.Name)
+					}
+				})
+				if err != nil {
+					return self.c.Error(err)
 				}
-			})
-			if err != nil {
-				return self.c.Error(err)
 			}
 
 			if self.c.Git().Patch.PatchBuilder.IsEmpty() {
@@ -273,7 +395,7 @@ func (self *CommitFilesController) toggleForPatch(node *filetree.CommitFileNode)
 
 func (self *CommitFilesController) toggleAllForPatch(_ *filetree.CommitFileNode) error {
 	root := self.context().CommitFileTreeViewModel.GetRoot()
-	return self.toggleForPatch(root)
+	return self.toggleForPatch([]*filetree.CommitFileNode{root})
 }
 
 func (self *CommitFilesController) startPatchBuilder() error {
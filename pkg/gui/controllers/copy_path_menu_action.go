@@ -0,0 +1,51 @@
+package controllers
+
+import (
+	"path"
+	"path/filepath"
+
+	"github.com/jesseduffield/lazygit/pkg/gui/types"
+)
+
+// CopyPathMenuAction provides the items of the copy menus of the files and
+// commit files views that copy the selected path in one form or another
+type CopyPathMenuAction struct {
+	c *ControllerCommon
+}
+
+// The path is relative to the root of the repo. If disabledReason isn't nil,
+// all the items are disabled with it, e.g. because nothing is selected
+func (self *CopyPathMenuAction) MenuItems(relativePath string, disabledReason *types.DisabledReason) []*types.MenuItem {
+	dir := path.Dir(relativePath)
+
+	items := []*types.MenuItem{
+		self.copyItem(self.c.Tr.CopyFileName, 'n', path.Base(relativePath), self.c.Tr.FileNameCopiedToast),
+		self.copyItem(self.c.Tr.CopyFilePath, 'p', relativePath, self.c.Tr.FilePathCopiedToast),
+		self.copyItem(self.c.Tr.CopyAbsolutePath, 'P', filepath.Join(self.c.Git().RepoPaths.WorktreePath(), relativePath), self.c.Tr.AbsolutePathCopiedToast),
+		self.copyItem(self.c.Tr.CopyDirectory, 'd', dir, self.c.Tr.DirectoryCopiedToast),
+	}
+
+	if disabledReason != nil {
+		for _, item := range items {
+			item.DisabledReason = disabledReason
+		}
+	} else if dir == "." {
+		items[3].DisabledReason = &types.DisabledReason{Text: self.c.Tr.PathIsAtTopLevel}
+	}
+
+	return items
+}
+
+func (self *CopyPathMenuAction) copyItem(label string, key types.Key, str string, toast string) *types.MenuItem {
+	return &types.MenuItem{
+		Label: label,
+		OnPress: func() error {
+			if err := self.c.OS().CopyToClipboard(str); err != nil {
+				return self.c.Error(err)
+			}
+			self.c.Toast(toast)
+			return nil
+		},
+		Key: key,
+	}
+}
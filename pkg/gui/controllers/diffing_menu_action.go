@@ -46,6 +46,29 @@ func (self *DiffingMenuAction) Call() error {
 		},
 	}...)
 
+	if checkedOutRef := self.c.Helpers().Refs.GetCheckedOutRef(); checkedOutRef != nil {
+		refName := checkedOutRef.Name
+		if checkedOutRef.DetachedHead {
+			refName = "HEAD"
+		}
+
+		menuItems = append(menuItems, &types.MenuItem{
+			Label: fmt.Sprintf(self.c.Tr.DiffAgainstRefAsOfTime, refName),
+			OnPress: func() error {
+				return self.c.Prompt(types.PromptOpts{
+					Title: self.c.Tr.EnterRelativeTime,
+					HandleConfirm: func(response string) error {
+						if strings.TrimSpace(response) == "" {
+							return nil
+						}
+						self.c.Modes().Diffing.Ref = diffing.RefAsOfTime(refName, response)
+						return self.c.Refresh(types.RefreshOptions{Mode: types.ASYNC})
+					},
+				})
+			},
+		})
+	}
+
 	if self.c.Modes().Diffing.Active() {
 		menuItems = append(menuItems, []*types.MenuItem{
 			{
@@ -0,0 +1,136 @@
+package controllers
+
+import (
+	"github.com/jesseduffield/lazygit/pkg/commands/models"
+	"github.com/jesseduffield/lazygit/pkg/gui/context"
+	"github.com/jesseduffield/lazygit/pkg/gui/types"
+	"github.com/jesseduffield/lazygit/pkg/utils"
+	"github.com/samber/lo"
+)
+
+type FileHistoryController struct {
+	baseController
+	c *ControllerCommon
+}
+
+var _ types.IController = &FileHistoryController{}
+
+func NewFileHistoryController(
+	common *ControllerCommon,
+) *FileHistoryController {
+	return &FileHistoryController{
+		baseController: baseController{},
+		c:              common,
+	}
+}
+
+func (self *FileHistoryController) GetKeybindings(opts types.KeybindingsOpts) []*types.Binding {
+	return []*types.Binding{
+		{
+			Key:         opts.GetKey(opts.Config.CommitFiles.CheckoutCommitFile),
+			Handler:     self.checkSelected(self.checkout),
+			Description: self.c.Tr.CheckoutFileVersion,
+			Tooltip:     self.c.Tr.CheckoutFileVersionTooltip,
+		},
+		{
+			Key:         opts.GetKey(opts.Config.Universal.CopyToClipboard),
+			Handler:     self.checkSelected(self.copyToClipboard),
+			Description: self.c.Tr.CopyFileVersionToClipboard,
+		},
+	}
+}
+
+func (self *FileHistoryController) Context() types.Context {
+	return self.context()
+}
+
+func (self *FileHistoryController) context() *context.FileHistoryContext {
+	return self.c.Contexts().FileHistory
+}
+
+func (self *FileHistoryController) GetOnRenderToMain() func() error {
+	return func() error {
+		return self.c.Helpers().Diff.WithDiffModeCheck(func() error {
+			commit := self.context().GetSelected()
+			var task types.UpdateTask
+			if commit == nil {
+				task = types.NewRenderStringTask("No commits")
+			} else {
+				cmdObj := self.c.Git().Commit.ShowCmdObj(commit.Sha, self.context().GetPathAt(commit))
+
+				task = types.NewRunPtyTask(cmdObj.GetCmd())
+			}
+
+			return self.c.RenderToMainViews(types.RefreshMainOpts{
+				Pair: self.c.MainViewPairs().Normal,
+				Main: &types.ViewUpdateOpts{
+					Title:    "Commit",
+					SubTitle: self.c.Helpers().Diff.IgnoringWhitespaceSubTitle(),
+					Task:     task,
+				},
+			})
+		})
+	}
+}
+
+// Rather than checking out the file from the commit, we write its content to
+// the file's current path, so that this also works for versions from before
+// the file was renamed. The change is left unstaged.
+func (self *FileHistoryController) checkout(commit *models.Commit) error {
+	path := self.context().GetPath()
+	hasChanges := lo.ContainsBy(self.c.Model().Files, func(file *models.File) bool {
+		return file.Name == path
+	})
+	if !hasChanges {
+		return self.checkoutAux(commit, path)
+	}
+
+	return self.c.Confirm(types.ConfirmOpts{
+		Title: self.c.Tr.CheckoutFileVersion,
+		Prompt: utils.ResolvePlaceholderString(self.c.Tr.CheckoutFileVersionPrompt,
+			map[string]string{"path": path}),
+		HandleConfirm: func() error {
+			return self.checkoutAux(commit, path)
+		},
+	})
+}
+
+func (self *FileHistoryController) checkoutAux(commit *models.Commit, path string) error {
+	content, err := self.c.Git().Commit.GetFileContentAtCommit(commit.Sha, self.context().GetPathAt(commit))
+	if err != nil {
+		return self.c.Error(err)
+	}
+
+	self.c.LogAction(self.c.Tr.Actions.CheckoutFileVersion)
+	if err := self.c.OS().CreateFileWithContent(path, content); err != nil {
+		return self.c.Error(err)
+	}
+
+	return self.c.Refresh(types.RefreshOptions{Scope: []types.RefreshableView{types.FILES}})
+}
+
+func (self *FileHistoryController) copyToClipboard(commit *models.Commit) error {
+	content, err := self.c.Git().Commit.GetFileContentAtCommit(commit.Sha, self.context().GetPathAt(commit))
+	if err != nil {
+		return self.c.Error(err)
+	}
+
+	self.c.LogAction(self.c.Tr.Actions.CopyToClipboard)
+	if err := self.c.OS().CopyToClipboard(content); err != nil {
+		return self.c.Error(err)
+	}
+
+	self.c.Toast(self.c.Tr.FileVersionCopiedToClipboard)
+	return nil
+}
+
+func (self *FileHistoryController) checkSelected(callback func(*models.Commit) error) func() error {
+	return func() error {
+		commit := self.context().GetSelected()
+		if commit == nil {
+			return nil
+		}
+
+		return callback(commit)
+	}
+}
@@ -0,0 +1,69 @@
+package controllers
+
+import (
+	"fmt"
+
+	"github.com/jesseduffield/lazygit/pkg/gui/types"
+	"github.com/jesseduffield/lazygit/pkg/utils"
+)
+
+type collapsibleFileTree interface {
+	CollapseAll()
+	ExpandAll()
+	CollapseToDepth(depth int)
+	MaxDepth() int
+}
+
+// FileTreeCollapseAction collapses or expands the directories of a file tree
+// in bulk, for the files and commit files views
+type FileTreeCollapseAction struct {
+	c       *ControllerCommon
+	tree    collapsibleFileTree
+	context types.Context
+}
+
+func (self *FileTreeCollapseAction) CollapseAll() error {
+	self.tree.CollapseAll()
+
+	return self.c.PostRefreshUpdate(self.context)
+}
+
+func (self *FileTreeCollapseAction) ExpandAll() error {
+	self.tree.ExpandAll()
+
+	return self.c.PostRefreshUpdate(self.context)
+}
+
+func (self *FileTreeCollapseAction) OpenCollapseToLevelMenu() error {
+	menuItems := make([]*types.MenuItem, 0, self.tree.MaxDepth())
+	for depth := 1; depth <= self.tree.MaxDepth(); depth++ {
+		depth := depth
+		menuItem := &types.MenuItem{
+			Label: utils.ResolvePlaceholderString(self.c.Tr.CollapseToLevelItem, map[string]string{
+				"levels": fmt.Sprint(depth),
+			}),
+			OnPress: func() error {
+				self.tree.CollapseToDepth(depth)
+
+				return self.c.PostRefreshUpdate(self.context)
+			},
+		}
+		if depth <= 9 {
+			menuItem.Key = rune('0' + depth)
+		}
+		menuItems = append(menuItems, menuItem)
+	}
+
+	return self.c.Menu(types.CreateMenuOptions{
+		Title: self.c.Tr.CollapseToLevel,
+		Items: menuItems,
+	})
+}
+
+func (self *FileTreeCollapseAction) GetDisabledReason() *types.DisabledReason {
+	if self.tree.MaxDepth() == 0 {
+		return &types.DisabledReason{Text: self.c.Tr.NoDirectoriesToCollapse}
+	}
+
+	return nil
+}
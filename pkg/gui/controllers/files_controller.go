@@ -1,14 +1,22 @@
 package controllers
 
 import (
+	"fmt"
+	"path"
+	"strconv"
 	"strings"
+	"unicode"
 
 	"github.com/jesseduffield/gocui"
 	"github.com/jesseduffield/lazygit/pkg/commands/git_commands"
 	"github.com/jesseduffield/lazygit/pkg/commands/models"
 	"github.com/jesseduffield/lazygit/pkg/gui/context"
 	"github.com/jesseduffield/lazygit/pkg/gui/filetree"
+	"github.com/jesseduffield/lazygit/pkg/gui/presentation"
+	"github.com/jesseduffield/lazygit/pkg/gui/style"
 	"github.com/jesseduffield/lazygit/pkg/gui/types"
+	"github.com/jesseduffield/lazygit/pkg/utils"
+	"github.com/samber/lo"
 )
 
 type FilesController struct {
@@ -27,10 +35,12 @@ func NewFilesController(
 }
 
 func (self *FilesController) GetKeybindings(opts types.KeybindingsOpts) []*types.Binding {
+	collapseAction := &FileTreeCollapseAction{c: self.c, tree: self.context().FileTreeViewModel, context: self.context()}
+
 	return []*types.Binding{
 		{
 			Key:         opts.GetKey(opts.Config.Universal.Select),
-			Handler:     self.checkSelectedFileNode(self.press),
+			Handler:     self.withSelectedNodes(self.press),
 			Description: self.c.Tr.ToggleStaged,
 		},
 		{
@@ -71,20 +81,30 @@ func (self *FilesController) GetKeybindings(opts types.KeybindingsOpts) []*types
 			Tooltip:     self.c.Tr.FindBaseCommitForFixupTooltip,
 		},
 		{
-			Key:         opts.GetKey(opts.Config.Universal.Edit),
-			Handler:     self.checkSelectedFileNode(self.edit),
-			Description: self.c.Tr.EditFile,
+			Key:         opts.GetKey(opts.Config.Files.QuickFixup),
+			Handler:     self.c.Helpers().FixupHelper.HandleQuickFixupPress,
+			Description: self.c.Tr.QuickFixup,
+			Tooltip:     self.c.Tr.QuickFixupTooltip,
+			OpensMenu:   true,
 		},
 		{
-			Key:         opts.GetKey(opts.Config.Universal.OpenFile),
-			Handler:     self.Open,
-			Description: self.c.Tr.OpenFile,
+			Key:               opts.GetKey(opts.Config.Universal.Edit),
+			Handler:           self.checkSelectedFileNode(self.edit),
+			GetDisabledReason: self.getDisabledReasonForSectionHeader,
+			Description:       self.c.Tr.EditFile,
 		},
 		{
-			Key:         opts.GetKey(opts.Config.Files.IgnoreFile),
-			Handler:     self.checkSelectedFileNode(self.ignoreOrExcludeMenu),
-			Description: self.c.Tr.Actions.IgnoreExcludeFile,
-			OpensMenu:   true,
+			Key:               opts.GetKey(opts.Config.Universal.OpenFile),
+			Handler:           self.Open,
+			GetDisabledReason: self.getDisabledReasonForSectionHeader,
+			Description:       self.c.Tr.OpenFile,
+		},
+		{
+			Key:               opts.GetKey(opts.Config.Files.IgnoreFile),
+			Handler:           self.checkSelectedFileNode(self.ignoreOrExcludeMenu),
+			GetDisabledReason: self.getDisabledReasonForSectionHeader,
+			Description:       self.c.Tr.Actions.IgnoreExcludeFile,
+			OpensMenu:         true,
 		},
 		{
 			Key:         opts.GetKey(opts.Config.Files.RefreshFiles),
@@ -130,9 +150,105 @@ func (self *FilesController) GetKeybindings(opts types.KeybindingsOpts) []*types
 			Description: self.c.Tr.ToggleTreeView,
 		},
 		{
-			Key:         opts.GetKey(opts.Config.Universal.OpenDiffTool),
-			Handler:     self.checkSelectedFileNode(self.openDiffTool),
-			Description: self.c.Tr.OpenDiffTool,
+			Key:         opts.GetKey(opts.Config.Files.ToggleGroupByChangeType),
+			Handler:     self.toggleGroupByChangeType,
+			Description: self.c.Tr.ToggleGroupByChangeType,
+			Tooltip:     self.c.Tr.ToggleGroupByChangeTypeTooltip,
+		},
+		{
+			Key:         opts.GetKey(opts.Config.Files.CollapseAll),
+			Handler:     collapseAction.CollapseAll,
+			Description: self.c.Tr.CollapseAll,
+			Tooltip:     self.c.Tr.CollapseAllTooltip,
+		},
+		{
+			Key:         opts.GetKey(opts.Config.Files.ExpandAll),
+			Handler:     collapseAction.ExpandAll,
+			Description: self.c.Tr.ExpandAll,
+			Tooltip:     self.c.Tr.ExpandAllTooltip,
+		},
+		{
+			Key:               opts.GetKey(opts.Config.Files.CollapseToLevel),
+			Handler:           collapseAction.OpenCollapseToLevelMenu,
+			GetDisabledReason: collapseAction.GetDisabledReason,
+			Description:       self.c.Tr.CollapseToLevel,
+			Tooltip:           self.c.Tr.CollapseToLevelTooltip,
+			OpensMenu:         true,
+		},
+		{
+			Key:         opts.GetKey(opts.Config.Files.CycleSortOrder),
+			Handler:     self.cycleSortOrder,
+			Description: self.c.Tr.CycleFileSortOrder,
+			Tooltip:     self.c.Tr.CycleFileSortOrderTooltip,
+		},
+		{
+			Key:         opts.GetKey(opts.Config.Files.ViewParkedChanges),
+			Handler:     self.createParkedChangesMenu,
+			Description: self.c.Tr.ViewParkedChanges,
+			Tooltip:     self.c.Tr.ViewParkedChangesTooltip,
+			OpensMenu:   true,
+		},
+		{
+			Key:         opts.GetKey(opts.Config.Files.ViewTrash),
+			Handler:     self.createTrashMenu,
+			Description: self.c.Tr.ViewTrash,
+			Tooltip:     self.c.Tr.ViewTrashTooltip,
+			OpensMenu:   true,
+		},
+		{
+			Key:         opts.GetKey(opts.Config.Files.StageByPattern),
+			Handler:     self.createStageByPatternMenu,
+			Description: self.c.Tr.StageByPattern,
+			Tooltip:     self.c.Tr.StageByPatternTooltip,
+			OpensMenu:   true,
+		},
+		{
+			Key:               opts.GetKey(opts.Config.Files.ToggleAssumeUnchanged),
+			Handler:           self.checkSelectedFileNode(self.toggleAssumeUnchanged),
+			GetDisabledReason: self.getDisabledReasonForSectionHeader,
+			Description:       self.c.Tr.ToggleAssumeUnchanged,
+			Tooltip:           self.c.Tr.ToggleAssumeUnchangedTooltip,
+		},
+		{
+			Key:               opts.GetKey(opts.Config.Files.ToggleSkipWorktree),
+			Handler:           self.checkSelectedFileNode(self.toggleSkipWorktree),
+			GetDisabledReason: self.getDisabledReasonForSectionHeader,
+			Description:       self.c.Tr.ToggleSkipWorktree,
+			Tooltip:           self.c.Tr.ToggleSkipWorktreeTooltip,
+		},
+		{
+			Key:               opts.GetKey(opts.Config.Files.ToggleExecutable),
+			Handler:           self.checkSelectedFileNode(self.toggleExecutable),
+			GetDisabledReason: self.getDisabledReasonForSectionHeader,
+			Description:       self.c.Tr.ToggleExecutable,
+			Tooltip:           self.c.Tr.ToggleExecutableTooltip,
+		},
+		{
+			Key:               opts.GetKey(opts.Config.Universal.OpenDiffTool),
+			Handler:           self.checkSelectedFileNode(self.openDiffTool),
+			GetDisabledReason: self.getDisabledReasonForSectionHeader,
+			Description:       self.c.Tr.OpenDiffTool,
+		},
+		{
+			Key:               opts.GetKey(opts.Config.Files.FilterCommitsByPath),
+			Handler:           self.checkSelectedFileNode(self.filterCommitsByPath),
+			GetDisabledReason: self.getDisabledReasonForSectionHeader,
+			Description:       self.c.Tr.FilterCommitsByPath,
+			Tooltip:           self.c.Tr.FilterCommitsByPathTooltip,
+		},
+		{
+			Key:               opts.GetKey(opts.Config.Files.ViewFileHistory),
+			Handler:           self.checkSelectedFileNode(self.viewFileHistory),
+			GetDisabledReason: self.getDisabledReasonForFileHistory,
+			Description:       self.c.Tr.ViewFileHistory,
+			Tooltip:           self.c.Tr.ViewFileHistoryTooltip,
+		},
+		{
+			Key:               opts.GetKey(opts.Config.Files.ViewFileOwnership),
+			Handler:           self.checkSelectedFileNode(self.viewFileOwnership),
+			GetDisabledReason: self.getDisabledReasonForSectionHeader,
+			Description:       self.c.Tr.ViewFileOwnership,
+			Tooltip:           self.c.Tr.ViewFileOwnershipTooltip,
 		},
 		{
 			Key:         opts.GetKey(opts.Config.Files.OpenMergeTool),
@@ -198,6 +314,10 @@ func (self *FilesController) GetOnRenderToMain() func() error {
 				})
 			}
 
+			if section, ok := node.GetSection(); ok {
+				return self.renderSection(node, section)
+			}
+
 			if node.File != nil && node.File.HasInlineMergeConflicts {
 				hasConflicts, err := self.c.Helpers().MergeConflicts.SetMergeState(node.GetPath())
 				if err != nil {
@@ -211,6 +331,10 @@ func (self *FilesController) GetOnRenderToMain() func() error {
 
 			self.c.Helpers().MergeConflicts.ResetMergeState()
 
+			if node.File != nil && node.File.ModeChangeOnly {
+				return self.renderModeChange(node.File)
+			}
+
 			pair := self.c.MainViewPairs().Normal
 			if node.File != nil {
 				pair = self.c.MainViewPairs().Staging
@@ -219,7 +343,7 @@ func (self *FilesController) GetOnRenderToMain() func() error {
 			split := self.c.UserConfig.Gui.SplitDiff == "always" || (node.GetHasUnstagedChanges() && node.GetHasStagedChanges())
 			mainShowsStaged := !split && node.GetHasStagedChanges()
 
-			cmdObj := self.c.Git().WorkingTree.WorktreeFileDiffCmdObj(node, false, mainShowsStaged)
+			task := self.nodeDiffTask(node, mainShowsStaged)
 			title := self.c.Tr.UnstagedChanges
 			if mainShowsStaged {
 				title = self.c.Tr.StagedChanges
@@ -227,14 +351,14 @@ func (self *FilesController) GetOnRenderToMain() func() error {
 			refreshOpts := types.RefreshMainOpts{
 				Pair: pair,
 				Main: &types.ViewUpdateOpts{
-					Task:     types.NewRunPtyTask(cmdObj.GetCmd()),
+					Task:     task,
 					SubTitle: self.c.Helpers().Diff.IgnoringWhitespaceSubTitle(),
 					Title:    title,
 				},
 			}
 
 			if split {
-				cmdObj := self.c.Git().WorkingTree.WorktreeFileDiffCmdObj(node, false, true)
+				task := self.nodeDiffTask(node, true)
 
 				title := self.c.Tr.StagedChanges
 				if mainShowsStaged {
@@ -244,7 +368,7 @@ func (self *FilesController) GetOnRenderToMain() func() error {
 				refreshOpts.Secondary = &types.ViewUpdateOpts{
 					Title:    title,
 					SubTitle: self.c.Helpers().Diff.IgnoringWhitespaceSubTitle(),
-					Task:     types.NewRunPtyTask(cmdObj.GetCmd()),
+					Task:     task,
 				}
 			}
 
@@ -253,8 +377,67 @@ func (self *FilesController) GetOnRenderToMain() func() error {
 	}
 }
 
+// a section header shows the combined diff of its files
+func (self *FilesController) renderSection(node *filetree.FileNode, section filetree.FileSection) error {
+	var title string
+	cached := false
+	switch section {
+	case filetree.SectionUntracked:
+		title = self.c.Tr.UntrackedSection
+	case filetree.SectionStaged:
+		title = self.c.Tr.StagedChanges
+		cached = true
+	default:
+		title = self.c.Tr.UnstagedChanges
+	}
+
+	task := self.nodeDiffTask(node, cached)
+
+	return self.c.RenderToMainViews(types.RefreshMainOpts{
+		Pair: self.c.MainViewPairs().Normal,
+		Main: &types.ViewUpdateOpts{
+			Title:    title,
+			SubTitle: self.c.Helpers().Diff.IgnoringWhitespaceSubTitle(),
+			Task:     task,
+		},
+	})
+}
+
+// nodeDiffTask shows the diff of a file, or the
+// combined diff of all files below a directory, however deeply nested or
+// collapsed. For a directory we pass the paths of its files rather than its
+// own path, so that only the files of the node are included, e.g. when
+// grouping by change type, and so that untracked files are included too.
+func (self *FilesController) nodeDiffTask(node *filetree.FileNode, cached bool) types.UpdateTask {
+	if node.File != nil {
+		return types.NewRunPtyTask(self.c.Git().WorkingTree.WorktreeFileDiffCmdObj(node, false, cached).GetCmd())
+	}
+
+	trackedPaths := []string{}
+	untrackedPaths := []string{}
+	_ = node.ForEachFile(func(file *models.File) error {
+		if file.Tracked {
+			trackedPaths = append(trackedPaths, file.Names()...)
+		} else if !file.IsNestedRepo {
+			untrackedPaths = append(untrackedPaths, file.Name)
+		}
+		return nil
+	})
+
+	// without any paths git would diff the whole repo, e.g. for a directory
+	// holding nothing but nested repos
+	if len(trackedPaths) == 0 && len(untrackedPaths) == 0 {
+		return types.NewRenderStringTask("")
+	}
+
+	cmdObj, prepare := self.c.Git().WorkingTree.WorktreeFilesDiffCmdObj(trackedPaths, untrackedPaths, cached)
+	task := types.NewRunPtyTask(cmdObj.GetCmd())
+	task.Prepare = prepare
+	return task
+}
+
 func (self *FilesController) GetOnClick() func() error {
-	return self.checkSelectedFileNode(self.press)
+	return self.withSelectedNodes(self.press)
 }
 
 // if we are dealing with a status for which there is no key in this map,
@@ -304,23 +487,33 @@ func (self *FilesController) optimisticUnstage(file *models.File) bool {
 // the files panel. Then we'll immediately do a proper git status call
 // so that if the optimistic rendering got something wrong, it's quickly
 // corrected.
-func (self *FilesController) optimisticChange(node *filetree.FileNode, optimisticChangeFn func(*models.File) bool) error {
+func (self *FilesController) optimisticChange(nodes []*filetree.FileNode, optimisticChangeFn func(*models.File) bool) error {
 	rerender := false
-	err := node.ForEachFile(func(f *models.File) error {
-		// can't act on the file itself: we need to update the original model file
-		for _, modelFile := range self.c.Model().Files {
-			if modelFile.Name == f.Name {
-				if optimisticChangeFn(modelFile) {
-					rerender = true
+	// a selected range may contain both a directory and files within it, and
+	// we must only change each file once
+	changed := map[string]bool{}
+	for _, node := range nodes {
+		err := node.ForEachFile(func(f *models.File) error {
+			if changed[f.Name] {
+				return nil
+			}
+			changed[f.Name] = true
+
+			// can't act on the file itself: we need to update the original model file
+			for _, modelFile := range self.c.Model().Files {
+				if modelFile.Name == f.Name {
+					if optimisticChangeFn(modelFile) {
+						rerender = true
+					}
+					break
 				}
-				break
 			}
-		}
 
-		return nil
-	})
-	if err != nil {
-		return err
+			return nil
+		})
+		if err != nil {
+			return err
+		}
 	}
 	if rerender {
 		if err := self.c.PostRefreshUpdate(self.c.Contexts().Files); err != nil {
@@ -331,19 +524,24 @@ func (self *FilesController) optimisticChange(node *filetree.FileNode, optimisti
 	return nil
 }
 
-func (self *FilesController) pressWithLock(node *filetree.FileNode) error {
+func (self *FilesController) pressWithLock(nodes []*filetree.FileNode) error {
 	// Obtaining this lock because optimistic rendering requires us to mutate
 	// the files in our model.
 	self.c.Mutexes().RefreshingFilesMutex.Lock()
 	defer self.c.Mutexes().RefreshingFilesMutex.Unlock()
 
+	if len(nodes) > 1 {
+		return self.pressRange(nodes)
+	}
+
+	node := nodes[0]
 	if node.IsFile() {
 		file := node.File
 
 		if file.HasUnstagedChanges {
 			self.c.LogAction(self.c.Tr.Actions.StageFile)
 
-			if err := self.optimisticChange(node, self.optimisticStage); err != nil {
+			if err := self.optimisticChange(nodes, self.optimisticStage); err != nil {
 				return err
 			}
 
@@ -353,7 +551,7 @@ func (self *FilesController) pressWithLock(node *filetree.FileNode) error {
 		} else {
 			self.c.LogAction(self.c.Tr.Actions.UnstageFile)
 
-			if err := self.optimisticChange(node, self.optimisticUnstage); err != nil {
+			if err := self.optimisticChange(nodes, self.optimisticUnstage); err != nil {
 				return err
 			}
 
@@ -371,22 +569,21 @@ func (self *FilesController) pressWithLock(node *filetree.FileNode) error {
 		if node.GetHasUnstagedChanges() {
 			self.c.LogAction(self.c.Tr.Actions.StageFile)
 
-			if err := self.optimisticChange(node, self.optimisticStage); err != nil {
+			if err := self.optimisticChange(nodes, self.optimisticStage); err != nil {
 				return err
 			}
 
-			if err := self.c.Git().WorkingTree.StageFile(node.Path); err != nil {
+			if err := self.stageDirOrSection(node); err != nil {
 				return self.c.Error(err)
 			}
 		} else {
 			self.c.LogAction(self.c.Tr.Actions.UnstageFile)
 
-			if err := self.optimisticChange(node, self.optimisticUnstage); err != nil {
+			if err := self.optimisticChange(nodes, self.optimisticUnstage); err != nil {
 				return err
 			}
 
-			// pretty sure it doesn't matter that we're always passing true here
-			if err := self.c.Git().WorkingTree.UnStageFile([]string{node.Path}, true); err != nil {
+			if err := self.unstageDirOrSection(node); err != nil {
 				return self.c.Error(err)
 			}
 		}
@@ -395,20 +592,160 @@ func (self *FilesController) pressWithLock(node *filetree.FileNode) error {
 	return nil
 }
 
-func (self *FilesController) press(node *filetree.FileNode) error {
-	if node.IsFile() && node.File.HasInlineMergeConflicts {
+// Stages everything in the selected range if any of it has unstaged changes,
+// and unstages all of it otherwise. Either way we get by with a single git
+// call, rather than one per file.
+func (self *FilesController) pressRange(nodes []*filetree.FileNode) error {
+	// if any files within have inline merge conflicts we can't stage or unstage,
+	// or it'll end up with those >>>>>> lines actually staged
+	if lo.SomeBy(nodes, (*filetree.FileNode).GetHasInlineMergeConflicts) {
+		return self.c.ErrorMsg(self.c.Tr.ErrStageRangeWithMergeConflicts)
+	}
+
+	if lo.SomeBy(nodes, (*filetree.FileNode).GetHasUnstagedChanges) {
+		self.c.LogAction(self.c.Tr.Actions.StageFile)
+
+		if err := self.optimisticChange(nodes, self.optimisticStage); err != nil {
+			return err
+		}
+
+		if err := self.c.Git().WorkingTree.StageFiles(pathsOfNodes(nodes)); err != nil {
+			return self.c.Error(err)
+		}
+	} else {
+		self.c.LogAction(self.c.Tr.Actions.UnstageFile)
+
+		if err := self.optimisticChange(nodes, self.optimisticUnstage); err != nil {
+			return err
+		}
+
+		if err := self.c.Git().WorkingTree.UnstageFiles(self.context().GetSelectedFiles()); err != nil {
+			return self.c.Error(err)
+		}
+	}
+
+	return nil
+}
+
+// the names of the matching files, including the old names of renamed files
+func namesOfFiles(files []*models.File, test func(*models.File) bool) []string {
+	return lo.FlatMap(files, func(file *models.File, _ int) []string {
+		if !test(file) {
+			return nil
+		}
+		return file.Names()
+	})
+}
+
+// the paths to pass to git to act on the given nodes. A section header has no
+// path of its own, so it stands for the paths of its files
+func pathsOfNodes(nodes []*filetree.FileNode) []string {
+	return lo.Uniq(lo.FlatMap(nodes, func(node *filetree.FileNode, _ int) []string {
+		if node.IsSectionHeader() {
+			return node.GetFilePathsMatching(func(*models.File) bool { return true })
+		}
+		return []string{node.GetPath()}
+	}))
+}
+
+// a section header has no path of its own, so we stage its files individually
+func (self *FilesController) stageDirOrSection(node *filetree.FileNode) error {
+	if node.IsSectionHeader() {
+		return self.c.Git().WorkingTree.StageFiles(
+			node.GetFilePathsMatching(func(*models.File) bool { return true }),
+		)
+	}
+
+	return self.c.Git().WorkingTree.StageFile(node.Path)
+}
+
+func (self *FilesController) unstageDirOrSection(node *filetree.FileNode) error {
+	if node.IsSectionHeader() {
+		return self.c.Git().WorkingTree.UnstageFiles(
+			lo.Map(node.GetLeaves(), func(leaf *filetree.Node[models.File], _ int) *models.File { return leaf.File }),
+		)
+	}
+
+	// pretty sure it doesn't matter that we're always passing true here
+	return self.c.Git().WorkingTree.UnStageFile([]string{node.Path}, true)
+}
+
+func (self *FilesController) press(nodes []*filetree.FileNode) error {
+	if len(nodes) == 1 && nodes[0].IsFile() && nodes[0].File.HasInlineMergeConflicts {
 		return self.switchToMerge()
 	}
 
-	if err := self.pressWithLock(node); err != nil {
-		return err
+	return self.withLargeFilesConfirmation(nodes, func() error {
+		if err := self.pressWithLock(nodes); err != nil {
+			return err
+		}
+
+		if err := self.c.Refresh(types.RefreshOptions{Scope: []types.RefreshableView{types.FILES}, Mode: types.ASYNC}); err != nil {
+			return err
+		}
+
+		return self.context().HandleFocus(types.OnFocusOpts{})
+	})
+}
+
+// if staging the nodes would stage any files over the large file threshold, we
+// suggest tracking them with git-lfs first
+func (self *FilesController) withLargeFilesConfirmation(nodes []*filetree.FileNode, f func() error) error {
+	if !lo.SomeBy(nodes, (*filetree.FileNode).GetHasUnstagedChanges) {
+		return f()
 	}
 
-	if err := self.c.Refresh(types.RefreshOptions{Scope: []types.RefreshableView{types.FILES}, Mode: types.ASYNC}); err != nil {
-		return err
+	largeFiles := self.largeFilesToStage(nodes)
+	if len(largeFiles) == 0 {
+		return f()
+	}
+
+	fileSizes, _ := presentation.NewFileSizeOptions(self.c.UserConfig.Gui.Files)
+	fileList := strings.Join(lo.Map(largeFiles, func(file *models.File, _ int) string {
+		return fmt.Sprintf("%s (%s)", file.Name, utils.FormatByteSize(file.Size))
+	}), "\n")
+
+	return self.c.Confirm(types.ConfirmOpts{
+		Title: self.c.Tr.StageLargeFilesTitle,
+		Prompt: utils.ResolvePlaceholderString(self.c.Tr.StageLargeFilesPrompt, map[string]string{
+			"threshold": utils.FormatByteSize(fileSizes.LargeThreshold),
+			"files":     fileList,
+		}),
+		HandleConfirm: f,
+	})
+}
+
+func (self *FilesController) largeFilesToStage(nodes []*filetree.FileNode) []*models.File {
+	fileSizes, _ := presentation.NewFileSizeOptions(self.c.UserConfig.Gui.Files)
+	if fileSizes.LargeThreshold == 0 {
+		return nil
+	}
+
+	largeFiles := []*models.File{}
+	for _, node := range nodes {
+		_ = node.ForEachFile(func(file *models.File) error {
+			if file.HasUnstagedChanges && !file.Deleted && file.Size >= fileSizes.LargeThreshold &&
+				!lo.Contains(largeFiles, file) {
+				largeFiles = append(largeFiles, file)
+			}
+			return nil
+		})
+	}
+	if len(largeFiles) == 0 {
+		return nil
+	}
+
+	// files that are already tracked with git-lfs are fine
+	lfsTrackedFiles, err := self.c.Git().WorkingTree.LfsTrackedFiles(lo.Map(largeFiles, func(file *models.File, _ int) string {
+		return file.Name
+	}))
+	if err != nil {
+		self.c.Log.Error(err)
 	}
 
-	return self.context().HandleFocus(types.OnFocusOpts{})
+	return lo.Filter(largeFiles, func(file *models.File, _ int) bool {
+		return !lo.Contains(lfsTrackedFiles, file.Name)
+	})
 }
 
 func (self *FilesController) checkSelectedFileNode(callback func(*filetree.FileNode) error) func() error {
@@ -422,6 +759,17 @@ func (self *FilesController) checkSelectedFileNode(callback func(*filetree.FileN
 	}
 }
 
+func (self *FilesController) withSelectedNodes(callback func([]*filetree.FileNode) error) func() error {
+	return func() error {
+		nodes := self.context().GetSelectedNodes()
+		if len(nodes) == 0 {
+			return nil
+		}
+
+		return callback(nodes)
+	}
+}
+
 func (self *FilesController) Context() types.Context {
 	return self.context()
 }
@@ -460,6 +808,10 @@ func (self *FilesController) EnterFile(opts types.OnFocusOpts) error {
 		return self.c.Helpers().Repos.EnterSubmodule(submoduleConfig)
 	}
 
+	if file.IsNestedRepo {
+		return self.createNestedRepoMenu(file)
+	}
+
 	if file.HasInlineMergeConflicts {
 		return self.switchToMerge()
 	}
@@ -467,19 +819,68 @@ func (self *FilesController) EnterFile(opts types.OnFocusOpts) error {
 		return self.c.ErrorMsg(self.c.Tr.FileStagingRequirements)
 	}
 
+	if err := self.c.Helpers().Staging.PrepareFileForStaging(file); err != nil {
+		return self.c.Error(err)
+	}
+
 	return self.c.PushContext(self.c.Contexts().Staging, opts)
 }
 
+func (self *FilesController) createNestedRepoMenu(file *models.File) error {
+	return self.c.Menu(types.CreateMenuOptions{
+		Title: utils.ResolvePlaceholderString(self.c.Tr.NestedRepoOptions, map[string]string{"path": file.Name}),
+		Items: []*types.MenuItem{
+			{
+				Label: self.c.Tr.OpenNestedRepo,
+				OnPress: func() error {
+					return self.c.Helpers().Repos.EnterNestedRepo(file.Name)
+				},
+				Key:     'o',
+				Tooltip: self.c.Tr.OpenNestedRepoTooltip,
+			},
+			{
+				Label: self.c.Tr.ConvertNestedRepoToSubmodule,
+				OnPress: func() error {
+					return self.convertNestedRepoToSubmodule(file)
+				},
+				Key:     's',
+				Tooltip: self.c.Tr.ConvertNestedRepoToSubmoduleTooltip,
+			},
+		},
+	})
+}
+
+func (self *FilesController) convertNestedRepoToSubmodule(file *models.File) error {
+	return self.c.Prompt(types.PromptOpts{
+		Title:          self.c.Tr.NewSubmoduleUrl,
+		InitialContent: self.c.Git().Submodule.GetNestedRepoUrl(file.Name),
+		HandleConfirm: func(submoduleUrl string) error {
+			return self.c.WithWaitingStatus(self.c.Tr.AddingSubmoduleStatus, func(gocui.Task) error {
+				self.c.LogAction(self.c.Tr.Actions.ConvertNestedRepoToSubmodule)
+				// git picks up the repo that's already there rather than cloning
+				// the URL again
+				if err := self.c.Git().Submodule.Add(file.Name, file.Name, submoduleUrl); err != nil {
+					return self.c.Error(err)
+				}
+
+				return self.c.Refresh(types.RefreshOptions{Scope: []types.RefreshableView{types.FILES, types.SUBMODULES}})
+			})
+		},
+	})
+}
+
 func (self *FilesController) toggleStagedAll() error {
-	if err := self.toggleStagedAllWithLock(); err != nil {
-		return err
-	}
+	return self.withLargeFilesConfirmation([]*filetree.FileNode{self.context().FileTreeViewModel.GetRoot()}, func() error {
+		if err := self.toggleStagedAllWithLock(); err != nil {
+			return err
+		}
 
-	if err := self.c.Refresh(types.RefreshOptions{Scope: []types.RefreshableView{types.FILES}, Mode: types.ASYNC}); err != nil {
-		return err
-	}
+		if err := self.c.Refresh(types.RefreshOptions{Scope: []types.RefreshableView{types.FILES}, Mode: types.ASYNC}); err != nil {
+			return err
+		}
 
-	return self.context().HandleFocus(types.OnFocusOpts{})
+		return self.context().HandleFocus(types.OnFocusOpts{})
+	})
 }
 
 func (self *FilesController) toggleStagedAllWithLock() error {
@@ -497,7 +898,7 @@ func (self *FilesController) toggleStagedAllWithLock() error {
 	if root.GetHasUnstagedChanges() {
 		self.c.LogAction(self.c.Tr.Actions.StageAllFiles)
 
-		if err := self.optimisticChange(root, self.optimisticStage); err != nil {
+		if err := self.optimisticChange([]*filetree.FileNode{root}, self.optimisticStage); err != nil {
 			return err
 		}
 
@@ -507,7 +908,7 @@ func (self *FilesController) toggleStagedAllWithLock() error {
 	} else {
 		self.c.LogAction(self.c.Tr.Actions.UnstageAllFiles)
 
-		if err := self.optimisticChange(root, self.optimisticUnstage); err != nil {
+		if err := self.optimisticChange([]*filetree.FileNode{root}, self.optimisticUnstage); err != nil {
 			return err
 		}
 
@@ -601,28 +1002,153 @@ func (self *FilesController) exclude(node *filetree.FileNode) error {
 }
 
 func (self *FilesController) ignoreOrExcludeMenu(node *filetree.FileNode) error {
+	menuItems := []*types.MenuItem{
+		{
+			LabelColumns: []string{self.c.Tr.IgnoreFile},
+			OnPress: func() error {
+				if err := self.ignore(node); err != nil {
+					return self.c.Error(err)
+				}
+				return nil
+			},
+			Key: 'i',
+		},
+		{
+			LabelColumns: []string{self.c.Tr.ExcludeFile},
+			OnPress: func() error {
+				if err := self.exclude(node); err != nil {
+					return self.c.Error(err)
+				}
+				return nil
+			},
+			Key: 'e',
+		},
+	}
+
+	for _, pattern := range ignorePatterns(node) {
+		pattern := pattern
+		menuItems = append(menuItems,
+			&types.MenuItem{
+				LabelColumns: []string{utils.ResolvePlaceholderString(self.c.Tr.IgnorePattern, map[string]string{"pattern": pattern.pattern})},
+				OnPress: func() error {
+					return self.addIgnorePattern(pattern.pattern, self.c.Git().WorkingTree.Ignore)
+				},
+				Key: pattern.key,
+			},
+			&types.MenuItem{
+				LabelColumns: []string{utils.ResolvePlaceholderString(self.c.Tr.ExcludePattern, map[string]string{"pattern": pattern.pattern})},
+				OnPress: func() error {
+					return self.addIgnorePattern(pattern.pattern, self.c.Git().WorkingTree.Exclude)
+				},
+				Key: unicode.ToUpper(pattern.key),
+			},
+		)
+	}
+
+	menuItems = append(menuItems, &types.MenuItem{
+		LabelColumns: []string{self.c.Tr.ShowIgnoreRules},
+		OnPress: func() error {
+			return self.createIgnoreRulesMenu(node.GetPath())
+		},
+		Key: 'r',
+	})
+
 	return self.c.Menu(types.CreateMenuOptions{
 		Title: self.c.Tr.Actions.IgnoreExcludeFile,
+		Items: menuItems,
+	})
+}
+
+type ignorePattern struct {
+	pattern string
+	key     rune
+}
+
+// ignorePatterns returns the patterns, other than the path itself, that the
+// user might want to ignore a file by: its extension and its directory
+func ignorePatterns(node *filetree.FileNode) []ignorePattern {
+	if !node.GetIsFile() {
+		return nil
+	}
+
+	patterns := []ignorePattern{}
+
+	// we don't want to treat dotfiles like .env as having an extension
+	base := path.Base(node.GetPath())
+	if ext := path.Ext(base); ext != "" && ext != base {
+		patterns = append(patterns, ignorePattern{pattern: "*" + ext, key: 'x'})
+	}
+
+	if dir := path.Dir(node.GetPath()); dir != "." {
+		patterns = append(patterns, ignorePattern{pattern: "/" + dir + "/", key: 'd'})
+	}
+
+	return patterns
+}
+
+func (self *FilesController) addIgnorePattern(pattern string, f func(string) error) error {
+	self.c.LogAction(self.c.Tr.Actions.IgnoreExcludeFile)
+	if err := f(pattern); err != nil {
+		return self.c.Error(err)
+	}
+
+	return self.c.Refresh(types.RefreshOptions{Scope: []types.RefreshableView{types.FILES}})
+}
+
+func (self *FilesController) createIgnoreRulesMenu(filePath string) error {
+	rules, err := self.c.Git().WorkingTree.GetMatchingIgnoreRules(filePath)
+	if err != nil {
+		return self.c.Error(err)
+	}
+
+	if len(rules) == 0 {
+		self.c.Toast(utils.ResolvePlaceholderString(self.c.Tr.NoIgnoreRules, map[string]string{"path": filePath}))
+		return nil
+	}
+
+	menuItems := lo.Map(rules, func(rule *models.IgnoreRule, _ int) *types.MenuItem {
+		return &types.MenuItem{
+			LabelColumns: []string{rule.Pattern, style.FgYellow.Sprint(rule.Location())},
+			OnPress: func() error {
+				return self.createIgnoreRuleOptionsMenu(rule)
+			},
+		}
+	})
+
+	return self.c.Menu(types.CreateMenuOptions{
+		Title: utils.ResolvePlaceholderString(self.c.Tr.IgnoreRulesTitle, map[string]string{"path": filePath}),
+		Items: menuItems,
+	})
+}
+
+func (self *FilesController) createIgnoreRuleOptionsMenu(rule *models.IgnoreRule) error {
+	var removeDisabledReason *types.DisabledReason
+	if !self.c.Git().WorkingTree.IsRepoIgnoreFile(rule.Source) {
+		removeDisabledReason = &types.DisabledReason{Text: self.c.Tr.CantRemoveGlobalIgnoreRule}
+	}
+
+	return self.c.Menu(types.CreateMenuOptions{
+		Title: rule.Pattern,
 		Items: []*types.MenuItem{
 			{
-				LabelColumns: []string{self.c.Tr.IgnoreFile},
+				Label: self.c.Tr.EditIgnoreRule,
 				OnPress: func() error {
-					if err := self.ignore(node); err != nil {
-						return self.c.Error(err)
-					}
-					return nil
+					return self.c.Helpers().Files.EditFileAtLine(rule.Source, rule.LineNumber)
 				},
-				Key: 'i',
+				Key: 'e',
 			},
 			{
-				LabelColumns: []string{self.c.Tr.ExcludeFile},
+				Label: self.c.Tr.RemoveIgnoreRule,
 				OnPress: func() error {
-					if err := self.exclude(node); err != nil {
+					self.c.LogAction(self.c.Tr.Actions.RemoveIgnoreRule)
+					if err := self.c.Git().WorkingTree.RemoveIgnoreRule(rule); err != nil {
 						return self.c.Error(err)
 					}
-					return nil
+
+					return self.c.Refresh(types.RefreshOptions{Scope: []types.RefreshableView{types.FILES}})
 				},
-				Key: 'e',
+				Key:            'd',
+				DisabledReason: removeDisabledReason,
 			},
 		},
 	})
@@ -740,7 +1266,8 @@ func (self *FilesController) createStashMenu() error {
 				Key: 'a',
 			},
 			{
-				Label: self.c.Tr.StashAllChangesKeepIndex,
+				Label:   self.c.Tr.StashAllChangesKeepIndex,
+				Tooltip: self.c.Tr.StashAllChangesKeepIndexTooltip,
 				OnPress: func() error {
 					if !self.c.Helpers().WorkingTree.IsWorkingTreeDirty() {
 						return self.c.ErrorMsg(self.c.Tr.NoFilesToStash)
@@ -751,14 +1278,30 @@ func (self *FilesController) createStashMenu() error {
 				Key: 'i',
 			},
 			{
-				Label: self.c.Tr.StashIncludeUntrackedChanges,
+				Label:   self.c.Tr.StashIncludeUntrackedChanges,
+				Tooltip: self.c.Tr.StashIncludeUntrackedTooltip,
 				OnPress: func() error {
 					return self.handleStashSave(self.c.Git().Stash.StashIncludeUntrackedChanges, self.c.Tr.Actions.StashIncludeUntrackedChanges)
 				},
 				Key: 'U',
 			},
 			{
-				Label: self.c.Tr.StashStagedChanges,
+				Label:   self.c.Tr.StashIncludingIgnored,
+				Tooltip: self.c.Tr.StashIncludingIgnoredTooltip,
+				OnPress: func() error {
+					return self.c.Confirm(types.ConfirmOpts{
+						Title:  self.c.Tr.StashIncludingIgnored,
+						Prompt: self.c.Tr.StashIncludingIgnoredWarning,
+						HandleConfirm: func() error {
+							return self.handleStashSave(self.c.Git().Stash.StashAllChangesIncludingIgnored, self.c.Tr.Actions.StashIncludingIgnored)
+						},
+					})
+				},
+				Key: 'A',
+			},
+			{
+				Label:   self.c.Tr.StashStagedChanges,
+				Tooltip: self.c.Tr.StashStagedChangesTooltip,
 				OnPress: func() error {
 					// there must be something in staging otherwise the current implementation mucks the stash up
 					if !self.c.Helpers().WorkingTree.AnyStagedFiles() {
@@ -769,7 +1312,8 @@ func (self *FilesController) createStashMenu() error {
 				Key: 's',
 			},
 			{
-				Label: self.c.Tr.StashUnstagedChanges,
+				Label:   self.c.Tr.StashUnstagedChanges,
+				Tooltip: self.c.Tr.StashUnstagedChangesTooltip,
 				OnPress: func() error {
 					if !self.c.Helpers().WorkingTree.IsWorkingTreeDirty() {
 						return self.c.ErrorMsg(self.c.Tr.NoFilesToStash)
@@ -782,6 +1326,20 @@ func (self *FilesController) createStashMenu() error {
 				},
 				Key: 'u',
 			},
+			{
+				Label:   self.c.Tr.StashSelectedFiles,
+				Tooltip: self.c.Tr.StashSelectedFilesTooltip,
+				OnPress: func() error {
+					paths := pathsOfNodes(self.context().GetSelectedNodes())
+					if len(paths) == 0 {
+						return self.c.ErrorMsg(self.c.Tr.NoFilesToStash)
+					}
+					return self.handleStashSave(func(message string) error {
+						return self.c.Git().Stash.StashPaths(message, paths)
+					}, self.c.Tr.Actions.StashSelectedFiles)
+				},
+				Key: 'f',
+			},
 		},
 	})
 }
@@ -789,28 +1347,18 @@ func (self *FilesController) createStashMenu() error {
 func (self *FilesController) openCopyMenu() error {
 	node := self.context().GetSelected()
 
-	copyNameItem := &types.MenuItem{
-		Label: self.c.Tr.CopyFileName,
-		OnPress: func() error {
-			if err := self.c.OS().CopyToClipboard(node.Name()); err != nil {
-				return self.c.Error(err)
-			}
-			self.c.Toast(self.c.Tr.FileNameCopiedToast)
-			return nil
-		},
-		Key: 'n',
+	var pathDisabledReason *types.DisabledReason
+	if node == nil {
+		pathDisabledReason = &types.DisabledReason{Text: self.c.Tr.NoContentToCopyError}
+	} else if node.IsSectionHeader() {
+		pathDisabledReason = &types.DisabledReason{Text: self.c.Tr.NotAvailableOnSectionHeader}
 	}
-	copyPathItem := &types.MenuItem{
-		Label: self.c.Tr.CopyFilePath,
-		OnPress: func() error {
-			if err := self.c.OS().CopyToClipboard(node.Path); err != nil {
-				return self.c.Error(err)
-			}
-			self.c.Toast(self.c.Tr.FilePathCopiedToast)
-			return nil
-		},
-		Key: 'p',
+	selectedPath := ""
+	if node != nil {
+		selectedPath = node.GetPath()
 	}
+	pathItems := (&CopyPathMenuAction{c: self.c}).MenuItems(selectedPath, pathDisabledReason)
+
 	copyFileDiffItem := &types.MenuItem{
 		Label:   self.c.Tr.CopySelectedDiff,
 		Tooltip: self.c.Tr.CopyFileDiffTooltip,
@@ -847,13 +1395,11 @@ func (self *FilesController) openCopyMenu() error {
 		Key: 'a',
 	}
 
-	if node == nil {
-		copyNameItem.DisabledReason = &types.DisabledReason{Text: self.c.Tr.NoContentToCopyError}
-		copyPathItem.DisabledReason = &types.DisabledReason{Text: self.c.Tr.NoContentToCopyError}
+	if node == nil || !node.GetHasStagedOrTrackedChanges() {
 		copyFileDiffItem.DisabledReason = &types.DisabledReason{Text: self.c.Tr.NoContentToCopyError}
 	}
-	if node != nil && !node.GetHasStagedOrTrackedChanges() {
-		copyFileDiffItem.DisabledReason = &types.DisabledReason{Text: self.c.Tr.NoContentToCopyError}
+	if node.IsSectionHeader() {
+		copyFileDiffItem.DisabledReason = pathDisabledReason
 	}
 	if !self.anyStagedOrTrackedFile() {
 		copyAllDiff.DisabledReason = &types.DisabledReason{Text: self.c.Tr.NoContentToCopyError}
@@ -861,12 +1407,7 @@ func (self *FilesController) openCopyMenu() error {
 
 	return self.c.Menu(types.CreateMenuOptions{
 		Title: self.c.Tr.CopyToClipboardMenu,
-		Items: []*types.MenuItem{
-			copyNameItem,
-			copyPathItem,
-			copyFileDiffItem,
-			copyAllDiff,
-		},
+		Items: append(pathItems, copyFileDiffItem, copyAllDiff),
 	})
 }
 
@@ -906,12 +1447,227 @@ func (self *FilesController) handleToggleDirCollapsed() error {
 	return nil
 }
 
+func (self *FilesController) filterCommitsByPath(node *filetree.FileNode) error {
+	return (&FilteringMenuAction{c: self.c}).setFiltering(node.GetPath())
+}
+
+func (self *FilesController) getDisabledReasonForSectionHeader() *types.DisabledReason {
+	if self.context().GetSelected().IsSectionHeader() {
+		return &types.DisabledReason{Text: self.c.Tr.NotAvailableOnSectionHeader}
+	}
+
+	return nil
+}
+
+func (self *FilesController) viewFileHistory(node *filetree.FileNode) error {
+	return self.c.Helpers().FileHistory.ViewFileHistory(node.GetPath(), "HEAD", self.context())
+}
+
+func (self *FilesController) getDisabledReasonForFileHistory() *types.DisabledReason {
+	if node := self.context().GetSelected(); node != nil && node.File == nil {
+		return &types.DisabledReason{Text: self.c.Tr.CantFlagDirectory}
+	}
+
+	return nil
+}
+
+// renders the authors of the selected files to the main view, which stays
+// until the selection changes
+func (self *FilesController) viewFileOwnership(node *filetree.FileNode) error {
+	paths := []string{}
+	_ = node.ForEachFile(func(file *models.File) error {
+		paths = append(paths, file.Name)
+		return nil
+	})
+	header := utils.ResolvePlaceholderString(self.c.Tr.FileOwnershipHeader, map[string]string{
+		"path": node.GetPath(),
+	})
+
+	return self.c.WithWaitingStatus(self.c.Tr.LoadingFileOwnership, func(gocui.Task) error {
+		ownerships, err := self.c.Git().Commit.GetFileOwnership(paths)
+		if err != nil {
+			return self.c.Error(err)
+		}
+
+		content := presentation.GetFileOwnershipDisplayString(ownerships, self.c.Views().Main.InnerWidth(), self.c.Tr)
+
+		self.c.OnUIThread(func() error {
+			return self.c.RenderToMainViews(types.RefreshMainOpts{
+				Pair: self.c.MainViewPairs().Normal,
+				Main: &types.ViewUpdateOpts{
+					Title: self.c.Tr.FileOwnershipTitle,
+					Task:  types.NewRenderStringTask(style.AttrBold.Sprint(header) + "\n\n" + content),
+				},
+			})
+		})
+		return nil
+	})
+}
+
+func (self *FilesController) toggleGroupByChangeType() error {
+	self.context().FileTreeViewModel.ToggleGroupByChangeType()
+
+	return self.c.PostRefreshUpdate(self.context())
+}
+
 func (self *FilesController) toggleTreeView() error {
 	self.context().FileTreeViewModel.ToggleShowTree()
 
 	return self.c.PostRefreshUpdate(self.context())
 }
 
+func (self *FilesController) cycleSortOrder() error {
+	sortOrder := self.context().GetSortOrder().Next()
+
+	appState := self.c.GetAppState()
+	if appState.FileSortOrders == nil {
+		appState.FileSortOrders = map[string]string{}
+	}
+	appState.FileSortOrders[self.c.Git().RepoPaths.RepoPath()] = string(sortOrder)
+	self.c.SaveAppStateAndLogError()
+
+	sortOrderNames := map[filetree.FileSortOrder]string{
+		filetree.SortByName:         self.c.Tr.FileSortByName,
+		filetree.SortByStatus:       self.c.Tr.FileSortByStatus,
+		filetree.SortByModTime:      self.c.Tr.FileSortByModTime,
+		filetree.SortByChangedLines: self.c.Tr.FileSortByChangedLines,
+	}
+	self.c.Toast(utils.ResolvePlaceholderString(self.c.Tr.FilesSortedBy, map[string]string{
+		"sortOrder": sortOrderNames[sortOrder],
+	}))
+
+	// we need to refresh rather than just re-sort, because the sort order
+	// decides what we load for each file
+	return self.c.Refresh(types.RefreshOptions{Mode: types.ASYNC, Scope: []types.RefreshableView{types.FILES}})
+}
+
+func (self *FilesController) createParkedChangesMenu() error {
+	parkedPatches, err := self.c.Git().Patch.GetParkedPatches()
+	if err != nil {
+		return self.c.Error(err)
+	}
+	if len(parkedPatches) == 0 {
+		self.c.Toast(self.c.Tr.NoParkedChanges)
+		return nil
+	}
+
+	menuItems := lo.Map(parkedPatches, func(parkedPatch *models.ParkedPatch, _ int) *types.MenuItem {
+		return &types.MenuItem{
+			LabelColumns: []string{parkedPatch.Name, style.FgYellow.Sprint(strings.Join(parkedPatch.Files, ", "))},
+			OnPress: func() error {
+				return self.createParkedChangesOptionsMenu(parkedPatch)
+			},
+		}
+	})
+
+	return self.c.Menu(types.CreateMenuOptions{
+		Title: self.c.Tr.ViewParkedChanges,
+		Items: menuItems,
+	})
+}
+
+func (self *FilesController) createParkedChangesOptionsMenu(parkedPatch *models.ParkedPatch) error {
+	return self.c.Menu(types.CreateMenuOptions{
+		Title: parkedPatch.Description(),
+		Items: []*types.MenuItem{
+			{
+				Label: self.c.Tr.RestoreParkedChanges,
+				Key:   'r',
+				OnPress: func() error {
+					self.c.LogAction(self.c.Tr.Actions.RestoreParkedChanges)
+					if err := self.c.Git().Patch.UnparkPatch(parkedPatch); err != nil {
+						return self.c.Error(err)
+					}
+
+					return self.c.Refresh(types.RefreshOptions{Mode: types.ASYNC, Scope: []types.RefreshableView{types.FILES}})
+				},
+			},
+			{
+				Label: self.c.Tr.DropParkedChanges,
+				Key:   'd',
+				OnPress: func() error {
+					return self.c.Confirm(types.ConfirmOpts{
+						Title: self.c.Tr.DropParkedChanges,
+						Prompt: utils.ResolvePlaceholderString(self.c.Tr.DropParkedChangesPrompt, map[string]string{
+							"name": parkedPatch.Name,
+						}),
+						HandleConfirm: func() error {
+							self.c.LogAction(self.c.Tr.Actions.DropParkedChanges)
+							if err := self.c.Git().Patch.DropParkedPatch(parkedPatch); err != nil {
+								return self.c.Error(err)
+							}
+							return nil
+						},
+					})
+				},
+			},
+		},
+	})
+}
+
+func (self *FilesController) createTrashMenu() error {
+	trashEntries, err := self.c.Git().WorkingTree.GetTrashEntries()
+	if err != nil {
+		return self.c.Error(err)
+	}
+	if len(trashEntries) == 0 {
+		self.c.Toast(self.c.Tr.NoTrashEntries)
+		return nil
+	}
+
+	menuItems := lo.Map(trashEntries, func(trashEntry *models.TrashEntry, _ int) *types.MenuItem {
+		return &types.MenuItem{
+			LabelColumns: []string{utils.UnixToTimeAgo(trashEntry.Time.Unix()), style.FgYellow.Sprint(trashEntry.Description())},
+			OnPress: func() error {
+				return self.createTrashEntryOptionsMenu(trashEntry)
+			},
+		}
+	})
+
+	return self.c.Menu(types.CreateMenuOptions{
+		Title: self.c.Tr.ViewTrash,
+		Items: menuItems,
+	})
+}
+
+func (self *FilesController) createTrashEntryOptionsMenu(trashEntry *models.TrashEntry) error {
+	return self.c.Menu(types.CreateMenuOptions{
+		Title: trashEntry.Description(),
+		Items: []*types.MenuItem{
+			{
+				Label:   self.c.Tr.RestoreTrashEntry,
+				Key:     'r',
+				Tooltip: self.c.Tr.RestoreTrashEntryTooltip,
+				OnPress: func() error {
+					self.c.LogAction(self.c.Tr.Actions.RestoreTrashEntry)
+					if err := self.c.Git().WorkingTree.RestoreTrashEntry(trashEntry); err != nil {
+						return self.c.Error(err)
+					}
+
+					return self.c.Refresh(types.RefreshOptions{Mode: types.ASYNC, Scope: []types.RefreshableView{types.FILES}})
+				},
+			},
+			{
+				Label: self.c.Tr.DropTrashEntry,
+				Key:   'd',
+				OnPress: func() error {
+					return self.c.Confirm(types.ConfirmOpts{
+						Title:  self.c.Tr.DropTrashEntry,
+						Prompt: self.c.Tr.DropTrashEntryPrompt,
+						HandleConfirm: func() error {
+							self.c.LogAction(self.c.Tr.Actions.DropTrashEntry)
+							if err := self.c.Git().WorkingTree.DropTrashEntry(trashEntry); err != nil {
+								return self.c.Error(err)
+							}
+							return nil
+						},
+					})
+				},
+			},
+		},
+	})
+}
+
 func (self *FilesController) handleStashSave(stashFunc func(message string) error, action string) error {
 	return self.c.Prompt(types.PromptOpts{
 		Title: self.c.Tr.StashChanges,
@@ -955,3 +1711,192 @@ func (self *FilesController) fetchAux(task gocui.Task) (err error) {
 
 	return err
 }
+
+// indexFlags returns the assume-unchanged and skip-worktree bits of the file.
+// Unless the files panel shows them, they weren't loaded with the files, so we
+// look them up now
+func (self *FilesController) indexFlags(node *filetree.FileNode) (bool, bool, error) {
+	if self.c.UserConfig.Gui.Files.ShowIndexFlags {
+		return node.File.AssumeUnchanged, node.File.SkipWorktree, nil
+	}
+
+	return self.c.Git().File.GetIndexFlags(node.GetPath())
+}
+
+// the diff of a file whose content didn't change is just a header, so we
+// show the mode change on its own
+func (self *FilesController) renderModeChange(file *models.File) error {
+	title := self.c.Tr.UnstagedChanges
+	if file.HasStagedChanges {
+		title = self.c.Tr.StagedChanges
+	}
+
+	return self.c.RenderToMainViews(types.RefreshMainOpts{
+		Pair: self.c.MainViewPairs().Normal,
+		Main: &types.ViewUpdateOpts{
+			Title: title,
+			Task:  types.NewRenderStringTask(presentation.ModeChange(file)),
+		},
+	})
+}
+
+func (self *FilesController) toggleAssumeUnchanged(node *filetree.FileNode) error {
+	if err := self.validateIndexFlagTarget(node); err != nil {
+		return err
+	}
+
+	assumeUnchanged, _, err := self.indexFlags(node)
+	if err != nil {
+		return self.c.Error(err)
+	}
+
+	if assumeUnchanged {
+		self.c.LogAction(self.c.Tr.Actions.ClearAssumeUnchanged)
+	} else {
+		self.c.LogAction(self.c.Tr.Actions.SetAssumeUnchanged)
+	}
+	if err := self.c.Git().File.SetAssumeUnchanged(node.GetPath(), !assumeUnchanged); err != nil {
+		return self.c.Error(err)
+	}
+
+	return self.c.Refresh(types.RefreshOptions{Scope: []types.RefreshableView{types.FILES}})
+}
+
+func (self *FilesController) toggleSkipWorktree(node *filetree.FileNode) error {
+	if err := self.validateIndexFlagTarget(node); err != nil {
+		return err
+	}
+
+	_, skipWorktree, err := self.indexFlags(node)
+	if err != nil {
+		return self.c.Error(err)
+	}
+
+	if skipWorktree {
+		self.c.LogAction(self.c.Tr.Actions.ClearSkipWorktree)
+	} else {
+		self.c.LogAction(self.c.Tr.Actions.SetSkipWorktree)
+	}
+	if err := self.c.Git().File.SetSkipWorktree(node.GetPath(), !skipWorktree); err != nil {
+		return self.c.Error(err)
+	}
+
+	return self.c.Refresh(types.RefreshOptions{Scope: []types.RefreshableView{types.FILES}})
+}
+
+func (self *FilesController) toggleExecutable(node *filetree.FileNode) error {
+	if err := self.validateIndexFlagTarget(node); err != nil {
+		return err
+	}
+
+	isExecutable, err := self.c.Git().File.IsExecutableInIndex(node.GetPath())
+	if err != nil {
+		return self.c.Error(err)
+	}
+
+	if isExecutable {
+		self.c.LogAction(self.c.Tr.Actions.ClearExecutable)
+	} else {
+		self.c.LogAction(self.c.Tr.Actions.SetExecutable)
+	}
+	if err := self.c.Git().File.SetExecutable(node.GetPath(), !isExecutable); err != nil {
+		return self.c.Error(err)
+	}
+
+	return self.c.Refresh(types.RefreshOptions{Scope: []types.RefreshableView{types.FILES}})
+}
+
+// the assume-unchanged and skip-worktree bits live on index entries, so they
+// only apply to individual tracked files
+func (self *FilesController) validateIndexFlagTarget(node *filetree.FileNode) error {
+	if node.File == nil {
+		return self.c.ErrorMsg(self.c.Tr.CantFlagDirectory)
+	}
+
+	if !node.File.Tracked {
+		return self.c.ErrorMsg(self.c.Tr.CantFlagUntrackedFile)
+	}
+
+	return nil
+}
+
+func (self *FilesController) createStageByPatternMenu() error {
+	return self.c.Menu(types.CreateMenuOptions{
+		Title: self.c.Tr.StageByPattern,
+		Items: []*types.MenuItem{
+			{
+				Label: self.c.Tr.StageMatchingFiles,
+				OnPress: func() error {
+					return self.promptForPathPattern(self.stageMatchingFiles)
+				},
+				Key: 's',
+			},
+			{
+				Label: self.c.Tr.UnstageMatchingFiles,
+				OnPress: func() error {
+					return self.promptForPathPattern(self.unstageMatchingFiles)
+				},
+				Key: 'u',
+			},
+		},
+	})
+}
+
+func (self *FilesController) promptForPathPattern(handleMatcher func(pattern string, matches func(string) bool) error) error {
+	return self.c.Prompt(types.PromptOpts{
+		Title: self.c.Tr.PathPatternPrompt,
+		HandleConfirm: func(pattern string) error {
+			matches, err := utils.NewPathMatcher(pattern)
+			if err != nil {
+				return self.c.Error(err)
+			}
+
+			return handleMatcher(pattern, matches)
+		},
+	})
+}
+
+// we go through the model's files rather than the tree's nodes so that files
+// in collapsed directories are included too
+func (self *FilesController) stageMatchingFiles(pattern string, matches func(string) bool) error {
+	files := lo.Filter(self.c.Model().Files, func(file *models.File, _ int) bool {
+		// staging a file with inline merge conflicts would stage the markers
+		return file.HasUnstagedChanges && !file.HasInlineMergeConflicts && matches(file.Name)
+	})
+	if len(files) == 0 {
+		return self.c.ErrorMsg(utils.ResolvePlaceholderString(self.c.Tr.NoUnstagedFilesMatchPattern, map[string]string{"pattern": pattern}))
+	}
+
+	self.c.LogAction(self.c.Tr.Actions.StageFile)
+	if err := self.c.Git().WorkingTree.StageFiles(lo.Map(files, func(file *models.File, _ int) string { return file.Name })); err != nil {
+		return self.c.Error(err)
+	}
+
+	self.c.Toast(utils.ResolvePlaceholderString(self.c.Tr.StagedMatchingFiles, map[string]string{
+		"count":   strconv.Itoa(len(files)),
+		"pattern": pattern,
+	}))
+
+	return self.c.Refresh(types.RefreshOptions{Scope: []types.RefreshableView{types.FILES}})
+}
+
+func (self *FilesController) unstageMatchingFiles(pattern string, matches func(string) bool) error {
+	files := lo.Filter(self.c.Model().Files, func(file *models.File, _ int) bool {
+		return file.HasStagedChanges && matches(file.Name)
+	})
+	if len(files) == 0 {
+		return self.c.ErrorMsg(utils.ResolvePlaceholderString(self.c.Tr.NoStagedFilesMatchPattern, map[string]string{"pattern": pattern}))
+	}
+
+	self.c.LogAction(self.c.Tr.Actions.UnstageFile)
+	if err := self.c.Git().WorkingTree.UnstageFiles(files); err != nil {
+		return self.c.Error(err)
+	}
+
+	self.c.Toast(utils.ResolvePlaceholderString(self.c.Tr.UnstagedMatchingFiles, map[string]string{
+		"count":   strconv.Itoa(len(files)),
+		"pattern": pattern,
+	}))
+
+	return self.c.Refresh(types.RefreshOptions{Scope: []types.RefreshableView{types.FILES}})
+}
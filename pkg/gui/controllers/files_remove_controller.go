@@ -1,12 +1,18 @@
 package controllers
 
 import (
+	"strconv"
+
 	"github.com/jesseduffield/gocui"
+	"github.com/jesseduffield/lazygit/pkg/commands/git_commands"
 	"github.com/jesseduffield/lazygit/pkg/commands/models"
+	"github.com/jesseduffield/lazygit/pkg/commands/patch"
 	"github.com/jesseduffield/lazygit/pkg/gui/context"
 	"github.com/jesseduffield/lazygit/pkg/gui/filetree"
+	"github.com/jesseduffield/lazygit/pkg/gui/style"
 	"github.com/jesseduffield/lazygit/pkg/gui/types"
 	"github.com/jesseduffield/lazygit/pkg/utils"
+	"github.com/samber/lo"
 )
 
 // splitting this action out into its own file because it's self-contained
@@ -30,17 +36,23 @@ func NewFilesRemoveController(
 func (self *FilesRemoveController) GetKeybindings(opts types.KeybindingsOpts) []*types.Binding {
 	bindings := []*types.Binding{
 		{
-			Key:         opts.GetKey(opts.Config.Universal.Remove),
-			Handler:     self.checkSelectedFileNode(self.remove),
-			Description: self.c.Tr.ViewDiscardOptions,
-			OpensMenu:   true,
+			Key:               opts.GetKey(opts.Config.Universal.Remove),
+			Handler:           self.withSelectedNodes(self.remove),
+			GetDisabledReason: self.getDisabledReasonForSectionHeader,
+			Description:       self.c.Tr.ViewDiscardOptions,
+			OpensMenu:         true,
 		},
 	}
 
 	return bindings
 }
 
-func (self *FilesRemoveController) remove(node *filetree.FileNode) error {
+func (self *FilesRemoveController) remove(nodes []*filetree.FileNode) error {
+	if len(nodes) > 1 {
+		return self.removeRange()
+	}
+
+	node := nodes[0]
 	var menuItems []*types.MenuItem
 	if node.File == nil {
 		menuItems = []*types.MenuItem{
@@ -48,6 +60,9 @@ func (self *FilesRemoveController) remove(node *filetree.FileNode) error {
 				Label: self.c.Tr.DiscardAllChanges,
 				OnPress: func() error {
 					self.c.LogAction(self.c.Tr.Actions.DiscardAllChangesInDirectory)
+					if err := self.trashFiles(node.GetFilePathsMatching(func(*models.File) bool { return true })); err != nil {
+						return err
+					}
 					if err := self.c.Git().WorkingTree.DiscardAllDirChanges(node); err != nil {
 						return self.c.Error(err)
 					}
@@ -68,6 +83,9 @@ func (self *FilesRemoveController) remove(node *filetree.FileNode) error {
 				Label: self.c.Tr.DiscardUnstagedChanges,
 				OnPress: func() error {
 					self.c.LogAction(self.c.Tr.Actions.DiscardUnstagedChangesInDirectory)
+					if err := self.trashFiles(node.GetFilePathsMatching(func(file *models.File) bool { return file.HasUnstagedChanges })); err != nil {
+						return err
+					}
 					if err := self.c.Git().WorkingTree.DiscardUnstagedDirChanges(node); err != nil {
 						return self.c.Error(err)
 					}
@@ -104,6 +122,10 @@ func (self *FilesRemoveController) remove(node *filetree.FileNode) error {
 					Label: self.c.Tr.DiscardAllChanges,
 					OnPress: func() error {
 						self.c.LogAction(self.c.Tr.Actions.DiscardAllChangesInFile)
+						// for a rename, the file gets its old name back
+						if err := self.trashFiles(file.Names()); err != nil {
+							return err
+						}
 						if err := self.c.Git().WorkingTree.DiscardAllFileChanges(file); err != nil {
 							return self.c.Error(err)
 						}
@@ -124,6 +146,9 @@ func (self *FilesRemoveController) remove(node *filetree.FileNode) error {
 					Label: self.c.Tr.DiscardUnstagedChanges,
 					OnPress: func() error {
 						self.c.LogAction(self.c.Tr.Actions.DiscardAllUnstagedChangesInFile)
+						if err := self.trashFiles([]string{file.Name}); err != nil {
+							return err
+						}
 						if err := self.c.Git().WorkingTree.DiscardUnstagedFileChanges(file); err != nil {
 							return self.c.Error(err)
 						}
@@ -139,12 +164,143 @@ func (self *FilesRemoveController) remove(node *filetree.FileNode) error {
 					),
 				})
 			}
+
+			if file.Tracked && file.HasUnstagedChanges && !file.Deleted {
+				menuItems = append(menuItems, &types.MenuItem{
+					Label: self.c.Tr.DiscardHunks,
+					OnPress: func() error {
+						return self.openDiscardHunksMenu(file)
+					},
+					Key:       'h',
+					Tooltip:   self.c.Tr.DiscardHunksTooltip,
+					OpensMenu: true,
+				})
+			}
 		}
 	}
 
 	return self.c.Menu(types.CreateMenuOptions{Title: node.GetPath(), Items: menuItems})
 }
 
+// discards the changes of all files in the selected range, with as few git
+// calls as we can get away with
+func (self *FilesRemoveController) removeRange() error {
+	submodules := self.c.Model().Submodules
+	// submodules and nested repos are left alone: discarding their changes
+	// means something else entirely
+	files := lo.Filter(self.context().GetSelectedFiles(), func(file *models.File, _ int) bool {
+		return !file.IsSubmodule(submodules) && !file.IsNestedRepo
+	})
+	if len(files) == 0 {
+		return nil
+	}
+
+	menuItems := []*types.MenuItem{
+		{
+			Label: self.c.Tr.DiscardAllChanges,
+			OnPress: func() error {
+				self.c.LogAction(self.c.Tr.Actions.DiscardAllChangesInSelection)
+				if err := self.trashFiles(namesOfFiles(files, func(*models.File) bool { return true })); err != nil {
+					return err
+				}
+				if err := self.c.Git().WorkingTree.DiscardAllFilesChanges(files); err != nil {
+					return self.c.Error(err)
+				}
+				self.context().CancelRangeSelect()
+				return self.c.Refresh(types.RefreshOptions{Mode: types.ASYNC, Scope: []types.RefreshableView{types.FILES, types.WORKTREES}})
+			},
+			Key:     self.c.KeybindingsOpts().GetKey(self.c.UserConfig.Keybinding.Files.ConfirmDiscard),
+			Tooltip: self.c.Tr.DiscardRangeAllTooltip,
+		},
+	}
+
+	if lo.SomeBy(files, func(file *models.File) bool { return file.HasStagedChanges }) &&
+		lo.SomeBy(files, func(file *models.File) bool { return file.HasUnstagedChanges }) {
+		menuItems = append(menuItems, &types.MenuItem{
+			Label: self.c.Tr.DiscardUnstagedChanges,
+			OnPress: func() error {
+				self.c.LogAction(self.c.Tr.Actions.DiscardUnstagedChangesInSelection)
+				if err := self.trashFiles(namesOfFiles(files, func(file *models.File) bool { return file.HasUnstagedChanges })); err != nil {
+					return err
+				}
+				if err := self.c.Git().WorkingTree.DiscardUnstagedFilesChanges(files); err != nil {
+					return self.c.Error(err)
+				}
+				return self.c.Refresh(types.RefreshOptions{Mode: types.ASYNC, Scope: []types.RefreshableView{types.FILES, types.WORKTREES}})
+			},
+			Key:     'u',
+			Tooltip: self.c.Tr.DiscardRangeUnstagedTooltip,
+		})
+	}
+
+	title := utils.ResolvePlaceholderString(self.c.Tr.SelectedFilesCount, map[string]string{"count": strconv.Itoa(len(files))})
+	return self.c.Menu(types.CreateMenuOptions{Title: title, Items: menuItems})
+}
+
+// lets the user pick hunks of the file's unstaged changes to discard one by one
+func (self *FilesRemoveController) openDiscardHunksMenu(file *models.File) error {
+	diff := self.c.Git().WorkingTree.WorktreeFileDiff(file, true, false)
+	filePatch := patch.Parse(diff)
+	if filePatch.HunkCount() == 0 {
+		return nil
+	}
+
+	lines := filePatch.Lines()
+	menuItems := lo.Map(lo.Range(filePatch.HunkCount()), func(hunkIdx int, _ int) *types.MenuItem {
+		startIdx := filePatch.HunkStartIdx(hunkIdx)
+		endIdx := filePatch.HunkEndIdx(hunkIdx)
+
+		firstChange, _ := lo.Find(lines[startIdx:endIdx+1], func(line *patch.PatchLine) bool {
+			return line.Kind == patch.ADDITION || line.Kind == patch.DELETION
+		})
+		firstChangeColumn := ""
+		if firstChange != nil {
+			textStyle := lo.Ternary(firstChange.Kind == patch.ADDITION, style.FgGreen, style.FgRed)
+			firstChangeColumn = textStyle.Sprint(firstChange.Content)
+		}
+
+		return &types.MenuItem{
+			LabelColumns: []string{style.FgCyan.Sprint(lines[startIdx].Content), firstChangeColumn},
+			OnPress: func() error {
+				return self.discardHunk(file, filePatch, startIdx, endIdx)
+			},
+		}
+	})
+
+	return self.c.Menu(types.CreateMenuOptions{
+		Title: utils.ResolvePlaceholderString(self.c.Tr.DiscardHunksMenuTitle, map[string]string{"path": file.Name}),
+		Items: menuItems,
+	})
+}
+
+func (self *FilesRemoveController) discardHunk(file *models.File, filePatch *patch.Patch, startIdx int, endIdx int) error {
+	patchToApply := filePatch.
+		Transform(patch.TransformOpts{
+			Reverse:             true,
+			IncludedLineIndices: patch.ExpandRange(startIdx, endIdx),
+			FileNameOverride:    file.Name,
+		}).
+		FormatPlain()
+
+	self.c.LogAction(self.c.Tr.Actions.DiscardHunk)
+	if err := self.c.Git().Patch.ApplyPatch(patchToApply, git_commands.ApplyPatchOpts{Reverse: true}); err != nil {
+		return self.c.Error(err)
+	}
+
+	if err := self.c.Refresh(types.RefreshOptions{Scope: []types.RefreshableView{types.FILES}}); err != nil {
+		return err
+	}
+
+	// reopen the menu so that more hunks can be discarded, as long as there are
+	// any left
+	file, ok := lo.Find(self.c.Model().Files, func(f *models.File) bool { return f.Name == file.Name })
+	if !ok || !file.HasUnstagedChanges {
+		return nil
+	}
+
+	return self.openDiscardHunksMenu(file)
+}
+
 func (self *FilesRemoveController) ResetSubmodule(submodule *models.SubmoduleConfig) error {
 	return self.c.WithWaitingStatus(self.c.Tr.ResettingSubmoduleStatus, func(gocui.Task) error {
 		self.c.LogAction(self.c.Tr.Actions.ResetSubmodule)
@@ -167,15 +323,33 @@ func (self *FilesRemoveController) ResetSubmodule(submodule *models.SubmoduleCon
 	})
 }
 
-func (self *FilesRemoveController) checkSelectedFileNode(callback func(*filetree.FileNode) error) func() error {
+func (self *FilesRemoveController) trashFiles(paths []string) error {
+	if err := self.c.Helpers().WorkingTree.TrashFiles(paths); err != nil {
+		return self.c.Error(err)
+	}
+
+	return nil
+}
+
+func (self *FilesRemoveController) withSelectedNodes(callback func([]*filetree.FileNode) error) func() error {
 	return func() error {
-		node := self.context().GetSelected()
-		if node == nil {
+		nodes := self.context().GetSelectedNodes()
+		if len(nodes) == 0 {
 			return nil
 		}
 
-		return callback(node)
+		return callback(nodes)
+	}
+}
+
+// a section header can be part of a selected range, just not on its own
+func (self *FilesRemoveController) getDisabledReasonForSectionHeader() *types.DisabledReason {
+	nodes := self.context().GetSelectedNodes()
+	if len(nodes) == 1 && nodes[0].IsSectionHeader() {
+		return &types.DisabledReason{Text: self.c.Tr.NotAvailableOnSectionHeader}
 	}
+
+	return nil
 }
 
 func (self *FilesRemoveController) Context() types.Context {
@@ -15,10 +15,7 @@ func (self *FilteringMenuAction) Call() error {
 	fileName := ""
 	switch self.c.CurrentSideContext() {
 	case self.c.Contexts().Files:
-		node := self.c.Contexts().Files.GetSelected()
-		if node != nil {
-			fileName = node.GetPath()
-		}
+		fileName = self.c.Contexts().Files.GetSelectedPath()
 	case self.c.Contexts().CommitFiles:
 		node := self.c.Contexts().CommitFiles.GetSelected()
 		if node != nil {
@@ -0,0 +1,184 @@
+package controllers
+
+import (
+	"github.com/jesseduffield/lazygit/pkg/commands/models"
+	"github.com/jesseduffield/lazygit/pkg/gui/types"
+	"github.com/jesseduffield/lazygit/pkg/utils"
+	"github.com/samber/lo"
+)
+
+// The fuzzy finder is a menu listing branches, tags, remote branches, files and
+// recent commits all at once. It opens with its filter prompt already active so
+// the user can start typing straight away, and selecting an item focuses the
+// panel that item lives in, with the item selected.
+type FuzzyFinderAction struct {
+	c *ControllerCommon
+}
+
+// we only offer the most recent commits; the whole log would drown out
+// everything else
+const fuzzyFinderMaxCommits = 100
+
+func (self *FuzzyFinderAction) Call() error {
+	menuItems := []*types.MenuItem{}
+	addItem := func(kind string, name string, onPress func() error) {
+		menuItems = append(menuItems, &types.MenuItem{
+			LabelColumns: []string{kind, name},
+			OnPress:      onPress,
+		})
+	}
+
+	for _, branch := range self.c.Model().Branches {
+		branch := branch
+		addItem(self.c.Tr.FuzzyFinderBranch, branch.Name, func() error {
+			return self.selectBranch(branch)
+		})
+	}
+
+	for _, tag := range self.c.Model().Tags {
+		tag := tag
+		addItem(self.c.Tr.FuzzyFinderTag, tag.Name, func() error {
+			return self.selectTag(tag)
+		})
+	}
+
+	for _, remote := range self.c.Model().Remotes {
+		remote := remote
+		for _, branch := range remote.Branches {
+			branch := branch
+			addItem(self.c.Tr.FuzzyFinderRemoteBranch, branch.FullName(), func() error {
+				return self.selectRemoteBranch(remote, branch)
+			})
+		}
+	}
+
+	for _, file := range self.c.Model().Files {
+		file := file
+		addItem(self.c.Tr.FuzzyFinderFile, file.Name, func() error {
+			return self.selectFile(file)
+		})
+	}
+
+	commits := lo.Filter(self.c.Model().Commits, func(commit *models.Commit, _ int) bool {
+		return !commit.IsTODO()
+	})
+	for _, commit := range commits[:utils.Min(len(commits), fuzzyFinderMaxCommits)] {
+		commit := commit
+		addItem(
+			self.c.Tr.FuzzyFinderCommit,
+			utils.ShortShaOfLength(commit.Sha, self.c.UserConfig.Gui.CommitHashLength)+" "+commit.Name,
+			func() error {
+				return self.selectCommit(commit)
+			},
+		)
+	}
+
+	if err := self.c.Menu(types.CreateMenuOptions{
+		Title: self.c.Tr.FuzzyFinderTitle,
+		Items: menuItems,
+	}); err != nil {
+		return err
+	}
+
+	return self.c.Helpers().Search.OpenFilterPrompt(self.c.Contexts().Menu)
+}
+
+// the context may be filtered down to a subset of its items, in which case
+// the model index won't match, so we clear the filter before selecting
+func clearFilterIfFiltering(context types.IFilterableContext) {
+	if context.IsFiltering() {
+		context.ClearFilter()
+	}
+}
+
+func (self *FuzzyFinderAction) selectBranch(branch *models.Branch) error {
+	context := self.c.Contexts().Branches
+	clearFilterIfFiltering(context)
+
+	_, index, ok := lo.FindIndexOf(self.c.Model().Branches, func(b *models.Branch) bool {
+		return b.Name == branch.Name
+	})
+	if ok {
+		context.SetSelectedLineIdx(index)
+	}
+
+	return self.c.PushContext(context)
+}
+
+func (self *FuzzyFinderAction) selectTag(tag *models.Tag) error {
+	context := self.c.Contexts().Tags
+	clearFilterIfFiltering(context)
+
+	_, index, ok := lo.FindIndexOf(self.c.Model().Tags, func(t *models.Tag) bool {
+		return t.Name == tag.Name
+	})
+	if ok {
+		context.SetSelectedLineIdx(index)
+	}
+
+	return self.c.PushContext(context)
+}
+
+func (self *FuzzyFinderAction) selectRemoteBranch(remote *models.Remote, branch *models.RemoteBranch) error {
+	remotesContext := self.c.Contexts().Remotes
+	clearFilterIfFiltering(remotesContext)
+
+	_, remoteIndex, ok := lo.FindIndexOf(self.c.Model().Remotes, func(r *models.Remote) bool {
+		return r.Name == remote.Name
+	})
+	if ok {
+		remotesContext.SetSelectedLineIdx(remoteIndex)
+	}
+
+	// this mirrors what happens when pressing enter on a remote
+	self.c.Model().RemoteBranches = remote.Branches
+
+	context := self.c.Contexts().RemoteBranches
+	clearFilterIfFiltering(context)
+
+	_, index, _ := lo.FindIndexOf(remote.Branches, func(b *models.RemoteBranch) bool {
+		return b.Name == branch.Name
+	})
+	context.SetSelectedLineIdx(index)
+	context.SetTitleRef(remote.Name)
+	context.SetParentContext(remotesContext)
+	context.GetView().TitlePrefix = remotesContext.GetView().TitlePrefix
+
+	if err := self.c.PostRefreshUpdate(context); err != nil {
+		return err
+	}
+
+	return self.c.PushContext(context)
+}
+
+func (self *FuzzyFinderAction) selectFile(file *models.File) error {
+	context := self.c.Contexts().Files
+
+	if context.InTreeMode() {
+		context.ExpandToPath(file.Name)
+	}
+
+	index, found := context.GetIndexForPath(file.Name)
+	if found {
+		context.SetSelectedLineIdx(index)
+	}
+
+	if err := self.c.PostRefreshUpdate(context); err != nil {
+		return err
+	}
+
+	return self.c.PushContext(context)
+}
+
+func (self *FuzzyFinderAction) selectCommit(commit *models.Commit) error {
+	context := self.c.Contexts().LocalCommits
+
+	_, index, ok := lo.FindIndexOf(self.c.Model().Commits, func(c *models.Commit) bool {
+		return c.Sha == commit.Sha
+	})
+	if ok {
+		context.SetSelectedLineIdx(index)
+	}
+
+	return self.c.PushContext(context)
+}
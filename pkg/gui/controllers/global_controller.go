@@ -112,6 +112,50 @@ func (self *GlobalController) GetKeybindings(opts types.KeybindingsOpts) []*type
 			Handler:     self.toggleWhitespace,
 			Description: self.c.Tr.ToggleWhitespaceInDiffView,
 		},
+		{
+			Key:         opts.GetKey(opts.Config.Universal.ToggleSplitDiffView),
+			Handler:     self.toggleSplitDiff,
+			Description: self.c.Tr.ToggleSplitDiffView,
+			Tooltip:     self.c.Tr.ToggleSplitDiffViewTooltip,
+		},
+		{
+			Key:         opts.GetKey(opts.Config.Universal.ToggleDateDisplay),
+			Handler:     self.toggleDateDisplay,
+			Description: self.c.Tr.ToggleDateDisplay,
+		},
+		{
+			Key:         opts.GetKey(opts.Config.Universal.OpenFuzzyFinder),
+			Handler:     self.openFuzzyFinder,
+			Description: self.c.Tr.OpenFuzzyFinder,
+			Tooltip:     self.c.Tr.OpenFuzzyFinderTooltip,
+			OpensMenu:   true,
+		},
+		{
+			Key:         opts.GetKey(opts.Config.Universal.TogglePresenterMode),
+			Handler:     self.c.Helpers().PresenterMode.Toggle,
+			Description: self.c.Tr.TogglePresenterMode,
+			Tooltip:     self.c.Tr.TogglePresenterModeTooltip,
+		},
+		{
+			Key:               opts.GetKey(opts.Config.Universal.NextConflictedFile),
+			Handler:           self.c.Helpers().MergeConflicts.SwitchToNextConflictedFile,
+			GetDisabledReason: self.getDisabledReasonForConflictedFiles,
+			Description:       self.c.Tr.NextConflictedFile,
+			Tooltip:           self.c.Tr.ConflictedFileTooltip,
+		},
+		{
+			Key:               opts.GetKey(opts.Config.Universal.PrevConflictedFile),
+			Handler:           self.c.Helpers().MergeConflicts.SwitchToPrevConflictedFile,
+			GetDisabledReason: self.getDisabledReasonForConflictedFiles,
+			Description:       self.c.Tr.PrevConflictedFile,
+			Tooltip:           self.c.Tr.ConflictedFileTooltip,
+		},
+		{
+			Key:         opts.GetKey(opts.Config.Universal.ToggleDryRun),
+			Handler:     self.c.Helpers().DryRun.Toggle,
+			Description: self.c.Tr.ToggleDryRun,
+			Tooltip:     self.c.Tr.ToggleDryRunTooltip,
+		},
 	}
 }
 
@@ -151,6 +195,10 @@ func (self *GlobalController) createDiffingMenu() error {
 	return (&DiffingMenuAction{c: self.c}).Call()
 }
 
+func (self *GlobalController) openFuzzyFinder() error {
+	return (&FuzzyFinderAction{c: self.c}).Call()
+}
+
 func (self *GlobalController) quit() error {
 	return (&QuitActions{c: self.c}).Quit()
 }
@@ -166,3 +214,34 @@ func (self *GlobalController) escape() error {
 func (self *GlobalController) toggleWhitespace() error {
 	return (&ToggleWhitespaceAction{c: self.c}).Call()
 }
+
+func (self *GlobalController) toggleSplitDiff() error {
+	return (&ToggleSplitDiffAction{c: self.c}).Call()
+}
+
+func (self *GlobalController) toggleDateDisplay() error {
+	repoState := self.c.State().GetRepoState()
+	repoState.SetDateDisplayToggled(!repoState.GetDateDisplayToggled())
+
+	for _, context := range []types.Context{
+		self.c.Contexts().Branches,
+		self.c.Contexts().LocalCommits,
+		self.c.Contexts().SubCommits,
+		self.c.Contexts().ReflogCommits,
+		self.c.Contexts().Stash,
+	} {
+		if err := self.c.PostRefreshUpdate(context); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (self *GlobalController) getDisabledReasonForConflictedFiles() *types.DisabledReason {
+	if len(self.c.Helpers().MergeConflicts.ConflictedFiles()) == 0 {
+		return &types.DisabledReason{Text: self.c.Tr.NoConflictedFiles}
+	}
+
+	return nil
+}
@@ -0,0 +1,75 @@
+package helpers
+
+import (
+	"github.com/jesseduffield/lazygit/pkg/config"
+	"github.com/jesseduffield/lazygit/pkg/gui/types"
+	"github.com/jesseduffield/lazygit/pkg/utils"
+)
+
+// Risky actions are grouped into tiers, and the user decides per tier whether
+// they want to be asked before the action runs, and if so, whether a yes/no
+// prompt is enough or they'd rather have to type the name of the thing that's
+// about to be deleted or overwritten.
+type ActionConfirmationHelper struct {
+	c *HelperCommon
+}
+
+func NewActionConfirmationHelper(c *HelperCommon) *ActionConfirmationHelper {
+	return &ActionConfirmationHelper{
+		c: c,
+	}
+}
+
+type ConfirmActionOpts struct {
+	// one of the Confirm* constants in the config package
+	Action string
+	Title  string
+	Prompt string
+	// what has to be typed when the action's tier requires typing to confirm,
+	// e.g. the name of the branch that's being deleted
+	ConfirmationText string
+	// what the user should know before going ahead, e.g. the commits that are
+	// about to be lost. It's shown below the prompt, or before asking to type
+	// the confirmation text.
+	Details       string
+	HandleConfirm func() error
+}
+
+func (self *ActionConfirmationHelper) Confirm(opts ConfirmActionOpts) error {
+	switch self.c.UserConfig.Confirmations.LevelFor(opts.Action) {
+	case config.ConfirmationLevelNone:
+		return opts.HandleConfirm()
+	case config.ConfirmationLevelTypeToConfirm:
+		if opts.Details == "" {
+			return self.typeToConfirm(opts)
+		}
+		return self.c.Confirm(types.ConfirmOpts{
+			Title:         opts.Title,
+			Prompt:        opts.Details,
+			HandleConfirm: func() error { return self.typeToConfirm(opts) },
+		})
+	default:
+		prompt := opts.Prompt
+		if opts.Details != "" {
+			prompt += "\n\n" + opts.Details
+		}
+		return self.c.Confirm(types.ConfirmOpts{
+			Title:         opts.Title,
+			Prompt:        prompt,
+			HandleConfirm: opts.HandleConfirm,
+		})
+	}
+}
+
+func (self *ActionConfirmationHelper) typeToConfirm(opts ConfirmActionOpts) error {
+	placeholders := map[string]string{"title": opts.Title, "text": opts.ConfirmationText}
+	return self.c.Prompt(types.PromptOpts{
+		Title: utils.ResolvePlaceholderString(self.c.Tr.TypeToConfirmTitle, placeholders),
+		HandleConfirm: func(input string) error {
+			if input != opts.ConfirmationText {
+				return self.c.ErrorMsg(utils.ResolvePlaceholderString(self.c.Tr.TypedTextDoesNotMatch, placeholders))
+			}
+			return opts.HandleConfirm()
+		},
+	})
+}
@@ -2,17 +2,23 @@ package helpers
 
 import (
 	"github.com/jesseduffield/gocui"
+	"github.com/jesseduffield/lazygit/pkg/commands/oscommands"
+	"github.com/jesseduffield/lazygit/pkg/config"
 	"github.com/jesseduffield/lazygit/pkg/gui/types"
 	"github.com/jesseduffield/lazygit/pkg/utils"
 )
 
 type BranchesHelper struct {
-	c *HelperCommon
+	c                  *HelperCommon
+	actionConfirmation *ActionConfirmationHelper
+	dryRunHelper       *DryRunHelper
 }
 
-func NewBranchesHelper(c *HelperCommon) *BranchesHelper {
+func NewBranchesHelper(c *HelperCommon, actionConfirmation *ActionConfirmationHelper, dryRunHelper *DryRunHelper) *BranchesHelper {
 	return &BranchesHelper{
-		c: c,
+		c:                  c,
+		actionConfirmation: actionConfirmation,
+		dryRunHelper:       dryRunHelper,
 	}
 }
 
@@ -30,10 +36,16 @@ func (self *BranchesHelper) ConfirmDeleteRemote(remoteName string, branchName st
 			"upstream":           remoteName,
 		},
 	)
-	return self.c.Confirm(types.ConfirmOpts{
-		Title:  title,
-		Prompt: prompt,
+	return self.actionConfirmation.Confirm(ConfirmActionOpts{
+		Action:           config.ConfirmDeleteRemoteBranch,
+		Title:            title,
+		Prompt:           prompt,
+		ConfirmationText: branchName,
 		HandleConfirm: func() error {
+			if self.dryRunHelper.IsEnabled() {
+				return self.showDeleteRemoteDryRun(remoteName, branchName)
+			}
+
 			return self.c.WithWaitingStatus(self.c.Tr.DeletingStatus, func(task gocui.Task) error {
 				self.c.LogAction(self.c.Tr.Actions.DeleteRemoteBranch)
 				if err := self.c.Git().Remote.DeleteRemoteBranch(task, remoteName, branchName); err != nil {
@@ -44,3 +56,17 @@ func (self *BranchesHelper) ConfirmDeleteRemote(remoteName string, branchName st
 		},
 	})
 }
+
+func (self *BranchesHelper) showDeleteRemoteDryRun(remoteName string, branchName string) error {
+	// the command is never run, so there's no task to prompt for credentials in
+	cmdObj := self.c.Git().Remote.DeleteRemoteBranchCmdObj(nil, remoteName, branchName)
+
+	return self.dryRunHelper.Show(DryRunPreview{
+		CmdObjs: []oscommands.ICmdObj{cmdObj},
+		Affected: []string{
+			utils.ResolvePlaceholderString(self.c.Tr.DryRunRemoteBranchDeleted, map[string]string{
+				"ref": remoteName + "/" + branchName,
+			}),
+		},
+	})
+}
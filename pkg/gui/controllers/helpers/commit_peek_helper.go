@@ -0,0 +1,124 @@
+package helpers
+
+import (
+	"fmt"
+	"math"
+
+	"github.com/jesseduffield/gocui"
+	"github.com/jesseduffield/lazygit/pkg/commands/models"
+	"github.com/jesseduffield/lazygit/pkg/gui/presentation"
+	"github.com/jesseduffield/lazygit/pkg/gui/types"
+	"github.com/jesseduffield/lazygit/pkg/utils"
+)
+
+// The commit peek is a small floating view showing the message and diffstat of
+// the selected commit. Unlike a popup it never takes focus, so the user can
+// keep moving through the commits while it follows along.
+type CommitPeekHelper struct {
+	c *HelperCommon
+
+	// the sha of the commit currently shown
+	sha string
+}
+
+func NewCommitPeekHelper(c *HelperCommon) *CommitPeekHelper {
+	return &CommitPeekHelper{
+		c: c,
+	}
+}
+
+func (self *CommitPeekHelper) IsShowing() bool {
+	return self.c.Views().CommitPeek.Visible
+}
+
+func (self *CommitPeekHelper) Toggle(commit *models.Commit) error {
+	if self.IsShowing() {
+		self.Hide()
+		return nil
+	}
+
+	return self.show(commit)
+}
+
+func (self *CommitPeekHelper) Hide() {
+	self.c.Views().CommitPeek.Visible = false
+	self.sha = ""
+}
+
+// Update shows the given commit if the peek is currently showing a different one
+func (self *CommitPeekHelper) Update(commit *models.Commit) error {
+	if !self.IsShowing() {
+		return nil
+	}
+
+	if commit == nil || commit.Sha == "" {
+		self.Hide()
+		return nil
+	}
+
+	if commit.Sha == self.sha {
+		return nil
+	}
+
+	return self.show(commit)
+}
+
+func (self *CommitPeekHelper) show(commit *models.Commit) error {
+	view := self.c.Views().CommitPeek
+	view.Title = fmt.Sprintf(self.c.Tr.CommitPeekTitle, utils.ShortShaOfLength(commit.Sha, self.c.UserConfig.Gui.CommitHashLength))
+	view.Visible = true
+	self.sha = commit.Sha
+
+	// loading the message off the UI thread so that moving through the
+	// commits doesn't stutter, and then streaming the diffstat below it
+	self.c.OnWorker(func(gocui.Task) {
+		message, err := self.c.Git().Commit.GetCommitMessage(commit.Sha)
+
+		self.c.OnUIThread(func() error {
+			if err != nil {
+				return self.c.Error(err)
+			}
+
+			// by now the user may have moved on to another commit
+			if self.sha != commit.Sha {
+				return nil
+			}
+
+			// the peek shrinks to fit its content, but the amount of output that
+			// gets read depends on the height of the view, so we make it as tall
+			// as it can get before streaming into it
+			self.setHeight(func(int) int { return math.MaxInt })
+
+			cmdObj := self.c.Git().Commit.GetCommitDiffStatCmdObj(commit.Sha)
+			prefix := presentation.RenderCommitMessage(message, self.c.Model().Linker) + "\n\n"
+			return self.c.RenderToView(view, types.NewRunCommandTaskWithPrefix(cmdObj.GetCmd(), prefix))
+		})
+	})
+
+	return nil
+}
+
+// Resize places the peek at the bottom of the main view, tall enough to fit
+// its content but never covering more than half of the main view
+func (self *CommitPeekHelper) Resize() {
+	if !self.IsShowing() {
+		return
+	}
+
+	view := self.c.Views().CommitPeek
+	self.setHeight(func(width int) int {
+		return getMessageHeight(view.Wrap, view.Buffer(), width) + 1
+	})
+}
+
+func (self *CommitPeekHelper) setHeight(getHeight func(width int) int) {
+	mainX0, mainY0, mainX1, mainY1 := self.c.Views().Main.Dimensions()
+
+	x0 := mainX0 + 2
+	x1 := mainX1 - 2
+	height := utils.Min(getHeight(x1-x0-1), (mainY1-mainY0)/2)
+	y1 := mainY1 - 1
+	y0 := y1 - height
+
+	_, _ = self.c.GocuiGui().SetView(self.c.Views().CommitPeek.Name(), x0, y0, x1, y1, 0)
+}
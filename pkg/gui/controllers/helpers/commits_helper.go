@@ -2,6 +2,7 @@ package helpers
 
 import (
 	"path/filepath"
+	"regexp"
 	"strings"
 	"time"
 
@@ -60,6 +61,60 @@ func (self *CommitsHelper) JoinCommitMessageAndDescription() string {
 	return self.getCommitSummary() + "\n" + self.getCommitDescription()
 }
 
+// GetTrailers returns the trailers at the end of the commit description
+func (self *CommitsHelper) GetTrailers() []string {
+	_, trailers := splitTrailers(self.getCommitDescription())
+	return trailers
+}
+
+// AddTrailer appends a 'key: value' trailer to the commit description, adding
+// it to the existing trailers if there are any
+func (self *CommitsHelper) AddTrailer(key string, value string) {
+	body, trailers := splitTrailers(self.getCommitDescription())
+	self.setCommitDescription(joinTrailers(body, append(trailers, key+": "+value)))
+}
+
+func (self *CommitsHelper) RemoveTrailer(trailer string) {
+	body, trailers := splitTrailers(self.getCommitDescription())
+	self.setCommitDescription(joinTrailers(body, lo.Without(trailers, trailer)))
+}
+
+var trailerRegexp = regexp.MustCompile(`^[A-Za-z0-9-]+: `)
+
+// splitTrailers splits a commit description into its body and the trailers
+// at its end. Like git, we treat the last paragraph as trailers if every line
+// of it looks like 'key: value'.
+func splitTrailers(description string) (string, []string) {
+	description = strings.TrimRight(description, " \n")
+	if description == "" {
+		return "", nil
+	}
+
+	body, lastParagraph := "", description
+	if index := strings.LastIndex(description, "\n\n"); index != -1 {
+		body, lastParagraph = description[:index], description[index+2:]
+	}
+
+	lines := strings.Split(lastParagraph, "\n")
+	if !lo.EveryBy(lines, trailerRegexp.MatchString) {
+		return description, nil
+	}
+
+	return strings.TrimRight(body, " \n"), lines
+}
+
+func joinTrailers(body string, trailers []string) string {
+	if len(trailers) == 0 {
+		return body
+	}
+
+	if body == "" {
+		return strings.Join(trailers, "\n")
+	}
+
+	return body + "\n\n" + strings.Join(trailers, "\n")
+}
+
 func (self *CommitsHelper) SwitchToEditor() error {
 	if !self.c.Contexts().CommitMessage.CanSwitchToEditor() {
 		return nil
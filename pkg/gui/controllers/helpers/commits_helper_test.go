@@ -0,0 +1,62 @@
+package helpers
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSplitTrailers(t *testing.T) {
+	scenarios := []struct {
+		name             string
+		description      string
+		expectedBody     string
+		expectedTrailers []string
+	}{
+		{
+			name:             "empty",
+			description:      "",
+			expectedBody:     "",
+			expectedTrailers: nil,
+		},
+		{
+			name:             "no trailers",
+			description:      "Some body\n\nmore body",
+			expectedBody:     "Some body\n\nmore body",
+			expectedTrailers: nil,
+		},
+		{
+			name:             "only trailers",
+			description:      "Refs: #1\nTicket: ABC-2\n",
+			expectedBody:     "",
+			expectedTrailers: []string{"Refs: #1", "Ticket: ABC-2"},
+		},
+		{
+			name:             "body and trailers",
+			description:      "Some body\n\nReviewed-by: Jane <jane@example.com>",
+			expectedBody:     "Some body",
+			expectedTrailers: []string{"Reviewed-by: Jane <jane@example.com>"},
+		},
+		{
+			name:             "last paragraph isn't all trailers",
+			description:      "Some body\n\nRefs: #1\nnot a trailer",
+			expectedBody:     "Some body\n\nRefs: #1\nnot a trailer",
+			expectedTrailers: nil,
+		},
+	}
+
+	for _, s := range scenarios {
+		s := s
+		t.Run(s.name, func(t *testing.T) {
+			body, trailers := splitTrailers(s.description)
+			assert.Equal(t, s.expectedBody, body)
+			assert.Equal(t, s.expectedTrailers, trailers)
+		})
+	}
+}
+
+func TestJoinTrailers(t *testing.T) {
+	assert.Equal(t, "Some body", joinTrailers("Some body", nil))
+	assert.Equal(t, "Refs: #1", joinTrailers("", []string{"Refs: #1"}))
+	assert.Equal(t, "Some body\n\nRefs: #1\nTicket: ABC-2", joinTrailers("Some body", []string{"Refs: #1", "Ticket: ABC-2"}))
+}
@@ -0,0 +1,98 @@
+package helpers
+
+import (
+	"sync"
+
+	"github.com/jesseduffield/lazygit/pkg/gui/presentation"
+	"github.com/jesseduffield/lazygit/pkg/gui/style"
+)
+
+// The commits minimap is a slim column beside the commits panel giving an
+// overview of the whole (loaded) commits list, with the visible part
+// highlighted. Render is called on every layout because the minimap depends on
+// the scroll position of the commits view, so we cache as much as we can: the
+// colours of the commits until the commits or branches are refreshed, and the
+// rendered content until the size or scroll position changes too.
+type CommitsMinimapHelper struct {
+	c            *HelperCommon
+	windowHelper *WindowHelper
+
+	// nil when the commits or branches have changed since we last computed
+	// them. Refreshes happen in the background, hence the mutex.
+	colors      []style.TextStyle
+	colorsMutex sync.Mutex
+	colorBy     string
+
+	// what we last rendered, and what we rendered it for
+	content string
+	key     commitsMinimapKey
+}
+
+type commitsMinimapKey struct {
+	height          int
+	visibleStartIdx int
+	visibleEndIdx   int
+}
+
+func NewCommitsMinimapHelper(c *HelperCommon, windowHelper *WindowHelper) *CommitsMinimapHelper {
+	return &CommitsMinimapHelper{
+		c:            c,
+		windowHelper: windowHelper,
+	}
+}
+
+// Invalidate is to be called whenever the commits or branches are refreshed.
+func (self *CommitsMinimapHelper) Invalidate() {
+	self.colorsMutex.Lock()
+	defer self.colorsMutex.Unlock()
+
+	self.colors = nil
+}
+
+func (self *CommitsMinimapHelper) Render() {
+	view := self.c.Views().CommitsMinimap
+	if !view.Visible {
+		return
+	}
+
+	content := ""
+	// the minimap only makes sense for the commits tab of the commits window
+	if self.windowHelper.GetViewNameForWindow("commits") == self.c.Views().Commits.Name() {
+		commitsView := self.c.Views().Commits
+		_, height := view.Size()
+		_, visibleHeight := commitsView.Size()
+		visibleStartIdx := commitsView.OriginY()
+		key := commitsMinimapKey{
+			height:          height,
+			visibleStartIdx: visibleStartIdx,
+			visibleEndIdx:   visibleStartIdx + visibleHeight,
+		}
+
+		self.colorsMutex.Lock()
+		defer self.colorsMutex.Unlock()
+
+		colorBy := self.c.UserConfig.Gui.CommitsMinimap
+		if self.colors == nil || colorBy != self.colorBy {
+			self.colors = presentation.GetCommitsMinimapColors(
+				self.c.Model().Commits,
+				self.c.Model().Branches,
+				colorBy,
+			)
+			self.colorBy = colorBy
+		} else if key == self.key {
+			return
+		}
+
+		self.key = key
+		content = presentation.GetCommitsMinimap(self.colors, key.height, key.visibleStartIdx, key.visibleEndIdx)
+	} else {
+		// the minimap is blank, so we'll need to render it afresh when
+		// switching back to the commits tab
+		self.key = commitsMinimapKey{}
+	}
+
+	if content != self.content {
+		self.content = content
+		self.c.SetViewContent(view, content)
+	}
+}
@@ -0,0 +1,129 @@
+package helpers
+
+import (
+	"strings"
+
+	"github.com/jesseduffield/lazygit/pkg/commands/oscommands"
+	"github.com/jesseduffield/lazygit/pkg/gui/style"
+	"github.com/jesseduffield/lazygit/pkg/utils"
+	"github.com/samber/lo"
+)
+
+// In dry run mode, force pushes, hard resets, branch deletions and cleans
+// aren't run. Instead we show the commands that we would have run along with
+// the refs and files they would have affected, so that users can double-check
+// what an action does, or learn which git commands lazygit runs for it.
+type DryRunHelper struct {
+	c *HelperCommon
+}
+
+func NewDryRunHelper(c *HelperCommon) *DryRunHelper {
+	return &DryRunHelper{
+		c: c,
+	}
+}
+
+// DryRunPreview describes what a destructive action would have done
+type DryRunPreview struct {
+	CmdObjs []oscommands.ICmdObj
+	// the refs and files that running the commands would affect, one line each
+	Affected []string
+}
+
+func (self *DryRunHelper) IsEnabled() bool {
+	return self.c.State().GetDryRun()
+}
+
+func (self *DryRunHelper) Toggle() error {
+	enabled := !self.c.State().GetDryRun()
+	self.c.State().SetDryRun(enabled)
+
+	if enabled {
+		self.c.Toast(self.c.Tr.DryRunEnabled)
+	} else {
+		self.c.Toast(self.c.Tr.DryRunDisabled)
+	}
+
+	return nil
+}
+
+// Show tells the user what the action would have done, in place of doing it
+func (self *DryRunHelper) Show(preview DryRunPreview) error {
+	var message strings.Builder
+	message.WriteString(self.c.Tr.DryRunCommands)
+	message.WriteString("\n")
+	for _, cmdObj := range preview.CmdObjs {
+		message.WriteString("\n" + style.FgCyan.Sprint(cmdObj.ToString()))
+	}
+
+	message.WriteString("\n\n" + self.c.Tr.DryRunAffected + "\n")
+	if len(preview.Affected) == 0 {
+		message.WriteString(self.c.Tr.DryRunNothingAffected)
+	} else {
+		message.WriteString("- " + strings.Join(preview.Affected, "\n- "))
+	}
+
+	return self.c.Alert(self.c.Tr.DryRunTitle, message.String())
+}
+
+// HardResetAffected lists what `git reset --hard <ref>` would affect: where the
+// checked-out branch would move, the commits that would no longer be on it and
+// the files whose changes would be discarded
+func (self *DryRunHelper) HardResetAffected(ref string) []string {
+	affected := []string{}
+
+	if branches := self.c.Model().Branches; len(branches) > 0 && ref != "HEAD" {
+		checkedOutBranch := branches[0]
+		name := checkedOutBranch.Name
+		if checkedOutBranch.DetachedHead {
+			name = "HEAD"
+		}
+
+		affected = append(affected, utils.ResolvePlaceholderString(self.c.Tr.DryRunRefMoves, map[string]string{
+			"ref":  name,
+			"from": utils.ShortSha(checkedOutBranch.CommitHash),
+			"to":   ref,
+		}))
+
+		// the commits on HEAD that aren't reachable from the ref
+		if count, _ := self.c.Git().Branch.GetCommitDifferences("HEAD", ref); count != "0" && count != "?" {
+			affected = append(affected, utils.ResolvePlaceholderString(self.c.Tr.DryRunCommitsLeftBehind, map[string]string{
+				"count": count,
+				"ref":   name,
+			}))
+		}
+	}
+
+	for _, file := range self.c.Model().Files {
+		// untracked files are left alone
+		if (file.Tracked || file.HasStagedChanges) && (file.HasStagedChanges || file.HasUnstagedChanges) {
+			affected = append(affected, utils.ResolvePlaceholderString(self.c.Tr.DryRunChangesDiscarded, map[string]string{
+				"path": file.Name,
+			}))
+		}
+	}
+
+	return affected
+}
+
+// BranchDeletedAffected describes the deletion of the given local branch
+func (self *DryRunHelper) BranchDeletedAffected(branchName string, hash string) string {
+	return utils.ResolvePlaceholderString(self.c.Tr.DryRunRefDeleted, map[string]string{
+		"ref":  branchName,
+		"hash": utils.ShortSha(hash),
+	})
+}
+
+// CleanAffected lists the files that `git clean -fd` would remove
+func (self *DryRunHelper) CleanAffected() ([]string, error) {
+	paths, err := self.c.Git().WorkingTree.UntrackedFilesToRemove()
+	if err != nil {
+		return nil, err
+	}
+
+	return lo.Map(paths, func(path string, _ int) string {
+		return utils.ResolvePlaceholderString(self.c.Tr.DryRunFileRemoved, map[string]string{
+			"path": path,
+		})
+	}), nil
+}
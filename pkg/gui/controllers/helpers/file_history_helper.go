@@ -0,0 +1,60 @@
+package helpers
+
+import (
+	"github.com/jesseduffield/lazygit/pkg/commands/git_commands"
+	"github.com/jesseduffield/lazygit/pkg/gui/types"
+	"github.com/jesseduffield/lazygit/pkg/utils"
+)
+
+type FileHistoryHelper struct {
+	c *HelperCommon
+
+	refreshHelper *RefreshHelper
+}
+
+func NewFileHistoryHelper(
+	c *HelperCommon,
+	refreshHelper *RefreshHelper,
+) *FileHistoryHelper {
+	return &FileHistoryHelper{
+		c:             c,
+		refreshHelper: refreshHelper,
+	}
+}
+
+// ViewFileHistory shows the commits reachable from ref that touched the file
+// at the given path, in the window of the given context
+func (self *FileHistoryHelper) ViewFileHistory(path string, ref string, parentContext types.Context) error {
+	commits, err := self.c.Git().Loaders.CommitLoader.GetCommits(
+		git_commands.GetCommitsOptions{
+			FilterPath:         path,
+			RefName:            ref,
+			RefForPushedStatus: ref,
+		},
+	)
+	if err != nil {
+		return err
+	}
+
+	pathsBySha, err := self.c.Git().Commit.GetFollowedPaths(ref, path)
+	if err != nil {
+		return err
+	}
+
+	self.c.Model().FileHistoryCommits = commits
+	self.refreshHelper.RefreshAuthors(commits)
+
+	fileHistoryContext := self.c.Contexts().FileHistory
+	fileHistoryContext.SetPath(path, pathsBySha)
+	fileHistoryContext.SetSelectedLineIdx(0)
+	fileHistoryContext.SetParentContext(parentContext)
+	fileHistoryContext.SetWindowName(parentContext.GetWindowName())
+	fileHistoryContext.SetTitleRef(utils.TruncateWithEllipsis(path, 50))
+	fileHistoryContext.GetView().TitlePrefix = parentContext.GetView().TitlePrefix
+
+	if err := self.c.PostRefreshUpdate(fileHistoryContext); err != nil {
+		return err
+	}
+
+	return self.c.PushContext(fileHistoryContext)
+}
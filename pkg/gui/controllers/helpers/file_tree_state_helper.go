@@ -0,0 +1,82 @@
+package helpers
+
+import (
+	"os"
+
+	"github.com/jesseduffield/lazygit/pkg/config"
+	"github.com/jesseduffield/lazygit/pkg/gui/filetree"
+)
+
+// We store how the files panel of a repo was laid out (tree or flat view,
+// grouping by change type, and which directories were collapsed) in the app
+// state when quitting or switching to another repo, so that it looks the same
+// the next time the repo is opened.
+type FileTreeStateHelper struct {
+	c *HelperCommon
+}
+
+func NewFileTreeStateHelper(c *HelperCommon) *FileTreeStateHelper {
+	return &FileTreeStateHelper{
+		c: c,
+	}
+}
+
+// Store puts the state of the current repo into the app state. It's up to the
+// caller to save the app state afterwards.
+func (self *FileTreeStateHelper) Store() {
+	storeFileTreeState(self.c.GetAppState(), self.c.Git().RepoPaths.WorktreePath(), self.CurrentState())
+}
+
+func (self *FileTreeStateHelper) CurrentState() *config.FileTreeState {
+	return fileTreeStateOf(self.c.Contexts().Files.FileTreeViewModel)
+}
+
+// Restore applies the stored state of the current repo, if there is one. It
+// doesn't depend on the files being loaded, so it can be called as soon as the
+// repo is opened.
+func (self *FileTreeStateHelper) Restore() {
+	state := self.c.GetAppState().FileTreeStates[self.c.Git().RepoPaths.WorktreePath()]
+	if state == nil {
+		return
+	}
+
+	self.Apply(state)
+}
+
+func (self *FileTreeStateHelper) Apply(state *config.FileTreeState) {
+	applyFileTreeState(self.c.Contexts().Files.FileTreeViewModel, state)
+}
+
+// Like with the recent repos, we drop the states of repos that no longer exist,
+// so that the app state doesn't keep growing.
+func storeFileTreeState(appState *config.AppState, path string, state *config.FileTreeState) {
+	states := map[string]*config.FileTreeState{}
+	for otherPath, otherState := range appState.FileTreeStates {
+		if _, err := os.Stat(otherPath); err == nil {
+			states[otherPath] = otherState
+		}
+	}
+	states[path] = state
+
+	appState.FileTreeStates = states
+}
+
+func fileTreeStateOf(viewModel *filetree.FileTreeViewModel) *config.FileTreeState {
+	return &config.FileTreeState{
+		ShowTree:          viewModel.InTreeMode(),
+		GroupByChangeType: viewModel.InGroupedMode(),
+		CollapsedPaths:    viewModel.CollapsedPaths().Paths(),
+	}
+}
+
+func applyFileTreeState(viewModel *filetree.FileTreeViewModel, state *config.FileTreeState) {
+	if viewModel.InTreeMode() != state.ShowTree {
+		viewModel.ToggleShowTree()
+	}
+	if viewModel.InGroupedMode() != state.GroupByChangeType {
+		viewModel.ToggleGroupByChangeType()
+	}
+
+	viewModel.CollapsedPaths().ExpandAll()
+	viewModel.CollapsedPaths().CollapseAll(state.CollapsedPaths)
+}
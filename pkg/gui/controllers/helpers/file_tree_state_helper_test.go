@@ -0,0 +1,71 @@
+package helpers
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/jesseduffield/lazygit/pkg/commands/models"
+	"github.com/jesseduffield/lazygit/pkg/config"
+	"github.com/jesseduffield/lazygit/pkg/gui/filetree"
+	"github.com/jesseduffield/lazygit/pkg/utils"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFileTreeStateRoundTrip(t *testing.T) {
+	files := []*models.File{
+		{Name: "pkg/gui/gui.go", ShortStatus: " M"},
+		{Name: "pkg/utils/utils.go", ShortStatus: " M"},
+		{Name: "README.md", ShortStatus: "??"},
+	}
+	newViewModel := func() *filetree.FileTreeViewModel {
+		viewModel := filetree.NewFileTreeViewModel(func() []*models.File { return files }, utils.NewDummyLog(), false, false)
+		viewModel.SetTree()
+		return viewModel
+	}
+
+	viewModel := newViewModel()
+	viewModel.ToggleShowTree()
+	viewModel.ToggleGroupByChangeType()
+	viewModel.CollapsedPaths().Collapse("pkg/gui")
+
+	repoPath := t.TempDir()
+	appState := &config.AppState{}
+	storeFileTreeState(appState, repoPath, fileTreeStateOf(viewModel))
+
+	restored := newViewModel()
+	applyFileTreeState(restored, appState.FileTreeStates[repoPath])
+
+	assert.True(t, restored.InTreeMode())
+	assert.True(t, restored.InGroupedMode())
+	assert.Equal(t, []string{"pkg/gui"}, restored.CollapsedPaths().Paths())
+
+	// applying a state also undoes what was changed since
+	restored.CollapsedPaths().Collapse("pkg/utils")
+	applyFileTreeState(restored, &config.FileTreeState{})
+
+	assert.False(t, restored.InTreeMode())
+	assert.False(t, restored.InGroupedMode())
+	assert.Empty(t, restored.CollapsedPaths().Paths())
+}
+
+func TestStoreFileTreeStatePrunesMissingRepos(t *testing.T) {
+	existingPath := t.TempDir()
+	missingPath := filepath.Join(t.TempDir(), "deleted-repo")
+	currentPath := t.TempDir()
+
+	existingState := &config.FileTreeState{ShowTree: true}
+	currentState := &config.FileTreeState{GroupByChangeType: true}
+	appState := &config.AppState{
+		FileTreeStates: map[string]*config.FileTreeState{
+			existingPath: existingState,
+			missingPath:  {ShowTree: true},
+		},
+	}
+
+	storeFileTreeState(appState, currentPath, currentState)
+
+	assert.Equal(t, map[string]*config.FileTreeState{
+		existingPath: existingState,
+		currentPath:  currentState,
+	}, appState.FileTreeStates)
+}
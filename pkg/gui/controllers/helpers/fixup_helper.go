@@ -6,7 +6,9 @@ import (
 	"sync"
 
 	"github.com/jesseduffield/generics/set"
+	"github.com/jesseduffield/gocui"
 	"github.com/jesseduffield/lazygit/pkg/commands/models"
+	"github.com/jesseduffield/lazygit/pkg/gui/style"
 	"github.com/jesseduffield/lazygit/pkg/gui/types"
 	"github.com/jesseduffield/lazygit/pkg/utils"
 	"github.com/samber/lo"
@@ -104,6 +106,132 @@ func (self *FixupHelper) HandleFindBaseCommitForFixupPress() error {
 	return doIt()
 }
 
+// We test-apply the changes to each candidate commit, so we don't want to go
+// too far back
+const maxQuickFixupTargets = 50
+
+// HandleQuickFixupPress lets the user pick any commit of the current branch to
+// create a fixup commit for, showing for each one whether the changes apply
+// cleanly to it. If they don't, squashing the fixup in is likely to conflict.
+func (self *FixupHelper) HandleQuickFixupPress() error {
+	if len(self.c.Model().Files) == 0 {
+		return self.c.ErrorMsg(self.c.Tr.NoChangedFiles)
+	}
+
+	commits := lo.Filter(self.c.Model().Commits, func(commit *models.Commit, _ int) bool {
+		return commit.Status != models.StatusMerged && !commit.IsTODO() && !commit.IsMerge()
+	})
+	if len(commits) == 0 {
+		return self.c.ErrorMsg(self.c.Tr.NoFixupTargets)
+	}
+	if len(commits) > maxQuickFixupTargets {
+		commits = commits[:maxQuickFixupTargets]
+	}
+
+	return self.c.WithWaitingStatus(self.c.Tr.PredictingFixupConflictsStatus, func(gocui.Task) error {
+		patch, hasStagedChanges, err := self.getPatch()
+		if err != nil {
+			return err
+		}
+
+		appliesCleanly, err := self.appliesCleanly(patch, commits)
+		if err != nil {
+			return err
+		}
+
+		menuItems := lo.Map(commits, func(commit *models.Commit, i int) *types.MenuItem {
+			prediction := style.FgGreen.Sprint(self.c.Tr.FixupAppliesCleanly)
+			tooltip := ""
+			if !appliesCleanly[i] {
+				prediction = style.FgRed.Sprint(self.c.Tr.FixupMayConflict)
+				tooltip = self.c.Tr.FixupMayConflictTooltip
+			}
+
+			return &types.MenuItem{
+				LabelColumns: []string{style.FgYellow.Sprint(commit.ShortSha()), commit.Name, prediction},
+				OnPress: func() error {
+					return self.createFixupCommit(commit, hasStagedChanges)
+				},
+				Tooltip: tooltip,
+			}
+		})
+
+		self.c.OnUIThread(func() error {
+			return self.c.Menu(types.CreateMenuOptions{
+				Title: self.c.Tr.QuickFixupTitle,
+				Items: menuItems,
+			})
+		})
+
+		return nil
+	})
+}
+
+// like getDiff, but with context lines so that the patch can be applied
+func (self *FixupHelper) getPatch() (string, bool, error) {
+	args := []string{"--binary", "--ignore-submodules=all", "HEAD", "--"}
+
+	hasStagedChanges := true
+	patch, err := self.c.Git().Diff.DiffIndexCmdObj(append([]string{"--cached"}, args...)...).RunWithOutput()
+
+	if err == nil && patch == "" {
+		hasStagedChanges = false
+		patch, err = self.c.Git().Diff.DiffIndexCmdObj(args...).RunWithOutput()
+	}
+
+	return patch, hasStagedChanges, err
+}
+
+// returns, for each of the commits, whether the patch applies cleanly on top of it
+func (self *FixupHelper) appliesCleanly(patch string, commits []*models.Commit) ([]bool, error) {
+	result := make([]bool, len(commits))
+	// e.g. only untracked files have changed
+	if patch == "" {
+		for i := range result {
+			result[i] = true
+		}
+		return result, nil
+	}
+
+	patchPath, err := self.c.Git().Patch.SaveTemporaryPatch(patch)
+	if err != nil {
+		return nil, err
+	}
+
+	var wg sync.WaitGroup
+	for i, commit := range commits {
+		i, commit := i, commit
+		wg.Add(1)
+		go utils.Safe(func() {
+			defer wg.Done()
+
+			applies, err := self.c.Git().Patch.PatchAppliesToCommit(patchPath, commit.Sha)
+			if err != nil {
+				self.c.Log.Errorf("Error test-applying patch to %s: %v", commit.Sha, err)
+			}
+			result[i] = applies
+		})
+	}
+	wg.Wait()
+
+	return result, nil
+}
+
+func (self *FixupHelper) createFixupCommit(commit *models.Commit, hasStagedChanges bool) error {
+	self.c.LogAction(self.c.Tr.Actions.CreateFixupCommit)
+	if !hasStagedChanges {
+		if err := self.c.Git().WorkingTree.StageAll(); err != nil {
+			return self.c.Error(err)
+		}
+	}
+
+	if err := self.c.Git().Commit.CreateFixupCommit(commit.Sha); err != nil {
+		return self.c.Error(err)
+	}
+
+	return self.c.Refresh(types.RefreshOptions{Mode: types.ASYNC})
+}
+
 func (self *FixupHelper) getDiff() (string, bool, error) {
 	args := []string{"-U0", "--ignore-submodules=all", "HEAD", "--"}
 
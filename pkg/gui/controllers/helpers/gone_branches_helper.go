@@ -0,0 +1,194 @@
+package helpers
+
+import (
+	"strings"
+
+	"github.com/jesseduffield/gocui"
+	"github.com/jesseduffield/lazygit/pkg/commands/git_commands"
+	"github.com/jesseduffield/lazygit/pkg/commands/models"
+	"github.com/jesseduffield/lazygit/pkg/gui/style"
+	"github.com/jesseduffield/lazygit/pkg/gui/types"
+	"github.com/jesseduffield/lazygit/pkg/utils"
+	"github.com/samber/lo"
+)
+
+// Once a pull request is merged, its branch usually gets deleted on the remote,
+// but the local branch sticks around. GoneBranchesHelper finds those branches
+// (after pruning the remote-tracking branches, which is what tells us that the
+// upstream is gone) and lets the user pick which ones to delete.
+type GoneBranchesHelper struct {
+	c            *HelperCommon
+	refsHelper   *RefsHelper
+	dryRunHelper *DryRunHelper
+}
+
+func NewGoneBranchesHelper(c *HelperCommon, refsHelper *RefsHelper, dryRunHelper *DryRunHelper) *GoneBranchesHelper {
+	return &GoneBranchesHelper{
+		c:            c,
+		refsHelper:   refsHelper,
+		dryRunHelper: dryRunHelper,
+	}
+}
+
+func (self *GoneBranchesHelper) CleanUp() error {
+	remoteNames := lo.Map(self.c.Model().Remotes, func(remote *models.Remote, _ int) string {
+		return remote.Name
+	})
+
+	return self.c.WithWaitingStatus(self.c.Tr.PruningRemotesStatus, func(task gocui.Task) error {
+		if len(remoteNames) > 0 {
+			self.c.LogAction(self.c.Tr.Actions.PruneRemotes)
+			if err := self.c.Git().Remote.PruneRemotes(task, remoteNames); err != nil {
+				return self.c.Error(err)
+			}
+		}
+
+		if err := self.c.Refresh(types.RefreshOptions{
+			Mode:  types.SYNC,
+			Scope: []types.RefreshableView{types.BRANCHES, types.REMOTES},
+		}); err != nil {
+			return err
+		}
+
+		self.c.OnUIThread(self.createReviewMenu)
+		return nil
+	})
+}
+
+func (self *GoneBranchesHelper) createReviewMenu() error {
+	goneBranches := lo.Filter(self.c.Model().Branches, func(branch *models.Branch, _ int) bool {
+		return branch.UpstreamGone
+	})
+	if len(goneBranches) == 0 {
+		self.c.Toast(self.c.Tr.NoGoneBranches)
+		return nil
+	}
+
+	// all branches start out selected, since deleting them is the point
+	selected := lo.SliceToMap(goneBranches, func(branch *models.Branch) (string, bool) {
+		return branch.Name, true
+	})
+
+	menuItems := lo.Map(goneBranches, func(branch *models.Branch, _ int) *types.MenuItem {
+		item := &types.MenuItem{
+			LabelColumns: []string{
+				checkbox(true),
+				branch.Name,
+				style.FgYellow.Sprint(branch.ShortUpstreamRefName()),
+			},
+			KeepOpen: true,
+		}
+		item.OnPress = func() error {
+			selected[branch.Name] = !selected[branch.Name]
+			item.LabelColumns[0] = checkbox(selected[branch.Name])
+			return nil
+		}
+		return item
+	})
+
+	menuItems = append(menuItems, &types.MenuItem{
+		LabelColumns: []string{"", self.c.Tr.DeleteSelectedBranches},
+		OnPress: func() error {
+			branchesToDelete := lo.Filter(goneBranches, func(branch *models.Branch, _ int) bool {
+				return selected[branch.Name]
+			})
+			return self.confirmDelete(branchesToDelete)
+		},
+	})
+
+	return self.c.Menu(types.CreateMenuOptions{
+		Title: self.c.Tr.CleanUpGoneBranches,
+		Items: menuItems,
+	})
+}
+
+func (self *GoneBranchesHelper) confirmDelete(branches []*models.Branch) error {
+	if len(branches) == 0 {
+		self.c.ErrorToast(self.c.Tr.NoBranchesSelected)
+		return nil
+	}
+
+	branchNames := lo.Map(branches, func(branch *models.Branch, _ int) string {
+		return branch.Name
+	})
+	prompt := utils.ResolvePlaceholderString(self.c.Tr.DeleteGoneBranchesPrompt, map[string]string{
+		"branches": "- " + strings.Join(branchNames, "\n- "),
+	})
+
+	// we can't delete the checked-out branch, so we switch to a main branch
+	// first
+	checkedOutBranch := self.refsHelper.GetCheckedOutRef()
+	branchToCheckOut := ""
+	if lo.Contains(branchNames, checkedOutBranch.Name) {
+		var ok bool
+		branchToCheckOut, ok = lo.Find(self.c.UserConfig.Git.MainBranches, func(mainBranch string) bool {
+			return !lo.Contains(branchNames, mainBranch) && lo.ContainsBy(self.c.Model().Branches, func(branch *models.Branch) bool {
+				return branch.Name == mainBranch
+			})
+		})
+		if !ok {
+			return self.c.ErrorMsg(utils.ResolvePlaceholderString(self.c.Tr.NoBranchToCheckoutInstead, map[string]string{
+				"branch":       checkedOutBranch.Name,
+				"mainBranches": strings.Join(self.c.UserConfig.Git.MainBranches, ", "),
+			}))
+		}
+
+		prompt += "\n\n" + utils.ResolvePlaceholderString(self.c.Tr.CheckoutBeforeDeletingBranch, map[string]string{
+			"branch": checkedOutBranch.Name,
+			"target": branchToCheckOut,
+		})
+	}
+
+	return self.c.Confirm(types.ConfirmOpts{
+		Title:  self.c.Tr.DeleteSelectedBranches,
+		Prompt: prompt,
+		HandleConfirm: func() error {
+			if self.dryRunHelper.IsEnabled() {
+				return self.showDeleteDryRun(branches, branchToCheckOut)
+			}
+
+			return self.c.WithWaitingStatus(self.c.Tr.DeletingStatus, func(gocui.Task) error {
+				self.c.LogAction(self.c.Tr.Actions.CleanUpGoneBranches)
+
+				if branchToCheckOut != "" {
+					if err := self.c.Git().Branch.Checkout(branchToCheckOut, git_commands.CheckoutOptions{}); err != nil {
+						return self.c.Error(err)
+					}
+				}
+
+				for _, branchName := range branchNames {
+					// the upstream being gone doesn't mean that the branch was
+					// merged (think squash merges), so we need to force it
+					if err := self.c.Git().Branch.LocalDelete(branchName, true); err != nil {
+						_ = self.c.Refresh(types.RefreshOptions{Mode: types.ASYNC})
+						return self.c.Error(err)
+					}
+				}
+
+				return self.c.Refresh(types.RefreshOptions{Mode: types.ASYNC})
+			})
+		},
+	})
+}
+
+func (self *GoneBranchesHelper) showDeleteDryRun(branches []*models.Branch, branchToCheckOut string) error {
+	preview := DryRunPreview{}
+	if branchToCheckOut != "" {
+		preview.CmdObjs = append(preview.CmdObjs, self.c.Git().Branch.CheckoutCmdObj(branchToCheckOut, git_commands.CheckoutOptions{}))
+	}
+
+	for _, branch := range branches {
+		preview.CmdObjs = append(preview.CmdObjs, self.c.Git().Branch.LocalDeleteCmdObj(branch.Name, true))
+		preview.Affected = append(preview.Affected, self.dryRunHelper.BranchDeletedAffected(branch.Name, branch.CommitHash))
+	}
+
+	return self.dryRunHelper.Show(preview)
+}
+
+func checkbox(checked bool) string {
+	if checked {
+		return style.FgGreen.Sprint("[x]")
+	}
+
+	return "[ ]"
+}
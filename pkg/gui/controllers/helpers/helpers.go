@@ -52,6 +52,19 @@ type Helpers struct {
 	Search            *SearchHelper
 	Worktree          *WorktreeHelper
 	SubCommits        *SubCommitsHelper
+
+	CommitPeek          *CommitPeekHelper
+	CommitsMinimap      *CommitsMinimapHelper
+	RebaseProgress      *RebaseProgressHelper
+	SessionState        *SessionStateHelper
+	KeybindingConflicts *KeybindingConflictsHelper
+	PresenterMode       *PresenterModeHelper
+	Language            *LanguageHelper
+	GoneBranches        *GoneBranchesHelper
+	FileHistory         *FileHistoryHelper
+	DryRun              *DryRunHelper
+	FileTreeState       *FileTreeStateHelper
+	ActionConfirmation  *ActionConfirmationHelper
 }
 
 func NewStubHelpers() *Helpers {
@@ -89,5 +102,18 @@ func NewStubHelpers() *Helpers {
 		Search:            &SearchHelper{},
 		Worktree:          &WorktreeHelper{},
 		SubCommits:        &SubCommitsHelper{},
+
+		CommitPeek:          &CommitPeekHelper{},
+		CommitsMinimap:      &CommitsMinimapHelper{},
+		RebaseProgress:      &RebaseProgressHelper{},
+		SessionState:        &SessionStateHelper{},
+		KeybindingConflicts: &KeybindingConflictsHelper{},
+		PresenterMode:       &PresenterModeHelper{},
+		Language:            &LanguageHelper{},
+		GoneBranches:        &GoneBranchesHelper{},
+		FileHistory:         &FileHistoryHelper{},
+		DryRun:              &DryRunHelper{},
+		FileTreeState:       &FileTreeStateHelper{},
+		ActionConfirmation:  &ActionConfirmationHelper{},
 	}
 }
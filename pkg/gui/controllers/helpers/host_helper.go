@@ -2,6 +2,9 @@ package helpers
 
 import (
 	"github.com/jesseduffield/lazygit/pkg/commands/hosting_service"
+	"github.com/jesseduffield/lazygit/pkg/commands/models"
+	"github.com/jesseduffield/lazygit/pkg/gui/presentation/links"
+	"github.com/samber/lo"
 )
 
 // this helper just wraps our hosting_service package
@@ -9,6 +12,7 @@ import (
 type IHostHelper interface {
 	GetPullRequestURL(from string, to string) (string, error)
 	GetCommitURL(commitSha string) (string, error)
+	GetForkURL(remoteName string, owner string) (string, error)
 }
 
 type HostHelper struct {
@@ -39,13 +43,50 @@ func (self *HostHelper) GetCommitURL(commitSha string) (string, error) {
 	return mgr.GetCommitURL(commitSha)
 }
 
+func (self *HostHelper) GetForkURL(remoteName string, owner string) (string, error) {
+	mgr, err := self.getHostingServiceMgrForRemote(remoteName)
+	if err != nil {
+		return "", err
+	}
+	return mgr.GetForkURL(owner)
+}
+
 // getting this on every request rather than storing it in state in case our remoteURL changes
 // from one invocation to the next.
 func (self *HostHelper) getHostingServiceMgr() (*hosting_service.HostingServiceMgr, error) {
-	remoteUrl, err := self.c.Git().Remote.GetRemoteURL("origin")
+	return self.getHostingServiceMgrForRemote("origin")
+}
+
+func (self *HostHelper) getHostingServiceMgrForRemote(remoteName string) (*hosting_service.HostingServiceMgr, error) {
+	remoteUrl, err := self.c.Git().Remote.GetRemoteURL(remoteName)
 	if err != nil {
 		return nil, err
 	}
 	configServices := self.c.UserConfig.Services
 	return hosting_service.NewHostingServiceMgr(self.c.Log, self.c.Tr, remoteUrl, configServices), nil
 }
+
+// newLinker returns a linker for the issue links from the user config, which
+// also links references like '#123' to the issue tracker of the origin remote
+// (or the first remote, if there's no origin). We use the remotes we've already
+// loaded rather than asking git, because this is used while rendering.
+func newLinker(c *HelperCommon, remotes []*models.Remote) *links.Linker {
+	remote, ok := lo.Find(remotes, func(remote *models.Remote) bool { return remote.Name == "origin" })
+	if !ok && len(remotes) > 0 {
+		remote = remotes[0]
+	}
+
+	var getIssueURL func(number string) string
+	if remote != nil && len(remote.Urls) > 0 {
+		mgr := hosting_service.NewHostingServiceMgr(c.Log, c.Tr, remote.Urls[0], c.UserConfig.Services)
+		getIssueURL = func(number string) string {
+			url, err := mgr.GetIssueURL(number)
+			if err != nil {
+				return ""
+			}
+			return url
+		}
+	}
+
+	return links.NewLinker(c.UserConfig.Git.IssueLinks, getIssueURL, c.Log)
+}
@@ -0,0 +1,145 @@
+package helpers
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/jesseduffield/lazygit/pkg/gui/keybindings"
+	"github.com/jesseduffield/lazygit/pkg/gui/style"
+	"github.com/jesseduffield/lazygit/pkg/gui/types"
+	"github.com/jesseduffield/lazygit/pkg/utils"
+	"github.com/samber/lo"
+)
+
+// When the user binds the same key to two actions in the same view, only one
+// of them can ever be invoked. Rather than leaving the user to wonder why a
+// keybinding does nothing, we mention such conflicts in the command log on
+// startup, and the full list can be opened from the command log menu. Some
+// users override built-in keys with custom commands on purpose, so we only
+// mention them again when a conflict comes up that we haven't reported before.
+type KeybindingConflictsHelper struct {
+	c *HelperCommon
+}
+
+func NewKeybindingConflictsHelper(c *HelperCommon) *KeybindingConflictsHelper {
+	return &KeybindingConflictsHelper{
+		c: c,
+	}
+}
+
+// NewConflictsNotice returns the notice to show for conflicts that we haven't
+// reported before, or an empty string if there are none
+func (self *KeybindingConflictsHelper) NewConflictsNotice() string {
+	conflicts := self.conflicts()
+	ids := lo.Map(conflicts, func(conflict *keybindings.Conflict, _ int) string {
+		return conflictId(conflict)
+	})
+
+	appState := self.c.GetAppState()
+	if len(lo.Without(ids, appState.ReportedKeybindingConflicts...)) == 0 {
+		return ""
+	}
+
+	appState.ReportedKeybindingConflicts = ids
+	self.c.SaveAppStateAndLogError()
+
+	return utils.ResolvePlaceholderString(self.c.Tr.KeybindingConflictsNotice, map[string]string{
+		"count": fmt.Sprint(len(conflicts)),
+		"key":   keybindings.Label(self.c.UserConfig.Keybinding.Universal.ExtrasMenu),
+	})
+}
+
+func (self *KeybindingConflictsHelper) ShowConflicts() error {
+	conflicts := self.conflicts()
+	if len(conflicts) == 0 {
+		return self.c.ErrorMsg(self.c.Tr.NoKeybindingConflicts)
+	}
+
+	menuItems := lo.Map(conflicts, func(conflict *keybindings.Conflict, _ int) *types.MenuItem {
+		return &types.MenuItem{
+			LabelColumns: []string{
+				style.FgCyan.Sprint(keybindings.LabelFromKey(conflict.Key)),
+				self.viewDisplayName(conflict.ViewName),
+				strings.Join(conflict.Descriptions, " / "),
+			},
+			OnPress: func() error { return nil },
+			Tooltip: self.conflictTooltip(conflict),
+		}
+	})
+
+	return self.c.Menu(types.CreateMenuOptions{
+		Title: self.c.Tr.KeybindingConflictsTitle,
+		Items: menuItems,
+	})
+}
+
+func (self *KeybindingConflictsHelper) conflicts() []*keybindings.Conflict {
+	bindings, _ := self.c.GetInitialKeybindingsWithCustomCommands()
+	return keybindings.FindConflicts(bindings)
+}
+
+func (self *KeybindingConflictsHelper) conflictTooltip(conflict *keybindings.Conflict) string {
+	paths := append(
+		keybindings.ConfigPaths(&self.c.UserConfig.Keybinding, conflict.ViewName, conflict.Key),
+		self.customCommandPaths(conflict)...,
+	)
+
+	tooltip := utils.ResolvePlaceholderString(self.c.Tr.KeybindingConflictTooltip, map[string]string{
+		"key":     keybindings.LabelFromKey(conflict.Key),
+		"view":    self.viewDisplayName(conflict.ViewName),
+		"action":  conflict.Descriptions[0],
+		"actions": "- " + strings.Join(conflict.Descriptions[1:], "\n- "),
+	})
+	if len(paths) > 0 {
+		tooltip += "\n\n" + self.c.Tr.KeybindingConflictConfigPaths + "\n- " + strings.Join(paths, "\n- ")
+	}
+
+	return tooltip
+}
+
+func (self *KeybindingConflictsHelper) customCommandPaths(conflict *keybindings.Conflict) []string {
+	paths := []string{}
+	label := keybindings.LabelFromKey(conflict.Key)
+	for i, customCommand := range self.c.UserConfig.CustomCommands {
+		key := keybindings.GetKey(customCommand.Key)
+		if key == nil || keybindings.LabelFromKey(key) != label {
+			continue
+		}
+
+		if viewName, ok := self.viewNameForCustomCommandContext(customCommand.Context); ok && viewName == conflict.ViewName {
+			paths = append(paths, fmt.Sprintf("customCommands[%d].key", i))
+		}
+	}
+
+	return paths
+}
+
+func (self *KeybindingConflictsHelper) viewNameForCustomCommandContext(contextKey string) (string, bool) {
+	if contextKey == "global" {
+		return "", true
+	}
+
+	ctx, ok := lo.Find(self.c.Contexts().Flatten(), func(ctx types.Context) bool {
+		return string(ctx.GetKey()) == contextKey
+	})
+	if !ok {
+		return "", false
+	}
+
+	return ctx.GetViewName(), true
+}
+
+func conflictId(conflict *keybindings.Conflict) string {
+	return strings.Join(append(
+		[]string{conflict.ViewName, keybindings.LabelFromKey(conflict.Key), fmt.Sprint(conflict.Modifier)},
+		conflict.Descriptions...,
+	), "|")
+}
+
+func (self *KeybindingConflictsHelper) viewDisplayName(viewName string) string {
+	if viewName == "" {
+		return self.c.Tr.GlobalTitle
+	}
+
+	return viewName
+}
@@ -0,0 +1,74 @@
+package helpers
+
+import (
+	"strings"
+
+	"github.com/jesseduffield/lazygit/pkg/gui/style"
+	"github.com/jesseduffield/lazygit/pkg/gui/types"
+	"github.com/jesseduffield/lazygit/pkg/i18n"
+	"github.com/samber/lo"
+)
+
+type onLanguageChangedFn func(language string, tr *i18n.TranslationSet) error
+
+// LanguageHelper lets the user switch the UI language on the fly, including to
+// languages they've supplied translation files for themselves
+type LanguageHelper struct {
+	c                 *HelperCommon
+	translationsDir   string
+	currentLanguage   string
+	onLanguageChanged onLanguageChangedFn
+}
+
+func NewLanguageHelper(
+	c *HelperCommon,
+	translationsDir string,
+	currentLanguage string,
+	onLanguageChanged onLanguageChangedFn,
+) *LanguageHelper {
+	return &LanguageHelper{
+		c:                 c,
+		translationsDir:   translationsDir,
+		currentLanguage:   currentLanguage,
+		onLanguageChanged: onLanguageChanged,
+	}
+}
+
+func (self *LanguageHelper) CreateLanguageMenu() error {
+	builtInLanguages := i18n.GetTranslationSets()
+	languages := append([]string{"auto"}, i18n.GetLanguages(self.translationsDir)...)
+
+	menuItems := lo.Map(languages, func(language string, _ int) *types.MenuItem {
+		notes := []string{}
+		if language == self.currentLanguage {
+			notes = append(notes, self.c.Tr.CurrentLanguage)
+		}
+		if _, ok := builtInLanguages[language]; !ok && language != "auto" {
+			notes = append(notes, self.c.Tr.UserProvidedLanguage)
+		}
+
+		return &types.MenuItem{
+			LabelColumns: []string{
+				language,
+				style.FgYellow.Sprint(strings.Join(notes, ", ")),
+			},
+			OnPress: func() error {
+				return self.SetLanguage(language)
+			},
+		}
+	})
+
+	return self.c.Menu(types.CreateMenuOptions{
+		Title: self.c.Tr.ChangeLanguage,
+		Items: menuItems,
+	})
+}
+
+func (self *LanguageHelper) SetLanguage(language string) error {
+	tr, err := i18n.NewTranslationSetFromConfig(self.c.Log, language, self.translationsDir)
+	if err != nil {
+		return self.c.Error(err)
+	}
+
+	return self.onLanguageChanged(language, tr)
+}
@@ -2,6 +2,7 @@ package helpers
 
 import (
 	"fmt"
+	"regexp"
 	"strings"
 
 	"github.com/jesseduffield/gocui"
@@ -13,17 +14,20 @@ import (
 )
 
 type MergeAndRebaseHelper struct {
-	c          *HelperCommon
-	refsHelper *RefsHelper
+	c                 *HelperCommon
+	refsHelper        *RefsHelper
+	suggestionsHelper *SuggestionsHelper
 }
 
 func NewMergeAndRebaseHelper(
 	c *HelperCommon,
 	refsHelper *RefsHelper,
+	suggestionsHelper *SuggestionsHelper,
 ) *MergeAndRebaseHelper {
 	return &MergeAndRebaseHelper{
-		c:          c,
-		refsHelper: refsHelper,
+		c:                 c,
+		refsHelper:        refsHelper,
+		suggestionsHelper: suggestionsHelper,
 	}
 }
 
@@ -46,7 +50,8 @@ func (self *MergeAndRebaseHelper) CreateRebaseOptionsMenu() error {
 		{option: REBASE_OPTION_ABORT, key: 'a'},
 	}
 
-	if self.c.Git().Status.WorkingTreeState() == enums.REBASE_MODE_REBASING {
+	workingTreeState := self.c.Git().Status.WorkingTreeState()
+	if workingTreeState != enums.REBASE_MODE_MERGING {
 		options = append(options, optionAndKey{
 			option: REBASE_OPTION_SKIP, key: 's',
 		})
@@ -63,19 +68,32 @@ func (self *MergeAndRebaseHelper) CreateRebaseOptionsMenu() error {
 	})
 
 	var title string
-	if self.c.Git().Status.WorkingTreeState() == enums.REBASE_MODE_MERGING {
+	switch workingTreeState {
+	case enums.REBASE_MODE_MERGING:
 		title = self.c.Tr.MergeOptionsTitle
-	} else {
+	case enums.REBASE_MODE_CHERRY_PICKING:
+		title = self.c.Tr.CherryPickOptionsTitle
+	case enums.REBASE_MODE_REVERTING:
+		title = self.c.Tr.RevertOptionsTitle
+	default:
 		title = self.c.Tr.RebaseOptionsTitle
 	}
 
 	return self.c.Menu(types.CreateMenuOptions{Title: title, Items: menuItems})
 }
 
+func (self *MergeAndRebaseHelper) ContinueMergeOrRebase() error {
+	return self.genericMergeCommand(REBASE_OPTION_CONTINUE)
+}
+
+func (self *MergeAndRebaseHelper) SkipMergeOrRebaseStep() error {
+	return self.genericMergeCommand(REBASE_OPTION_SKIP)
+}
+
 func (self *MergeAndRebaseHelper) genericMergeCommand(command string) error {
 	status := self.c.Git().Status.WorkingTreeState()
 
-	if status != enums.REBASE_MODE_MERGING && status != enums.REBASE_MODE_REBASING {
+	if status == enums.REBASE_MODE_NONE {
 		return self.c.ErrorMsg(self.c.Tr.NotMergingOrRebasing)
 	}
 
@@ -87,6 +105,10 @@ func (self *MergeAndRebaseHelper) genericMergeCommand(command string) error {
 		commandType = "merge"
 	case enums.REBASE_MODE_REBASING:
 		commandType = "rebase"
+	case enums.REBASE_MODE_CHERRY_PICKING:
+		commandType = "cherry-pick"
+	case enums.REBASE_MODE_REVERTING:
+		commandType = "revert"
 	default:
 		// shouldn't be possible to land here
 	}
@@ -125,6 +147,19 @@ func isMergeConflictErr(errStr string) bool {
 	return false
 }
 
+var failedExecRegexp = regexp.MustCompile(`(?m)execution failed: (.*)$`)
+
+// failedExecCommand returns the command of an exec todo that failed, if that's
+// why the rebase stopped
+func failedExecCommand(errStr string) (string, bool) {
+	match := failedExecRegexp.FindStringSubmatch(errStr)
+	if match == nil {
+		return "", false
+	}
+
+	return strings.TrimSpace(match[1]), true
+}
+
 func (self *MergeAndRebaseHelper) CheckMergeOrRebaseWithRefreshOptions(result error, refreshOptions types.RefreshOptions) error {
 	if err := self.c.Refresh(refreshOptions); err != nil {
 		return err
@@ -138,6 +173,8 @@ func (self *MergeAndRebaseHelper) CheckMergeOrRebaseWithRefreshOptions(result er
 	} else if strings.Contains(result.Error(), "No rebase in progress?") {
 		// assume in this case that we're already done
 		return nil
+	} else if failedCommand, ok := failedExecCommand(result.Error()); ok {
+		return self.PromptForFailedExec(failedCommand)
 	} else {
 		return self.CheckForConflicts(result)
 	}
@@ -183,6 +220,70 @@ func (self *MergeAndRebaseHelper) PromptForConflictHandling() error {
 	})
 }
 
+// PromptForFailedExec is shown when an exec todo of a rebase fails. Depending
+// on rebase.rescheduleFailedExec, git has either put the command back into the
+// todo list or moved on from it, so each option makes sure the todo list is in
+// the right state before continuing.
+func (self *MergeAndRebaseHelper) PromptForFailedExec(failedCommand string) error {
+	continueRebase := func(updateTodos func() error) error {
+		if err := updateTodos(); err != nil {
+			return self.c.Error(err)
+		}
+		return self.genericMergeCommand(REBASE_OPTION_CONTINUE)
+	}
+
+	return self.c.Menu(types.CreateMenuOptions{
+		Title: utils.ResolvePlaceholderString(self.c.Tr.ExecFailedTitle, map[string]string{
+			"command": failedCommand,
+		}),
+		Items: []*types.MenuItem{
+			{
+				Label: self.c.Tr.RetryExec,
+				OnPress: func() error {
+					return continueRebase(func() error {
+						return self.c.Git().Rebase.RescheduleFailedExec(failedCommand, failedCommand)
+					})
+				},
+				Key: 'r',
+			},
+			{
+				Label: self.c.Tr.EditExecAndRetry,
+				OnPress: func() error {
+					return self.c.Prompt(types.PromptOpts{
+						Title:          self.c.Tr.EditExecTitle,
+						InitialContent: failedCommand,
+						HandleConfirm: func(newCommand string) error {
+							if strings.TrimSpace(newCommand) == "" {
+								return self.c.ErrorMsg(self.c.Tr.ExecCommandCannotBeEmpty)
+							}
+							return continueRebase(func() error {
+								return self.c.Git().Rebase.RescheduleFailedExec(failedCommand, newCommand)
+							})
+						},
+					})
+				},
+				Key: 'e',
+			},
+			{
+				Label: self.c.Tr.SkipExec,
+				OnPress: func() error {
+					return continueRebase(func() error {
+						return self.c.Git().Rebase.SkipFailedExec(failedCommand)
+					})
+				},
+				Key: 's',
+			},
+			{
+				Label: fmt.Sprintf(self.c.Tr.AbortMenuItem, self.c.Tr.RebaseOperation),
+				OnPress: func() error {
+					return self.genericMergeCommand(REBASE_OPTION_ABORT)
+				},
+				Key: 'a',
+			},
+		},
+	})
+}
+
 func (self *MergeAndRebaseHelper) AbortMergeOrRebaseWithConfirm() error {
 	// prompt user to confirm that they want to abort, then do it
 	mode := self.workingTreeStateNoun()
@@ -201,9 +302,30 @@ func (self *MergeAndRebaseHelper) workingTreeStateNoun() string {
 	case enums.REBASE_MODE_NONE:
 		return ""
 	case enums.REBASE_MODE_MERGING:
-		return "merge"
+		return self.c.Tr.MergeOperation
+	case enums.REBASE_MODE_CHERRY_PICKING:
+		return self.c.Tr.CherryPickOperation
+	case enums.REBASE_MODE_REVERTING:
+		return self.c.Tr.RevertOperation
+	default:
+		return self.c.Tr.RebaseOperation
+	}
+}
+
+// HandleConflictsResolved is called once the last conflict of the
+// rebase/merge in progress has been resolved. Depending on the user's config we
+// ask whether to continue, continue straight away, or leave it to the user.
+func (self *MergeAndRebaseHelper) HandleConflictsResolved() error {
+	switch self.c.UserConfig.Git.Merging.OnConflictsResolved {
+	case "continue":
+		self.c.Toast(utils.ResolvePlaceholderString(self.c.Tr.ContinuingOnConflictsResolved, map[string]string{
+			"action": self.workingTreeStateNoun(),
+		}))
+		return self.genericMergeCommand(REBASE_OPTION_CONTINUE)
+	case "none":
+		return nil
 	default:
-		return "rebase"
+		return self.PromptToContinueRebase()
 	}
 }
 
@@ -219,7 +341,18 @@ func (self *MergeAndRebaseHelper) PromptToContinueRebase() error {
 }
 
 func (self *MergeAndRebaseHelper) RebaseOntoRef(ref string) error {
+	return self.rebaseOnto(ref, ref)
+}
+
+func (self *MergeAndRebaseHelper) RebaseOntoCommit(sha string) error {
+	return self.rebaseOnto(sha, utils.ShortShaOfLength(sha, self.c.UserConfig.Gui.CommitHashLength))
+}
+
+// displayName is how we refer to the ref in the menu, which matters for
+// commits whose full sha would be a bit much
+func (self *MergeAndRebaseHelper) rebaseOnto(ref string, displayName string) error {
 	checkedOutBranch := self.refsHelper.GetCheckedOutRef().Name
+	autosquash := self.c.GetAppState().AutosquashInteractiveRebase
 	menuItems := []*types.MenuItem{
 		{
 			Label: self.c.Tr.SimpleRebase,
@@ -243,7 +376,7 @@ func (self *MergeAndRebaseHelper) RebaseOntoRef(ref string) error {
 			},
 		},
 		{
-			Label:   self.c.Tr.InteractiveRebase,
+			Label:   lo.Ternary(autosquash, self.c.Tr.InteractiveRebaseWithAutosquash, self.c.Tr.InteractiveRebase),
 			Key:     'i',
 			Tooltip: self.c.Tr.InteractiveRebaseTooltip,
 			OnPress: func() error {
@@ -251,9 +384,9 @@ func (self *MergeAndRebaseHelper) RebaseOntoRef(ref string) error {
 				baseCommit := self.c.Modes().MarkedBaseCommit.GetSha()
 				var err error
 				if baseCommit != "" {
-					err = self.c.Git().Rebase.EditRebaseFromBaseCommit(ref, baseCommit)
+					err = self.c.Git().Rebase.EditRebaseFromBaseCommit(ref, baseCommit, autosquash)
 				} else {
-					err = self.c.Git().Rebase.EditRebase(ref)
+					err = self.c.Git().Rebase.EditRebaseBranch(ref, autosquash)
 				}
 				if err = self.CheckMergeOrRebase(err); err != nil {
 					return err
@@ -264,6 +397,44 @@ func (self *MergeAndRebaseHelper) RebaseOntoRef(ref string) error {
 				return self.c.PushContext(self.c.Contexts().LocalCommits)
 			},
 		},
+		{
+			Label:   lo.Ternary(autosquash, self.c.Tr.DisableAutosquash, self.c.Tr.EnableAutosquash),
+			Key:     'a',
+			Tooltip: self.c.Tr.ToggleAutosquashTooltip,
+			OnPress: func() error {
+				self.ToggleAutosquash()
+				// reopen the menu so that the user can go on to start the rebase
+				return self.rebaseOnto(ref, displayName)
+			},
+		},
+		{
+			Label:   self.c.Tr.RebaseOntoWithUpstream,
+			Key:     'o',
+			Tooltip: self.c.Tr.RebaseOntoWithUpstreamTooltip,
+			OnPress: func() error {
+				return self.c.Prompt(types.PromptOpts{
+					Title: utils.ResolvePlaceholderString(self.c.Tr.RebaseOntoUpstreamPromptTitle, map[string]string{
+						"ref": displayName,
+					}),
+					FindSuggestionsFunc: self.suggestionsHelper.GetRefsSuggestionsFunc(),
+					HandleConfirm: func(upstream string) error {
+						upstream = strings.TrimSpace(upstream)
+						if upstream == "" {
+							return nil
+						}
+
+						self.c.LogAction(self.c.Tr.Actions.RebaseBranch)
+						return self.c.WithWaitingStatus(self.c.Tr.RebasingStatus, func(gocui.Task) error {
+							err := self.c.Git().Rebase.RebaseBranchFromBaseCommit(ref, upstream)
+							if err = self.CheckMergeOrRebase(err); err != nil {
+								return err
+							}
+							return self.ResetMarkedBaseCommit()
+						})
+					},
+				})
+			},
+		},
 	}
 
 	title := utils.ResolvePlaceholderString(
@@ -272,7 +443,7 @@ func (self *MergeAndRebaseHelper) RebaseOntoRef(ref string) error {
 			self.c.Tr.RebasingTitle),
 		map[string]string{
 			"checkedOutBranch": checkedOutBranch,
-			"ref":              ref,
+			"ref":              displayName,
 		},
 	)
 
@@ -282,6 +453,13 @@ func (self *MergeAndRebaseHelper) RebaseOntoRef(ref string) error {
 	})
 }
 
+// ToggleAutosquash toggles whether interactive rebases that the user starts
+// are run with --autosquash. The setting is remembered across sessions.
+func (self *MergeAndRebaseHelper) ToggleAutosquash() {
+	self.c.GetAppState().AutosquashInteractiveRebase = !self.c.GetAppState().AutosquashInteractiveRebase
+	self.c.SaveAppStateAndLogError()
+}
+
 func (self *MergeAndRebaseHelper) MergeRefIntoCheckedOutBranch(refName string) error {
 	if self.c.Git().Branch.IsHeadDetached() {
 		return self.c.ErrorMsg("Cannot merge branch in detached head state. You might have checked out a commit directly or a remote branch, in which case you should checkout the local branch you want to be on")
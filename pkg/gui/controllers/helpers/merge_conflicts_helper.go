@@ -1,8 +1,11 @@
 package helpers
 
 import (
+	"github.com/jesseduffield/lazygit/pkg/commands/models"
 	"github.com/jesseduffield/lazygit/pkg/gui/context"
+	"github.com/jesseduffield/lazygit/pkg/gui/filetree"
 	"github.com/jesseduffield/lazygit/pkg/gui/types"
+	"github.com/samber/lo"
 )
 
 type MergeConflictsHelper struct {
@@ -96,6 +99,83 @@ func (self *MergeConflictsHelper) SwitchToMerge(path string) error {
 	return self.c.PushContext(self.c.Contexts().MergeConflicts)
 }
 
+// ConflictedFiles returns the files with merge conflicts, in the order they
+// appear in the files panel
+func (self *MergeConflictsHelper) ConflictedFiles() []*models.File {
+	root := self.c.Contexts().Files.GetRoot()
+	if root == nil {
+		return nil
+	}
+
+	return lo.FilterMap(root.GetLeaves(), func(node *filetree.Node[models.File], _ int) (*models.File, bool) {
+		return node.File, node.File.HasMergeConflicts
+	})
+}
+
+func (self *MergeConflictsHelper) SwitchToNextConflictedFile() error {
+	return self.switchToConflictedFile(1)
+}
+
+func (self *MergeConflictsHelper) SwitchToPrevConflictedFile() error {
+	return self.switchToConflictedFile(-1)
+}
+
+func (self *MergeConflictsHelper) switchToConflictedFile(offset int) error {
+	files := self.ConflictedFiles()
+	if len(files) == 0 {
+		return nil
+	}
+
+	index := lo.IndexOf(lo.Map(files, func(file *models.File, _ int) string { return file.Name }), self.currentPath())
+	if index == -1 {
+		// we're not looking at a conflicted file, so we start from either end
+		if offset > 0 {
+			index = len(files) - 1
+		} else {
+			index = 0
+		}
+	}
+	file := files[(index+offset+len(files))%len(files)]
+
+	// select the file in the files panel so that we return to it after
+	// resolving its conflicts
+	filesContext := self.c.Contexts().Files
+	if filesContext.InTreeMode() {
+		filesContext.ExpandToPath(file.Name)
+	}
+	if index, found := filesContext.GetIndexForPath(file.Name); found {
+		filesContext.SetSelectedLineIdx(index)
+	}
+	if err := self.c.PostRefreshUpdate(filesContext); err != nil {
+		return err
+	}
+	filesContext.FocusLine()
+
+	// open the merge conflicts view on top of the files panel, just like when
+	// pressing enter on the file there, so that escaping lands on the file
+	if !self.c.IsCurrentContext(self.context()) {
+		if err := self.c.PushContext(filesContext); err != nil {
+			return err
+		}
+	}
+
+	return self.SwitchToMerge(file.Name)
+}
+
+// currentPath returns the path of the conflicted file being looked at, either
+// in the merge conflicts view or in the files panel
+func (self *MergeConflictsHelper) currentPath() string {
+	if self.c.IsCurrentContext(self.context()) {
+		return self.context().GetState().GetPath()
+	}
+
+	if self.c.IsCurrentContext(self.c.Contexts().Files) {
+		return self.c.Contexts().Files.GetSelectedPath()
+	}
+
+	return ""
+}
+
 func (self *MergeConflictsHelper) context() *context.MergeConflictsContext {
 	return self.c.Contexts().MergeConflicts
 }
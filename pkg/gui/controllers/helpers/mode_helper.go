@@ -8,6 +8,7 @@ import (
 	"github.com/jesseduffield/lazygit/pkg/gui/presentation"
 	"github.com/jesseduffield/lazygit/pkg/gui/style"
 	"github.com/jesseduffield/lazygit/pkg/gui/types"
+	"github.com/jesseduffield/lazygit/pkg/utils"
 	"github.com/samber/lo"
 )
 
@@ -130,10 +131,31 @@ func (self *ModeHelper) Statuses() []ModeStatus {
 				return self.c.Model().BisectInfo.Started()
 			},
 			Description: func() string {
+				info := self.c.Model().BisectInfo
+				if info.UsesCustomTerms() {
+					return self.withResetButton(
+						utils.ResolvePlaceholderString(self.c.Tr.Bisect.BisectingWithTerms, map[string]string{
+							"oldTerm": info.OldTerm(),
+							"newTerm": info.NewTerm(),
+						}),
+						style.FgGreen,
+					)
+				}
 				return self.withResetButton(self.c.Tr.Bisect.Bisecting, style.FgGreen)
 			},
 			Reset: self.bisectHelper.Reset,
 		},
+		{
+			IsActive: self.c.State().GetDryRun,
+			Description: func() string {
+				return self.withResetButton(self.c.Tr.DryRunModeStatus, style.FgMagenta.SetBold())
+			},
+			Reset: func() error {
+				self.c.State().SetDryRun(false)
+				self.c.Toast(self.c.Tr.DryRunDisabled)
+				return nil
+			},
+		},
 	}
 }
 
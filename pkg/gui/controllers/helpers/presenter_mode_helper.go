@@ -0,0 +1,154 @@
+package helpers
+
+import (
+	"strings"
+	"time"
+
+	"github.com/jesseduffield/gocui"
+	"github.com/jesseduffield/lazygit/pkg/gui/keybindings"
+	"github.com/jesseduffield/lazygit/pkg/gui/style"
+	"github.com/jesseduffield/lazygit/pkg/gui/types"
+	"github.com/jesseduffield/lazygit/pkg/utils"
+	"github.com/mattn/go-runewidth"
+	"github.com/samber/lo"
+)
+
+// In presenter mode we show the keys that were pressed, along with the actions
+// they triggered, in a small floating view in the bottom right corner, so that
+// people watching a screencast or a pairing session can follow along. Actions
+// can also be delayed a little, so that the key shows up before the screen
+// changes.
+type PresenterModeHelper struct {
+	c *HelperCommon
+
+	// most recent last
+	keystrokes []*keystroke
+}
+
+type keystroke struct {
+	label       string
+	description string
+	shownUntil  time.Time
+}
+
+// we only show the most recent keys, so that the view stays small
+const maxKeystrokes = 5
+
+func NewPresenterModeHelper(c *HelperCommon) *PresenterModeHelper {
+	return &PresenterModeHelper{
+		c: c,
+	}
+}
+
+func (self *PresenterModeHelper) Toggle() error {
+	enabled := !self.c.State().GetPresenterMode()
+	self.c.State().SetPresenterMode(enabled)
+	self.keystrokes = nil
+	self.render()
+
+	if enabled {
+		self.c.Toast(self.c.Tr.PresenterModeEnabled)
+	} else {
+		self.c.Toast(self.c.Tr.PresenterModeDisabled)
+	}
+
+	return nil
+}
+
+// RunKeybinding shows the binding's key and then runs its handler, after the
+// configured delay. We wait on the UI thread, after drawing the key, so that
+// any keys pressed in the meantime stay queued in gocui; that way they are
+// matched to their bindings only after this action has run, just like outside
+// presenter mode.
+func (self *PresenterModeHelper) RunKeybinding(binding *types.Binding) error {
+	if key, ok := binding.Key.(gocui.Key); ok && gocui.IsMouseKey(key) {
+		return binding.Handler()
+	}
+
+	self.addKeystroke(binding)
+
+	if delay := self.actionDelay(); delay > 0 {
+		if err := self.c.GocuiGui().ForceLayoutAndRedraw(); err != nil {
+			return err
+		}
+		time.Sleep(delay)
+	}
+
+	return binding.Handler()
+}
+
+func (self *PresenterModeHelper) actionDelay() time.Duration {
+	return time.Duration(self.c.UserConfig.Gui.PresenterMode.ActionDelay) * time.Millisecond
+}
+
+func (self *PresenterModeHelper) addKeystroke(binding *types.Binding) {
+	displayDuration := time.Duration(self.c.UserConfig.Gui.PresenterMode.KeyDisplayDuration) * time.Millisecond
+
+	self.keystrokes = append(self.keystrokes, &keystroke{
+		label:       keybindings.LabelFromKey(binding.Key),
+		description: binding.Description,
+		shownUntil:  time.Now().Add(displayDuration),
+	})
+	if len(self.keystrokes) > maxKeystrokes {
+		self.keystrokes = self.keystrokes[len(self.keystrokes)-maxKeystrokes:]
+	}
+	self.render()
+
+	time.AfterFunc(displayDuration, func() {
+		self.c.OnUIThread(func() error {
+			self.removeExpiredKeystrokes()
+			return nil
+		})
+	})
+}
+
+func (self *PresenterModeHelper) removeExpiredKeystrokes() {
+	now := time.Now()
+	self.keystrokes = lo.Filter(self.keystrokes, func(keystroke *keystroke, _ int) bool {
+		return keystroke.shownUntil.After(now)
+	})
+	self.render()
+}
+
+func (self *PresenterModeHelper) render() {
+	view := self.c.Views().Keystrokes
+	if !self.c.State().GetPresenterMode() || len(self.keystrokes) == 0 {
+		view.Visible = false
+		return
+	}
+
+	lines := lo.Map(self.keystrokes, func(keystroke *keystroke, _ int) string {
+		if keystroke.description == "" {
+			return style.FgCyan.Sprint(keystroke.label)
+		}
+		return style.FgCyan.Sprint(keystroke.label) + " " + keystroke.description
+	})
+	self.c.SetViewContent(view, strings.Join(lines, "\n"))
+	view.Visible = true
+
+	self.Resize()
+}
+
+// Resize places the view in the bottom right corner, just above the options
+// bar, and makes it wide enough for its longest line
+func (self *PresenterModeHelper) Resize() {
+	view := self.c.Views().Keystrokes
+	if !view.Visible {
+		return
+	}
+
+	screenWidth, screenHeight := self.c.GocuiGui().Size()
+	contentWidth := utils.MaxFn(self.keystrokes, func(keystroke *keystroke) int {
+		return runewidth.StringWidth(keystroke.label) + 1 + runewidth.StringWidth(keystroke.description)
+	})
+	// leaving room for the title too
+	contentWidth = utils.Max(contentWidth, runewidth.StringWidth(view.Title)+2)
+	width := utils.Min(contentWidth+1, screenWidth/2)
+
+	x1 := screenWidth - 2
+	x0 := x1 - width - 1
+	y1 := screenHeight - 2
+	y0 := y1 - len(self.keystrokes) - 1
+
+	_, _ = self.c.GocuiGui().SetView(view.Name(), x0, y0, x1, y1, 0)
+}
@@ -0,0 +1,52 @@
+package helpers
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/jesseduffield/lazygit/pkg/gui/presentation"
+	"github.com/jesseduffield/lazygit/pkg/utils"
+)
+
+// The rebase progress panel sits below the main view during an interactive
+// rebase and lists the todos that are done, the one we're stopped at, and the
+// remaining ones, so that the step counter has some context. Like the commits
+// minimap it is re-rendered on every layout, but only touches the view when
+// something changed.
+type RebaseProgressHelper struct {
+	c *HelperCommon
+
+	// the content we last rendered
+	content string
+}
+
+func NewRebaseProgressHelper(c *HelperCommon) *RebaseProgressHelper {
+	return &RebaseProgressHelper{
+		c: c,
+	}
+}
+
+func (self *RebaseProgressHelper) Render() {
+	view := self.c.Views().RebaseProgress
+	progress := self.c.Model().RebaseProgress
+	if !view.Visible || progress == nil {
+		self.content = ""
+		return
+	}
+
+	lines := presentation.GetRebaseProgressDisplayStrings(progress, self.c.UserConfig.Gui.CommitHashLength)
+	content := strings.Join(lines, "\n")
+	if content == self.content {
+		return
+	}
+	self.content = content
+
+	view.Title = fmt.Sprintf("%s (%d/%d)", self.c.Tr.RebaseProgressTitle, len(progress.Done), progress.Total())
+	self.c.SetViewContent(view, content)
+
+	// keep the current todo in view, with the one before it for context
+	_, height := view.Size()
+	currentIdx := len(progress.Done) - 1
+	originY := utils.Clamp(currentIdx-1, 0, utils.Max(len(lines)-height, 0))
+	_ = view.SetOriginY(originY)
+}
@@ -29,6 +29,7 @@ type RefreshHelper struct {
 	mergeConflictsHelper *MergeConflictsHelper
 	worktreeHelper       *WorktreeHelper
 	searchHelper         *SearchHelper
+	tagsHelper           *TagsHelper
 }
 
 func NewRefreshHelper(
@@ -40,6 +41,7 @@ func NewRefreshHelper(
 	mergeConflictsHelper *MergeConflictsHelper,
 	worktreeHelper *WorktreeHelper,
 	searchHelper *SearchHelper,
+	tagsHelper *TagsHelper,
 ) *RefreshHelper {
 	return &RefreshHelper{
 		c:                    c,
@@ -50,6 +52,7 @@ func NewRefreshHelper(
 		mergeConflictsHelper: mergeConflictsHelper,
 		worktreeHelper:       worktreeHelper,
 		searchHelper:         searchHelper,
+		tagsHelper:           tagsHelper,
 	}
 }
 
@@ -329,6 +332,7 @@ func (self *RefreshHelper) refreshCommitsWithLimit() error {
 	self.c.Model().Commits = commits
 	self.RefreshAuthors(commits)
 	self.c.Model().WorkingTreeStateAtLastCommitRefresh = self.c.Git().Status.WorkingTreeState()
+	self.refreshRebaseProgress()
 	self.c.Model().CheckedOutBranch = checkedOutBranchName
 
 	return self.refreshView(self.c.Contexts().LocalCommits)
@@ -397,16 +401,27 @@ func (self *RefreshHelper) refreshRebaseCommits() error {
 	}
 	self.c.Model().Commits = updatedCommits
 	self.c.Model().WorkingTreeStateAtLastCommitRefresh = self.c.Git().Status.WorkingTreeState()
+	self.refreshRebaseProgress()
 
 	return self.refreshView(self.c.Contexts().LocalCommits)
 }
 
+func (self *RefreshHelper) refreshRebaseProgress() {
+	progress, err := self.c.Git().Rebase.GetRebaseProgress()
+	if err != nil {
+		self.c.Log.Error(err)
+	}
+
+	self.c.Model().RebaseProgress = progress
+}
+
 func (self *RefreshHelper) refreshTags() error {
 	tags, err := self.c.Git().Loaders.TagLoader.GetTags()
 	if err != nil {
 		return self.c.Error(err)
 	}
 
+	self.tagsHelper.ApplySignatureStatuses(tags)
 	self.c.Model().Tags = tags
 
 	return self.refreshView(self.c.Contexts().Tags)
@@ -535,18 +550,41 @@ func (self *RefreshHelper) refreshStateFiles() error {
 		}
 	}
 
+	sortOrder := filetree.FileSortOrder(self.c.GetAppState().FileSortOrders[self.c.Git().RepoPaths.RepoPath()])
+	if !lo.Contains(filetree.FileSortOrders, sortOrder) {
+		sortOrder = filetree.SortByName
+	}
+
+	fileSizes, err := presentation.NewFileSizeOptions(self.c.UserConfig.Gui.Files)
+	if err != nil {
+		self.c.Log.Errorf("Invalid large/huge file threshold in config: %v", err)
+	}
+
 	files := self.c.Git().Loaders.FileLoader.
-		GetStatusFiles(git_commands.GetStatusFileOptions{})
+		GetStatusFiles(git_commands.GetStatusFileOptions{
+			LineCounts: self.c.UserConfig.Gui.Files.ShowNumstat || sortOrder == filetree.SortByChangedLines,
+			ModTimes:   sortOrder == filetree.SortByModTime,
+			FileSizes:  fileSizes.ShowAll || fileSizes.LargeThreshold > 0 || fileSizes.HugeThreshold > 0,
+			IndexFlags: self.c.UserConfig.Gui.Files.ShowIndexFlags,
+			Modes:      true,
+		})
 
 	conflictFileCount := 0
 	for _, file := range files {
 		if file.HasMergeConflicts {
 			conflictFileCount++
 		}
+		if file.HasInlineMergeConflicts {
+			conflictCount, err := mergeconflicts.CountConflicts(file.Name)
+			if err != nil {
+				self.c.Log.Error(err)
+			}
+			file.ConflictCount = conflictCount
+		}
 	}
 
 	if self.c.Git().Status.WorkingTreeState() != enums.REBASE_MODE_NONE && conflictFileCount == 0 && prevConflictFileCount > 0 {
-		self.c.OnUIThread(func() error { return self.mergeAndRebaseHelper.PromptToContinueRebase() })
+		self.c.OnUIThread(func() error { return self.mergeAndRebaseHelper.HandleConflictsResolved() })
 	}
 
 	fileTreeViewModel.RWMutex.Lock()
@@ -557,14 +595,15 @@ func (self *RefreshHelper) refreshStateFiles() error {
 	// extra state here to see if the user's set the filter themselves we can do that, but
 	// I'd prefer to maintain as little state as possible.
 	if conflictFileCount > 0 {
-		if fileTreeViewModel.GetFilter() == filetree.DisplayAll {
+		if fileTreeViewModel.GetStatusFilter() == filetree.DisplayAll {
 			fileTreeViewModel.SetStatusFilter(filetree.DisplayConflicted)
 		}
-	} else if fileTreeViewModel.GetFilter() == filetree.DisplayConflicted {
+	} else if fileTreeViewModel.GetStatusFilter() == filetree.DisplayConflicted {
 		fileTreeViewModel.SetStatusFilter(filetree.DisplayAll)
 	}
 
 	self.c.Model().Files = files
+	fileTreeViewModel.SetSortOrder(sortOrder)
 	fileTreeViewModel.SetTree()
 	fileTreeViewModel.RWMutex.Unlock()
 
@@ -626,6 +665,7 @@ func (self *RefreshHelper) refreshRemotes() error {
 	}
 
 	self.c.Model().Remotes = remotes
+	self.c.Model().Linker = newLinker(self.c, remotes)
 
 	// we need to ensure our selected remote branches aren't now outdated
 	if prevSelectedRemote != nil && self.c.Model().RemoteBranches != nil {
@@ -646,7 +686,15 @@ func (self *RefreshHelper) refreshRemotes() error {
 		return err
 	}
 
-	return nil
+	// Need to re-render the branches and commits views because the issue links
+	// in them depend on the remotes
+	if err := self.c.Contexts().Branches.HandleRender(); err != nil {
+		return err
+	}
+
+	self.c.Mutexes().LocalCommitsMutex.Lock()
+	defer self.c.Mutexes().LocalCommitsMutex.Unlock()
+	return self.c.Contexts().LocalCommits.HandleRender()
 }
 
 func (self *RefreshHelper) loadWorktrees() {
@@ -661,6 +709,7 @@ func (self *RefreshHelper) loadWorktrees() {
 
 func (self *RefreshHelper) refreshWorktrees() error {
 	self.loadWorktrees()
+	self.worktreeHelper.InvalidateStats()
 
 	// need to refresh branches because the branches view shows worktrees against
 	// branches
@@ -692,11 +741,35 @@ func (self *RefreshHelper) refreshStatus() {
 	workingTreeState := self.c.Git().Status.WorkingTreeState()
 	linkedWorktreeName := self.worktreeHelper.GetLinkedWorktreeName()
 
-	repoName := self.c.Git().RepoPaths.RepoName()
+	repoName := presentation.FormatRepoBreadcrumbs(
+		self.c.State().GetRepoPathStack().Items(),
+		self.c.Git().RepoPaths.RepoName(),
+	)
 
-	status := presentation.FormatStatus(repoName, currentBranch, types.ItemOperationNone, linkedWorktreeName, workingTreeState, self.c.Tr)
+	status := presentation.FormatStatus(repoName, currentBranch, types.ItemOperationNone, linkedWorktreeName, workingTreeState, self.c.Tr, self.c.UserConfig.Gui.StatusGlyphs)
 
 	self.c.SetViewContent(self.c.Views().Status, status)
+
+	// while a rebase, merge, bisect etc. is in progress the main view shows how
+	// far along it is, so it needs to keep up, and go back to normal once it's
+	// done
+	operationTitles := []string{
+		presentation.FormatWorkingTreeStateTitle(self.c.Tr, enums.REBASE_MODE_REBASING),
+		presentation.FormatWorkingTreeStateTitle(self.c.Tr, enums.REBASE_MODE_MERGING),
+		presentation.FormatWorkingTreeStateTitle(self.c.Tr, enums.REBASE_MODE_CHERRY_PICKING),
+		presentation.FormatWorkingTreeStateTitle(self.c.Tr, enums.REBASE_MODE_REVERTING),
+		self.c.Tr.Bisect.Bisecting,
+	}
+	inOperation := workingTreeState != enums.REBASE_MODE_NONE || self.c.Git().Bisect.GetInfo().Started()
+	self.c.OnUIThread(func() error {
+		if self.c.CurrentSideContext().GetKey() != self.c.Contexts().Status.GetKey() {
+			return nil
+		}
+		if inOperation || lo.Contains(operationTitles, self.c.Views().Main.Title) {
+			return self.c.Contexts().Status.HandleRenderToMain()
+		}
+		return nil
+	})
 }
 
 func (self *RefreshHelper) refForLog() string {
@@ -7,6 +7,7 @@ import (
 	"github.com/jesseduffield/gocui"
 	"github.com/jesseduffield/lazygit/pkg/commands/git_commands"
 	"github.com/jesseduffield/lazygit/pkg/commands/models"
+	"github.com/jesseduffield/lazygit/pkg/commands/oscommands"
 	"github.com/jesseduffield/lazygit/pkg/gui/style"
 	"github.com/jesseduffield/lazygit/pkg/gui/types"
 	"github.com/jesseduffield/lazygit/pkg/utils"
@@ -23,13 +24,17 @@ type IRefsHelper interface {
 
 type RefsHelper struct {
 	c *HelperCommon
+
+	dryRunHelper *DryRunHelper
 }
 
 func NewRefsHelper(
 	c *HelperCommon,
+	dryRunHelper *DryRunHelper,
 ) *RefsHelper {
 	return &RefsHelper{
-		c: c,
+		c:            c,
+		dryRunHelper: dryRunHelper,
 	}
 }
 
@@ -179,6 +184,10 @@ func (self *RefsHelper) CreateGitResetMenu(ref string) error {
 				style.FgRed.Sprintf("reset --%s %s", row.strength, ref),
 			},
 			OnPress: func() error {
+				if row.strength == "hard" && self.dryRunHelper.IsEnabled() {
+					return self.showHardResetDryRun(ref)
+				}
+
 				self.c.LogAction("Reset")
 				return self.ResetToRef(ref, row.strength, []string{})
 			},
@@ -192,6 +201,13 @@ func (self *RefsHelper) CreateGitResetMenu(ref string) error {
 	})
 }
 
+func (self *RefsHelper) showHardResetDryRun(ref string) error {
+	return self.dryRunHelper.Show(DryRunPreview{
+		CmdObjs:  []oscommands.ICmdObj{self.c.Git().Commit.ResetToCommitCmdObj(ref, "hard", []string{})},
+		Affected: self.dryRunHelper.HardResetAffected(ref),
+	})
+}
+
 func (self *RefsHelper) NewBranch(from string, fromFormattedName string, suggestedBranchName string) error {
 	message := utils.ResolvePlaceholderString(
 		self.c.Tr.NewBranchNameBranchOff,
@@ -42,13 +42,19 @@ func NewRecentReposHelper(
 }
 
 func (self *ReposHelper) EnterSubmodule(submodule *models.SubmoduleConfig) error {
+	return self.EnterNestedRepo(submodule.Path)
+}
+
+// Switches to a repo within the current one, remembering where we came from so
+// that escaping out of it takes us back
+func (self *ReposHelper) EnterNestedRepo(path string) error {
 	wd, err := os.Getwd()
 	if err != nil {
 		return err
 	}
 	self.c.State().GetRepoPathStack().Push(wd)
 
-	return self.DispatchSwitchToRepo(submodule.Path, context.NO_CONTEXT)
+	return self.DispatchSwitchToRepo(path, context.NO_CONTEXT)
 }
 
 func (self *ReposHelper) getCurrentBranch(path string) string {
@@ -94,19 +100,24 @@ func (self *ReposHelper) getCurrentBranch(path string) string {
 }
 
 func (self *ReposHelper) CreateRecentReposMenu() error {
+	// if we're in a submodule, the repos we came from are listed first so that
+	// we can jump straight back to any of them
+	parentRepoPaths := self.c.State().GetRepoPathStack().Items()
+
 	// we'll show an empty panel if there are no recent repos
 	recentRepoPaths := []string{}
 	if len(self.c.GetAppState().RecentRepos) > 0 {
 		// we skip the first one because we're currently in it
-		recentRepoPaths = self.c.GetAppState().RecentRepos[1:]
+		recentRepoPaths = lo.Without(self.c.GetAppState().RecentRepos[1:], parentRepoPaths...)
 	}
 
 	currentBranches := sync.Map{}
 
 	wg := sync.WaitGroup{}
-	wg.Add(len(recentRepoPaths))
+	allPaths := append(append([]string{}, parentRepoPaths...), recentRepoPaths...)
+	wg.Add(len(allPaths))
 
-	for _, path := range recentRepoPaths {
+	for _, path := range allPaths {
 		go func(path string) {
 			defer wg.Done()
 			currentBranches.Store(path, self.getCurrentBranch(path))
@@ -115,18 +126,52 @@ func (self *ReposHelper) CreateRecentReposMenu() error {
 
 	wg.Wait()
 
-	menuItems := lo.Map(recentRepoPaths, func(path string, _ int) *types.MenuItem {
+	labelColumns := func(path string) []string {
 		branchName, _ := currentBranches.Load(path)
 		if icons.IsIconEnabled() {
 			branchName = icons.BRANCH_ICON + " " + fmt.Sprintf("%v", branchName)
 		}
 
+		return []string{
+			filepath.Base(path),
+			style.FgCyan.Sprint(branchName),
+			style.FgMagenta.Sprint(path),
+		}
+	}
+
+	menuItems := []*types.MenuItem{}
+
+	if len(parentRepoPaths) > 0 {
+		parentReposSection := &types.MenuSection{Title: self.c.Tr.ParentRepos, Column: 0}
+		recentReposSection := &types.MenuSection{Title: self.c.Tr.RecentRepos, Column: 0}
+
+		// closest parent first
+		for i := len(parentRepoPaths) - 1; i >= 0; i-- {
+			depth := i
+			menuItems = append(menuItems, &types.MenuItem{
+				LabelColumns: labelColumns(parentRepoPaths[depth]),
+				OnPress: func() error {
+					return self.ReturnToParentRepo(depth)
+				},
+				Section: parentReposSection,
+			})
+		}
+
+		for _, item := range self.recentRepoMenuItems(recentRepoPaths, labelColumns) {
+			item.Section = recentReposSection
+			menuItems = append(menuItems, item)
+		}
+	} else {
+		menuItems = self.recentRepoMenuItems(recentRepoPaths, labelColumns)
+	}
+
+	return self.c.Menu(types.CreateMenuOptions{Title: self.c.Tr.RecentRepos, Items: menuItems})
+}
+
+func (self *ReposHelper) recentRepoMenuItems(paths []string, labelColumns func(string) []string) []*types.MenuItem {
+	return lo.Map(paths, func(path string, _ int) *types.MenuItem {
 		return &types.MenuItem{
-			LabelColumns: []string{
-				filepath.Base(path),
-				style.FgCyan.Sprint(branchName),
-				style.FgMagenta.Sprint(path),
-			},
+			LabelColumns: labelColumns(path),
 			OnPress: func() error {
 				// if we were in a submodule, we want to forget about that stack of repos
 				// so that hitting escape in the new repo does nothing
@@ -135,8 +180,20 @@ func (self *ReposHelper) CreateRecentReposMenu() error {
 			},
 		}
 	})
+}
 
-	return self.c.Menu(types.CreateMenuOptions{Title: self.c.Tr.RecentRepos, Items: menuItems})
+// ReturnToParentRepo switches back to one of the repos we came from when
+// entering submodules, where a depth of 0 is the top-level repo. Everything
+// we entered after that repo is forgotten.
+func (self *ReposHelper) ReturnToParentRepo(depth int) error {
+	repoPathStack := self.c.State().GetRepoPathStack()
+
+	path := ""
+	for len(repoPathStack.Items()) > depth {
+		path = repoPathStack.Pop()
+	}
+
+	return self.DispatchSwitchToRepo(path, context.NO_CONTEXT)
 }
 
 func (self *ReposHelper) DispatchSwitchToRepo(path string, contextKey types.ContextKey) error {
@@ -18,6 +18,20 @@ import (
 
 type SearchHelper struct {
 	c *HelperCommon
+
+	// the search status that's currently displayed, so that we only re-render it
+	// when it changes
+	displayedSearchStatus searchStatus
+	// whether we're waiting for another layout pass so that the search status
+	// can catch up with a change to the searched view's content
+	searchStatusRefreshPending bool
+}
+
+type searchStatus struct {
+	contextKey types.ContextKey
+	prefix     string
+	index      int
+	total      int
 }
 
 func NewSearchHelper(
@@ -31,6 +45,8 @@ func NewSearchHelper(
 func (self *SearchHelper) OpenFilterPrompt(context types.IFilterableContext) error {
 	state := self.searchState()
 
+	state.PrevSearchIndex = -1
+
 	state.Context = context
 
 	self.searchPrefixView().SetContent(self.c.Tr.FilterPrefix)
@@ -83,9 +99,26 @@ func (self *SearchHelper) DisplaySearchStatus(context types.ISearchableContext)
 
 	state.Context = context
 
-	self.searchPrefixView().SetContent(self.c.Tr.SearchPrefix)
-	index, totalCount := context.GetView().GetSearchStatus()
-	context.RenderSearchStatus(index, totalCount)
+	status := self.searchStatus(context)
+	self.searchPrefixView().SetContent(status.prefix)
+	context.RenderSearchStatus(status.index, status.total)
+	self.displayedSearchStatus = status
+}
+
+func (self *SearchHelper) searchStatus(context types.ISearchableContext) searchStatus {
+	index, total := context.GetView().GetSearchStatus()
+	// the selected match can be past the end if matches have gone away since
+	// it was selected
+	if total > 0 && index >= total {
+		index = total - 1
+	}
+
+	return searchStatus{
+		contextKey: context.GetKey(),
+		prefix:     self.c.Tr.SearchPrefix,
+		index:      index,
+		total:      total,
+	}
 }
 
 func (self *SearchHelper) searchState() *types.SearchState {
@@ -262,6 +295,31 @@ func (self *SearchHelper) RenderSearchStatus(c types.Context) {
 	self.HidePrompt()
 }
 
+// RefreshSearchStatus re-renders the search status of the current context if it
+// has changed, so that the match count stays accurate when the searched content
+// changes
+func (self *SearchHelper) RefreshSearchStatus() {
+	searchableContext, ok := self.c.CurrentContext().(types.ISearchableContext)
+	if !ok || !searchableContext.IsSearching() {
+		return
+	}
+
+	if searchableContext.GetView().IsTainted() {
+		// gocui only finds the matches in a view when drawing it, which happens
+		// after layout, so we need another layout pass to show the new count
+		if !self.searchStatusRefreshPending {
+			self.searchStatusRefreshPending = true
+			self.c.OnUIThread(func() error { return nil })
+		}
+		return
+	}
+	self.searchStatusRefreshPending = false
+
+	if self.searchStatus(searchableContext) != self.displayedSearchStatus {
+		self.DisplaySearchStatus(searchableContext)
+	}
+}
+
 func (self *SearchHelper) CancelSearchIfSearching(c types.Context) {
 	if searchableContext, ok := c.(types.ISearchableContext); ok {
 		view := searchableContext.GetView()
@@ -284,6 +342,7 @@ func (self *SearchHelper) CancelSearchIfSearching(c types.Context) {
 
 func (self *SearchHelper) HidePrompt() {
 	self.setNonSearchingFrameColor()
+	self.displayedSearchStatus = searchStatus{}
 
 	state := self.searchState()
 	state.Context = nil
@@ -0,0 +1,263 @@
+package helpers
+
+import (
+	"os"
+
+	"github.com/jesseduffield/lazygit/pkg/config"
+	"github.com/jesseduffield/lazygit/pkg/gui/context"
+	"github.com/jesseduffield/lazygit/pkg/gui/types"
+	yaml "github.com/jesseduffield/yaml"
+)
+
+// When gui.persistSessionState is enabled, we store the UI state of a repo in
+// the app state when quitting or switching to another repo, and restore it the
+// next time the repo is opened in a fresh session.
+type SessionStateHelper struct {
+	c                   *HelperCommon
+	fileTreeStateHelper *FileTreeStateHelper
+}
+
+func NewSessionStateHelper(c *HelperCommon, fileTreeStateHelper *FileTreeStateHelper) *SessionStateHelper {
+	return &SessionStateHelper{
+		c:                   c,
+		fileTreeStateHelper: fileTreeStateHelper,
+	}
+}
+
+// the side contexts whose state we persist. Contexts like sub-commits or commit
+// files depend on what was selected in their parent, so we don't bother with
+// those.
+var sessionStateContextKeys = []types.ContextKey{
+	context.STATUS_CONTEXT_KEY,
+	context.FILES_CONTEXT_KEY,
+	context.WORKTREES_CONTEXT_KEY,
+	context.SUBMODULES_CONTEXT_KEY,
+	context.LOCAL_BRANCHES_CONTEXT_KEY,
+	context.REMOTES_CONTEXT_KEY,
+	context.TAGS_CONTEXT_KEY,
+	context.LOCAL_COMMITS_CONTEXT_KEY,
+	context.REFLOG_COMMITS_CONTEXT_KEY,
+	context.STASH_CONTEXT_KEY,
+}
+
+// Store puts the state of the current repo into the app state. It's up to the
+// caller to save the app state afterwards.
+func (self *SessionStateHelper) Store() {
+	if !self.c.UserConfig.Gui.PersistSessionState {
+		return
+	}
+
+	appState := self.c.GetAppState()
+	if appState.SessionStates == nil {
+		appState.SessionStates = map[string]*config.SessionState{}
+	}
+	appState.SessionStates[self.c.Git().RepoPaths.WorktreePath()] = self.currentState()
+}
+
+func (self *SessionStateHelper) currentState() *config.SessionState {
+	state := &config.SessionState{
+		SelectedItemIds:  map[string]string{},
+		SelectedLineIdxs: map[string]int{},
+		OriginYs:         map[string]int{},
+		Filters:          map[string]string{},
+		DiffRef:          self.c.Modes().Diffing.Ref,
+		DiffReverse:      self.c.Modes().Diffing.Reverse,
+	}
+
+	currentSideContext := self.c.CurrentSideContext()
+	for _, key := range sessionStateContextKeys {
+		ctx := self.c.ContextForKey(key)
+		if ctx == currentSideContext {
+			state.CurrentContext = string(key)
+		}
+
+		if listContext, ok := ctx.(types.IListContext); ok {
+			if id := listContext.GetSelectedItemId(); id != "" {
+				state.SelectedItemIds[string(key)] = id
+			}
+			state.SelectedLineIdxs[string(key)] = listContext.GetList().GetSelectedLineIdx()
+		}
+
+		if filterableContext, ok := ctx.(types.IFilterableContext); ok && filterableContext.IsFiltering() {
+			state.Filters[string(key)] = filterableContext.GetFilter()
+		}
+
+		if view := ctx.GetView(); view != nil {
+			state.OriginYs[string(key)] = view.OriginY()
+		}
+	}
+
+	return state
+}
+
+// Restore applies the stored state of the current repo. It needs to be called
+// once the models have been loaded, so that selected items can be found.
+func (self *SessionStateHelper) Restore() error {
+	state := self.c.GetAppState().SessionStates[self.c.Git().RepoPaths.WorktreePath()]
+	if state == nil {
+		return nil
+	}
+
+	return self.restore(state)
+}
+
+func (self *SessionStateHelper) restore(state *config.SessionState) error {
+	if state.DiffRef != "" {
+		self.c.Modes().Diffing.Ref = state.DiffRef
+		self.c.Modes().Diffing.Reverse = state.DiffReverse
+	}
+
+	for _, key := range sessionStateContextKeys {
+		ctx := self.c.ContextForKey(key)
+
+		if filter, ok := state.Filters[string(key)]; ok {
+			if filterableContext, ok := ctx.(types.IFilterableContext); ok {
+				filterableContext.SetFilter(filter)
+			}
+		}
+
+		if originY, ok := state.OriginYs[string(key)]; ok {
+			if view := ctx.GetView(); view != nil {
+				_ = view.SetOriginY(originY)
+			}
+		}
+
+		listContext, isListContext := ctx.(types.IListContext)
+		if isListContext {
+			idx, ok := findItemIndexById(listContext, state.SelectedItemIds[string(key)])
+			if !ok {
+				idx = state.SelectedLineIdxs[string(key)]
+			}
+			listContext.GetList().SetSelectedLineIdx(idx)
+		}
+
+		if err := self.c.PostRefreshUpdate(ctx); err != nil {
+			return err
+		}
+
+		if isListContext {
+			// the view isn't focused yet, but we still want its cursor on the
+			// selected line
+			listContext.FocusLine()
+		}
+	}
+
+	if state.CurrentContext != "" {
+		return self.c.PushContext(self.c.ContextForKey(types.ContextKey(state.CurrentContext)))
+	}
+
+	return nil
+}
+
+// Export writes the UI state of the current repo, along with the layout tweaks
+// from the app state, to the given file
+func (self *SessionStateHelper) Export(path string) error {
+	content, err := exportUIState(
+		self.c.GetAppState(), self.currentState(), self.fileTreeStateHelper.CurrentState(),
+	)
+	if err != nil {
+		return err
+	}
+
+	return self.c.OS().CreateFileWithContent(path, string(content))
+}
+
+// Import reads a file written by Export and applies it to the current repo
+func (self *SessionStateHelper) Import(path string) error {
+	content, err := os.ReadFile(path)
+	if err != nil {
+		return err
+	}
+
+	appState := self.c.GetAppState()
+	export, err := importUIState(content, appState)
+	if err != nil {
+		return err
+	}
+
+	if export.Session != nil && self.c.UserConfig.Gui.PersistSessionState {
+		if appState.SessionStates == nil {
+			appState.SessionStates = map[string]*config.SessionState{}
+		}
+		appState.SessionStates[self.c.Git().RepoPaths.WorktreePath()] = export.Session
+	}
+	if export.FileTree != nil {
+		if appState.FileTreeStates == nil {
+			appState.FileTreeStates = map[string]*config.FileTreeState{}
+		}
+		appState.FileTreeStates[self.c.Git().RepoPaths.WorktreePath()] = export.FileTree
+		self.fileTreeStateHelper.Apply(export.FileTree)
+	}
+	self.c.SaveAppStateAndLogError()
+
+	self.c.State().SetShowExtrasWindow(self.c.UserConfig.Gui.ShowCommandLog && !appState.HideCommandLog)
+
+	// the sort orders affect what gets loaded, so we need to refresh before we
+	// can find the selected items again
+	if err := self.c.Refresh(types.RefreshOptions{Mode: types.SYNC}); err != nil {
+		return err
+	}
+
+	if export.Session == nil {
+		return nil
+	}
+
+	return self.restore(export.Session)
+}
+
+func exportUIState(appState *config.AppState, session *config.SessionState, fileTree *config.FileTreeState) ([]byte, error) {
+	return yaml.Marshal(config.UIStateExport{
+		Session:                    session,
+		FileTree:                   fileTree,
+		HideCommandLog:             appState.HideCommandLog,
+		IgnoreWhitespaceInDiffView: appState.IgnoreWhitespaceInDiffView,
+		SplitDiffView:              appState.SplitDiffView,
+		DiffContextSize:            appState.DiffContextSize,
+		LocalBranchSortOrder:       appState.LocalBranchSortOrder,
+		RemoteBranchSortOrder:      appState.RemoteBranchSortOrder,
+	})
+}
+
+// importUIState applies the layout tweaks of an exported UI state to the
+// app state. Settings that are missing from the export (e.g. because it was
+// edited by hand) are left as they are.
+func importUIState(content []byte, appState *config.AppState) (*config.UIStateExport, error) {
+	var export config.UIStateExport
+	if err := yaml.Unmarshal(content, &export); err != nil {
+		return nil, err
+	}
+
+	appState.HideCommandLog = export.HideCommandLog
+	appState.IgnoreWhitespaceInDiffView = export.IgnoreWhitespaceInDiffView
+	appState.SplitDiffView = export.SplitDiffView
+	// the context size can't go below 1, so 0 means it's missing
+	if export.DiffContextSize > 0 {
+		appState.DiffContextSize = export.DiffContextSize
+	}
+	if export.LocalBranchSortOrder != "" {
+		appState.LocalBranchSortOrder = export.LocalBranchSortOrder
+	}
+	if export.RemoteBranchSortOrder != "" {
+		appState.RemoteBranchSortOrder = export.RemoteBranchSortOrder
+	}
+
+	return &export, nil
+}
+
+// not all list items implement HasUrn, so we find the item by moving the
+// selection until the selected item's ID matches
+func findItemIndexById(listContext types.IListContext, id string) (int, bool) {
+	if id == "" {
+		return 0, false
+	}
+
+	list := listContext.GetList()
+	for i := 0; i < list.Len(); i++ {
+		list.SetSelectedLineIdx(i)
+		if listContext.GetSelectedItemId() == id {
+			return i, true
+		}
+	}
+
+	return 0, false
+}
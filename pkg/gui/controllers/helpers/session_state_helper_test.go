@@ -0,0 +1,102 @@
+package helpers
+
+import (
+	"testing"
+
+	"github.com/jesseduffield/lazygit/pkg/config"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestUIStateRoundTrip(t *testing.T) {
+	session := &config.SessionState{
+		CurrentContext:   "localBranches",
+		SelectedItemIds:  map[string]string{"localBranches": "master"},
+		SelectedLineIdxs: map[string]int{"localBranches": 2},
+		OriginYs:         map[string]int{"commits": 10},
+		Filters:          map[string]string{"files": "pkg/"},
+		DiffRef:          "develop",
+		DiffReverse:      true,
+	}
+	fileTree := &config.FileTreeState{
+		ShowTree:          true,
+		GroupByChangeType: true,
+		CollapsedPaths:    []string{"pkg/gui"},
+	}
+	exported := &config.AppState{
+		HideCommandLog:             true,
+		IgnoreWhitespaceInDiffView: true,
+		SplitDiffView:              true,
+		DiffContextSize:            5,
+		LocalBranchSortOrder:       "alphabetical",
+		RemoteBranchSortOrder:      "date",
+	}
+
+	content, err := exportUIState(exported, session, fileTree)
+	assert.NoError(t, err)
+
+	imported := &config.AppState{DiffContextSize: 3}
+	export, err := importUIState(content, imported)
+	assert.NoError(t, err)
+
+	assert.Equal(t, session, export.Session)
+	assert.Equal(t, fileTree, export.FileTree)
+	assert.Equal(t, exported, imported)
+}
+
+func TestImportUIState(t *testing.T) {
+	scenarios := []struct {
+		testName         string
+		content          string
+		expectedAppState *config.AppState
+		expectedSession  *config.SessionState
+		expectedFileTree *config.FileTreeState
+	}{
+		{
+			testName: "missing settings keep their current value",
+			content:  "hidecommandlog: true\n",
+			expectedAppState: &config.AppState{
+				HideCommandLog:        true,
+				DiffContextSize:       3,
+				LocalBranchSortOrder:  "recency",
+				RemoteBranchSortOrder: "alphabetical",
+			},
+		},
+		{
+			testName: "a zero diff context size is treated as missing",
+			content:  "diffcontextsize: 0\nsplitdiffview: true\n",
+			expectedAppState: &config.AppState{
+				SplitDiffView:         true,
+				DiffContextSize:       3,
+				LocalBranchSortOrder:  "recency",
+				RemoteBranchSortOrder: "alphabetical",
+			},
+		},
+		{
+			testName: "repo state without a file tree",
+			content:  "session:\n  currentcontext: stash\n  diffref: main\n",
+			expectedAppState: &config.AppState{
+				DiffContextSize:       3,
+				LocalBranchSortOrder:  "recency",
+				RemoteBranchSortOrder: "alphabetical",
+			},
+			expectedSession: &config.SessionState{CurrentContext: "stash", DiffRef: "main"},
+		},
+	}
+
+	for _, s := range scenarios {
+		s := s
+		t.Run(s.testName, func(t *testing.T) {
+			appState := &config.AppState{
+				DiffContextSize:       3,
+				LocalBranchSortOrder:  "recency",
+				RemoteBranchSortOrder: "alphabetical",
+			}
+
+			export, err := importUIState([]byte(s.content), appState)
+			assert.NoError(t, err)
+			assert.Equal(t, s.expectedAppState, appState)
+			assert.Equal(t, s.expectedSession, export.Session)
+			assert.Equal(t, s.expectedFileTree, export.FileTree)
+		})
+	}
+}
@@ -1,21 +1,104 @@
 package helpers
 
 import (
+	"sort"
+	"strings"
+
+	"github.com/jesseduffield/generics/set"
 	"github.com/jesseduffield/lazygit/pkg/commands/models"
+	"github.com/jesseduffield/lazygit/pkg/commands/patch"
 	"github.com/jesseduffield/lazygit/pkg/gui/patch_exploring"
 	"github.com/jesseduffield/lazygit/pkg/gui/types"
+	"github.com/samber/lo"
 )
 
 type StagingHelper struct {
 	c *HelperCommon
+
+	// the untracked files that we marked as intent-to-add so that their lines
+	// can be staged individually
+	intentToAddPaths *set.Set[string]
 }
 
 func NewStagingHelper(
 	c *HelperCommon,
 ) *StagingHelper {
 	return &StagingHelper{
-		c: c,
+		c:                c,
+		intentToAddPaths: set.New[string](),
+	}
+}
+
+// PrepareFileForStaging marks an untracked file as intent-to-add, so that the
+// staging panel shows it as a diff whose lines can be staged individually
+func (self *StagingHelper) PrepareFileForStaging(file *models.File) error {
+	if file.Tracked || file.HasStagedChanges {
+		return nil
+	}
+
+	self.c.LogAction(self.c.Tr.Actions.IntentToAdd)
+	if err := self.c.Git().WorkingTree.IntentToAdd(file.Name); err != nil {
+		return err
 	}
+	self.intentToAddPaths.Add(file.Name)
+
+	return self.c.Refresh(types.RefreshOptions{Scope: []types.RefreshableView{types.FILES}})
+}
+
+// RevertUnusedIntentToAdd makes the files that we marked as intent-to-add
+// untracked again if none of their lines are staged, except for the file at
+// keepPath, which is still being staged.
+func (self *StagingHelper) RevertUnusedIntentToAdd(keepPath string) error {
+	reverted, err := self.revertUnusedIntentToAdd(keepPath)
+	if err != nil || !reverted {
+		return err
+	}
+
+	return self.c.Refresh(types.RefreshOptions{Scope: []types.RefreshableView{types.FILES}, Mode: types.ASYNC})
+}
+
+// RevertAllUnusedIntentToAdd is for when we're leaving the repo, by quitting
+// or switching to another one: whichever file is being staged, we don't want
+// to leave it intent-to-add in the index behind the user's back, and there's
+// no point in refreshing afterwards.
+func (self *StagingHelper) RevertAllUnusedIntentToAdd() error {
+	_, err := self.revertUnusedIntentToAdd("")
+	return err
+}
+
+func (self *StagingHelper) revertUnusedIntentToAdd(keepPath string) (bool, error) {
+	paths := self.intentToAddPaths.ToSlice()
+	sort.Strings(paths)
+
+	reverted := false
+	for _, path := range paths {
+		if path == keepPath {
+			continue
+		}
+
+		file, ok := lo.Find(self.c.Model().Files, func(file *models.File) bool { return file.Name == path })
+		// once the file is committed or made untracked otherwise, it's no
+		// longer our business. Note that unstaging all the lines of a file
+		// leaves it added with empty content ('AM') rather than intent-to-add
+		if !ok || (file.ShortStatus != " A" && !strings.HasPrefix(file.ShortStatus, "A")) {
+			self.intentToAddPaths.Remove(path)
+			continue
+		}
+
+		stagedDiff := self.c.Git().WorkingTree.WorktreeFileDiff(file, true, true)
+		if patch.Parse(stagedDiff).ContainsChanges() {
+			continue
+		}
+
+		self.c.LogAction(self.c.Tr.Actions.RevertIntentToAdd)
+		if err := self.c.Git().WorkingTree.UnStageFile([]string{path}, false); err != nil {
+			return reverted, err
+		}
+		self.intentToAddPaths.Remove(path)
+		reverted = true
+	}
+
+	return reverted, nil
 }
 
 // NOTE: used from outside this file
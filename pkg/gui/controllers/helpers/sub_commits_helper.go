@@ -29,9 +29,13 @@ func NewSubCommitsHelper(
 type ViewSubCommitsOpts struct {
 	Ref                     types.Ref
 	RefToShowDivergenceFrom string
-	TitleRef                string
-	Context                 types.Context
-	ShowBranchHeads         bool
+	// the headers of the divergence view's sections; 'Local' and 'Remote' if
+	// left empty
+	DivergenceSectionHeaderLocal  string
+	DivergenceSectionHeaderRemote string
+	TitleRef                      string
+	Context                       types.Context
+	ShowBranchHeads               bool
 }
 
 func (self *SubCommitsHelper) ViewSubCommits(opts ViewSubCommitsOpts) error {
@@ -59,6 +63,7 @@ func (self *SubCommitsHelper) ViewSubCommits(opts ViewSubCommitsOpts) error {
 	subCommitsContext.SetTitleRef(utils.TruncateWithEllipsis(opts.TitleRef, 50))
 	subCommitsContext.SetRef(opts.Ref)
 	subCommitsContext.SetRefToShowDivergenceFrom(opts.RefToShowDivergenceFrom)
+	subCommitsContext.SetDivergenceSectionHeaders(opts.DivergenceSectionHeaderLocal, opts.DivergenceSectionHeaderRemote)
 	subCommitsContext.SetLimitCommits(true)
 	subCommitsContext.SetShowBranchHeads(opts.ShowBranchHeads)
 	subCommitsContext.ClearSearchString()
@@ -3,6 +3,7 @@ package helpers
 import (
 	"fmt"
 	"os"
+	"strings"
 
 	"github.com/jesseduffield/gocui"
 	"github.com/jesseduffield/lazygit/pkg/commands/models"
@@ -175,14 +176,56 @@ func (self *SuggestionsHelper) GetRefsSuggestionsFunc() func(string) []*types.Su
 	return FuzzySearchFunc(refNames)
 }
 
-func (self *SuggestionsHelper) GetAuthorsSuggestionsFunc() func(string) []*types.Suggestion {
+// GetRefRangeSuggestionsFunc suggests refs like GetRefsSuggestionsFunc, except
+// that for a range like 'main..fea' it completes the ref after the dots
+func (self *SuggestionsHelper) GetRefRangeSuggestionsFunc() func(string) []*types.Suggestion {
+	refsSuggestionsFunc := self.GetRefsSuggestionsFunc()
+
+	return func(input string) []*types.Suggestion {
+		idx := strings.Index(input, "..")
+		if idx == -1 {
+			return refsSuggestionsFunc(input)
+		}
+
+		prefix := input[:idx+2]
+		rest := input[idx+2:]
+		if strings.HasPrefix(rest, ".") {
+			prefix += "."
+			rest = rest[1:]
+		}
+
+		return lo.Map(refsSuggestionsFunc(rest), func(suggestion *types.Suggestion, _ int) *types.Suggestion {
+			return &types.Suggestion{
+				Value: prefix + suggestion.Value,
+				Label: prefix + suggestion.Label,
+			}
+		})
+	}
+}
+
+func (self *SuggestionsHelper) getAuthorNames() []string {
 	authors := lo.Map(lo.Values(self.c.Model().Authors), func(author *models.Author, _ int) string {
 		return author.Combined()
 	})
 
 	slices.Sort(authors)
 
-	return FuzzySearchFunc(authors)
+	return authors
+}
+
+func (self *SuggestionsHelper) GetAuthorsSuggestionsFunc() func(string) []*types.Suggestion {
+	return FuzzySearchFunc(self.getAuthorNames())
+}
+
+// GetTrailerValueSuggestionsFunc suggests the given values, followed by the
+// repo's authors if includeAuthors is true
+func (self *SuggestionsHelper) GetTrailerValueSuggestionsFunc(values []string, includeAuthors bool) func(string) []*types.Suggestion {
+	suggestions := slices.Clone(values)
+	if includeAuthors {
+		suggestions = append(suggestions, self.getAuthorNames()...)
+	}
+
+	return FuzzySearchFunc(suggestions)
 }
 
 func FuzzySearchFunc(options []string) func(string) []*types.Suggestion {
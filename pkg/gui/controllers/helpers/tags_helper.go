@@ -2,26 +2,104 @@ package helpers
 
 import (
 	"github.com/jesseduffield/gocui"
+	"github.com/jesseduffield/lazygit/pkg/commands/models"
 	"github.com/jesseduffield/lazygit/pkg/gui/context"
 	"github.com/jesseduffield/lazygit/pkg/gui/types"
 	"github.com/jesseduffield/lazygit/pkg/utils"
+	"github.com/samber/lo"
 )
 
 type TagsHelper struct {
 	c             *HelperCommon
 	commitsHelper *CommitsHelper
+
+	// the results of verifying tag signatures, keyed by tag name
+	signatures          *utils.ThreadSafeMap[string, models.TagSignatureStatus]
+	verifyingSignatures *utils.ThreadSafeMap[string, bool]
 }
 
 func NewTagsHelper(c *HelperCommon, commitsHelper *CommitsHelper) *TagsHelper {
 	return &TagsHelper{
-		c:             c,
-		commitsHelper: commitsHelper,
+		c:                   c,
+		commitsHelper:       commitsHelper,
+		signatures:          utils.NewThreadSafeMap[string, models.TagSignatureStatus](),
+		verifyingSignatures: utils.NewThreadSafeMap[string, bool](),
+	}
+}
+
+// ApplySignatureStatuses fills in what we already know about the signatures
+// of the given tags, e.g. after the tags have been reloaded
+func (self *TagsHelper) ApplySignatureStatuses(tags []*models.Tag) {
+	for _, tag := range tags {
+		if status, ok := self.signatures.Get(tag.Name); ok {
+			tag.SignatureStatus = status
+		}
+	}
+}
+
+// LoadSignatureStatus works out the signature status of the selected tag.
+// Verifying a signature can be slow, so it's done in the background and the
+// tags are re-rendered once we're done. The result is remembered so that each
+// tag is only verified once.
+func (self *TagsHelper) LoadSignatureStatus(tag *models.Tag, annotation *models.TagAnnotation) {
+	if tag.SignatureStatus != models.TagSignatureUnknown {
+		return
+	}
+
+	if status, ok := self.signatures.Get(tag.Name); ok {
+		tag.SignatureStatus = status
+		return
+	}
+
+	if annotation == nil || annotation.Signature == "" {
+		tag.SignatureStatus = models.TagSignatureUnsigned
+		self.signatures.Set(tag.Name, tag.SignatureStatus)
+		return
+	}
+
+	tag.SignatureStatus = models.TagSignatureUnverified
+	if self.verifyingSignatures.Has(tag.Name) {
+		return
+	}
+	self.verifyingSignatures.Set(tag.Name, true)
+
+	self.c.OnWorker(func(gocui.Task) {
+		self.VerifySignature(tag.Name)
+		self.verifyingSignatures.Delete(tag.Name)
+
+		self.c.OnUIThread(func() error {
+			self.ApplySignatureStatuses(self.c.Model().Tags)
+			return self.c.PostRefreshUpdate(self.c.Contexts().Tags)
+		})
+	})
+}
+
+// VerifySignature verifies the signature of a signed tag and remembers the
+// result. It returns what gpg (or ssh) had to say about it.
+func (self *TagsHelper) VerifySignature(tagName string) (string, models.TagSignatureStatus) {
+	output, err := self.c.Git().Tag.VerifySignature(tagName)
+	status := models.TagSignatureGood
+	if err != nil {
+		status = models.TagSignatureBad
+		if output == "" {
+			output = err.Error()
+		}
 	}
+
+	self.signatures.Set(tagName, status)
+	return output, status
+}
+
+// ForgetSignature is called when a tag is deleted or replaced, so that we
+// don't show the old tag's signature status for a new tag of the same name
+func (self *TagsHelper) ForgetSignature(tagName string) {
+	self.signatures.Delete(tagName)
 }
 
 func (self *TagsHelper) OpenCreateTagPrompt(ref string, onCreate func()) error {
 	doCreateTag := func(tagName string, description string, force bool) error {
 		return self.c.WithWaitingStatus(self.c.Tr.CreatingTag, func(gocui.Task) error {
+			self.ForgetSignature(tagName)
 			if description != "" {
 				self.c.LogAction(self.c.Tr.Actions.CreateAnnotatedTag)
 				if err := self.c.Git().Tag.CreateAnnotated(tagName, ref, description, force); err != nil {
@@ -75,3 +153,78 @@ func (self *TagsHelper) OpenCreateTagPrompt(ref string, onCreate func()) error {
 		},
 	)
 }
+
+// OpenMoveTagPrompt asks which tag to move to the given commit, and then
+// whether to also force-push the moved tag to a remote
+func (self *TagsHelper) OpenMoveTagPrompt(commitSha string) error {
+	tagNames := lo.Map(self.c.Model().Tags, func(tag *models.Tag, _ int) string { return tag.Name })
+
+	return self.c.Prompt(types.PromptOpts{
+		Title:               self.c.Tr.MoveTagPrompt,
+		FindSuggestionsFunc: FuzzySearchFunc(tagNames),
+		HandleConfirm: func(tagName string) error {
+			if !self.c.Git().Tag.HasTag(tagName) {
+				return self.c.ErrorMsg(utils.ResolvePlaceholderString(self.c.Tr.TagDoesNotExist, map[string]string{"tagName": tagName}))
+			}
+
+			return self.c.Menu(types.CreateMenuOptions{
+				Title: utils.ResolvePlaceholderString(self.c.Tr.MoveTagTitle, map[string]string{"tagName": tagName}),
+				Items: []*types.MenuItem{
+					{
+						Label:   self.c.Tr.MoveTagLocally,
+						Tooltip: self.c.Tr.MoveTagLocallyTooltip,
+						OnPress: func() error {
+							return self.c.WithWaitingStatus(self.c.Tr.MovingTag, func(gocui.Task) error {
+								return self.moveTag(tagName, commitSha)
+							})
+						},
+						Key: 'l',
+					},
+					{
+						Label:     self.c.Tr.MoveTagAndForcePush,
+						OpensMenu: true,
+						OnPress: func() error {
+							return self.moveTagAndForcePush(tagName, commitSha)
+						},
+						Key: 'p',
+					},
+				},
+			})
+		},
+	})
+}
+
+func (self *TagsHelper) moveTagAndForcePush(tagName string, commitSha string) error {
+	return self.c.Prompt(types.PromptOpts{
+		Title:               utils.ResolvePlaceholderString(self.c.Tr.PushTagTitle, map[string]string{"tagName": tagName}),
+		InitialContent:      "origin",
+		FindSuggestionsFunc: FuzzySearchFunc(lo.Map(self.c.Model().Remotes, func(remote *models.Remote, _ int) string { return remote.Name })),
+		HandleConfirm: func(remoteName string) error {
+			return self.c.Confirm(types.ConfirmOpts{
+				Title: self.c.Tr.RewritePublishedTag,
+				Prompt: utils.ResolvePlaceholderString(self.c.Tr.RewritePublishedTagWarning, map[string]string{
+					"tagName": tagName,
+					"remote":  remoteName,
+				}),
+				HandleConfirm: func() error {
+					return self.c.WithWaitingStatus(self.c.Tr.MovingTag, func(task gocui.Task) error {
+						if err := self.moveTag(tagName, commitSha); err != nil {
+							return err
+						}
+
+						self.c.LogAction(self.c.Tr.Actions.ForcePushTag)
+						return self.c.Git().Tag.ForcePush(task, remoteName, tagName)
+					})
+				},
+			})
+		},
+	})
+}
+
+func (self *TagsHelper) moveTag(tagName string, commitSha string) error {
+	self.c.LogAction(self.c.Tr.Actions.MoveTag)
+	self.ForgetSignature(tagName)
+	err := self.c.Git().Tag.Move(tagName, commitSha)
+	_ = self.c.Refresh(types.RefreshOptions{Mode: types.ASYNC, Scope: []types.RefreshableView{types.COMMITS, types.TAGS}})
+	return err
+}
@@ -6,6 +6,7 @@ import (
 
 	"github.com/jesseduffield/lazygit/pkg/commands/models"
 	"github.com/jesseduffield/lazygit/pkg/gui/types"
+	"github.com/samber/lo"
 )
 
 type UpstreamHelper struct {
@@ -75,6 +76,12 @@ func getSuggestedRemote(remotes []*models.Remote) string {
 		return "origin"
 	}
 
+	// new branches are pushed to the default push remote, e.g. a fork, so
+	// that's where they should track
+	if remote, ok := lo.Find(remotes, func(remote *models.Remote) bool { return remote.IsPushDefault }); ok {
+		return remote.Name
+	}
+
 	for _, remote := range remotes {
 		if remote.Name == "origin" {
 			return remote.Name
@@ -16,6 +16,7 @@ func TestGetSuggestedRemote(t *testing.T) {
 		{mkRemoteList(), "origin"},
 		{mkRemoteList("upstream", "origin", "foo"), "origin"},
 		{mkRemoteList("upstream", "foo", "bar"), "upstream"},
+		{withPushDefault(mkRemoteList("origin", "fork"), "fork"), "fork"},
 	}
 
 	for _, c := range cases {
@@ -29,3 +30,10 @@ func mkRemoteList(names ...string) []*models.Remote {
 		return &models.Remote{Name: name}
 	})
 }
+
+func withPushDefault(remotes []*models.Remote, name string) []*models.Remote {
+	for _, remote := range remotes {
+		remote.IsPushDefault = remote.Name == name
+	}
+	return remotes
+}
@@ -9,6 +9,7 @@ import (
 	"github.com/jesseduffield/lazygit/pkg/gui/types"
 	"github.com/jesseduffield/lazygit/pkg/utils"
 	"github.com/mattn/go-runewidth"
+	"github.com/samber/lo"
 	"golang.org/x/exp/slices"
 )
 
@@ -63,6 +64,9 @@ type WindowArrangementArgs struct {
 	InformationStr string
 	// Whether to show the extras window which contains the command log context
 	ShowExtrasWindow bool
+	// The number of todos (done and remaining) of an ongoing interactive
+	// rebase, for sizing the rebase progress window. Zero hides the window.
+	RebaseTodoCount int
 	// Whether we are in a demo (which is used for generating demo gifs for the
 	// repo's readme)
 	InDemo bool
@@ -97,6 +101,7 @@ func (self *WindowArrangementHelper) GetWindowDimensions(informationStr string,
 		AppStatus:           appStatus,
 		InformationStr:      informationStr,
 		ShowExtrasWindow:    self.c.State().GetShowExtrasWindow(),
+		RebaseTodoCount:     self.rebaseTodoCount(),
 		InDemo:              self.c.InDemo(),
 		IsAnyModeActive:     self.modeHelper.IsAnyModeActive(),
 		InSearchPrompt:      repoState.InSearchPrompt(),
@@ -106,6 +111,15 @@ func (self *WindowArrangementHelper) GetWindowDimensions(informationStr string,
 	return GetWindowDimensions(args)
 }
 
+func (self *WindowArrangementHelper) rebaseTodoCount() int {
+	progress := self.c.Model().RebaseProgress
+	if !self.c.UserConfig.Gui.ShowRebaseProgress || progress == nil {
+		return 0
+	}
+
+	return progress.Total()
+}
+
 func shouldUsePortraitMode(args WindowArrangementArgs) bool {
 	if args.ScreenMode == types.SCREEN_HALF {
 		return args.UserConfig.Gui.EnlargedSideViewLocation == "top"
@@ -124,6 +138,11 @@ func shouldUsePortraitMode(args WindowArrangementArgs) bool {
 func GetWindowDimensions(args WindowArrangementArgs) map[string]boxlayout.Dimensions {
 	sideSectionWeight, mainSectionWeight := getMidSectionWeights(args)
 
+	sideSectionSize := 0
+	if showFileOutline(args) {
+		sideSectionSize = getFileOutlineWidth(args)
+	}
+
 	sidePanelsDirection := boxlayout.COLUMN
 	if shouldUsePortraitMode(args) {
 		sidePanelsDirection = boxlayout.ROW
@@ -148,7 +167,8 @@ func GetWindowDimensions(args WindowArrangementArgs) map[string]boxlayout.Dimens
 					{
 						Direction:           boxlayout.ROW,
 						Weight:              sideSectionWeight,
-						ConditionalChildren: sidePanelChildren(args),
+						Size:                sideSectionSize,
+						ConditionalChildren: withCommitsMinimap(args, sidePanelChildren(args)),
 					},
 					{
 						Direction: boxlayout.ROW,
@@ -184,6 +204,12 @@ func mainPanelChildren(args WindowArrangementArgs) []*boxlayout.Box {
 			Weight:    1,
 		},
 	}
+	if args.RebaseTodoCount > 0 {
+		result = append(result, &boxlayout.Box{
+			Window: "rebaseProgress",
+			Size:   getRebaseProgressWindowSize(args),
+		})
+	}
 	if args.ShowExtrasWindow {
 		result = append(result, &boxlayout.Box{
 			Window: "extras",
@@ -228,6 +254,21 @@ func mainSectionChildren(args WindowArrangementArgs) []*boxlayout.Box {
 	}
 }
 
+// When the staging or patch building view is maximized, we keep the list of
+// files visible in a slim column to its left so that the user can see where
+// they are and click to jump to another file
+func showFileOutline(args WindowArrangementArgs) bool {
+	return args.UserConfig.Gui.ShowFileOutline &&
+		args.CurrentWindow == "main" &&
+		args.ScreenMode != types.SCREEN_NORMAL &&
+		(args.CurrentSideWindow == "files" || args.CurrentSideWindow == "commits") &&
+		!shouldUsePortraitMode(args)
+}
+
+func getFileOutlineWidth(args WindowArrangementArgs) int {
+	return utils.Min(args.Width/5, 40)
+}
+
 func getMidSectionWeights(args WindowArrangementArgs) (int, int) {
 	// we originally specified this as a ratio i.e. .20 would correspond to a weight of 1 against 4
 	sidePanelWidthRatio := args.UserConfig.Gui.SidePanelWidth
@@ -397,6 +438,14 @@ func getExtrasWindowSize(args WindowArrangementArgs) int {
 	return baseSize + frameSize
 }
 
+// The rebase progress window grows with the number of todos, up to a limit
+// beyond which it scrolls to keep the current todo in view
+func getRebaseProgressWindowSize(args WindowArrangementArgs) int {
+	maxSize := utils.Max(args.Height/4, 3)
+	frameSize := 2
+	return utils.Min(args.RebaseTodoCount, maxSize) + frameSize
+}
+
 // The stash window by default only contains one line so that it's not hogging
 // too much space, but if you access it it should take up some space. This is
 // the default behaviour when accordion mode is NOT in effect. If it is in effect
@@ -413,6 +462,44 @@ func getDefaultStashWindowBox(args WindowArrangementArgs) *boxlayout.Box {
 	return box
 }
 
+func showCommitsMinimap(args WindowArrangementArgs) bool {
+	switch args.UserConfig.Gui.CommitsMinimap {
+	case "branch", "author":
+		return true
+	default:
+		return false
+	}
+}
+
+// The commits minimap is a slim column to the right of the commits window,
+// which takes up the same vertical space
+func withCommitsMinimap(
+	args WindowArrangementArgs, getChildren func(width int, height int) []*boxlayout.Box,
+) func(width int, height int) []*boxlayout.Box {
+	if !showCommitsMinimap(args) {
+		return getChildren
+	}
+
+	return func(width int, height int) []*boxlayout.Box {
+		return lo.Map(getChildren(width, height), func(box *boxlayout.Box, _ int) *boxlayout.Box {
+			if box.Window != "commits" {
+				return box
+			}
+
+			return &boxlayout.Box{
+				Direction: boxlayout.COLUMN,
+				Size:      box.Size,
+				Weight:    box.Weight,
+				Children: []*boxlayout.Box{
+					{Window: "commits", Weight: 1},
+					// one cell plus the frame
+					{Window: "commitsMinimap", Size: 3},
+				},
+			}
+		})
+	}
+}
+
 func sidePanelChildren(args WindowArrangementArgs) func(width int, height int) []*boxlayout.Box {
 	return func(width int, height int) []*boxlayout.Box {
 		if args.ScreenMode == types.SCREEN_FULL || args.ScreenMode == types.SCREEN_HALF {
@@ -185,6 +185,152 @@ func TestGetWindowDimensions(t *testing.T) {
 			B: information
 			`,
 		},
+		{
+			name: "full screen mode, main view focused, with file outline",
+			mutateArgs: func(args *WindowArrangementArgs) {
+				args.Height = 20 // smaller height because we don't more here
+				args.ScreenMode = types.SCREEN_FULL
+				args.CurrentWindow = "main"
+				args.UserConfig.Gui.ShowFileOutline = true
+			},
+			expected: `
+			╭status───────╮╭main──────────────────────────────────────────────────────╮
+			│             ││                                                          │
+			│             ││                                                          │
+			│             ││                                                          │
+			│             ││                                                          │
+			│             ││                                                          │
+			│             ││                                                          │
+			│             ││                                                          │
+			│             ││                                                          │
+			│             ││                                                          │
+			│             ││                                                          │
+			│             ││                                                          │
+			│             ││                                                          │
+			│             ││                                                          │
+			│             ││                                                          │
+			│             ││                                                          │
+			│             ││                                                          │
+			│             ││                                                          │
+			╰─────────────╯╰──────────────────────────────────────────────────────────╯
+			<options──────────────────────────────────────────────────────>A<B────────>
+			A: statusSpacer1
+			B: information
+			`,
+		},
+		{
+			name: "full screen mode, main view focused, without file outline",
+			mutateArgs: func(args *WindowArrangementArgs) {
+				args.Height = 20 // smaller height because we don't more here
+				args.ScreenMode = types.SCREEN_FULL
+				args.CurrentWindow = "main"
+			},
+			expected: `
+			╭main─────────────────────────────────────────────────────────────────────╮
+			│                                                                         │
+			│                                                                         │
+			│                                                                         │
+			│                                                                         │
+			│                                                                         │
+			│                                                                         │
+			│                                                                         │
+			│                                                                         │
+			│                                                                         │
+			│                                                                         │
+			│                                                                         │
+			│                                                                         │
+			│                                                                         │
+			│                                                                         │
+			│                                                                         │
+			│                                                                         │
+			│                                                                         │
+			╰─────────────────────────────────────────────────────────────────────────╯
+			<options──────────────────────────────────────────────────────>A<B────────>
+			A: statusSpacer1
+			B: information
+			`,
+		},
+		{
+			name: "commits minimap",
+			mutateArgs: func(args *WindowArrangementArgs) {
+				args.UserConfig.Gui.CommitsMinimap = "branch"
+			},
+			expected: `
+			╭status─────────────────╮╭main────────────────────────────────────────────╮
+			│                       ││                                                │
+			╰───────────────────────╯│                                                │
+			╭files──────────────────╮│                                                │
+			│                       ││                                                │
+			│                       ││                                                │
+			│                       ││                                                │
+			│                       ││                                                │
+			│                       ││                                                │
+			│                       ││                                                │
+			╰───────────────────────╯│                                                │
+			╭branches───────────────╮│                                                │
+			│                       ││                                                │
+			│                       ││                                                │
+			│                       ││                                                │
+			│                       ││                                                │
+			│                       ││                                                │
+			│                       ││                                                │
+			╰───────────────────────╯│                                                │
+			╭commits─────────────╮╭A╮│                                                │
+			│                    ││ ││                                                │
+			│                    ││ ││                                                │
+			│                    ││ ││                                                │
+			│                    ││ ││                                                │
+			│                    ││ ││                                                │
+			╰────────────────────╯╰─╯│                                                │
+			╭stash──────────────────╮│                                                │
+			│                       ││                                                │
+			╰───────────────────────╯╰────────────────────────────────────────────────╯
+			<options──────────────────────────────────────────────────────>B<C────────>
+			A: commitsMinimap
+			B: statusSpacer1
+			C: information
+			`,
+		},
+		{
+			name: "rebase progress",
+			mutateArgs: func(args *WindowArrangementArgs) {
+				args.RebaseTodoCount = 3
+			},
+			expected: `
+			╭status─────────────────╮╭main────────────────────────────────────────────╮
+			│                       ││                                                │
+			╰───────────────────────╯│                                                │
+			╭files──────────────────╮│                                                │
+			│                       ││                                                │
+			│                       ││                                                │
+			│                       ││                                                │
+			│                       ││                                                │
+			│                       ││                                                │
+			│                       ││                                                │
+			╰───────────────────────╯│                                                │
+			╭branches───────────────╮│                                                │
+			│                       ││                                                │
+			│                       ││                                                │
+			│                       ││                                                │
+			│                       ││                                                │
+			│                       ││                                                │
+			│                       ││                                                │
+			╰───────────────────────╯│                                                │
+			╭commits────────────────╮│                                                │
+			│                       ││                                                │
+			│                       ││                                                │
+			│                       ││                                                │
+			│                       │╰────────────────────────────────────────────────╯
+			│                       │╭rebaseProgress──────────────────────────────────╮
+			╰───────────────────────╯│                                                │
+			╭stash──────────────────╮│                                                │
+			│                       ││                                                │
+			╰───────────────────────╯╰────────────────────────────────────────────────╯
+			<options──────────────────────────────────────────────────────>A<B────────>
+			A: statusSpacer1
+			B: information
+			`,
+		},
 		{
 			name: "search mode",
 			mutateArgs: func(args *WindowArrangementArgs) {
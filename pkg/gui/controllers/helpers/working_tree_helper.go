@@ -60,6 +60,17 @@ func (self *WorkingTreeHelper) IsWorkingTreeDirty() bool {
 	return self.AnyStagedFiles() || self.AnyTrackedFiles()
 }
 
+// TrashFiles keeps copies of the files as they are now, before their changes
+// get discarded, so that the discard can be undone from the trash. Does
+// nothing unless the user enabled the trash
+func (self *WorkingTreeHelper) TrashFiles(paths []string) error {
+	if !self.c.UserConfig.Gui.Files.TrashDiscardedChanges || len(paths) == 0 {
+		return nil
+	}
+
+	return self.c.Git().WorkingTree.TrashFiles(paths)
+}
+
 func (self *WorkingTreeHelper) FileForSubmodule(submodule *models.SubmoduleConfig) *models.File {
 	for _, file := range self.c.Model().Files {
 		if file.IsSubmodule([]*models.SubmoduleConfig{submodule}) {
@@ -1,6 +1,7 @@
 package helpers
 
 import (
+	"fmt"
 	"strings"
 
 	"github.com/jesseduffield/gocui"
@@ -9,6 +10,7 @@ import (
 	"github.com/jesseduffield/lazygit/pkg/gui/context"
 	"github.com/jesseduffield/lazygit/pkg/gui/types"
 	"github.com/jesseduffield/lazygit/pkg/utils"
+	"github.com/samber/lo"
 )
 
 type IWorktreeHelper interface {
@@ -21,6 +23,24 @@ type WorktreeHelper struct {
 	reposHelper       *ReposHelper
 	refsHelper        *RefsHelper
 	suggestionsHelper *SuggestionsHelper
+
+	// keyed by worktree path
+	stats        *utils.ThreadSafeMap[string, *WorktreeStats]
+	loadingStats *utils.ThreadSafeMap[string, bool]
+}
+
+// WorktreeStats holds the details of a worktree that take too long to work out
+// to do it every time we load the worktrees, so we do it in the background
+type WorktreeStats struct {
+	IsDirty   bool
+	DiskUsage int64
+	// set if we couldn't work out the dirty state or the disk usage, in which
+	// case we show the error instead
+	IsDirtyErr   error
+	DiskUsageErr error
+	// true if the worktrees have been refreshed since we worked these out, in
+	// which case they're still worth showing while we work them out again
+	IsStale bool
 }
 
 func NewWorktreeHelper(c *HelperCommon, reposHelper *ReposHelper, refsHelper *RefsHelper, suggestionsHelper *SuggestionsHelper) *WorktreeHelper {
@@ -29,6 +49,8 @@ func NewWorktreeHelper(c *HelperCommon, reposHelper *ReposHelper, refsHelper *Re
 		reposHelper:       reposHelper,
 		refsHelper:        refsHelper,
 		suggestionsHelper: suggestionsHelper,
+		stats:             utils.NewThreadSafeMap[string, *WorktreeStats](),
+		loadingStats:      utils.NewThreadSafeMap[string, bool](),
 	}
 }
 
@@ -200,6 +222,119 @@ func (self *WorktreeHelper) Remove(worktree *models.Worktree, force bool) error
 	})
 }
 
+// Prune removes the given worktrees, whose directories have gone missing, from
+// git's list of worktrees
+func (self *WorktreeHelper) Prune(missingWorktrees []*models.Worktree) error {
+	names := lo.Map(missingWorktrees, func(worktree *models.Worktree, _ int) string {
+		return fmt.Sprintf("%s (%s)", worktree.Name, worktree.Path)
+	})
+
+	return self.c.Confirm(types.ConfirmOpts{
+		Title: self.c.Tr.PruneWorktrees,
+		Prompt: utils.ResolvePlaceholderString(
+			self.c.Tr.PruneWorktreesPrompt,
+			map[string]string{
+				"worktrees": strings.Join(names, "\n"),
+			},
+		),
+		HandleConfirm: func() error {
+			return self.c.WithWaitingStatus(self.c.Tr.PruningWorktrees, func(gocui.Task) error {
+				self.c.LogAction(self.c.Tr.Actions.PruneWorktrees)
+				if err := self.c.Git().Worktree.Prune(); err != nil {
+					return self.c.Error(err)
+				}
+				return self.c.Refresh(types.RefreshOptions{Mode: types.ASYNC, Scope: []types.RefreshableView{types.WORKTREES, types.BRANCHES}})
+			})
+		},
+	})
+}
+
+// Repair fixes the links between the repo and its worktrees. If the given
+// worktree's directory is missing, we ask where it has been moved to, because
+// git can't find it on its own.
+func (self *WorktreeHelper) Repair(worktree *models.Worktree) error {
+	repair := func(worktreePaths ...string) error {
+		return self.c.WithWaitingStatus(self.c.Tr.RepairingWorktree, func(gocui.Task) error {
+			self.c.LogAction(self.c.Tr.Actions.RepairWorktree)
+			if err := self.c.Git().Worktree.Repair(worktreePaths...); err != nil {
+				return self.c.Error(err)
+			}
+			return self.c.Refresh(types.RefreshOptions{Mode: types.ASYNC, Scope: []types.RefreshableView{types.WORKTREES, types.BRANCHES, types.FILES}})
+		})
+	}
+
+	if !worktree.IsPathMissing {
+		return repair()
+	}
+
+	return self.c.Prompt(types.PromptOpts{
+		Title: utils.ResolvePlaceholderString(
+			self.c.Tr.RepairWorktreeNewPath,
+			map[string]string{
+				"worktreeName": worktree.Name,
+			},
+		),
+		InitialContent: worktree.Path,
+		HandleConfirm: func(path string) error {
+			return repair(path)
+		},
+	})
+}
+
+// GetStats returns what we know about the worktree's dirty state and disk
+// usage. If that's nothing yet, or it's out of date, we work it out in the
+// background and re-render the worktree once we're done.
+func (self *WorktreeHelper) GetStats(worktree *models.Worktree) (*WorktreeStats, bool) {
+	stats, ok := self.stats.Get(worktree.Path)
+	if (ok && !stats.IsStale) || worktree.IsPathMissing || self.loadingStats.Has(worktree.Path) {
+		return stats, ok
+	}
+
+	path := worktree.Path
+	self.loadingStats.Set(path, true)
+	self.c.OnWorker(func(gocui.Task) {
+		defer self.loadingStats.Delete(path)
+
+		isDirty, isDirtyErr := self.c.Git().Worktree.IsDirty(path)
+		if isDirtyErr != nil {
+			self.c.Log.Warnf("Could not get status of worktree %s: %v", path, isDirtyErr)
+		}
+		diskUsage, diskUsageErr := self.c.Git().Worktree.DiskUsage(path)
+		if diskUsageErr != nil {
+			self.c.Log.Warnf("Could not get disk usage of worktree %s: %v", path, diskUsageErr)
+		}
+		self.stats.Set(path, &WorktreeStats{
+			IsDirty:      isDirty,
+			DiskUsage:    diskUsage,
+			IsDirtyErr:   isDirtyErr,
+			DiskUsageErr: diskUsageErr,
+		})
+
+		self.c.OnUIThread(func() error {
+			worktreesContext := self.c.Contexts().Worktrees
+			selected := worktreesContext.GetSelected()
+			if self.c.CurrentSideContext() != worktreesContext || selected == nil || selected.Path != path {
+				return nil
+			}
+			return worktreesContext.HandleRenderToMain()
+		})
+	})
+
+	return stats, ok
+}
+
+// InvalidateStats marks the stats of all worktrees as out of date, so that
+// they get worked out again the next time they're shown
+func (self *WorktreeHelper) InvalidateStats() {
+	for _, path := range self.stats.Keys() {
+		if stats, ok := self.stats.Get(path); ok {
+			staleStats := *stats
+			staleStats.IsStale = true
+			self.stats.Set(path, &staleStats)
+		}
+	}
+}
+
 func (self *WorktreeHelper) Detach(worktree *models.Worktree) error {
 	return self.c.WithWaitingStatus(self.c.Tr.DetachingWorktree, func(gocui.Task) error {
 		self.c.LogAction(self.c.Tr.RemovingWorktree)
@@ -82,6 +82,13 @@ func (self *ListController) handleLineChange(change int) error {
 	// doing this check so that if we're holding the up key at the start of the list
 	// we're not constantly re-rendering the main view.
 	if before != after {
+		if self.isSelectingRange() {
+			// the highlighting of the lines in the range is part of the content
+			if err := self.context.HandleRender(); err != nil {
+				return err
+			}
+		}
+
 		if change == -1 {
 			checkScrollUp(self.context.GetViewTrait(), self.c.UserConfig,
 				self.context.ModelIndexToViewIndex(before), self.context.ModelIndexToViewIndex(after))
@@ -112,6 +119,16 @@ func (self *ListController) HandleGotoBottom() error {
 	return self.handleLineChange(self.context.GetList().Len())
 }
 
+func (self *ListController) HandleToggleRangeSelect() error {
+	self.context.GetList().(types.IRangeSelect).ToggleRangeSelect()
+
+	if err := self.context.HandleRender(); err != nil {
+		return err
+	}
+
+	return self.context.HandleFocus(types.OnFocusOpts{})
+}
+
 func (self *ListController) HandleClick(opts gocui.ViewMouseBindingOpts) error {
 	prevSelectedLineIdx := self.context.GetList().GetSelectedLineIdx()
 	newSelectedLineIdx := self.context.ViewIndexToModelIndex(opts.Y)
@@ -126,6 +143,11 @@ func (self *ListController) HandleClick(opts gocui.ViewMouseBindingOpts) error {
 	}
 
 	self.context.GetList().SetSelectedLineIdx(newSelectedLineIdx)
+	if self.isSelectingRange() {
+		if err := self.context.HandleRender(); err != nil {
+			return err
+		}
+	}
 
 	if prevSelectedLineIdx == newSelectedLineIdx && alreadyFocused && self.context.GetOnClick() != nil {
 		return self.context.GetOnClick()()
@@ -133,6 +155,11 @@ func (self *ListController) HandleClick(opts gocui.ViewMouseBindingOpts) error {
 	return self.context.HandleFocus(types.OnFocusOpts{})
 }
 
+func (self *ListController) isSelectingRange() bool {
+	rangeSelect, ok := self.context.GetList().(types.IRangeSelect)
+	return ok && rangeSelect.IsSelectingRange()
+}
+
 func (self *ListController) pushContextIfNotFocused() error {
 	if !self.isFocused() {
 		if err := self.c.PushContext(self.context); err != nil {
@@ -148,7 +175,7 @@ func (self *ListController) isFocused() bool {
 }
 
 func (self *ListController) GetKeybindings(opts types.KeybindingsOpts) []*types.Binding {
-	return []*types.Binding{
+	bindings := []*types.Binding{
 		{Tag: "navigation", Key: opts.GetKey(opts.Config.Universal.PrevItemAlt), Handler: self.HandlePrevLine},
 		{Tag: "navigation", Key: opts.GetKey(opts.Config.Universal.PrevItem), Handler: self.HandlePrevLine},
 		{Tag: "navigation", Key: opts.GetKey(opts.Config.Universal.NextItemAlt), Handler: self.HandleNextLine},
@@ -160,6 +187,19 @@ func (self *ListController) GetKeybindings(opts types.KeybindingsOpts) []*types.
 		{Tag: "navigation", Key: opts.GetKey(opts.Config.Universal.ScrollRight), Handler: self.HandleScrollRight},
 		{Tag: "navigation", Key: opts.GetKey(opts.Config.Universal.GotoBottom), Handler: self.HandleGotoBottom, Description: self.c.Tr.GotoBottom},
 	}
+
+	// only lists whose actions can work on several items at once let you
+	// select a range
+	if _, ok := self.context.GetList().(types.IRangeSelect); ok {
+		bindings = append(bindings, &types.Binding{
+			Tag:         "navigation",
+			Key:         opts.GetKey(opts.Config.Universal.ToggleRangeSelect),
+			Handler:     self.HandleToggleRangeSelect,
+			Description: self.c.Tr.ToggleRangeSelect,
+		})
+	}
+
+	return bindings
 }
 
 func (self *ListController) GetMouseKeybindings(opts types.KeybindingsOpts) []*gocui.ViewMouseBinding {
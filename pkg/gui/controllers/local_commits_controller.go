@@ -2,15 +2,21 @@ package controllers
 
 import (
 	"fmt"
+	"regexp"
+	"strings"
 
 	"github.com/fsmiamoto/git-todo-parser/todo"
 	"github.com/go-errors/errors"
 	"github.com/jesseduffield/gocui"
+	"github.com/jesseduffield/lazygit/pkg/commands/git_commands"
 	"github.com/jesseduffield/lazygit/pkg/commands/models"
 	"github.com/jesseduffield/lazygit/pkg/commands/types/enums"
+	"github.com/jesseduffield/lazygit/pkg/config"
 	"github.com/jesseduffield/lazygit/pkg/gui/context"
 	"github.com/jesseduffield/lazygit/pkg/gui/controllers/helpers"
 	"github.com/jesseduffield/lazygit/pkg/gui/keybindings"
+	"github.com/jesseduffield/lazygit/pkg/gui/presentation"
+	"github.com/jesseduffield/lazygit/pkg/gui/presentation/links"
 	"github.com/jesseduffield/lazygit/pkg/gui/types"
 	"github.com/jesseduffield/lazygit/pkg/utils"
 	"github.com/samber/lo"
@@ -116,13 +122,13 @@ func (self *LocalCommitsController) GetKeybindings(opts types.KeybindingsOpts) [
 		{
 			Key:               opts.GetKey(opts.Config.Commits.MoveDownCommit),
 			Handler:           self.checkSelected(self.moveDown),
-			GetDisabledReason: self.disabledIfNoSelectedCommit(),
+			GetDisabledReason: self.callGetDisabledReasonFuncWithSelectedCommit(self.getDisabledReasonForMove),
 			Description:       self.c.Tr.MoveDownCommit,
 		},
 		{
 			Key:               opts.GetKey(opts.Config.Commits.MoveUpCommit),
 			Handler:           self.checkSelected(self.moveUp),
-			GetDisabledReason: self.disabledIfNoSelectedCommit(),
+			GetDisabledReason: self.callGetDisabledReasonFuncWithSelectedCommit(self.getDisabledReasonForMove),
 			Description:       self.c.Tr.MoveUpCommit,
 		},
 		{
@@ -178,12 +184,34 @@ func (self *LocalCommitsController) GetKeybindings(opts types.KeybindingsOpts) [
 			GetDisabledReason: self.disabledIfNoSelectedCommit(),
 			Description:       self.c.Tr.RevertCommit,
 		},
+		{
+			Key:               opts.GetKey(opts.Config.Commits.RevertCommitIntoWorkingTree),
+			Handler:           self.checkSelected(self.revertIntoWorkingTree),
+			GetDisabledReason: self.callGetDisabledReasonFuncWithSelectedCommit(self.getDisabledReasonForRevertIntoWorkingTree),
+			Description:       self.c.Tr.RevertIntoWorkingTree,
+			Tooltip:           self.c.Tr.RevertIntoWorkingTreeTooltip,
+		},
 		{
 			Key:               opts.GetKey(opts.Config.Commits.CreateTag),
 			Handler:           self.checkSelected(self.createTag),
 			GetDisabledReason: self.disabledIfNoSelectedCommit(),
 			Description:       self.c.Tr.TagCommit,
 		},
+		{
+			Key:               opts.GetKey(opts.Config.Commits.MoveTag),
+			Handler:           self.checkSelected(self.moveTag),
+			GetDisabledReason: self.disabledIfNoSelectedCommit(),
+			Description:       self.c.Tr.MoveTagToCommit,
+			Tooltip:           self.c.Tr.MoveTagToCommitTooltip,
+			OpensMenu:         true,
+		},
+		{
+			Key:               opts.GetKey(opts.Config.Commits.PeekCommit),
+			Handler:           self.checkSelected(self.peek),
+			GetDisabledReason: self.disabledIfNoSelectedCommit(),
+			Description:       self.c.Tr.PeekCommit,
+			Tooltip:           self.c.Tr.PeekCommitTooltip,
+		},
 		{
 			Key:         opts.GetKey(opts.Config.Commits.OpenLogMenu),
 			Handler:     self.handleOpenLogMenu,
@@ -195,11 +223,128 @@ func (self *LocalCommitsController) GetKeybindings(opts types.KeybindingsOpts) [
 	return bindings
 }
 
+func (self *LocalCommitsController) GetMouseKeybindings(opts types.KeybindingsOpts) []*gocui.ViewMouseBinding {
+	return []*gocui.ViewMouseBinding{
+		{
+			ViewName:    self.context().GetViewName(),
+			Key:         gocui.MouseLeft,
+			Handler:     self.onClick,
+			FocusedView: self.context().GetViewName(),
+		},
+		{
+			ViewName:    self.context().GetViewName(),
+			Key:         gocui.MouseLeft,
+			Modifier:    gocui.ModMotion,
+			Handler:     self.onDrag,
+			FocusedView: self.context().GetViewName(),
+		},
+		{
+			ViewName:    "main",
+			Key:         gocui.MouseLeft,
+			Handler:     self.onClickMain,
+			FocusedView: self.context().GetViewName(),
+		},
+	}
+}
+
+// onClick cycles the action of a rebase todo when its action is clicked, and
+// otherwise just selects the clicked line
+func (self *LocalCommitsController) onClick(opts gocui.ViewMouseBindingOpts) error {
+	index := self.context().ViewIndexToModelIndex(opts.Y)
+	commits := self.c.Model().Commits
+	if index < len(commits) && self.clickedOnTodoAction(commits[index], opts) {
+		self.context().SetSelectedLineIdx(index)
+		if err := self.context().HandleFocus(types.OnFocusOpts{}); err != nil {
+			return err
+		}
+
+		commit := commits[index]
+		action := nextTodoActionOnClick(commit.Action)
+		if reason := self.rebaseCommandEnabled(action, commit); reason != nil {
+			self.c.ErrorToast(reason.Text)
+			return nil
+		}
+
+		_, err := self.handleMidRebaseCommand(action, commit)
+		return err
+	}
+
+	return NewListControllerFactory(self.c).Create(self.context()).HandleClick(opts)
+}
+
+func (self *LocalCommitsController) clickedOnTodoAction(commit *models.Commit, opts gocui.ViewMouseBindingOpts) bool {
+	if !commit.IsTODO() || !isChangeOfRebaseTodoAllowed(commit.Action) {
+		return false
+	}
+
+	lines := self.context().GetView().BufferLines()
+	if opts.Y < 0 || opts.Y >= len(lines) {
+		return false
+	}
+
+	// the action is the first word on the line that isn't part of the sha, and
+	// since shas are hex the first match is always the action column
+	line := []rune(utils.Decolorise(lines[opts.Y]))
+	actionString := []rune(commit.Action.String())
+	for start := 0; start+len(actionString) <= len(line); start++ {
+		if string(line[start:start+len(actionString)]) == string(actionString) {
+			return opts.X >= start && opts.X < start+len(actionString)
+		}
+	}
+
+	return false
+}
+
+func nextTodoActionOnClick(action todo.TodoCommand) todo.TodoCommand {
+	switch action {
+	case todo.Pick:
+		return todo.Squash
+	case todo.Squash:
+		return todo.Fixup
+	case todo.Fixup:
+		return todo.Drop
+	default:
+		return todo.Pick
+	}
+}
+
+// onDrag moves the selected rebase todo towards the line under the mouse, one
+// step at a time so that each move goes through the same checks as the
+// keyboard bindings
+func (self *LocalCommitsController) onDrag(opts gocui.ViewMouseBindingOpts) error {
+	commit := self.context().GetSelected()
+	if commit == nil || !commit.IsTODO() || self.getDisabledReasonForMove(commit) != nil {
+		return nil
+	}
+
+	target := self.context().ViewIndexToModelIndex(opts.Y)
+	for {
+		index := self.context().GetSelectedLineIdx()
+		if index == target {
+			return nil
+		}
+
+		move := lo.Ternary(target > index, self.moveDown, self.moveUp)
+		if err := move(self.context().GetSelected()); err != nil {
+			return err
+		}
+
+		if self.context().GetSelectedLineIdx() == index {
+			// we've hit something the todo can't be moved past
+			return nil
+		}
+	}
+}
+
 func (self *LocalCommitsController) GetOnRenderToMain() func() error {
 	return func() error {
 		return self.c.Helpers().Diff.WithDiffModeCheck(func() error {
 			var task types.UpdateTask
 			commit := self.context().GetSelected()
+			if err := self.c.Helpers().CommitPeek.Update(commit); err != nil {
+				return err
+			}
+
 			if commit == nil {
 				task = types.NewRenderStringTask(self.c.Tr.NoCommitsThisBranch)
 			} else if commit.Action == todo.UpdateRef {
@@ -209,9 +354,14 @@ func (self *LocalCommitsController) GetOnRenderToMain() func() error {
 						map[string]string{
 							"ref": commit.Name,
 						}))
+			} else if commit.Action == todo.Label || commit.Action == todo.Reset || (commit.Action == todo.Merge && commit.Sha == "") {
+				task = types.NewRenderStringTask(self.mergeStructureTodoDescription(commit))
 			} else {
-				cmdObj := self.c.Git().Commit.ShowCmdObj(commit.Sha, self.c.Modes().Filtering.GetPath())
-				task = types.NewRunPtyTask(cmdObj.GetCmd())
+				cmdObj := self.c.Git().Commit.ShowPatchCmdObj(commit.Sha, self.c.Modes().Filtering.GetPath())
+				linker := self.c.Model().Linker
+				task = types.NewRunPtyTaskWithPrefixFunc(cmdObj.GetCmd(), func() string {
+					return self.renderCommitDetails(commit.Sha, linker)
+				})
 			}
 
 			return self.c.RenderToMainViews(types.RefreshMainOpts{
@@ -227,6 +377,51 @@ func (self *LocalCommitsController) GetOnRenderToMain() func() error {
 	}
 }
 
+// we render the message ourselves rather than leaving it to git, so that its
+// links are underlined and its body is formatted as markdown. This is called
+// from the main view's task, so it doesn't hold up the UI thread.
+func (self *LocalCommitsController) renderCommitDetails(sha string, linker *links.Linker) string {
+	details, err := self.c.Git().Commit.GetCommitDetails(sha)
+	if err != nil {
+		self.c.Log.Error(err)
+		return ""
+	}
+
+	return presentation.RenderCommitDetails(details, linker)
+}
+
+// matches the diffstat that follows the commit message, e.g.
+// ' pkg/foo.go | 3 ++-'
+var diffStatLineRegex = regexp.MustCompile(`^ \S.* \| +(\d+|Bin)`)
+
+// onClickMain opens the link that was clicked in the commit message in the main
+// view. Links in the patch below the message are ignored, because what looks
+// like an issue reference in code (e.g. a colour like '#123456') usually isn't.
+func (self *LocalCommitsController) onClickMain(opts gocui.ViewMouseBindingOpts) error {
+	lines := self.c.Views().Main.ViewBufferLines()
+	if opts.Y < 0 || opts.Y >= len(lines) {
+		return nil
+	}
+
+	for _, line := range lines[:opts.Y+1] {
+		if strings.HasPrefix(line, "diff ") || diffStatLineRegex.MatchString(line) {
+			return nil
+		}
+	}
+
+	runes := []rune(lines[opts.Y])
+	if opts.X < 0 || opts.X >= len(runes) {
+		return nil
+	}
+
+	link, found := self.c.Model().Linker.FindAt(string(runes), len(string(runes[:opts.X])))
+	if !found {
+		return nil
+	}
+
+	return (&OpenLinkAction{c: self.c}).open(link.Url)
+}
+
 func secondaryPatchPanelUpdateOpts(c *ControllerCommon) *types.ViewUpdateOpts {
 	if c.Git().Patch.PatchBuilder.Active() {
 		patch := c.Git().Patch.PatchBuilder.RenderAggregatedPatch(false)
@@ -409,9 +604,11 @@ func (self *LocalCommitsController) drop(commit *models.Commit) error {
 		return nil
 	}
 
-	return self.c.Confirm(types.ConfirmOpts{
-		Title:  self.c.Tr.DeleteCommitTitle,
-		Prompt: self.c.Tr.DeleteCommitPrompt,
+	return self.c.Helpers().ActionConfirmation.Confirm(helpers.ConfirmActionOpts{
+		Action:           config.ConfirmDropCommit,
+		Title:            self.c.Tr.DeleteCommitTitle,
+		Prompt:           self.c.Tr.DeleteCommitPrompt,
+		ConfirmationText: commit.ShortSha(),
 		HandleConfirm: func() error {
 			return self.c.WithWaitingStatus(self.c.Tr.DeletingStatus, func(gocui.Task) error {
 				self.c.LogAction(self.c.Tr.Actions.DropCommit)
@@ -430,7 +627,7 @@ func (self *LocalCommitsController) edit(commit *models.Commit) error {
 		return nil
 	}
 
-	return self.startInteractiveRebaseWithEdit(commit, commit)
+	return self.startInteractiveRebaseWithEdit(commit, commit, false)
 }
 
 func (self *LocalCommitsController) quickStartInteractiveRebase(selectedCommit *models.Commit) error {
@@ -439,16 +636,17 @@ func (self *LocalCommitsController) quickStartInteractiveRebase(selectedCommit *
 		return self.c.Error(err)
 	}
 
-	return self.startInteractiveRebaseWithEdit(commitToEdit, selectedCommit)
+	return self.startInteractiveRebaseWithEdit(commitToEdit, selectedCommit, self.c.GetAppState().AutosquashInteractiveRebase)
 }
 
 func (self *LocalCommitsController) startInteractiveRebaseWithEdit(
 	commitToEdit *models.Commit,
 	selectedCommit *models.Commit,
+	autosquash bool,
 ) error {
 	return self.c.WithWaitingStatus(self.c.Tr.RebasingStatus, func(gocui.Task) error {
 		self.c.LogAction(self.c.Tr.Actions.EditCommit)
-		err := self.c.Git().Rebase.EditRebase(commitToEdit.Sha)
+		err := self.c.Git().Rebase.EditRebase(commitToEdit.Sha, autosquash)
 		return self.c.Helpers().MergeAndRebase.CheckMergeOrRebaseWithRefreshOptions(
 			err,
 			types.RefreshOptions{Mode: types.BLOCK_UI, Then: func() {
@@ -545,6 +743,10 @@ func (self *LocalCommitsController) rebaseCommandEnabled(action todo.TodoCommand
 		return nil
 	}
 
+	if isMergeStructureTodo(commit.Action) {
+		return &types.DisabledReason{Text: self.c.Tr.ChangingThisActionIsNotAllowed}
+	}
+
 	// for now we do not support setting 'reword' because it requires an editor
 	// and that means we either unconditionally wait around for the subprocess to ask for
 	// our input or we set a lazygit client as the EDITOR env variable and have it
@@ -610,6 +812,17 @@ func (self *LocalCommitsController) moveDown(commit *models.Commit) error {
 	})
 }
 
+func (self *LocalCommitsController) getDisabledReasonForMove(commit *models.Commit) *types.DisabledReason {
+	// these are found in the todo file by their label rather than by sha, so
+	// we can't reliably tell which one to move; and moving them would change
+	// the shape of the rebuilt history anyway
+	if isMergeStructureTodo(commit.Action) {
+		return &types.DisabledReason{Text: self.c.Tr.CannotMoveMergeStructureTodo}
+	}
+
+	return nil
+}
+
 func (self *LocalCommitsController) moveUp(commit *models.Commit) error {
 	index := self.context().GetSelectedLineIdx()
 	if index == 0 {
@@ -770,6 +983,10 @@ func (self *LocalCommitsController) addCoAuthor() error {
 }
 
 func (self *LocalCommitsController) revert(commit *models.Commit) error {
+	if commits := self.selectedCommitRange(); len(commits) > 1 {
+		return self.revertRange(commits)
+	}
+
 	if commit.IsMerge() {
 		return self.createRevertMergeCommitMenu(commit)
 	} else {
@@ -820,6 +1037,134 @@ func (self *LocalCommitsController) createRevertMergeCommitMenu(commit *models.C
 	return self.c.Menu(types.CreateMenuOptions{Title: self.c.Tr.SelectParentCommitForMerge, Items: menuItems})
 }
 
+// selectedCommitRange returns the commits in the selected range, newest first
+func (self *LocalCommitsController) selectedCommitRange() []*models.Commit {
+	startIdx, endIdx := self.context().GetSelectionRange()
+	commits := self.c.Model().Commits
+	if startIdx < 0 || endIdx >= len(commits) {
+		return nil
+	}
+
+	return commits[startIdx : endIdx+1]
+}
+
+// revertRange reverts each of the given commits, starting with the newest so
+// that later changes are undone before the ones they build on
+func (self *LocalCommitsController) revertRange(commits []*models.Commit) error {
+	for _, commit := range commits {
+		if commit.IsTODO() {
+			return self.c.ErrorMsg(self.c.Tr.CantRevertTodoCommits)
+		}
+		if commit.IsMerge() {
+			return self.c.ErrorMsg(self.c.Tr.CantRevertRangeWithMergeCommits)
+		}
+	}
+
+	shas := lo.Map(commits, func(commit *models.Commit, _ int) string { return commit.Sha })
+
+	revert := func(squash bool) error {
+		self.c.LogAction(self.c.Tr.Actions.RevertCommits)
+		return self.c.WithWaitingStatusSync(self.c.Tr.RevertingStatus, func() error {
+			self.context().CancelRangeSelect()
+			err := self.c.Git().Commit.RevertCommits(shas, squash)
+			if err == nil && squash {
+				summary, description := revertCommitsMessage(commits)
+				err = self.c.Git().Commit.CommitCmdObj(summary, description).Run()
+			}
+			selectedCommit := self.context().GetSelected()
+			return self.c.Helpers().MergeAndRebase.CheckMergeOrRebaseWithRefreshOptions(
+				err,
+				types.RefreshOptions{Mode: types.SYNC, Then: func() {
+					// keep the same commit selected now that the reverts are on top of it
+					_, index, ok := lo.FindIndexOf(self.c.Model().Commits, func(c *models.Commit) bool {
+						return c.Sha == selectedCommit.Sha
+					})
+					if ok {
+						self.context().SetSelectedLineIdx(index)
+					}
+				}})
+		})
+	}
+
+	return self.c.Menu(types.CreateMenuOptions{
+		Title: utils.ResolvePlaceholderString(
+			self.c.Tr.RevertCommitsTitle,
+			map[string]string{
+				"count": fmt.Sprintf("%d", len(commits)),
+			}),
+		Items: []*types.MenuItem{
+			{
+				Label: self.c.Tr.RevertCommitsSeparately,
+				OnPress: func() error {
+					return revert(false)
+				},
+				Key: 's',
+			},
+			{
+				Label: self.c.Tr.RevertCommitsAsOne,
+				OnPress: func() error {
+					return revert(true)
+				},
+				Key: 'o',
+			},
+		},
+	})
+}
+
+// revertCommitsMessage returns the message of a single commit that reverts all
+// the given commits, in the style of the message git uses for a single revert
+func revertCommitsMessage(commits []*models.Commit) (string, string) {
+	summary := fmt.Sprintf("Revert %d commits", len(commits))
+	description := strings.Join(
+		lo.Map(commits, func(commit *models.Commit, _ int) string {
+			return fmt.Sprintf("This reverts commit %s (\"%s\").", commit.Sha, commit.Name)
+		}),
+		"\n",
+	)
+
+	return summary, description
+}
+
+func (self *LocalCommitsController) revertIntoWorkingTree(commit *models.Commit) error {
+	self.c.LogAction(self.c.Tr.Actions.RevertCommitIntoWorkingTree)
+	return self.c.WithWaitingStatusSync(self.c.Tr.RevertingStatus, func() error {
+		patch, err := self.c.Git().Commit.GetCommitPatch(commit.Sha)
+		if err != nil {
+			return err
+		}
+		if strings.TrimSpace(patch) == "" {
+			return errors.New(self.c.Tr.CommitHasNoChangesToRevert)
+		}
+
+		// We only touch the working tree (not the index), so that the user can
+		// pick and adjust the changes before committing them
+		if err := self.c.Git().Patch.ApplyPatch(patch, git_commands.ApplyPatchOpts{Reverse: true}); err != nil {
+			_ = self.c.Refresh(types.RefreshOptions{Mode: types.ASYNC, Scope: []types.RefreshableView{types.FILES}})
+			return err
+		}
+
+		if err := self.c.Refresh(types.RefreshOptions{
+			Mode: types.SYNC, Scope: []types.RefreshableView{types.FILES},
+		}); err != nil {
+			return err
+		}
+
+		return self.c.PushContext(self.c.Contexts().Files)
+	})
+}
+
+func (self *LocalCommitsController) getDisabledReasonForRevertIntoWorkingTree(commit *models.Commit) *types.DisabledReason {
+	if commit.IsTODO() {
+		return &types.DisabledReason{Text: self.c.Tr.CantRevertTodoCommits}
+	}
+
+	if commit.IsMerge() {
+		return &types.DisabledReason{Text: self.c.Tr.CantRevertMergeIntoWorkingTree}
+	}
+
+	return nil
+}
+
 func (self *LocalCommitsController) afterRevertCommit() error {
 	self.context().MoveSelectedLine(1)
 	return self.c.Refresh(types.RefreshOptions{
@@ -900,6 +1245,10 @@ func (self *LocalCommitsController) createTag(commit *models.Commit) error {
 	return self.c.Helpers().Tags.OpenCreateTagPrompt(commit.Sha, func() {})
 }
 
+func (self *LocalCommitsController) moveTag(commit *models.Commit) error {
+	return self.c.Helpers().Tags.OpenMoveTagPrompt(commit.Sha)
+}
+
 func (self *LocalCommitsController) openSearch() error {
 	// we usually lazyload these commits but now that we're searching we need to load them now
 	if self.context().GetLimitCommits() {
@@ -1012,6 +1361,35 @@ func (self *LocalCommitsController) handleOpenLogMenu() error {
 					})
 				},
 			},
+			{
+				Label:   self.c.Tr.ViewCommitsOfRange,
+				Tooltip: self.c.Tr.ViewCommitsOfRangeTooltip,
+				OnPress: self.viewCommitsOfRange,
+			},
+		},
+	})
+}
+
+func (self *LocalCommitsController) viewCommitsOfRange() error {
+	return self.c.Prompt(types.PromptOpts{
+		Title:               self.c.Tr.EnterRevisionRange,
+		FindSuggestionsFunc: self.c.Helpers().Suggestions.GetRefRangeSuggestionsFunc(),
+		HandleConfirm: func(response string) error {
+			spec := strings.TrimSpace(response)
+			if spec == "" {
+				return nil
+			}
+
+			if err := self.c.Git().Commit.VerifyRevisionRange(spec); err != nil {
+				return self.c.Error(err)
+			}
+
+			return self.c.Helpers().SubCommits.ViewSubCommits(helpers.ViewSubCommitsOpts{
+				Ref:             &models.RevisionRange{Spec: spec},
+				TitleRef:        spec,
+				Context:         self.context(),
+				ShowBranchHeads: true,
+			})
 		},
 	})
 }
@@ -1065,6 +1443,21 @@ func (self *LocalCommitsController) GetOnFocus() func(types.OnFocusOpts) error {
 	}
 }
 
+func (self *LocalCommitsController) GetOnFocusLost() func(types.OnFocusLostOpts) error {
+	return func(types.OnFocusLostOpts) error {
+		self.c.Helpers().CommitPeek.Hide()
+		return nil
+	}
+}
+
+func (self *LocalCommitsController) peek(commit *models.Commit) error {
+	if commit.Sha == "" {
+		return nil
+	}
+
+	return self.c.Helpers().CommitPeek.Toggle(commit)
+}
+
 func (self *LocalCommitsController) Context() types.Context {
 	return self.context()
 }
@@ -1112,6 +1505,24 @@ func (self *LocalCommitsController) require(callbacks ...func() *types.DisabledR
 	}
 }
 
+// label, reset and merge todos come from --rebase-merges, and describe how
+// the merges of the rebased branch are rebuilt
+func isMergeStructureTodo(action todo.TodoCommand) bool {
+	return action == todo.Label || action == todo.Reset || action == todo.Merge
+}
+
+func (self *LocalCommitsController) mergeStructureTodoDescription(commit *models.Commit) string {
+	template := map[todo.TodoCommand]string{
+		todo.Label: self.c.Tr.RebaseTodoLabelHere,
+		todo.Reset: self.c.Tr.RebaseTodoResetTo,
+		todo.Merge: self.c.Tr.RebaseTodoMerge,
+	}[commit.Action]
+
+	return utils.ResolvePlaceholderString(template, map[string]string{
+		"label": commit.Name,
+	})
+}
+
 func isChangeOfRebaseTodoAllowed(action todo.TodoCommand) bool {
 	allowedActions := []todo.TodoCommand{
 		todo.Pick,
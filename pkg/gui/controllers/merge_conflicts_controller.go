@@ -7,6 +7,7 @@ import (
 	"github.com/jesseduffield/lazygit/pkg/gui/context"
 	"github.com/jesseduffield/lazygit/pkg/gui/mergeconflicts"
 	"github.com/jesseduffield/lazygit/pkg/gui/types"
+	"github.com/samber/lo"
 )
 
 type MergeConflictsController struct {
@@ -112,6 +113,12 @@ func (self *MergeConflictsController) GetKeybindings(opts types.KeybindingsOpts)
 			Description: self.c.Tr.PickAllHunks,
 			Display:     true,
 		},
+		{
+			Key:         opts.GetKey(opts.Config.Main.ToggleConflictStyle),
+			Handler:     self.withRenderAndFocus(self.HandleToggleConflictStyle),
+			Description: self.c.Tr.ToggleConflictStyle,
+			Tooltip:     self.c.Tr.ToggleConflictStyleTooltip,
+		},
 		{
 			Key:         opts.GetKey(opts.Config.Universal.Return),
 			Handler:     self.Escape,
@@ -220,7 +227,7 @@ func (self *MergeConflictsController) HandleUndo() error {
 		return err
 	}
 
-	return nil
+	return self.refreshConflictCount()
 }
 
 func (self *MergeConflictsController) PrevConflictHunk() error {
@@ -259,6 +266,67 @@ func (self *MergeConflictsController) HandlePickAllHunks() error {
 	return self.pickSelection(mergeconflicts.ALL)
 }
 
+func (self *MergeConflictsController) HandleToggleConflictStyle() error {
+	// recreating the markers brings back the conflicts that were resolved
+	if !self.context().GetState().HasResolvedConflicts() {
+		return self.toggleConflictStyle()
+	}
+
+	return self.c.Confirm(types.ConfirmOpts{
+		Title:         self.c.Tr.ToggleConflictStyle,
+		Prompt:        self.c.Tr.ToggleConflictStyleResolvedPrompt,
+		HandleConfirm: self.withRenderAndFocus(self.toggleConflictStyle),
+	})
+}
+
+func (self *MergeConflictsController) toggleConflictStyle() error {
+	self.context().SetUserScrolling(false)
+
+	state := self.context().GetState()
+	style := self.nextConflictStyle()
+
+	self.c.LogAction(self.c.Tr.Actions.RecreateConflictMarkers)
+	if err := self.c.Git().WorkingTree.RecreateConflictMarkers(state.GetPath(), style); err != nil {
+		return self.c.Error(err)
+	}
+
+	// remembering the style so that it's also used for conflicts that come up
+	// later on
+	self.c.Git().WorkingTree.SetConflictStyle(style)
+
+	content, err := self.c.Git().File.Cat(state.GetPath())
+	if err != nil {
+		return self.c.Error(err)
+	}
+
+	// pushing rather than setting the content so that the change can be undone
+	state.PushContent(content)
+
+	// a refresh of the files panel may have queued up a render of the old
+	// content, so we queue one of the new content after it
+	return self.c.Helpers().MergeConflicts.Render(true)
+}
+
+func (self *MergeConflictsController) nextConflictStyle() string {
+	current := self.c.Git().WorkingTree.ConflictStyle()
+	if current == "" {
+		// we don't know what git's own setting is, so we go by what the file shows
+		current = lo.Ternary(self.context().GetState().ShowsBase(), "diff3", "merge")
+	}
+
+	switch current {
+	case "merge":
+		return "diff3"
+	case "diff3":
+		if self.c.Git().Version.IsAtLeast(2, 35, 0) {
+			return "zdiff3"
+		}
+		return "merge"
+	default:
+		return "merge"
+	}
+}
+
 func (self *MergeConflictsController) pickSelection(selection mergeconflicts.Selection) error {
 	ok, err := self.resolveConflict(selection)
 	if err != nil {
@@ -273,7 +341,7 @@ func (self *MergeConflictsController) pickSelection(selection mergeconflicts.Sel
 		return self.onLastConflictResolved()
 	}
 
-	return nil
+	return self.refreshConflictCount()
 }
 
 func (self *MergeConflictsController) resolveConflict(selection mergeconflicts.Selection) (bool, error) {
@@ -295,7 +363,7 @@ func (self *MergeConflictsController) resolveConflict(selection mergeconflicts.S
 	case mergeconflicts.TOP:
 		logStr = "Picking top hunk"
 	case mergeconflicts.MIDDLE:
-		logStr = "Picking middle hunk"
+		logStr = "Picking base hunk"
 	case mergeconflicts.BOTTOM:
 		logStr = "Picking bottom hunk"
 	case mergeconflicts.ALL:
@@ -313,6 +381,11 @@ func (self *MergeConflictsController) onLastConflictResolved() error {
 	return self.c.Refresh(types.RefreshOptions{Mode: types.ASYNC, Scope: []types.RefreshableView{types.FILES}})
 }
 
+// the files panel shows how many conflicts are left in each file
+func (self *MergeConflictsController) refreshConflictCount() error {
+	return self.c.Refresh(types.RefreshOptions{Mode: types.ASYNC, Scope: []types.RefreshableView{types.FILES}})
+}
+
 func (self *MergeConflictsController) isFocused() bool {
 	return self.c.CurrentContext().GetKey() == self.context().GetKey()
 }
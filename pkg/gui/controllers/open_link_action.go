@@ -0,0 +1,52 @@
+package controllers
+
+import (
+	"github.com/jesseduffield/lazygit/pkg/gui/presentation/links"
+	"github.com/jesseduffield/lazygit/pkg/gui/style"
+	"github.com/jesseduffield/lazygit/pkg/gui/types"
+	"github.com/samber/lo"
+)
+
+// OpenLinkAction opens a URL or issue reference from a commit message or branch
+// name in the browser, letting the user pick one if there are several
+type OpenLinkAction struct {
+	c *ControllerCommon
+}
+
+func (self *OpenLinkAction) Call(text string) error {
+	foundLinks := lo.UniqBy(self.c.Model().Linker.Find(text), func(link links.Link) string {
+		return link.Url
+	})
+
+	switch len(foundLinks) {
+	case 0:
+		return self.c.ErrorMsg(self.c.Tr.NoLinksFound)
+	case 1:
+		return self.open(foundLinks[0].Url)
+	}
+
+	menuItems := lo.Map(foundLinks, func(link links.Link, _ int) *types.MenuItem {
+		// there's no need to show a URL twice
+		url := lo.Ternary(link.Text == link.Url, "", link.Url)
+		return &types.MenuItem{
+			LabelColumns: []string{link.Text, style.FgBlue.Sprint(url)},
+			OnPress: func() error {
+				return self.open(link.Url)
+			},
+		}
+	})
+
+	return self.c.Menu(types.CreateMenuOptions{
+		Title: self.c.Tr.OpenLink,
+		Items: menuItems,
+	})
+}
+
+func (self *OpenLinkAction) open(url string) error {
+	self.c.LogAction(self.c.Tr.Actions.OpenLink)
+	if err := self.c.OS().OpenLink(url); err != nil {
+		return self.c.Error(err)
+	}
+
+	return nil
+}
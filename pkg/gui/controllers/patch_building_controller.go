@@ -2,6 +2,8 @@ package controllers
 
 import (
 	"github.com/jesseduffield/gocui"
+	"github.com/jesseduffield/lazygit/pkg/commands/git_commands"
+	"github.com/jesseduffield/lazygit/pkg/commands/patch"
 	"github.com/jesseduffield/lazygit/pkg/gui/types"
 	"github.com/samber/lo"
 )
@@ -39,6 +41,13 @@ func (self *PatchBuildingController) GetKeybindings(opts types.KeybindingsOpts)
 			Handler:     self.ToggleSelectionAndRefresh,
 			Description: self.c.Tr.ToggleSelectionForPatch,
 		},
+		{
+			Key:         opts.GetKey(opts.Config.Main.ApplySelection),
+			Handler:     self.openApplySelectionMenu,
+			Description: self.c.Tr.ApplySelection,
+			Tooltip:     self.c.Tr.ApplySelectionTooltip,
+			OpensMenu:   true,
+		},
 		{
 			Key:         opts.GetKey(opts.Config.Universal.Return),
 			Handler:     self.Escape,
@@ -56,7 +65,32 @@ func (self *PatchBuildingController) context() types.IPatchExplorerContext {
 }
 
 func (self *PatchBuildingController) GetMouseKeybindings(opts types.KeybindingsOpts) []*gocui.ViewMouseBinding {
-	return []*gocui.ViewMouseBinding{}
+	return []*gocui.ViewMouseBinding{
+		{
+			// when the patch building view is maximized, the commit files view is
+			// shown as an outline that we can click on to jump to another file
+			ViewName:    self.c.Contexts().CommitFiles.GetViewName(),
+			Key:         gocui.MouseLeft,
+			Handler:     self.onClickFileOutline,
+			FocusedView: self.context().GetViewName(),
+		},
+	}
+}
+
+func (self *PatchBuildingController) onClickFileOutline(opts gocui.ViewMouseBindingOpts) error {
+	commitFilesContext := self.c.Contexts().CommitFiles
+	idx := commitFilesContext.ViewIndexToModelIndex(opts.Y)
+	node := commitFilesContext.Get(idx)
+	if node == nil || node.File == nil {
+		return nil
+	}
+
+	commitFilesContext.SetSelectedLineIdx(idx)
+	if err := self.c.PostRefreshUpdate(commitFilesContext); err != nil {
+		return err
+	}
+
+	return self.c.Helpers().PatchBuilding.RefreshPatchBuildingPanel(types.OnFocusOpts{ClickedViewLineIdx: -1})
 }
 
 func (self *PatchBuildingController) GetOnFocus() func(types.OnFocusOpts) error {
@@ -153,6 +187,87 @@ func (self *PatchBuildingController) toggleSelection() error {
 	return nil
 }
 
+func (self *PatchBuildingController) openApplySelectionMenu() error {
+	return self.c.Menu(types.CreateMenuOptions{
+		Title: self.c.Tr.ApplySelection,
+		Items: []*types.MenuItem{
+			{
+				Label: self.c.Tr.ApplySelectionToWorktree,
+				OnPress: func() error {
+					return self.applySelectionAndRefresh(git_commands.ApplyPatchOpts{Index: true, ThreeWay: true})
+				},
+				Key: 'a',
+			},
+			{
+				Label: self.c.Tr.ApplySelectionToIndex,
+				OnPress: func() error {
+					return self.applySelectionAndRefresh(git_commands.ApplyPatchOpts{Cached: true})
+				},
+				Key: 'i',
+			},
+			{
+				Label: self.c.Tr.ReverseSelectionInWorktree,
+				OnPress: func() error {
+					return self.applySelectionAndRefresh(git_commands.ApplyPatchOpts{Index: true, ThreeWay: true, Reverse: true})
+				},
+				Key: 'r',
+			},
+			{
+				Label: self.c.Tr.ReverseSelectionInIndex,
+				OnPress: func() error {
+					return self.applySelectionAndRefresh(git_commands.ApplyPatchOpts{Cached: true, Reverse: true})
+				},
+				Key: 'R',
+			},
+		},
+	})
+}
+
+func (self *PatchBuildingController) applySelectionAndRefresh(opts git_commands.ApplyPatchOpts) error {
+	if err := self.applySelection(opts); err != nil {
+		return err
+	}
+
+	return self.c.Refresh(types.RefreshOptions{Scope: []types.RefreshableView{types.FILES}})
+}
+
+// applySelection applies the selected lines of the commit file's diff to the
+// index (and the working tree, unless opts.Cached is set), leaving the custom
+// patch alone.
+func (self *PatchBuildingController) applySelection(opts git_commands.ApplyPatchOpts) error {
+	self.context().GetMutex().Lock()
+	defer self.context().GetMutex().Unlock()
+
+	state := self.context().GetState()
+	if state == nil || self.c.Contexts().CommitFiles.GetSelectedPath() == "" {
+		return nil
+	}
+
+	firstLineIdx, lastLineIdx := state.SelectedRange()
+	patchToApply := patch.
+		Parse(state.GetDiff()).
+		Transform(patch.TransformOpts{
+			Reverse:             opts.Reverse,
+			IncludedLineIndices: patch.ExpandRange(firstLineIdx, lastLineIdx),
+		}).
+		FormatPlain()
+
+	if patchToApply == "" {
+		return nil
+	}
+
+	self.c.LogAction(self.c.Tr.Actions.ApplyPatch)
+	if err := self.c.Git().Patch.ApplyPatch(patchToApply, opts); err != nil {
+		return self.c.Error(err)
+	}
+
+	if state.SelectingRange() {
+		state.SetLineSelectMode()
+	}
+
+	return nil
+}
+
 func (self *PatchBuildingController) Escape() error {
 	return self.c.Helpers().PatchBuilding.Escape()
 }
@@ -1,8 +1,11 @@
 package controllers
 
 import (
+	"time"
+
 	"github.com/jesseduffield/lazygit/pkg/gui/context"
 	"github.com/jesseduffield/lazygit/pkg/gui/types"
+	"github.com/jesseduffield/lazygit/pkg/utils"
 )
 
 type ReflogCommitsController struct {
@@ -21,6 +24,20 @@ func NewReflogCommitsController(
 	}
 }
 
+func (self *ReflogCommitsController) GetKeybindings(opts types.KeybindingsOpts) []*types.Binding {
+	bindings := []*types.Binding{
+		{
+			Key:         opts.GetKey(opts.Config.Commits.ViewReflogDateOptions),
+			Handler:     self.openDateOptionsMenu,
+			Description: self.c.Tr.ReflogDateOptions,
+			Tooltip:     self.c.Tr.ReflogDateOptionsTooltip,
+			OpensMenu:   true,
+		},
+	}
+
+	return bindings
+}
+
 func (self *ReflogCommitsController) Context() types.Context {
 	return self.context()
 }
@@ -52,3 +69,64 @@ func (self *ReflogCommitsController) GetOnRenderToMain() func() error {
 		})
 	}
 }
+
+func (self *ReflogCommitsController) openDateOptionsMenu() error {
+	groupByDayLabel := self.c.Tr.GroupReflogByDay
+	if self.context().GroupByDay() {
+		groupByDayLabel = self.c.Tr.UngroupReflogByDay
+	}
+
+	var clearFilterDisabledReason *types.DisabledReason
+	if !self.context().IsFilteringByDate() {
+		clearFilterDisabledReason = &types.DisabledReason{Text: self.c.Tr.NotFilteringReflogByDate}
+	}
+
+	return self.c.Menu(types.CreateMenuOptions{
+		Title: self.c.Tr.ReflogDateOptions,
+		Items: []*types.MenuItem{
+			{
+				Label: groupByDayLabel,
+				OnPress: func() error {
+					self.context().SetGroupByDay(!self.context().GroupByDay())
+					return self.c.PostRefreshUpdate(self.context())
+				},
+				Key: 'g',
+			},
+			{
+				Label:   self.c.Tr.FilterReflogByDate,
+				OnPress: self.promptForDateFilter,
+				Key:     'f',
+				Tooltip: self.c.Tr.FilterReflogByDateTooltip,
+			},
+			{
+				Label: self.c.Tr.ClearReflogDateFilter,
+				OnPress: func() error {
+					return self.setDateFilter(time.Time{}, time.Time{}, "")
+				},
+				Key:            'c',
+				DisabledReason: clearFilterDisabledReason,
+			},
+		},
+	})
+}
+
+func (self *ReflogCommitsController) promptForDateFilter() error {
+	return self.c.Prompt(types.PromptOpts{
+		Title: self.c.Tr.FilterReflogByDatePrompt,
+		HandleConfirm: func(input string) error {
+			from, to, err := utils.ParseDayRange(time.Now(), input)
+			if err != nil {
+				return self.c.Error(err)
+			}
+
+			return self.setDateFilter(from, to, input)
+		},
+	})
+}
+
+func (self *ReflogCommitsController) setDateFilter(from time.Time, to time.Time, description string) error {
+	self.context().SetDateRange(from, to)
+	self.c.Views().ReflogCommits.Subtitle = description
+	self.context().SetSelectedLineIdx(0)
+	return self.c.PostRefreshUpdate(self.context())
+}
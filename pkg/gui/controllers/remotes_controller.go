@@ -7,9 +7,11 @@ import (
 	"github.com/jesseduffield/gocui"
 	"github.com/jesseduffield/lazygit/pkg/commands/models"
 	"github.com/jesseduffield/lazygit/pkg/gui/context"
+	"github.com/jesseduffield/lazygit/pkg/gui/presentation"
 	"github.com/jesseduffield/lazygit/pkg/gui/style"
 	"github.com/jesseduffield/lazygit/pkg/gui/types"
 	"github.com/jesseduffield/lazygit/pkg/utils"
+	"github.com/samber/lo"
 )
 
 type RemotesController struct {
@@ -43,6 +45,13 @@ func (self *RemotesController) GetKeybindings(opts types.KeybindingsOpts) []*typ
 			Handler:     self.checkSelected(self.fetch),
 			Description: self.c.Tr.FetchRemote,
 		},
+		{
+			Key:         opts.GetKey(opts.Config.Branches.SyncNotes),
+			Handler:     self.checkSelected(self.openNotesMenu),
+			Description: self.c.Tr.SyncNotes,
+			Tooltip:     self.c.Tr.SyncNotesTooltip,
+			OpensMenu:   true,
+		},
 		{
 			Key:         opts.GetKey(opts.Config.Universal.New),
 			Handler:     self.add,
@@ -58,6 +67,18 @@ func (self *RemotesController) GetKeybindings(opts types.KeybindingsOpts) []*typ
 			Handler:     self.checkSelected(self.edit),
 			Description: self.c.Tr.EditRemote,
 		},
+		{
+			Key:         opts.GetKey(opts.Config.Branches.AddForkRemote),
+			Handler:     self.checkSelected(self.addFork),
+			Description: self.c.Tr.AddForkRemote,
+			Tooltip:     self.c.Tr.AddForkRemoteTooltip,
+		},
+		{
+			Key:         opts.GetKey(opts.Config.Branches.ToggleDefaultPushRemote),
+			Handler:     self.checkSelected(self.toggleDefaultPushRemote),
+			Description: self.c.Tr.ToggleDefaultPushRemote,
+			Tooltip:     self.c.Tr.ToggleDefaultPushRemoteTooltip,
+		},
 	}
 
 	return bindings
@@ -79,7 +100,7 @@ func (self *RemotesController) GetOnRenderToMain() func() error {
 			if remote == nil {
 				task = types.NewRenderStringTask("No remotes")
 			} else {
-				task = types.NewRenderStringTask(fmt.Sprintf("%s\nUrls:\n%s", style.FgGreen.Sprint(remote.Name), strings.Join(remote.Urls, "\n")))
+				task = types.NewRenderStringTask(self.remoteSummary(remote))
 			}
 
 			return self.c.RenderToMainViews(types.RefreshMainOpts{
@@ -93,6 +114,27 @@ func (self *RemotesController) GetOnRenderToMain() func() error {
 	}
 }
 
+func (self *RemotesController) remoteSummary(remote *models.Remote) string {
+	title := style.FgGreen.Sprint(remote.Name)
+	if remote.IsPushDefault {
+		title += " " + style.FgYellow.Sprintf("(%s)", self.c.Tr.DefaultPushRemote)
+	}
+
+	summary := fmt.Sprintf("%s\n%s\n%s", title, self.c.Tr.RemoteUrls, strings.Join(remote.Urls, "\n"))
+
+	trackingBranches := lo.Filter(self.c.Model().Branches, func(branch *models.Branch, _ int) bool {
+		return branch.UpstreamRemote == remote.Name
+	})
+	if len(trackingBranches) > 0 {
+		lines := lo.Map(trackingBranches, func(branch *models.Branch, _ int) string {
+			return fmt.Sprintf("%s → %s", presentation.GetBranchTextStyle(branch.Name).Sprint(branch.Name), branch.UpstreamBranch)
+		})
+		summary += fmt.Sprintf("\n\n%s\n%s", self.c.Tr.TrackedByBranches, strings.Join(lines, "\n"))
+	}
+
+	return summary
+}
+
 func (self *RemotesController) GetOnClick() func() error {
 	return self.checkSelected(self.enter)
 }
@@ -136,6 +178,50 @@ func (self *RemotesController) add() error {
 	})
 }
 
+func (self *RemotesController) addFork(remote *models.Remote) error {
+	return self.c.Prompt(types.PromptOpts{
+		Title: self.c.Tr.ForkOwner,
+		HandleConfirm: func(owner string) error {
+			forkUrl, err := self.c.Helpers().Host.GetForkURL(remote.Name, owner)
+			if err != nil {
+				return self.c.Error(err)
+			}
+
+			self.c.LogAction(self.c.Tr.Actions.AddForkRemote)
+			if err := self.c.Git().Remote.AddRemote(owner, forkUrl); err != nil {
+				return self.c.Error(err)
+			}
+			if err := self.c.Git().Remote.SetPushDefault(owner); err != nil {
+				return self.c.Error(err)
+			}
+
+			return self.c.WithWaitingStatus(self.c.Tr.FetchingRemoteStatus, func(task gocui.Task) error {
+				if err := self.c.Git().Sync.FetchRemote(task, owner); err != nil {
+					_ = self.c.Error(err)
+				}
+
+				return self.c.Refresh(types.RefreshOptions{Scope: []types.RefreshableView{types.BRANCHES, types.REMOTES}})
+			})
+		},
+	})
+}
+
+func (self *RemotesController) toggleDefaultPushRemote(remote *models.Remote) error {
+	if remote.IsPushDefault {
+		self.c.LogAction(self.c.Tr.Actions.UnsetDefaultPushRemote)
+		if err := self.c.Git().Remote.UnsetPushDefault(); err != nil {
+			return self.c.Error(err)
+		}
+	} else {
+		self.c.LogAction(self.c.Tr.Actions.SetDefaultPushRemote)
+		if err := self.c.Git().Remote.SetPushDefault(remote.Name); err != nil {
+			return self.c.Error(err)
+		}
+	}
+
+	return self.c.Refresh(types.RefreshOptions{Scope: []types.RefreshableView{types.REMOTES}})
+}
+
 func (self *RemotesController) remove(remote *models.Remote) error {
 	return self.c.Confirm(types.ConfirmOpts{
 		Title:  self.c.Tr.RemoveRemote,
@@ -209,6 +295,41 @@ func (self *RemotesController) fetch(remote *models.Remote) error {
 	})
 }
 
+func (self *RemotesController) openNotesMenu(remote *models.Remote) error {
+	placeholders := map[string]string{"remote": remote.Name}
+
+	return self.c.Menu(types.CreateMenuOptions{
+		Title: self.c.Tr.SyncNotes,
+		Items: []*types.MenuItem{
+			{
+				Label: utils.ResolvePlaceholderString(self.c.Tr.PushNotes, placeholders),
+				OnPress: func() error {
+					return self.c.WithWaitingStatus(self.c.Tr.PushingNotesStatus, func(task gocui.Task) error {
+						self.c.LogAction(self.c.Tr.Actions.PushNotes)
+						return self.c.Git().Remote.PushNotes(task, remote.Name)
+					})
+				},
+				Key: 'p',
+			},
+			{
+				Label: utils.ResolvePlaceholderString(self.c.Tr.FetchNotes, placeholders),
+				OnPress: func() error {
+					return self.c.WithWaitingStatus(self.c.Tr.FetchingNotesStatus, func(task gocui.Task) error {
+						self.c.LogAction(self.c.Tr.Actions.FetchNotes)
+						if err := self.c.Git().Remote.FetchNotes(task, remote.Name); err != nil {
+							return err
+						}
+
+						// the main view shows notes when showing a commit
+						return self.c.Refresh(types.RefreshOptions{Scope: []types.RefreshableView{types.COMMITS}})
+					})
+				},
+				Key: 'f',
+			},
+		},
+	})
+}
+
 func (self *RemotesController) checkSelected(callback func(*models.Remote) error) func() error {
 	return func() error {
 		file := self.context().GetSelected()
@@ -0,0 +1,240 @@
+package controllers
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/jesseduffield/gocui"
+	"github.com/jesseduffield/lazygit/pkg/commands/git_commands"
+	"github.com/jesseduffield/lazygit/pkg/commands/models"
+	"github.com/jesseduffield/lazygit/pkg/gui/presentation"
+	"github.com/jesseduffield/lazygit/pkg/gui/style"
+	"github.com/jesseduffield/lazygit/pkg/gui/types"
+	"github.com/jesseduffield/lazygit/pkg/utils"
+	"github.com/samber/lo"
+)
+
+// RepoHealthAction shows a summary of the state of the repo's object store and
+// refs, along with actions for fixing what can be fixed
+type RepoHealthAction struct {
+	c *ControllerCommon
+}
+
+// how many large files and stale branches we list in the tooltips
+const repoHealthListLimit = 10
+
+type repoHealth struct {
+	objectCounts  *git_commands.ObjectCounts
+	refHealth     *git_commands.RefHealth
+	largeBlobs    []*git_commands.LargeBlob
+	staleBranches []*models.Branch
+	// zero if the check for large files is disabled
+	largeFileThreshold int64
+}
+
+func (self *RepoHealthAction) Call() error {
+	return self.c.WithWaitingStatus(self.c.Tr.CheckingRepoHealthStatus, func(gocui.Task) error {
+		health, err := self.check()
+		if err != nil {
+			return self.c.Error(err)
+		}
+
+		self.c.OnUIThread(func() error {
+			return self.showMenu(health)
+		})
+		return nil
+	})
+}
+
+func (self *RepoHealthAction) check() (*repoHealth, error) {
+	objectCounts, err := self.c.Git().RepoHealth.CountObjects()
+	if err != nil {
+		return nil, err
+	}
+
+	refHealth, err := self.c.Git().RepoHealth.CheckRefs()
+	if err != nil {
+		return nil, err
+	}
+
+	fileSizes, _ := presentation.NewFileSizeOptions(self.c.UserConfig.Gui.Files)
+	var largeBlobs []*git_commands.LargeBlob
+	if fileSizes.LargeThreshold > 0 {
+		largeBlobs, err = self.c.Git().RepoHealth.LargeBlobs(refHealth.ValidRefs, fileSizes.LargeThreshold)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	staleBranches := lo.Filter(self.c.Model().Branches, func(branch *models.Branch, _ int) bool {
+		return branch.UpstreamGone
+	})
+
+	return &repoHealth{
+		objectCounts:       objectCounts,
+		refHealth:          refHealth,
+		largeBlobs:         largeBlobs,
+		staleBranches:      staleBranches,
+		largeFileThreshold: fileSizes.LargeThreshold,
+	}, nil
+}
+
+func (self *RepoHealthAction) showMenu(health *repoHealth) error {
+	diagnosticsSection := &types.MenuSection{Title: self.c.Tr.RepoHealthDiagnostics}
+	actionsSection := &types.MenuSection{Title: self.c.Tr.RepoHealthActions}
+
+	// the diagnostics are just for showing, so pressing them does nothing
+	diagnostic := func(label string, value string, ok bool, tooltip string) *types.MenuItem {
+		valueStyle := lo.Ternary(ok, style.FgGreen, style.FgYellow)
+		return &types.MenuItem{
+			LabelColumns: []string{label, valueStyle.Sprint(value)},
+			OnPress:      func() error { return nil },
+			KeepOpen:     true,
+			Tooltip:      tooltip,
+			Section:      diagnosticsSection,
+		}
+	}
+
+	counts := health.objectCounts
+	largeFilesValue := strconv.Itoa(len(health.largeBlobs))
+	if health.largeFileThreshold == 0 {
+		largeFilesValue = self.c.Tr.RepoHealthCheckDisabled
+	}
+
+	// gc and prune walk all refs, so they fail if any of them are broken
+	var brokenRefsDisabledReason *types.DisabledReason
+	if len(health.refHealth.BrokenRefs) > 0 {
+		brokenRefsDisabledReason = &types.DisabledReason{Text: self.c.Tr.FixBrokenRefsFirst}
+	}
+
+	menuItems := []*types.MenuItem{
+		diagnostic(self.c.Tr.RepoHealthSize, utils.FormatByteSize(counts.TotalSize()), true,
+			utils.ResolvePlaceholderString(self.c.Tr.RepoHealthSizeTooltip, map[string]string{
+				"packedSize":  utils.FormatByteSize(counts.PackSize),
+				"packedCount": strconv.Itoa(counts.PackedCount),
+				"packCount":   strconv.Itoa(counts.PackCount),
+				"looseSize":   utils.FormatByteSize(counts.LooseSize),
+			})),
+		diagnostic(self.c.Tr.RepoHealthLooseObjects, strconv.Itoa(counts.LooseCount), counts.LooseCount < looseObjectsWarningCount,
+			self.c.Tr.RepoHealthLooseObjectsTooltip),
+		diagnostic(self.c.Tr.RepoHealthLargeFiles, largeFilesValue, len(health.largeBlobs) == 0,
+			self.largeFilesTooltip(health)),
+		diagnostic(self.c.Tr.RepoHealthStaleBranches, strconv.Itoa(len(health.staleBranches)), len(health.staleBranches) == 0,
+			self.listTooltip(self.c.Tr.RepoHealthStaleBranchesTooltip, lo.Map(health.staleBranches, func(branch *models.Branch, _ int) string {
+				return branch.Name
+			}))),
+		diagnostic(self.c.Tr.RepoHealthBrokenRefs, strconv.Itoa(len(health.refHealth.BrokenRefs)), len(health.refHealth.BrokenRefs) == 0,
+			self.listTooltip(self.c.Tr.RepoHealthBrokenRefsTooltip, health.refHealth.BrokenRefs)),
+		{
+			Label:          self.c.Tr.RepoHealthGarbageCollect,
+			Tooltip:        self.c.Tr.RepoHealthGarbageCollectTooltip,
+			OnPress:        self.garbageCollect,
+			DisabledReason: brokenRefsDisabledReason,
+			Key:            'g',
+			Section:        actionsSection,
+		},
+		{
+			Label:          self.c.Tr.RepoHealthPrune,
+			Tooltip:        self.c.Tr.RepoHealthPruneTooltip,
+			OnPress:        self.prune,
+			DisabledReason: brokenRefsDisabledReason,
+			Key:            'p',
+			Section:        actionsSection,
+		},
+		{
+			Label:   self.c.Tr.RepoHealthMigrateToLfs,
+			Tooltip: self.c.Tr.RepoHealthMigrateToLfsTooltip,
+			OnPress: func() error {
+				return self.showLfsMigrateHint(health.largeBlobs)
+			},
+			DisabledReason: lo.Ternary(len(health.largeBlobs) == 0,
+				&types.DisabledReason{Text: self.c.Tr.NoLargeFilesInHistory}, nil),
+			Key:     'l',
+			Section: actionsSection,
+		},
+	}
+
+	return self.c.Menu(types.CreateMenuOptions{
+		Title: self.c.Tr.RepoHealthTitle,
+		Items: menuItems,
+	})
+}
+
+// this is about where `git gc --auto` would pack them
+const looseObjectsWarningCount = 6700
+
+func (self *RepoHealthAction) largeFilesTooltip(health *repoHealth) string {
+	if health.largeFileThreshold == 0 {
+		return self.c.Tr.RepoHealthLargeFilesDisabledTooltip
+	}
+
+	tooltip := utils.ResolvePlaceholderString(self.c.Tr.RepoHealthLargeFilesTooltip, map[string]string{
+		"threshold": utils.FormatByteSize(health.largeFileThreshold),
+	})
+	return self.listTooltip(tooltip, lo.Map(health.largeBlobs, func(blob *git_commands.LargeBlob, _ int) string {
+		return fmt.Sprintf("%s (%s)", blob.Path, utils.FormatByteSize(blob.Size))
+	}))
+}
+
+// appends the first few of the items to the tooltip
+func (self *RepoHealthAction) listTooltip(tooltip string, items []string) string {
+	if len(items) == 0 {
+		return tooltip
+	}
+
+	lines := lo.Map(items[:utils.Min(len(items), repoHealthListLimit)], func(item string, _ int) string {
+		return "- " + item
+	})
+	if len(items) > repoHealthListLimit {
+		lines = append(lines, fmt.Sprintf("… (%d more)", len(items)-repoHealthListLimit))
+	}
+
+	return tooltip + "\n\n" + strings.Join(lines, "\n")
+}
+
+func (self *RepoHealthAction) garbageCollect() error {
+	return self.c.WithWaitingStatus(self.c.Tr.RunningGarbageCollectionStatus, func(gocui.Task) error {
+		self.c.LogAction(self.c.Tr.Actions.GarbageCollect)
+		if err := self.c.Git().RepoHealth.GarbageCollect(); err != nil {
+			return self.c.Error(err)
+		}
+
+		self.c.Toast(self.c.Tr.GarbageCollectionDone)
+		return nil
+	})
+}
+
+func (self *RepoHealthAction) prune() error {
+	return self.c.Confirm(types.ConfirmOpts{
+		Title:  self.c.Tr.RepoHealthPrune,
+		Prompt: self.c.Tr.RepoHealthPrunePrompt,
+		HandleConfirm: func() error {
+			return self.c.WithWaitingStatus(self.c.Tr.PruningObjectsStatus, func(gocui.Task) error {
+				self.c.LogAction(self.c.Tr.Actions.PruneObjects)
+				if err := self.c.Git().RepoHealth.PruneObjects(); err != nil {
+					return self.c.Error(err)
+				}
+
+				self.c.Toast(self.c.Tr.PruneObjectsDone)
+				return nil
+			})
+		},
+	})
+}
+
+// we don't run the migration ourselves because it rewrites all of history,
+// which everyone working on the repo needs to know about
+func (self *RepoHealthAction) showLfsMigrateHint(largeBlobs []*git_commands.LargeBlob) error {
+	paths := lo.Uniq(lo.Map(largeBlobs, func(blob *git_commands.LargeBlob, _ int) string {
+		return blob.Path
+	}))
+	command := fmt.Sprintf("git lfs migrate import --everything --include=%s", self.c.OS().Quote(strings.Join(paths, ",")))
+
+	return self.c.Alert(
+		self.c.Tr.RepoHealthMigrateToLfs,
+		utils.ResolvePlaceholderString(self.c.Tr.RepoHealthMigrateToLfsHint, map[string]string{
+			"command": command,
+		}),
+	)
+}
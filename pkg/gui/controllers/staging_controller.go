@@ -74,6 +74,19 @@ func (self *StagingController) GetKeybindings(opts types.KeybindingsOpts) []*typ
 			Handler:     self.EditHunkAndRefresh,
 			Description: self.c.Tr.EditHunk,
 		},
+		{
+			Key:         opts.GetKey(opts.Config.Main.SplitHunk),
+			Handler:     self.SplitHunk,
+			Description: self.c.Tr.SplitHunk,
+			Tooltip:     self.c.Tr.SplitHunkTooltip,
+		},
+		{
+			Key:               opts.GetKey(opts.Config.Main.ParkSelection),
+			Handler:           self.ParkSelection,
+			GetDisabledReason: self.getDisabledReasonForParkSelection,
+			Description:       self.c.Tr.ParkSelection,
+			Tooltip:           self.c.Tr.ParkSelectionTooltip,
+		},
 		{
 			Key:         opts.GetKey(opts.Config.Files.CommitChanges),
 			Handler:     self.c.Helpers().WorkingTree.HandleCommitPress,
@@ -97,7 +110,39 @@ func (self *StagingController) Context() types.Context {
 }
 
 func (self *StagingController) GetMouseKeybindings(opts types.KeybindingsOpts) []*gocui.ViewMouseBinding {
-	return []*gocui.ViewMouseBinding{}
+	return []*gocui.ViewMouseBinding{
+		{
+			// when the staging view is maximized, the files view is shown as an
+			// outline that we can click on to jump to another file
+			ViewName:    self.c.Contexts().Files.GetViewName(),
+			Key:         gocui.MouseLeft,
+			Handler:     self.onClickFileOutline,
+			FocusedView: self.context.GetViewName(),
+		},
+	}
+}
+
+func (self *StagingController) onClickFileOutline(opts gocui.ViewMouseBindingOpts) error {
+	filesContext := self.c.Contexts().Files
+	idx := filesContext.ViewIndexToModelIndex(opts.Y)
+	node := filesContext.Get(idx)
+	if node == nil || node.File == nil {
+		return nil
+	}
+
+	filesContext.SetSelectedLineIdx(idx)
+	if err := self.c.PostRefreshUpdate(filesContext); err != nil {
+		return err
+	}
+
+	if err := self.c.Helpers().Staging.RevertUnusedIntentToAdd(node.File.Name); err != nil {
+		return self.c.Error(err)
+	}
+	if err := self.c.Helpers().Staging.PrepareFileForStaging(node.File); err != nil {
+		return self.c.Error(err)
+	}
+
+	return self.c.Helpers().Staging.RefreshStagingPanel(types.OnFocusOpts{ClickedViewLineIdx: -1})
 }
 
 func (self *StagingController) GetOnFocus() func(types.OnFocusOpts) error {
@@ -118,6 +163,8 @@ func (self *StagingController) GetOnFocusLost() func(types.OnFocusLostOpts) erro
 			self.c.Views().StagingSecondary.Wrap = true
 			_ = self.c.Contexts().Staging.Render(false)
 			_ = self.c.Contexts().StagingSecondary.Render(false)
+
+			return self.c.Helpers().Staging.RevertUnusedIntentToAdd("")
 		}
 		return nil
 	}
@@ -180,6 +227,74 @@ func (self *StagingController) DiscardSelection() error {
 	return reset()
 }
 
+func (self *StagingController) SplitHunk() error {
+	self.context.GetMutex().Lock()
+	defer self.context.GetMutex().Unlock()
+
+	if !self.context.GetState().ToggleHunkSplit() {
+		self.c.ErrorToast(self.c.Tr.CannotSplitHunkHere)
+		return nil
+	}
+
+	return self.context.RenderAndFocus(true)
+}
+
+func (self *StagingController) ParkSelection() error {
+	path := self.FilePath()
+	patchToPark := self.selectionPatch(true)
+	if patchToPark == "" {
+		return nil
+	}
+
+	return self.c.Prompt(types.PromptOpts{
+		Title:          self.c.Tr.ParkSelectionPrompt,
+		InitialContent: path,
+		HandleConfirm: func(name string) error {
+			if name == "" {
+				name = path
+			}
+
+			self.c.LogAction(self.c.Tr.Actions.ParkChanges)
+			if err := self.c.Git().Patch.ParkPatch(name, patchToPark); err != nil {
+				return self.c.Error(err)
+			}
+
+			return self.c.Refresh(types.RefreshOptions{Scope: []types.RefreshableView{types.FILES, types.STAGING}})
+		},
+	})
+}
+
+func (self *StagingController) getDisabledReasonForParkSelection() *types.DisabledReason {
+	if self.staged {
+		return &types.DisabledReason{Text: self.c.Tr.CantParkStagedChanges}
+	}
+
+	return nil
+}
+
+// selectionPatch returns the patch of the selected lines. See
+// patch.TransformOpts for what reverse means.
+func (self *StagingController) selectionPatch(reverse bool) string {
+	self.context.GetMutex().Lock()
+	defer self.context.GetMutex().Unlock()
+
+	path := self.FilePath()
+	if path == "" {
+		return ""
+	}
+
+	state := self.context.GetState()
+	firstLineIdx, lastLineIdx := state.SelectedRange()
+	return patch.
+		Parse(state.GetDiff()).
+		Transform(patch.TransformOpts{
+			Reverse:             reverse,
+			IncludedLineIndices: patch.ExpandRange(firstLineIdx, lastLineIdx),
+			FileNameOverride:    path,
+		}).
+		FormatPlain()
+}
+
 func (self *StagingController) applySelectionAndRefresh(reverse bool) error {
 	if err := self.applySelection(reverse); err != nil {
 		return err
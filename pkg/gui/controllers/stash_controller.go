@@ -1,10 +1,16 @@
 package controllers
 
 import (
+	"fmt"
+	"strings"
+
 	"github.com/jesseduffield/lazygit/pkg/commands/models"
+	"github.com/jesseduffield/lazygit/pkg/config"
 	"github.com/jesseduffield/lazygit/pkg/gui/context"
+	"github.com/jesseduffield/lazygit/pkg/gui/controllers/helpers"
 	"github.com/jesseduffield/lazygit/pkg/gui/types"
 	"github.com/jesseduffield/lazygit/pkg/utils"
+	"github.com/samber/lo"
 )
 
 type StashController struct {
@@ -35,6 +41,12 @@ func (self *StashController) GetKeybindings(opts types.KeybindingsOpts) []*types
 			Handler:     self.checkSelected(self.handleStashPop),
 			Description: self.c.Tr.Pop,
 		},
+		{
+			Key:         opts.GetKey(opts.Config.Stash.ApplyStashWithIndex),
+			Handler:     self.checkSelected(self.handleStashApplyWithIndex),
+			Description: self.c.Tr.ApplyWithIndex,
+			Tooltip:     self.c.Tr.ApplyWithIndexTooltip,
+		},
 		{
 			Key:         opts.GetKey(opts.Config.Universal.Remove),
 			Handler:     self.checkSelected(self.handleStashDrop),
@@ -45,6 +57,12 @@ func (self *StashController) GetKeybindings(opts types.KeybindingsOpts) []*types
 			Handler:     self.checkSelected(self.handleNewBranchOffStashEntry),
 			Description: self.c.Tr.NewBranch,
 		},
+		{
+			Key:         opts.GetKey(opts.Config.Stash.BranchFromStash),
+			Handler:     self.checkSelected(self.handleBranchFromStashEntry),
+			Description: self.c.Tr.BranchFromStash,
+			Tooltip:     self.c.Tr.BranchFromStashTooltip,
+		},
 		{
 			Key:         opts.GetKey(opts.Config.Stash.RenameStash),
 			Handler:     self.checkSelected(self.handleRenameStashEntry),
@@ -62,6 +80,12 @@ func (self *StashController) GetOnRenderToMain() func() error {
 			stashEntry := self.context().GetSelected()
 			if stashEntry == nil {
 				task = types.NewRenderStringTask(self.c.Tr.NoStashEntries)
+			} else if entries := self.selectedEntries(); len(entries) > 1 {
+				task = types.NewRunPtyTask(
+					self.c.Git().Stash.ShowStashEntriesCmdObj(
+						lo.Map(entries, func(entry *models.StashEntry, _ int) int { return entry.Index }),
+					).GetCmd(),
+				)
 			} else {
 				task = types.NewRunPtyTask(
 					self.c.Git().Stash.ShowStashEntryCmdObj(stashEntry.Index).GetCmd(),
@@ -123,6 +147,30 @@ func (self *StashController) handleStashApply(stashEntry *models.StashEntry) err
 	})
 }
 
+func (self *StashController) handleStashApplyWithIndex(stashEntry *models.StashEntry) error {
+	apply := func() error {
+		self.c.LogAction(self.c.Tr.Actions.Stash)
+		err := self.c.Git().Stash.ApplyWithIndex(stashEntry.Index)
+		_ = self.postStashRefresh()
+		if err != nil {
+			return self.c.Error(err)
+		}
+		return nil
+	}
+
+	if self.c.UserConfig.Gui.SkipStashWarning {
+		return apply()
+	}
+
+	return self.c.Confirm(types.ConfirmOpts{
+		Title:  self.c.Tr.StashApply,
+		Prompt: self.c.Tr.SureApplyStashEntryWithIndex,
+		HandleConfirm: func() error {
+			return apply()
+		},
+	})
+}
+
 func (self *StashController) handleStashPop(stashEntry *models.StashEntry) error {
 	pop := func() error {
 		self.c.LogAction(self.c.Tr.Actions.Stash)
@@ -147,10 +195,27 @@ func (self *StashController) handleStashPop(stashEntry *models.StashEntry) error
 	})
 }
 
+// selectedEntries returns the stash entries in the selected range, newest first
+func (self *StashController) selectedEntries() []*models.StashEntry {
+	startIdx, endIdx := self.context().GetSelectionRange()
+	entries := self.c.Model().StashEntries
+	if startIdx < 0 || endIdx >= len(entries) {
+		return nil
+	}
+
+	return entries[startIdx : endIdx+1]
+}
+
 func (self *StashController) handleStashDrop(stashEntry *models.StashEntry) error {
-	return self.c.Confirm(types.ConfirmOpts{
-		Title:  self.c.Tr.StashDrop,
-		Prompt: self.c.Tr.SureDropStashEntry,
+	if entries := self.selectedEntries(); len(entries) > 1 {
+		return self.handleStashDropRange(entries)
+	}
+
+	return self.c.Helpers().ActionConfirmation.Confirm(helpers.ConfirmActionOpts{
+		Action:           config.ConfirmDropStash,
+		Title:            self.c.Tr.StashDrop,
+		Prompt:           self.c.Tr.SureDropStashEntry,
+		ConfirmationText: stashEntry.RefName(),
 		HandleConfirm: func() error {
 			self.c.LogAction(self.c.Tr.Actions.Stash)
 			err := self.c.Git().Stash.Drop(stashEntry.Index)
@@ -163,6 +228,49 @@ func (self *StashController) handleStashDrop(stashEntry *models.StashEntry) erro
 	})
 }
 
+func (self *StashController) handleStashDropRange(entries []*models.StashEntry) error {
+	summary := strings.Join(
+		lo.Map(entries, func(entry *models.StashEntry, _ int) string {
+			return entry.RefName() + ": " + entry.Name
+		}),
+		"\n",
+	)
+
+	return self.c.Helpers().ActionConfirmation.Confirm(helpers.ConfirmActionOpts{
+		Action: config.ConfirmDropStash,
+		Title:  self.c.Tr.StashDrop,
+		Prompt: utils.ResolvePlaceholderString(
+			self.c.Tr.SureDropStashEntries,
+			map[string]string{
+				"count":   fmt.Sprintf("%d", len(entries)),
+				"entries": summary,
+			},
+		),
+		ConfirmationText: fmt.Sprintf("%d", len(entries)),
+		HandleConfirm: func() error {
+			self.c.LogAction(self.c.Tr.Actions.Stash)
+			self.context().CancelRangeSelect()
+			// dropping an entry renumbers the ones after it, so we start with the
+			// oldest to keep the indices of the remaining ones valid
+			var dropErr error
+			for i := len(entries) - 1; i >= 0; i-- {
+				if dropErr = self.c.Git().Stash.Drop(entries[i].Index); dropErr != nil {
+					break
+				}
+			}
+			// refreshing even if a drop failed, because the ones before it
+			// have been dropped
+			if err := self.c.Refresh(types.RefreshOptions{Scope: []types.RefreshableView{types.STASH}}); err != nil {
+				return err
+			}
+			if dropErr != nil {
+				return self.c.Error(dropErr)
+			}
+			return nil
+		},
+	})
+}
+
 func (self *StashController) postStashRefresh() error {
 	return self.c.Refresh(types.RefreshOptions{Scope: []types.RefreshableView{types.STASH, types.FILES}})
 }
@@ -171,6 +279,30 @@ func (self *StashController) handleNewBranchOffStashEntry(stashEntry *models.Sta
 	return self.c.Helpers().Refs.NewBranch(stashEntry.RefName(), stashEntry.Description(), "")
 }
 
+func (self *StashController) handleBranchFromStashEntry(stashEntry *models.StashEntry) error {
+	return self.c.Prompt(types.PromptOpts{
+		Title: utils.ResolvePlaceholderString(
+			self.c.Tr.BranchFromStashPrompt,
+			map[string]string{
+				"stashName": stashEntry.Description(),
+			},
+		),
+		HandleConfirm: func(response string) error {
+			self.c.LogAction(self.c.Tr.Actions.BranchFromStash)
+			err := self.c.Git().Stash.Branch(helpers.SanitizedBranchName(response), stashEntry.Index)
+			_ = self.c.Refresh(types.RefreshOptions{Mode: types.SYNC})
+			if err != nil {
+				return self.c.Error(err)
+			}
+
+			self.context().SetSelectedLineIdx(0)
+			self.c.Contexts().LocalCommits.SetSelectedLineIdx(0)
+			self.c.Contexts().Branches.SetSelectedLineIdx(0)
+			return self.c.PushContext(self.c.Contexts().Files)
+		},
+	})
+}
+
 func (self *StashController) handleRenameStashEntry(stashEntry *models.StashEntry) error {
 	message := utils.ResolvePlaceholderString(
 		self.c.Tr.RenameStashPrompt,
@@ -3,14 +3,20 @@ package controllers
 import (
 	"errors"
 	"fmt"
+	"os"
+	"path/filepath"
 	"strings"
 	"time"
 
+	"github.com/jesseduffield/gocui"
+	"github.com/jesseduffield/lazygit/pkg/commands/git_commands"
 	"github.com/jesseduffield/lazygit/pkg/commands/types/enums"
 	"github.com/jesseduffield/lazygit/pkg/constants"
+	"github.com/jesseduffield/lazygit/pkg/gui/keybindings"
 	"github.com/jesseduffield/lazygit/pkg/gui/presentation"
 	"github.com/jesseduffield/lazygit/pkg/gui/style"
 	"github.com/jesseduffield/lazygit/pkg/gui/types"
+	"github.com/jesseduffield/lazygit/pkg/utils"
 	"github.com/samber/lo"
 )
 
@@ -57,6 +63,52 @@ func (self *StatusController) GetKeybindings(opts types.KeybindingsOpts) []*type
 			Handler:     self.showAllBranchLogs,
 			Description: self.c.Tr.AllBranchesLogGraph,
 		},
+		{
+			Key:         opts.GetKey(opts.Config.Status.UIState),
+			Handler:     self.createUIStateMenu,
+			Description: self.c.Tr.UIStateMenu,
+			Tooltip:     self.c.Tr.UIStateMenuTooltip,
+			OpensMenu:   true,
+		},
+		{
+			Key:         opts.GetKey(opts.Config.Status.ChangeLanguage),
+			Handler:     self.c.Helpers().Language.CreateLanguageMenu,
+			Description: self.c.Tr.ChangeLanguage,
+			Tooltip:     self.c.Tr.ChangeLanguageTooltip,
+			OpensMenu:   true,
+		},
+		{
+			Key:               opts.GetKey(opts.Config.Status.ContinueOperation),
+			Handler:           self.c.Helpers().MergeAndRebase.ContinueMergeOrRebase,
+			GetDisabledReason: self.getDisabledReasonForContinue,
+			Description:       self.c.Tr.ContinueOperation,
+		},
+		{
+			Key:               opts.GetKey(opts.Config.Status.SkipOperationStep),
+			Handler:           self.c.Helpers().MergeAndRebase.SkipMergeOrRebaseStep,
+			GetDisabledReason: self.getDisabledReasonForSkip,
+			Description:       self.c.Tr.SkipOperationStep,
+		},
+		{
+			Key:               opts.GetKey(opts.Config.Status.AbortOperation),
+			Handler:           self.abortOperation,
+			GetDisabledReason: self.getDisabledReasonForAbort,
+			Description:       self.c.Tr.AbortOperation,
+		},
+		{
+			Key:         opts.GetKey(opts.Config.Status.RepoHealth),
+			Handler:     self.showRepoHealth,
+			Description: self.c.Tr.RepoHealth,
+			Tooltip:     self.c.Tr.RepoHealthTooltip,
+			OpensMenu:   true,
+		},
+		{
+			Key:         opts.GetKey(opts.Config.Status.AuthorStats),
+			Handler:     self.createAuthorStatsMenu,
+			Description: self.c.Tr.AuthorStats,
+			Tooltip:     self.c.Tr.AuthorStatsTooltip,
+			OpensMenu:   true,
+		},
 	}
 
 	return bindings
@@ -64,6 +116,19 @@ func (self *StatusController) GetKeybindings(opts types.KeybindingsOpts) []*type
 
 func (self *StatusController) GetOnRenderToMain() func() error {
 	return func() error {
+		switch workingTreeState := self.c.Git().Status.WorkingTreeState(); workingTreeState {
+		case enums.REBASE_MODE_REBASING:
+			return self.renderRebaseState()
+		case enums.REBASE_MODE_MERGING:
+			return self.renderMergeState()
+		case enums.REBASE_MODE_CHERRY_PICKING, enums.REBASE_MODE_REVERTING:
+			return self.renderSequencerState(workingTreeState)
+		}
+
+		if bisectInfo := self.c.Git().Bisect.GetInfo(); bisectInfo.Started() {
+			return self.renderBisectState(bisectInfo)
+		}
+
 		dashboardString := strings.Join(
 			[]string{
 				lazygitTitle(),
@@ -86,6 +151,119 @@ func (self *StatusController) GetOnRenderToMain() func() error {
 	}
 }
 
+func (self *StatusController) renderRebaseState() error {
+	state, err := self.c.Git().Status.RebaseState()
+	if err != nil {
+		return self.c.Error(err)
+	}
+
+	return self.renderOperationState(
+		presentation.FormatWorkingTreeStateTitle(self.c.Tr, enums.REBASE_MODE_REBASING),
+		presentation.FormatRebaseState(self.c.Tr, state, self.c.Model().RebaseProgress, self.c.UserConfig.Gui.CommitHashLength),
+		self.operationKeysHint(self.c.Tr.OperationKeysHint),
+	)
+}
+
+func (self *StatusController) renderMergeState() error {
+	state, err := self.c.Git().Status.MergeState()
+	if err != nil {
+		return self.c.Error(err)
+	}
+
+	return self.renderOperationState(
+		presentation.FormatWorkingTreeStateTitle(self.c.Tr, enums.REBASE_MODE_MERGING),
+		presentation.FormatMergeState(self.c.Tr, state, self.c.UserConfig.Gui.CommitHashLength),
+		self.operationKeysHint(self.c.Tr.MergeKeysHint),
+	)
+}
+
+// renderSequencerState shows where a cherry-pick or revert that has stopped
+// midway is up to, much like the commits panel does for a rebase
+func (self *StatusController) renderSequencerState(workingTreeState enums.RebaseMode) error {
+	state, err := self.c.Git().Status.SequencerState()
+	if err != nil {
+		return self.c.Error(err)
+	}
+
+	return self.renderOperationState(
+		presentation.FormatWorkingTreeStateTitle(self.c.Tr, workingTreeState),
+		presentation.FormatSequencerState(self.c.Tr, state, self.c.UserConfig.Gui.CommitHashLength),
+		self.operationKeysHint(self.c.Tr.OperationKeysHint),
+	)
+}
+
+func (self *StatusController) renderBisectState(bisectInfo *git_commands.BisectInfo) error {
+	// the log only adds detail, so we can do without it
+	log, err := self.c.Git().Bisect.GetLog()
+	if err != nil {
+		self.c.Log.Error(err)
+	}
+
+	hint := utils.ResolvePlaceholderString(self.c.Tr.Bisect.ResetHint, map[string]string{
+		"key": keybindings.Label(self.c.UserConfig.Keybinding.Status.AbortOperation),
+	})
+
+	return self.renderOperationState(
+		self.c.Tr.Bisect.Bisecting,
+		presentation.FormatBisectState(self.c.Tr, bisectInfo, log, self.c.UserConfig.Gui.CommitHashLength),
+		hint,
+	)
+}
+
+func (self *StatusController) renderOperationState(title string, content string, hint string) error {
+	return self.c.RenderToMainViews(types.RefreshMainOpts{
+		Pair: self.c.MainViewPairs().Normal,
+		Main: &types.ViewUpdateOpts{
+			Title: title,
+			Task:  types.NewRenderStringTask(content + "\n\n" + hint),
+		},
+	})
+}
+
+func (self *StatusController) operationKeysHint(template string) string {
+	keybindingConfig := self.c.UserConfig.Keybinding.Status
+	return utils.ResolvePlaceholderString(template, map[string]string{
+		"continueKey": keybindings.Label(keybindingConfig.ContinueOperation),
+		"skipKey":     keybindings.Label(keybindingConfig.SkipOperationStep),
+		"abortKey":    keybindings.Label(keybindingConfig.AbortOperation),
+	})
+}
+
+func (self *StatusController) abortOperation() error {
+	if self.c.Git().Status.WorkingTreeState() == enums.REBASE_MODE_NONE {
+		return self.c.Helpers().Bisect.Reset()
+	}
+
+	return self.c.Helpers().MergeAndRebase.AbortMergeOrRebaseWithConfirm()
+}
+
+func (self *StatusController) getDisabledReasonForContinue() *types.DisabledReason {
+	if self.c.Git().Status.WorkingTreeState() == enums.REBASE_MODE_NONE {
+		return &types.DisabledReason{Text: self.c.Tr.NotMergingOrRebasing}
+	}
+
+	return nil
+}
+
+func (self *StatusController) getDisabledReasonForSkip() *types.DisabledReason {
+	switch self.c.Git().Status.WorkingTreeState() {
+	case enums.REBASE_MODE_NONE:
+		return &types.DisabledReason{Text: self.c.Tr.NotMergingOrRebasing}
+	case enums.REBASE_MODE_MERGING:
+		return &types.DisabledReason{Text: self.c.Tr.CantSkipMerge}
+	}
+
+	return nil
+}
+
+func (self *StatusController) getDisabledReasonForAbort() *types.DisabledReason {
+	if self.c.Git().Status.WorkingTreeState() == enums.REBASE_MODE_NONE && !self.c.Git().Bisect.GetInfo().Started() {
+		return &types.DisabledReason{Text: self.c.Tr.NoOperationInProgress}
+	}
+
+	return nil
+}
+
 func (self *StatusController) GetOnClick() func() error {
 	return self.onClick
 }
@@ -107,11 +285,14 @@ func (self *StatusController) onClick() error {
 	}
 
 	cx, _ := self.c.Views().Status.Cursor()
-	upstreamStatus := presentation.BranchStatus(currentBranch, types.ItemOperationNone, self.c.Tr, time.Now())
-	repoName := self.c.Git().RepoPaths.RepoName()
+	upstreamStatus := presentation.BranchStatus(currentBranch, types.ItemOperationNone, self.c.Tr, time.Now(), self.c.UserConfig.Gui.StatusGlyphs)
+	repoName := presentation.FormatRepoBreadcrumbs(
+		self.c.State().GetRepoPathStack().Items(),
+		self.c.Git().RepoPaths.RepoName(),
+	)
 	workingTreeState := self.c.Git().Status.WorkingTreeState()
 	switch workingTreeState {
-	case enums.REBASE_MODE_REBASING, enums.REBASE_MODE_MERGING:
+	case enums.REBASE_MODE_REBASING, enums.REBASE_MODE_MERGING, enums.REBASE_MODE_CHERRY_PICKING, enums.REBASE_MODE_REVERTING:
 		workingTreeStatus := fmt.Sprintf("(%s)", presentation.FormatWorkingTreeStateLower(self.c.Tr, workingTreeState))
 		if cursorInSubstring(cx, upstreamStatus+" ", workingTreeStatus) {
 			return self.c.Helpers().MergeAndRebase.CreateRebaseOptionsMenu()
@@ -128,6 +309,60 @@ func (self *StatusController) onClick() error {
 	return nil
 }
 
+func (self *StatusController) createUIStateMenu() error {
+	promptForFile := func(handle func(path string) error) error {
+		return self.c.Prompt(types.PromptOpts{
+			Title:          self.c.Tr.UIStateFilePrompt,
+			InitialContent: self.defaultUIStateFile(),
+			HandleConfirm:  handle,
+		})
+	}
+
+	return self.c.Menu(types.CreateMenuOptions{
+		Title: self.c.Tr.UIStateMenu,
+		Items: []*types.MenuItem{
+			{
+				Label: self.c.Tr.ExportUIState,
+				OnPress: func() error {
+					return promptForFile(func(path string) error {
+						if err := self.c.Helpers().SessionState.Export(path); err != nil {
+							return self.c.Error(err)
+						}
+						self.c.Toast(utils.ResolvePlaceholderString(self.c.Tr.UIStateExported, map[string]string{"path": path}))
+						return nil
+					})
+				},
+				Key: 'e',
+			},
+			{
+				Label: self.c.Tr.ImportUIState,
+				OnPress: func() error {
+					return promptForFile(func(path string) error {
+						if err := self.c.Helpers().SessionState.Import(path); err != nil {
+							return self.c.Error(err)
+						}
+						self.c.Toast(utils.ResolvePlaceholderString(self.c.Tr.UIStateImported, map[string]string{"path": path}))
+						return nil
+					})
+				},
+				Key: 'i',
+			},
+		},
+	})
+}
+
+// we default to a file in the home directory named after the repo, so that
+// exporting doesn't leave an untracked file in the repo itself
+func (self *StatusController) defaultUIStateFile() string {
+	fileName := self.c.Git().RepoPaths.RepoName() + ".lazygit-state.yml"
+	homeDir, err := os.UserHomeDir()
+	if err != nil {
+		return fileName
+	}
+
+	return filepath.Join(homeDir, fileName)
+}
+
 func runeCount(str string) int {
 	return len([]rune(str))
 }
@@ -180,6 +415,72 @@ func (self *StatusController) editConfig() error {
 	return self.askForConfigFile(self.c.Helpers().Files.EditFile)
 }
 
+func (self *StatusController) showRepoHealth() error {
+	return (&RepoHealthAction{c: self.c}).Call()
+}
+
+func (self *StatusController) createAuthorStatsMenu() error {
+	type timeRange struct {
+		label string
+		since string
+		key   types.Key
+	}
+	timeRanges := []timeRange{
+		{label: self.c.Tr.LastWeek, since: "1 week ago", key: 'w'},
+		{label: self.c.Tr.LastMonth, since: "1 month ago", key: 'm'},
+		{label: self.c.Tr.LastYear, since: "1 year ago", key: 'y'},
+		{label: self.c.Tr.AllTime, since: "", key: 'a'},
+	}
+
+	menuItems := lo.Map(timeRanges, func(r timeRange, _ int) *types.MenuItem {
+		return &types.MenuItem{
+			Label: r.label,
+			OnPress: func() error {
+				return self.showAuthorStats(r.label, r.since)
+			},
+			Key: r.key,
+		}
+	})
+
+	return self.c.Menu(types.CreateMenuOptions{
+		Title: self.c.Tr.AuthorStats,
+		Items: menuItems,
+	})
+}
+
+// renders a bar chart of the commits per author to the main view. When
+// filtering by path, only the commits touching that path are counted.
+func (self *StatusController) showAuthorStats(timeRangeLabel string, since string) error {
+	path := self.c.Modes().Filtering.GetPath()
+
+	return self.c.WithWaitingStatus(self.c.Tr.LoadingAuthorStats, func(gocui.Task) error {
+		counts, err := self.c.Git().Commit.GetAuthorCommitCounts(since, path)
+		if err != nil {
+			return self.c.Error(err)
+		}
+
+		header := utils.ResolvePlaceholderString(
+			lo.Ternary(path == "", self.c.Tr.AuthorStatsHeader, self.c.Tr.AuthorStatsHeaderWithPath),
+			map[string]string{"timeRange": timeRangeLabel, "path": path},
+		)
+		content := self.c.Tr.NoCommitsInTimeRange
+		if len(counts) > 0 {
+			content = presentation.GetAuthorStatsDisplayString(counts, self.c.Views().Main.InnerWidth())
+		}
+
+		self.c.OnUIThread(func() error {
+			return self.c.RenderToMainViews(types.RefreshMainOpts{
+				Pair: self.c.MainViewPairs().Normal,
+				Main: &types.ViewUpdateOpts{
+					Title: self.c.Tr.AuthorStatsTitle,
+					Task:  types.NewRenderStringTask(style.AttrBold.Sprint(header) + "\n\n" + content),
+				},
+			})
+		})
+		return nil
+	})
+}
+
 func (self *StatusController) showAllBranchLogs() error {
 	cmdObj := self.c.Git().Branch.AllBranchesLogCmdObj()
 	task := types.NewRunPtyTask(cmdObj.GetCmd())
@@ -8,6 +8,7 @@ import (
 	"github.com/jesseduffield/gocui"
 	"github.com/jesseduffield/lazygit/pkg/commands/models"
 	"github.com/jesseduffield/lazygit/pkg/gui/context"
+	"github.com/jesseduffield/lazygit/pkg/gui/controllers/helpers"
 	"github.com/jesseduffield/lazygit/pkg/gui/style"
 	"github.com/jesseduffield/lazygit/pkg/gui/types"
 )
@@ -65,6 +66,13 @@ func (self *SubmodulesController) GetKeybindings(opts types.KeybindingsOpts) []*
 			Handler:     self.checkSelected(self.init),
 			Description: self.c.Tr.InitSubmodule,
 		},
+		{
+			Key:         opts.GetKey(opts.Config.Submodules.UpdateOptions),
+			Handler:     self.checkSelected(self.openUpdateOptionsMenu),
+			Description: self.c.Tr.ViewSubmoduleUpdateOptions,
+			Tooltip:     self.c.Tr.ViewSubmoduleUpdateOptionsTooltip,
+			OpensMenu:   true,
+		},
 		{
 			Key:         opts.GetKey(opts.Config.Submodules.BulkMenu),
 			Handler:     self.openBulkActionsMenu,
@@ -92,10 +100,12 @@ func (self *SubmodulesController) GetOnRenderToMain() func() error {
 				task = types.NewRenderStringTask("No submodules")
 			} else {
 				prefix := fmt.Sprintf(
-					"Name: %s\nPath: %s\nUrl:  %s\n\n",
+					"Name:   %s\nPath:   %s\nUrl:    %s\nBranch: %s\nUpdate: %s\n\n",
 					style.FgGreen.Sprint(submodule.Name),
 					style.FgYellow.Sprint(submodule.Path),
 					style.FgCyan.Sprint(submodule.Url),
+					style.FgMagenta.Sprint(self.branchDisplay(submodule)),
+					style.FgMagenta.Sprint(self.updateStrategyDisplay(submodule)),
 				)
 
 				file := self.c.Helpers().WorkingTree.FileForSubmodule(submodule)
@@ -216,6 +226,20 @@ func (self *SubmodulesController) openBulkActionsMenu() error {
 				},
 				Key: 'u',
 			},
+			{
+				LabelColumns: []string{self.c.Tr.BulkUpdateSubmodulesFromRemote, style.FgYellow.Sprint(self.c.Git().Submodule.BulkUpdateFromRemoteCmdObj().ToString())},
+				OnPress: func() error {
+					return self.c.WithWaitingStatus(self.c.Tr.RunningCommand, func(gocui.Task) error {
+						self.c.LogAction(self.c.Tr.Actions.BulkUpdateSubmodulesFromRemote)
+						if err := self.c.Git().Submodule.BulkUpdateFromRemoteCmdObj().Run(); err != nil {
+							return self.c.Error(err)
+						}
+
+						return self.c.Refresh(types.RefreshOptions{Scope: []types.RefreshableView{types.SUBMODULES, types.FILES}})
+					})
+				},
+				Key: 'r',
+			},
 			{
 				LabelColumns: []string{self.c.Tr.BulkDeinitSubmodules, style.FgRed.Sprint(self.c.Git().Submodule.BulkDeinitCmdObj().ToString())},
 				OnPress: func() error {
@@ -230,10 +254,139 @@ func (self *SubmodulesController) openBulkActionsMenu() error {
 				},
 				Key: 'd',
 			},
+			{
+				LabelColumns: []string{self.c.Tr.RunCommandInSubmodules, style.FgCyan.Sprint(self.c.Git().Submodule.ForeachCmdObj("<command>").ToString())},
+				OnPress:      self.runCommandInSubmodules,
+				Key:          'f',
+			},
+		},
+	})
+}
+
+func (self *SubmodulesController) runCommandInSubmodules() error {
+	return self.c.Prompt(types.PromptOpts{
+		Title:               self.c.Tr.RunCommandInSubmodulesPrompt,
+		FindSuggestionsFunc: helpers.FuzzySearchFunc(self.c.UserConfig.Git.SubmoduleForeachCommands),
+		HandleConfirm: func(command string) error {
+			return self.c.WithWaitingStatus(self.c.Tr.RunningCommand, func(gocui.Task) error {
+				self.c.LogAction(self.c.Tr.Actions.RunCommandInSubmodules)
+				// stream the output to the command log as it comes in: git prints
+				// which submodule it is entering before running the command there,
+				// so the output ends up grouped per submodule
+				if err := self.c.Git().Submodule.ForeachCmdObj(command).StreamOutput().Run(); err != nil {
+					return self.c.Error(err)
+				}
+
+				return self.c.Refresh(types.RefreshOptions{Scope: []types.RefreshableView{types.SUBMODULES, types.FILES}})
+			})
+		},
+	})
+}
+
+func (self *SubmodulesController) branchDisplay(submodule *models.SubmoduleConfig) string {
+	if submodule.Branch == "" {
+		return self.c.Tr.SubmoduleRemoteDefaultBranch
+	}
+
+	return submodule.Branch
+}
+
+func (self *SubmodulesController) updateStrategyDisplay(submodule *models.SubmoduleConfig) string {
+	if submodule.UpdateStrategy == "" {
+		return "checkout"
+	}
+
+	return submodule.UpdateStrategy
+}
+
+func (self *SubmodulesController) openUpdateOptionsMenu(submodule *models.SubmoduleConfig) error {
+	return self.c.Menu(types.CreateMenuOptions{
+		Title: self.c.Tr.SubmoduleUpdateOptions,
+		Items: []*types.MenuItem{
+			{
+				LabelColumns: []string{self.c.Tr.SetSubmoduleBranch, style.FgMagenta.Sprint(self.branchDisplay(submodule))},
+				OnPress: func() error {
+					return self.setBranch(submodule)
+				},
+				Key: 'b',
+			},
+			{
+				LabelColumns: []string{self.c.Tr.SetSubmoduleUpdateStrategy, style.FgMagenta.Sprint(self.updateStrategyDisplay(submodule))},
+				OnPress: func() error {
+					return self.openUpdateStrategyMenu(submodule)
+				},
+				Key:       's',
+				OpensMenu: true,
+			},
+			{
+				LabelColumns: []string{self.c.Tr.UpdateSubmoduleFromRemote, style.FgYellow.Sprint(self.c.Git().Submodule.UpdateFromRemoteCmdObj(submodule.Path).ToString())},
+				OnPress: func() error {
+					return self.updateFromRemote(submodule)
+				},
+				Key: 'u',
+			},
 		},
 	})
 }
 
+func (self *SubmodulesController) setBranch(submodule *models.SubmoduleConfig) error {
+	return self.c.Prompt(types.PromptOpts{
+		Title:          fmt.Sprintf(self.c.Tr.SetSubmoduleBranchPrompt, submodule.Name),
+		InitialContent: submodule.Branch,
+		HandleConfirm: func(branch string) error {
+			branch = strings.TrimSpace(branch)
+			if branch == submodule.Branch {
+				return nil
+			}
+
+			self.c.LogAction(self.c.Tr.Actions.SetSubmoduleBranch)
+			if err := self.c.Git().Submodule.SetBranch(submodule, branch); err != nil {
+				return self.c.Error(err)
+			}
+
+			return self.c.Refresh(types.RefreshOptions{Scope: []types.RefreshableView{types.SUBMODULES, types.FILES}})
+		},
+	})
+}
+
+func (self *SubmodulesController) openUpdateStrategyMenu(submodule *models.SubmoduleConfig) error {
+	menuItemForStrategy := func(strategy string, tooltip string, key types.Key) *types.MenuItem {
+		return &types.MenuItem{
+			Label:   strategy,
+			Tooltip: tooltip,
+			OnPress: func() error {
+				self.c.LogAction(self.c.Tr.Actions.SetSubmoduleUpdateStrategy)
+				if err := self.c.Git().Submodule.SetUpdateStrategy(submodule, strategy); err != nil {
+					return self.c.Error(err)
+				}
+
+				return self.c.Refresh(types.RefreshOptions{Scope: []types.RefreshableView{types.SUBMODULES, types.FILES}})
+			},
+			Key: key,
+		}
+	}
+
+	return self.c.Menu(types.CreateMenuOptions{
+		Title: self.c.Tr.SetSubmoduleUpdateStrategy,
+		Items: []*types.MenuItem{
+			menuItemForStrategy("checkout", self.c.Tr.SubmoduleUpdateCheckoutTooltip, 'c'),
+			menuItemForStrategy("rebase", self.c.Tr.SubmoduleUpdateRebaseTooltip, 'r'),
+			menuItemForStrategy("merge", self.c.Tr.SubmoduleUpdateMergeTooltip, 'm'),
+		},
+	})
+}
+
+func (self *SubmodulesController) updateFromRemote(submodule *models.SubmoduleConfig) error {
+	return self.c.WithWaitingStatus(self.c.Tr.UpdatingSubmoduleStatus, func(gocui.Task) error {
+		self.c.LogAction(self.c.Tr.Actions.UpdateSubmoduleFromRemote)
+		if err := self.c.Git().Submodule.UpdateFromRemoteCmdObj(submodule.Path).Run(); err != nil {
+			return self.c.Error(err)
+		}
+
+		return self.c.Refresh(types.RefreshOptions{Scope: []types.RefreshableView{types.SUBMODULES, types.FILES}})
+	})
+}
+
 func (self *SubmodulesController) update(submodule *models.SubmoduleConfig) error {
 	return self.c.WithWaitingStatus(self.c.Tr.UpdatingSubmoduleStatus, func(gocui.Task) error {
 		self.c.LogAction(self.c.Tr.Actions.UpdateSubmodule)
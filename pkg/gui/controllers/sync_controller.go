@@ -2,14 +2,19 @@ package controllers
 
 import (
 	"fmt"
+	"path"
 	"strings"
 
 	"github.com/jesseduffield/gocui"
 	"github.com/jesseduffield/lazygit/pkg/commands/git_commands"
 	"github.com/jesseduffield/lazygit/pkg/commands/models"
+	"github.com/jesseduffield/lazygit/pkg/commands/oscommands"
+	"github.com/jesseduffield/lazygit/pkg/config"
 	"github.com/jesseduffield/lazygit/pkg/gui/context"
+	"github.com/jesseduffield/lazygit/pkg/gui/controllers/helpers"
 	"github.com/jesseduffield/lazygit/pkg/gui/types"
 	"github.com/jesseduffield/lazygit/pkg/utils"
+	"github.com/samber/lo"
 )
 
 type SyncController struct {
@@ -183,16 +188,20 @@ type pushOpts struct {
 }
 
 func (self *SyncController) pushAux(currentBranch *models.Branch, opts pushOpts) error {
+	gitOpts := git_commands.PushOpts{
+		Force:          opts.force,
+		UpstreamRemote: opts.upstreamRemote,
+		UpstreamBranch: opts.upstreamBranch,
+		SetUpstream:    opts.setUpstream,
+	}
+
+	if opts.force && self.c.Helpers().DryRun.IsEnabled() {
+		return self.showForcePushDryRun(currentBranch, gitOpts)
+	}
+
 	return self.c.WithInlineStatus(currentBranch, types.ItemOperationPushing, context.LOCAL_BRANCHES_CONTEXT_KEY, func(task gocui.Task) error {
 		self.c.LogAction(self.c.Tr.Actions.Push)
-		err := self.c.Git().Sync.Push(
-			task,
-			git_commands.PushOpts{
-				Force:          opts.force,
-				UpstreamRemote: opts.upstreamRemote,
-				UpstreamBranch: opts.upstreamBranch,
-				SetUpstream:    opts.setUpstream,
-			})
+		err := self.c.Git().Sync.Push(task, gitOpts)
 		if err != nil {
 			if !opts.force && strings.Contains(err.Error(), "Updates were rejected") {
 				forcePushDisabled := self.c.UserConfig.Git.DisableForcePushing
@@ -200,15 +209,11 @@ func (self *SyncController) pushAux(currentBranch *models.Branch, opts pushOpts)
 					_ = self.c.ErrorMsg(self.c.Tr.UpdatesRejectedAndForcePushDisabled)
 					return nil
 				}
-				_ = self.c.Confirm(types.ConfirmOpts{
-					Title:  self.c.Tr.ForcePush,
-					Prompt: self.forcePushPrompt(),
-					HandleConfirm: func() error {
-						newOpts := opts
-						newOpts.force = true
-
-						return self.pushAux(currentBranch, newOpts)
-					},
+				_ = self.confirmForcePush(currentBranch, func() error {
+					newOpts := opts
+					newOpts.force = true
+
+					return self.pushAux(currentBranch, newOpts)
 				})
 				return nil
 			}
@@ -218,20 +223,139 @@ func (self *SyncController) pushAux(currentBranch *models.Branch, opts pushOpts)
 	})
 }
 
+func (self *SyncController) showForcePushDryRun(currentBranch *models.Branch, opts git_commands.PushOpts) error {
+	// the command is never run, so there's no task to prompt for credentials in
+	cmdObj, err := self.c.Git().Sync.PushCmdObj(nil, opts)
+	if err != nil {
+		return self.c.Error(err)
+	}
+
+	remoteRef := currentBranch.ShortUpstreamRefName()
+	if opts.UpstreamRemote != "" {
+		remoteRef = opts.UpstreamRemote + "/" + opts.UpstreamBranch
+	}
+
+	affected := []string{
+		utils.ResolvePlaceholderString(self.c.Tr.DryRunRemoteRefSet, map[string]string{
+			"ref":  remoteRef,
+			"hash": utils.ShortSha(currentBranch.CommitHash),
+		}),
+	}
+	// we only know how far behind the branch is if it's pushed to its upstream
+	if opts.UpstreamRemote == "" && currentBranch.HasCommitsToPull() {
+		affected = append(affected, utils.ResolvePlaceholderString(self.c.Tr.DryRunCommitsOverwritten, map[string]string{
+			"ref":   remoteRef,
+			"count": currentBranch.Pullables,
+		}))
+	}
+
+	return self.c.Helpers().DryRun.Show(helpers.DryRunPreview{
+		CmdObjs:  []oscommands.ICmdObj{cmdObj},
+		Affected: affected,
+	})
+}
+
 func (self *SyncController) requestToForcePush(currentBranch *models.Branch, opts pushOpts) error {
 	forcePushDisabled := self.c.UserConfig.Git.DisableForcePushing
 	if forcePushDisabled {
 		return self.c.ErrorMsg(self.c.Tr.ForcePushDisabled)
 	}
 
-	return self.c.Confirm(types.ConfirmOpts{
-		Title:  self.c.Tr.ForcePush,
-		Prompt: self.forcePushPrompt(),
-		HandleConfirm: func() error {
-			opts.force = true
-			return self.pushAux(currentBranch, opts)
-		},
+	return self.confirmForcePush(currentBranch, func() error {
+		opts.force = true
+		return self.pushAux(currentBranch, opts)
+	})
+}
+
+// Force pushing a branch that others work on too can throw away their work, so
+// in that case we spell out what's going to be lost, and the action has its
+// own (by default higher) confirmation tier
+func (self *SyncController) confirmForcePush(currentBranch *models.Branch, handleConfirm func() error) error {
+	action := config.ConfirmForcePush
+	details := ""
+	lostCommits := self.commitsLostByForcePush(currentBranch)
+	if reasons := self.sharedBranchReasons(currentBranch, lostCommits); len(reasons) > 0 {
+		action = config.ConfirmForcePushSharedBranch
+		details = self.sharedBranchDetails(currentBranch, reasons, lostCommits)
+	}
+
+	return self.c.Helpers().ActionConfirmation.Confirm(helpers.ConfirmActionOpts{
+		Action:           action,
+		Title:            self.c.Tr.ForcePush,
+		Prompt:           self.forcePushPrompt(),
+		ConfirmationText: currentBranch.Name,
+		Details:          details,
+		HandleConfirm:    handleConfirm,
+	})
+}
+
+// returns why we think others are working on the branch too, if we do
+func (self *SyncController) sharedBranchReasons(branch *models.Branch, lostCommits []*git_commands.MissingCommit) []string {
+	reasons := []string{}
+
+	for _, pattern := range self.c.UserConfig.Confirmations.ProtectedBranches {
+		if matched, _ := path.Match(pattern, branch.Name); matched {
+			reasons = append(reasons, utils.ResolvePlaceholderString(self.c.Tr.SharedBranchMatchesPattern, map[string]string{"pattern": pattern}))
+			break
+		}
+	}
+
+	if ownEmail := self.c.Git().Config.GetUserEmail(); ownEmail != "" {
+		otherAuthors := lo.Uniq(lo.FilterMap(lostCommits, func(commit *git_commands.MissingCommit, _ int) (string, bool) {
+			return commit.AuthorName, commit.AuthorEmail != ownEmail
+		}))
+		if len(otherAuthors) > 0 {
+			reasons = append(reasons, utils.ResolvePlaceholderString(self.c.Tr.SharedBranchHasOtherAuthors, map[string]string{"authors": strings.Join(otherAuthors, ", ")}))
+		}
+	}
+
+	otherRemotes := lo.FilterMap(self.c.Model().Remotes, func(remote *models.Remote, _ int) (string, bool) {
+		return remote.Name, remote.Name != branch.UpstreamRemote && lo.SomeBy(remote.Branches, func(remoteBranch *models.RemoteBranch) bool {
+			return remoteBranch.Name == branch.UpstreamBranch
+		})
 	})
+	if len(otherRemotes) > 0 {
+		reasons = append(reasons, utils.ResolvePlaceholderString(self.c.Tr.SharedBranchOnOtherRemotes, map[string]string{"remotes": strings.Join(otherRemotes, ", ")}))
+	}
+
+	return reasons
+}
+
+// the commits on the upstream that aren't on the branch, so force pushing the
+// branch removes them from the remote
+func (self *SyncController) commitsLostByForcePush(branch *models.Branch) []*git_commands.MissingCommit {
+	if !branch.RemoteBranchStoredLocally() {
+		return nil
+	}
+
+	commits, err := self.c.Git().Branch.GetCommitsMissingFrom(branch.FullUpstreamRefName(), branch.FullRefName())
+	if err != nil {
+		self.c.Log.Error(err)
+	}
+
+	return commits
+}
+
+func (self *SyncController) sharedBranchDetails(branch *models.Branch, reasons []string, lostCommits []*git_commands.MissingCommit) string {
+	lines := []string{
+		utils.ResolvePlaceholderString(self.c.Tr.SharedBranchIntro, map[string]string{"branch": branch.Name}),
+	}
+	for _, reason := range reasons {
+		lines = append(lines, "- "+reason)
+	}
+
+	if len(lostCommits) > 0 {
+		lines = append(lines, "", utils.ResolvePlaceholderString(self.c.Tr.ForcePushDivergence, map[string]string{
+			"ahead":    branch.Pushables,
+			"behind":   branch.Pullables,
+			"upstream": branch.ShortUpstreamRefName(),
+		}))
+		for _, commit := range lostCommits {
+			lines = append(lines, fmt.Sprintf("%s %s (%s)", commit.Hash, commit.Subject, commit.AuthorName))
+		}
+	}
+
+	return strings.Join(lines, "\n")
 }
 
 func (self *SyncController) forcePushPrompt() string {
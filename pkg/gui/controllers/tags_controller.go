@@ -3,7 +3,10 @@ package controllers
 import (
 	"github.com/jesseduffield/gocui"
 	"github.com/jesseduffield/lazygit/pkg/commands/models"
+	"github.com/jesseduffield/lazygit/pkg/config"
 	"github.com/jesseduffield/lazygit/pkg/gui/context"
+	"github.com/jesseduffield/lazygit/pkg/gui/controllers/helpers"
+	"github.com/jesseduffield/lazygit/pkg/gui/presentation"
 	"github.com/jesseduffield/lazygit/pkg/gui/types"
 	"github.com/jesseduffield/lazygit/pkg/utils"
 )
@@ -42,6 +45,13 @@ func (self *TagsController) GetKeybindings(opts types.KeybindingsOpts) []*types.
 			Handler:     self.withSelectedTag(self.push),
 			Description: self.c.Tr.PushTag,
 		},
+		{
+			Key:               opts.GetKey(opts.Config.Branches.VerifyTag),
+			Handler:           self.withSelectedTag(self.verifySignature),
+			GetDisabledReason: self.getDisabledReasonForVerifySignature,
+			Description:       self.c.Tr.VerifyTagSignature,
+			Tooltip:           self.c.Tr.VerifyTagSignatureTooltip,
+		},
 		{
 			Key:         opts.GetKey(opts.Config.Universal.New),
 			Handler:     self.create,
@@ -67,7 +77,17 @@ func (self *TagsController) GetOnRenderToMain() func() error {
 				task = types.NewRenderStringTask("No tags")
 			} else {
 				cmdObj := self.c.Git().Branch.GetGraphCmdObj(tag.FullRefName())
-				task = types.NewRunCommandTask(cmdObj.GetCmd())
+				annotation, err := self.c.Git().Tag.GetAnnotation(tag.Name)
+				if err != nil {
+					self.c.Log.Error(err)
+				}
+				self.c.Helpers().Tags.LoadSignatureStatus(tag, annotation)
+				if annotation != nil {
+					prefix := presentation.FormatTagAnnotation(tag, annotation, self.c.Tr)
+					task = types.NewRunCommandTaskWithPrefix(cmdObj.GetCmd(), prefix)
+				} else {
+					task = types.NewRunCommandTask(cmdObj.GetCmd())
+				}
 			}
 
 			return self.c.RenderToMainViews(types.RefreshMainOpts{
@@ -81,6 +101,29 @@ func (self *TagsController) GetOnRenderToMain() func() error {
 	}
 }
 
+func (self *TagsController) getDisabledReasonForVerifySignature() *types.DisabledReason {
+	tag := self.context().GetSelected()
+	if tag != nil && tag.SignatureStatus == models.TagSignatureUnsigned {
+		return &types.DisabledReason{Text: self.c.Tr.TagNotSigned}
+	}
+
+	return nil
+}
+
+func (self *TagsController) verifySignature(tag *models.Tag) error {
+	return self.c.WithWaitingStatus(self.c.Tr.VerifyingTagSignature, func(gocui.Task) error {
+		output, status := self.c.Helpers().Tags.VerifySignature(tag.Name)
+		tag.SignatureStatus = status
+
+		if err := self.c.PostRefreshUpdate(self.context()); err != nil {
+			return err
+		}
+
+		title := utils.ResolvePlaceholderString(self.c.Tr.TagSignatureTitle, map[string]string{"tagName": tag.Name})
+		return self.c.Alert(title, output)
+	})
+}
+
 func (self *TagsController) checkout(tag *models.Tag) error {
 	self.c.LogAction(self.c.Tr.Actions.CheckoutTag)
 	if err := self.c.Helpers().Refs.CheckoutRef(tag.FullRefName(), types.CheckoutRefOptions{}); err != nil {
@@ -90,11 +133,21 @@ func (self *TagsController) checkout(tag *models.Tag) error {
 }
 
 func (self *TagsController) localDelete(tag *models.Tag) error {
-	return self.c.WithWaitingStatus(self.c.Tr.DeletingStatus, func(gocui.Task) error {
-		self.c.LogAction(self.c.Tr.Actions.DeleteLocalTag)
-		err := self.c.Git().Tag.LocalDelete(tag.Name)
-		_ = self.c.Refresh(types.RefreshOptions{Mode: types.ASYNC, Scope: []types.RefreshableView{types.COMMITS, types.TAGS}})
-		return err
+	placeholders := map[string]string{"tagName": tag.Name}
+	return self.c.Helpers().ActionConfirmation.Confirm(helpers.ConfirmActionOpts{
+		Action:           config.ConfirmDeleteTag,
+		Title:            utils.ResolvePlaceholderString(self.c.Tr.DeleteTagTitle, placeholders),
+		Prompt:           utils.ResolvePlaceholderString(self.c.Tr.DeleteLocalTagPrompt, placeholders),
+		ConfirmationText: tag.Name,
+		HandleConfirm: func() error {
+			return self.c.WithWaitingStatus(self.c.Tr.DeletingStatus, func(gocui.Task) error {
+				self.c.LogAction(self.c.Tr.Actions.DeleteLocalTag)
+				err := self.c.Git().Tag.LocalDelete(tag.Name)
+				self.c.Helpers().Tags.ForgetSignature(tag.Name)
+				_ = self.c.Refresh(types.RefreshOptions{Mode: types.ASYNC, Scope: []types.RefreshableView{types.COMMITS, types.TAGS}})
+				return err
+			})
+		},
 	})
 }
 
@@ -125,9 +178,11 @@ func (self *TagsController) remoteDelete(tag *models.Tag) error {
 				},
 			)
 
-			return self.c.Confirm(types.ConfirmOpts{
-				Title:  confirmTitle,
-				Prompt: confirmPrompt,
+			return self.c.Helpers().ActionConfirmation.Confirm(helpers.ConfirmActionOpts{
+				Action:           config.ConfirmDeleteRemoteTag,
+				Title:            confirmTitle,
+				Prompt:           confirmPrompt,
+				ConfirmationText: tag.Name,
 				HandleConfirm: func() error {
 					return self.c.WithInlineStatus(tag, types.ItemOperationDeleting, context.TAGS_CONTEXT_KEY, func(task gocui.Task) error {
 						self.c.LogAction(self.c.Tr.Actions.DeleteRemoteTag)
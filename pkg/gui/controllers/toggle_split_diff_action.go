@@ -0,0 +1,35 @@
+package controllers
+
+import (
+	"github.com/jesseduffield/lazygit/pkg/gui/context"
+	"github.com/jesseduffield/lazygit/pkg/gui/types"
+)
+
+type ToggleSplitDiffAction struct {
+	c *ControllerCommon
+}
+
+func (self *ToggleSplitDiffAction) Call() error {
+	self.c.GetAppState().SplitDiffView = !self.c.GetAppState().SplitDiffView
+	self.c.SaveAppStateAndLogError()
+
+	if self.c.GetAppState().SplitDiffView && self.usingPager() {
+		// the staging and patch building views are still split, but we can't
+		// split what a pager has already laid out
+		self.c.Toast(self.c.Tr.SplitDiffViewWithPager)
+	}
+
+	switch self.c.CurrentStaticContext().GetKey() {
+	case context.PATCH_BUILDING_MAIN_CONTEXT_KEY:
+		return self.c.Refresh(types.RefreshOptions{Scope: []types.RefreshableView{types.PATCH_BUILDING}})
+	case context.STAGING_MAIN_CONTEXT_KEY, context.STAGING_SECONDARY_CONTEXT_KEY:
+		return self.c.Refresh(types.RefreshOptions{Scope: []types.RefreshableView{types.STAGING}})
+	default:
+		return self.c.CurrentSideContext().HandleFocus(types.OnFocusOpts{})
+	}
+}
+
+func (self *ToggleSplitDiffAction) usingPager() bool {
+	width, _ := self.c.Views().Main.Size()
+	return self.c.Git().Config.GetPager(width) != "" || self.c.UserConfig.Git.Paging.ExternalDiffCommand != ""
+}
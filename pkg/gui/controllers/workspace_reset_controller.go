@@ -8,8 +8,11 @@ import (
 	"time"
 
 	"github.com/jesseduffield/gocui"
+	"github.com/jesseduffield/lazygit/pkg/commands/models"
+	"github.com/jesseduffield/lazygit/pkg/gui/controllers/helpers"
 	"github.com/jesseduffield/lazygit/pkg/gui/style"
 	"github.com/jesseduffield/lazygit/pkg/gui/types"
+	"github.com/jesseduffield/lazygit/pkg/utils"
 )
 
 // this is in its own file given that the workspace controller file is already quite long
@@ -29,7 +32,14 @@ func (self *FilesController) createResetMenu() error {
 				red.Sprint(nukeStr),
 			},
 			OnPress: func() error {
+				if self.c.Helpers().DryRun.IsEnabled() {
+					return self.showResetDryRun(true, true)
+				}
+
 				self.c.LogAction(self.c.Tr.Actions.NukeWorkingTree)
+				if err := self.trashModelFiles(func(*models.File) bool { return true }); err != nil {
+					return err
+				}
 				if err := self.c.Git().WorkingTree.ResetAndClean(); err != nil {
 					return self.c.Error(err)
 				}
@@ -52,6 +62,9 @@ func (self *FilesController) createResetMenu() error {
 			},
 			OnPress: func() error {
 				self.c.LogAction(self.c.Tr.Actions.DiscardUnstagedFileChanges)
+				if err := self.trashModelFiles(func(file *models.File) bool { return file.Tracked && file.HasUnstagedChanges }); err != nil {
+					return err
+				}
 				if err := self.c.Git().WorkingTree.DiscardAnyUnstagedFileChanges(); err != nil {
 					return self.c.Error(err)
 				}
@@ -68,7 +81,14 @@ func (self *FilesController) createResetMenu() error {
 				red.Sprint("git clean -fd"),
 			},
 			OnPress: func() error {
+				if self.c.Helpers().DryRun.IsEnabled() {
+					return self.showResetDryRun(false, true)
+				}
+
 				self.c.LogAction(self.c.Tr.Actions.RemoveUntrackedFiles)
+				if err := self.trashModelFiles(func(file *models.File) bool { return !file.Tracked }); err != nil {
+					return err
+				}
 				if err := self.c.Git().WorkingTree.RemoveUntrackedFiles(); err != nil {
 					return self.c.Error(err)
 				}
@@ -143,6 +163,10 @@ func (self *FilesController) createResetMenu() error {
 				red.Sprint("git reset --hard HEAD"),
 			},
 			OnPress: func() error {
+				if self.c.Helpers().DryRun.IsEnabled() {
+					return self.showResetDryRun(true, false)
+				}
+
 				self.c.LogAction(self.c.Tr.Actions.HardReset)
 				if err := self.c.Git().WorkingTree.ResetHard("HEAD"); err != nil {
 					return self.c.Error(err)
@@ -159,6 +183,48 @@ func (self *FilesController) createResetMenu() error {
 	return self.c.Menu(types.CreateMenuOptions{Title: "", Items: menuItems})
 }
 
+// shows what a hard reset and/or a clean of the working tree would do, in
+// place of doing it
+func (self *FilesController) showResetDryRun(hardReset bool, clean bool) error {
+	dryRunHelper := self.c.Helpers().DryRun
+	preview := helpers.DryRunPreview{}
+
+	if hardReset {
+		preview.CmdObjs = append(preview.CmdObjs, self.c.Git().WorkingTree.ResetHardCmdObj("HEAD"))
+		preview.Affected = append(preview.Affected, dryRunHelper.HardResetAffected("HEAD")...)
+	}
+
+	if clean {
+		preview.CmdObjs = append(preview.CmdObjs, self.c.Git().WorkingTree.RemoveUntrackedFilesCmdObj())
+		affected, err := dryRunHelper.CleanAffected()
+		if err != nil {
+			return self.c.Error(err)
+		}
+		preview.Affected = append(preview.Affected, affected...)
+	}
+
+	// nuking the working tree resets submodules too
+	if hardReset && clean {
+		for _, submodule := range self.c.Model().Submodules {
+			preview.Affected = append(preview.Affected, utils.ResolvePlaceholderString(self.c.Tr.DryRunSubmoduleReset, map[string]string{
+				"path": submodule.Path,
+			}))
+		}
+	}
+
+	return dryRunHelper.Show(preview)
+}
+
+// keeps copies of the files about to be discarded in the trash, going by the
+// model so that files in collapsed directories are included too
+func (self *FilesController) trashModelFiles(test func(*models.File) bool) error {
+	if err := self.c.Helpers().WorkingTree.TrashFiles(namesOfFiles(self.c.Model().Files, test)); err != nil {
+		return self.c.Error(err)
+	}
+
+	return nil
+}
+
 func (self *FilesController) animateExplosion() {
 	self.Explode(self.c.Views().Files, func() {
 		err := self.c.PostRefreshUpdate(self.c.Contexts().Files)
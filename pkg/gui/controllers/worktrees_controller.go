@@ -9,6 +9,8 @@ import (
 	"github.com/jesseduffield/lazygit/pkg/gui/context"
 	"github.com/jesseduffield/lazygit/pkg/gui/style"
 	"github.com/jesseduffield/lazygit/pkg/gui/types"
+	"github.com/jesseduffield/lazygit/pkg/utils"
+	"github.com/samber/lo"
 )
 
 type WorktreesController struct {
@@ -49,11 +51,30 @@ func (self *WorktreesController) GetKeybindings(opts types.KeybindingsOpts) []*t
 			Handler:     self.checkSelected(self.open),
 			Description: self.c.Tr.OpenInEditor,
 		},
+		{
+			Key:               opts.GetKey(opts.Config.Worktrees.OpenInTerminal),
+			Handler:           self.checkSelected(self.openInTerminal),
+			GetDisabledReason: self.getDisabledReasonForOpenInTerminal,
+			Description:       self.c.Tr.OpenWorktreeInTerminal,
+			Tooltip:           self.c.Tr.OpenWorktreeInTerminalTooltip,
+		},
 		{
 			Key:         opts.GetKey(opts.Config.Universal.Remove),
 			Handler:     self.checkSelected(self.remove),
 			Description: self.c.Tr.RemoveWorktree,
 		},
+		{
+			Key:         opts.GetKey(opts.Config.Worktrees.PruneWorktrees),
+			Handler:     self.prune,
+			Description: self.c.Tr.PruneWorktrees,
+			Tooltip:     self.c.Tr.PruneWorktreesTooltip,
+		},
+		{
+			Key:         opts.GetKey(opts.Config.Worktrees.RepairWorktree),
+			Handler:     self.checkSelected(self.repair),
+			Description: self.c.Tr.RepairWorktree,
+			Tooltip:     self.c.Tr.RepairWorktreeTooltip,
+		},
 	}
 
 	return bindings
@@ -81,6 +102,12 @@ func (self *WorktreesController) GetOnRenderToMain() func() error {
 			_, _ = fmt.Fprintf(w, "%s:\t%s%s\n", self.c.Tr.Name, style.FgGreen.Sprint(worktree.Name), main)
 			_, _ = fmt.Fprintf(w, "%s:\t%s\n", self.c.Tr.Branch, style.FgYellow.Sprint(worktree.Branch))
 			_, _ = fmt.Fprintf(w, "%s:\t%s%s\n", self.c.Tr.Path, style.FgCyan.Sprint(worktree.Path), missing)
+			_, _ = fmt.Fprintf(w, "%s:\t%s\n", self.c.Tr.WorktreeLocked, self.lockStatus(worktree))
+			if !worktree.IsPathMissing {
+				dirtyState, diskUsage := self.stats(worktree)
+				_, _ = fmt.Fprintf(w, "%s:\t%s\n", self.c.Tr.WorktreeChanges, dirtyState)
+				_, _ = fmt.Fprintf(w, "%s:\t%s\n", self.c.Tr.WorktreeDiskUsage, diskUsage)
+			}
 			_ = w.Flush()
 
 			task = types.NewRenderStringTask(builder.String())
@@ -96,6 +123,45 @@ func (self *WorktreesController) GetOnRenderToMain() func() error {
 	}
 }
 
+func (self *WorktreesController) lockStatus(worktree *models.Worktree) string {
+	if !worktree.IsLocked {
+		return self.c.Tr.WorktreeNotLocked
+	}
+	if worktree.LockReason == "" {
+		return style.FgYellow.Sprint(self.c.Tr.WorktreeIsLocked)
+	}
+	return style.FgYellow.Sprintf("%s (%s)", self.c.Tr.WorktreeIsLocked, worktree.LockReason)
+}
+
+// stats returns the worktree's dirty state and disk usage, which are worked out
+// in the background, so they may not be known yet
+func (self *WorktreesController) stats(worktree *models.Worktree) (string, string) {
+	stats, ok := self.c.Helpers().Worktree.GetStats(worktree)
+	if !ok {
+		return self.c.Tr.CalculatingWorktreeStats, self.c.Tr.CalculatingWorktreeStats
+	}
+
+	dirtyState := style.FgGreen.Sprint(self.c.Tr.WorktreeClean)
+	if stats.IsDirtyErr != nil {
+		dirtyState = formatStatsError(stats.IsDirtyErr)
+	} else if stats.IsDirty {
+		dirtyState = style.FgYellow.Sprint(self.c.Tr.WorktreeDirty)
+	}
+
+	diskUsage := "~" + utils.FormatByteSize(stats.DiskUsage)
+	if stats.DiskUsageErr != nil {
+		diskUsage = formatStatsError(stats.DiskUsageErr)
+	}
+
+	return dirtyState, diskUsage
+}
+
+// errors from git can span several lines, which would break the layout
+func formatStatsError(err error) string {
+	firstLine, _, _ := strings.Cut(strings.TrimSpace(err.Error()), "\n")
+	return style.FgRed.Sprint(firstLine)
+}
+
 func (self *WorktreesController) add() error {
 	return self.c.Helpers().Worktree.NewWorktree()
 }
@@ -112,6 +178,21 @@ func (self *WorktreesController) remove(worktree *models.Worktree) error {
 	return self.c.Helpers().Worktree.Remove(worktree, false)
 }
 
+func (self *WorktreesController) prune() error {
+	missingWorktrees := lo.Filter(self.c.Model().Worktrees, func(worktree *models.Worktree, _ int) bool {
+		return worktree.IsPathMissing
+	})
+	if len(missingWorktrees) == 0 {
+		return self.c.ErrorMsg(self.c.Tr.NoWorktreesToPrune)
+	}
+
+	return self.c.Helpers().Worktree.Prune(missingWorktrees)
+}
+
+func (self *WorktreesController) repair(worktree *models.Worktree) error {
+	return self.c.Helpers().Worktree.Repair(worktree)
+}
+
 func (self *WorktreesController) GetOnClick() func() error {
 	return self.checkSelected(self.enter)
 }
@@ -124,6 +205,27 @@ func (self *WorktreesController) open(worktree *models.Worktree) error {
 	return self.c.Helpers().Files.OpenDirInEditor(worktree.Path)
 }
 
+func (self *WorktreesController) openInTerminal(worktree *models.Worktree) error {
+	if worktree.IsPathMissing {
+		return self.c.ErrorMsg(self.c.Tr.ErrWorktreeMovedOrRemoved)
+	}
+
+	self.c.LogAction(self.c.Tr.Actions.OpenWorktreeInTerminal)
+	if err := self.c.OS().OpenInTerminal(worktree.Path); err != nil {
+		return self.c.Error(err)
+	}
+
+	return nil
+}
+
+func (self *WorktreesController) getDisabledReasonForOpenInTerminal() *types.DisabledReason {
+	if self.c.UserConfig.OS.OpenInTerminal == "" {
+		return &types.DisabledReason{Text: self.c.Tr.OpenInTerminalNotConfigured}
+	}
+
+	return nil
+}
+
 func (self *WorktreesController) checkSelected(callback func(worktree *models.Worktree) error) func() error {
 	return func() error {
 		worktree := self.context().GetSelected()
@@ -32,6 +32,10 @@ func (gui *Gui) handleCreateExtrasMenuPanel() error {
 				Label:   gui.c.Tr.FocusCommandLog,
 				OnPress: gui.handleFocusCommandLog,
 			},
+			{
+				Label:   gui.c.Tr.ShowKeybindingConflicts,
+				OnPress: gui.helpers.KeybindingConflicts.ShowConflicts,
+			},
 		},
 	})
 }
@@ -43,24 +47,8 @@ func (gui *Gui) handleFocusCommandLog() error {
 	return gui.c.PushContext(gui.State.Contexts.CommandLog)
 }
 
-func (gui *Gui) scrollUpExtra() error {
-	gui.Views.Extras.Autoscroll = false
-
-	gui.scrollUpView(gui.Views.Extras)
-
-	return nil
-}
-
-func (gui *Gui) scrollDownExtra() error {
-	gui.Views.Extras.Autoscroll = false
-
-	gui.scrollDownView(gui.Views.Extras)
-
-	return nil
-}
-
 func (gui *Gui) getCmdWriter() io.Writer {
-	return &prefixWriter{writer: gui.Views.Extras, prefix: style.FgMagenta.Sprintf("\n\n%s\n", gui.c.Tr.GitOutput)}
+	return &prefixWriter{writer: &commandLogWriter{gui: gui}, prefix: style.FgMagenta.Sprintf("\n\n%s\n", gui.c.Tr.GitOutput)}
 }
 
 // Ensures that the first write is preceded by writing a prefix.
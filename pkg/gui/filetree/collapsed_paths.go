@@ -1,6 +1,10 @@
 package filetree
 
-import "github.com/jesseduffield/generics/set"
+import (
+	"sort"
+
+	"github.com/jesseduffield/generics/set"
+)
 
 type CollapsedPaths struct {
 	collapsedPaths *set.Set[string]
@@ -36,3 +40,32 @@ func (self *CollapsedPaths) ToggleCollapsed(path string) {
 		self.collapsedPaths.Add(path)
 	}
 }
+
+func (self *CollapsedPaths) CollapseAll(paths []string) {
+	for _, path := range paths {
+		self.collapsedPaths.Add(path)
+	}
+}
+
+func (self *CollapsedPaths) ExpandAll() {
+	self.collapsedPaths = set.New[string]()
+}
+
+// CollapseToDepth expands the directories above the given depth and collapses
+// the rest, so that only that many levels of the tree are shown. The directory
+// paths are grouped by depth, with the top level directories at depth 1.
+func (self *CollapsedPaths) CollapseToDepth(dirPathsByDepth [][]string, depth int) {
+	self.ExpandAll()
+	for i := depth - 1; i < len(dirPathsByDepth); i++ {
+		if i >= 0 {
+			self.CollapseAll(dirPathsByDepth[i])
+		}
+	}
+}
+
+// Paths returns the collapsed paths in sorted order
+func (self *CollapsedPaths) Paths() []string {
+	paths := self.collapsedPaths.ToSlice()
+	sort.Strings(paths)
+	return paths
+}
@@ -0,0 +1,85 @@
+package filetree
+
+import (
+	"testing"
+
+	"github.com/jesseduffield/lazygit/pkg/commands/models"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCollapseToDepth(t *testing.T) {
+	files := []*models.File{
+		{Name: "a/b/c/file1"},
+		{Name: "a/b/d/file2"},
+		{Name: "a/file3"},
+		{Name: "e/file4"},
+		{Name: "file5"},
+	}
+
+	scenarios := []struct {
+		name     string
+		apply    func(viewModel *FileTreeViewModel)
+		expected []string
+	}{
+		{
+			name:     "collapse all",
+			apply:    func(viewModel *FileTreeViewModel) { viewModel.CollapseAll() },
+			expected: []string{"a", "e", "file5"},
+		},
+		{
+			name:     "collapse to depth 2",
+			apply:    func(viewModel *FileTreeViewModel) { viewModel.CollapseToDepth(2) },
+			expected: []string{"a", "a/b", "a/file3", "e", "e/file4", "file5"},
+		},
+		{
+			name: "expand all",
+			apply: func(viewModel *FileTreeViewModel) {
+				viewModel.CollapseAll()
+				viewModel.ExpandAll()
+			},
+			expected: []string{"a", "a/b", "a/b/c", "a/b/c/file1", "a/b/d", "a/b/d/file2", "a/file3", "e", "e/file4", "file5"},
+		},
+	}
+
+	for _, s := range scenarios {
+		s := s
+		t.Run(s.name, func(t *testing.T) {
+			viewModel := NewFileTreeViewModel(func() []*models.File { return files }, nil, true, false)
+			viewModel.SetTree()
+			assert.Equal(t, 3, viewModel.MaxDepth())
+
+			s.apply(viewModel)
+
+			paths := lo.Map(viewModel.GetAllItems(), func(node *FileNode, _ int) string {
+				return node.GetPath()
+			})
+			assert.Equal(t, s.expected, paths)
+		})
+	}
+}
+
+func TestCollapseKeepsSelectionVisible(t *testing.T) {
+	files := []*models.File{
+		{Name: "a/b/file1"},
+		{Name: "a/file2"},
+		{Name: "c/file3"},
+	}
+	viewModel := NewFileTreeViewModel(func() []*models.File { return files }, nil, true, false)
+	viewModel.SetTree()
+
+	// a/b/file1 is selected, so after collapsing the tree we select the
+	// directory it's hidden in
+	viewModel.SetSelectedLineIdx(2)
+	viewModel.CollapseToDepth(2)
+	assert.Equal(t, "a/b", viewModel.GetSelectedPath())
+
+	viewModel.CollapseAll()
+	assert.Equal(t, "a", viewModel.GetSelectedPath())
+
+	// a visible selection stays where it is
+	viewModel.ExpandAll()
+	viewModel.SetSelectedLineIdx(5)
+	viewModel.CollapseToDepth(2)
+	assert.Equal(t, "c/file3", viewModel.GetSelectedPath())
+}
@@ -99,6 +99,32 @@ func (self *CommitFileTree) GetRoot() *CommitFileNode {
 	return NewCommitFileNode(self.tree)
 }
 
+func (self *CommitFileTree) CollapseAll() {
+	self.CollapseToDepth(1)
+}
+
+func (self *CommitFileTree) ExpandAll() {
+	self.collapsedPaths.ExpandAll()
+}
+
+func (self *CommitFileTree) CollapseToDepth(depth int) {
+	self.collapsedPaths.CollapseToDepth(self.tree.GetDirPathsByDepth(), depth)
+}
+
+// the number of levels of directories in the tree
+func (self *CommitFileTree) MaxDepth() int {
+	return len(self.tree.GetDirPathsByDepth())
+}
+
+func (self *CommitFileTree) GetVisibleIndexForPath(path string) (int, bool) {
+	if self.tree == nil {
+		return -1, false
+	}
+
+	index, found := self.tree.GetVisibleIndexForPath(path, self.collapsedPaths)
+	return index - 1, found
+}
+
 func (self *CommitFileTree) CollapsedPaths() *CollapsedPaths {
 	return self.collapsedPaths
 }
@@ -6,6 +6,7 @@ import (
 	"github.com/jesseduffield/lazygit/pkg/commands/models"
 	"github.com/jesseduffield/lazygit/pkg/gui/context/traits"
 	"github.com/jesseduffield/lazygit/pkg/gui/types"
+	"github.com/samber/lo"
 	"github.com/sirupsen/logrus"
 )
 
@@ -23,6 +24,7 @@ type CommitFileTreeViewModel struct {
 	sync.RWMutex
 	ICommitFileTree
 	types.IListCursor
+	*traits.RangeSelect
 
 	// this is e.g. the commit for which we're viewing the files
 	ref types.Ref
@@ -40,6 +42,7 @@ func NewCommitFileTreeViewModel(getFiles func() []*models.CommitFile, log *logru
 	return &CommitFileTreeViewModel{
 		ICommitFileTree: fileTree,
 		IListCursor:     listCursor,
+		RangeSelect:     traits.NewRangeSelect(listCursor),
 		ref:             nil,
 		canRebase:       false,
 	}
@@ -87,6 +90,46 @@ func (self *CommitFileTreeViewModel) GetSelectedPath() string {
 	return node.GetPath()
 }
 
+// duplicated from file_tree_view_model.go
+func (self *CommitFileTreeViewModel) GetSelectedNodes() []*CommitFileNode {
+	if self.Len() == 0 {
+		return nil
+	}
+
+	startIdx, endIdx := self.GetSelectionRange()
+	nodes := make([]*CommitFileNode, 0, endIdx-startIdx+1)
+	for i := startIdx; i <= endIdx; i++ {
+		// the tree may not have been built yet
+		if node := self.Get(i); node != nil {
+			nodes = append(nodes, node)
+		}
+	}
+
+	return nodes
+}
+
+func (self *CommitFileTreeViewModel) GetSelectedFiles() []*models.CommitFile {
+	seen := map[*models.CommitFile]bool{}
+	files := []*models.CommitFile{}
+	for _, node := range self.GetSelectedNodes() {
+		_ = node.ForEachFile(func(file *models.CommitFile) error {
+			if !seen[file] {
+				seen[file] = true
+				files = append(files, file)
+			}
+			return nil
+		})
+	}
+
+	return files
+}
+
+func (self *CommitFileTreeViewModel) GetSelectedPaths() []string {
+	return lo.Map(self.GetSelectedNodes(), func(node *CommitFileNode, _ int) string {
+		return node.GetPath()
+	})
+}
+
 // duplicated from file_tree_view_model.go. Generics will help here
 func (self *CommitFileTreeViewModel) ToggleShowTree() {
 	selectedNode := self.GetSelected()
@@ -109,3 +152,31 @@ func (self *CommitFileTreeViewModel) ToggleShowTree() {
 		self.SetSelectedLineIdx(index)
 	}
 }
+
+func (self *CommitFileTreeViewModel) CollapseAll() {
+	self.keepingSelection(self.ICommitFileTree.CollapseAll)
+}
+
+func (self *CommitFileTreeViewModel) ExpandAll() {
+	self.keepingSelection(self.ICommitFileTree.ExpandAll)
+}
+
+func (self *CommitFileTreeViewModel) CollapseToDepth(depth int) {
+	self.keepingSelection(func() { self.ICommitFileTree.CollapseToDepth(depth) })
+}
+
+// duplicated from file_tree_view_model.go
+func (self *CommitFileTreeViewModel) keepingSelection(f func()) {
+	selectedNode := self.GetSelected()
+
+	f()
+
+	if selectedNode == nil {
+		return
+	}
+
+	index, found := self.GetVisibleIndexForPath(selectedNode.GetPath())
+	if found {
+		self.SetSelectedLineIdx(index)
+	}
+}
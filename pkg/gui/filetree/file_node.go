@@ -47,6 +47,16 @@ func (self *FileNode) GetHasInlineMergeConflicts() bool {
 	return self.SomeFile(func(file *models.File) bool { return file.HasInlineMergeConflicts })
 }
 
+// the number of conflicts left in the file, or in all files of the directory
+func (self *FileNode) GetConflictCount() int {
+	count := 0
+	_ = self.ForEachFile(func(file *models.File) error {
+		count += file.ConflictCount
+		return nil
+	})
+	return count
+}
+
 func (self *FileNode) GetIsTracked() bool {
 	return self.SomeFile(func(file *models.File) bool { return file.Tracked })
 }
@@ -0,0 +1,95 @@
+package filetree
+
+import (
+	"strings"
+
+	"github.com/jesseduffield/lazygit/pkg/commands/models"
+	"github.com/samber/lo"
+)
+
+// When grouping by change type, the files are split into sections, each shown
+// under a collapsible header. Every file appears in exactly one section, so a
+// file with both staged and unstaged changes is listed under 'Unstaged' given
+// it still needs attention.
+type FileSection string
+
+const (
+	SectionConflicts FileSection = "conflicts"
+	SectionStaged    FileSection = "staged"
+	SectionUnstaged  FileSection = "unstaged"
+	SectionUntracked FileSection = "untracked"
+)
+
+// the order in which the sections are shown
+var FileSections = []FileSection{SectionConflicts, SectionStaged, SectionUnstaged, SectionUntracked}
+
+// Section headers are directory nodes whose path can't clash with that of a
+// real file, given git paths are always relative. Having a path means they
+// can be collapsed and selected like any other node.
+const sectionPathPrefix = "/"
+
+func SectionPath(section FileSection) string {
+	return sectionPathPrefix + string(section)
+}
+
+func SectionForFile(file *models.File) FileSection {
+	switch {
+	case file.HasMergeConflicts:
+		return SectionConflicts
+	case !file.Tracked:
+		return SectionUntracked
+	case file.HasStagedChanges && !file.HasUnstagedChanges:
+		return SectionStaged
+	default:
+		return SectionUnstaged
+	}
+}
+
+// BuildGroupedTreeFromFiles returns a tree whose top-level nodes are the
+// headers of the sections that have any files, each containing either a tree
+// or a flat list of its files, sorted by the given order
+func BuildGroupedTreeFromFiles(files []*models.File, showTree bool, sortOrder FileSortOrder) *Node[models.File] {
+	filesBySection := lo.GroupBy(files, func(file *models.File) FileSection {
+		return SectionForFile(file)
+	})
+
+	root := &Node[models.File]{}
+	for _, section := range FileSections {
+		sectionFiles, ok := filesBySection[section]
+		if !ok {
+			continue
+		}
+
+		var sectionRoot *Node[models.File]
+		if showTree {
+			sectionRoot = BuildTreeFromFiles(sectionFiles)
+		} else {
+			sectionRoot = BuildFlatTreeFromFiles(sectionFiles)
+		}
+		SortFileTree(sectionRoot, sortOrder)
+
+		root.Children = append(root.Children, &Node[models.File]{
+			Path:     SectionPath(section),
+			Children: sectionRoot.Children,
+			// the header isn't part of the paths of the files below it, so they
+			// are rendered as though they were at the top level
+			CompressionLevel: -1,
+		})
+	}
+
+	return root
+}
+
+// returns the section that the node is the header of, if it is one
+func (self *FileNode) GetSection() (FileSection, bool) {
+	if self == nil || self.File != nil || !strings.HasPrefix(self.Path, sectionPathPrefix) {
+		return "", false
+	}
+
+	return FileSection(strings.TrimPrefix(self.Path, sectionPathPrefix)), true
+}
+
+func (self *FileNode) IsSectionHeader() bool {
+	_, ok := self.GetSection()
+	return ok
+}
@@ -0,0 +1,143 @@
+package filetree
+
+import (
+	"testing"
+
+	"github.com/jesseduffield/lazygit/pkg/commands/models"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSectionForFile(t *testing.T) {
+	scenarios := []struct {
+		name     string
+		file     *models.File
+		expected FileSection
+	}{
+		{
+			name:     "conflicted",
+			file:     &models.File{HasMergeConflicts: true, HasUnstagedChanges: true, Tracked: true},
+			expected: SectionConflicts,
+		},
+		{
+			name:     "untracked",
+			file:     &models.File{HasUnstagedChanges: true},
+			expected: SectionUntracked,
+		},
+		{
+			name:     "added",
+			file:     &models.File{HasStagedChanges: true, Tracked: true},
+			expected: SectionStaged,
+		},
+		{
+			name:     "partially staged",
+			file:     &models.File{HasStagedChanges: true, HasUnstagedChanges: true, Tracked: true},
+			expected: SectionUnstaged,
+		},
+		{
+			name:     "unstaged",
+			file:     &models.File{HasUnstagedChanges: true, Tracked: true},
+			expected: SectionUnstaged,
+		},
+	}
+
+	for _, s := range scenarios {
+		s := s
+		t.Run(s.name, func(t *testing.T) {
+			assert.Equal(t, s.expected, SectionForFile(s.file))
+		})
+	}
+}
+
+func TestBuildGroupedTreeFromFiles(t *testing.T) {
+	files := []*models.File{
+		{Name: "dir/b", HasUnstagedChanges: true, Tracked: true, LinesAdded: 1},
+		{Name: "dir/a", HasStagedChanges: true, Tracked: true},
+		{Name: "c", HasUnstagedChanges: true, Tracked: true, LinesAdded: 5},
+		{Name: "d", HasUnstagedChanges: true},
+	}
+
+	scenarios := []struct {
+		name      string
+		showTree  bool
+		sortOrder FileSortOrder
+		expected  []string
+	}{
+		{
+			name:      "tree",
+			showTree:  true,
+			sortOrder: SortByName,
+			expected:  []string{"/staged", "dir", "dir/a", "/unstaged", "dir", "dir/b", "c", "/untracked", "d"},
+		},
+		{
+			name:      "flat",
+			showTree:  false,
+			sortOrder: SortByName,
+			expected:  []string{"/staged", "dir/a", "/unstaged", "dir/b", "c", "/untracked", "d"},
+		},
+		{
+			// the sections keep their order, only their contents are sorted
+			name:      "sorted by changed lines",
+			showTree:  false,
+			sortOrder: SortByChangedLines,
+			expected:  []string{"/staged", "dir/a", "/unstaged", "c", "dir/b", "/untracked", "d"},
+		},
+	}
+
+	for _, s := range scenarios {
+		s := s
+		t.Run(s.name, func(t *testing.T) {
+			root := BuildGroupedTreeFromFiles(files, s.showTree, s.sortOrder)
+			paths := lo.Map(root.Flatten(NewCollapsedPaths())[1:], func(node *Node[models.File], _ int) string {
+				return node.Path
+			})
+			assert.Equal(t, s.expected, paths)
+		})
+	}
+}
+
+func TestToggleGroupByChangeType(t *testing.T) {
+	files := []*models.File{
+		{Name: "a", HasStagedChanges: true, Tracked: true},
+		{Name: "b", HasUnstagedChanges: true, Tracked: true},
+	}
+	viewModel := NewFileTreeViewModel(func() []*models.File { return files }, nil, false, false)
+	viewModel.SetTree()
+	viewModel.SetSelectedLineIdx(1)
+
+	viewModel.ToggleGroupByChangeType()
+	assert.True(t, viewModel.InGroupedMode())
+	assert.Equal(t, "b", viewModel.GetSelected().Path)
+
+	// a file in a collapsed section is revealed when selecting it
+	viewModel.ToggleCollapsed(SectionPath(SectionUnstaged))
+	viewModel.ExpandToPath("b")
+	assert.False(t, viewModel.IsCollapsed(SectionPath(SectionUnstaged)))
+
+	// when we stop grouping with a header selected, we select its first file
+	viewModel.SetSelectedLineIdx(2)
+	assert.True(t, viewModel.GetSelected().IsSectionHeader())
+	assert.Equal(t, "", viewModel.GetSelectedPath())
+	viewModel.ToggleGroupByChangeType()
+	assert.Equal(t, "b", viewModel.GetSelected().Path)
+}
+
+func TestGetSelectedFilesInGroupedMode(t *testing.T) {
+	files := []*models.File{
+		{Name: "dir/a", HasStagedChanges: true, Tracked: true},
+		{Name: "dir/b", HasUnstagedChanges: true, Tracked: true},
+		{Name: "c", HasUnstagedChanges: true, Tracked: true},
+	}
+	viewModel := NewFileTreeViewModel(func() []*models.File { return files }, nil, true, true)
+	viewModel.SetTree()
+
+	// a selected directory or section header stands for all of its files, each
+	// of which is only included once, but a header has no path of its own
+	viewModel.SetSelectedLineIdx(1)
+	viewModel.ToggleRangeSelect()
+	viewModel.SetSelectedLineIdx(3)
+	assert.True(t, viewModel.GetSelected().IsSectionHeader())
+
+	assert.Equal(t, []string{"dir", "dir/a"}, viewModel.GetSelectedPaths())
+	assert.Equal(t, []*models.File{files[0], files[1], files[2]}, viewModel.GetSelectedFiles())
+}
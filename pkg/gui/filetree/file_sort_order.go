@@ -0,0 +1,123 @@
+package filetree
+
+import (
+	"sort"
+	"time"
+
+	"github.com/jesseduffield/lazygit/pkg/commands/models"
+)
+
+type FileSortOrder string
+
+const (
+	SortByName         FileSortOrder = "name"
+	SortByStatus       FileSortOrder = "status"
+	SortByModTime      FileSortOrder = "modified"
+	SortByChangedLines FileSortOrder = "changedLines"
+)
+
+// the order in which the sort orders are cycled through
+var FileSortOrders = []FileSortOrder{SortByName, SortByStatus, SortByModTime, SortByChangedLines}
+
+func (self FileSortOrder) Next() FileSortOrder {
+	for i, sortOrder := range FileSortOrders {
+		if sortOrder == self {
+			return FileSortOrders[(i+1)%len(FileSortOrders)]
+		}
+	}
+
+	return SortByName
+}
+
+// sortKey is what we compare nodes by. For a directory it's derived from the
+// files it contains: the most urgent status, the latest modification time,
+// and the total number of changed lines respectively.
+type sortKey struct {
+	statusRank   int
+	modTime      time.Time
+	changedLines int
+}
+
+// SortFileTree reorders the children of every directory in the tree. It
+// expects the tree to be sorted by name already, and uses that as the
+// tie-breaker.
+func SortFileTree(root *Node[models.File], sortOrder FileSortOrder) {
+	if sortOrder == SortByName || root == nil {
+		return
+	}
+
+	keys := map[*Node[models.File]]sortKey{}
+	computeSortKeys(root, keys)
+
+	var sortChildren func(node *Node[models.File])
+	sortChildren = func(node *Node[models.File]) {
+		sort.SliceStable(node.Children, func(i, j int) bool {
+			return less(keys[node.Children[i]], keys[node.Children[j]], sortOrder)
+		})
+
+		for _, child := range node.Children {
+			sortChildren(child)
+		}
+	}
+	sortChildren(root)
+}
+
+func computeSortKeys(node *Node[models.File], keys map[*Node[models.File]]sortKey) sortKey {
+	if node.IsFile() {
+		key := sortKey{
+			statusRank:   statusRank(node.File),
+			modTime:      node.File.ModTime,
+			changedLines: node.File.LinesAdded + node.File.LinesDeleted,
+		}
+		keys[node] = key
+		return key
+	}
+
+	key := sortKey{statusRank: len(statusRanks)}
+	for _, child := range node.Children {
+		childKey := computeSortKeys(child, keys)
+		if childKey.statusRank < key.statusRank {
+			key.statusRank = childKey.statusRank
+		}
+		if childKey.modTime.After(key.modTime) {
+			key.modTime = childKey.modTime
+		}
+		key.changedLines += childKey.changedLines
+	}
+	keys[node] = key
+	return key
+}
+
+func less(a sortKey, b sortKey, sortOrder FileSortOrder) bool {
+	switch sortOrder {
+	case SortByStatus:
+		return a.statusRank < b.statusRank
+	case SortByModTime:
+		// most recently modified first
+		return a.modTime.After(b.modTime)
+	case SortByChangedLines:
+		// most changed lines first
+		return a.changedLines > b.changedLines
+	default:
+		return false
+	}
+}
+
+// from most to least in need of attention
+var statusRanks = []func(*models.File) bool{
+	func(file *models.File) bool { return file.HasMergeConflicts },
+	func(file *models.File) bool { return file.HasStagedChanges && file.HasUnstagedChanges },
+	func(file *models.File) bool { return file.HasUnstagedChanges && file.Tracked },
+	func(file *models.File) bool { return file.HasStagedChanges },
+}
+
+func statusRank(file *models.File) int {
+	for i, matches := range statusRanks {
+		if matches(file) {
+			return i
+		}
+	}
+
+	// untracked files
+	return len(statusRanks)
+}
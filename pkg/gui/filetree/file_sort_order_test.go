@@ -0,0 +1,74 @@
+package filetree
+
+import (
+	"testing"
+	"time"
+
+	"github.com/jesseduffield/lazygit/pkg/commands/models"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSortFileTree(t *testing.T) {
+	now := time.Now()
+	files := []*models.File{
+		{Name: "dir/a", HasUnstagedChanges: true, Tracked: true, ModTime: now.Add(-3 * time.Hour), LinesAdded: 1},
+		{Name: "dir/b", HasUnstagedChanges: true, ModTime: now.Add(-2 * time.Hour), LinesAdded: 7},
+		{Name: "c", HasMergeConflicts: true, HasUnstagedChanges: true, Tracked: true, ModTime: now.Add(-4 * time.Hour), LinesAdded: 2, LinesDeleted: 3},
+		{Name: "d", HasStagedChanges: true, Tracked: true, ModTime: now.Add(-1 * time.Hour), LinesDeleted: 1},
+	}
+
+	scenarios := []struct {
+		sortOrder         FileSortOrder
+		expectedTree      []string
+		expectedFlatFiles []string
+	}{
+		{
+			sortOrder:         SortByName,
+			expectedTree:      []string{"dir", "dir/a", "dir/b", "c", "d"},
+			expectedFlatFiles: []string{"c", "dir/a", "d", "dir/b"},
+		},
+		{
+			// the directory ranks by its most urgent file, which is less urgent
+			// than the conflict
+			sortOrder:         SortByStatus,
+			expectedTree:      []string{"c", "dir", "dir/a", "dir/b", "d"},
+			expectedFlatFiles: []string{"c", "dir/a", "d", "dir/b"},
+		},
+		{
+			sortOrder:         SortByModTime,
+			expectedTree:      []string{"d", "dir", "dir/b", "dir/a", "c"},
+			expectedFlatFiles: []string{"d", "dir/b", "dir/a", "c"},
+		},
+		{
+			sortOrder:         SortByChangedLines,
+			expectedTree:      []string{"dir", "dir/b", "dir/a", "c", "d"},
+			expectedFlatFiles: []string{"dir/b", "c", "dir/a", "d"},
+		},
+	}
+
+	for _, s := range scenarios {
+		s := s
+		t.Run(string(s.sortOrder), func(t *testing.T) {
+			tree := BuildTreeFromFiles(files)
+			SortFileTree(tree, s.sortOrder)
+			assert.Equal(t, s.expectedTree, paths(tree.Flatten(NewCollapsedPaths())[1:]))
+
+			flatTree := BuildFlatTreeFromFiles(files)
+			SortFileTree(flatTree, s.sortOrder)
+			assert.Equal(t, s.expectedFlatFiles, paths(flatTree.Children))
+		})
+	}
+}
+
+func TestFileSortOrderNext(t *testing.T) {
+	assert.Equal(t, SortByStatus, SortByName.Next())
+	assert.Equal(t, SortByName, SortByChangedLines.Next())
+	assert.Equal(t, SortByName, FileSortOrder("unknown").Next())
+}
+
+func paths(nodes []*Node[models.File]) []string {
+	return lo.Map(nodes, func(node *Node[models.File], _ int) string {
+		return node.Path
+	})
+}
@@ -2,9 +2,11 @@ package filetree
 
 import (
 	"fmt"
+	"strings"
 
 	"github.com/jesseduffield/lazygit/pkg/commands/models"
 	"github.com/jesseduffield/lazygit/pkg/gui/types"
+	"github.com/sahilm/fuzzy"
 	"github.com/samber/lo"
 	"github.com/sirupsen/logrus"
 )
@@ -29,6 +31,11 @@ type ITree[T any] interface {
 	SetTree()
 	IsCollapsed(path string) bool
 	ToggleCollapsed(path string)
+	CollapseAll()
+	ExpandAll()
+	CollapseToDepth(depth int)
+	MaxDepth() int
+	GetVisibleIndexForPath(path string) (int, bool)
 	CollapsedPaths() *CollapsedPaths
 }
 
@@ -41,28 +48,41 @@ type IFileTree interface {
 	GetFile(path string) *models.File
 	GetAllItems() []*FileNode
 	GetAllFiles() []*models.File
-	GetFilter() FileTreeDisplayFilter
+	GetStatusFilter() FileTreeDisplayFilter
+	SetTextFilter(filter string)
+	GetTextFilter() string
+	GetSortOrder() FileSortOrder
+	SetSortOrder(sortOrder FileSortOrder)
 	GetRoot() *FileNode
+	InGroupedMode() bool
+	ToggleGroupByChangeType()
 }
 
 type FileTree struct {
-	getFiles       func() []*models.File
-	tree           *Node[models.File]
-	showTree       bool
-	log            *logrus.Entry
-	filter         FileTreeDisplayFilter
+	getFiles func() []*models.File
+	tree     *Node[models.File]
+	showTree bool
+	log      *logrus.Entry
+	filter   FileTreeDisplayFilter
+	// a fuzzy query that the paths of the displayed files must match
+	textFilter     string
+	sortOrder      FileSortOrder
 	collapsedPaths *CollapsedPaths
+	// if true, the files are split into sections by change type
+	groupByChangeType bool
 }
 
 var _ IFileTree = &FileTree{}
 
-func NewFileTree(getFiles func() []*models.File, log *logrus.Entry, showTree bool) *FileTree {
+func NewFileTree(getFiles func() []*models.File, log *logrus.Entry, showTree bool, groupByChangeType bool) *FileTree {
 	return &FileTree{
-		getFiles:       getFiles,
-		log:            log,
-		showTree:       showTree,
-		filter:         DisplayAll,
-		collapsedPaths: NewCollapsedPaths(),
+		getFiles:          getFiles,
+		log:               log,
+		showTree:          showTree,
+		groupByChangeType: groupByChangeType,
+		filter:            DisplayAll,
+		sortOrder:         SortByName,
+		collapsedPaths:    NewCollapsedPaths(),
 	}
 }
 
@@ -72,9 +92,26 @@ func (self *FileTree) InTreeMode() bool {
 
 func (self *FileTree) ExpandToPath(path string) {
 	self.collapsedPaths.ExpandToPath(path)
+
+	if self.groupByChangeType {
+		// the path may be a directory, with files in several sections
+		for _, file := range self.getFiles() {
+			if file.Name == path || strings.HasPrefix(file.Name, path+"/") {
+				self.collapsedPaths.ExpandToPath(SectionPath(SectionForFile(file)))
+			}
+		}
+	}
+}
+
+func (self *FileTree) InGroupedMode() bool {
+	return self.groupByChangeType
 }
 
 func (self *FileTree) getFilesForDisplay() []*models.File {
+	return self.filterByText(self.getFilesForStatusFilter())
+}
+
+func (self *FileTree) getFilesForStatusFilter() []*models.File {
 	switch self.filter {
 	case DisplayAll:
 		return self.getFiles()
@@ -89,6 +126,22 @@ func (self *FileTree) getFilesForDisplay() []*models.File {
 	}
 }
 
+// we keep the files in their original order rather than ordering them by how
+// well they match, given that the tree gets sorted anyway
+func (self *FileTree) filterByText(files []*models.File) []*models.File {
+	if self.textFilter == "" {
+		return files
+	}
+
+	names := lo.Map(files, func(file *models.File, _ int) string { return file.Name })
+	isMatch := make([]bool, len(files))
+	for _, match := range fuzzy.Find(self.textFilter, names) {
+		isMatch[match.Index] = true
+	}
+
+	return lo.Filter(files, func(_ *models.File, i int) bool { return isMatch[i] })
+}
+
 func (self *FileTree) FilterFiles(test func(*models.File) bool) []*models.File {
 	return lo.Filter(self.getFiles(), func(file *models.File, _ int) bool { return test(file) })
 }
@@ -98,11 +151,25 @@ func (self *FileTree) SetStatusFilter(filter FileTreeDisplayFilter) {
 	self.SetTree()
 }
 
+func (self *FileTree) SetTextFilter(filter string) {
+	self.textFilter = filter
+	self.SetTree()
+}
+
+func (self *FileTree) GetTextFilter() string {
+	return self.textFilter
+}
+
 func (self *FileTree) ToggleShowTree() {
 	self.showTree = !self.showTree
 	self.SetTree()
 }
 
+func (self *FileTree) ToggleGroupByChangeType() {
+	self.groupByChangeType = !self.groupByChangeType
+	self.SetTree()
+}
+
 func (self *FileTree) Get(index int) *FileNode {
 	// need to traverse the tree depth first until we get to the index.
 	return NewFileNode(self.tree.GetNodeAtIndex(index+1, self.collapsedPaths)) // ignoring root
@@ -152,11 +219,17 @@ func (self *FileTree) GetAllFiles() []*models.File {
 
 func (self *FileTree) SetTree() {
 	filesForDisplay := self.getFilesForDisplay()
+	if self.groupByChangeType {
+		self.tree = BuildGroupedTreeFromFiles(filesForDisplay, self.showTree, self.sortOrder)
+		return
+	}
+
 	if self.showTree {
 		self.tree = BuildTreeFromFiles(filesForDisplay)
 	} else {
 		self.tree = BuildFlatTreeFromFiles(filesForDisplay)
 	}
+	SortFileTree(self.tree, self.sortOrder)
 }
 
 func (self *FileTree) IsCollapsed(path string) bool {
@@ -175,10 +248,49 @@ func (self *FileTree) GetRoot() *FileNode {
 	return NewFileNode(self.tree)
 }
 
+func (self *FileTree) CollapseAll() {
+	self.CollapseToDepth(1)
+}
+
+func (self *FileTree) ExpandAll() {
+	self.collapsedPaths.ExpandAll()
+}
+
+func (self *FileTree) CollapseToDepth(depth int) {
+	self.collapsedPaths.CollapseToDepth(self.tree.GetDirPathsByDepth(), depth)
+}
+
+// the number of levels of directories in the tree
+func (self *FileTree) MaxDepth() int {
+	return len(self.tree.GetDirPathsByDepth())
+}
+
+func (self *FileTree) GetVisibleIndexForPath(path string) (int, bool) {
+	if self.tree == nil {
+		return -1, false
+	}
+
+	index, found := self.tree.GetVisibleIndexForPath(path, self.collapsedPaths)
+	return index - 1, found
+}
+
 func (self *FileTree) CollapsedPaths() *CollapsedPaths {
 	return self.collapsedPaths
 }
 
-func (self *FileTree) GetFilter() FileTreeDisplayFilter {
+func (self *FileTree) GetStatusFilter() FileTreeDisplayFilter {
 	return self.filter
 }
+
+func (self *FileTree) GetSortOrder() FileSortOrder {
+	return self.sortOrder
+}
+
+func (self *FileTree) SetSortOrder(sortOrder FileSortOrder) {
+	if sortOrder == self.sortOrder {
+		return
+	}
+
+	self.sortOrder = sortOrder
+	self.SetTree()
+}
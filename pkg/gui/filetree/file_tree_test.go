@@ -4,6 +4,7 @@ import (
 	"testing"
 
 	"github.com/jesseduffield/lazygit/pkg/commands/models"
+	"github.com/samber/lo"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -79,3 +80,52 @@ func TestFilterAction(t *testing.T) {
 		})
 	}
 }
+
+func TestTextFilter(t *testing.T) {
+	files := []*models.File{
+		{Name: "dir1/apple-grape", HasUnstagedChanges: true},
+		{Name: "dir1/apple-orange", HasStagedChanges: true},
+		{Name: "dir2/grape-orange", HasUnstagedChanges: true},
+	}
+
+	scenarios := []struct {
+		name          string
+		statusFilter  FileTreeDisplayFilter
+		textFilter    string
+		expectedPaths []string
+	}{
+		{
+			name:          "no text filter",
+			statusFilter:  DisplayAll,
+			textFilter:    "",
+			expectedPaths: []string{"dir1/apple-grape", "dir1/apple-orange", "dir2/grape-orange"},
+		},
+		{
+			name:          "fuzzy match on the whole path",
+			statusFilter:  DisplayAll,
+			textFilter:    "d1grape",
+			expectedPaths: []string{"dir1/apple-grape"},
+		},
+		{
+			name:          "keeps the original order",
+			statusFilter:  DisplayAll,
+			textFilter:    "orange",
+			expectedPaths: []string{"dir1/apple-orange", "dir2/grape-orange"},
+		},
+		{
+			name:          "combined with the status filter",
+			statusFilter:  DisplayUnstaged,
+			textFilter:    "orange",
+			expectedPaths: []string{"dir2/grape-orange"},
+		},
+	}
+
+	for _, s := range scenarios {
+		s := s
+		t.Run(s.name, func(t *testing.T) {
+			mngr := &FileTree{getFiles: func() []*models.File { return files }, filter: s.statusFilter, textFilter: s.textFilter}
+			result := mngr.getFilesForDisplay()
+			assert.EqualValues(t, s.expectedPaths, lo.Map(result, func(file *models.File, _ int) string { return file.Name }))
+		})
+	}
+}
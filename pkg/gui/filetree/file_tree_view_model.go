@@ -22,16 +22,18 @@ type FileTreeViewModel struct {
 	sync.RWMutex
 	IFileTree
 	types.IListCursor
+	*traits.RangeSelect
 }
 
 var _ IFileTreeViewModel = &FileTreeViewModel{}
 
-func NewFileTreeViewModel(getFiles func() []*models.File, log *logrus.Entry, showTree bool) *FileTreeViewModel {
-	fileTree := NewFileTree(getFiles, log, showTree)
+func NewFileTreeViewModel(getFiles func() []*models.File, log *logrus.Entry, showTree bool, groupByChangeType bool) *FileTreeViewModel {
+	fileTree := NewFileTree(getFiles, log, showTree, groupByChangeType)
 	listCursor := traits.NewListCursor(fileTree)
 	return &FileTreeViewModel{
 		IFileTree:   fileTree,
 		IListCursor: listCursor,
+		RangeSelect: traits.NewRangeSelect(listCursor),
 	}
 }
 
@@ -54,13 +56,62 @@ func (self *FileTreeViewModel) GetSelectedFile() *models.File {
 
 func (self *FileTreeViewModel) GetSelectedPath() string {
 	node := self.GetSelected()
-	if node == nil {
+	// section headers don't correspond to a path in the repo
+	if node == nil || node.IsSectionHeader() {
 		return ""
 	}
 
 	return node.GetPath()
 }
 
+// returns the nodes in the selected range, which is just the selected node
+// when we're not selecting a range
+func (self *FileTreeViewModel) GetSelectedNodes() []*FileNode {
+	if self.Len() == 0 {
+		return nil
+	}
+
+	startIdx, endIdx := self.GetSelectionRange()
+	nodes := make([]*FileNode, 0, endIdx-startIdx+1)
+	for i := startIdx; i <= endIdx; i++ {
+		// the tree may not have been built yet
+		if node := self.Get(i); node != nil {
+			nodes = append(nodes, node)
+		}
+	}
+
+	return nodes
+}
+
+// returns the files within the selected range, including the files within
+// any selected directories
+func (self *FileTreeViewModel) GetSelectedFiles() []*models.File {
+	seen := map[*models.File]bool{}
+	files := []*models.File{}
+	for _, node := range self.GetSelectedNodes() {
+		_ = node.ForEachFile(func(file *models.File) error {
+			if !seen[file] {
+				seen[file] = true
+				files = append(files, file)
+			}
+			return nil
+		})
+	}
+
+	return files
+}
+
+func (self *FileTreeViewModel) GetSelectedPaths() []string {
+	paths := []string{}
+	for _, node := range self.GetSelectedNodes() {
+		if !node.IsSectionHeader() {
+			paths = append(paths, node.GetPath())
+		}
+	}
+
+	return paths
+}
+
 func (self *FileTreeViewModel) SetTree() {
 	newFiles := self.GetAllFiles()
 	selectedNode := self.GetSelected()
@@ -131,6 +182,18 @@ func (self *FileTreeViewModel) SetStatusFilter(filter FileTreeDisplayFilter) {
 	self.IListCursor.SetSelectedLineIdx(0)
 }
 
+// The selection starts over at the top as the filter narrows the tree down, but
+// when the filter is cleared we keep the selected file or directory selected.
+func (self *FileTreeViewModel) SetTextFilter(filter string) {
+	if filter == "" {
+		self.keepingSelection(func() { self.IFileTree.SetTextFilter(filter) })
+	} else {
+		self.IFileTree.SetTextFilter(filter)
+	}
+
+	self.RefreshSelectedIdx()
+}
+
 // If we're going from flat to tree we want to select the same file.
 // If we're going from tree to flat and we have a file selected we want to select that.
 // If instead we've selected a directory we need to select the first file in that directory.
@@ -155,3 +218,54 @@ func (self *FileTreeViewModel) ToggleShowTree() {
 		self.SetSelectedLineIdx(index)
 	}
 }
+
+// We keep the selected file or directory selected. If a section header is
+// selected when we stop grouping, we select the first file in its section.
+func (self *FileTreeViewModel) ToggleGroupByChangeType() {
+	selectedNode := self.GetSelected()
+
+	self.IFileTree.ToggleGroupByChangeType()
+
+	if selectedNode == nil {
+		return
+	}
+	path := selectedNode.Path
+	if selectedNode.IsSectionHeader() {
+		path = selectedNode.GetLeaves()[0].Path
+	}
+
+	self.ExpandToPath(path)
+	index, found := self.GetIndexForPath(path)
+	if found {
+		self.SetSelectedLineIdx(index)
+	}
+}
+
+func (self *FileTreeViewModel) CollapseAll() {
+	self.keepingSelection(self.IFileTree.CollapseAll)
+}
+
+func (self *FileTreeViewModel) ExpandAll() {
+	self.keepingSelection(self.IFileTree.ExpandAll)
+}
+
+func (self *FileTreeViewModel) CollapseToDepth(depth int) {
+	self.keepingSelection(func() { self.IFileTree.CollapseToDepth(depth) })
+}
+
+// The selected node stays selected unless it ends up hidden within a collapsed
+// directory, in which case we select that directory instead.
+func (self *FileTreeViewModel) keepingSelection(f func()) {
+	selectedNode := self.GetSelected()
+
+	f()
+
+	if selectedNode == nil {
+		return
+	}
+
+	index, found := self.GetVisibleIndexForPath(selectedNode.GetPath())
+	if found {
+		self.SetSelectedLineIdx(index)
+	}
+}
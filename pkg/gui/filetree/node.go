@@ -229,6 +229,62 @@ func (self *Node[T]) Size(collapsedPaths *CollapsedPaths) int {
 	return output
 }
 
+// Returns the index of the node with the given path or, if that node is hidden
+// within a collapsed directory, the index of that directory
+func (self *Node[T]) GetVisibleIndexForPath(path string, collapsedPaths *CollapsedPaths) (int, bool) {
+	index := 0
+	node := self
+	for node.GetPath() != path {
+		if collapsedPaths.IsCollapsed(node.GetPath()) {
+			return index, true
+		}
+
+		// skip past the node itself, and then past its children that come
+		// before the one containing the path
+		index++
+		var next *Node[T]
+		for _, child := range node.Children {
+			if child.Some(func(n *Node[T]) bool { return n.GetPath() == path }) {
+				next = child
+				break
+			}
+			index += child.Size(collapsedPaths)
+		}
+		if next == nil {
+			return 0, false
+		}
+		node = next
+	}
+
+	return index, true
+}
+
+// Returns the paths of the directories below this node, grouped by depth with
+// this node's children at index 0
+func (self *Node[T]) GetDirPathsByDepth() [][]string {
+	result := [][]string{}
+	if self == nil {
+		return result
+	}
+
+	var aux func(node *Node[T], depth int)
+	aux = func(node *Node[T], depth int) {
+		for _, child := range node.Children {
+			if child.IsFile() {
+				continue
+			}
+			if len(result) <= depth {
+				result = append(result, []string{})
+			}
+			result[depth] = append(result[depth], child.GetPath())
+			aux(child, depth+1)
+		}
+	}
+	aux(self, 0)
+
+	return result
+}
+
 func (self *Node[T]) Compress() {
 	if self == nil {
 		return
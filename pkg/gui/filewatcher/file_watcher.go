@@ -0,0 +1,310 @@
+package filewatcher
+
+import (
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/fsnotify/fsnotify"
+	"github.com/jesseduffield/generics/set"
+	"github.com/jesseduffield/lazygit/pkg/gui/types"
+	"github.com/jesseduffield/lazygit/pkg/utils"
+	"github.com/samber/lo"
+	"github.com/sirupsen/logrus"
+)
+
+// fsnotify can't watch a directory recursively so we need a watch per
+// directory. Each watch costs a file descriptor on macOS/BSD (plus one per file
+// in the directory), and the number of inotify watches on linux is limited too,
+// so we cap the number of directories we watch. Changes outside the watched
+// directories are still picked up by the periodic refresh.
+const MAX_WATCHED_DIRS = 1000
+
+// Rather than refreshing on every event we gather up the events that arrive
+// within this window, because a single git command or a save from an editor
+// typically touches several files in quick succession.
+const DEBOUNCE_DURATION = 200 * time.Millisecond
+
+type RepoPaths interface {
+	WorktreePath() string
+	WorktreeGitDirPath() string
+	RepoGitDirPath() string
+}
+
+type IgnoreChecker interface {
+	IgnoredDirectories() ([]string, error)
+	IsIgnored(path string) (bool, error)
+}
+
+// Watcher watches a repo's worktree and git dir, and tells its owner which
+// models need refreshing when something changes on disk.
+type Watcher struct {
+	log      *logrus.Entry
+	watcher  *fsnotify.Watcher
+	onChange func(scopes []types.RefreshableView)
+
+	// guards everything below
+	mutex         sync.Mutex
+	worktreePath  string
+	gitDirPaths   []string
+	ignoreChecker IgnoreChecker
+	ignoredDirs   *set.Set[string]
+	watchedCount  int
+	reachedLimit  bool
+}
+
+func New(log *logrus.Entry, onChange func(scopes []types.RefreshableView)) (*Watcher, error) {
+	watcher, err := fsnotify.NewWatcher()
+	if err != nil {
+		return nil, err
+	}
+
+	self := &Watcher{
+		log:         log,
+		watcher:     watcher,
+		onChange:    onChange,
+		ignoredDirs: set.New[string](),
+	}
+
+	go utils.Safe(self.loop)
+
+	return self, nil
+}
+
+// Watch drops any directories watched for a previous repo and starts watching
+// the given one. It walks the worktree so it's best called off the UI thread.
+func (self *Watcher) Watch(repoPaths RepoPaths, ignoreChecker IgnoreChecker) {
+	self.mutex.Lock()
+	defer self.mutex.Unlock()
+
+	for _, path := range self.watcher.WatchList() {
+		_ = self.watcher.Remove(path)
+	}
+	self.watchedCount = 0
+	self.reachedLimit = false
+
+	self.worktreePath = repoPaths.WorktreePath()
+	self.ignoreChecker = ignoreChecker
+	// the worktree's git dir comes first because for linked worktrees it lives
+	// inside the repo's git dir, and we want the most specific match
+	self.gitDirPaths = lo.Uniq([]string{repoPaths.WorktreeGitDirPath(), repoPaths.RepoGitDirPath()})
+
+	ignoredDirs, err := ignoreChecker.IgnoredDirectories()
+	if err != nil {
+		self.log.Errorf("Failed to get ignored directories: %v", err)
+	}
+	self.ignoredDirs = set.NewFromSlice(lo.Map(ignoredDirs, func(dir string, _ int) string {
+		return filepath.Join(self.worktreePath, dir)
+	}))
+
+	for _, gitDirPath := range self.gitDirPaths {
+		// we only care about the files directly inside the git dir (e.g. HEAD
+		// and index) and the refs, not the objects
+		self.add(gitDirPath)
+		self.addRecursively(filepath.Join(gitDirPath, "refs"))
+		self.addRecursively(filepath.Join(gitDirPath, "logs"))
+		self.add(filepath.Join(gitDirPath, "worktrees"))
+	}
+
+	self.addRecursively(self.worktreePath)
+
+	self.log.Infof("Watching %d directories for changes", self.watchedCount)
+}
+
+func (self *Watcher) Close() {
+	_ = self.watcher.Close()
+}
+
+func (self *Watcher) loop() {
+	var pendingScopes *set.Set[types.RefreshableView]
+	var debounce <-chan time.Time
+
+	for {
+		select {
+		case event, ok := <-self.watcher.Events:
+			if !ok {
+				return
+			}
+
+			// e.g. a `touch`, or spotlight indexing on macOS: nothing to refresh
+			if event.Op == fsnotify.Chmod {
+				continue
+			}
+
+			scopes := self.handleEvent(event)
+			if len(scopes) == 0 {
+				continue
+			}
+
+			if pendingScopes == nil {
+				pendingScopes = set.New[types.RefreshableView]()
+				debounce = time.After(DEBOUNCE_DURATION)
+			}
+			pendingScopes.Add(scopes...)
+
+		case <-debounce:
+			self.onChange(pendingScopes.ToSlice())
+			pendingScopes = nil
+			debounce = nil
+
+		case err, ok := <-self.watcher.Errors:
+			if !ok {
+				return
+			}
+			self.log.Errorf("File watcher error: %v", err)
+		}
+	}
+}
+
+func (self *Watcher) handleEvent(event fsnotify.Event) []types.RefreshableView {
+	self.mutex.Lock()
+	defer self.mutex.Unlock()
+
+	if event.Has(fsnotify.Create) {
+		self.addNewDir(event.Name)
+	}
+
+	return self.scopesForPath(event.Name)
+}
+
+// newly created directories need watching too, unless they're ignored
+func (self *Watcher) addNewDir(path string) {
+	info, err := os.Stat(path)
+	if err != nil || !info.IsDir() {
+		return
+	}
+
+	for _, gitDirPath := range self.gitDirPaths {
+		if rel, ok := relativePath(gitDirPath, path); ok {
+			if strings.HasPrefix(rel, "refs/") || strings.HasPrefix(rel, "logs/") {
+				self.addRecursively(path)
+			}
+			return
+		}
+	}
+
+	if _, ok := relativePath(self.worktreePath, path); !ok {
+		return
+	}
+
+	ignored, err := self.ignoreChecker.IsIgnored(path)
+	if err != nil {
+		self.log.Errorf("Failed to check whether %s is ignored: %v", path, err)
+	}
+	if ignored {
+		self.ignoredDirs.Add(path)
+		return
+	}
+
+	self.addRecursively(path)
+}
+
+func (self *Watcher) addRecursively(root string) {
+	_ = filepath.WalkDir(root, func(path string, entry fs.DirEntry, err error) error {
+		// the directory may have been removed in the meantime
+		if err != nil || !entry.IsDir() {
+			return nil
+		}
+
+		if path != root && (entry.Name() == ".git" || self.ignoredDirs.Includes(path)) {
+			return filepath.SkipDir
+		}
+
+		if !self.add(path) {
+			return filepath.SkipAll
+		}
+
+		return nil
+	})
+}
+
+// returns false once we've hit the limit of watched directories
+func (self *Watcher) add(path string) bool {
+	if self.watchedCount >= MAX_WATCHED_DIRS {
+		if !self.reachedLimit {
+			self.reachedLimit = true
+			self.log.Warnf("Not watching more than %d directories; other changes will be picked up by the periodic refresh", MAX_WATCHED_DIRS)
+		}
+		return false
+	}
+
+	if _, err := os.Stat(path); err != nil {
+		return true
+	}
+
+	if err := self.watcher.Add(path); err != nil {
+		self.log.Errorf("Failed to watch %s: %v", path, err)
+		return true
+	}
+
+	self.watchedCount++
+	return true
+}
+
+func (self *Watcher) scopesForPath(path string) []types.RefreshableView {
+	for _, gitDirPath := range self.gitDirPaths {
+		if rel, ok := relativePath(gitDirPath, path); ok {
+			return scopesForGitDirPath(rel)
+		}
+	}
+
+	if _, ok := relativePath(self.worktreePath, path); ok {
+		return []types.RefreshableView{types.FILES}
+	}
+
+	return nil
+}
+
+// scopesForGitDirPath returns what needs refreshing when the given file in the
+// git dir changes. The path is relative to the git dir, with forward slashes.
+func scopesForGitDirPath(path string) []types.RefreshableView {
+	// git writes to a lock file and then renames it, so we'll get an event for
+	// the real file too
+	if strings.HasSuffix(path, ".lock") {
+		return nil
+	}
+
+	switch path {
+	case "index":
+		return []types.RefreshableView{types.FILES}
+	case "HEAD":
+		return []types.RefreshableView{types.COMMITS, types.BRANCHES, types.FILES, types.STATUS}
+	case "packed-refs":
+		return []types.RefreshableView{types.COMMITS, types.BRANCHES, types.REMOTES, types.TAGS}
+	case "refs/stash", "logs/refs/stash":
+		return []types.RefreshableView{types.STASH}
+	case "logs/HEAD":
+		return []types.RefreshableView{types.REFLOG}
+	case "MERGE_HEAD", "CHERRY_PICK_HEAD", "REVERT_HEAD", "rebase-merge", "rebase-apply":
+		return []types.RefreshableView{types.COMMITS, types.FILES, types.STATUS}
+	case "BISECT_LOG":
+		return []types.RefreshableView{types.COMMITS, types.BISECT_INFO}
+	}
+
+	switch {
+	case strings.HasPrefix(path, "refs/heads/"):
+		return []types.RefreshableView{types.COMMITS, types.BRANCHES}
+	case strings.HasPrefix(path, "refs/remotes/"):
+		// branches show how far they are from their upstream
+		return []types.RefreshableView{types.REMOTES, types.BRANCHES}
+	case strings.HasPrefix(path, "refs/tags/"):
+		return []types.RefreshableView{types.TAGS}
+	case strings.HasPrefix(path, "worktrees/"):
+		return []types.RefreshableView{types.WORKTREES}
+	}
+
+	return nil
+}
+
+// returns the path relative to the given dir, or false if it's not inside it
+func relativePath(dir string, path string) (string, bool) {
+	rel, err := filepath.Rel(dir, path)
+	if err != nil || rel == "." || rel == ".." || strings.HasPrefix(rel, ".."+string(filepath.Separator)) {
+		return "", false
+	}
+
+	return filepath.ToSlash(rel), true
+}
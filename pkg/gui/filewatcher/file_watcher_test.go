@@ -0,0 +1,137 @@
+package filewatcher
+
+import (
+	"os"
+	"path/filepath"
+	"sort"
+	"testing"
+	"time"
+
+	"github.com/jesseduffield/lazygit/pkg/gui/types"
+	"github.com/jesseduffield/lazygit/pkg/utils"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestScopesForGitDirPath(t *testing.T) {
+	scenarios := []struct {
+		path     string
+		expected []types.RefreshableView
+	}{
+		{path: "index", expected: []types.RefreshableView{types.FILES}},
+		{path: "index.lock", expected: nil},
+		{path: "HEAD", expected: []types.RefreshableView{types.COMMITS, types.BRANCHES, types.FILES, types.STATUS}},
+		{path: "refs/heads/feature/one", expected: []types.RefreshableView{types.COMMITS, types.BRANCHES}},
+		{path: "refs/heads/master.lock", expected: nil},
+		{path: "refs/remotes/origin/master", expected: []types.RefreshableView{types.REMOTES, types.BRANCHES}},
+		{path: "refs/tags/v1.0", expected: []types.RefreshableView{types.TAGS}},
+		{path: "refs/stash", expected: []types.RefreshableView{types.STASH}},
+		{path: "logs/refs/stash", expected: []types.RefreshableView{types.STASH}},
+		{path: "logs/HEAD", expected: []types.RefreshableView{types.REFLOG}},
+		{path: "rebase-merge", expected: []types.RefreshableView{types.COMMITS, types.FILES, types.STATUS}},
+		{path: "worktrees/other/HEAD", expected: []types.RefreshableView{types.WORKTREES}},
+		{path: "objects/ab/cdef", expected: nil},
+		{path: "COMMIT_EDITMSG", expected: nil},
+	}
+
+	for _, s := range scenarios {
+		s := s
+		t.Run(s.path, func(t *testing.T) {
+			assert.Equal(t, s.expected, scopesForGitDirPath(s.path))
+		})
+	}
+}
+
+func TestRelativePath(t *testing.T) {
+	scenarios := []struct {
+		dir        string
+		path       string
+		expected   string
+		expectedOk bool
+	}{
+		{dir: "/repo", path: "/repo/dir/file.txt", expected: "dir/file.txt", expectedOk: true},
+		{dir: "/repo", path: "/repo", expected: "", expectedOk: false},
+		{dir: "/repo", path: "/other/file.txt", expected: "", expectedOk: false},
+		{dir: "/repo", path: "/repo2/file.txt", expected: "", expectedOk: false},
+		{dir: "/repo", path: "/repo/..file", expected: "..file", expectedOk: true},
+	}
+
+	for _, s := range scenarios {
+		rel, ok := relativePath(filepath.FromSlash(s.dir), filepath.FromSlash(s.path))
+		assert.Equal(t, s.expected, rel, s.path)
+		assert.Equal(t, s.expectedOk, ok, s.path)
+	}
+}
+
+type fakeRepoPaths struct {
+	worktreePath string
+}
+
+func (self fakeRepoPaths) WorktreePath() string {
+	return self.worktreePath
+}
+
+func (self fakeRepoPaths) WorktreeGitDirPath() string {
+	return filepath.Join(self.worktreePath, ".git")
+}
+
+func (self fakeRepoPaths) RepoGitDirPath() string {
+	return filepath.Join(self.worktreePath, ".git")
+}
+
+type fakeIgnoreChecker struct{}
+
+func (fakeIgnoreChecker) IgnoredDirectories() ([]string, error) {
+	return []string{"node_modules"}, nil
+}
+
+func (fakeIgnoreChecker) IsIgnored(path string) (bool, error) {
+	return filepath.Base(path) == "build", nil
+}
+
+func TestWatcher(t *testing.T) {
+	worktreePath := t.TempDir()
+	for _, dir := range []string{".git/refs/heads", "src", "node_modules"} {
+		assert.NoError(t, os.MkdirAll(filepath.Join(worktreePath, dir), 0o755))
+	}
+
+	changes := make(chan []types.RefreshableView, 10)
+	watcher, err := New(utils.NewDummyLog(), func(scopes []types.RefreshableView) {
+		sort.Slice(scopes, func(i, j int) bool { return scopes[i] < scopes[j] })
+		changes <- scopes
+	})
+	assert.NoError(t, err)
+	defer watcher.Close()
+
+	watcher.Watch(fakeRepoPaths{worktreePath: worktreePath}, fakeIgnoreChecker{})
+
+	writeFile := func(path string) {
+		assert.NoError(t, os.WriteFile(filepath.Join(worktreePath, path), []byte("content"), 0o644))
+	}
+
+	expectChange := func(expected ...types.RefreshableView) {
+		select {
+		case scopes := <-changes:
+			assert.Equal(t, expected, scopes)
+		case <-time.After(5 * time.Second):
+			t.Fatalf("expected a change to %v", expected)
+		}
+	}
+
+	writeFile("src/main.go")
+	expectChange(types.FILES)
+
+	// ignored directories aren't watched, so only the ref change comes through
+	writeFile("node_modules/package.json")
+	writeFile(".git/refs/heads/master")
+	expectChange(types.COMMITS, types.BRANCHES)
+
+	// new directories get watched unless they're ignored
+	assert.NoError(t, os.MkdirAll(filepath.Join(worktreePath, "build"), 0o755))
+	assert.NoError(t, os.MkdirAll(filepath.Join(worktreePath, "lib"), 0o755))
+	expectChange(types.FILES)
+	writeFile("build/out.o")
+	writeFile(".git/refs/heads/other")
+	expectChange(types.COMMITS, types.BRANCHES)
+	writeFile("lib/lib.go")
+	expectChange(types.FILES)
+}
@@ -30,10 +30,12 @@ import (
 	"github.com/jesseduffield/lazygit/pkg/gui/presentation/authors"
 	"github.com/jesseduffield/lazygit/pkg/gui/presentation/graph"
 	"github.com/jesseduffield/lazygit/pkg/gui/presentation/icons"
+	"github.com/jesseduffield/lazygit/pkg/gui/presentation/links"
 	"github.com/jesseduffield/lazygit/pkg/gui/services/custom_commands"
 	"github.com/jesseduffield/lazygit/pkg/gui/status"
 	"github.com/jesseduffield/lazygit/pkg/gui/style"
 	"github.com/jesseduffield/lazygit/pkg/gui/types"
+	"github.com/jesseduffield/lazygit/pkg/i18n"
 	"github.com/jesseduffield/lazygit/pkg/integration/components"
 	integrationTypes "github.com/jesseduffield/lazygit/pkg/integration/types"
 	"github.com/jesseduffield/lazygit/pkg/tasks"
@@ -41,6 +43,7 @@ import (
 	"github.com/jesseduffield/lazygit/pkg/updates"
 	"github.com/jesseduffield/lazygit/pkg/utils"
 	"github.com/sasha-s/go-deadlock"
+	"golang.org/x/exp/slices"
 	"gopkg.in/ozeidan/fuzzy-patricia.v3/patricia"
 )
 
@@ -97,9 +100,30 @@ type Gui struct {
 	// Log of the commands/actions logged in the Command Log panel.
 	GuiLog []string
 
+	// the commands logged in the Command Log panel, which we list when the
+	// panel is focused
+	CommandLogEntries []*models.CommandLogEntry
+	// everything we've written to the Command Log panel, so that we can show
+	// it again after having shown the list of commands in its place
+	commandLogContent strings.Builder
+	// the action that the commands we're logging are being run for
+	commandLogAction string
+	commandLogMutex  deadlock.Mutex
+
 	// the extras window contains things like the command log
 	ShowExtrasWindow bool
 
+	// whether we show the keys that are pressed, for screencasts and the like
+	PresenterMode bool
+
+	// whether destructive commands are only shown rather than run. This is
+	// kept across repos so that switching repos doesn't quietly turn it off
+	DryRun bool
+
+	// the language the UI is shown in, as configured or as picked from the
+	// language menu. Can be 'auto'
+	Language string
+
 	PopupHandler types.IPopupHandler
 
 	IsNewRepo bool
@@ -178,6 +202,36 @@ func (self *StateAccessor) SetShowExtrasWindow(value bool) {
 	self.gui.ShowExtrasWindow = value
 }
 
+func (self *StateAccessor) GetPresenterMode() bool {
+	return self.gui.PresenterMode
+}
+
+func (self *StateAccessor) SetPresenterMode(value bool) {
+	self.gui.PresenterMode = value
+}
+
+func (self *StateAccessor) GetDryRun() bool {
+	return self.gui.DryRun
+}
+
+func (self *StateAccessor) SetDryRun(value bool) {
+	self.gui.DryRun = value
+}
+
+func (self *StateAccessor) GetCommandLogEntries() []*models.CommandLogEntry {
+	self.gui.commandLogMutex.Lock()
+	defer self.gui.commandLogMutex.Unlock()
+
+	return slices.Clone(self.gui.CommandLogEntries)
+}
+
+func (self *StateAccessor) GetCommandLogContent() string {
+	self.gui.commandLogMutex.Lock()
+	defer self.gui.commandLogMutex.Unlock()
+
+	return self.gui.commandLogContent.String()
+}
+
 func (self *StateAccessor) GetRetainOriginalDir() bool {
 	return self.gui.RetainOriginalDir
 }
@@ -240,6 +294,13 @@ type GuiRepoState struct {
 
 	ScreenMode types.WindowMaximisation
 
+	// if true, dates are shown relative where the config says absolute and vice versa
+	DateDisplayToggled bool
+
+	// if true, the UI state stored in the app state for this repo is restored
+	// once the repo has been loaded
+	RestoreSessionState bool
+
 	CurrentPopupOpts *types.CreatePopupPanelOpts
 }
 
@@ -277,6 +338,14 @@ func (self *GuiRepoState) SetScreenMode(value types.WindowMaximisation) {
 	self.ScreenMode = value
 }
 
+func (self *GuiRepoState) GetDateDisplayToggled() bool {
+	return self.DateDisplayToggled
+}
+
+func (self *GuiRepoState) SetDateDisplayToggled(value bool) {
+	self.DateDisplayToggled = value
+}
+
 func (self *GuiRepoState) InSearchPrompt() bool {
 	return self.SearchState.SearchType() != types.SearchTypeNone
 }
@@ -293,7 +362,26 @@ func (self *GuiRepoState) GetSplitMainPanel() bool {
 	return self.SplitMainPanel
 }
 
+// leaveRepo tidies up the current repo before quitting or switching to another
+// one, and remembers how it was left, so that it can be restored the next time
+// it's opened
+func (gui *Gui) leaveRepo() {
+	if err := gui.helpers.Staging.RevertAllUnusedIntentToAdd(); err != nil {
+		gui.c.Log.Error(err)
+	}
+
+	gui.helpers.SessionState.Store()
+	gui.helpers.FileTreeState.Store()
+	gui.c.SaveAppStateAndLogError()
+}
+
 func (gui *Gui) onNewRepo(startArgs appTypes.StartArgs, contextKey types.ContextKey) error {
+	// tidy up and remember the state of the repo we're leaving, before gui.git
+	// is replaced
+	if gui.State != nil {
+		gui.leaveRepo()
+	}
+
 	var err error
 	gui.git, err = commands.NewGitCommand(
 		gui.Common,
@@ -305,10 +393,20 @@ func (gui *Gui) onNewRepo(startArgs appTypes.StartArgs, contextKey types.Context
 		return err
 	}
 
+	gui.BackgroundRoutineMgr.WatchCurrentRepo()
+
+	// if we've been in this repo before in this session, its files panel is
+	// still as we left it
+	isNewRepoState := gui.RepoStateMap[Repo(gui.git.RepoPaths.WorktreePath())] == nil
+
 	contextToPush := gui.resetState(startArgs)
 
 	gui.resetHelpersAndControllers()
 
+	if isNewRepoState {
+		gui.helpers.FileTreeState.Restore()
+	}
+
 	if err := gui.resetKeybindings(); err != nil {
 		return err
 	}
@@ -340,6 +438,23 @@ func (gui *Gui) onNewRepo(startArgs appTypes.StartArgs, contextKey types.Context
 	return nil
 }
 
+// onLanguageChanged switches the UI over to the given translation set. Views
+// and contexts hold onto the strings they were created with, so we recreate
+// them as if we had just opened the repo. Other repos' state gets dropped for
+// the same reason.
+func (gui *Gui) onLanguageChanged(language string, tr *i18n.TranslationSet) error {
+	gui.Language = language
+	*gui.c.Tr = *tr
+
+	if err := gui.createAllViews(); err != nil {
+		return err
+	}
+
+	gui.RepoStateMap = map[Repo]*GuiRepoState{}
+
+	return gui.onNewRepo(appTypes.StartArgs{}, context.STATUS_CONTEXT_KEY)
+}
+
 // reuseState determines if we pull the repo state from our repo state map or
 // just re-initialize it. For now we're only re-using state when we're going
 // in and out of submodules, for the sake of having the cursor back on the submodule
@@ -384,6 +499,7 @@ func (gui *Gui) resetState(startArgs appTypes.StartArgs) types.Context {
 			BisectInfo:            git_commands.NewNullBisectInfo(),
 			FilesTrie:             patricia.NewTrie(),
 			Authors:               map[string]*models.Author{},
+			Linker:                links.NewLinker(gui.UserConfig.Git.IssueLinks, nil, gui.Log),
 		},
 		Modes: &types.Modes{
 			Filtering:        filtering.New(startArgs.FilterPath),
@@ -397,6 +513,8 @@ func (gui *Gui) resetState(startArgs appTypes.StartArgs) types.Context {
 		Contexts:          contextTree,
 		WindowViewNameMap: initialWindowViewNameMap(contextTree),
 		SearchState:       types.NewSearchState(),
+		// a context passed on the command line takes precedence
+		RestoreSessionState: gui.UserConfig.Gui.PersistSessionState && startArgs.GitArg == appTypes.GitArgNone,
 	}
 
 	gui.RepoStateMap[Repo(worktreePath)] = gui.State
@@ -492,6 +610,8 @@ func NewGui(
 		// but now we do it via state. So we need to still support the config for the
 		// sake of backwards compatibility. We're making use of short circuiting here
 		ShowExtrasWindow: cmn.UserConfig.Gui.ShowCommandLog && !config.GetAppState().HideCommandLog,
+		PresenterMode:    cmn.UserConfig.Gui.PresenterMode.Enabled,
+		Language:         cmn.UserConfig.Gui.Language,
 		Mutexes: types.Mutexes{
 			RefreshingFilesMutex:    &deadlock.Mutex{},
 			RefreshingBranchesMutex: &deadlock.Mutex{},
@@ -536,6 +656,8 @@ func NewGui(
 	guiIO := oscommands.NewGuiIO(
 		cmn.Log,
 		gui.LogCommand,
+		gui.LogCmdObj,
+		gui.LogCommandResult,
 		gui.getCmdWriter,
 		credentialsHelper.PromptUserForCredential,
 	)
@@ -697,6 +819,19 @@ func (gui *Gui) Run(startArgs appTypes.StartArgs) error {
 		return err
 	}
 
+	gui.c.OnUIThread(func() error {
+		// the notice would go unseen in a hidden command log, so we hold on to
+		// it until the command log is shown
+		if !gui.c.State().GetShowExtrasWindow() {
+			return nil
+		}
+
+		if notice := gui.helpers.KeybindingConflicts.NewConflictsNotice(); notice != "" {
+			gui.writeToCommandLog("\n\n" + style.FgRed.Sprint(notice))
+		}
+		return nil
+	})
+
 	gui.waitForIntro.Add(1)
 
 	gui.BackgroundRoutineMgr.startBackgroundRoutines()
@@ -721,6 +856,8 @@ func (gui *Gui) RunAndHandleError(startArgs appTypes.StartArgs) error {
 
 			switch err {
 			case gocui.ErrQuit:
+				gui.leaveRepo()
+
 				if gui.c.State().GetRetainOriginalDir() {
 					if err := gui.helpers.RecordDirectory.RecordDirectory(gui.InitialDir); err != nil {
 						return err
@@ -813,7 +950,7 @@ func (gui *Gui) runSubprocessWithSuspense(subprocess oscommands.ICmdObj) (bool,
 }
 
 func (gui *Gui) runSubprocess(cmdObj oscommands.ICmdObj) error { //nolint:unparam
-	gui.LogCommand(cmdObj.ToString(), true)
+	gui.LogCmdObj(cmdObj)
 
 	subprocess := cmdObj.GetCmd()
 	subprocess.Stdout = os.Stdout
@@ -844,7 +981,19 @@ func (gui *Gui) loadNewRepo() error {
 		return err
 	}
 
-	if err := gui.c.Refresh(types.RefreshOptions{Mode: types.ASYNC}); err != nil {
+	if gui.State.RestoreSessionState {
+		gui.State.RestoreSessionState = false
+		// we can only restore selections once the models are loaded, so we do
+		// the initial refresh synchronously on a worker and restore afterwards
+		gui.c.OnWorker(func(gocui.Task) {
+			_ = gui.c.Refresh(types.RefreshOptions{
+				Mode: types.SYNC,
+				Then: func() {
+					gui.c.OnUIThread(gui.helpers.SessionState.Restore)
+				},
+			})
+		})
+	} else if err := gui.c.Refresh(types.RefreshOptions{Mode: types.ASYNC}); err != nil {
 		return err
 	}
 
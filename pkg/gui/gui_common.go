@@ -155,6 +155,10 @@ func (self *guiCommon) RenderToMainViews(opts types.RefreshMainOpts) error {
 	return self.gui.refreshMainViews(opts)
 }
 
+func (self *guiCommon) RenderToView(view *gocui.View, task types.UpdateTask) error {
+	return self.gui.runTaskForView(view, task)
+}
+
 func (self *guiCommon) MainViewPairs() types.MainViewPairs {
 	return types.MainViewPairs{
 		Normal:         self.gui.normalMainContextPair(),
@@ -51,11 +51,38 @@ func (self *GuiDriver) PressKey(keyStr string) {
 func (self *GuiDriver) Click(x, y int) {
 	self.CheckAllToastsAcknowledged()
 
+	self.sendMouseEvent(x, y, tcell.ButtonPrimary)
+	self.waitTillIdle()
+}
+
+// ClickAndRelease is like Click, but releases the button again, so that a
+// subsequent click elsewhere isn't taken for a drag
+func (self *GuiDriver) ClickAndRelease(x, y int) {
+	self.CheckAllToastsAcknowledged()
+
+	self.sendMouseEvent(x, y, tcell.ButtonPrimary)
+	self.sendMouseEvent(x, y, tcell.ButtonNone)
+	self.waitTillIdle()
+}
+
+func (self *GuiDriver) Drag(fromX, fromY, toX, toY int) {
+	self.CheckAllToastsAcknowledged()
+
+	self.sendMouseEvent(fromX, fromY, tcell.ButtonPrimary)
+	self.waitTillIdle()
+	// gocui only reports a drag once the mouse has moved while the button is
+	// held, so the first motion event just starts the drag
+	self.sendMouseEvent(toX, toY, tcell.ButtonPrimary)
+	self.sendMouseEvent(toX, toY, tcell.ButtonPrimary)
+	self.sendMouseEvent(toX, toY, tcell.ButtonNone)
+	self.waitTillIdle()
+}
+
+func (self *GuiDriver) sendMouseEvent(x, y int, button tcell.ButtonMask) {
 	self.gui.g.ReplayedEvents.MouseEvents <- gocui.NewTcellMouseEventWrapper(
-		tcell.NewEventMouse(x, y, tcell.ButtonPrimary, 0),
+		tcell.NewEventMouse(x, y, button, 0),
 		0,
 	)
-	self.waitTillIdle()
 }
 
 // wait until lazygit is idle (i.e. all processing is done) before continuing
@@ -245,51 +245,6 @@ func (self *Gui) GetInitialKeybindings() ([]*types.Binding, []*gocui.ViewMouseBi
 			Handler:     self.handleCopySelectedSideContextItemToClipboard,
 			Description: self.c.Tr.CopySubmoduleNameToClipboard,
 		},
-		{
-			ViewName: "extras",
-			Key:      gocui.MouseWheelUp,
-			Handler:  self.scrollUpExtra,
-		},
-		{
-			ViewName: "extras",
-			Key:      gocui.MouseWheelDown,
-			Handler:  self.scrollDownExtra,
-		},
-		{
-			ViewName: "extras",
-			Tag:      "navigation",
-			Key:      opts.GetKey(opts.Config.Universal.PrevItemAlt),
-			Modifier: gocui.ModNone,
-			Handler:  self.scrollUpExtra,
-		},
-		{
-			ViewName: "extras",
-			Tag:      "navigation",
-			Key:      opts.GetKey(opts.Config.Universal.PrevItem),
-			Modifier: gocui.ModNone,
-			Handler:  self.scrollUpExtra,
-		},
-		{
-			ViewName: "extras",
-			Tag:      "navigation",
-			Key:      opts.GetKey(opts.Config.Universal.NextItem),
-			Modifier: gocui.ModNone,
-			Handler:  self.scrollDownExtra,
-		},
-		{
-			ViewName: "extras",
-			Tag:      "navigation",
-			Key:      opts.GetKey(opts.Config.Universal.NextItemAlt),
-			Modifier: gocui.ModNone,
-			Handler:  self.scrollDownExtra,
-		},
-		{
-			ViewName: "extras",
-			Tag:      "navigation",
-			Key:      gocui.MouseLeft,
-			Modifier: gocui.ModNone,
-			Handler:  self.handleFocusCommandLog,
-		},
 	}
 
 	mouseKeybindings := []*gocui.ViewMouseBinding{}
@@ -423,5 +378,8 @@ func (gui *Gui) callKeybindingHandler(binding *types.Binding) error {
 		gui.c.ErrorToast(gui.Tr.DisabledMenuItemPrefix + disabledReason.Text)
 		return nil
 	}
+	if gui.PresenterMode {
+		return gui.helpers.PresenterMode.RunKeybinding(binding)
+	}
 	return binding.Handler()
 }
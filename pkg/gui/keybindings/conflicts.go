@@ -0,0 +1,141 @@
+package keybindings
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+
+	"github.com/jesseduffield/gocui"
+	"github.com/jesseduffield/lazygit/pkg/config"
+	"github.com/jesseduffield/lazygit/pkg/gui/types"
+	"github.com/samber/lo"
+)
+
+// A Conflict is a key that's bound to more than one action in the same view.
+// Only the first binding ever gets to handle the key, so the other actions are
+// silently shadowed.
+type Conflict struct {
+	ViewName string
+	Key      types.Key
+	Modifier gocui.Modifier
+	// descriptions of the conflicting actions, in order of precedence
+	Descriptions []string
+}
+
+// FindConflicts returns the keys that are bound to different actions within
+// the same view. The same action being bound more than once (e.g. because a
+// view is shared by several contexts) isn't considered a conflict, and neither
+// is a view binding shadowing a global one, which we do on purpose.
+func FindConflicts(bindings []*types.Binding) []*Conflict {
+	conflicts := []*Conflict{}
+	conflictsByKey := map[string]*Conflict{}
+
+	for _, binding := range bindings {
+		if binding.Key == nil || binding.Description == "" {
+			continue
+		}
+		if key, ok := binding.Key.(gocui.Key); ok && gocui.IsMouseKey(key) {
+			continue
+		}
+
+		mapKey := fmt.Sprintf("%s|%s|%d", binding.ViewName, LabelFromKey(binding.Key), binding.Modifier)
+		conflict, ok := conflictsByKey[mapKey]
+		if !ok {
+			conflict = &Conflict{
+				ViewName: binding.ViewName,
+				Key:      binding.Key,
+				Modifier: binding.Modifier,
+			}
+			conflictsByKey[mapKey] = conflict
+			conflicts = append(conflicts, conflict)
+		}
+
+		if !lo.Contains(conflict.Descriptions, binding.Description) {
+			conflict.Descriptions = append(conflict.Descriptions, binding.Description)
+		}
+	}
+
+	return lo.Filter(conflicts, func(conflict *Conflict, _ int) bool {
+		return len(conflict.Descriptions) > 1
+	})
+}
+
+// the sections of the keybinding config (besides 'universal') whose keys are
+// used in a view
+var configSectionsByView = map[string][]string{
+	"status":                 {"status"},
+	"files":                  {"files"},
+	"worktrees":              {"worktrees"},
+	"submodules":             {"submodules"},
+	"localBranches":          {"branches"},
+	"remotes":                {"branches"},
+	"remoteBranches":         {"branches"},
+	"tags":                   {"branches"},
+	"commits":                {"commits"},
+	"subCommits":             {"commits"},
+	"fileHistory":            {"commitFiles"},
+	"reflogCommits":          {"commits"},
+	"stash":                  {"stash"},
+	"commitFiles":            {"commitFiles"},
+	"main":                   {"main"},
+	"secondary":              {"main"},
+	"staging":                {"main", "files"},
+	"stagingSecondary":       {"main", "files"},
+	"patchBuilding":          {"main"},
+	"patchBuildingSecondary": {"main"},
+	"mergeConflicts":         {"main"},
+	"commitMessage":          {"commitMessage"},
+	"commitDescription":      {"commitMessage"},
+}
+
+// ConfigPaths returns the YAML paths of the keybinding config entries that are
+// set to the given key and could apply to the given view, e.g.
+// 'keybinding.files.commitChanges'
+func ConfigPaths(keybindingConfig *config.KeybindingConfig, viewName string, key types.Key) []string {
+	label := LabelFromKey(key)
+	sections, knownView := configSectionsByView[viewName]
+	if viewName == "" {
+		// global bindings all come from the universal section
+		knownView = true
+	}
+
+	paths := []string{}
+	configValue := reflect.ValueOf(*keybindingConfig)
+	configType := configValue.Type()
+	for i := 0; i < configType.NumField(); i++ {
+		section := yamlName(configType.Field(i))
+		if knownView && section != "universal" && !lo.Contains(sections, section) {
+			continue
+		}
+
+		sectionValue := configValue.Field(i)
+		sectionType := sectionValue.Type()
+		for j := 0; j < sectionType.NumField(); j++ {
+			path := fmt.Sprintf("keybinding.%s.%s", section, yamlName(sectionType.Field(j)))
+
+			switch value := sectionValue.Field(j).Interface().(type) {
+			case string:
+				if keyLabelEquals(value, label) {
+					paths = append(paths, path)
+				}
+			case []string:
+				for k, item := range value {
+					if keyLabelEquals(item, label) {
+						paths = append(paths, fmt.Sprintf("%s[%d]", path, k))
+					}
+				}
+			}
+		}
+	}
+
+	return paths
+}
+
+func keyLabelEquals(configValue string, label string) bool {
+	key := GetKey(configValue)
+	return key != nil && LabelFromKey(key) == label
+}
+
+func yamlName(field reflect.StructField) string {
+	return strings.Split(field.Tag.Get("yaml"), ",")[0]
+}
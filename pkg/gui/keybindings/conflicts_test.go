@@ -0,0 +1,68 @@
+package keybindings
+
+import (
+	"testing"
+
+	"github.com/jesseduffield/gocui"
+	"github.com/jesseduffield/lazygit/pkg/config"
+	"github.com/jesseduffield/lazygit/pkg/gui/types"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFindConflicts(t *testing.T) {
+	bindings := []*types.Binding{
+		{ViewName: "files", Key: 'c', Description: "Commit"},
+		{ViewName: "files", Key: 'a', Description: "Stage all"},
+		{ViewName: "files", Key: 'c', Description: "Custom command"},
+		// a different view, so no conflict
+		{ViewName: "commits", Key: 'a', Description: "Set author"},
+		// the same action bound twice, e.g. because two contexts share the view
+		{ViewName: "commits", Key: 'r', Description: "Reword"},
+		{ViewName: "commits", Key: 'r', Description: "Reword"},
+		// a view binding shadowing a global one is fine
+		{ViewName: "", Key: 'a', Description: "Global thing"},
+		// different modifiers
+		{ViewName: "files", Key: 'a', Modifier: gocui.ModAlt, Description: "Something else"},
+		// bindings without a description or a key are ignored
+		{ViewName: "files", Key: 'a', Description: ""},
+		{ViewName: "files", Key: nil, Description: "Disabled"},
+		{ViewName: "files", Key: gocui.KeyEnter, Description: "Open"},
+		{ViewName: "files", Key: gocui.KeyCtrlM, Description: "Also enter"},
+	}
+
+	conflicts := FindConflicts(bindings)
+
+	assert.EqualValues(t, [][]string{
+		{"files", "c", "Commit", "Custom command"},
+		{"files", "<enter>", "Open", "Also enter"},
+	}, lo.Map(conflicts, func(conflict *Conflict, _ int) []string {
+		return append([]string{conflict.ViewName, LabelFromKey(conflict.Key)}, conflict.Descriptions...)
+	}))
+}
+
+func TestConfigPaths(t *testing.T) {
+	keybindingConfig := config.GetDefaultConfig().Keybinding
+	keybindingConfig.Files.CommitChanges = "a"
+
+	assert.EqualValues(t,
+		[]string{"keybinding.files.commitChanges", "keybinding.files.toggleStagedAll"},
+		ConfigPaths(&keybindingConfig, "files", 'a'),
+	)
+
+	// other sections' keys don't apply to the files view
+	assert.EqualValues(t,
+		[]string{"keybinding.commits.resetCommitAuthor"},
+		ConfigPaths(&keybindingConfig, "commits", 'a'),
+	)
+
+	assert.EqualValues(t,
+		[]string{"keybinding.universal.select"},
+		ConfigPaths(&keybindingConfig, "files", gocui.KeySpace),
+	)
+
+	assert.EqualValues(t,
+		[]string{"keybinding.universal.jumpToBlock[0]"},
+		ConfigPaths(&keybindingConfig, "", '1'),
+	)
+}
@@ -152,6 +152,14 @@ func (gui *Gui) layout(g *gocui.Gui) error {
 		view.SelBgColor = theme.GocuiSelectedLineBgColor
 	}
 
+	// the main views have no selection to show in a footer, so we show how far
+	// they've been scrolled instead
+	if gui.c.UserConfig.Gui.ShowListFooter {
+		for _, context := range []types.Context{gui.State.Contexts.Normal, gui.State.Contexts.NormalSecondary} {
+			context.GetViewTrait().SetScrollPositionFooter(gui.c.Tr)
+		}
+	}
+
 	mainViewWidth, mainViewHeight := gui.Views.Main.Size()
 	if mainViewWidth != gui.PrevLayout.MainWidth || mainViewHeight != gui.PrevLayout.MainHeight {
 		gui.PrevLayout.MainWidth = mainViewWidth
@@ -175,6 +183,12 @@ func (gui *Gui) layout(g *gocui.Gui) error {
 		return err
 	}
 
+	gui.helpers.CommitPeek.Resize()
+	gui.helpers.PresenterMode.Resize()
+
+	gui.helpers.CommitsMinimap.Render()
+	gui.helpers.RebaseProgress.Render()
+
 outer:
 	for {
 		select {
@@ -187,6 +201,10 @@ outer:
 		}
 	}
 
+	// after-layout funcs can change the content of the searched view, so we do
+	// this last
+	gui.helpers.Search.RefreshSearchStatus()
+
 	return nil
 }
 
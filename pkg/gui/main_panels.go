@@ -2,6 +2,7 @@ package gui
 
 import (
 	"github.com/jesseduffield/gocui"
+	"github.com/jesseduffield/lazygit/pkg/gui/style"
 	"github.com/jesseduffield/lazygit/pkg/gui/types"
 )
 
@@ -20,7 +21,23 @@ func (gui *Gui) runTaskForView(view *gocui.View, task types.UpdateTask) error {
 		return gui.newCmdTask(view, v.Cmd, v.Prefix)
 
 	case *types.RunPtyTask:
-		return gui.newPtyTask(view, v.Cmd, v.Prefix)
+		getPrefix := v.GetPrefix
+		if getPrefix == nil {
+			getPrefix = func() string { return v.Prefix }
+		}
+		if v.Prepare != nil {
+			// the prefix is got from within the task, right before running the
+			// command, so that's where we prepare for it too
+			getPrefixWithoutPreparing := getPrefix
+			getPrefix = func() string {
+				if err := v.Prepare(); err != nil {
+					gui.c.Log.Error(err)
+					return style.FgRed.Sprint(err.Error()) + "\n\n" + getPrefixWithoutPreparing()
+				}
+				return getPrefixWithoutPreparing()
+			}
+		}
+		return gui.newPtyTask(view, v.Cmd, getPrefix)
 	}
 
 	return nil
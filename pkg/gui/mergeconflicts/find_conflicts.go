@@ -96,6 +96,17 @@ func FileHasConflictMarkers(path string) (bool, error) {
 	return fileHasConflictMarkersAux(file), nil
 }
 
+// CountConflicts returns the number of conflicts that are still marked in the
+// file
+func CountConflicts(path string) (int, error) {
+	content, err := os.ReadFile(path)
+	if err != nil {
+		return 0, err
+	}
+
+	return len(findConflicts(string(content))), nil
+}
+
 // Efficiently scans through a file looking for merge conflict markers. Returns true if it does
 func fileHasConflictMarkersAux(file io.Reader) bool {
 	scanner := bufio.NewScanner(file)
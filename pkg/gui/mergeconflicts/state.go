@@ -4,6 +4,7 @@ import (
 	"strings"
 
 	"github.com/jesseduffield/lazygit/pkg/utils"
+	"github.com/samber/lo"
 )
 
 // State represents the selection state of the merge conflict context.
@@ -116,6 +117,14 @@ func (s *State) Undo() bool {
 	return true
 }
 
+// HasResolvedConflicts returns whether the file has fewer conflicts left than
+// it had at some point since we started showing it
+func (s *State) HasResolvedConflicts() bool {
+	return lo.SomeBy(s.contents, func(content string) bool {
+		return len(findConflicts(content)) > len(s.conflicts)
+	})
+}
+
 func (s *State) setConflicts(conflicts []*mergeConflict) {
 	s.conflicts = conflicts
 	s.setConflictIndex(s.conflictIndex)
@@ -158,6 +167,13 @@ func (s *State) Active() bool {
 	return s.path != ""
 }
 
+// ShowsBase tells us whether the selected conflict includes the base version,
+// i.e. whether git wrote it in diff3 or zdiff3 style
+func (s *State) ShowsBase() bool {
+	conflict := s.currentConflict()
+	return conflict != nil && conflict.hasAncestor()
+}
+
 func (s *State) GetConflictMiddle() int {
 	currentConflict := s.currentConflict()
 
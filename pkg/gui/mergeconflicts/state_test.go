@@ -121,3 +121,18 @@ baz
 		})
 	}
 }
+
+func TestHasResolvedConflicts(t *testing.T) {
+	twoConflicts := "<<<<<<< HEAD\nfoo\n=======\nbar\n>>>>>>> branch\n<<<<<<< HEAD\nbaz\n=======\nqux\n>>>>>>> branch\n"
+	oneConflict := "foo\n<<<<<<< HEAD\nbaz\n=======\nqux\n>>>>>>> branch\n"
+
+	state := NewState()
+	state.SetContent(twoConflicts, "file")
+	assert.False(t, state.HasResolvedConflicts())
+
+	state.PushContent(oneConflict)
+	assert.True(t, state.HasResolvedConflicts())
+
+	state.Undo()
+	assert.False(t, state.HasResolvedConflicts())
+}
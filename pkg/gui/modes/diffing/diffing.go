@@ -1,5 +1,10 @@
 package diffing
 
+import (
+	"fmt"
+	"strings"
+)
+
 // if ref is blank we're not diffing anything
 type Diffing struct {
 	Ref     string
@@ -26,3 +31,13 @@ func (self *Diffing) GetFromAndReverseArgsForDiff(from string) (string, bool) {
 
 	return from, reverse
 }
+
+// RefAsOfTime returns a reflog-based ref pointing at where the given ref was at the
+// given point in time, e.g. "master@{yesterday}". The time may be passed with or
+// without the surrounding '@{...}'.
+func RefAsOfTime(ref string, when string) string {
+	when = strings.TrimSpace(when)
+	when = strings.TrimSuffix(strings.TrimPrefix(when, "@{"), "}")
+
+	return fmt.Sprintf("%s@{%s}", ref, when)
+}
@@ -1,8 +1,11 @@
 package patch_exploring
 
 import (
+	"sort"
+
 	"github.com/jesseduffield/generics/set"
 	"github.com/jesseduffield/lazygit/pkg/commands/patch"
+	"github.com/samber/lo"
 	"github.com/sirupsen/logrus"
 )
 
@@ -15,6 +18,11 @@ type State struct {
 	diff              string
 	patch             *patch.Patch
 	selectMode        selectMode
+	// patch line indices at which the user has split a hunk, sorted. Each one
+	// is the first line of a part that can be selected on its own in hunk
+	// select mode. They only apply to this exact diff, so they're dropped as
+	// soon as the diff changes.
+	hunkSplits []int
 }
 
 // these represent what select mode we're in
@@ -60,12 +68,18 @@ func NewState(diff string, selectedLineIdx int, oldState *State, log *logrus.Ent
 		selectedLineIdx = patch.GetNextChangeIdx(0)
 	}
 
+	var hunkSplits []int
+	if oldState != nil && diff == oldState.diff {
+		hunkSplits = oldState.hunkSplits
+	}
+
 	return &State{
 		patch:             patch,
 		selectedLineIdx:   selectedLineIdx,
 		selectMode:        selectMode,
 		rangeStartLineIdx: rangeStartLineIdx,
 		diff:              diff,
+		hunkSplits:        hunkSplits,
 	}
 }
 
@@ -142,14 +156,23 @@ func (s *State) CycleHunk(forward bool) {
 		change = -1
 	}
 
-	hunkIdx := s.patch.HunkContainingLine(s.selectedLineIdx)
-	if hunkIdx != -1 {
-		newHunkIdx := hunkIdx + change
-		if newHunkIdx >= 0 && newHunkIdx < s.patch.HunkCount() {
-			start := s.patch.HunkStartIdx(newHunkIdx)
-			s.selectedLineIdx = s.patch.GetNextChangeIdx(start)
-		}
+	if s.patch.HunkContainingLine(s.selectedLineIdx) == -1 {
+		return
+	}
+
+	// moving to the line just outside the current hunk (or part of a split
+	// hunk) gets us into the neighbouring one
+	start, end := s.CurrentHunkBounds()
+	lineInNewHunk := end + 1
+	if change == -1 {
+		lineInNewHunk = start - 1
+	}
+	if s.patch.HunkContainingLine(lineInNewHunk) == -1 {
+		return
 	}
+
+	newStart, _ := s.hunkBoundsAt(lineInNewHunk)
+	s.selectedLineIdx = s.patch.GetNextChangeIdx(newStart)
 }
 
 func (s *State) CycleLine(forward bool) {
@@ -161,14 +184,63 @@ func (s *State) CycleLine(forward bool) {
 	s.SelectLine(s.selectedLineIdx + change)
 }
 
-// returns first and last patch line index of current hunk
+// returns first and last patch line index of current hunk, or of the current
+// part of the hunk if it has been split
 func (s *State) CurrentHunkBounds() (int, int) {
-	hunkIdx := s.patch.HunkContainingLine(s.selectedLineIdx)
+	return s.hunkBoundsAt(s.selectedLineIdx)
+}
+
+func (s *State) hunkBoundsAt(lineIdx int) (int, int) {
+	hunkIdx := s.patch.HunkContainingLine(lineIdx)
 	start := s.patch.HunkStartIdx(hunkIdx)
 	end := s.patch.HunkEndIdx(hunkIdx)
+
+	for _, split := range s.hunkSplits {
+		if split > start && split <= lineIdx {
+			start = split
+		} else if split > lineIdx && split <= end {
+			end = split - 1
+		}
+	}
+
 	return start, end
 }
 
+// ToggleHunkSplit splits the current hunk in two at the selected line, so that
+// each part can be selected on its own in hunk select mode. If the selected
+// line already starts a part, the split is removed again. Returns false if
+// the hunk can't be split there because one of the parts wouldn't contain any
+// changes.
+func (s *State) ToggleHunkSplit() bool {
+	if idx := lo.IndexOf(s.hunkSplits, s.selectedLineIdx); idx != -1 {
+		s.hunkSplits = append(s.hunkSplits[:idx:idx], s.hunkSplits[idx+1:]...)
+		return true
+	}
+
+	if s.patch.HunkContainingLine(s.selectedLineIdx) == -1 {
+		return false
+	}
+
+	start, end := s.CurrentHunkBounds()
+	if !s.containsChanges(start, s.selectedLineIdx-1) || !s.containsChanges(s.selectedLineIdx, end) {
+		return false
+	}
+
+	s.hunkSplits = append(s.hunkSplits, s.selectedLineIdx)
+	sort.Ints(s.hunkSplits)
+	s.selectMode = HUNK
+	return true
+}
+
+func (s *State) containsChanges(firstLineIdx int, lastLineIdx int) bool {
+	if firstLineIdx > lastLineIdx {
+		return false
+	}
+
+	nextChangeIdx := s.patch.GetNextChangeIdx(firstLineIdx)
+	return nextChangeIdx >= firstLineIdx && nextChangeIdx <= lastLineIdx
+}
+
 func (s *State) SelectedRange() (int, int) {
 	switch s.selectMode {
 	case HUNK:
@@ -195,7 +267,9 @@ func (s *State) AdjustSelectedLineIdx(change int) {
 	s.SelectLine(s.selectedLineIdx + change)
 }
 
-func (s *State) RenderForLineIndices(isFocused bool, includedLineIndices []int) string {
+// splitWidth is the width of the view if the patch should be laid out in two
+// columns, or 0 otherwise
+func (s *State) RenderForLineIndices(isFocused bool, includedLineIndices []int, splitWidth int) string {
 	firstLineIdx, lastLineIdx := s.SelectedRange()
 	includedLineIndicesSet := set.NewFromSlice(includedLineIndices)
 	return s.patch.FormatView(patch.FormatViewOpts{
@@ -203,6 +277,7 @@ func (s *State) RenderForLineIndices(isFocused bool, includedLineIndices []int)
 		FirstLineIndex: firstLineIdx,
 		LastLineIndex:  lastLineIdx,
 		IncLineIndices: includedLineIndicesSet,
+		SplitWidth:     splitWidth,
 	})
 }
 
@@ -0,0 +1,71 @@
+package patch_exploring
+
+import (
+	"testing"
+
+	"github.com/jesseduffield/lazygit/pkg/utils"
+	"github.com/stretchr/testify/assert"
+)
+
+const twoChangesDiff = `diff --git a/file b/file
+index 1234567..89abcde 100644
+--- a/file
++++ b/file
+@@ -1,5 +1,5 @@
+-a
++A
+ b
+ c
+-d
++D
+ e
+`
+
+func TestToggleHunkSplit(t *testing.T) {
+	state := NewState(twoChangesDiff, -1, nil, utils.NewDummyLog())
+	assert.Equal(t, 5, state.GetSelectedLineIdx())
+
+	// splitting before the first change or after the last one would leave a
+	// part without changes
+	state.SelectLine(5)
+	assert.False(t, state.ToggleHunkSplit())
+	state.SelectLine(11)
+	assert.False(t, state.ToggleHunkSplit())
+	assert.False(t, state.SelectingHunk())
+
+	state.SelectLine(8)
+	assert.True(t, state.ToggleHunkSplit())
+	assert.True(t, state.SelectingHunk())
+	assertBounds(t, state, 8, 11)
+
+	state.CycleHunk(false)
+	assert.Equal(t, 5, state.GetSelectedLineIdx())
+	assertBounds(t, state, 4, 7)
+
+	// there's nothing before the first part
+	state.CycleHunk(false)
+	assert.Equal(t, 5, state.GetSelectedLineIdx())
+
+	state.CycleHunk(true)
+	assert.Equal(t, 9, state.GetSelectedLineIdx())
+	assertBounds(t, state, 8, 11)
+
+	// the split survives the state being recreated for the same diff
+	state = NewState(twoChangesDiff, 9, state, utils.NewDummyLog())
+	state.SetLineSelectMode()
+	state.ToggleSelectHunk()
+	assertBounds(t, state, 8, 11)
+
+	// toggling at the start of a part removes the split again
+	state.SelectLine(8)
+	assert.True(t, state.ToggleHunkSplit())
+	assertBounds(t, state, 4, 11)
+}
+
+func assertBounds(t *testing.T, state *State, expectedStart int, expectedEnd int) {
+	t.Helper()
+
+	start, end := state.CurrentHunkBounds()
+	assert.Equal(t, expectedStart, start)
+	assert.Equal(t, expectedEnd, end)
+}
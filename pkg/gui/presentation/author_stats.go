@@ -0,0 +1,45 @@
+package presentation
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/jesseduffield/lazygit/pkg/commands/git_commands"
+	"github.com/jesseduffield/lazygit/pkg/gui/presentation/authors"
+	"github.com/jesseduffield/lazygit/pkg/utils"
+	"github.com/mattn/go-runewidth"
+	"github.com/samber/lo"
+)
+
+// the bars get at least this much room, at the expense of long author names
+const minAuthorStatsBarWidth = 10
+
+// GetAuthorStatsDisplayString renders a bar chart of the commits per author,
+// fitting in the given width. The counts are expected to be sorted, most
+// commits first.
+func GetAuthorStatsDisplayString(counts []*git_commands.AuthorCommitCount, width int) string {
+	if len(counts) == 0 {
+		return ""
+	}
+
+	maxCount := counts[0].Count
+	countWidth := len(strconv.Itoa(maxCount))
+	nameWidth := utils.MaxFn(counts, func(count *git_commands.AuthorCommitCount) int {
+		return runewidth.StringWidth(count.Name)
+	})
+	// a space after the name and another one before the count
+	nameWidth = utils.Max(0, utils.Min(nameWidth, width-minAuthorStatsBarWidth-countWidth-2))
+	barWidth := utils.Max(1, width-nameWidth-countWidth-2)
+
+	lines := lo.Map(counts, func(count *git_commands.AuthorCommitCount, _ int) string {
+		// every author gets a bar, however small their share
+		barLength := utils.Max(1, count.Count*barWidth/maxCount)
+		authorStyle := authors.AuthorStyle(count.Name)
+		name := utils.WithPadding(utils.TruncateWithEllipsis(count.Name, nameWidth), nameWidth, utils.AlignLeft)
+
+		return fmt.Sprintf("%s %s %d", authorStyle.Sprint(name), authorStyle.Sprint(strings.Repeat("█", barLength)), count.Count)
+	})
+
+	return strings.Join(lines, "\n")
+}
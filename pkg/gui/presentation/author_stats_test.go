@@ -0,0 +1,44 @@
+package presentation
+
+import (
+	"testing"
+
+	"github.com/jesseduffield/lazygit/pkg/commands/git_commands"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGetAuthorStatsDisplayString(t *testing.T) {
+	counts := []*git_commands.AuthorCommitCount{
+		{Name: "Jesse Duffield", Count: 20},
+		{Name: "Jane", Count: 10},
+		{Name: "Joe", Count: 1},
+	}
+
+	scenarios := []struct {
+		testName string
+		width    int
+		expected string
+	}{
+		{
+			testName: "enough room",
+			width:    38,
+			expected: "Jesse Duffield ████████████████████ 20\n" +
+				"Jane           ██████████ 10\n" +
+				"Joe            █ 1",
+		},
+		{
+			testName: "names are truncated to leave room for the bars",
+			width:    20,
+			expected: "Jes... ██████████ 20\n" +
+				"Jane   █████ 10\n" +
+				"Joe    █ 1",
+		},
+	}
+
+	for _, s := range scenarios {
+		s := s
+		t.Run(s.testName, func(t *testing.T) {
+			assert.Equal(t, s.expected, GetAuthorStatsDisplayString(counts, s.width))
+		})
+	}
+}
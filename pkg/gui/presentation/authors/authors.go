@@ -14,9 +14,10 @@ import (
 // if these being global variables causes trouble we can wrap them in a struct
 // attached to the gui state.
 var (
-	authorInitialCache = make(map[string]string)
-	authorNameCache    = make(map[string]string)
-	authorStyleCache   = make(map[string]style.TextStyle)
+	authorInitialCache      = make(map[string]string)
+	authorInitialBlockCache = make(map[string]string)
+	authorNameCache         = make(map[string]string)
+	authorStyleCache        = make(map[string]style.TextStyle)
 )
 
 const authorNameWildcard = "*"
@@ -37,6 +38,25 @@ func ShortAuthor(authorName string) string {
 	return value
 }
 
+// ShortAuthorBlock renders the author's initials as a two-cell block with the
+// author's color as the background, which is easier to tell apart at a glance
+// than colored text
+func ShortAuthorBlock(authorName string) string {
+	if value, ok := authorInitialBlockCache[authorName]; ok {
+		return value
+	}
+
+	initials := getInitials(authorName)
+	if initials == "" {
+		return ""
+	}
+
+	value := AuthorStyle(authorName).SetReverse().Sprint(padInitials(initials))
+	authorInitialBlockCache[authorName] = value
+
+	return value
+}
+
 func LongAuthor(authorName string) string {
 	if value, ok := authorNameCache[authorName]; ok {
 		return value
@@ -50,6 +70,31 @@ func LongAuthor(authorName string) string {
 	return value
 }
 
+// ShortAuthorWithStyle is like ShortAuthor but uses the given style instead of
+// the author's own color
+func ShortAuthorWithStyle(authorName string, textStyle style.TextStyle) string {
+	return textStyle.Sprint(getInitials(authorName))
+}
+
+// ShortAuthorBlockWithStyle is like ShortAuthorBlock but uses the given style
+// instead of the author's own color
+func ShortAuthorBlockWithStyle(authorName string, textStyle style.TextStyle) string {
+	initials := getInitials(authorName)
+	if initials == "" {
+		return ""
+	}
+
+	return textStyle.SetReverse().Sprint(padInitials(initials))
+}
+
+// LongAuthorWithStyle is like LongAuthor but uses the given style instead of
+// the author's own color
+func LongAuthorWithStyle(authorName string, textStyle style.TextStyle) string {
+	paddedAuthorName := utils.WithPadding(authorName, 17, utils.AlignLeft)
+	truncatedName := utils.TruncateWithEllipsis(paddedAuthorName, 17)
+	return textStyle.Sprint(truncatedName)
+}
+
 func AuthorStyle(authorName string) style.TextStyle {
 	if value, ok := authorStyleCache[authorName]; ok {
 		return value
@@ -104,6 +149,11 @@ func getInitials(authorName string) string {
 	return utils.LimitStr(split[0], 1) + utils.LimitStr(split[1], 1)
 }
 
+// padInitials makes sure initials always take up two cells so that blocks line up
+func padInitials(initials string) string {
+	return utils.WithPadding(initials, 2, utils.AlignLeft)
+}
+
 func getFirstRune(str string) rune {
 	// just using the loop for the sake of getting the first rune
 	for _, r := range str {
@@ -1,6 +1,12 @@
 package authors
 
-import "testing"
+import (
+	"testing"
+
+	"github.com/gookit/color"
+	"github.com/stretchr/testify/assert"
+	"github.com/xo/terminfo"
+)
 
 func TestGetInitials(t *testing.T) {
 	for input, expectedOutput := range map[string]string{
@@ -18,3 +24,22 @@ func TestGetInitials(t *testing.T) {
 		}
 	}
 }
+
+func TestShortAuthorBlock(t *testing.T) {
+	color.ForceSetColorLevel(terminfo.ColorLevelNone)
+
+	scenarios := []struct {
+		authorName string
+		expected   string
+	}{
+		{authorName: "", expected: ""},
+		{authorName: "Jesse Duffield", expected: "JD"},
+		{authorName: "J", expected: "J "},
+		{authorName: "jesse", expected: "je"},
+		{authorName: "张伟", expected: "张"},
+	}
+
+	for _, s := range scenarios {
+		assert.Equal(t, s.expected, ShortAuthorBlock(s.authorName))
+	}
+}
@@ -9,6 +9,7 @@ import (
 	"github.com/jesseduffield/lazygit/pkg/commands/models"
 	"github.com/jesseduffield/lazygit/pkg/config"
 	"github.com/jesseduffield/lazygit/pkg/gui/presentation/icons"
+	"github.com/jesseduffield/lazygit/pkg/gui/presentation/links"
 	"github.com/jesseduffield/lazygit/pkg/gui/style"
 	"github.com/jesseduffield/lazygit/pkg/gui/types"
 	"github.com/jesseduffield/lazygit/pkg/i18n"
@@ -29,10 +30,13 @@ func GetBranchListDisplayStrings(
 	tr *i18n.TranslationSet,
 	userConfig *config.UserConfig,
 	worktrees []*models.Worktree,
+	relativeDates bool,
+	linker *links.Linker,
 ) [][]string {
+	glyphs := newStatusGlyphs(userConfig.Gui.StatusGlyphs)
 	return lo.Map(branches, func(branch *models.Branch, _ int) []string {
 		diffed := branch.Name == diffName
-		return getBranchDisplayStrings(branch, getItemOperation(branch), fullDescription, diffed, viewWidth, tr, userConfig, worktrees, time.Now())
+		return getBranchDisplayStrings(branch, getItemOperation(branch), fullDescription, diffed, viewWidth, tr, userConfig, glyphs, worktrees, relativeDates, time.Now(), linker)
 	})
 }
 
@@ -45,16 +49,25 @@ func getBranchDisplayStrings(
 	viewWidth int,
 	tr *i18n.TranslationSet,
 	userConfig *config.UserConfig,
+	glyphs statusGlyphs,
 	worktrees []*models.Worktree,
+	relativeDates bool,
 	now time.Time,
+	linker *links.Linker,
 ) []string {
 	checkedOutByWorkTree := git_commands.CheckedOutByOtherWorktree(b, worktrees)
 	showCommitHash := fullDescription || userConfig.Gui.ShowBranchCommitHash
-	branchStatus := BranchStatus(b, itemOperation, tr, now)
+	branchStatus := getBranchStatus(b, itemOperation, tr, now, glyphs)
 	worktreeIcon := lo.Ternary(icons.IsIconEnabled(), icons.LINKED_WORKTREE_ICON, fmt.Sprintf("(%s)", tr.LcWorktree))
 
-	// Recency is always three characters, plus one for the space
-	availableWidth := viewWidth - 4
+	recency := b.Recency
+	if !relativeDates && !b.Head && b.RecencyUnixTimestamp != 0 {
+		timeFormat, shortTimeFormat := userConfig.Gui.TimeFormatsFor(userConfig.Gui.ViewTimeFormats.Branches)
+		recency = utils.UnixToDateSmart(now, b.RecencyUnixTimestamp, timeFormat, shortTimeFormat)
+	}
+
+	// Relative recency is always three characters, plus one for the space
+	availableWidth := viewWidth - utils.Max(runewidth.StringWidth(recency), 3) - 1
 	if len(branchStatus) > 0 {
 		availableWidth -= runewidth.StringWidth(branchStatus) + 1
 	}
@@ -62,7 +75,7 @@ func getBranchDisplayStrings(
 		availableWidth -= 2 // one for the icon, one for the space
 	}
 	if showCommitHash {
-		availableWidth -= utils.COMMIT_HASH_SHORT_SIZE + 1
+		availableWidth -= runewidth.StringWidth(utils.ShortShaOfLength(b.CommitHash, userConfig.Gui.CommitHashLength)) + 1
 	}
 	if checkedOutByWorkTree {
 		availableWidth -= runewidth.StringWidth(worktreeIcon) + 1
@@ -82,13 +95,17 @@ func getBranchDisplayStrings(
 		// Never shorten the branch name to less then 3 characters
 		len := utils.Max(availableWidth, 4)
 		displayName = displayName[:len-1] + "…"
+	} else {
+		// we don't link a truncated name because it might end in a truncated
+		// issue number, which would link to the wrong issue
+		displayName = linker.Render(displayName, nil)
 	}
 	coloredName := nameTextStyle.Sprint(displayName)
 	if checkedOutByWorkTree {
 		coloredName = fmt.Sprintf("%s %s", coloredName, style.FgDefault.Sprint(worktreeIcon))
 	}
 	if len(branchStatus) > 0 {
-		coloredStatus := branchStatusColor(b, itemOperation).Sprint(branchStatus)
+		coloredStatus := branchStatusColor(b, itemOperation, glyphs).Sprint(branchStatus)
 		coloredName = fmt.Sprintf("%s %s", coloredName, coloredStatus)
 	}
 
@@ -98,14 +115,14 @@ func getBranchDisplayStrings(
 	}
 
 	res := make([]string, 0, 6)
-	res = append(res, recencyColor.Sprint(b.Recency))
+	res = append(res, recencyColor.Sprint(recency))
 
 	if icons.IsIconEnabled() {
 		res = append(res, nameTextStyle.Sprint(icons.IconForBranch(b)))
 	}
 
 	if showCommitHash {
-		res = append(res, utils.ShortSha(b.CommitHash))
+		res = append(res, utils.ShortShaOfLength(b.CommitHash, userConfig.Gui.CommitHashLength))
 	}
 
 	res = append(res, coloredName)
@@ -143,26 +160,31 @@ func GetBranchTextStyle(name string) style.TextStyle {
 	}
 }
 
-func branchStatusColor(branch *models.Branch, itemOperation types.ItemOperation) style.TextStyle {
-	colour := style.FgYellow
+func branchStatusColor(branch *models.Branch, itemOperation types.ItemOperation, glyphs statusGlyphs) style.TextStyle {
+	colour := glyphs.aheadBehindColor
 	if itemOperation != types.ItemOperationNone {
 		colour = style.FgCyan
 	} else if branch.UpstreamGone {
-		colour = style.FgRed
+		colour = glyphs.upstreamGoneColor
 	} else if branch.MatchesUpstream() {
-		colour = style.FgGreen
+		colour = glyphs.inSyncColor
 	} else if branch.RemoteBranchNotStoredLocally() {
-		colour = style.FgMagenta
+		colour = glyphs.upstreamNotFetchedColor
 	}
 
 	return colour
 }
 
-func ColoredBranchStatus(branch *models.Branch, itemOperation types.ItemOperation, tr *i18n.TranslationSet) string {
-	return branchStatusColor(branch, itemOperation).Sprint(BranchStatus(branch, itemOperation, tr, time.Now()))
+func ColoredBranchStatus(branch *models.Branch, itemOperation types.ItemOperation, tr *i18n.TranslationSet, glyphsConfig config.StatusGlyphsConfig) string {
+	glyphs := newStatusGlyphs(glyphsConfig)
+	return branchStatusColor(branch, itemOperation, glyphs).Sprint(getBranchStatus(branch, itemOperation, tr, time.Now(), glyphs))
+}
+
+func BranchStatus(branch *models.Branch, itemOperation types.ItemOperation, tr *i18n.TranslationSet, now time.Time, glyphsConfig config.StatusGlyphsConfig) string {
+	return getBranchStatus(branch, itemOperation, tr, now, newStatusGlyphs(glyphsConfig))
 }
 
-func BranchStatus(branch *models.Branch, itemOperation types.ItemOperation, tr *i18n.TranslationSet, now time.Time) string {
+func getBranchStatus(branch *models.Branch, itemOperation types.ItemOperation, tr *i18n.TranslationSet, now time.Time, glyphs statusGlyphs) string {
 	itemOperationStr := ItemOperationToString(itemOperation, tr)
 	if itemOperationStr != "" {
 		return itemOperationStr + " " + utils.Loader(now)
@@ -177,18 +199,18 @@ func BranchStatus(branch *models.Branch, itemOperation types.ItemOperation, tr *
 	}
 
 	if branch.MatchesUpstream() {
-		return "✓"
+		return glyphs.inSync
 	}
 	if branch.RemoteBranchNotStoredLocally() {
-		return "?"
+		return glyphs.upstreamNotFetched
 	}
 
 	result := ""
 	if branch.HasCommitsToPush() {
-		result = fmt.Sprintf("↑%s", branch.Pushables)
+		result = glyphs.ahead + branch.Pushables
 	}
 	if branch.HasCommitsToPull() {
-		result = fmt.Sprintf("%s↓%s", result, branch.Pullables)
+		result += glyphs.behind + branch.Pullables
 	}
 
 	return result
@@ -6,14 +6,21 @@ import (
 	"time"
 
 	"github.com/jesseduffield/lazygit/pkg/commands/models"
+	"github.com/jesseduffield/lazygit/pkg/config"
 	"github.com/jesseduffield/lazygit/pkg/gui/presentation/icons"
+	"github.com/jesseduffield/lazygit/pkg/gui/presentation/links"
 	"github.com/jesseduffield/lazygit/pkg/gui/types"
+	"github.com/jesseduffield/lazygit/pkg/i18n"
 	"github.com/jesseduffield/lazygit/pkg/utils"
 	"github.com/samber/lo"
 	"github.com/stretchr/testify/assert"
 )
 
 func Test_getBranchDisplayStrings(t *testing.T) {
+	issueLinker := links.NewLinker(nil, func(number string) string {
+		return "https://example.com/issues/" + number
+	}, utils.NewDummyLog())
+
 	scenarios := []struct {
 		branch               *models.Branch
 		itemOperation        types.ItemOperation
@@ -21,6 +28,8 @@ func Test_getBranchDisplayStrings(t *testing.T) {
 		viewWidth            int
 		useIcons             bool
 		checkedOutByWorktree bool
+		absoluteDates        bool
+		linker               *links.Linker
 		expected             []string
 	}{
 		// First some tests for when the view is wide enough so that everything fits:
@@ -51,6 +60,16 @@ func Test_getBranchDisplayStrings(t *testing.T) {
 			checkedOutByWorktree: true,
 			expected:             []string{"1m", "󰘬", "branch_name 󰌹"},
 		},
+		{
+			branch:               &models.Branch{Name: "branch_name", Recency: "1m", RecencyUnixTimestamp: 1577880000},
+			itemOperation:        types.ItemOperationNone,
+			fullDescription:      false,
+			viewWidth:            100,
+			useIcons:             false,
+			checkedOutByWorktree: false,
+			absoluteDates:        true,
+			expected:             []string{"01 Jan 20", "branch_name"},
+		},
 		{
 			branch: &models.Branch{
 				Name:           "branch_name",
@@ -194,6 +213,29 @@ func Test_getBranchDisplayStrings(t *testing.T) {
 			checkedOutByWorktree: false,
 			expected:             []string{"1m", "12345678", "bran… ✓", "origin branch_name", "commit title"},
 		},
+		{
+			// with colours turned off the underline of the link is stripped
+			// too, so we only see that linking leaves the name intact
+			branch:               &models.Branch{Name: "fix/#12-crash", Recency: "1m"},
+			itemOperation:        types.ItemOperationNone,
+			fullDescription:      false,
+			viewWidth:            100,
+			useIcons:             false,
+			checkedOutByWorktree: false,
+			linker:               issueLinker,
+			expected:             []string{"1m", "fix/#12-crash"},
+		},
+		{
+			// a truncated name isn't linked
+			branch:               &models.Branch{Name: "fix/#12-crash", Recency: "1m"},
+			itemOperation:        types.ItemOperationNone,
+			fullDescription:      false,
+			viewWidth:            10,
+			useIcons:             false,
+			checkedOutByWorktree: false,
+			linker:               issueLinker,
+			expected:             []string{"1m", "fix/#…"},
+		},
 	}
 
 	c := utils.NewDummyCommon()
@@ -207,8 +249,46 @@ func Test_getBranchDisplayStrings(t *testing.T) {
 		}
 
 		t.Run(fmt.Sprintf("getBranchDisplayStrings_%d", i), func(t *testing.T) {
-			strings := getBranchDisplayStrings(s.branch, s.itemOperation, s.fullDescription, false, s.viewWidth, c.Tr, c.UserConfig, worktrees, time.Time{})
+			strings := getBranchDisplayStrings(s.branch, s.itemOperation, s.fullDescription, false, s.viewWidth, c.Tr, c.UserConfig, newStatusGlyphs(c.UserConfig.Gui.StatusGlyphs), worktrees, !s.absoluteDates, time.Time{}, s.linker)
 			assert.Equal(t, s.expected, strings)
 		})
 	}
 }
+
+func TestBranchStatusWithCustomGlyphs(t *testing.T) {
+	glyphsConfig := config.GetDefaultConfig().Gui.StatusGlyphs
+	glyphsConfig.Ahead = "^"
+	glyphsConfig.Behind = "v"
+	glyphsConfig.InSync = "="
+	glyphsConfig.UpstreamNotFetched = "??"
+
+	tr := i18n.EnglishTranslationSet()
+	scenarios := []struct {
+		name     string
+		branch   *models.Branch
+		expected string
+	}{
+		{
+			name:     "ahead and behind",
+			branch:   &models.Branch{Name: "a", UpstreamRemote: "origin", Pushables: "2", Pullables: "3"},
+			expected: "^2v3",
+		},
+		{
+			name:     "in sync",
+			branch:   &models.Branch{Name: "a", UpstreamRemote: "origin", Pushables: "0", Pullables: "0"},
+			expected: "=",
+		},
+		{
+			name:     "upstream not fetched",
+			branch:   &models.Branch{Name: "a", UpstreamRemote: "origin", Pushables: "?", Pullables: "?"},
+			expected: "??",
+		},
+	}
+
+	for _, s := range scenarios {
+		s := s
+		t.Run(s.name, func(t *testing.T) {
+			assert.Equal(t, s.expected, BranchStatus(s.branch, types.ItemOperationNone, &tr, time.Time{}, glyphsConfig))
+		})
+	}
+}
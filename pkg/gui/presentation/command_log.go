@@ -0,0 +1,62 @@
+package presentation
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/jesseduffield/lazygit/pkg/commands/models"
+	"github.com/jesseduffield/lazygit/pkg/gui/style"
+	"github.com/jesseduffield/lazygit/pkg/theme"
+	"github.com/samber/lo"
+)
+
+func GetCommandLogDisplayStrings(entries []*models.CommandLogEntry, slowThreshold time.Duration) [][]string {
+	return lo.Map(entries, func(entry *models.CommandLogEntry, _ int) []string {
+		return getCommandLogEntryDisplayStrings(entry, slowThreshold)
+	})
+}
+
+func getCommandLogEntryDisplayStrings(entry *models.CommandLogEntry, slowThreshold time.Duration) []string {
+	textStyle := theme.DefaultTextColor
+	if !entry.IsCommandLine {
+		// same as in the regular command log
+		textStyle = style.FgMagenta
+	}
+
+	// the list has one line per entry, so multi-line commands are squashed
+	command := strings.Join(strings.Fields(entry.Command), " ")
+
+	return []string{
+		style.FgYellow.Sprint(entry.Action),
+		commandResultDisplayString(entry, slowThreshold),
+		textStyle.Sprint(command),
+	}
+}
+
+func commandResultDisplayString(entry *models.CommandLogEntry, slowThreshold time.Duration) string {
+	if !entry.Finished {
+		return ""
+	}
+
+	result := FormatCommandDuration(entry.Duration)
+	if entry.Failed() {
+		return style.FgRed.Sprint(fmt.Sprintf("exit %d, %s", entry.ExitCode, result))
+	}
+
+	if entry.IsSlow(slowThreshold) {
+		return style.FgYellow.Sprint(result)
+	}
+
+	return result
+}
+
+// FormatCommandDuration rounds the duration so that it reads nicely e.g. '12ms'
+// or '1.5s'
+func FormatCommandDuration(duration time.Duration) string {
+	if duration < time.Second {
+		return duration.Round(time.Millisecond).String()
+	}
+
+	return duration.Round(100 * time.Millisecond).String()
+}
@@ -0,0 +1,41 @@
+package presentation
+
+import (
+	"testing"
+	"time"
+
+	"github.com/jesseduffield/lazygit/pkg/commands/models"
+	"github.com/jesseduffield/lazygit/pkg/utils"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGetCommandLogDisplayStrings(t *testing.T) {
+	entries := []*models.CommandLogEntry{
+		{Action: "Fetch", Command: "git fetch", IsCommandLine: true, Finished: true, Duration: 1234 * time.Millisecond},
+		{Action: "Stage file", Command: "git add -- file", IsCommandLine: true, Finished: true, Duration: 12 * time.Millisecond},
+		{Action: "Commit", Command: "git commit\n-m msg", IsCommandLine: true, Finished: true, Duration: 40 * time.Millisecond, ExitCode: 1},
+		{Action: "Pull", Command: "git pull", IsCommandLine: true},
+		{Action: "Copy", Command: "Copying to clipboard"},
+	}
+
+	lines := GetCommandLogDisplayStrings(entries, time.Second)
+
+	assert.EqualValues(t, [][]string{
+		{"Fetch", "1.2s", "git fetch"},
+		{"Stage file", "12ms", "git add -- file"},
+		{"Commit", "exit 1, 40ms", "git commit -m msg"},
+		{"Pull", "", "git pull"},
+		{"Copy", "", "Copying to clipboard"},
+	}, lo.Map(lines, func(line []string, _ int) []string {
+		return lo.Map(line, func(column string, _ int) string { return utils.Decolorise(column) })
+	}))
+}
+
+func TestFormatCommandDuration(t *testing.T) {
+	assert.Equal(t, "0s", FormatCommandDuration(0))
+	assert.Equal(t, "3ms", FormatCommandDuration(3200*time.Microsecond))
+	assert.Equal(t, "999ms", FormatCommandDuration(999*time.Millisecond))
+	assert.Equal(t, "2.5s", FormatCommandDuration(2468*time.Millisecond))
+	assert.Equal(t, "1m5s", FormatCommandDuration(65*time.Second))
+}
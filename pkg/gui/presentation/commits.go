@@ -13,6 +13,7 @@ import (
 	"github.com/jesseduffield/lazygit/pkg/gui/presentation/authors"
 	"github.com/jesseduffield/lazygit/pkg/gui/presentation/graph"
 	"github.com/jesseduffield/lazygit/pkg/gui/presentation/icons"
+	"github.com/jesseduffield/lazygit/pkg/gui/presentation/links"
 	"github.com/jesseduffield/lazygit/pkg/gui/style"
 	"github.com/jesseduffield/lazygit/pkg/theme"
 	"github.com/jesseduffield/lazygit/pkg/utils"
@@ -48,6 +49,7 @@ func GetCommitListDisplayStrings(
 	markedBaseCommit string,
 	timeFormat string,
 	shortTimeFormat string,
+	relativeDates bool,
 	now time.Time,
 	parseEmoji bool,
 	selectedCommitSha string,
@@ -56,6 +58,8 @@ func GetCommitListDisplayStrings(
 	showGraph bool,
 	bisectInfo *git_commands.BisectInfo,
 	showYouAreHereLabel bool,
+	ownAuthorEmail string,
+	linker *links.Linker,
 ) [][]string {
 	mutex.Lock()
 	defer mutex.Unlock()
@@ -126,7 +130,10 @@ func GetCommitListDisplayStrings(
 					(showBranchMarkerForHeadCommit || b.CommitHash != commits[0].Sha)
 		}))
 
+	fixupTargets := getFixupTargets(commits[:indexOfFirstNonTODOCommit(commits)])
+
 	lines := make([][]string, 0, len(filteredCommits))
+	glyphs := newStatusGlyphs(common.UserConfig.Gui.StatusGlyphs)
 	var bisectStatus BisectStatus
 	willBeRebased := markedBaseCommit == ""
 	for i, commit := range filteredCommits {
@@ -143,6 +150,7 @@ func GetCommitListDisplayStrings(
 		}
 		lines = append(lines, displayCommit(
 			common,
+			glyphs,
 			commit,
 			branchHeadsToVisualize,
 			cherryPickedCommitShaSet,
@@ -151,13 +159,18 @@ func GetCommitListDisplayStrings(
 			diffName,
 			timeFormat,
 			shortTimeFormat,
+			relativeDates,
 			now,
 			parseEmoji,
 			getGraphLine(unfilteredIdx),
 			fullDescription,
 			bisectStatus,
 			bisectInfo,
+			getBisectRangeMarker(unfilteredIdx, bisectStatus, bisectBounds),
 			isYouAreHereCommit,
+			ownAuthorEmail != "" && strings.EqualFold(commit.AuthorEmail, ownAuthorEmail),
+			fixupTargets[commit],
+			linker,
 		))
 	}
 	return lines
@@ -186,6 +199,45 @@ func getbisectBounds(commits []*models.Commit, bisectInfo *git_commands.BisectIn
 	return nil
 }
 
+var fixupPrefixes = []string{"fixup! ", "squash! ", "amend! "}
+
+// getFixupTargets takes the TODO commits of a rebase and finds, for each
+// fixup!, squash! or amend! commit, the commit that it would be squashed into,
+// using the same rules as git's autosquash: an exact subject match, then a
+// sha, then a subject prefix. The target has to come before the fixup in the
+// rebase, i.e. further down the list.
+func getFixupTargets(todoCommits []*models.Commit) map[*models.Commit]*models.Commit {
+	result := map[*models.Commit]*models.Commit{}
+
+	for i, commit := range todoCommits {
+		prefix, ok := lo.Find(fixupPrefixes, func(prefix string) bool {
+			return strings.HasPrefix(commit.Name, prefix)
+		})
+		if !ok {
+			continue
+		}
+
+		subject := strings.TrimPrefix(commit.Name, prefix)
+		candidates := lo.Filter(todoCommits[i+1:], func(c *models.Commit, _ int) bool {
+			return c.Sha != ""
+		})
+
+		matchers := []func(*models.Commit) bool{
+			func(c *models.Commit) bool { return c.Name == subject },
+			func(c *models.Commit) bool { return len(subject) >= 4 && strings.HasPrefix(c.Sha, subject) },
+			func(c *models.Commit) bool { return strings.HasPrefix(c.Name, subject) },
+		}
+		for _, matcher := range matchers {
+			if target, found := lo.Find(candidates, matcher); found {
+				result[commit] = target
+				break
+			}
+		}
+	}
+
+	return result
+}
+
 // precondition: slice is not empty
 func indexOfFirstNonTODOCommit(commits []*models.Commit) int {
 	for i, commit := range commits {
@@ -292,8 +344,27 @@ func getBisectStatusText(bisectStatus BisectStatus, bisectInfo *git_commands.Bis
 	return ""
 }
 
+// getBisectRangeMarker returns a coloured bar showing whether the commit is in
+// the part of the list already known to be new, the part known to be old, or
+// the part that is still being searched
+func getBisectRangeMarker(index int, bisectStatus BisectStatus, bisectBounds *bisectBounds) string {
+	if bisectBounds == nil {
+		return ""
+	}
+
+	color := getBisectStatusColor(bisectStatus)
+	if index <= bisectBounds.newIndex {
+		color = getBisectStatusColor(BisectStatusNew)
+	} else if index >= bisectBounds.oldIndex {
+		color = getBisectStatusColor(BisectStatusOld)
+	}
+
+	return color.Sprint("▌")
+}
+
 func displayCommit(
 	common *common.Common,
+	glyphs statusGlyphs,
 	commit *models.Commit,
 	branchHeadsToVisualize *set.Set[string],
 	cherryPickedCommitShaSet *set.Set[string],
@@ -302,15 +373,20 @@ func displayCommit(
 	diffName string,
 	timeFormat string,
 	shortTimeFormat string,
+	relativeDates bool,
 	now time.Time,
 	parseEmoji bool,
 	graphLine string,
 	fullDescription bool,
 	bisectStatus BisectStatus,
 	bisectInfo *git_commands.BisectInfo,
+	bisectRangeMarker string,
 	isYouAreHereCommit bool,
+	isOwnCommit bool,
+	fixupTarget *models.Commit,
+	linker *links.Linker,
 ) []string {
-	shaColor := getShaColor(commit, diffName, cherryPickedCommitShaSet, bisectStatus, bisectInfo)
+	shaColor := getShaColor(commit, glyphs, diffName, cherryPickedCommitShaSet, bisectStatus, bisectInfo)
 	bisectString := getBisectStatusText(bisectStatus, bisectInfo)
 
 	actionString := ""
@@ -339,6 +415,7 @@ func displayCommit(
 	if parseEmoji {
 		name = emoji.Sprint(name)
 	}
+	name = linker.Render(name, nil)
 
 	mark := ""
 	if isYouAreHereCommit {
@@ -351,24 +428,51 @@ func displayCommit(
 	} else if !willBeRebased {
 		willBeRebased := style.FgYellow.Sprint("✓")
 		mark = fmt.Sprintf("%s ", willBeRebased)
+	} else if fixupTarget != nil {
+		target := style.FgMagenta.Sprintf("↳ %s", utils.ShortShaOfLength(fixupTarget.Sha, common.UserConfig.Gui.CommitHashLength))
+		mark = fmt.Sprintf("%s ", target)
+	} else if commit.AppliedUpstream {
+		// a rebase onto the upstream will drop this commit, so let the user know
+		appliedUpstream := style.FgGreen.Sprint(common.Tr.AppliedUpstreamMarker)
+		mark = fmt.Sprintf("%s ", appliedUpstream)
 	}
 
+	useInitialsBlock := common.UserConfig.Gui.AuthorInitialsBlock
 	authorFunc := authors.ShortAuthor
 	if fullDescription {
 		authorFunc = authors.LongAuthor
+	} else if useInitialsBlock {
+		authorFunc = authors.ShortAuthorBlock
+	}
+	if isOwnCommit {
+		authorFunc = func(authorName string) string {
+			if fullDescription {
+				return authors.LongAuthorWithStyle(authorName, theme.OwnCommitAuthorTextStyle)
+			} else if useInitialsBlock {
+				return authors.ShortAuthorBlockWithStyle(authorName, theme.OwnCommitAuthorTextStyle)
+			}
+			return authors.ShortAuthorWithStyle(authorName, theme.OwnCommitAuthorTextStyle)
+		}
 	}
 
-	cols := make([]string, 0, 7)
+	cols := make([]string, 0, 8)
+	if bisectRangeMarker != "" {
+		cols = append(cols, bisectRangeMarker)
+	}
 	if commit.Divergence != models.DivergenceNone {
-		cols = append(cols, shaColor.Sprint(lo.Ternary(commit.Divergence == models.DivergenceLeft, "↑", "↓")))
+		cols = append(cols, shaColor.Sprint(lo.Ternary(commit.Divergence == models.DivergenceLeft, glyphs.ahead, glyphs.behind)))
 	} else if icons.IsIconEnabled() {
 		cols = append(cols, shaColor.Sprint(icons.IconForCommit(commit)))
 	}
-	cols = append(cols, shaColor.Sprint(commit.ShortSha()))
+	if glyphs.merged != "" {
+		// every commit gets the column, so that the hashes stay aligned
+		cols = append(cols, shaColor.Sprint(lo.Ternary(commit.Status == models.StatusMerged, glyphs.merged, "")))
+	}
+	cols = append(cols, shaColor.Sprint(utils.ShortShaOfLength(commit.Sha, common.UserConfig.Gui.CommitHashLength)))
 	cols = append(cols, bisectString)
 	if fullDescription {
 		cols = append(cols, style.FgBlue.Sprint(
-			utils.UnixToDateSmart(now, commit.UnixTimestamp, timeFormat, shortTimeFormat),
+			utils.UnixToDate(now, commit.UnixTimestamp, relativeDates, timeFormat, shortTimeFormat),
 		))
 	}
 	cols = append(
@@ -403,6 +507,7 @@ func getBisectStatusColor(status BisectStatus) style.TextStyle {
 
 func getShaColor(
 	commit *models.Commit,
+	glyphs statusGlyphs,
 	diffName string,
 	cherryPickedCommitShaSet *set.Set[string],
 	bisectStatus BisectStatus,
@@ -416,11 +521,11 @@ func getShaColor(
 	shaColor := theme.DefaultTextColor
 	switch commit.Status {
 	case models.StatusUnpushed:
-		shaColor = style.FgRed
+		shaColor = glyphs.unpushedCommitColor
 	case models.StatusPushed:
-		shaColor = style.FgYellow
+		shaColor = glyphs.pushedCommitColor
 	case models.StatusMerged:
-		shaColor = style.FgGreen
+		shaColor = glyphs.mergedCommitColor
 	case models.StatusRebasing:
 		shaColor = style.FgBlue
 	case models.StatusReflog:
@@ -449,6 +554,8 @@ func actionColorMap(action todo.TodoCommand) style.TextStyle {
 		return style.FgGreen
 	case todo.Fixup:
 		return style.FgMagenta
+	case todo.Label, todo.Reset:
+		return style.FgBlue
 	case models.ActionConflict:
 		return style.FgRed
 	default:
@@ -0,0 +1,77 @@
+package presentation
+
+import (
+	"strings"
+
+	"github.com/jesseduffield/generics/set"
+	"github.com/jesseduffield/lazygit/pkg/commands/models"
+	"github.com/jesseduffield/lazygit/pkg/gui/presentation/authors"
+	"github.com/jesseduffield/lazygit/pkg/gui/style"
+	"github.com/jesseduffield/lazygit/pkg/utils"
+	"github.com/samber/lo"
+)
+
+var minimapBranchColors = []style.TextStyle{
+	style.FgCyan,
+	style.FgMagenta,
+	style.FgYellow,
+	style.FgGreen,
+	style.FgBlue,
+	style.FgRed,
+}
+
+// GetCommitsMinimap renders an overview of the commits list which fits in the
+// given height, given the colours of the commits as returned by
+// GetCommitsMinimapColors: each line stands for a bucket of consecutive commits,
+// coloured after the first commit in the bucket. Buckets overlapping the visible
+// part of the list (from visibleStartIdx up to but not including visibleEndIdx)
+// are drawn as full blocks so that the user can see where they are.
+func GetCommitsMinimap(
+	colors []style.TextStyle,
+	height int,
+	visibleStartIdx int,
+	visibleEndIdx int,
+) string {
+	if len(colors) == 0 || height <= 0 {
+		return ""
+	}
+
+	bucketSize := (len(colors) + height - 1) / height
+
+	lines := make([]string, 0, height)
+	for startIdx := 0; startIdx < len(colors); startIdx += bucketSize {
+		endIdx := utils.Min(startIdx+bucketSize, len(colors))
+		cell := "▌"
+		if startIdx < visibleEndIdx && endIdx > visibleStartIdx {
+			cell = "█"
+		}
+		lines = append(lines, colors[startIdx].Sprint(cell))
+	}
+
+	return strings.Join(lines, "\n")
+}
+
+// GetCommitsMinimapColors works out the colour of each commit in the minimap.
+// It only depends on the commits and branches, not on the view, so it can be
+// computed once per refresh rather than on every layout.
+func GetCommitsMinimapColors(commits []*models.Commit, branches []*models.Branch, colorBy string) []style.TextStyle {
+	if colorBy == "author" {
+		return lo.Map(commits, func(commit *models.Commit, _ int) style.TextStyle {
+			return authors.AuthorStyle(commit.AuthorName)
+		})
+	}
+
+	// A commit belongs to the closest branch head at or above it in the list,
+	// or to the checked-out branch if there is none
+	branchHeads := set.NewFromSlice(lo.FilterMap(branches, func(branch *models.Branch, _ int) (string, bool) {
+		return branch.CommitHash, !branch.Head && branch.CommitHash != ""
+	}))
+
+	colorIdx := 0
+	return lo.Map(commits, func(commit *models.Commit, idx int) style.TextStyle {
+		if idx > 0 && branchHeads.Includes(commit.Sha) {
+			colorIdx++
+		}
+		return minimapBranchColors[colorIdx%len(minimapBranchColors)]
+	})
+}
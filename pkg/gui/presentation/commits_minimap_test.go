@@ -0,0 +1,72 @@
+package presentation
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/jesseduffield/lazygit/pkg/commands/models"
+	"github.com/jesseduffield/lazygit/pkg/gui/style"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGetCommitsMinimap(t *testing.T) {
+	commits := lo.Map(lo.Range(10), func(i int, _ int) *models.Commit {
+		return &models.Commit{Sha: fmt.Sprintf("sha%d", i)}
+	})
+
+	scenarios := []struct {
+		testName        string
+		height          int
+		visibleStartIdx int
+		visibleEndIdx   int
+		expected        string
+	}{
+		{
+			testName:        "one commit per cell",
+			height:          12,
+			visibleStartIdx: 0,
+			visibleEndIdx:   3,
+			expected:        "█\n█\n█\n▌\n▌\n▌\n▌\n▌\n▌\n▌",
+		},
+		{
+			testName:        "several commits per cell",
+			height:          4,
+			visibleStartIdx: 4,
+			visibleEndIdx:   7,
+			expected:        "▌\n█\n█\n▌",
+		},
+		{
+			testName:        "no room",
+			height:          0,
+			visibleStartIdx: 0,
+			visibleEndIdx:   0,
+			expected:        "",
+		},
+	}
+
+	for _, s := range scenarios {
+		s := s
+		t.Run(s.testName, func(t *testing.T) {
+			assert.Equal(t, s.expected, GetCommitsMinimap(GetCommitsMinimapColors(commits, nil, "branch"), s.height, s.visibleStartIdx, s.visibleEndIdx))
+		})
+	}
+}
+
+func TestGetMinimapColorsByBranch(t *testing.T) {
+	commits := []*models.Commit{
+		{Sha: "a"},
+		{Sha: "b"},
+		{Sha: "c"},
+		{Sha: "d"},
+	}
+	branches := []*models.Branch{
+		{Name: "current", CommitHash: "a", Head: true},
+		{Name: "stacked", CommitHash: "c"},
+	}
+
+	assert.Equal(t,
+		[]style.TextStyle{style.FgCyan, style.FgCyan, style.FgMagenta, style.FgMagenta},
+		GetCommitsMinimapColors(commits, branches, "branch"),
+	)
+}
@@ -11,6 +11,10 @@ import (
 	"github.com/jesseduffield/generics/set"
 	"github.com/jesseduffield/lazygit/pkg/commands/git_commands"
 	"github.com/jesseduffield/lazygit/pkg/commands/models"
+	"github.com/jesseduffield/lazygit/pkg/gui/presentation/authors"
+	"github.com/jesseduffield/lazygit/pkg/gui/presentation/links"
+	"github.com/jesseduffield/lazygit/pkg/gui/style"
+	"github.com/jesseduffield/lazygit/pkg/theme"
 	"github.com/jesseduffield/lazygit/pkg/utils"
 	"github.com/stretchr/testify/assert"
 	"github.com/xo/terminfo"
@@ -37,6 +41,7 @@ func TestGetCommitListDisplayStrings(t *testing.T) {
 		diffName                 string
 		timeFormat               string
 		shortTimeFormat          string
+		relativeDates            bool
 		now                      time.Time
 		parseEmoji               bool
 		selectedCommitSha        string
@@ -45,6 +50,9 @@ func TestGetCommitListDisplayStrings(t *testing.T) {
 		showGraph                bool
 		bisectInfo               *git_commands.BisectInfo
 		showYouAreHereLabel      bool
+		ownAuthorEmail           string
+		linker                   *links.Linker
+		mergedGlyph              string
 		expected                 string
 		focus                    bool
 	}{
@@ -76,6 +84,41 @@ func TestGetCommitListDisplayStrings(t *testing.T) {
 		sha2 commit2
 						`),
 		},
+		{
+			testName: "commit with issue reference",
+			commits: []*models.Commit{
+				{Name: "Fix crash (#12)", Sha: "sha1"},
+				{Name: "commit2", Sha: "sha2"},
+			},
+			startIdx:                 0,
+			endIdx:                   2,
+			showGraph:                false,
+			bisectInfo:               git_commands.NewNullBisectInfo(),
+			cherryPickedCommitShaSet: set.New[string](),
+			now:                      time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC),
+			linker: links.NewLinker(nil, func(number string) string {
+				return "https://github.com/owner/repo/issues/" + number
+			}, utils.NewDummyLog()),
+			// with colours turned off the underline is stripped too, so we only
+			// see that linking leaves the name intact
+			expected: "sha1 Fix crash (#12)\n" +
+				"sha2 commit2",
+		},
+		{
+			testName: "merged glyph",
+			commits: []*models.Commit{
+				{Name: "commit1", Sha: "sha1", Status: models.StatusUnpushed},
+				{Name: "commit2", Sha: "sha2", Status: models.StatusMerged},
+			},
+			startIdx:                 0,
+			endIdx:                   2,
+			showGraph:                false,
+			bisectInfo:               git_commands.NewNullBisectInfo(),
+			cherryPickedCommitShaSet: set.New[string](),
+			now:                      time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC),
+			mergedGlyph:              "M",
+			expected:                 "  sha1 commit1\nM sha2 commit2",
+		},
 		{
 			testName: "commit with tags",
 			commits: []*models.Commit{
@@ -93,6 +136,23 @@ func TestGetCommitListDisplayStrings(t *testing.T) {
 		sha2 commit2
 						`),
 		},
+		{
+			testName: "mark commits that are already applied upstream",
+			commits: []*models.Commit{
+				{Name: "commit1", Sha: "sha1", Status: models.StatusUnpushed, AppliedUpstream: true},
+				{Name: "commit2", Sha: "sha2", Status: models.StatusUnpushed},
+			},
+			startIdx:                 0,
+			endIdx:                   2,
+			showGraph:                false,
+			bisectInfo:               git_commands.NewNullBisectInfo(),
+			cherryPickedCommitShaSet: set.New[string](),
+			now:                      time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC),
+			expected: formatExpected(`
+		sha1 = already upstream commit1
+		sha2 commit2
+						`),
+		},
 		{
 			testName: "show local branch head, except the current branch, main branches, or merged branches",
 			commits: []*models.Commit{
@@ -302,6 +362,33 @@ func TestGetCommitListDisplayStrings(t *testing.T) {
 		sha2 pick  commit2
 				`),
 		},
+		{
+			testName: "show targets of fixup! and squash! TODO commits",
+			commits: []*models.Commit{
+				{Name: "squash! commit3", Sha: "sha1", Action: todo.Pick},
+				{Name: "fixup! commit2", Sha: "sha2", Action: todo.Fixup},
+				{Name: "commit2", Sha: "sha3", Action: todo.Pick},
+				{Name: "fixup! sha5", Sha: "sha4", Action: todo.Pick},
+				{Name: "commit3", Sha: "sha5", Action: todo.Pick},
+				{Name: "fixup! commit6", Sha: "sha6"},
+				{Name: "commit6", Sha: "sha7"},
+			},
+			startIdx:                 0,
+			endIdx:                   7,
+			showGraph:                false,
+			bisectInfo:               git_commands.NewNullBisectInfo(),
+			cherryPickedCommitShaSet: set.New[string](),
+			now:                      time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC),
+			expected: formatExpected(`
+		sha1 pick   ↳ sha5 squash! commit3
+		sha2 fixup  ↳ sha3 fixup! commit2
+		sha3 pick   commit2
+		sha4 pick   ↳ sha5 fixup! sha5
+		sha5 pick   commit3
+		sha6        fixup! commit6
+		sha7        commit6
+				`),
+		},
 		{
 			testName: "no TODO commits, towards bottom",
 			commits: []*models.Commit{
@@ -394,12 +481,15 @@ func TestGetCommitListDisplayStrings(t *testing.T) {
 		}
 	}
 
-	common := utils.NewDummyCommon()
-
 	for _, s := range scenarios {
 		s := s
 		if !focusing || s.focus {
 			t.Run(s.testName, func(t *testing.T) {
+				common := utils.NewDummyCommon()
+				if s.mergedGlyph != "" {
+					common.UserConfig.Gui.StatusGlyphs.Merged = s.mergedGlyph
+				}
+
 				result := GetCommitListDisplayStrings(
 					common,
 					s.commits,
@@ -412,6 +502,7 @@ func TestGetCommitListDisplayStrings(t *testing.T) {
 					s.markedBaseCommit,
 					s.timeFormat,
 					s.shortTimeFormat,
+					s.relativeDates,
 					s.now,
 					s.parseEmoji,
 					s.selectedCommitSha,
@@ -420,6 +511,8 @@ func TestGetCommitListDisplayStrings(t *testing.T) {
 					s.showGraph,
 					s.bisectInfo,
 					s.showYouAreHereLabel,
+					s.ownAuthorEmail,
+					s.linker,
 				)
 
 				renderedLines, _ := utils.RenderDisplayStrings(result, nil)
@@ -431,3 +524,47 @@ func TestGetCommitListDisplayStrings(t *testing.T) {
 		}
 	}
 }
+
+func TestGetCommitListDisplayStringsHighlightsOwnCommits(t *testing.T) {
+	color.ForceSetColorLevel(terminfo.ColorLevelMillions)
+	defer color.ForceSetColorLevel(terminfo.ColorLevelNone)
+
+	oldOwnCommitAuthorTextStyle := theme.OwnCommitAuthorTextStyle
+	theme.OwnCommitAuthorTextStyle = style.FgGreen.SetBold()
+	defer func() { theme.OwnCommitAuthorTextStyle = oldOwnCommitAuthorTextStyle }()
+
+	commits := []*models.Commit{
+		{Name: "mine", Sha: "sha1", AuthorName: "Jane Doe", AuthorEmail: "Jane@Example.com"},
+		{Name: "theirs", Sha: "sha2", AuthorName: "John Doe", AuthorEmail: "john@example.com"},
+	}
+
+	result := GetCommitListDisplayStrings(
+		utils.NewDummyCommon(),
+		commits,
+		nil,
+		"",
+		false,
+		true,
+		set.New[string](),
+		"",
+		"",
+		"",
+		"",
+		false,
+		time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC),
+		false,
+		"",
+		0,
+		2,
+		false,
+		git_commands.NewNullBisectInfo(),
+		false,
+		// the email is compared case-insensitively
+		"jane@example.com",
+		nil,
+	)
+
+	assert.Contains(t, result[0], authors.LongAuthorWithStyle("Jane Doe", theme.OwnCommitAuthorTextStyle))
+	assert.NotContains(t, result[1], authors.LongAuthorWithStyle("John Doe", theme.OwnCommitAuthorTextStyle))
+	assert.Contains(t, result[1], authors.LongAuthor("John Doe"))
+}
@@ -0,0 +1,84 @@
+package presentation
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/jesseduffield/lazygit/pkg/commands/git_commands"
+	"github.com/jesseduffield/lazygit/pkg/gui/presentation/authors"
+	"github.com/jesseduffield/lazygit/pkg/gui/style"
+	"github.com/jesseduffield/lazygit/pkg/i18n"
+	"github.com/jesseduffield/lazygit/pkg/theme"
+	"github.com/jesseduffield/lazygit/pkg/utils"
+	"github.com/samber/lo"
+)
+
+const fileOwnershipBarWidth = 10
+
+// GetFileOwnershipDisplayString renders who committed to the given files: a
+// chart of the authors across all of them, followed by a line per file showing
+// the share of its commits made by its main author, and who touched it last.
+func GetFileOwnershipDisplayString(ownerships []*git_commands.FileOwnership, width int, tr *i18n.TranslationSet) string {
+	totals := fileOwnershipTotals(ownerships)
+	if len(totals) == 0 {
+		return tr.NoCommitsForFiles
+	}
+
+	fileLines, _ := utils.RenderDisplayStrings(
+		lo.Map(ownerships, func(ownership *git_commands.FileOwnership, _ int) []string {
+			return getFileOwnershipDisplayStrings(ownership, tr)
+		}),
+		[]utils.Alignment{utils.AlignLeft, utils.AlignLeft, utils.AlignLeft, utils.AlignRight, utils.AlignLeft},
+	)
+
+	return strings.Join([]string{
+		style.AttrBold.Sprint(tr.FileOwnershipAuthors),
+		GetAuthorStatsDisplayString(totals, width),
+		"",
+		style.AttrBold.Sprint(tr.FileOwnershipFiles),
+		// files without commits leave the trailing columns blank
+		strings.Join(lo.Map(fileLines, func(line string, _ int) string { return strings.TrimRight(line, " ") }), "\n"),
+	}, "\n")
+}
+
+func getFileOwnershipDisplayStrings(ownership *git_commands.FileOwnership, tr *i18n.TranslationSet) []string {
+	if len(ownership.Authors) == 0 {
+		return []string{ownership.Path, theme.DefaultTextColor.Sprint(tr.NoCommitsYet), "", "", ""}
+	}
+
+	mainAuthor := ownership.Authors[0]
+	commitCount := ownership.CommitCount()
+	authorStyle := authors.AuthorStyle(mainAuthor.Name)
+	filled := utils.Max(1, mainAuthor.Count*fileOwnershipBarWidth/commitCount)
+	bar := authorStyle.Sprint(strings.Repeat("█", filled)) +
+		strings.Repeat("░", fileOwnershipBarWidth-filled)
+
+	return []string{
+		ownership.Path,
+		authorStyle.Sprint(mainAuthor.Name),
+		bar,
+		fmt.Sprintf("%d/%d", mainAuthor.Count, commitCount),
+		utils.ResolvePlaceholderString(tr.LastTouchedBy, map[string]string{
+			"author": authors.AuthorStyle(ownership.LastAuthor).Sprint(ownership.LastAuthor),
+		}),
+	}
+}
+
+// sums up the commits of each author over all files, most commits first
+func fileOwnershipTotals(ownerships []*git_commands.FileOwnership) []*git_commands.AuthorCommitCount {
+	totals := []*git_commands.AuthorCommitCount{}
+	for _, ownership := range ownerships {
+		for _, author := range ownership.Authors {
+			if total, found := lo.Find(totals, func(t *git_commands.AuthorCommitCount) bool { return t.Name == author.Name }); found {
+				total.Count += author.Count
+			} else {
+				totals = append(totals, &git_commands.AuthorCommitCount{Name: author.Name, Count: author.Count})
+			}
+		}
+	}
+
+	sort.SliceStable(totals, func(i, j int) bool { return totals[i].Count > totals[j].Count })
+
+	return totals
+}
@@ -1,16 +1,20 @@
 package presentation
 
 import (
+	"fmt"
 	"strings"
 
 	"github.com/gookit/color"
 	"github.com/jesseduffield/lazygit/pkg/commands/models"
 	"github.com/jesseduffield/lazygit/pkg/commands/patch"
+	"github.com/jesseduffield/lazygit/pkg/config"
 	"github.com/jesseduffield/lazygit/pkg/gui/filetree"
 	"github.com/jesseduffield/lazygit/pkg/gui/presentation/icons"
 	"github.com/jesseduffield/lazygit/pkg/gui/style"
+	"github.com/jesseduffield/lazygit/pkg/i18n"
 	"github.com/jesseduffield/lazygit/pkg/theme"
 	"github.com/jesseduffield/lazygit/pkg/utils"
+	"github.com/samber/lo"
 )
 
 const (
@@ -30,14 +34,104 @@ func RenderFileTree(
 	tree filetree.IFileTree,
 	diffName string,
 	submoduleConfigs []*models.SubmoduleConfig,
+	showNumstat bool,
+	fileSizes FileSizeOptions,
+	tr *i18n.TranslationSet,
 ) []string {
+	// when sorting by the number of changed lines we always show them, so
+	// that the order makes sense
+	showLineCounts := showNumstat || tree.GetSortOrder() == filetree.SortByChangedLines
+
 	return renderAux(tree.GetRoot().Raw(), tree.CollapsedPaths(), "", -1, func(node *filetree.Node[models.File], depth int) string {
 		fileNode := filetree.NewFileNode(node)
 
-		return getFileLine(fileNode.GetHasUnstagedChanges(), fileNode.GetHasStagedChanges(), fileNameAtDepth(node, depth), diffName, submoduleConfigs, node.File)
+		if section, ok := fileNode.GetSection(); ok {
+			return sectionHeader(section, len(node.GetLeaves()), tr)
+		}
+
+		line := getFileLine(fileNode.GetHasUnstagedChanges(), fileNode.GetHasStagedChanges(), fileNameAtDepth(node, depth), diffName, submoduleConfigs, node.File, fileNode.GetConflictCount())
+		if showLineCounts {
+			line += lineCounts(node)
+		}
+		if node.File != nil && !node.File.IsWorktree && !node.File.IsNestedRepo && !node.File.IsSubmodule(submoduleConfigs) {
+			line += fileSize(node.File, fileSizes)
+		}
+		return line
 	})
 }
 
+func sectionHeader(section filetree.FileSection, fileCount int, tr *i18n.TranslationSet) string {
+	titles := map[filetree.FileSection]string{
+		filetree.SectionConflicts: tr.ConflictsSection,
+		filetree.SectionStaged:    tr.StagedSection,
+		filetree.SectionUnstaged:  tr.UnstagedSection,
+		filetree.SectionUntracked: tr.UntrackedSection,
+	}
+
+	return style.AttrBold.Sprint(titles[section]) + theme.DefaultTextColor.Sprintf(" (%d)", fileCount)
+}
+
+type FileSizeOptions struct {
+	// if false, we only show the sizes of large files
+	ShowAll bool
+	// files at least this big are highlighted in yellow; zero disables this
+	LargeThreshold int64
+	// files at least this big are highlighted in red; zero disables this
+	HugeThreshold int64
+}
+
+// NewFileSizeOptions parses the thresholds from the config. Invalid thresholds
+// are returned as zero, along with an error
+func NewFileSizeOptions(filesConfig config.FilesPanelConfig) (FileSizeOptions, error) {
+	parseThreshold := func(str string) (int64, error) {
+		if str == "" {
+			return 0, nil
+		}
+		return utils.ParseByteSize(str)
+	}
+
+	largeThreshold, largeErr := parseThreshold(filesConfig.LargeFileThreshold)
+	hugeThreshold, hugeErr := parseThreshold(filesConfig.HugeFileThreshold)
+
+	return FileSizeOptions{
+		ShowAll:        filesConfig.ShowFileSizes,
+		LargeThreshold: largeThreshold,
+		HugeThreshold:  hugeThreshold,
+	}, lo.Ternary(largeErr != nil, largeErr, hugeErr)
+}
+
+func fileSize(file *models.File, opts FileSizeOptions) string {
+	if file.Deleted {
+		return ""
+	}
+
+	sizeColor := theme.DefaultTextColor
+	if opts.HugeThreshold > 0 && file.Size >= opts.HugeThreshold {
+		sizeColor = style.FgRed
+	} else if opts.LargeThreshold > 0 && file.Size >= opts.LargeThreshold {
+		sizeColor = style.FgYellow
+	} else if !opts.ShowAll {
+		return ""
+	}
+
+	return " " + sizeColor.Sprint(utils.FormatByteSize(file.Size))
+}
+
+// for a directory it's the sum over its files
+func lineCounts(node *filetree.Node[models.File]) string {
+	added, deleted := 0, 0
+	_ = node.ForEachFile(func(file *models.File) error {
+		added += file.LinesAdded
+		deleted += file.LinesDeleted
+		return nil
+	})
+	if added == 0 && deleted == 0 {
+		return ""
+	}
+
+	return fmt.Sprintf(" %s %s", style.FgGreen.Sprintf("+%d", added), style.FgRed.Sprintf("-%d", deleted))
+}
+
 func RenderCommitFileTree(
 	tree *filetree.CommitFileTreeViewModel,
 	diffName string,
@@ -119,7 +213,7 @@ func renderAux[T any](
 	return arr
 }
 
-func getFileLine(hasUnstagedChanges bool, hasStagedChanges bool, name string, diffName string, submoduleConfigs []*models.SubmoduleConfig, file *models.File) string {
+func getFileLine(hasUnstagedChanges bool, hasStagedChanges bool, name string, diffName string, submoduleConfigs []*models.SubmoduleConfig, file *models.File, conflictCount int) string {
 	// potentially inefficient to be instantiating these color
 	// objects with each render
 	partiallyModifiedColor := style.FgYellow
@@ -156,11 +250,13 @@ func getFileLine(hasUnstagedChanges bool, hasStagedChanges bool, name string, di
 	}
 
 	isSubmodule := file != nil && file.IsSubmodule(submoduleConfigs)
+	isNestedRepo := file != nil && file.IsNestedRepo
 	isLinkedWorktree := file != nil && file.IsWorktree
 	isDirectory := file == nil
 
 	if icons.IsIconEnabled() {
-		icon := icons.IconForFile(name, isSubmodule, isLinkedWorktree, isDirectory)
+		// a nested repo looks just like a submodule on disk
+		icon := icons.IconForFile(name, isSubmodule || isNestedRepo, isLinkedWorktree, isDirectory)
 		paint := color.C256(icon.Color, false)
 		output += paint.Sprint(icon.Icon) + " "
 	}
@@ -171,9 +267,55 @@ func getFileLine(hasUnstagedChanges bool, hasStagedChanges bool, name string, di
 		output += theme.DefaultTextColor.Sprint(" (submodule)")
 	}
 
+	if isNestedRepo {
+		output += style.FgMagenta.Sprint(" (nested repo)")
+	}
+
+	if file != nil && file.HasModeChange() {
+		output += style.FgCyan.Sprint(" (" + ModeChange(file) + ")")
+	}
+
+	if file != nil && file.IsSymlinkChange() {
+		output += style.FgCyan.Sprint(" (" + symlinkLabel(file) + ")")
+	}
+
+	if file != nil && file.AssumeUnchanged {
+		output += style.FgCyan.Sprint(" (assume-unchanged)")
+	}
+
+	if file != nil && file.SkipWorktree {
+		output += style.FgCyan.Sprint(" (skip-worktree)")
+	}
+
+	if conflictCount > 0 {
+		output += style.FgRed.Sprint(" " + conflictCountLabel(conflictCount))
+	}
+
 	return output
 }
 
+// ModeChange is how we show a flipped executable bit or a file turned into a
+// symlink, e.g. 'mode 100644 → 100755'
+func ModeChange(file *models.File) string {
+	return fmt.Sprintf("mode %s → %s", file.OldMode, file.NewMode)
+}
+
+func symlinkLabel(file *models.File) string {
+	if file.SymlinkTarget == "" {
+		return "symlink"
+	}
+
+	return "symlink → " + utils.EscapeSpecialChars(file.SymlinkTarget)
+}
+
+func conflictCountLabel(count int) string {
+	if count == 1 {
+		return "(1 conflict)"
+	}
+
+	return fmt.Sprintf("(%d conflicts)", count)
+}
+
 func getCommitFileLine(name string, diffName string, commitFile *models.CommitFile, status patch.PatchStatus) string {
 	var colour style.TextStyle
 	if diffName == name {
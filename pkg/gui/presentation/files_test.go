@@ -7,7 +7,9 @@ import (
 	"github.com/gookit/color"
 	"github.com/jesseduffield/lazygit/pkg/commands/models"
 	"github.com/jesseduffield/lazygit/pkg/commands/patch"
+	"github.com/jesseduffield/lazygit/pkg/config"
 	"github.com/jesseduffield/lazygit/pkg/gui/filetree"
+	"github.com/jesseduffield/lazygit/pkg/i18n"
 	"github.com/jesseduffield/lazygit/pkg/utils"
 	"github.com/stretchr/testify/assert"
 	"github.com/xo/terminfo"
@@ -27,6 +29,9 @@ func TestRenderFileTree(t *testing.T) {
 		root           *filetree.FileNode
 		files          []*models.File
 		collapsedPaths []string
+		showNumstat    bool
+		fileSizes      FileSizeOptions
+		groupByType    bool
 		expected       []string
 	}{
 		{
@@ -41,6 +46,102 @@ func TestRenderFileTree(t *testing.T) {
 			},
 			expected: []string{" M test"},
 		},
+		{
+			name: "conflicted files",
+			files: []*models.File{
+				{Name: "a", ShortStatus: "UU", HasUnstagedChanges: true, HasInlineMergeConflicts: true, ConflictCount: 1},
+				{Name: "b", ShortStatus: "UU", HasUnstagedChanges: true, HasInlineMergeConflicts: true, ConflictCount: 3},
+				{Name: "c", ShortStatus: "UU", HasUnstagedChanges: true, HasInlineMergeConflicts: true},
+			},
+			expected: []string{"UU a (1 conflict)", "UU b (3 conflicts)", "UU c"},
+		},
+		{
+			name: "conflicts add up in directories",
+			files: []*models.File{
+				{Name: "dir/a", ShortStatus: "UU", HasUnstagedChanges: true, HasInlineMergeConflicts: true, ConflictCount: 1},
+				{Name: "dir/sub/b", ShortStatus: "UU", HasUnstagedChanges: true, HasInlineMergeConflicts: true, ConflictCount: 3},
+				{Name: "dir/sub/c", ShortStatus: "M ", HasStagedChanges: true},
+			},
+			expected: toStringSlice(
+				`
+▼ dir (4 conflicts)
+  ▼ sub (3 conflicts)
+    UU b (3 conflicts)
+    M  c
+  UU a (1 conflict)
+`,
+			),
+		},
+		{
+			name: "index flags",
+			files: []*models.File{
+				{Name: "a", ShortStatus: "  ", Tracked: true, AssumeUnchanged: true},
+				{Name: "b", ShortStatus: " M", Tracked: true, HasUnstagedChanges: true, SkipWorktree: true},
+				{Name: "c", ShortStatus: "  ", Tracked: true, AssumeUnchanged: true, SkipWorktree: true},
+			},
+			expected: []string{"   a (assume-unchanged)", " M b (skip-worktree)", "   c (assume-unchanged) (skip-worktree)"},
+		},
+		{
+			name: "nested repos",
+			files: []*models.File{
+				{Name: "nested", ShortStatus: "??", HasUnstagedChanges: true, IsNestedRepo: true},
+				{Name: "plain", ShortStatus: "??", HasUnstagedChanges: true},
+			},
+			expected: []string{"?? nested (nested repo)", "?? plain"},
+		},
+		{
+			name: "mode changes",
+			files: []*models.File{
+				{Name: "a", ShortStatus: "M ", HasStagedChanges: true, Tracked: true, OldMode: "100644", NewMode: "100755", ModeChangeOnly: true},
+				{Name: "b", ShortStatus: "A ", HasStagedChanges: true, Tracked: true, OldMode: "000000", NewMode: "100755"},
+				{Name: "c", ShortStatus: " M", HasUnstagedChanges: true, Tracked: true, OldMode: "120000", NewMode: "120000", SymlinkTarget: "b"},
+				{Name: "d", ShortStatus: " T", HasUnstagedChanges: true, Tracked: true, OldMode: "100644", NewMode: "120000"},
+			},
+			expected: []string{"M  a (mode 100644 → 100755)", "A  b", " M c (symlink → b)", " T d (mode 100644 → 120000)"},
+		},
+		{
+			name: "numstat",
+			files: []*models.File{
+				{Name: "dir/a", ShortStatus: " M", HasUnstagedChanges: true, LinesAdded: 3, LinesDeleted: 1},
+				{Name: "dir/b", ShortStatus: "A ", HasStagedChanges: true, LinesAdded: 2},
+				{Name: "c", ShortStatus: "??", HasUnstagedChanges: true},
+			},
+			showNumstat: true,
+			expected: toStringSlice(
+				`
+▼ dir +5 -1
+   M a +3 -1
+  A  b +2 -0
+?? c
+`,
+			),
+		},
+		{
+			name: "sizes of large files only",
+			files: []*models.File{
+				{Name: "a", ShortStatus: "??", HasUnstagedChanges: true, Size: 100},
+				{Name: "b", ShortStatus: "??", HasUnstagedChanges: true, Size: 2048},
+				{Name: "c", ShortStatus: "??", HasUnstagedChanges: true, Size: 5 * 1024 * 1024},
+				{Name: "d", ShortStatus: " D", HasUnstagedChanges: true, Deleted: true},
+			},
+			fileSizes: FileSizeOptions{LargeThreshold: 1024, HugeThreshold: 1024 * 1024},
+			expected:  []string{"?? a", "?? b 2.0 KiB", "?? c 5.0 MiB", " D d"},
+		},
+		{
+			name: "all sizes",
+			files: []*models.File{
+				{Name: "dir/a", ShortStatus: "??", HasUnstagedChanges: true, Size: 100},
+				{Name: "b", ShortStatus: " D", HasUnstagedChanges: true, Deleted: true},
+			},
+			fileSizes: FileSizeOptions{ShowAll: true},
+			expected: toStringSlice(
+				`
+▼ dir
+  ?? a 100 B
+ D b
+`,
+			),
+		},
 		{
 			name: "big example",
 			files: []*models.File{
@@ -64,17 +165,43 @@ M  file1
 			),
 			collapsedPaths: []string{"dir1"},
 		},
+		{
+			name: "grouped by change type",
+			files: []*models.File{
+				{Name: "dir/a", ShortStatus: "M ", HasStagedChanges: true, Tracked: true},
+				{Name: "dir/b", ShortStatus: "MM", HasStagedChanges: true, HasUnstagedChanges: true, Tracked: true},
+				{Name: "c", ShortStatus: "??", HasUnstagedChanges: true},
+				{Name: "d", ShortStatus: "UU", HasUnstagedChanges: true, HasMergeConflicts: true, Tracked: true},
+				{Name: "e", ShortStatus: "??", HasUnstagedChanges: true},
+			},
+			groupByType:    true,
+			collapsedPaths: []string{filetree.SectionPath(filetree.SectionUntracked)},
+			expected: toStringSlice(
+				`
+▼ Conflicts (1)
+  UU d
+▼ Staged (1)
+  ▼ dir
+    M  a
+▼ Unstaged (1)
+  ▼ dir
+    MM b
+▶ Untracked (2)
+`,
+			),
+		},
 	}
 
+	tr := i18n.EnglishTranslationSet()
 	for _, s := range scenarios {
 		s := s
 		t.Run(s.name, func(t *testing.T) {
-			viewModel := filetree.NewFileTree(func() []*models.File { return s.files }, utils.NewDummyLog(), true)
+			viewModel := filetree.NewFileTree(func() []*models.File { return s.files }, utils.NewDummyLog(), true, s.groupByType)
 			viewModel.SetTree()
 			for _, path := range s.collapsedPaths {
 				viewModel.ToggleCollapsed(path)
 			}
-			result := RenderFileTree(viewModel, "", nil)
+			result := RenderFileTree(viewModel, "", nil, s.showNumstat, s.fileSizes, &tr)
 			assert.EqualValues(t, s.expected, result)
 		})
 	}
@@ -146,3 +273,13 @@ M file1
 		})
 	}
 }
+
+func TestNewFileSizeOptions(t *testing.T) {
+	opts, err := NewFileSizeOptions(config.FilesPanelConfig{ShowFileSizes: true, LargeFileThreshold: "5MB", HugeFileThreshold: ""})
+	assert.NoError(t, err)
+	assert.Equal(t, FileSizeOptions{ShowAll: true, LargeThreshold: 5 * 1024 * 1024}, opts)
+
+	opts, err = NewFileSizeOptions(config.FilesPanelConfig{LargeFileThreshold: "lots", HugeFileThreshold: "1KB"})
+	assert.Error(t, err)
+	assert.Equal(t, FileSizeOptions{HugeThreshold: 1024}, opts)
+}
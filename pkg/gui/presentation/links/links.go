@@ -0,0 +1,176 @@
+package links
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/jesseduffield/lazygit/pkg/config"
+	"github.com/sirupsen/logrus"
+)
+
+// Linker finds URLs and references to issues in text such as commit messages
+// and branch names, so that we can underline them or open them in the
+// browser. A nil Linker only finds URLs.
+type Linker struct {
+	issuePatterns []pattern
+}
+
+type pattern struct {
+	regex  *regexp.Regexp
+	getUrl func(match []string) string
+}
+
+type Link struct {
+	Text string
+	Url  string
+
+	start int
+	end   int
+}
+
+// trailing punctuation is most likely not part of the URL
+var urlPattern = pattern{
+	regex:  regexp.MustCompile(`https?://[^\s<>()]*[^\s<>().,;:!?'"]`),
+	getUrl: func(match []string) string { return match[0] },
+}
+
+// matches '#123' but not 'abc#123'
+var issueNumberRegex = regexp.MustCompile(`\B#(\d+)\b`)
+
+// NewLinker returns a linker for the issue link patterns from the user config.
+// If getIssueURL is not nil, references like '#123' link to the URL it returns
+// for the issue number.
+func NewLinker(issueLinks []config.IssueLinkConfig, getIssueURL func(number string) string, log logrus.FieldLogger) *Linker {
+	issuePatterns := []pattern{}
+	for _, issueLink := range issueLinks {
+		regex, err := regexp.Compile(issueLink.Pattern)
+		if err != nil {
+			log.Errorf("Invalid issue link pattern %q: %v", issueLink.Pattern, err)
+			continue
+		}
+
+		urlTemplate := issueLink.Url
+		issuePatterns = append(issuePatterns, pattern{
+			regex: regex,
+			getUrl: func(match []string) string {
+				return expand(urlTemplate, match)
+			},
+		})
+	}
+
+	if getIssueURL != nil {
+		issuePatterns = append(issuePatterns, pattern{
+			regex: issueNumberRegex,
+			getUrl: func(match []string) string {
+				return getIssueURL(match[1])
+			},
+		})
+	}
+
+	return &Linker{issuePatterns: issuePatterns}
+}
+
+// Find returns the links in the text, in the order they appear
+func (self *Linker) Find(text string) []Link {
+	patterns := []pattern{urlPattern}
+	if self != nil {
+		patterns = append(patterns, self.issuePatterns...)
+	}
+
+	result := []Link{}
+	for _, pattern := range patterns {
+		for _, indices := range pattern.regex.FindAllStringSubmatchIndex(text, -1) {
+			start, end := indices[0], indices[1]
+			// earlier patterns take precedence, so that e.g. the '#123' in a
+			// URL doesn't get linked separately
+			overlaps := false
+			for _, link := range result {
+				if start < link.end && link.start < end {
+					overlaps = true
+					break
+				}
+			}
+			if overlaps || start == end {
+				continue
+			}
+
+			url := pattern.getUrl(submatches(text, indices))
+			if url == "" {
+				continue
+			}
+			result = append(result, Link{Text: text[start:end], Url: url, start: start, end: end})
+		}
+	}
+
+	sort.Slice(result, func(i, j int) bool { return result[i].start < result[j].start })
+
+	return result
+}
+
+// FindAt returns the link that the given byte offset into the text is part of
+func (self *Linker) FindAt(text string, offset int) (Link, bool) {
+	for _, link := range self.Find(text) {
+		if link.start <= offset && offset < link.end {
+			return link, true
+		}
+	}
+
+	return Link{}, false
+}
+
+// Render underlines the links in the text, so that they stand out as things
+// that can be opened with the open-link keybinding. If decorate is not nil,
+// it's applied to the text of each link instead, e.g. to colour it too.
+func (self *Linker) Render(text string, decorate func(...interface{}) string) string {
+	links := self.Find(text)
+	if len(links) == 0 {
+		return text
+	}
+
+	if decorate == nil {
+		decorate = underline
+	}
+
+	var builder strings.Builder
+	lastEnd := 0
+	for _, link := range links {
+		builder.WriteString(text[lastEnd:link.start])
+		builder.WriteString(decorate(link.Text))
+		lastEnd = link.end
+	}
+	builder.WriteString(text[lastEnd:])
+
+	return builder.String()
+}
+
+// we only turn the underline off again afterwards, rather than resetting all
+// attributes, so that the text around the link keeps its colour
+func underline(a ...interface{}) string {
+	return "\x1b[4m" + fmt.Sprint(a...) + "\x1b[24m"
+}
+
+func submatches(text string, indices []int) []string {
+	result := make([]string, len(indices)/2)
+	for i := range result {
+		if indices[2*i] >= 0 {
+			result[i] = text[indices[2*i]:indices[2*i+1]]
+		}
+	}
+
+	return result
+}
+
+var placeholderRegex = regexp.MustCompile(`\$(\d)`)
+
+// replaces $0, $1 etc. in the template with the match and its capture groups
+func expand(template string, match []string) string {
+	return placeholderRegex.ReplaceAllStringFunc(template, func(placeholder string) string {
+		index := int(placeholder[1] - '0')
+		if index < len(match) {
+			return match[index]
+		}
+		return placeholder
+	})
+}
@@ -0,0 +1,113 @@
+package links
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/jesseduffield/lazygit/pkg/config"
+	"github.com/jesseduffield/lazygit/pkg/utils"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFind(t *testing.T) {
+	issueLinks := []config.IssueLinkConfig{
+		{Pattern: `\bJIRA-(\d+)\b`, Url: "https://jira.example.com/browse/JIRA-$1"},
+		{Pattern: `(`, Url: "invalid patterns are skipped"},
+	}
+	getIssueURL := func(number string) string {
+		return "https://github.com/owner/repo/issues/" + number
+	}
+	linker := NewLinker(issueLinks, getIssueURL, utils.NewDummyLog())
+
+	scenarios := []struct {
+		name     string
+		linker   *Linker
+		text     string
+		expected []Link
+	}{
+		{
+			name:     "no links",
+			linker:   linker,
+			text:     "Fix the thing",
+			expected: []Link{},
+		},
+		{
+			name:   "issue references in order of appearance",
+			linker: linker,
+			text:   "JIRA-456: fix #123",
+			expected: []Link{
+				{Text: "JIRA-456", Url: "https://jira.example.com/browse/JIRA-456", start: 0, end: 8},
+				{Text: "#123", Url: "https://github.com/owner/repo/issues/123", start: 14, end: 18},
+			},
+		},
+		{
+			name:   "url containing an issue reference",
+			linker: linker,
+			text:   "see https://example.com/page#123.",
+			expected: []Link{
+				{Text: "https://example.com/page#123", Url: "https://example.com/page#123", start: 4, end: 32},
+			},
+		},
+		{
+			name:     "hash within a word",
+			linker:   linker,
+			text:     "abc#123",
+			expected: []Link{},
+		},
+		{
+			name:   "nil linker only finds urls",
+			linker: nil,
+			text:   "#123 http://example.com",
+			expected: []Link{
+				{Text: "http://example.com", Url: "http://example.com", start: 5, end: 23},
+			},
+		},
+	}
+
+	for _, s := range scenarios {
+		s := s
+		t.Run(s.name, func(t *testing.T) {
+			assert.Equal(t, s.expected, s.linker.Find(s.text))
+		})
+	}
+}
+
+func TestRender(t *testing.T) {
+	linker := NewLinker(nil, func(number string) string { return "https://issues/" + number }, utils.NewDummyLog())
+
+	assert.Equal(t,
+		"fix \x1b[4m#1\x1b[24m now",
+		linker.Render("fix #1 now", nil),
+	)
+	assert.Equal(t,
+		"fix [#1] now",
+		linker.Render("fix #1 now", func(a ...interface{}) string { return "[" + fmt.Sprint(a...) + "]" }),
+	)
+	assert.Equal(t, "no links", linker.Render("no links", nil))
+}
+
+func TestFindAt(t *testing.T) {
+	linker := NewLinker(nil, func(number string) string { return "https://issues/" + number }, utils.NewDummyLog())
+	text := "fix #12 and https://example.com"
+
+	scenarios := []struct {
+		offset      int
+		expectedUrl string
+	}{
+		{offset: 0, expectedUrl: ""},
+		{offset: 4, expectedUrl: "https://issues/12"},
+		{offset: 6, expectedUrl: "https://issues/12"},
+		{offset: 7, expectedUrl: ""},
+		{offset: 12, expectedUrl: "https://example.com"},
+		{offset: 100, expectedUrl: ""},
+	}
+
+	for _, s := range scenarios {
+		s := s
+		t.Run(fmt.Sprint(s.offset), func(t *testing.T) {
+			link, found := linker.FindAt(text, s.offset)
+			assert.Equal(t, s.expectedUrl != "", found)
+			assert.Equal(t, s.expectedUrl, link.Url)
+		})
+	}
+}
@@ -0,0 +1,90 @@
+package presentation
+
+import (
+	"regexp"
+	"strings"
+
+	"github.com/jesseduffield/lazygit/pkg/commands/git_commands"
+	"github.com/jesseduffield/lazygit/pkg/gui/presentation/links"
+	"github.com/jesseduffield/lazygit/pkg/gui/style"
+)
+
+var (
+	markdownCodeStyle = style.FgCyan
+	markdownLinkStyle = style.FgBlue.SetUnderline()
+
+	markdownListItemRegex = regexp.MustCompile(`^(\s*)[-*+]\s+(.*)$`)
+	// matches a code span or a [text](url) link
+	markdownInlineRegex = regexp.MustCompile("`([^`]+)`" + `|\[([^\]]+)\]\((https?://[^)\s]+)\)`)
+)
+
+// RenderMarkdown applies some simple formatting to text written in markdown,
+// as many people do in commit message bodies. List markers become bullets,
+// code spans and fenced code blocks are coloured, and links, including the
+// URLs and issue references found by the linker, are underlined. For
+// [text](url) links we show the URL after the text, so that it can still be
+// opened. Everything else is left as it is.
+func RenderMarkdown(text string, linker *links.Linker) string {
+	lines := strings.Split(text, "\n")
+	inCodeBlock := false
+	for i, line := range lines {
+		if strings.HasPrefix(strings.TrimSpace(line), "```") {
+			inCodeBlock = !inCodeBlock
+			lines[i] = markdownCodeStyle.Sprint(line)
+		} else if inCodeBlock {
+			lines[i] = markdownCodeStyle.Sprint(line)
+		} else if match := markdownListItemRegex.FindStringSubmatch(line); match != nil {
+			lines[i] = match[1] + "• " + renderMarkdownInline(match[2], linker)
+		} else {
+			lines[i] = renderMarkdownInline(line, linker)
+		}
+	}
+
+	return strings.Join(lines, "\n")
+}
+
+// RenderCommitMessage underlines the links in the subject of a commit message,
+// and renders its body as markdown
+func RenderCommitMessage(message string, linker *links.Linker) string {
+	subject, body, found := strings.Cut(message, "\n")
+	if !found {
+		return linker.Render(message, nil)
+	}
+
+	return linker.Render(subject, nil) + "\n" + RenderMarkdown(body, linker)
+}
+
+// RenderCommitDetails lays out the details of a commit the way `git show` does
+// above the patch, with the message rendered by RenderCommitMessage
+func RenderCommitDetails(details *git_commands.CommitDetails, linker *links.Linker) string {
+	result := details.Header + "\n\n" + indentAllLines(RenderCommitMessage(details.Message, linker)) + "\n\n"
+	if details.Notes != "" {
+		result += "Notes:\n" + indentAllLines(details.Notes) + "\n\n"
+	}
+
+	return result
+}
+
+// unlike indentLines this also indents empty lines, like git does
+func indentAllLines(str string) string {
+	return "    " + strings.ReplaceAll(str, "\n", "\n    ")
+}
+
+func renderMarkdownInline(line string, linker *links.Linker) string {
+	var builder strings.Builder
+	lastEnd := 0
+	for _, indices := range markdownInlineRegex.FindAllStringSubmatchIndex(line, -1) {
+		builder.WriteString(linker.Render(line[lastEnd:indices[0]], markdownLinkStyle.Sprint))
+		if indices[2] >= 0 {
+			builder.WriteString(markdownCodeStyle.Sprint(line[indices[2]:indices[3]]))
+		} else {
+			text := line[indices[4]:indices[5]]
+			url := line[indices[6]:indices[7]]
+			builder.WriteString(text + " (" + markdownLinkStyle.Sprint(url) + ")")
+		}
+		lastEnd = indices[1]
+	}
+	builder.WriteString(linker.Render(line[lastEnd:], markdownLinkStyle.Sprint))
+
+	return builder.String()
+}
@@ -0,0 +1,107 @@
+package presentation
+
+import (
+	"testing"
+
+	"github.com/gookit/color"
+	"github.com/jesseduffield/lazygit/pkg/commands/git_commands"
+	"github.com/jesseduffield/lazygit/pkg/gui/presentation/links"
+	"github.com/jesseduffield/lazygit/pkg/utils"
+	"github.com/stretchr/testify/assert"
+	"github.com/xo/terminfo"
+)
+
+func TestRenderMarkdown(t *testing.T) {
+	color.ForceSetColorLevel(terminfo.ColorLevelNone)
+
+	scenarios := []struct {
+		name     string
+		text     string
+		expected string
+	}{
+		{
+			name:     "plain text",
+			text:     "Fix the thing\n\nIt was broken.",
+			expected: "Fix the thing\n\nIt was broken.",
+		},
+		{
+			name:     "list items",
+			text:     "- one\n* two\n  + nested\n**not** a list",
+			expected: "• one\n• two\n  • nested\n**not** a list",
+		},
+		{
+			name:     "code span",
+			text:     "Call `foo()` instead",
+			expected: "Call foo() instead",
+		},
+		{
+			name:     "link",
+			text:     "See [the docs](https://example.com/docs).",
+			expected: "See the docs (https://example.com/docs).",
+		},
+		{
+			name:     "bare url followed by punctuation",
+			text:     "Fixes https://example.com/issues/1.",
+			expected: "Fixes https://example.com/issues/1.",
+		},
+		{
+			name:     "fenced code block",
+			text:     "```\n- `not` a list\n```\n- a list",
+			expected: "```\n- `not` a list\n```\n• a list",
+		},
+	}
+
+	for _, s := range scenarios {
+		s := s
+		t.Run(s.name, func(t *testing.T) {
+			assert.Equal(t, s.expected, RenderMarkdown(s.text, nil))
+		})
+	}
+}
+
+func TestRenderMarkdownWithIssueLinks(t *testing.T) {
+	color.ForceSetColorLevel(terminfo.ColorLevelNone)
+
+	linker := links.NewLinker(nil, func(number string) string { return "https://issues/" + number }, utils.NewDummyLog())
+
+	assert.Equal(t,
+		"• fixes #12, not #13",
+		RenderMarkdown("- fixes #12, not `#13`", linker),
+	)
+}
+
+func TestRenderCommitDetails(t *testing.T) {
+	color.ForceSetColorLevel(terminfo.ColorLevelNone)
+
+	scenarios := []struct {
+		name     string
+		message  string
+		notes    string
+		expected string
+	}{
+		{
+			name:     "subject only",
+			message:  "Fix `the thing`",
+			expected: "commit 123\nAuthor: me\n\n    Fix `the thing`\n\n",
+		},
+		{
+			name:     "subject and body",
+			message:  "Fix `the thing`\n\n- it was `broken`",
+			expected: "commit 123\nAuthor: me\n\n    Fix `the thing`\n    \n    • it was broken\n\n",
+		},
+		{
+			name:     "notes",
+			message:  "Fix the thing",
+			notes:    "a note\n\nabout it",
+			expected: "commit 123\nAuthor: me\n\n    Fix the thing\n\nNotes:\n    a note\n    \n    about it\n\n",
+		},
+	}
+
+	for _, s := range scenarios {
+		s := s
+		t.Run(s.name, func(t *testing.T) {
+			details := &git_commands.CommitDetails{Header: "commit 123\nAuthor: me", Message: s.message, Notes: s.notes}
+			assert.Equal(t, s.expected, RenderCommitDetails(details, nil))
+		})
+	}
+}
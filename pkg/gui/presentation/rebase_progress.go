@@ -0,0 +1,61 @@
+package presentation
+
+import (
+	"strings"
+
+	"github.com/fsmiamoto/git-todo-parser/todo"
+	"github.com/jesseduffield/lazygit/pkg/commands/git_commands"
+	"github.com/jesseduffield/lazygit/pkg/gui/style"
+	"github.com/jesseduffield/lazygit/pkg/utils"
+	"github.com/samber/lo"
+)
+
+// GetRebaseProgressDisplayStrings returns one line per todo of an interactive
+// rebase: first the ones that are done, with the last of those being the one
+// the rebase is currently stopped at, and then the ones still to come.
+func GetRebaseProgressDisplayStrings(progress *git_commands.RebaseProgress, commitHashLength int) []string {
+	if progress == nil {
+		return nil
+	}
+
+	currentIdx := len(progress.Done) - 1
+	allTodos := append(append([]todo.Todo{}, progress.Done...), progress.Remaining...)
+
+	displayStrings := lo.Map(allTodos, func(t todo.Todo, i int) []string {
+		marker := " "
+		textStyle := style.FgDefault
+		if i < currentIdx {
+			marker = style.FgGreen.Sprint("✓")
+		} else if i == currentIdx {
+			marker = style.FgYellow.Sprint("▶")
+			textStyle = style.FgYellow.SetBold()
+		}
+
+		return []string{
+			marker,
+			actionColorMap(t.Command).Sprint(t.Command.String()),
+			textStyle.Sprint(utils.ShortShaOfLength(t.Commit, commitHashLength)),
+			textStyle.Sprint(todoDescription(t)),
+		}
+	})
+
+	lines, _ := utils.RenderDisplayStrings(displayStrings, nil)
+	return lines
+}
+
+func todoDescription(t todo.Todo) string {
+	switch t.Command {
+	case todo.Exec:
+		return t.ExecCommand
+	case todo.UpdateRef:
+		return strings.TrimPrefix(t.Ref, "refs/heads/")
+	case todo.Label, todo.Reset:
+		return t.Label
+	case todo.Merge:
+		if t.Msg == "" {
+			return t.Label
+		}
+	}
+
+	return t.Msg
+}
@@ -0,0 +1,38 @@
+package presentation
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/fsmiamoto/git-todo-parser/todo"
+	"github.com/jesseduffield/lazygit/pkg/commands/git_commands"
+	"github.com/jesseduffield/lazygit/pkg/utils"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGetRebaseProgressDisplayStrings(t *testing.T) {
+	progress := &git_commands.RebaseProgress{
+		Done: []todo.Todo{
+			{Command: todo.Pick, Commit: "aaaaaaaaaa", Msg: "first"},
+			{Command: todo.Edit, Commit: "bbbbbbbbbb", Msg: "second"},
+		},
+		Remaining: []todo.Todo{
+			{Command: todo.Exec, ExecCommand: "make test"},
+			{Command: todo.UpdateRef, Ref: "refs/heads/feature"},
+			{Command: todo.Pick, Commit: "cccccccccc", Msg: "third"},
+		},
+	}
+
+	lines := GetRebaseProgressDisplayStrings(progress, 7)
+
+	assert.EqualValues(t, []string{
+		"✓ pick       aaaaaaa first",
+		"▶ edit       bbbbbbb second",
+		"  exec               make test",
+		"  update-ref         feature",
+		"  pick       ccccccc third",
+	}, lo.Map(lines, func(line string, _ int) string {
+		return strings.TrimRight(utils.Decolorise(line), " ")
+	}))
+}
@@ -12,7 +12,7 @@ import (
 	"github.com/samber/lo"
 )
 
-func GetReflogCommitListDisplayStrings(commits []*models.Commit, fullDescription bool, cherryPickedCommitShaSet *set.Set[string], diffName string, now time.Time, timeFormat string, shortTimeFormat string, parseEmoji bool) [][]string {
+func GetReflogCommitListDisplayStrings(commits []*models.Commit, fullDescription bool, cherryPickedCommitShaSet *set.Set[string], diffName string, now time.Time, timeFormat string, shortTimeFormat string, relativeDates bool, parseEmoji bool, hashLength int) [][]string {
 	var displayFunc func(*models.Commit, reflogCommitDisplayAttributes) []string
 	if fullDescription {
 		displayFunc = getFullDescriptionDisplayStringsForReflogCommit
@@ -30,6 +30,7 @@ func GetReflogCommitListDisplayStrings(commits []*models.Commit, fullDescription
 				parseEmoji:      parseEmoji,
 				timeFormat:      timeFormat,
 				shortTimeFormat: shortTimeFormat,
+				relativeDates:   relativeDates,
 				now:             now,
 			})
 	})
@@ -54,6 +55,8 @@ type reflogCommitDisplayAttributes struct {
 	parseEmoji      bool
 	timeFormat      string
 	shortTimeFormat string
+	relativeDates   bool
+	hashLength      int
 	now             time.Time
 }
 
@@ -64,8 +67,8 @@ func getFullDescriptionDisplayStringsForReflogCommit(c *models.Commit, attrs ref
 	}
 
 	return []string{
-		reflogShaColor(attrs.cherryPicked, attrs.diffed).Sprint(c.ShortSha()),
-		style.FgMagenta.Sprint(utils.UnixToDateSmart(attrs.now, c.UnixTimestamp, attrs.timeFormat, attrs.shortTimeFormat)),
+		reflogShaColor(attrs.cherryPicked, attrs.diffed).Sprint(utils.ShortShaOfLength(c.Sha, attrs.hashLength)),
+		style.FgMagenta.Sprint(utils.UnixToDate(attrs.now, c.UnixTimestamp, attrs.relativeDates, attrs.timeFormat, attrs.shortTimeFormat)),
 		theme.DefaultTextColor.Sprint(name),
 	}
 }
@@ -77,7 +80,7 @@ func getDisplayStringsForReflogCommit(c *models.Commit, attrs reflogCommitDispla
 	}
 
 	return []string{
-		reflogShaColor(attrs.cherryPicked, attrs.diffed).Sprint(c.ShortSha()),
+		reflogShaColor(attrs.cherryPicked, attrs.diffed).Sprint(utils.ShortShaOfLength(c.Sha, attrs.hashLength)),
 		theme.DefaultTextColor.Sprint(name),
 	}
 }
@@ -4,19 +4,20 @@ import (
 	"github.com/jesseduffield/lazygit/pkg/commands/models"
 	"github.com/jesseduffield/lazygit/pkg/gui/presentation/icons"
 	"github.com/jesseduffield/lazygit/pkg/gui/style"
+	"github.com/jesseduffield/lazygit/pkg/i18n"
 	"github.com/jesseduffield/lazygit/pkg/theme"
 	"github.com/samber/lo"
 )
 
-func GetRemoteListDisplayStrings(remotes []*models.Remote, diffName string) [][]string {
+func GetRemoteListDisplayStrings(remotes []*models.Remote, diffName string, tr *i18n.TranslationSet) [][]string {
 	return lo.Map(remotes, func(remote *models.Remote, _ int) []string {
 		diffed := remote.Name == diffName
-		return getRemoteDisplayStrings(remote, diffed)
+		return getRemoteDisplayStrings(remote, diffed, tr)
 	})
 }
 
 // getRemoteDisplayStrings returns the display string of branch
-func getRemoteDisplayStrings(r *models.Remote, diffed bool) []string {
+func getRemoteDisplayStrings(r *models.Remote, diffed bool, tr *i18n.TranslationSet) []string {
 	branchCount := len(r.Branches)
 
 	textStyle := theme.DefaultTextColor
@@ -28,6 +29,10 @@ func getRemoteDisplayStrings(r *models.Remote, diffed bool) []string {
 	if icons.IsIconEnabled() {
 		res = append(res, textStyle.Sprint(icons.IconForRemote(r)))
 	}
-	res = append(res, textStyle.Sprint(r.Name), style.FgBlue.Sprintf("%d branches", branchCount))
+	description := style.FgBlue.Sprintf("%d branches", branchCount)
+	if r.IsPushDefault {
+		description += " " + style.FgYellow.Sprintf("(%s)", tr.DefaultPushRemote)
+	}
+	res = append(res, textStyle.Sprint(r.Name), description)
 	return res
 }
@@ -1,29 +1,37 @@
 package presentation
 
 import (
+	"time"
+
 	"github.com/jesseduffield/lazygit/pkg/commands/models"
 	"github.com/jesseduffield/lazygit/pkg/gui/presentation/icons"
 	"github.com/jesseduffield/lazygit/pkg/gui/style"
 	"github.com/jesseduffield/lazygit/pkg/theme"
+	"github.com/jesseduffield/lazygit/pkg/utils"
 	"github.com/samber/lo"
 )
 
-func GetStashEntryListDisplayStrings(stashEntries []*models.StashEntry, diffName string) [][]string {
+func GetStashEntryListDisplayStrings(stashEntries []*models.StashEntry, diffName string, now time.Time, timeFormat string, shortTimeFormat string, relativeDates bool) [][]string {
 	return lo.Map(stashEntries, func(stashEntry *models.StashEntry, _ int) []string {
 		diffed := stashEntry.RefName() == diffName
-		return getStashEntryDisplayStrings(stashEntry, diffed)
+		return getStashEntryDisplayStrings(stashEntry, diffed, now, timeFormat, shortTimeFormat, relativeDates)
 	})
 }
 
 // getStashEntryDisplayStrings returns the display string of branch
-func getStashEntryDisplayStrings(s *models.StashEntry, diffed bool) []string {
+func getStashEntryDisplayStrings(s *models.StashEntry, diffed bool, now time.Time, timeFormat string, shortTimeFormat string, relativeDates bool) []string {
 	textStyle := theme.DefaultTextColor
 	if diffed {
 		textStyle = theme.DiffTerminalColor
 	}
 
+	recency := s.Recency
+	if !relativeDates && s.UnixTimestamp != 0 {
+		recency = utils.UnixToDateSmart(now, s.UnixTimestamp, timeFormat, shortTimeFormat)
+	}
+
 	res := make([]string, 0, 3)
-	res = append(res, style.FgCyan.Sprint(s.Recency))
+	res = append(res, style.FgCyan.Sprint(recency))
 
 	if icons.IsIconEnabled() {
 		res = append(res, textStyle.Sprint(icons.IconForStash(s)))
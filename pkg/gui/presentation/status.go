@@ -2,20 +2,34 @@ package presentation
 
 import (
 	"fmt"
+	"path/filepath"
+	"strings"
 
 	"github.com/jesseduffield/lazygit/pkg/commands/models"
 	"github.com/jesseduffield/lazygit/pkg/commands/types/enums"
+	"github.com/jesseduffield/lazygit/pkg/config"
 	"github.com/jesseduffield/lazygit/pkg/gui/presentation/icons"
 	"github.com/jesseduffield/lazygit/pkg/gui/style"
 	"github.com/jesseduffield/lazygit/pkg/gui/types"
 	"github.com/jesseduffield/lazygit/pkg/i18n"
+	"github.com/samber/lo"
 )
 
-func FormatStatus(repoName string, currentBranch *models.Branch, itemOperation types.ItemOperation, linkedWorktreeName string, workingTreeState enums.RebaseMode, tr *i18n.TranslationSet) string {
+// FormatRepoBreadcrumbs prefixes the repo name with the names of the repos we
+// came from when entering (possibly nested) submodules, e.g. 'parent > child'
+func FormatRepoBreadcrumbs(parentRepoPaths []string, repoName string) string {
+	names := lo.Map(parentRepoPaths, func(path string, _ int) string {
+		return filepath.Base(path)
+	})
+
+	return strings.Join(append(names, repoName), " > ")
+}
+
+func FormatStatus(repoName string, currentBranch *models.Branch, itemOperation types.ItemOperation, linkedWorktreeName string, workingTreeState enums.RebaseMode, tr *i18n.TranslationSet, glyphsConfig config.StatusGlyphsConfig) string {
 	status := ""
 
 	if currentBranch.IsRealBranch() {
-		status += ColoredBranchStatus(currentBranch, itemOperation, tr) + " "
+		status += ColoredBranchStatus(currentBranch, itemOperation, tr, glyphsConfig) + " "
 	}
 
 	if workingTreeState != enums.REBASE_MODE_NONE {
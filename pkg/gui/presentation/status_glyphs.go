@@ -0,0 +1,43 @@
+package presentation
+
+import (
+	"github.com/jesseduffield/lazygit/pkg/config"
+	"github.com/jesseduffield/lazygit/pkg/gui/style"
+	"github.com/jesseduffield/lazygit/pkg/theme"
+)
+
+// statusGlyphs are the glyphs showing how branches and commits relate to their
+// upstream, along with their colors
+type statusGlyphs struct {
+	ahead              string
+	behind             string
+	inSync             string
+	upstreamNotFetched string
+	merged             string
+
+	aheadBehindColor        style.TextStyle
+	inSyncColor             style.TextStyle
+	upstreamGoneColor       style.TextStyle
+	upstreamNotFetchedColor style.TextStyle
+	unpushedCommitColor     style.TextStyle
+	pushedCommitColor       style.TextStyle
+	mergedCommitColor       style.TextStyle
+}
+
+func newStatusGlyphs(glyphsConfig config.StatusGlyphsConfig) statusGlyphs {
+	return statusGlyphs{
+		ahead:              glyphsConfig.Ahead,
+		behind:             glyphsConfig.Behind,
+		inSync:             glyphsConfig.InSync,
+		upstreamNotFetched: glyphsConfig.UpstreamNotFetched,
+		merged:             glyphsConfig.Merged,
+
+		aheadBehindColor:        theme.GetTextStyle(glyphsConfig.AheadBehindColor, false),
+		inSyncColor:             theme.GetTextStyle(glyphsConfig.InSyncColor, false),
+		upstreamGoneColor:       theme.GetTextStyle(glyphsConfig.UpstreamGoneColor, false),
+		upstreamNotFetchedColor: theme.GetTextStyle(glyphsConfig.UpstreamNotFetchedColor, false),
+		unpushedCommitColor:     theme.GetTextStyle(glyphsConfig.UnpushedCommitColor, false),
+		pushedCommitColor:       theme.GetTextStyle(glyphsConfig.PushedCommitColor, false),
+		mergedCommitColor:       theme.GetTextStyle(glyphsConfig.MergedCommitColor, false),
+	}
+}
@@ -1,6 +1,8 @@
 package presentation
 
 import (
+	"fmt"
+	"strings"
 	"time"
 
 	"github.com/jesseduffield/lazygit/pkg/commands/models"
@@ -10,6 +12,7 @@ import (
 	"github.com/jesseduffield/lazygit/pkg/i18n"
 	"github.com/jesseduffield/lazygit/pkg/theme"
 	"github.com/jesseduffield/lazygit/pkg/utils"
+	"github.com/mattn/go-runewidth"
 	"github.com/samber/lo"
 )
 
@@ -41,6 +44,62 @@ func getTagDisplayStrings(t *models.Tag, itemOperation types.ItemOperation, diff
 	if itemOperationStr != "" {
 		descriptionStr = style.FgCyan.Sprint(itemOperationStr+" "+utils.Loader(time.Now())) + " " + descriptionStr
 	}
-	res = append(res, textStyle.Sprint(t.Name), descriptionStr)
+	res = append(res, textStyle.Sprint(t.Name), tagSignatureIndicator(t.SignatureStatus), descriptionStr)
 	return res
 }
+
+func tagSignatureIndicator(status models.TagSignatureStatus) string {
+	switch status {
+	case models.TagSignatureGood:
+		return style.FgGreen.Sprint("✓")
+	case models.TagSignatureBad:
+		return style.FgRed.Sprint("✗")
+	case models.TagSignatureUnverified:
+		return style.FgYellow.Sprint("?")
+	default:
+		return ""
+	}
+}
+
+func tagSignatureStatusText(status models.TagSignatureStatus, tr *i18n.TranslationSet) string {
+	switch status {
+	case models.TagSignatureGood:
+		return style.FgGreen.Sprint(tr.TagSignatureGood)
+	case models.TagSignatureBad:
+		return style.FgRed.Sprint(tr.TagSignatureBad)
+	case models.TagSignatureUnverified:
+		return style.FgYellow.Sprint(tr.TagSignatureUnverified)
+	default:
+		return tr.TagUnsigned
+	}
+}
+
+// FormatTagAnnotation renders an annotated tag object much like 'git show'
+// does, so that it can be shown above the commit the tag points to
+func FormatTagAnnotation(tag *models.Tag, annotation *models.TagAnnotation, tr *i18n.TranslationSet) string {
+	signature := tagSignatureStatusText(tag.SignatureStatus, tr)
+
+	labels := []string{tr.TagTagger, tr.TagDate, tr.TagSignature}
+	width := lo.Max(lo.Map(labels, func(label string, _ int) int { return runewidth.StringWidth(label) }))
+	label := func(str string) string {
+		return utils.WithPadding(str, width, utils.AlignLeft)
+	}
+
+	return fmt.Sprintf(
+		"%s\n%s %s\n%s %s\n%s %s\n\n%s\n\n",
+		style.FgYellow.Sprint("tag "+tag.Name),
+		label(tr.TagTagger), annotation.Tagger,
+		label(tr.TagDate), annotation.Date,
+		label(tr.TagSignature), signature,
+		indentLines(annotation.Message),
+	)
+}
+
+func indentLines(str string) string {
+	return strings.Join(lo.Map(strings.Split(str, "\n"), func(line string, _ int) string {
+		if line == "" {
+			return line
+		}
+		return "    " + line
+	}), "\n")
+}
@@ -1,8 +1,16 @@
 package presentation
 
 import (
+	"fmt"
+	"strings"
+
+	"github.com/jesseduffield/lazygit/pkg/commands/git_commands"
+	"github.com/jesseduffield/lazygit/pkg/commands/models"
 	"github.com/jesseduffield/lazygit/pkg/commands/types/enums"
+	"github.com/jesseduffield/lazygit/pkg/gui/style"
 	"github.com/jesseduffield/lazygit/pkg/i18n"
+	"github.com/jesseduffield/lazygit/pkg/theme"
+	"github.com/jesseduffield/lazygit/pkg/utils"
 )
 
 func FormatWorkingTreeStateTitle(tr *i18n.TranslationSet, rebaseMode enums.RebaseMode) string {
@@ -11,6 +19,10 @@ func FormatWorkingTreeStateTitle(tr *i18n.TranslationSet, rebaseMode enums.Rebas
 		return tr.RebasingStatus
 	case enums.REBASE_MODE_MERGING:
 		return tr.MergingStatus
+	case enums.REBASE_MODE_CHERRY_PICKING:
+		return tr.CherryPickingStatus
+	case enums.REBASE_MODE_REVERTING:
+		return tr.RevertingStatus
 	default:
 		// should never actually display this
 		return "none"
@@ -23,8 +35,142 @@ func FormatWorkingTreeStateLower(tr *i18n.TranslationSet, rebaseMode enums.Rebas
 		return tr.LowercaseRebasingStatus
 	case enums.REBASE_MODE_MERGING:
 		return tr.LowercaseMergingStatus
+	case enums.REBASE_MODE_CHERRY_PICKING:
+		return tr.LowercaseCherryPickingStatus
+	case enums.REBASE_MODE_REVERTING:
+		return tr.LowercaseRevertingStatus
 	default:
 		// should never actually display this
 		return "none"
 	}
 }
+
+// FormatSequencerState lists the commits of a cherry-pick or revert that git has
+// stopped in the middle of, in the order they are applied
+func FormatSequencerState(tr *i18n.TranslationSet, state *git_commands.SequencerState, shaLength int) string {
+	formatCommit := func(mark string, commit *models.Commit) string {
+		return fmt.Sprintf("  %s %s %s",
+			mark,
+			style.FgYellow.Sprint(utils.ShortShaOfLength(commit.Sha, shaLength)),
+			theme.DefaultTextColor.Sprint(commit.Name),
+		)
+	}
+
+	sections := []string{}
+	addSection := func(title string, lines []string) {
+		if len(lines) > 0 {
+			sections = append(sections, style.AttrBold.Sprint(title)+"\n"+strings.Join(lines, "\n"))
+		}
+	}
+
+	done := []string{}
+	for _, commit := range state.Done {
+		done = append(done, formatCommit(style.FgGreen.Sprint("✓"), commit))
+	}
+	addSection(tr.SequencerDone, done)
+
+	if state.Current != nil {
+		addSection(tr.SequencerCurrent, []string{formatCommit(style.FgRed.Sprint("✗"), state.Current)})
+	}
+
+	remaining := []string{}
+	for _, commit := range state.Remaining {
+		remaining = append(remaining, formatCommit(" ", commit))
+	}
+	addSection(tr.SequencerRemaining, remaining)
+
+	return strings.Join(sections, "\n\n")
+}
+
+// FormatRebaseState describes the rebase that's in progress: what is being
+// rebased onto what, how it was started, and how far it has got
+func FormatRebaseState(tr *i18n.TranslationSet, state *git_commands.RebaseState, progress *git_commands.RebaseProgress, shaLength int) string {
+	rows := [][]string{}
+	if state.Branch != "" {
+		rows = append(rows, []string{tr.OperationBranch, style.FgGreen.Sprint(state.Branch)})
+	}
+	if state.Onto != nil {
+		rows = append(rows, []string{tr.OperationOnto, formatOperationCommit(state.Onto, shaLength)})
+	}
+	if state.Total > 0 {
+		rows = append(rows, []string{tr.OperationProgress, fmt.Sprintf("%d/%d", state.Step, state.Total)})
+	}
+	if state.StoppedAt != nil {
+		rows = append(rows, []string{tr.OperationStoppedAt, formatOperationCommit(state.StoppedAt, shaLength)})
+	}
+	if state.StartedBy != "" {
+		rows = append(rows, []string{tr.OperationStartedBy, state.StartedBy})
+	}
+
+	sections := []string{formatOperationRows(rows)}
+	if steps := GetRebaseProgressDisplayStrings(progress, shaLength); len(steps) > 0 {
+		sections = append(sections, style.AttrBold.Sprint(tr.OperationSteps)+"\n"+strings.Join(steps, "\n"))
+	}
+
+	return strings.Join(sections, "\n\n")
+}
+
+// FormatMergeState describes the merge that's in progress
+func FormatMergeState(tr *i18n.TranslationSet, state *git_commands.MergeState, shaLength int) string {
+	rows := [][]string{}
+	for i, head := range state.Heads {
+		label := ""
+		if i == 0 {
+			label = tr.OperationMergeHeads
+		}
+		rows = append(rows, []string{label, formatOperationCommit(head, shaLength)})
+	}
+	if state.Message != "" {
+		rows = append(rows, []string{tr.OperationMergeMessage, state.Message})
+	}
+
+	return formatOperationRows(rows)
+}
+
+// FormatBisectState describes the bisect that's in progress, including each
+// commit that has been marked so far, which we take from the comments of
+// 'git bisect log'
+func FormatBisectState(tr *i18n.TranslationSet, info *git_commands.BisectInfo, log string, shaLength int) string {
+	rows := [][]string{
+		{tr.Bisect.StartedFrom, style.FgGreen.Sprint(info.GetStartSha())},
+		{tr.Bisect.Terms, fmt.Sprintf("%s → %s", info.OldTerm(), info.NewTerm())},
+	}
+	if info.GetCurrentSha() != "" {
+		rows = append(rows, []string{tr.Bisect.Current, style.FgYellow.Sprint(utils.ShortShaOfLength(info.GetCurrentSha(), shaLength))})
+	}
+
+	sections := []string{formatOperationRows(rows)}
+	if steps := bisectLogSteps(log); len(steps) > 0 {
+		sections = append(sections, style.AttrBold.Sprint(tr.OperationSteps)+"\n"+strings.Join(steps, "\n"))
+	}
+
+	return strings.Join(sections, "\n\n")
+}
+
+// The comments in a bisect log look like "# bad: [<sha>] <subject>", with a
+// "# status: ..." line after each step that we don't need
+func bisectLogSteps(log string) []string {
+	steps := []string{}
+	for _, line := range utils.SplitLines(log) {
+		step, ok := strings.CutPrefix(line, "# ")
+		if !ok || strings.HasPrefix(step, "status:") {
+			continue
+		}
+		steps = append(steps, "  "+step)
+	}
+	return steps
+}
+
+func formatOperationCommit(commit *models.Commit, shaLength int) string {
+	return style.FgYellow.Sprint(utils.ShortShaOfLength(commit.Sha, shaLength)) + " " + theme.DefaultTextColor.Sprint(commit.Name)
+}
+
+func formatOperationRows(rows [][]string) string {
+	for _, row := range rows {
+		if row[0] != "" {
+			row[0] = style.AttrBold.Sprint(row[0])
+		}
+	}
+	lines, _ := utils.RenderDisplayStrings(rows, nil)
+	return strings.Join(lines, "\n")
+}
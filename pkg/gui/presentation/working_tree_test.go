@@ -0,0 +1,71 @@
+package presentation
+
+import (
+	"testing"
+
+	"github.com/jesseduffield/lazygit/pkg/commands/git_commands"
+	"github.com/jesseduffield/lazygit/pkg/commands/models"
+	"github.com/jesseduffield/lazygit/pkg/i18n"
+	"github.com/jesseduffield/lazygit/pkg/utils"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFormatRebaseState(t *testing.T) {
+	tr := i18n.EnglishTranslationSet()
+
+	state := &git_commands.RebaseState{
+		Branch:    "feature",
+		Onto:      &models.Commit{Sha: "aaaaaaaaaa", Name: "upstream change"},
+		StoppedAt: &models.Commit{Sha: "bbbbbbbbbb", Name: "my change"},
+		Step:      2,
+		Total:     3,
+		StartedBy: "rebase (start): checkout master",
+	}
+
+	assert.Equal(t,
+		"Branch     feature\n"+
+			"Onto       aaaaaaa upstream change\n"+
+			"Progress   2/3\n"+
+			"Stopped at bbbbbbb my change\n"+
+			"Started by rebase (start): checkout master",
+		utils.Decolorise(FormatRebaseState(&tr, state, nil, 7)),
+	)
+}
+
+func TestFormatMergeState(t *testing.T) {
+	tr := i18n.EnglishTranslationSet()
+
+	state := &git_commands.MergeState{
+		Heads: []*models.Commit{
+			{Sha: "aaaaaaaaaa", Name: "first"},
+			{Sha: "bbbbbbbbbb", Name: "second"},
+		},
+		Message: "Merge branches 'one' and 'two'",
+	}
+
+	assert.Equal(t,
+		"Merging in aaaaaaa first\n"+
+			"           bbbbbbb second\n"+
+			"Message    Merge branches 'one' and 'two'",
+		utils.Decolorise(FormatMergeState(&tr, state, 7)),
+	)
+}
+
+func TestBisectLogSteps(t *testing.T) {
+	log := `git bisect start
+# status: waiting for both good and bad commits
+# bad: [aaaaaaaaaa] commit 08
+git bisect bad aaaaaaaaaa
+# status: waiting for good commit(s), bad commit known
+# good: [bbbbbbbbbb] commit 05
+git bisect good bbbbbbbbbb
+# skip: [cccccccccc] commit 07
+git bisect skip cccccccccc
+`
+
+	assert.Equal(t, []string{
+		"  bad: [aaaaaaaaaa] commit 08",
+		"  good: [bbbbbbbbbb] commit 05",
+		"  skip: [cccccccccc] commit 07",
+	}, bisectLogSteps(log))
+}
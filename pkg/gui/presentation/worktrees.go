@@ -46,6 +46,9 @@ func GetWorktreeDisplayString(tr *i18n.TranslationSet, worktree *models.Worktree
 	if worktree.IsPathMissing && !icons.IsIconEnabled() {
 		name += " " + tr.MissingWorktree
 	}
+	if worktree.IsLocked {
+		name += " " + tr.LockedWorktree
+	}
 	res = append(res, textStyle.Sprint(name))
 	return res
 }
@@ -11,6 +11,7 @@ import (
 
 	"github.com/creack/pty"
 	"github.com/jesseduffield/gocui"
+	"github.com/jesseduffield/lazygit/pkg/tasks"
 	"github.com/jesseduffield/lazygit/pkg/utils"
 )
 
@@ -42,14 +43,14 @@ func (gui *Gui) onResize() error {
 // which is just an io.Reader. the pty package lets us wrap a command in a
 // pseudo-terminal meaning we'll get the behaviour we want from the underlying
 // command.
-func (gui *Gui) newPtyTask(view *gocui.View, cmd *exec.Cmd, prefix string) error {
+func (gui *Gui) newPtyTask(view *gocui.View, cmd *exec.Cmd, getPrefix func() string) error {
 	width, _ := gui.Views.Main.Size()
 	pager := gui.git.Config.GetPager(width)
 	externalDiffCommand := gui.Config.GetUserConfig().Git.Paging.ExternalDiffCommand
 
 	if pager == "" && externalDiffCommand == "" {
 		// if we're not using a custom pager we don't need to use a pty
-		return gui.newCmdTask(view, cmd, prefix)
+		return gui.newDiffCmdTask(view, cmd, getPrefix)
 	}
 
 	cmdStr := strings.Join(cmd.Args, " ")
@@ -81,7 +82,10 @@ func (gui *Gui) newPtyTask(view *gocui.View, cmd *exec.Cmd, prefix string) error
 	}
 
 	linesToRead := gui.linesToReadFromCmdTask(view)
-	if err := manager.NewTask(manager.NewCmdTask(start, prefix, linesToRead, onClose), cmdStr); err != nil {
+	task := func(opts tasks.TaskOpts) error {
+		return manager.NewCmdTask(start, getPrefix(), linesToRead, onClose)(opts)
+	}
+	if err := manager.NewTask(task, cmdStr); err != nil {
 		return err
 	}
 
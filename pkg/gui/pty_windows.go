@@ -13,6 +13,6 @@ func (gui *Gui) onResize() error {
 	return nil
 }
 
-func (gui *Gui) newPtyTask(view *gocui.View, cmd *exec.Cmd, prefix string) error {
-	return gui.newCmdTask(view, cmd, prefix)
+func (gui *Gui) newPtyTask(view *gocui.View, cmd *exec.Cmd, getPrefix func() string) error {
+	return gui.newDiffCmdTask(view, cmd, getPrefix)
 }
@@ -3,7 +3,9 @@ package custom_commands
 import (
 	"fmt"
 	"strings"
+	"sync"
 	"text/template"
+	"time"
 
 	"github.com/jesseduffield/gocui"
 	"github.com/jesseduffield/lazygit/pkg/config"
@@ -22,6 +24,7 @@ type HandlerCreator struct {
 	menuGenerator        *MenuGenerator
 	suggestionsHelper    *helpers.SuggestionsHelper
 	mergeAndRebaseHelper *helpers.MergeAndRebaseHelper
+	suggestionsCache     *suggestionsCache
 }
 
 func NewHandlerCreator(
@@ -40,6 +43,7 @@ func NewHandlerCreator(
 		menuGenerator:        menuGenerator,
 		suggestionsHelper:    suggestionsHelper,
 		mergeAndRebaseHelper: mergeAndRebaseHelper,
+		suggestionsCache:     newSuggestionsCache(),
 	}
 }
 
@@ -139,27 +143,77 @@ func (self *HandlerCreator) generateFindSuggestionsFunc(prompt *config.CustomCom
 	} else if prompt.Suggestions.Preset != "" {
 		return self.getPresetSuggestionsFn(prompt.Suggestions.Preset)
 	} else if prompt.Suggestions.Command != "" {
-		return self.getCommandSuggestionsFn(prompt.Suggestions.Command)
+		return self.getCommandSuggestionsFn(prompt.Suggestions)
 	}
 
 	return nil, nil
 }
 
-func (self *HandlerCreator) getCommandSuggestionsFn(command string) (func(string) []*types.Suggestion, error) {
-	lines := []*types.Suggestion{}
-	err := self.c.OS().Cmd.NewShell(command).RunAndProcessLines(func(line string) (bool, error) {
-		lines = append(lines, &types.Suggestion{Value: line, Label: line})
-		return false, nil
-	})
-	if err != nil {
+// The command is run in the background so that a slow command (e.g. one that
+// talks to an issue tracker) doesn't hold up the prompt; the suggestions are
+// refreshed once it's done.
+func (self *HandlerCreator) getCommandSuggestionsFn(suggestions config.CustomCommandSuggestions) (func(string) []*types.Suggestion, error) {
+	// parsing the filter and formats up front, so that mistakes in them are
+	// reported before the prompt is shown
+	if _, err := self.menuGenerator.call("", suggestions.Filter, suggestions.ValueFormat, suggestions.LabelFormat); err != nil {
 		return nil, err
 	}
 
-	return func(currentWord string) []*types.Suggestion {
-		return lo.Filter(lines, func(suggestion *types.Suggestion, _ int) bool {
-			return strings.Contains(strings.ToLower(suggestion.Value), strings.ToLower(currentWord))
+	var mutex sync.Mutex
+	items := []*types.Suggestion{}
+
+	findSuggestions := func(currentWord string) []*types.Suggestion {
+		mutex.Lock()
+		defer mutex.Unlock()
+
+		currentWord = strings.ToLower(currentWord)
+		return lo.Filter(items, func(suggestion *types.Suggestion, _ int) bool {
+			return strings.Contains(strings.ToLower(suggestion.Value), currentWord) ||
+				strings.Contains(strings.ToLower(utils.Decolorise(suggestion.Label)), currentWord)
 		})
-	}, nil
+	}
+
+	cacheKey := strings.Join([]string{
+		self.c.Git().RepoPaths.WorktreePath(),
+		suggestions.Command,
+		suggestions.Filter,
+		suggestions.ValueFormat,
+		suggestions.LabelFormat,
+	}, "\x00")
+	if cached, ok := self.suggestionsCache.get(cacheKey, time.Duration(suggestions.CacheSeconds)*time.Second); ok {
+		items = cached
+		return findSuggestions, nil
+	}
+
+	_ = self.c.WithWaitingStatus(self.c.Tr.LoadingSuggestions, func(gocui.Task) error {
+		output, err := self.c.OS().Cmd.NewShell(suggestions.Command).RunWithOutput()
+		if err != nil {
+			return err
+		}
+
+		candidates, err := self.menuGenerator.call(output, suggestions.Filter, suggestions.ValueFormat, suggestions.LabelFormat)
+		if err != nil {
+			return err
+		}
+
+		loaded := lo.Map(candidates, func(candidate *commandMenuItem, _ int) *types.Suggestion {
+			return &types.Suggestion{Value: candidate.value, Label: candidate.label}
+		})
+
+		mutex.Lock()
+		items = loaded
+		mutex.Unlock()
+
+		if suggestions.CacheSeconds > 0 {
+			self.suggestionsCache.set(cacheKey, loaded)
+		}
+
+		self.c.Contexts().Suggestions.RefreshSuggestions()
+
+		return nil
+	})
+
+	return findSuggestions, nil
 }
 
 func (self *HandlerCreator) getPresetSuggestionsFn(preset string) (func(string) []*types.Suggestion, error) {
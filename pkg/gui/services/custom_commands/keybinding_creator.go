@@ -24,12 +24,13 @@ func NewKeybindingCreator(c *helpers.HelperCommon) *KeybindingCreator {
 	}
 }
 
-func (self *KeybindingCreator) call(customCommand config.CustomCommand, handler func() error) (*types.Binding, error) {
+// returns one binding for each of the contexts that the custom command applies to
+func (self *KeybindingCreator) call(customCommand config.CustomCommand, handler func() error) ([]*types.Binding, error) {
 	if customCommand.Context == "" {
 		return nil, formatContextNotProvidedError(customCommand)
 	}
 
-	viewName, err := self.getViewNameAndContexts(customCommand)
+	viewNames, err := self.getViewNames(customCommand)
 	if err != nil {
 		return nil, err
 	}
@@ -39,27 +40,44 @@ func (self *KeybindingCreator) call(customCommand config.CustomCommand, handler
 		description = customCommand.Command
 	}
 
-	return &types.Binding{
-		ViewName:    viewName,
-		Key:         keybindings.GetKey(customCommand.Key),
-		Modifier:    gocui.ModNone,
-		Handler:     handler,
-		Description: description,
-	}, nil
+	return lo.Map(viewNames, func(viewName string, _ int) *types.Binding {
+		return &types.Binding{
+			ViewName:    viewName,
+			Key:         keybindings.GetKey(customCommand.Key),
+			Modifier:    gocui.ModNone,
+			Handler:     handler,
+			Description: description,
+		}
+	}), nil
 }
 
-func (self *KeybindingCreator) getViewNameAndContexts(customCommand config.CustomCommand) (string, error) {
-	if customCommand.Context == "global" {
-		return "", nil
-	}
+func (self *KeybindingCreator) getViewNames(customCommand config.CustomCommand) ([]string, error) {
+	viewNames := []string{}
+	for _, contextKey := range splitContexts(customCommand.Context) {
+		if contextKey == "global" {
+			viewNames = append(viewNames, "")
+			continue
+		}
 
-	ctx, ok := self.contextForContextKey(types.ContextKey(customCommand.Context))
-	if !ok {
-		return "", formatUnknownContextError(customCommand)
+		ctx, ok := self.contextForContextKey(types.ContextKey(contextKey))
+		if !ok {
+			return nil, formatUnknownContextError(customCommand)
+		}
+
+		viewNames = append(viewNames, ctx.GetViewName())
 	}
 
-	viewName := ctx.GetViewName()
-	return viewName, nil
+	// in case the same context is listed twice
+	return lo.Uniq(viewNames), nil
+}
+
+func splitContexts(contexts string) []string {
+	return lo.Filter(
+		lo.Map(strings.Split(contexts, ","), func(context string, _ int) string {
+			return strings.TrimSpace(context)
+		}),
+		func(context string, _ int) bool { return context != "" },
+	)
 }
 
 func (self *KeybindingCreator) contextForContextKey(contextKey types.ContextKey) (types.Context, bool) {
@@ -0,0 +1,26 @@
+package custom_commands
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSplitContexts(t *testing.T) {
+	scenarios := []struct {
+		contexts string
+		expected []string
+	}{
+		{"commits", []string{"commits"}},
+		{"commits, subCommits", []string{"commits", "subCommits"}},
+		{" files ,commitFiles,", []string{"files", "commitFiles"}},
+		{"", []string{}},
+	}
+
+	for _, s := range scenarios {
+		s := s
+		t.Run(s.contexts, func(t *testing.T) {
+			assert.EqualValues(t, s.expected, splitContexts(s.contexts))
+		})
+	}
+}
@@ -22,6 +22,11 @@ func (self *Resolver) resolvePrompt(
 	result := &config.CustomCommandPrompt{
 		ValueFormat: prompt.ValueFormat,
 		LabelFormat: prompt.LabelFormat,
+		Suggestions: config.CustomCommandSuggestions{
+			ValueFormat:  prompt.Suggestions.ValueFormat,
+			LabelFormat:  prompt.Suggestions.LabelFormat,
+			CacheSeconds: prompt.Suggestions.CacheSeconds,
+		},
 	}
 
 	result.Title, err = resolveTemplate(prompt.Title)
@@ -44,6 +49,11 @@ func (self *Resolver) resolvePrompt(
 		return nil, err
 	}
 
+	result.Suggestions.Filter, err = resolveTemplate(prompt.Suggestions.Filter)
+	if err != nil {
+		return nil, err
+	}
+
 	result.Body, err = resolveTemplate(prompt.Body)
 	if err != nil {
 		return nil, err
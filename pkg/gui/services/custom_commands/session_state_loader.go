@@ -2,6 +2,7 @@ package custom_commands
 
 import (
 	"github.com/jesseduffield/lazygit/pkg/commands/models"
+	"github.com/jesseduffield/lazygit/pkg/gui/context"
 	"github.com/jesseduffield/lazygit/pkg/gui/controllers/helpers"
 )
 
@@ -35,6 +36,17 @@ type SessionState struct {
 	SelectedCommitFilePath string
 	SelectedWorktree       *models.Worktree
 	CheckedOutBranch       *models.Branch
+
+	// The following include every item in the selected range, or just the
+	// selected item when no range is being selected.
+
+	// The commits selected in whichever commits panel is focused (local,
+	// reflog, sub-commits or file history), falling back to the local commits
+	SelectedCommits         []*models.Commit
+	SelectedFiles           []*models.File
+	SelectedPaths           []string
+	SelectedCommitFiles     []*models.CommitFile
+	SelectedCommitFilePaths []string
 }
 
 func (self *SessionStateLoader) call() *SessionState {
@@ -53,5 +65,24 @@ func (self *SessionStateLoader) call() *SessionState {
 		SelectedSubCommit:      self.c.Contexts().SubCommits.GetSelected(),
 		SelectedWorktree:       self.c.Contexts().Worktrees.GetSelected(),
 		CheckedOutBranch:       self.refsHelper.GetCheckedOutRef(),
+
+		SelectedCommits:         self.selectedCommits(),
+		SelectedFiles:           self.c.Contexts().Files.GetSelectedFiles(),
+		SelectedPaths:           self.c.Contexts().Files.GetSelectedPaths(),
+		SelectedCommitFiles:     self.c.Contexts().CommitFiles.GetSelectedFiles(),
+		SelectedCommitFilePaths: self.c.Contexts().CommitFiles.GetSelectedPaths(),
+	}
+}
+
+func (self *SessionStateLoader) selectedCommits() []*models.Commit {
+	switch self.c.CurrentSideContext().GetKey() {
+	case context.REFLOG_COMMITS_CONTEXT_KEY:
+		return self.c.Contexts().ReflogCommits.GetSelectedItems()
+	case context.SUB_COMMITS_CONTEXT_KEY:
+		return self.c.Contexts().SubCommits.GetSelectedItems()
+	case context.FILE_HISTORY_CONTEXT_KEY:
+		return self.c.Contexts().FileHistory.GetSelectedItems()
+	default:
+		return self.c.Contexts().LocalCommits.GetSelectedItems()
 	}
 }
@@ -0,0 +1,56 @@
+package custom_commands
+
+import (
+	"sync"
+	"time"
+
+	"github.com/jesseduffield/lazygit/pkg/gui/types"
+)
+
+// holds on to the suggestions obtained from a prompt's suggestions command, for
+// prompts that have the cacheSeconds field set
+type suggestionsCache struct {
+	mutex   sync.Mutex
+	entries map[string]suggestionsCacheEntry
+	// for the sake of tests
+	now func() time.Time
+}
+
+type suggestionsCacheEntry struct {
+	suggestions []*types.Suggestion
+	loadedAt    time.Time
+}
+
+func newSuggestionsCache() *suggestionsCache {
+	return &suggestionsCache{
+		entries: map[string]suggestionsCacheEntry{},
+		now:     time.Now,
+	}
+}
+
+// returns the cached suggestions for the key, unless they're older than maxAge
+func (self *suggestionsCache) get(key string, maxAge time.Duration) ([]*types.Suggestion, bool) {
+	if maxAge <= 0 {
+		return nil, false
+	}
+
+	self.mutex.Lock()
+	defer self.mutex.Unlock()
+
+	entry, ok := self.entries[key]
+	if !ok || self.now().Sub(entry.loadedAt) > maxAge {
+		return nil, false
+	}
+
+	return entry.suggestions, true
+}
+
+func (self *suggestionsCache) set(key string, suggestions []*types.Suggestion) {
+	self.mutex.Lock()
+	defer self.mutex.Unlock()
+
+	self.entries[key] = suggestionsCacheEntry{
+		suggestions: suggestions,
+		loadedAt:    self.now(),
+	}
+}
@@ -0,0 +1,36 @@
+package custom_commands
+
+import (
+	"testing"
+	"time"
+
+	"github.com/jesseduffield/lazygit/pkg/gui/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSuggestionsCache(t *testing.T) {
+	now := time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC)
+	cache := newSuggestionsCache()
+	cache.now = func() time.Time { return now }
+
+	_, ok := cache.get("key", time.Minute)
+	assert.False(t, ok)
+
+	suggestions := []*types.Suggestion{{Value: "PROJ-1", Label: "PROJ-1 Fix the thing"}}
+	cache.set("key", suggestions)
+
+	cached, ok := cache.get("key", time.Minute)
+	assert.True(t, ok)
+	assert.Equal(t, suggestions, cached)
+
+	_, ok = cache.get("other key", time.Minute)
+	assert.False(t, ok)
+
+	// a max age of zero means caching is disabled
+	_, ok = cache.get("key", 0)
+	assert.False(t, ok)
+
+	now = now.Add(2 * time.Minute)
+	_, ok = cache.get("key", time.Minute)
+	assert.False(t, ok)
+}
@@ -6,10 +6,22 @@ import (
 	"strings"
 
 	"github.com/jesseduffield/gocui"
+	"github.com/jesseduffield/lazygit/pkg/commands/patch"
 	"github.com/jesseduffield/lazygit/pkg/tasks"
 )
 
 func (gui *Gui) newCmdTask(view *gocui.View, cmd *exec.Cmd, prefix string) error {
+	return gui.newCmdTaskAux(view, cmd, func() string { return prefix }, false)
+}
+
+// newDiffCmdTask is like newCmdTask, but for commands whose output may contain
+// diffs, which we lay out side by side if the user has asked for that
+func (gui *Gui) newDiffCmdTask(view *gocui.View, cmd *exec.Cmd, getPrefix func() string) error {
+	return gui.newCmdTaskAux(view, cmd, getPrefix, gui.c.GetAppState().SplitDiffView)
+}
+
+// getPrefix is called from the task, so that it doesn't hold up the UI thread
+func (gui *Gui) newCmdTaskAux(view *gocui.View, cmd *exec.Cmd, getPrefix func() string, splitDiff bool) error {
 	cmdStr := strings.Join(cmd.Args, " ")
 	gui.c.Log.WithField(
 		"command",
@@ -29,11 +41,18 @@ func (gui *Gui) newCmdTask(view *gocui.View, cmd *exec.Cmd, prefix string) error
 			gui.c.Log.Error(err)
 		}
 
+		if splitDiff {
+			return cmd, patch.NewSplitDiffReader(r, view.InnerWidth())
+		}
+
 		return cmd, r
 	}
 
 	linesToRead := gui.linesToReadFromCmdTask(view)
-	if err := manager.NewTask(manager.NewCmdTask(start, prefix, linesToRead, nil), cmdStr); err != nil {
+	task := func(opts tasks.TaskOpts) error {
+		return manager.NewCmdTask(start, getPrefix(), linesToRead, nil)(opts)
+	}
+	if err := manager.NewTask(task, cmdStr); err != nil {
 		gui.c.Log.Error(err)
 	}
 
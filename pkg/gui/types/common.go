@@ -9,6 +9,7 @@ import (
 	"github.com/jesseduffield/lazygit/pkg/commands/types/enums"
 	"github.com/jesseduffield/lazygit/pkg/common"
 	"github.com/jesseduffield/lazygit/pkg/config"
+	"github.com/jesseduffield/lazygit/pkg/gui/presentation/links"
 	"github.com/jesseduffield/lazygit/pkg/utils"
 	"github.com/sasha-s/go-deadlock"
 	"gopkg.in/ozeidan/fuzzy-patricia.v3/patricia"
@@ -48,6 +49,9 @@ type IGuiCommon interface {
 	RenderToMainViews(opts RefreshMainOpts) error
 	// used purely for the sake of RenderToMainViews to provide the pair of main views we want to render to
 	MainViewPairs() MainViewPairs
+	// like RenderToMainViews, but for a view that isn't one of the main views,
+	// e.g. the commit peek
+	RenderToView(view *gocui.View, task UpdateTask) error
 
 	// returns true if command completed successfully
 	RunSubprocess(cmdObj oscommands.ICmdObj) (bool, error)
@@ -222,6 +226,11 @@ type MenuItem struct {
 	// Only applies when Label is used
 	OpensMenu bool
 
+	// If true, the menu stays open when the item is pressed, and is re-rendered
+	// afterwards. Useful for items that toggle something shown in the menu
+	// itself, e.g. a checkbox in front of their label.
+	KeepOpen bool
+
 	// If Key is defined it allows the user to press the key to invoke the menu
 	// item, as opposed to having to navigate to it
 	Key Key
@@ -253,6 +262,9 @@ type Model struct {
 	Remotes      []*models.Remote
 	Worktrees    []*models.Worktree
 
+	// the commits shown in the file history panel
+	FileHistoryCommits []*models.Commit
+
 	// FilteredReflogCommits are the ones that appear in the reflog panel.
 	// when in filtering mode we only include the ones that match the given path
 	FilteredReflogCommits []*models.Commit
@@ -270,10 +282,18 @@ type Model struct {
 	// we're on a detached head because we're rebasing or bisecting.
 	CheckedOutBranch string
 
+	// The todos done and remaining in an interactive rebase; nil if we're not
+	// in one
+	RebaseProgress *git_commands.RebaseProgress
+
 	// for displaying suggestions while typing in a file name
 	FilesTrie *patricia.Trie
 
 	Authors map[string]*models.Author
+
+	// for linking issue references and URLs in commit messages and branch
+	// names; depends on the remotes, so it's rebuilt when they are refreshed
+	Linker *links.Linker
 }
 
 // if you add a new mutex here be sure to instantiate it. We're using pointers to
@@ -318,11 +338,19 @@ type IStateAccessor interface {
 	GetIsRefreshingFiles() bool
 	GetShowExtrasWindow() bool
 	SetShowExtrasWindow(bool)
+	GetPresenterMode() bool
+	SetPresenterMode(bool)
+	GetDryRun() bool
+	SetDryRun(bool)
 	GetRetainOriginalDir() bool
 	SetRetainOriginalDir(bool)
 	GetItemOperation(item HasUrn) ItemOperation
 	SetItemOperation(item HasUrn, operation ItemOperation)
 	ClearItemOperation(item HasUrn)
+	// the commands we've logged in the command log so far
+	GetCommandLogEntries() []*models.CommandLogEntry
+	// the plain text content of the command log, including command output
+	GetCommandLogContent() string
 }
 
 type IRepoStateAccessor interface {
@@ -338,6 +366,8 @@ type IRepoStateAccessor interface {
 	GetSearchState() *SearchState
 	SetSplitMainPanel(bool)
 	GetSplitMainPanel() bool
+	GetDateDisplayToggled() bool
+	SetDateDisplayToggled(bool)
 }
 
 // startup stages so we don't need to load everything at once
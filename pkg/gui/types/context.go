@@ -4,6 +4,7 @@ import (
 	"github.com/jesseduffield/gocui"
 	"github.com/jesseduffield/lazygit/pkg/config"
 	"github.com/jesseduffield/lazygit/pkg/gui/patch_exploring"
+	"github.com/jesseduffield/lazygit/pkg/i18n"
 	"github.com/jesseduffield/lazygit/pkg/utils"
 	"github.com/sasha-s/go-deadlock"
 )
@@ -166,6 +167,7 @@ type IViewTrait interface {
 	SetViewPortContent(content string)
 	SetContent(content string)
 	SetFooter(value string)
+	SetScrollPositionFooter(tr *i18n.TranslationSet)
 	SetOriginX(value int)
 	ViewPortYBounds() (int, int)
 	ScrollLeft()
@@ -226,6 +228,14 @@ type IListCursor interface {
 	RefreshSelectedIdx()
 }
 
+// implemented by lists in which a range of lines can be selected
+type IRangeSelect interface {
+	ToggleRangeSelect()
+	CancelRangeSelect()
+	IsSelectingRange() bool
+	GetSelectionRange() (int, int)
+}
+
 type IListPanelState interface {
 	SetSelectedLineIdx(int)
 	GetSelectedLineIdx() int
@@ -87,6 +87,13 @@ func NewRunCommandTaskWithPrefix(cmd *exec.Cmd, prefix string) *RunCommandTask {
 type RunPtyTask struct {
 	Cmd    *exec.Cmd
 	Prefix string
+	// if set, this is called when the task starts, off the UI thread, to get
+	// the prefix. Use this when getting the prefix involves running a command.
+	GetPrefix func() string
+	// if set, this is called when the task starts, off the UI thread, before
+	// running the command, e.g. to set up files that the command reads. If it
+	// fails, the error is shown above the command's output.
+	Prepare func() error
 }
 
 func (t *RunPtyTask) IsUpdateTask() {}
@@ -94,3 +101,7 @@ func (t *RunPtyTask) IsUpdateTask() {}
 func NewRunPtyTask(cmd *exec.Cmd) *RunPtyTask {
 	return &RunPtyTask{Cmd: cmd}
 }
+
+func NewRunPtyTaskWithPrefixFunc(cmd *exec.Cmd, getPrefix func() string) *RunPtyTask {
+	return &RunPtyTask{Cmd: cmd, GetPrefix: getPrefix}
+}
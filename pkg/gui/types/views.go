@@ -30,6 +30,7 @@ type Views struct {
 	CommitDescription *gocui.View
 	CommitFiles       *gocui.View
 	SubCommits        *gocui.View
+	FileHistory       *gocui.View
 	Information       *gocui.View
 	AppStatus         *gocui.View
 	Search            *gocui.View
@@ -39,6 +40,10 @@ type Views struct {
 	Limit             *gocui.View
 	Suggestions       *gocui.View
 	Tooltip           *gocui.View
+	CommitPeek        *gocui.View
+	Keystrokes        *gocui.View
+	CommitsMinimap    *gocui.View
+	RebaseProgress    *gocui.View
 	Extras            *gocui.View
 
 	// for playing the easter egg snake game
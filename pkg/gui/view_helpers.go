@@ -4,6 +4,7 @@ import (
 	"time"
 
 	"github.com/jesseduffield/gocui"
+	"github.com/jesseduffield/lazygit/pkg/gui/context"
 	"github.com/jesseduffield/lazygit/pkg/gui/types"
 	"github.com/jesseduffield/lazygit/pkg/tasks"
 	"github.com/jesseduffield/lazygit/pkg/utils"
@@ -140,6 +141,11 @@ func (gui *Gui) postRefreshUpdate(c types.Context) error {
 		return err
 	}
 
+	switch c.GetKey() {
+	case context.LOCAL_COMMITS_CONTEXT_KEY, context.LOCAL_BRANCHES_CONTEXT_KEY:
+		gui.helpers.CommitsMinimap.Invalidate()
+	}
+
 	if gui.currentViewName() == c.GetViewName() {
 		if err := c.HandleFocus(types.OnFocusOpts{}); err != nil {
 			return err
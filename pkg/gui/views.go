@@ -34,8 +34,10 @@ func (gui *Gui) orderedViewNameMappings() []viewNameMapping {
 		{viewPtr: &gui.Views.RemoteBranches, name: "remoteBranches"},
 		{viewPtr: &gui.Views.ReflogCommits, name: "reflogCommits"},
 		{viewPtr: &gui.Views.Commits, name: "commits"},
+		{viewPtr: &gui.Views.CommitsMinimap, name: "commitsMinimap"},
 		{viewPtr: &gui.Views.Stash, name: "stash"},
 		{viewPtr: &gui.Views.SubCommits, name: "subCommits"},
+		{viewPtr: &gui.Views.FileHistory, name: "fileHistory"},
 		{viewPtr: &gui.Views.CommitFiles, name: "commitFiles"},
 
 		{viewPtr: &gui.Views.Staging, name: "staging"},
@@ -46,6 +48,7 @@ func (gui *Gui) orderedViewNameMappings() []viewNameMapping {
 		{viewPtr: &gui.Views.Secondary, name: "secondary"},
 		{viewPtr: &gui.Views.Main, name: "main"},
 
+		{viewPtr: &gui.Views.RebaseProgress, name: "rebaseProgress"},
 		{viewPtr: &gui.Views.Extras, name: "extras"},
 
 		// bottom line
@@ -66,6 +69,8 @@ func (gui *Gui) orderedViewNameMappings() []viewNameMapping {
 		{viewPtr: &gui.Views.Suggestions, name: "suggestions"},
 		{viewPtr: &gui.Views.Confirmation, name: "confirmation"},
 		{viewPtr: &gui.Views.Tooltip, name: "tooltip"},
+		{viewPtr: &gui.Views.CommitPeek, name: "commitPeek"},
+		{viewPtr: &gui.Views.Keystrokes, name: "keystrokes"},
 
 		// this guy will cover everything else when it appears
 		{viewPtr: &gui.Views.Limit, name: "limit"},
@@ -114,6 +119,10 @@ func (gui *Gui) createAllViews() error {
 
 	gui.Views.Commits.Title = gui.c.Tr.CommitsTitle
 
+	gui.Views.CommitsMinimap.Highlight = false
+
+	gui.Views.RebaseProgress.Title = gui.c.Tr.RebaseProgressTitle
+
 	gui.Views.CommitFiles.Title = gui.c.Tr.CommitFiles
 
 	gui.Views.Branches.Title = gui.c.Tr.BranchesTitle
@@ -183,6 +192,12 @@ func (gui *Gui) createAllViews() error {
 
 	gui.Views.Tooltip.Visible = false
 
+	gui.Views.CommitPeek.Visible = false
+	gui.Views.CommitPeek.Wrap = true
+
+	gui.Views.Keystrokes.Visible = false
+	gui.Views.Keystrokes.Title = gui.c.Tr.KeystrokesTitle
+
 	gui.Views.Information.BgColor = gocui.ColorDefault
 	gui.Views.Information.FgColor = gocui.ColorGreen
 	gui.Views.Information.Frame = false
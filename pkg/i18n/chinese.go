@@ -327,7 +327,6 @@ func chineseTranslationSet() TranslationSet {
 		Keybindings:                         "按键绑定",
 		RenameBranch:                        "重命名分支",
 		NewBranchNamePrompt:                 "输入分支的新名称",
-		RenameBranchWarning:                 "该分支正在跟踪远程仓库。此操作将仅会重命名本地分支名称，而不会重命名远程分支的名称。确定继续？",
 		OpenMenu:                            "打开菜单",
 		ResetCherryPick:                     "重置已拣选（复制）的提交",
 		NextTab:                             "下一个标签",
@@ -282,7 +282,6 @@ func dutchTranslationSet() TranslationSet {
 		Keybindings:                         "Sneltoetsen",
 		RenameBranch:                        "Hernoem branch",
 		NewBranchNamePrompt:                 "Noem een nieuwe branch naam",
-		RenameBranchWarning:                 "Deze branch volgt een remote. Deze actie zal alleen de locale branch name wijzigen niet de naam van de remote branch. Verder gaan?",
 		OpenMenu:                            "Open menu",
 		ResetCherryPick:                     "Reset cherry-picked (gekopieerde) commits selectie",
 		NextTab:                             "Volgende tabblad",
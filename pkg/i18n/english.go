@@ -55,6 +55,28 @@ type TranslationSet struct {
 	ToggleStaged                        string
 	ToggleStagedAll                     string
 	ToggleTreeView                      string
+	ToggleGroupByChangeType             string
+	ToggleGroupByChangeTypeTooltip      string
+	CollapseAll                         string
+	CollapseAllTooltip                  string
+	ExpandAll                           string
+	ExpandAllTooltip                    string
+	CollapseToLevel                     string
+	CollapseToLevelTooltip              string
+	CollapseToLevelItem                 string
+	NoDirectoriesToCollapse             string
+	ConflictsSection                    string
+	StagedSection                       string
+	UnstagedSection                     string
+	UntrackedSection                    string
+	NotAvailableOnSectionHeader         string
+	CycleFileSortOrder                  string
+	CycleFileSortOrderTooltip           string
+	FilesSortedBy                       string
+	FileSortByName                      string
+	FileSortByStatus                    string
+	FileSortByModTime                   string
+	FileSortByChangedLines              string
 	OpenDiffTool                        string
 	OpenMergeTool                       string
 	Refresh                             string
@@ -71,6 +93,12 @@ type TranslationSet struct {
 	NoContentToCopyError                string
 	FileNameCopiedToast                 string
 	FilePathCopiedToast                 string
+	CopyAbsolutePath                    string
+	AbsolutePathCopiedToast             string
+	CopyDirectory                       string
+	DirectoryCopiedToast                string
+	PathIsAtTopLevel                    string
+	CopyCommitFileDiffTooltip           string
 	FileDiffCopiedToast                 string
 	AllFilesDiffCopiedToast             string
 	FilterStagedFiles                   string
@@ -132,6 +160,10 @@ type TranslationSet struct {
 	RenameCommitEditor                  string
 	NoCommitsThisBranch                 string
 	UpdateRefHere                       string
+	RebaseTodoLabelHere                 string
+	RebaseTodoResetTo                   string
+	RebaseTodoMerge                     string
+	CannotMoveMergeStructureTodo        string
 	Error                               string
 	Undo                                string
 	UndoReflog                          string
@@ -140,26 +172,41 @@ type TranslationSet struct {
 	RedoTooltip                         string
 	DiscardAllTooltip                   string
 	DiscardUnstagedTooltip              string
+	DiscardRangeAllTooltip              string
+	DiscardRangeUnstagedTooltip         string
+	SelectedFilesCount                  string
 	Pop                                 string
 	Drop                                string
 	Apply                               string
 	NoStashEntries                      string
 	StashDrop                           string
 	SureDropStashEntry                  string
+	SureDropStashEntries                string
 	StashPop                            string
 	SurePopStashEntry                   string
 	StashApply                          string
 	SureApplyStashEntry                 string
+	SureApplyStashEntryWithIndex        string
+	ApplyWithIndex                      string
+	ApplyWithIndexTooltip               string
 	NoTrackedStagedFilesStash           string
 	NoFilesToStash                      string
 	StashChanges                        string
 	RenameStash                         string
 	RenameStashPrompt                   string
+	BranchFromStash                     string
+	BranchFromStashTooltip              string
+	BranchFromStashPrompt               string
 	OpenConfig                          string
 	EditConfig                          string
 	ForcePush                           string
 	ForcePushPrompt                     string
 	ForcePushDisabled                   string
+	SharedBranchIntro                   string
+	SharedBranchMatchesPattern          string
+	SharedBranchHasOtherAuthors         string
+	SharedBranchOnOtherRemotes          string
+	ForcePushDivergence                 string
 	UpdatesRejectedAndForcePushDisabled string
 	CheckForUpdate                      string
 	CheckingForUpdates                  string
@@ -185,12 +232,33 @@ type TranslationSet struct {
 	OpenInEditor                        string
 	IgnoreFile                          string
 	ExcludeFile                         string
+	IgnorePattern                       string
+	ExcludePattern                      string
+	ShowIgnoreRules                     string
+	IgnoreRulesTitle                    string
+	NoIgnoreRules                       string
+	EditIgnoreRule                      string
+	RemoveIgnoreRule                    string
+	IgnoreRuleChanged                   string
+	CantRemoveGlobalIgnoreRule          string
 	RefreshFiles                        string
 	MergeIntoCurrentBranch              string
 	ConfirmQuit                         string
 	SwitchRepo                          string
 	AllBranchesLogGraph                 string
+	UIStateMenu                         string
+	UIStateMenuTooltip                  string
+	ExportUIState                       string
+	ImportUIState                       string
+	UIStateFilePrompt                   string
+	UIStateExported                     string
+	UIStateImported                     string
+	ChangeLanguage                      string
+	ChangeLanguageTooltip               string
+	CurrentLanguage                     string
+	UserProvidedLanguage                string
 	UnsupportedGitService               string
+	IssuesNotSupported                  string
 	CopyPullRequestURL                  string
 	NoBranchOnRemote                    string
 	Fetch                               string
@@ -204,23 +272,96 @@ type TranslationSet struct {
 	ToggleSelectHunk                    string
 	ToggleSelectionForPatch             string
 	EditHunk                            string
+	SplitHunk                           string
+	SplitHunkTooltip                    string
+	CannotSplitHunkHere                 string
+	ParkSelection                       string
+	ParkSelectionTooltip                string
+	ParkSelectionPrompt                 string
+	ApplySelection                      string
+	ApplySelectionTooltip               string
+	ApplySelectionToWorktree            string
+	ApplySelectionToIndex               string
+	ReverseSelectionInWorktree          string
+	ReverseSelectionInIndex             string
+	CantParkStagedChanges               string
+	ViewParkedChanges                   string
+	ViewParkedChangesTooltip            string
+	NoParkedChanges                     string
+	RestoreParkedChanges                string
+	DropParkedChanges                   string
+	DropParkedChangesPrompt             string
+	ViewTrash                           string
+	ViewTrashTooltip                    string
+	NoTrashEntries                      string
+	RestoreTrashEntry                   string
+	RestoreTrashEntryTooltip            string
+	DropTrashEntry                      string
+	DropTrashEntryPrompt                string
+	ToggleAssumeUnchanged               string
+	ToggleAssumeUnchangedTooltip        string
+	ToggleSkipWorktree                  string
+	ToggleSkipWorktreeTooltip           string
+	ToggleExecutable                    string
+	ToggleExecutableTooltip             string
+	CantFlagDirectory                   string
+	CantFlagUntrackedFile               string
+	StageByPattern                      string
+	StageByPatternTooltip               string
+	StageMatchingFiles                  string
+	UnstageMatchingFiles                string
+	PathPatternPrompt                   string
+	NoUnstagedFilesMatchPattern         string
+	NoStagedFilesMatchPattern           string
+	StagedMatchingFiles                 string
+	UnstagedMatchingFiles               string
+	QuickFixup                          string
+	QuickFixupTooltip                   string
+	QuickFixupTitle                     string
+	NoFixupTargets                      string
+	PredictingFixupConflictsStatus      string
+	FixupAppliesCleanly                 string
+	FixupMayConflict                    string
+	FixupMayConflictTooltip             string
+	StageLargeFilesTitle                string
+	StageLargeFilesPrompt               string
 	ToggleStagingPanel                  string
 	ReturnToFilesPanel                  string
 	FastForward                         string
 	FastForwarding                      string
 	FoundConflictsTitle                 string
+	ExecFailedTitle                     string
+	RetryExec                           string
+	EditExecAndRetry                    string
+	EditExecTitle                       string
+	SkipExec                            string
+	ExecCommandCannotBeEmpty            string
 	ViewConflictsMenuItem               string
 	AbortMenuItem                       string
+	MergeOperation                      string
+	RebaseOperation                     string
+	CherryPickOperation                 string
+	RevertOperation                     string
 	PickHunk                            string
 	PickAllHunks                        string
+	ToggleConflictStyle                 string
+	ToggleConflictStyleTooltip          string
+	ToggleConflictStyleResolvedPrompt   string
 	ViewMergeRebaseOptions              string
 	NotMergingOrRebasing                string
 	AlreadyRebasing                     string
 	RecentRepos                         string
+	ParentRepos                         string
 	MergeOptionsTitle                   string
 	RebaseOptionsTitle                  string
+	CherryPickOptionsTitle              string
+	RevertOptionsTitle                  string
 	CommitSummaryTitle                  string
 	CommitDescriptionTitle              string
+	EditTrailers                        string
+	EditTrailersTooltip                 string
+	AddTrailer                          string
+	RemoveTrailer                       string
 	CommitDescriptionSubTitle           string
 	CommitDescriptionSubTitleNoSwitch   string
 	LocalBranchesTitle                  string
@@ -234,12 +375,23 @@ type TranslationSet struct {
 	SecondaryTitle                      string
 	ReflogCommitsTitle                  string
 	ConflictsResolved                   string
+	ContinuingOnConflictsResolved       string
 	Continue                            string
 	RebasingTitle                       string
 	RebasingFromBaseCommitTitle         string
 	SimpleRebase                        string
 	InteractiveRebase                   string
 	InteractiveRebaseTooltip            string
+	InteractiveRebaseWithAutosquash     string
+	EnableAutosquash                    string
+	DisableAutosquash                   string
+	ToggleAutosquashTooltip             string
+	RebaseOntoWithUpstream              string
+	RebaseOntoWithUpstreamTooltip       string
+	RebaseOntoUpstreamPromptTitle       string
+	RebaseOntoCommit                    string
+	RebaseOntoCommitTooltip             string
+	CannotRebaseOntoTodoCommit          string
 	ConfirmMerge                        string
 	FwdNoUpstream                       string
 	FwdNoLocalUpstream                  string
@@ -270,6 +422,8 @@ type TranslationSet struct {
 	ScrollUp                            string
 	ScrollUpMainPanel                   string
 	ScrollDownMainPanel                 string
+	ScrollPositionTop                   string
+	ScrollPositionBottom                string
 	AmendCommitTitle                    string
 	AmendCommitPrompt                   string
 	DeleteCommitTitle                   string
@@ -280,11 +434,32 @@ type TranslationSet struct {
 	SquashingStatus                     string
 	FixingStatus                        string
 	DeletingStatus                      string
+	RenamingBranchStatus                string
 	MovingStatus                        string
 	RebasingStatus                      string
 	MergingStatus                       string
 	LowercaseRebasingStatus             string
 	LowercaseMergingStatus              string
+	LowercaseCherryPickingStatus        string
+	LowercaseRevertingStatus            string
+	SequencerDone                       string
+	SequencerCurrent                    string
+	SequencerRemaining                  string
+	OperationKeysHint                   string
+	MergeKeysHint                       string
+	OperationBranch                     string
+	OperationOnto                       string
+	OperationProgress                   string
+	OperationStoppedAt                  string
+	OperationStartedBy                  string
+	OperationSteps                      string
+	OperationMergeHeads                 string
+	OperationMergeMessage               string
+	ContinueOperation                   string
+	SkipOperationStep                   string
+	AbortOperation                      string
+	CantSkipMerge                       string
+	NoOperationInProgress               string
 	AmendingStatus                      string
 	CherryPickingStatus                 string
 	UndoingStatus                       string
@@ -319,6 +494,9 @@ type TranslationSet struct {
 	Cancel                              string
 	DiscardAllChanges                   string
 	DiscardUnstagedChanges              string
+	DiscardHunks                        string
+	DiscardHunksTooltip                 string
+	DiscardHunksMenuTitle               string
 	DiscardAllChangesToAllFiles         string
 	DiscardAnyUnstagedChanges           string
 	DiscardUntrackedFiles               string
@@ -343,6 +521,15 @@ type TranslationSet struct {
 	StashAllChangesKeepIndex            string
 	StashUnstagedChanges                string
 	StashIncludeUntrackedChanges        string
+	StashStagedChangesTooltip           string
+	StashAllChangesKeepIndexTooltip     string
+	StashUnstagedChangesTooltip         string
+	StashIncludeUntrackedTooltip        string
+	StashSelectedFiles                  string
+	StashSelectedFilesTooltip           string
+	StashIncludingIgnored               string
+	StashIncludingIgnoredTooltip        string
+	StashIncludingIgnoredWarning        string
 	StashOptions                        string
 	NotARepository                      string
 	WorkingDirectoryDoesNotExist        string
@@ -372,14 +559,68 @@ type TranslationSet struct {
 	EditRemoteUrl                       string
 	RemoveRemote                        string
 	RemoveRemotePrompt                  string
+	AddForkRemote                       string
+	AddForkRemoteTooltip                string
+	ForkOwner                           string
+	ToggleDefaultPushRemote             string
+	ToggleDefaultPushRemoteTooltip      string
+	DefaultPushRemote                   string
+	RemoteUrls                          string
+	TrackedByBranches                   string
 	DeleteRemoteBranch                  string
 	DeleteRemoteBranchMessage           string
+	CleanUpGoneBranches                 string
+	CleanUpGoneBranchesTooltip          string
+	CompareBranch                       string
+	CompareBranchTooltip                string
+	CompareBranchWith                   string
+	CantCompareBranchWithItself         string
+	ComparingBranchesStatus             string
+	BranchComparisonTitle               string
+	BranchComparisonSummary             string
+	BranchComparisonActions             string
+	CommitsOnlyOn                       string
+	FilesChangedOnBothSides             string
+	FilesChangedOnBothSidesTooltip      string
+	MergeConflicts                      string
+	MergeConflictsTooltip               string
+	MergeConflictsUnknown               string
+	MergeConflictsUnknownTooltip        string
+	ViewComparedCommits                 string
+	ViewComparedCommitsTooltip          string
+	MergeRefIntoCheckedOutBranch        string
+	RebaseCheckedOutBranchOntoRef       string
+	CheckOutComparedBranchFirst         string
+	PruningRemotesStatus                string
+	NoGoneBranches                      string
+	DeleteSelectedBranches              string
+	NoBranchesSelected                  string
+	DeleteGoneBranchesPrompt            string
+	CheckoutBeforeDeletingBranch        string
+	NoBranchToCheckoutInstead           string
 	SetAsUpstream                       string
 	SetUpstream                         string
 	UnsetUpstream                       string
 	ViewDivergenceFromUpstream          string
 	DivergenceSectionHeaderLocal        string
+	ReflogDateOptions                   string
+	ReflogDateOptionsTooltip            string
+	GroupReflogByDay                    string
+	UngroupReflogByDay                  string
+	FilterReflogByDate                  string
+	FilterReflogByDateTooltip           string
+	FilterReflogByDatePrompt            string
+	ClearReflogDateFilter               string
+	NotFilteringReflogByDate            string
+	Today                               string
+	Yesterday                           string
+	ReflogDayFormat                     string
+	ListSectionSeparator                string
 	DivergenceSectionHeaderRemote       string
+	DivergenceMarkerIncoming            string
+	DivergenceMarkerToPush              string
+	DivergenceMarkerPushed              string
+	RebaseProgressTitle                 string
 	ViewUpstreamResetOptions            string
 	ViewUpstreamResetOptionsTooltip     string
 	ViewUpstreamRebaseOptions           string
@@ -389,9 +630,32 @@ type TranslationSet struct {
 	SetUpstreamMessage                  string
 	EditRemote                          string
 	TagCommit                           string
+	MoveTagToCommit                     string
+	MoveTagToCommitTooltip              string
+	MoveTagPrompt                       string
+	MoveTagTitle                        string
+	MoveTagLocally                      string
+	MoveTagLocallyTooltip               string
+	MoveTagAndForcePush                 string
+	MovingTag                           string
+	TagDoesNotExist                     string
+	RewritePublishedTag                 string
+	RewritePublishedTagWarning          string
 	TagMenuTitle                        string
 	TagNameTitle                        string
 	TagMessageTitle                     string
+	TagTagger                           string
+	TagDate                             string
+	TagSignature                        string
+	TagUnsigned                         string
+	TagSignatureGood                    string
+	TagSignatureBad                     string
+	TagSignatureUnverified              string
+	TagSignatureTitle                   string
+	TagNotSigned                        string
+	VerifyTagSignature                  string
+	VerifyTagSignatureTooltip           string
+	VerifyingTagSignature               string
 	LightweightTag                      string
 	AnnotatedTag                        string
 	DeleteTagTitle                      string
@@ -408,6 +672,12 @@ type TranslationSet struct {
 	ForceTagPrompt                      string
 	FetchRemote                         string
 	FetchingRemoteStatus                string
+	SyncNotes                           string
+	SyncNotesTooltip                    string
+	PushNotes                           string
+	FetchNotes                          string
+	PushingNotesStatus                  string
+	FetchingNotesStatus                 string
 	CheckoutCommit                      string
 	SureCheckoutThisCommit              string
 	GitFlowOptions                      string
@@ -434,7 +704,15 @@ type TranslationSet struct {
 	ViewBranchUpstreamOptionsTooltip    string
 	UpstreamNotSetError                 string
 	NewGitFlowBranchPrompt              string
-	RenameBranchWarning                 string
+	RenameLocalBranchOnly               string
+	RenameLocalAndRemoteBranch          string
+	RenameLocalAndRemoteTooltip         string
+	RenameBranchOnRemotePrompt          string
+	RenameBranchLocalRefChange          string
+	RenameBranchRemoteRefChange         string
+	RenameBranchUpstreamChange          string
+	RemoteBranchAlreadyExists           string
+	LocalBranchAlreadyExists            string
 	OpenMenu                            string
 	ResetCherryPick                     string
 	NextTab                             string
@@ -448,6 +726,7 @@ type TranslationSet struct {
 	NextPage                            string
 	GotoTop                             string
 	GotoBottom                          string
+	ToggleRangeSelect                   string
 	FilteringBy                         string
 	ResetInParentheses                  string
 	OpenFilteringMenu                   string
@@ -464,12 +743,16 @@ type TranslationSet struct {
 	ExitDiffMode                        string
 	DiffingMenuTitle                    string
 	SwapDiff                            string
+	DiffAgainstRefAsOfTime              string
+	EnterRelativeTime                   string
 	OpenDiffingMenu                     string
 	OpenExtrasMenu                      string
 	ShowingGitDiff                      string
 	CommitDiff                          string
 	CopyCommitShaToClipboard            string
 	CommitSha                           string
+	AbbreviatedCommitSha                string
+	CommitReference                     string
 	CommitURL                           string
 	CopyCommitMessageToClipboard        string
 	CommitMessage                       string
@@ -506,6 +789,11 @@ type TranslationSet struct {
 	AddSubmodule                        string
 	AddingSubmoduleStatus               string
 	UpdateSubmoduleUrl                  string
+	OpenNestedRepo                      string
+	OpenNestedRepoTooltip               string
+	ConvertNestedRepoToSubmodule        string
+	ConvertNestedRepoToSubmoduleTooltip string
+	NestedRepoOptions                   string
 	UpdatingSubmoduleUrlStatus          string
 	EditSubmoduleUrl                    string
 	InitializingSubmoduleStatus         string
@@ -515,6 +803,20 @@ type TranslationSet struct {
 	BulkInitSubmodules                  string
 	BulkUpdateSubmodules                string
 	BulkDeinitSubmodules                string
+	BulkUpdateSubmodulesFromRemote      string
+	ViewSubmoduleUpdateOptions          string
+	ViewSubmoduleUpdateOptionsTooltip   string
+	SubmoduleUpdateOptions              string
+	SetSubmoduleBranch                  string
+	SetSubmoduleBranchPrompt            string
+	SubmoduleRemoteDefaultBranch        string
+	SetSubmoduleUpdateStrategy          string
+	SubmoduleUpdateCheckoutTooltip      string
+	SubmoduleUpdateRebaseTooltip        string
+	SubmoduleUpdateMergeTooltip         string
+	UpdateSubmoduleFromRemote           string
+	RunCommandInSubmodules              string
+	RunCommandInSubmodulesPrompt        string
 	ViewBulkSubmoduleOptions            string
 	BulkSubmoduleOptions                string
 	RunningCommand                      string
@@ -529,6 +831,7 @@ type TranslationSet struct {
 	PullRequestURLCopiedToClipboard     string
 	CommitDiffCopiedToClipboard         string
 	CommitSHACopiedToClipboard          string
+	CommitReferenceCopiedToClipboard    string
 	CommitURLCopiedToClipboard          string
 	CommitMessageCopiedToClipboard      string
 	CommitSubjectCopiedToClipboard      string
@@ -542,10 +845,30 @@ type TranslationSet struct {
 	CommandLog                          string
 	ToggleShowCommandLog                string
 	FocusCommandLog                     string
+	CopyCommandToClipboard              string
+	CommandCopiedToClipboard            string
+	RerunCommand                        string
+	RerunCommandTooltip                 string
+	RerunCommandPrompt                  string
+	CannotRerunCommand                  string
+	FilterCommandLogBySubsystem         string
+	AllSubsystems                       string
+	SlowCommandLog                      string
+	KeybindingConflictsTitle            string
+	KeybindingConflictTooltip           string
+	KeybindingConflictConfigPaths       string
+	KeybindingConflictsNotice           string
+	ShowKeybindingConflicts             string
+	NoKeybindingConflicts               string
+	FailedCommandLog                    string
 	CommandLogHeader                    string
 	RandomTip                           string
 	SelectParentCommitForMerge          string
 	ToggleWhitespaceInDiffView          string
+	ToggleSplitDiffView                 string
+	ToggleSplitDiffViewTooltip          string
+	SplitDiffViewWithPager              string
+	ToggleDateDisplay                   string
 	IgnoreWhitespaceDiffViewSubTitle    string
 	IgnoreWhitespaceNotSupportedHere    string
 	IncreaseContextInDiffView           string
@@ -558,6 +881,7 @@ type TranslationSet struct {
 	SelectConfigFile                    string
 	NoConfigFileFoundErr                string
 	LoadingFileSuggestions              string
+	LoadingSuggestions                  string
 	LoadingCommits                      string
 	MustSpecifyOriginError              string
 	GitOutput                           string
@@ -565,7 +889,13 @@ type TranslationSet struct {
 	AbortTitle                          string
 	AbortPrompt                         string
 	OpenLogMenu                         string
+	PeekCommit                          string
+	PeekCommitTooltip                   string
+	CommitPeekTitle                     string
 	LogMenuTitle                        string
+	ViewCommitsOfRange                  string
+	ViewCommitsOfRangeTooltip           string
+	EnterRevisionRange                  string
 	ToggleShowGitGraphAll               string
 	ShowGitGraph                        string
 	SortOrder                           string
@@ -576,8 +906,81 @@ type TranslationSet struct {
 	SortCommits                         string
 	CantChangeContextSizeError          string
 	OpenCommitInBrowser                 string
+	OpenLink                            string
+	OpenLinkTooltip                     string
+	NoLinksFound                        string
+	RepoHealth                          string
+	RepoHealthTooltip                   string
+	RepoHealthTitle                     string
+	CheckingRepoHealthStatus            string
+	RepoHealthDiagnostics               string
+	RepoHealthActions                   string
+	RepoHealthSize                      string
+	RepoHealthSizeTooltip               string
+	RepoHealthLooseObjects              string
+	RepoHealthLooseObjectsTooltip       string
+	RepoHealthLargeFiles                string
+	RepoHealthLargeFilesTooltip         string
+	RepoHealthLargeFilesDisabledTooltip string
+	RepoHealthCheckDisabled             string
+	RepoHealthStaleBranches             string
+	RepoHealthStaleBranchesTooltip      string
+	RepoHealthBrokenRefs                string
+	RepoHealthBrokenRefsTooltip         string
+	RepoHealthGarbageCollect            string
+	RepoHealthGarbageCollectTooltip     string
+	RunningGarbageCollectionStatus      string
+	GarbageCollectionDone               string
+	RepoHealthPrune                     string
+	RepoHealthPruneTooltip              string
+	RepoHealthPrunePrompt               string
+	PruningObjectsStatus                string
+	PruneObjectsDone                    string
+	RepoHealthMigrateToLfs              string
+	RepoHealthMigrateToLfsTooltip       string
+	RepoHealthMigrateToLfsHint          string
+	NoLargeFilesInHistory               string
+	FixBrokenRefsFirst                  string
+	AuthorStats                         string
+	AuthorStatsTooltip                  string
+	AuthorStatsTitle                    string
+	LoadingAuthorStats                  string
+	AuthorStatsHeader                   string
+	AuthorStatsHeaderWithPath           string
+	NoCommitsInTimeRange                string
+	LastWeek                            string
+	LastMonth                           string
+	LastYear                            string
+	AllTime                             string
+	ViewFileOwnership                   string
+	ViewFileOwnershipTooltip            string
+	FileOwnershipTitle                  string
+	FileOwnershipHeader                 string
+	LoadingFileOwnership                string
+	FileOwnershipAuthors                string
+	FileOwnershipFiles                  string
+	NoCommitsForFiles                   string
+	NoCommitsYet                        string
+	LastTouchedBy                       string
+	TypeToConfirmTitle                  string
+	DeleteLocalBranchPrompt             string
+	DeleteLocalTagPrompt                string
+	TypedTextDoesNotMatch               string
+	ShowContainingRefs                  string
+	ShowContainingRefsTooltip           string
+	ContainingRefsTitle                 string
+	NoRefsContainCommit                 string
 	ViewBisectOptions                   string
 	ConfirmRevertCommit                 string
+	RevertCommitsTitle                  string
+	RevertCommitsSeparately             string
+	RevertCommitsAsOne                  string
+	CantRevertTodoCommits               string
+	CantRevertRangeWithMergeCommits     string
+	RevertIntoWorkingTree               string
+	RevertIntoWorkingTreeTooltip        string
+	CantRevertMergeIntoWorkingTree      string
+	CommitHasNoChangesToRevert          string
 	RewordInEditorTitle                 string
 	RewordInEditorPrompt                string
 	CheckoutPrompt                      string
@@ -603,6 +1006,52 @@ type TranslationSet struct {
 	SearchKeybindings                   string
 	SearchPrefix                        string
 	FilterPrefix                        string
+	OpenFuzzyFinder                     string
+	OpenFuzzyFinderTooltip              string
+	TogglePresenterMode                 string
+	TogglePresenterModeTooltip          string
+	PresenterModeEnabled                string
+	PresenterModeDisabled               string
+	NextConflictedFile                  string
+	PrevConflictedFile                  string
+	ConflictedFileTooltip               string
+	NoConflictedFiles                   string
+	ToggleDryRun                        string
+	ToggleDryRunTooltip                 string
+	DryRunEnabled                       string
+	DryRunDisabled                      string
+	DryRunModeStatus                    string
+	DryRunTitle                         string
+	DryRunCommands                      string
+	DryRunAffected                      string
+	DryRunNothingAffected               string
+	DryRunRefMoves                      string
+	DryRunCommitsLeftBehind             string
+	DryRunRemoteRefSet                  string
+	DryRunCommitsOverwritten            string
+	DryRunRefDeleted                    string
+	DryRunRemoteBranchDeleted           string
+	DryRunChangesDiscarded              string
+	DryRunFileRemoved                   string
+	DryRunSubmoduleReset                string
+	KeystrokesTitle                     string
+	FuzzyFinderTitle                    string
+	FuzzyFinderBranch                   string
+	FuzzyFinderTag                      string
+	FuzzyFinderRemoteBranch             string
+	FuzzyFinderFile                     string
+	FuzzyFinderCommit                   string
+	FilterCommitsByPath                 string
+	FilterCommitsByPathTooltip          string
+	FileHistoryTitle                    string
+	FileHistoryDynamicTitle             string
+	ViewFileHistory                     string
+	ViewFileHistoryTooltip              string
+	CheckoutFileVersion                 string
+	CheckoutFileVersionTooltip          string
+	CheckoutFileVersionPrompt           string
+	CopyFileVersionToClipboard          string
+	FileVersionCopiedToClipboard        string
 	ExitSearchMode                      string
 	ExitTextFilterMode                  string
 	SwitchToWorktree                    string
@@ -626,6 +1075,27 @@ type TranslationSet struct {
 	NoWorktreesThisRepo                 string
 	MissingWorktree                     string
 	MainWorktree                        string
+	LockedWorktree                      string
+	WorktreeLocked                      string
+	WorktreeIsLocked                    string
+	WorktreeNotLocked                   string
+	WorktreeChanges                     string
+	WorktreeDirty                       string
+	WorktreeClean                       string
+	WorktreeDiskUsage                   string
+	CalculatingWorktreeStats            string
+	PruneWorktrees                      string
+	PruneWorktreesTooltip               string
+	PruneWorktreesPrompt                string
+	PruningWorktrees                    string
+	NoWorktreesToPrune                  string
+	RepairWorktree                      string
+	RepairWorktreeTooltip               string
+	RepairWorktreeNewPath               string
+	RepairingWorktree                   string
+	OpenWorktreeInTerminal              string
+	OpenWorktreeInTerminalTooltip       string
+	OpenInTerminalNotConfigured         string
 	CreateWorktree                      string
 	NewWorktreePath                     string
 	NewWorktreeBase                     string
@@ -644,6 +1114,7 @@ type TranslationSet struct {
 	MarkAsBaseCommit                    string
 	MarkAsBaseCommitTooltip             string
 	MarkedCommitMarker                  string
+	AppliedUpstreamMarker               string
 	PleaseGoToURL                       string
 	DisabledMenuItemPrefix              string
 	NoCommitSelected                    string
@@ -654,6 +1125,7 @@ type TranslationSet struct {
 	Actions                             Actions
 	Bisect                              Bisect
 	Log                                 Log
+	ErrStageRangeWithMergeConflicts     string
 }
 
 type Bisect struct {
@@ -670,10 +1142,23 @@ type Bisect struct {
 	Mark                        string
 	SkipCurrent                 string
 	SkipSelected                string
+	SkipSelectedRange           string
+	ExportLog                   string
+	ExportLogTooltip            string
+	ExportLogPrompt             string
+	ExportedLogToast            string
+	ReplayLog                   string
+	ReplayLogTooltip            string
+	ReplayLogPrompt             string
 	CompleteTitle               string
 	CompletePrompt              string
 	CompletePromptIndeterminate string
 	Bisecting                   string
+	BisectingWithTerms          string
+	StartedFrom                 string
+	Terms                       string
+	Current                     string
+	ResetHint                   string
 }
 
 type Log struct {
@@ -703,10 +1188,13 @@ type Actions struct {
 	Merge                             string
 	RebaseBranch                      string
 	RenameBranch                      string
+	RenameBranchOnRemote              string
 	CreateBranch                      string
 	FastForwardBranch                 string
 	CherryPick                        string
 	CheckoutFile                      string
+	CheckoutFileVersion               string
+	RecreateConflictMarkers           string
 	DiscardOldFileChange              string
 	SquashCommitDown                  string
 	FixupCommit                       string
@@ -718,6 +1206,8 @@ type Actions struct {
 	SetCommitAuthor                   string
 	AddCommitCoAuthor                 string
 	RevertCommit                      string
+	RevertCommits                     string
+	RevertCommitIntoWorkingTree       string
 	CreateFixupCommit                 string
 	SquashAllAboveFixupCommits        string
 	MoveCommitUp                      string
@@ -726,18 +1216,26 @@ type Actions struct {
 	CopyCommitSubjectToClipboard      string
 	CopyCommitDiffToClipboard         string
 	CopyCommitSHAToClipboard          string
+	CopyCommitReferenceToClipboard    string
 	CopyCommitURLToClipboard          string
 	CopyCommitAuthorToClipboard       string
 	CopyCommitAttributeToClipboard    string
 	CopyPatchToClipboard              string
 	CustomCommand                     string
+	RerunCommand                      string
 	DiscardAllChangesInDirectory      string
 	DiscardUnstagedChangesInDirectory string
 	DiscardAllChangesInFile           string
 	DiscardAllUnstagedChangesInFile   string
+	DiscardAllChangesInSelection      string
+	DiscardUnstagedChangesInSelection string
+	StashSelectedFiles                string
+	DiscardHunk                       string
 	StageFile                         string
 	StageResolvedFiles                string
 	UnstageFile                       string
+	IntentToAdd                       string
+	RevertIntentToAdd                 string
 	UnstageAllFiles                   string
 	StageAllFiles                     string
 	IgnoreExcludeFile                 string
@@ -745,6 +1243,7 @@ type Actions struct {
 	ExcludeFile                       string
 	ExcludeFileErr                    string
 	ExcludeGitIgnoreErr               string
+	RemoveIgnoreRule                  string
 	Commit                            string
 	EditFile                          string
 	Push                              string
@@ -755,6 +1254,7 @@ type Actions struct {
 	StashStagedChanges                string
 	StashUnstagedChanges              string
 	StashIncludeUntrackedChanges      string
+	StashIncludingIgnored             string
 	GitFlowFinish                     string
 	GitFlowStart                      string
 	CopyToClipboard                   string
@@ -764,24 +1264,51 @@ type Actions struct {
 	MovePatchIntoIndex                string
 	MovePatchIntoNewCommit            string
 	DeleteRemoteBranch                string
+	PruneRemotes                      string
+	CleanUpGoneBranches               string
 	SetBranchUpstream                 string
 	AddRemote                         string
 	RemoveRemote                      string
 	UpdateRemote                      string
+	AddForkRemote                     string
+	SetDefaultPushRemote              string
+	UnsetDefaultPushRemote            string
 	ApplyPatch                        string
+	ParkChanges                       string
+	RestoreParkedChanges              string
+	DropParkedChanges                 string
+	RestoreTrashEntry                 string
+	DropTrashEntry                    string
+	SetAssumeUnchanged                string
+	ClearAssumeUnchanged              string
+	SetSkipWorktree                   string
+	ClearSkipWorktree                 string
+	SetExecutable                     string
+	ClearExecutable                   string
 	Stash                             string
 	RenameStash                       string
+	BranchFromStash                   string
 	RemoveSubmodule                   string
 	ResetSubmodule                    string
 	AddSubmodule                      string
+	ConvertNestedRepoToSubmodule      string
 	UpdateSubmoduleUrl                string
 	InitialiseSubmodule               string
 	BulkInitialiseSubmodules          string
 	BulkUpdateSubmodules              string
 	BulkDeinitialiseSubmodules        string
+	BulkUpdateSubmodulesFromRemote    string
+	SetSubmoduleBranch                string
+	SetSubmoduleUpdateStrategy        string
+	UpdateSubmoduleFromRemote         string
+	RunCommandInSubmodules            string
 	UpdateSubmodule                   string
 	CreateLightweightTag              string
 	CreateAnnotatedTag                string
+	MoveTag                           string
+	ForcePushTag                      string
+	PushNotes                         string
+	FetchNotes                        string
 	DeleteLocalTag                    string
 	DeleteRemoteTag                   string
 	PushTag                           string
@@ -798,13 +1325,21 @@ type Actions struct {
 	OpenDiffTool                      string
 	OpenMergeTool                     string
 	OpenCommitInBrowser               string
+	OpenLink                          string
+	GarbageCollect                    string
+	PruneObjects                      string
 	OpenPullRequest                   string
 	StartBisect                       string
 	ResetBisect                       string
+	ExportBisectLog                   string
+	ReplayBisectLog                   string
 	BisectSkip                        string
 	BisectMark                        string
 	RemoveWorktree                    string
 	AddWorktree                       string
+	PruneWorktrees                    string
+	RepairWorktree                    string
+	OpenWorktreeInTerminal            string
 }
 
 const englishIntroPopupMessage = `
@@ -885,6 +1420,28 @@ func EnglishTranslationSet() TranslationSet {
 		ToggleStaged:                        "Toggle staged",
 		ToggleStagedAll:                     "Stage/unstage all",
 		ToggleTreeView:                      "Toggle file tree view",
+		ToggleGroupByChangeType:             "Toggle grouping by change type",
+		ToggleGroupByChangeTypeTooltip:      "Split the files into sections for merge conflicts, staged, unstaged and untracked changes, or show them all together again. A file with both staged and unstaged changes is listed under 'Unstaged'. Pressing space on a section header stages or unstages all of its files.",
+		CollapseAll:                         "Collapse all directories",
+		CollapseAllTooltip:                  "Collapse every directory in the file tree, leaving just the top level entries.",
+		ExpandAll:                           "Expand all directories",
+		ExpandAllTooltip:                    "Expand every directory in the file tree.",
+		CollapseToLevel:                     "Collapse to level",
+		CollapseToLevelTooltip:              "Show the file tree down to a given depth, collapsing the directories below it and expanding the ones above it.",
+		CollapseToLevelItem:                 "Show {{.levels}} level(s)",
+		NoDirectoriesToCollapse:             "There are no directories to collapse",
+		ConflictsSection:                    "Conflicts",
+		StagedSection:                       "Staged",
+		UnstagedSection:                     "Unstaged",
+		UntrackedSection:                    "Untracked",
+		NotAvailableOnSectionHeader:         "Not available on a section header. Select a file or directory instead.",
+		CycleFileSortOrder:                  "Cycle sort order",
+		CycleFileSortOrderTooltip:           "Cycle between sorting the files by name, by status (merge conflicts first, untracked files last), by modification time (newest first), and by number of changed lines (most first). Directories are sorted by the files they contain. The sort order is remembered per repo.",
+		FilesSortedBy:                       "Sorting files by {{.sortOrder}}",
+		FileSortByName:                      "name",
+		FileSortByStatus:                    "status",
+		FileSortByModTime:                   "modification time",
+		FileSortByChangedLines:              "number of changed lines",
 		OpenDiffTool:                        "Open external diff tool (git difftool)",
 		OpenMergeTool:                       "Open external merge tool (git mergetool)",
 		Refresh:                             "Refresh",
@@ -905,6 +1462,12 @@ func EnglishTranslationSet() TranslationSet {
 		NoContentToCopyError:                "Nothing to copy",
 		FileNameCopiedToast:                 "File name copied to clipboard",
 		FilePathCopiedToast:                 "File path copied to clipboard",
+		CopyAbsolutePath:                    "Absolute path",
+		AbsolutePathCopiedToast:             "Absolute path copied to clipboard",
+		CopyDirectory:                       "Directory",
+		DirectoryCopiedToast:                "Directory copied to clipboard",
+		PathIsAtTopLevel:                    "This is at the top level of the repository",
+		CopyCommitFileDiffTooltip:           "The diff as shown in the main view, i.e. against the commit's parent, or against the ref being compared with in diff mode.",
 		FileDiffCopiedToast:                 "File diff copied to clipboard",
 		AllFilesDiffCopiedToast:             "All files diff copied to clipboard",
 		FilterStagedFiles:                   "Show only staged files",
@@ -940,6 +1503,10 @@ func EnglishTranslationSet() TranslationSet {
 		FixupCommit:                         "Fixup commit",
 		NoCommitsThisBranch:                 "No commits for this branch",
 		UpdateRefHere:                       "Update branch '{{.ref}}' here",
+		RebaseTodoLabelHere:                 "Label the commit rebased up to here as '{{.label}}', so that it can be referred to by later reset and merge entries",
+		RebaseTodoResetTo:                   "Continue rebasing on top of the commit labelled '{{.label}}'",
+		RebaseTodoMerge:                     "Create a merge of the commit labelled '{{.label}}'",
+		CannotMoveMergeStructureTodo:        "Label, reset and merge entries can't be moved",
 		CannotSquashOrFixupFirstCommit:      "There's no commit below to squash into",
 		Fixup:                               "Fixup",
 		SureFixupThisCommit:                 "Are you sure you want to 'fixup' this commit? It will be merged into the commit below",
@@ -965,6 +1532,9 @@ func EnglishTranslationSet() TranslationSet {
 		Error:                               "Error",
 		PickHunk:                            "Pick hunk",
 		PickAllHunks:                        "Pick all hunks",
+		ToggleConflictStyle:                 "Cycle conflict marker style",
+		ToggleConflictStyleTooltip:          "Rewrite the conflict markers of this file in the next style out of merge, diff3 and zdiff3, and keep using that style for subsequent merges and rebases until you quit lazygit. diff3 and zdiff3 also show the base version of each conflict, which you can pick like any other hunk. Conflicts you've already resolved in this file will reappear, but you can undo this.",
+		ToggleConflictStyleResolvedPrompt:   "Rewriting the conflict markers brings back the conflicts you've already resolved in this file. Are you sure?",
 		Undo:                                "Undo",
 		UndoReflog:                          "Undo",
 		RedoReflog:                          "Redo",
@@ -972,26 +1542,41 @@ func EnglishTranslationSet() TranslationSet {
 		RedoTooltip:                         "The reflog will be used to determine what git command to run to redo the last git command. This does not include changes to the working tree; only commits are taken into consideration.",
 		DiscardAllTooltip:                   "Discard both staged and unstaged changes in '{{.path}}'.",
 		DiscardUnstagedTooltip:              "Discard unstaged changes in '{{.path}}'.",
+		DiscardRangeAllTooltip:              "Discard both staged and unstaged changes in the selected files.",
+		DiscardRangeUnstagedTooltip:         "Discard unstaged changes in the selected files.",
+		SelectedFilesCount:                  "{{.count}} selected files",
 		Pop:                                 "Pop",
 		Drop:                                "Drop",
 		Apply:                               "Apply",
 		NoStashEntries:                      "No stash entries",
 		StashDrop:                           "Stash drop",
 		SureDropStashEntry:                  "Are you sure you want to drop this stash entry?",
+		SureDropStashEntries:                "Are you sure you want to drop these {{.count}} stash entries? This can't be undone.\n\n{{.entries}}",
 		StashPop:                            "Stash pop",
 		SurePopStashEntry:                   "Are you sure you want to pop this stash entry?",
 		StashApply:                          "Stash apply",
 		SureApplyStashEntry:                 "Are you sure you want to apply this stash entry?",
+		SureApplyStashEntryWithIndex:        "Are you sure you want to apply this stash entry, restoring its staged changes to the index?",
+		ApplyWithIndex:                      "Apply with index",
+		ApplyWithIndexTooltip:               "Apply the stash entry and put the changes that were staged when it was made back into the index, rather than leaving all of them unstaged.",
 		NoTrackedStagedFilesStash:           "You have no tracked/staged files to stash",
 		NoFilesToStash:                      "You have no files to stash",
 		StashChanges:                        "Stash changes",
 		RenameStash:                         "Rename stash",
 		RenameStashPrompt:                   "Rename stash: {{.stashName}}",
+		BranchFromStash:                     "Create branch from stash",
+		BranchFromStashTooltip:              "Create a new branch at the commit the stash entry was made on, check it out and apply the stash entry there. The stash entry is dropped if it applies cleanly.",
+		BranchFromStashPrompt:               "New branch name (branch is off of the base of '{{.stashName}}')",
 		OpenConfig:                          "Open config file",
 		EditConfig:                          "Edit config file",
 		ForcePush:                           "Force push",
 		ForcePushPrompt:                     "Your branch has diverged from the remote branch. Press {{.cancelKey}} to cancel, or {{.confirmKey}} to force push.",
 		ForcePushDisabled:                   "Your branch has diverged from the remote branch and you've disabled force pushing",
+		SharedBranchIntro:                   "'{{.branch}}' looks like it's shared with others:",
+		SharedBranchMatchesPattern:          "it matches the protected branch pattern '{{.pattern}}'",
+		SharedBranchHasOtherAuthors:         "{{.authors}} pushed commits to it",
+		SharedBranchOnOtherRemotes:          "it exists on {{.remotes}} as well",
+		ForcePushDivergence:                 "Your branch is {{.ahead}} commits ahead of and {{.behind}} commits behind '{{.upstream}}'. Force pushing removes these commits from it:",
 		UpdatesRejectedAndForcePushDisabled: "Updates were rejected and you have disabled force pushing",
 		CheckForUpdate:                      "Check for update",
 		CheckingForUpdates:                  "Checking for updates...",
@@ -1017,12 +1602,33 @@ func EnglishTranslationSet() TranslationSet {
 		OpenInEditor:                        "Open in editor",
 		IgnoreFile:                          `Add to .gitignore`,
 		ExcludeFile:                         `Add to .git/info/exclude`,
+		IgnorePattern:                       "Add '{{.pattern}}' to .gitignore",
+		ExcludePattern:                      "Add '{{.pattern}}' to .git/info/exclude",
+		ShowIgnoreRules:                     "Show ignore rules matching this path",
+		IgnoreRulesTitle:                    "Ignore rules matching '{{.path}}'",
+		NoIgnoreRules:                       "No ignore rules match '{{.path}}'",
+		EditIgnoreRule:                      "Edit rule",
+		RemoveIgnoreRule:                    "Remove rule",
+		IgnoreRuleChanged:                   "The line of the rule has changed in the meantime, so it wasn't removed",
+		CantRemoveGlobalIgnoreRule:          "This rule is in an ignore file outside of the repo, which other repos may depend on. Edit the file if you want to change it",
 		RefreshFiles:                        `Refresh files`,
 		MergeIntoCurrentBranch:              `Merge into currently checked out branch`,
 		ConfirmQuit:                         `Are you sure you want to quit?`,
 		SwitchRepo:                          `Switch to a recent repo`,
 		AllBranchesLogGraph:                 `Show all branch logs`,
+		UIStateMenu:                         `Export/import UI state`,
+		UIStateMenuTooltip:                  "Export the UI state of this repo (selected panel and items, filters, diff mode, collapsed directories and layout tweaks) to a file, or import it from one, e.g. to bring your setup to another machine.",
+		ExportUIState:                       `Export UI state to file`,
+		ImportUIState:                       `Import UI state from file`,
+		UIStateFilePrompt:                   `Path to UI state file:`,
+		UIStateExported:                     `Exported UI state to {{.path}}`,
+		UIStateImported:                     `Imported UI state from {{.path}}`,
+		ChangeLanguage:                      `Change language`,
+		ChangeLanguageTooltip:               "Switch the language of the UI without restarting. Besides the built-in languages, this lists the translation files in the 'translations' directory of your config dir. To keep using a language after restarting, set 'gui.language' in your config.",
+		CurrentLanguage:                     `current`,
+		UserProvidedLanguage:                `from translations directory`,
 		UnsupportedGitService:               `Unsupported git service`,
+		IssuesNotSupported:                  `Issue links are not supported for this git service`,
 		CreatePullRequest:                   `Create pull request`,
 		CopyPullRequestURL:                  `Copy pull request URL to clipboard`,
 		NoBranchOnRemote:                    `This branch doesn't exist on remote. You need to push it to remote first.`,
@@ -1037,21 +1643,91 @@ func EnglishTranslationSet() TranslationSet {
 		ToggleSelectHunk:                    `Toggle select hunk`,
 		ToggleSelectionForPatch:             `Add/Remove line(s) to patch`,
 		EditHunk:                            `Edit hunk`,
+		SplitHunk:                           `Split hunk`,
+		SplitHunkTooltip:                    "Split the current hunk in two at the selected line, so that each part can be selected on its own in hunk select mode. Splitting at the start of a part joins it with the part before it again.",
+		CannotSplitHunkHere:                 "A hunk can only be split between two changes",
+		ParkSelection:                       "Park selected lines",
+		ParkSelectionTooltip:                "Move the selected lines (or hunk) out of the working tree into a named holding area, so that you can deal with other changes in the file first. Bring them back from the parked changes menu in the files panel.",
+		ParkSelectionPrompt:                 "Name for the parked changes",
+		ApplySelection:                      "Apply selected lines",
+		ApplySelectionTooltip:               "Apply the selected lines (or hunk) of this commit's diff straight to your working tree and index, or to the index only, without building a custom patch first. The reverse options undo those lines instead.",
+		ApplySelectionToWorktree:            "Apply to working tree and index",
+		ApplySelectionToIndex:               "Apply to index only",
+		ReverseSelectionInWorktree:          "Apply in reverse to working tree and index",
+		ReverseSelectionInIndex:             "Apply in reverse to index only",
+		CantParkStagedChanges:               "Only unstaged changes can be parked",
+		ViewParkedChanges:                   "View parked changes",
+		ViewParkedChangesTooltip:            "Restore or drop changes that were parked from the staging view.",
+		NoParkedChanges:                     "There are no parked changes",
+		RestoreParkedChanges:                "Restore",
+		DropParkedChanges:                   "Drop",
+		DropParkedChangesPrompt:             "Are you sure you want to drop the parked changes '{{.name}}'? They can't be brought back afterwards.",
+		ViewTrash:                           "View trash",
+		ViewTrashTooltip:                    "Restore or drop the files kept from discarding changes. Files are only kept if gui.files.trashDiscardedChanges is enabled, and only as they were in the worktree, not in the index.",
+		NoTrashEntries:                      "The trash is empty",
+		RestoreTrashEntry:                   "Restore",
+		RestoreTrashEntryTooltip:            "Put the files back the way they were before the discard. Changes made to them since are overwritten.",
+		DropTrashEntry:                      "Drop",
+		DropTrashEntryPrompt:                "Are you sure you want to drop the discarded files from the trash? They can't be brought back afterwards.",
+		ToggleAssumeUnchanged:               "Toggle assume-unchanged",
+		ToggleAssumeUnchangedTooltip:        "Set or clear the assume-unchanged bit of the file, which tells git not to check it for changes. Useful for speeding up git on files that never change. Files with the bit set are marked as such.",
+		ToggleSkipWorktree:                  "Toggle skip-worktree",
+		ToggleSkipWorktreeTooltip:           "Set or clear the skip-worktree bit of the file, which makes git ignore your local changes to it, e.g. for a config file you've customised. Files with the bit set are marked as such.",
+		ToggleExecutable:                    "Toggle executable",
+		ToggleExecutableTooltip:             "Set or clear the executable bit of the file in the index (git update-index --chmod) and in the worktree. Files whose mode changed are marked as such.",
+		CantFlagDirectory:                   "This can only be done on individual files",
+		CantFlagUntrackedFile:               "This can only be done on tracked files",
+		StageByPattern:                      "Stage/unstage files matching a pattern",
+		StageByPatternTooltip:               "Stage or unstage all files whose path matches a glob like *_test.go, or a regex wrapped in slashes like /_test\\.go$/. Files in collapsed directories are included. A glob without a slash is matched against file names only, as in .gitignore.",
+		StageMatchingFiles:                  "Stage matching files",
+		UnstageMatchingFiles:                "Unstage matching files",
+		PathPatternPrompt:                   "Glob or /regex/ to match file paths against",
+		NoUnstagedFilesMatchPattern:         "No files with unstaged changes match '{{.pattern}}'",
+		NoStagedFilesMatchPattern:           "No files with staged changes match '{{.pattern}}'",
+		StagedMatchingFiles:                 "Staged {{.count}} file(s) matching '{{.pattern}}'",
+		UnstagedMatchingFiles:               "Unstaged {{.count}} file(s) matching '{{.pattern}}'",
+		QuickFixup:                          "Create fixup commit for...",
+		QuickFixupTooltip:                   "Create a fixup commit for one of the current branch's commits, from the staged changes (or all changes if nothing is staged). Each commit shows whether the changes apply cleanly to it, so that you can pick a target that won't conflict when the fixup is squashed in with an autosquash rebase.",
+		QuickFixupTitle:                     "Create fixup commit for",
+		NoFixupTargets:                      "There are no commits on the current branch to fix up",
+		PredictingFixupConflictsStatus:      "Checking for conflicts",
+		FixupAppliesCleanly:                 "applies cleanly",
+		FixupMayConflict:                    "may conflict",
+		FixupMayConflictTooltip:             "Your changes don't apply cleanly on top of this commit, so squashing the fixup into it is likely to cause conflicts.",
+		StageLargeFilesTitle:                "Stage large files",
+		StageLargeFilesPrompt:               "These files are larger than {{.threshold}}:\n\n{{.files}}\n\nOnce committed, large files stay in the repository's history for good, making it slow to clone. Consider tracking them with git-lfs instead (`git lfs track <pattern>`).\n\nStage them anyway?",
 		ToggleStagingPanel:                  `Switch to other panel (staged/unstaged changes)`,
 		ReturnToFilesPanel:                  `Return to files panel`,
 		FastForward:                         `Fast-forward this branch from its upstream`,
 		FastForwarding:                      "Fast-forwarding",
 		FoundConflictsTitle:                 "Conflicts!",
+		ExecFailedTitle:                     "Rebase stopped: '{{.command}}' failed",
+		RetryExec:                           "Run the command again and continue",
+		EditExecAndRetry:                    "Edit the command, run it, and continue",
+		EditExecTitle:                       "Command to run:",
+		SkipExec:                            "Skip the command and continue",
+		ExecCommandCannotBeEmpty:            "The command cannot be empty",
 		ViewConflictsMenuItem:               "View conflicts",
 		AbortMenuItem:                       "Abort the %s",
+		MergeOperation:                      "merge",
+		RebaseOperation:                     "rebase",
+		CherryPickOperation:                 "cherry-pick",
+		RevertOperation:                     "revert",
 		ViewMergeRebaseOptions:              "View merge/rebase options",
-		NotMergingOrRebasing:                "You are currently neither rebasing nor merging",
+		NotMergingOrRebasing:                "You are currently neither rebasing, merging, cherry-picking nor reverting",
 		AlreadyRebasing:                     "Can't perform this action during a rebase",
 		RecentRepos:                         "Recent repositories",
+		ParentRepos:                         "Parent repositories",
 		MergeOptionsTitle:                   "Merge options",
 		RebaseOptionsTitle:                  "Rebase options",
+		CherryPickOptionsTitle:              "Cherry-pick options",
+		RevertOptionsTitle:                  "Revert options",
 		CommitSummaryTitle:                  "Commit summary",
 		CommitDescriptionTitle:              "Commit description",
+		EditTrailers:                        "Add/remove trailers",
+		EditTrailersTooltip:                 "Add 'key: value' trailers like Reviewed-by at the end of the commit description, or remove existing ones. The trailers on offer are configured in git.commit.trailers.",
+		AddTrailer:                          "Add {{.key}}",
+		RemoveTrailer:                       "Remove '{{.trailer}}'",
 		CommitDescriptionSubTitle:           "Press {{.togglePanelKeyBinding}} to toggle focus, {{.switchToEditorKeyBinding}} to switch to editor",
 		CommitDescriptionSubTitleNoSwitch:   "Press {{.togglePanelKeyBinding}} to toggle focus",
 		LocalBranchesTitle:                  "Local branches",
@@ -1066,6 +1742,7 @@ func EnglishTranslationSet() TranslationSet {
 		ReflogCommitsTitle:                  "Reflog",
 		GlobalTitle:                         "Global keybindings",
 		ConflictsResolved:                   "All merge conflicts resolved. Continue?",
+		ContinuingOnConflictsResolved:       "All merge conflicts resolved. Continuing the {{.action}}",
 		Continue:                            "Continue",
 		Keybindings:                         "Keybindings",
 		KeybindingsMenuSectionLocal:         "Local",
@@ -1076,6 +1753,16 @@ func EnglishTranslationSet() TranslationSet {
 		SimpleRebase:                        "Simple rebase",
 		InteractiveRebase:                   "Interactive rebase",
 		InteractiveRebaseTooltip:            "Begin an interactive rebase with a break at the start, so you can update the TODO commits before continuing",
+		InteractiveRebaseWithAutosquash:     "Interactive rebase with autosquash",
+		EnableAutosquash:                    "Enable autosquash for interactive rebases",
+		DisableAutosquash:                   "Disable autosquash for interactive rebases",
+		ToggleAutosquashTooltip:             "When autosquash is enabled, interactive rebases are started with 'git rebase --autosquash', which moves fixup!, squash! and amend! commits next to the commits they refer to and sets their actions to fixup or squash. This also applies to starting an interactive rebase from the commits panel.",
+		RebaseOntoWithUpstream:              "Rebase onto, choosing which commits to move...",
+		RebaseOntoWithUpstreamTooltip:       "Prompt for an upstream, and move only the commits that come after it (i.e. 'git rebase --onto <target> <upstream>').",
+		RebaseOntoUpstreamPromptTitle:       "Move the commits after this ref onto '{{.ref}}':",
+		RebaseOntoCommit:                    "Rebase checked-out branch onto this commit",
+		RebaseOntoCommitTooltip:             "Rebase the checked-out branch onto the selected commit. You can choose between a simple rebase, an interactive rebase, or a rebase that only moves the commits after a given upstream.",
+		CannotRebaseOntoTodoCommit:          "Can't rebase onto a commit that hasn't been rebased yet",
 		ConfirmMerge:                        "Are you sure you want to merge '{{.selectedBranch}}' into '{{.checkedOutBranch}}'?",
 		FwdNoUpstream:                       "Cannot fast-forward a branch with no upstream",
 		FwdNoLocalUpstream:                  "Cannot fast-forward a branch whose remote is not registered locally",
@@ -1106,6 +1793,8 @@ func EnglishTranslationSet() TranslationSet {
 		ScrollUp:                            "Scroll up",
 		ScrollUpMainPanel:                   "Scroll up main panel",
 		ScrollDownMainPanel:                 "Scroll down main panel",
+		ScrollPositionTop:                   "Top",
+		ScrollPositionBottom:                "Bot",
 		AmendCommitTitle:                    "Amend commit",
 		AmendCommitPrompt:                   "Are you sure you want to amend this commit with your staged files?",
 		DeleteCommitTitle:                   "Delete commit",
@@ -1116,11 +1805,32 @@ func EnglishTranslationSet() TranslationSet {
 		SquashingStatus:                     "Squashing",
 		FixingStatus:                        "Fixing up",
 		DeletingStatus:                      "Deleting",
+		RenamingBranchStatus:                "Renaming branch",
 		MovingStatus:                        "Moving",
 		RebasingStatus:                      "Rebasing",
 		MergingStatus:                       "Merging",
-		LowercaseRebasingStatus:             "rebasing", // lowercase because it shows up in parentheses
-		LowercaseMergingStatus:              "merging",  // lowercase because it shows up in parentheses
+		LowercaseRebasingStatus:             "rebasing",       // lowercase because it shows up in parentheses
+		LowercaseMergingStatus:              "merging",        // lowercase because it shows up in parentheses
+		LowercaseCherryPickingStatus:        "cherry-picking", // lowercase because it shows up in parentheses
+		LowercaseRevertingStatus:            "reverting",      // lowercase because it shows up in parentheses
+		SequencerDone:                       "Done",
+		SequencerCurrent:                    "Current",
+		SequencerRemaining:                  "Remaining",
+		OperationKeysHint:                   "Press {{.continueKey}} to continue, {{.skipKey}} to skip the current commit or {{.abortKey}} to abort.",
+		MergeKeysHint:                       "Press {{.continueKey}} to continue or {{.abortKey}} to abort.",
+		OperationBranch:                     "Branch",
+		OperationOnto:                       "Onto",
+		OperationProgress:                   "Progress",
+		OperationStoppedAt:                  "Stopped at",
+		OperationStartedBy:                  "Started by",
+		OperationSteps:                      "Steps",
+		OperationMergeHeads:                 "Merging in",
+		OperationMergeMessage:               "Message",
+		ContinueOperation:                   "Continue rebase/merge/cherry-pick/revert",
+		SkipOperationStep:                   "Skip current commit of rebase/cherry-pick/revert",
+		AbortOperation:                      "Abort rebase/merge/cherry-pick/revert, or reset bisect",
+		CantSkipMerge:                       "A merge can only be continued or aborted",
+		NoOperationInProgress:               "There is no rebase, merge, cherry-pick, revert or bisect in progress",
 		AmendingStatus:                      "Amending",
 		CherryPickingStatus:                 "Cherry-picking",
 		UndoingStatus:                       "Undoing",
@@ -1155,6 +1865,9 @@ func EnglishTranslationSet() TranslationSet {
 		Cancel:                              "Cancel",
 		DiscardAllChanges:                   "Discard all changes",
 		DiscardUnstagedChanges:              "Discard unstaged changes",
+		DiscardHunks:                        "Discard individual hunks",
+		DiscardHunksTooltip:                 "Pick hunks of the unstaged changes to discard one at a time, keeping the rest of the file's changes.",
+		DiscardHunksMenuTitle:               "Discard hunk in '{{.path}}'",
 		DiscardAllChangesToAllFiles:         "Nuke working tree",
 		DiscardAnyUnstagedChanges:           "Discard unstaged changes",
 		DiscardUntrackedFiles:               "Discard untracked files",
@@ -1179,6 +1892,15 @@ func EnglishTranslationSet() TranslationSet {
 		StashAllChangesKeepIndex:            "Stash all changes and keep index",
 		StashUnstagedChanges:                "Stash unstaged changes",
 		StashIncludeUntrackedChanges:        "Stash all changes including untracked files",
+		StashStagedChangesTooltip:           "Stash only the staged changes, leaving the unstaged ones in the working tree.",
+		StashAllChangesKeepIndexTooltip:     "Stash all changes, both staged and unstaged, but leave the staged changes in place too, so that they're both stashed and still staged.",
+		StashUnstagedChangesTooltip:         "Stash only the unstaged changes, leaving the staged ones in place.",
+		StashIncludeUntrackedTooltip:        "Stash all changes, including untracked files, but leave ignored files alone (git stash --include-untracked).",
+		StashSelectedFiles:                  "Stash selected files",
+		StashSelectedFilesTooltip:           "Stash the changes of the selected files and directories only, including untracked files. Select a range to stash several at once.",
+		StashIncludingIgnored:               "Stash all changes including untracked and ignored files",
+		StashIncludingIgnoredTooltip:        "Stash all changes, including untracked files and files that are ignored by .gitignore (git stash --all).",
+		StashIncludingIgnoredWarning:        "This also stashes ignored files, such as build output, dependencies and local configuration, and removes them from the working tree until the stash is applied again. Are you sure?",
 		StashOptions:                        "Stash options",
 		NotARepository:                      "Error: must be run inside a git repository",
 		WorkingDirectoryDoesNotExist:        "Error: the current working directory does not exist",
@@ -1208,14 +1930,68 @@ func EnglishTranslationSet() TranslationSet {
 		EditRemoteUrl:                       `Enter updated remote url for {{.remoteName}}:`,
 		RemoveRemote:                        `Remove remote`,
 		RemoveRemotePrompt:                  "Are you sure you want to remove remote",
+		AddForkRemote:                       `Add fork remote`,
+		AddForkRemoteTooltip:                "Add a remote for a user's fork of the selected remote's repository, named after the user, and push to it by default. The url is derived from the selected remote's url, so this works for GitHub and GitLab. Branches keep pulling from the remote they track.",
+		ForkOwner:                           `Username of the fork owner:`,
+		ToggleDefaultPushRemote:             `Toggle default push remote`,
+		ToggleDefaultPushRemoteTooltip:      "Make plain pushes go to the selected remote, even for branches that track another remote (git's remote.pushDefault). This lets you pull from upstream and push to your fork.",
+		DefaultPushRemote:                   `push default`,
+		RemoteUrls:                          `Urls:`,
+		TrackedByBranches:                   `Tracked by:`,
 		DeleteRemoteBranch:                  "Delete remote branch",
 		DeleteRemoteBranchMessage:           "Are you sure you want to delete remote branch",
+		CleanUpGoneBranches:                 "Clean up branches with deleted upstream",
+		CleanUpGoneBranchesTooltip:          "Prune remote-tracking branches that no longer exist on their remote, then review the local branches whose upstream is gone (e.g. because their pull request was merged), and delete the ones you no longer need.",
+		CompareBranch:                       "Compare with another branch",
+		CompareBranchTooltip:                "Compare the selected branch with another one: the commits only on either side, the files both of them changed, and whether merging them would conflict. Helps deciding between merging, rebasing and cherry-picking.",
+		CompareBranchWith:                   "Compare '{{branch}}' with:",
+		CantCompareBranchWithItself:         "Can't compare a branch with itself",
+		ComparingBranchesStatus:             "Comparing branches",
+		BranchComparisonTitle:               "'{{branch}}' compared with '{{otherRef}}'",
+		BranchComparisonSummary:             "Summary",
+		BranchComparisonActions:             "Actions",
+		CommitsOnlyOn:                       "Commits only on '{{ref}}'",
+		FilesChangedOnBothSides:             "Files changed on both sides",
+		FilesChangedOnBothSidesTooltip:      "Files that both branches changed since they diverged. This is where conflicts can happen when merging, rebasing or cherry-picking.",
+		MergeConflicts:                      "Merge conflicts",
+		MergeConflictsTooltip:               "Files that merging the two branches would leave conflicted, worked out without touching your working tree. Rebasing or cherry-picking applies one commit at a time, so it can run into conflicts that a merge wouldn't.",
+		MergeConflictsUnknown:               "unknown",
+		MergeConflictsUnknownTooltip:        "Working out merge conflicts in advance needs git 2.38 or later, and branches that share some history.",
+		ViewComparedCommits:                 "View commits",
+		ViewComparedCommitsTooltip:          "Show the commits only on either side, e.g. to cherry-pick some of them.",
+		MergeRefIntoCheckedOutBranch:        "Merge '{{ref}}' into '{{checkedOutBranch}}'",
+		RebaseCheckedOutBranchOntoRef:       "Rebase '{{checkedOutBranch}}' onto '{{ref}}'",
+		CheckOutComparedBranchFirst:         "Check out one of the two branches first.",
+		PruningRemotesStatus:                "Pruning remotes",
+		NoGoneBranches:                      "No branches with a deleted upstream",
+		DeleteSelectedBranches:              "Delete selected branches",
+		NoBranchesSelected:                  "No branches selected",
+		DeleteGoneBranchesPrompt:            "Are you sure you want to delete the following branches? Commits that only exist on these branches (e.g. because the pull request was squash-merged) can only be recovered from the reflog.\n\n{{.branches}}",
+		CheckoutBeforeDeletingBranch:        "'{{.branch}}' is checked out, so '{{.target}}' will be checked out first.",
+		NoBranchToCheckoutInstead:           "Can't delete the checked-out branch '{{.branch}}', because none of the main branches ({{.mainBranches}}) exist to check out instead.",
 		SetAsUpstream:                       "Set as upstream of checked-out branch",
 		SetUpstream:                         "Set upstream of selected branch",
 		UnsetUpstream:                       "Unset upstream of selected branch",
 		ViewDivergenceFromUpstream:          "View divergence from upstream",
 		DivergenceSectionHeaderLocal:        "Local",
+		ReflogDateOptions:                   "View date options",
+		ReflogDateOptionsTooltip:            "Group reflog entries by day, or only show the entries from a given day or range of days.",
+		GroupReflogByDay:                    "Group by day",
+		UngroupReflogByDay:                  "Don't group by day",
+		FilterReflogByDate:                  "Filter by date",
+		FilterReflogByDateTooltip:           "Only show reflog entries from a given day (e.g. 'yesterday', 'tuesday', 'last friday' or '2024-01-31') or range of days (e.g. 'monday..today').",
+		FilterReflogByDatePrompt:            "Show reflog entries from:",
+		ClearReflogDateFilter:               "Clear date filter",
+		NotFilteringReflogByDate:            "Not filtering by date",
+		Today:                               "Today",
+		Yesterday:                           "Yesterday",
+		ReflogDayFormat:                     "Monday, 2006-01-02",
+		ListSectionSeparator:                "--- %s ---",
 		DivergenceSectionHeaderRemote:       "Remote",
+		DivergenceMarkerIncoming:            "{{.behind}}{{.count}} incoming from {{.upstream}}",
+		DivergenceMarkerToPush:              "{{.ahead}}{{.count}} to push",
+		DivergenceMarkerPushed:              "Pushed",
+		RebaseProgressTitle:                 "Rebase progress",
 		ViewUpstreamResetOptions:            "Reset checked-out branch onto {{.upstream}}",
 		ViewUpstreamResetOptionsTooltip:     "View options for resetting the checked-out branch onto {{upstream}}. Note: this will not reset the selected branch onto the upstream, it will reset the checked-out branch onto the upstream",
 		ViewUpstreamRebaseOptions:           "Rebase checked-out branch onto {{.upstream}}",
@@ -1225,9 +2001,32 @@ func EnglishTranslationSet() TranslationSet {
 		SetUpstreamMessage:                  "Are you sure you want to set the upstream branch of '{{.checkedOut}}' to '{{.selected}}'",
 		EditRemote:                          "Edit remote",
 		TagCommit:                           "Tag commit",
+		MoveTagToCommit:                     "Move tag to this commit",
+		MoveTagToCommitTooltip:              "Move an existing tag so that it points at the selected commit, optionally force-pushing it to a remote.",
+		MoveTagPrompt:                       "Tag to move to this commit:",
+		MoveTagTitle:                        "Move tag '{{.tagName}}'",
+		MoveTagLocally:                      "Move tag locally",
+		MoveTagLocallyTooltip:               "Only move your local copy of the tag. Remotes that already have the tag keep pointing at the old commit.",
+		MoveTagAndForcePush:                 "Move tag and force-push it to a remote",
+		MovingTag:                           "Moving tag",
+		TagDoesNotExist:                     "Tag '{{.tagName}}' does not exist",
+		RewritePublishedTag:                 "Rewrite published tag",
+		RewritePublishedTagWarning:          "WARNING: you are about to replace tag '{{.tagName}}' on '{{.remote}}'.\n\nAnyone who has already fetched this tag will keep the old one: git does not update existing tags when fetching, so they will silently disagree with you about what '{{.tagName}}' means until they delete their copy and fetch again. Release tooling and package managers may also have cached the old commit.\n\nOnly do this if you are sure nobody depends on the tag yet. Are you sure you want to continue?",
 		TagMenuTitle:                        "Create tag",
 		TagNameTitle:                        "Tag name",
 		TagMessageTitle:                     "Tag description",
+		TagTagger:                           "Tagger:",
+		TagDate:                             "Date:",
+		TagSignature:                        "Signature:",
+		TagUnsigned:                         "unsigned",
+		TagSignatureGood:                    "verified",
+		TagSignatureBad:                     "bad or unverifiable",
+		TagSignatureUnverified:              "verifying...",
+		TagSignatureTitle:                   "Signature of tag '{{.tagName}}'",
+		TagNotSigned:                        "This tag is not signed.",
+		VerifyTagSignature:                  "Verify signature",
+		VerifyTagSignatureTooltip:           "Verify the tag's signature and show which key made it. In the tags list, verified signatures are marked with ✓ and bad or unverifiable ones with ✗.",
+		VerifyingTagSignature:               "Verifying signature",
 		AnnotatedTag:                        "Annotated tag",
 		LightweightTag:                      "Lightweight tag",
 		DeleteTagTitle:                      "Delete tag '{{.tagName}}'?",
@@ -1244,6 +2043,12 @@ func EnglishTranslationSet() TranslationSet {
 		ForceTagPrompt:                      "The tag '{{.tagName}}' exists already. Press {{.cancelKey}} to cancel, or {{.confirmKey}} to overwrite.",
 		FetchRemote:                         "Fetch remote",
 		FetchingRemoteStatus:                "Fetching remote",
+		SyncNotes:                           "Push/fetch notes",
+		SyncNotesTooltip:                    "Push or fetch git notes (refs/notes/*). Notes aren't pushed or fetched along with branches and tags, so they only reach other people if you share them explicitly.",
+		PushNotes:                           "Push notes to '{{.remote}}'",
+		FetchNotes:                          "Fetch notes from '{{.remote}}'",
+		PushingNotesStatus:                  "Pushing notes",
+		FetchingNotesStatus:                 "Fetching notes",
 		CheckoutCommit:                      "Checkout commit",
 		SureCheckoutThisCommit:              "Are you sure you want to checkout this commit?",
 		GitFlowOptions:                      "Show git-flow options",
@@ -1267,7 +2072,15 @@ func EnglishTranslationSet() TranslationSet {
 		UpstreamNotSetError:              "The selected branch has no upstream (or the upstream is not stored locally)",
 		ViewBranchUpstreamOptions:        "View upstream options",
 		NewBranchNamePrompt:              "Enter new branch name for branch",
-		RenameBranchWarning:              "This branch is tracking a remote. This action will only rename the local branch name, not the name of the remote branch. Continue?",
+		RenameLocalBranchOnly:            "Rename local branch only",
+		RenameLocalAndRemoteBranch:       "Rename local and remote branch",
+		RenameLocalAndRemoteTooltip:      "Rename the branch on its remote '{{.remote}}' as well, by pushing it under the new name and deleting the old name, and make the renamed local branch track the renamed remote branch.",
+		RenameBranchOnRemotePrompt:       "The following refs will be changed:\n\n{{.changes}}\n\nThe old branch is only deleted from the remote if it hasn't changed since you last fetched. Continue?",
+		RenameBranchLocalRefChange:       "local: {{.from}} → {{.to}}",
+		RenameBranchRemoteRefChange:      "{{.remote}}: {{.from}} → {{.to}}",
+		RenameBranchUpstreamChange:       "upstream: {{.from}} → {{.to}}",
+		RemoteBranchAlreadyExists:        "Branch '{{.branch}}' already exists on remote '{{.remote}}'",
+		LocalBranchAlreadyExists:         "Branch '{{.branch}}' already exists",
 		OpenMenu:                         "Open menu",
 		ResetCherryPick:                  "Reset cherry-picked (copied) commits selection",
 		NextTab:                          "Next tab",
@@ -1281,6 +2094,7 @@ func EnglishTranslationSet() TranslationSet {
 		NextPage:                         "Next page",
 		GotoTop:                          "Scroll to top",
 		GotoBottom:                       "Scroll to bottom",
+		ToggleRangeSelect:                "Toggle range select",
 		FilteringBy:                      "Filtering by",
 		ResetInParentheses:               "(Reset)",
 		OpenFilteringMenu:                "View filter-by-path options",
@@ -1297,13 +2111,17 @@ func EnglishTranslationSet() TranslationSet {
 		ExitDiffMode:                     "Exit diff mode",
 		DiffingMenuTitle:                 "Diffing",
 		SwapDiff:                         "Reverse diff direction",
+		DiffAgainstRefAsOfTime:           "Compare against %s as of an earlier time",
+		EnterRelativeTime:                "Enter time (e.g. 'yesterday', '2.hours.ago', '2024-01-01'):",
 		OpenDiffingMenu:                  "Open diff menu",
 		// the actual view is the extras view which I intend to give more tabs in future but for now we'll only mention the command log part
 		OpenExtrasMenu:                      "Open command log menu",
 		ShowingGitDiff:                      "Showing output for:",
 		CommitDiff:                          "Commit diff",
 		CopyCommitShaToClipboard:            "Copy commit SHA to clipboard",
-		CommitSha:                           "Commit SHA",
+		CommitSha:                           "Full commit SHA",
+		AbbreviatedCommitSha:                "Abbreviated commit SHA",
+		CommitReference:                     "Commit reference (<sha> (\"<subject>\"))",
 		CommitURL:                           "Commit URL",
 		CopyCommitMessageToClipboard:        "Copy commit message to clipboard",
 		CommitMessage:                       "Full commit message",
@@ -1340,6 +2158,11 @@ func EnglishTranslationSet() TranslationSet {
 		AddSubmodule:                        "Add new submodule",
 		AddingSubmoduleStatus:               "Adding submodule",
 		UpdateSubmoduleUrl:                  "Update URL for submodule '%s'",
+		OpenNestedRepo:                      "Open repository",
+		OpenNestedRepoTooltip:               "Switch to the nested repository. Press escape in it to come back here.",
+		ConvertNestedRepoToSubmodule:        "Convert to submodule",
+		ConvertNestedRepoToSubmoduleTooltip: "Register the nested repository as a submodule of this one, using its origin as the submodule's URL unless you enter another one.",
+		NestedRepoOptions:                   "Nested repository '{{.path}}'",
 		UpdatingSubmoduleUrlStatus:          "Updating URL",
 		EditSubmoduleUrl:                    "Update submodule URL",
 		InitializingSubmoduleStatus:         "Initializing submodule",
@@ -1349,6 +2172,20 @@ func EnglishTranslationSet() TranslationSet {
 		BulkInitSubmodules:                  "Bulk init submodules",
 		BulkUpdateSubmodules:                "Bulk update submodules",
 		BulkDeinitSubmodules:                "Bulk deinit submodules",
+		BulkUpdateSubmodulesFromRemote:      "Bulk update submodules from remote",
+		ViewSubmoduleUpdateOptions:          "View update options",
+		ViewSubmoduleUpdateOptionsTooltip:   "View options for the branch the submodule tracks and how it gets updated.",
+		SubmoduleUpdateOptions:              "Submodule update options",
+		SetSubmoduleBranch:                  "Set tracked branch",
+		SetSubmoduleBranchPrompt:            "Branch for submodule '%s' to track (leave empty for the remote's default branch):",
+		SubmoduleRemoteDefaultBranch:        "(remote default)",
+		SetSubmoduleUpdateStrategy:          "Set update strategy",
+		SubmoduleUpdateCheckoutTooltip:      "Check out the new commit in the submodule, leaving it with a detached HEAD.",
+		SubmoduleUpdateRebaseTooltip:        "Rebase the submodule's current branch onto the new commit.",
+		SubmoduleUpdateMergeTooltip:         "Merge the new commit into the submodule's current branch.",
+		UpdateSubmoduleFromRemote:           "Update from remote",
+		RunCommandInSubmodules:              "Run command in each submodule",
+		RunCommandInSubmodulesPrompt:        "Command to run in each submodule",
 		ViewBulkSubmoduleOptions:            "View bulk submodule options",
 		BulkSubmoduleOptions:                "Bulk submodule options",
 		RunningCommand:                      "Running command",
@@ -1362,6 +2199,7 @@ func EnglishTranslationSet() TranslationSet {
 		PullRequestURLCopiedToClipboard:     "Pull request URL copied to clipboard",
 		CommitDiffCopiedToClipboard:         "Commit diff copied to clipboard",
 		CommitSHACopiedToClipboard:          "Commit SHA copied to clipboard",
+		CommitReferenceCopiedToClipboard:    "Commit reference copied to clipboard",
 		CommitURLCopiedToClipboard:          "Commit URL copied to clipboard",
 		CommitMessageCopiedToClipboard:      "Commit message copied to clipboard",
 		CommitSubjectCopiedToClipboard:      "Commit subject copied to clipboard",
@@ -1375,10 +2213,30 @@ func EnglishTranslationSet() TranslationSet {
 		ErrWorktreeMovedOrRemoved:           "Cannot find worktree. It might have been moved or removed ¯\\_(ツ)_/¯",
 		ToggleShowCommandLog:                "Toggle show/hide command log",
 		FocusCommandLog:                     "Focus command log",
+		CopyCommandToClipboard:              "Copy command to clipboard",
+		CommandCopiedToClipboard:            "Command copied to clipboard",
+		RerunCommand:                        "Re-run command",
+		RerunCommandTooltip:                 "Run the selected command again in a subprocess, so that you can see its output.",
+		RerunCommandPrompt:                  "Are you sure you want to re-run '{{.command}}'?",
+		CannotRerunCommand:                  "This isn't a command that can be run on the command line",
+		FilterCommandLogBySubsystem:         "Filter by subsystem",
+		AllSubsystems:                       "All",
+		SlowCommandLog:                      "'{{.command}}' took {{.duration}}",
+		KeybindingConflictsTitle:            "Keybinding conflicts",
+		KeybindingConflictTooltip:           "Pressing {{.key}} in {{.view}} runs '{{.action}}'. The following actions are bound to the same key, so they can't be invoked:\n{{.actions}}",
+		KeybindingConflictConfigPaths:       "Configured at:",
+		KeybindingConflictsNotice:           "{{.count}} key(s) are bound to more than one action in the same view. Press '{{.key}}' and choose 'Show keybinding conflicts' to see them.",
+		ShowKeybindingConflicts:             "Show keybinding conflicts",
+		NoKeybindingConflicts:               "No key is bound to more than one action in the same view",
+		FailedCommandLog:                    "'{{.command}}' exited with status {{.exitCode}} after {{.duration}}",
 		CommandLogHeader:                    "You can hide/focus this panel by pressing '%s'\n",
 		RandomTip:                           "Random tip",
 		SelectParentCommitForMerge:          "Select parent commit for merge",
 		ToggleWhitespaceInDiffView:          "Toggle whether or not whitespace changes are shown in the diff view",
+		ToggleSplitDiffView:                 "Toggle side-by-side diffs",
+		ToggleSplitDiffViewTooltip:          "Show diffs in two columns, with the old version of the changed lines on the left and the new one on the right. Works best with a wide terminal.",
+		SplitDiffViewWithPager:              "Diffs in the main view are laid out by your pager. Most pagers have a side-by-side mode of their own, e.g. 'delta --side-by-side'.",
+		ToggleDateDisplay:                   "Toggle between relative and absolute dates",
 		IgnoreWhitespaceDiffViewSubTitle:    "(ignoring whitespace)",
 		IgnoreWhitespaceNotSupportedHere:    "Ignoring whitespace is not supported in this view",
 		IncreaseContextInDiffView:           "Increase the size of the context shown around changes in the diff view",
@@ -1390,6 +2248,7 @@ func EnglishTranslationSet() TranslationSet {
 		SelectConfigFile:                    "Select config file",
 		NoConfigFileFoundErr:                "No config file found",
 		LoadingFileSuggestions:              "Loading file suggestions",
+		LoadingSuggestions:                  "Loading suggestions",
 		LoadingCommits:                      "Loading commits",
 		MustSpecifyOriginError:              "Must specify a remote if specifying a branch",
 		GitOutput:                           "Git output:",
@@ -1397,7 +2256,13 @@ func EnglishTranslationSet() TranslationSet {
 		AbortTitle:                          "Abort %s",
 		AbortPrompt:                         "Are you sure you want to abort the current %s?",
 		OpenLogMenu:                         "Open log menu",
+		PeekCommit:                          "Peek commit",
+		PeekCommitTooltip:                   "Toggle a small popup showing the message and changed files of the selected commit. Markdown in the message body, such as lists, code spans and links, is rendered. The popup follows the selection until you toggle it off or leave the commits view.",
+		CommitPeekTitle:                     "Commit %s",
 		LogMenuTitle:                        "Commit Log Options",
+		ViewCommitsOfRange:                  "View commits of ref or range",
+		ViewCommitsOfRangeTooltip:           "Browse the commits of any ref or range of commits (e.g. 'origin/main..origin/release-1.4') like you would those of a branch, so you can cherry-pick, copy or view the files of them.",
+		EnterRevisionRange:                  "Ref or range (e.g. 'main..feature'):",
 		ToggleShowGitGraphAll:               "Toggle show whole git graph (pass the `--all` flag to `git log`)",
 		ShowGitGraph:                        "Show git graph",
 		SortOrder:                           "Sort order",
@@ -1408,8 +2273,81 @@ func EnglishTranslationSet() TranslationSet {
 		SortCommits:                         "Commit sort order",
 		CantChangeContextSizeError:          "Cannot change context while in patch building mode because we were too lazy to support it when releasing the feature. If you really want it, please let us know!",
 		OpenCommitInBrowser:                 "Open commit in browser",
+		OpenLink:                            "Open link",
+		OpenLinkTooltip:                     "Open a URL or issue reference from the commit message or branch name in the browser. If there are several, you can pick one from a menu.",
+		NoLinksFound:                        "No URLs or issue references found",
+		RepoHealth:                          "Show repo health",
+		RepoHealthTooltip:                   "Check the repo for things that make it slow or big, or that are broken: its size, loose objects, large files in the history, branches whose upstream is gone, and refs that point at missing objects. Offers actions for fixing what can be fixed.",
+		RepoHealthTitle:                     "Repo health",
+		CheckingRepoHealthStatus:            "Checking repo health",
+		RepoHealthDiagnostics:               "Diagnostics",
+		RepoHealthActions:                   "Actions",
+		RepoHealthSize:                      "Repo size",
+		RepoHealthSizeTooltip:               "The size of the object database: {{.packedSize}} in {{.packCount}} pack(s) holding {{.packedCount}} objects, plus {{.looseSize}} of loose objects.",
+		RepoHealthLooseObjects:              "Loose objects",
+		RepoHealthLooseObjectsTooltip:       "Objects that aren't in a pack yet. Having many of them slows git down; running garbage collection packs them.",
+		RepoHealthLargeFiles:                "Large files in history",
+		RepoHealthLargeFilesTooltip:         "Versions of files of at least {{.threshold}} that are reachable from a branch, tag or other ref. Every clone has to download them, even if they've since been deleted.",
+		RepoHealthLargeFilesDisabledTooltip: "Set gui.files.largeFileThreshold in your config to look for large files in the history.",
+		RepoHealthCheckDisabled:             "disabled",
+		RepoHealthStaleBranches:             "Stale branches",
+		RepoHealthStaleBranchesTooltip:      "Local branches whose upstream branch has been deleted from the remote. You can delete them from the branches panel.",
+		RepoHealthBrokenRefs:                "Broken refs",
+		RepoHealthBrokenRefsTooltip:         "Refs that point at an object that doesn't exist. Git fails on any command that needs them, so they should be deleted or pointed elsewhere.",
+		RepoHealthGarbageCollect:            "Run garbage collection",
+		RepoHealthGarbageCollectTooltip:     "Run 'git gc' to pack loose objects and remove unreachable objects that have expired.",
+		RunningGarbageCollectionStatus:      "Running garbage collection",
+		GarbageCollectionDone:               "Garbage collection done",
+		RepoHealthPrune:                     "Prune unreachable objects",
+		RepoHealthPruneTooltip:              "Run 'git prune' to remove loose objects that aren't reachable from any ref or the reflog, without waiting for them to expire.",
+		RepoHealthPrunePrompt:               "This permanently deletes unreachable loose objects, such as the contents of dropped stashes that are no longer in the reflog. Are you sure you want to prune them?",
+		PruningObjectsStatus:                "Pruning objects",
+		PruneObjectsDone:                    "Unreachable objects pruned",
+		RepoHealthMigrateToLfs:              "Move large files to Git LFS",
+		RepoHealthMigrateToLfsTooltip:       "Show the command for rewriting the history so that the large files are stored in Git LFS.",
+		RepoHealthMigrateToLfsHint:          "Moving the large files to Git LFS rewrites every commit that contains them, so everyone working on the repo will have to re-clone it afterwards. If that's what you want, run this with git-lfs installed:\n\n{{.command}}",
+		NoLargeFilesInHistory:               "There are no large files in the history",
+		FixBrokenRefsFirst:                  "Git can't do this while there are broken refs. Delete them first.",
+		AuthorStats:                         "Show commits per author",
+		AuthorStatsTooltip:                  "Show a bar chart of how many commits each author has made on the current branch, over a time range of your choosing. When filtering by path, only the commits touching that path are counted.",
+		AuthorStatsTitle:                    "Author statistics",
+		LoadingAuthorStats:                  "Counting commits",
+		AuthorStatsHeader:                   "Commits per author ({{.timeRange}})",
+		AuthorStatsHeaderWithPath:           "Commits per author touching '{{.path}}' ({{.timeRange}})",
+		NoCommitsInTimeRange:                "There are no commits in this time range.",
+		LastWeek:                            "Last week",
+		LastMonth:                           "Last month",
+		LastYear:                            "Last year",
+		AllTime:                             "All time",
+		ViewFileOwnership:                   "View file ownership",
+		ViewFileOwnershipTooltip:            "Show who committed to the selected file, or to each of the files in the selected directory, and who touched it last. Useful for finding the right reviewer for a change.",
+		FileOwnershipTitle:                  "File ownership",
+		FileOwnershipHeader:                 "Ownership of '{{.path}}'",
+		LoadingFileOwnership:                "Reading history",
+		FileOwnershipAuthors:                "Changes to these files per author",
+		FileOwnershipFiles:                  "Main author of each file",
+		NoCommitsForFiles:                   "None of these files have been committed yet.",
+		NoCommitsYet:                        "no commits yet",
+		LastTouchedBy:                       "last touched by {{.author}}",
+		TypeToConfirmTitle:                  "{{.title}} (type '{{.text}}' to confirm)",
+		TypedTextDoesNotMatch:               "That's not '{{.text}}', so nothing was done.",
+		DeleteLocalBranchPrompt:             "Are you sure you want to delete the branch '{{.selectedBranchName}}'?",
+		DeleteLocalTagPrompt:                "Are you sure you want to delete the tag '{{.tagName}}'?",
+		ShowContainingRefs:                  "Show branches and tags containing commit",
+		ShowContainingRefsTooltip:           "List all local branches, remote branches and tags whose history includes the selected commit. Selecting one jumps to it in its panel.",
+		ContainingRefsTitle:                 "Branches and tags containing {{.sha}}",
+		NoRefsContainCommit:                 "No branches or tags contain this commit",
 		ViewBisectOptions:                   "View bisect options",
 		ConfirmRevertCommit:                 "Are you sure you want to revert {{.selectedCommit}}?",
+		RevertCommitsTitle:                  "Revert {{.count}} commits",
+		RevertCommitsSeparately:             "Revert each commit separately",
+		RevertCommitsAsOne:                  "Squash the reverts into a single commit",
+		CantRevertTodoCommits:               "You can't revert commits that haven't been made yet",
+		CantRevertRangeWithMergeCommits:     "Merge commits can only be reverted one at a time, because you need to pick the parent to revert to",
+		RevertIntoWorkingTree:               "Revert commit into working tree",
+		RevertIntoWorkingTreeTooltip:        "Apply the inverse of the selected commit's changes to the working tree, without creating a revert commit. This lets you adjust the changes before committing them.",
+		CantRevertMergeIntoWorkingTree:      "Merge commits can't be reverted into the working tree; use the regular revert instead",
+		CommitHasNoChangesToRevert:          "This commit has no changes to revert",
 		RewordInEditorTitle:                 "Reword in editor",
 		RewordInEditorPrompt:                "Are you sure you want to reword this commit in your editor?",
 		HardResetAutostashPrompt:            "Are you sure you want to hard reset to '%s'? An auto-stash will be performed if necessary.",
@@ -1437,6 +2375,52 @@ func EnglishTranslationSet() TranslationSet {
 		SearchKeybindings:                   "%s: Next match, %s: Previous match, %s: Exit search mode",
 		SearchPrefix:                        "Search: ",
 		FilterPrefix:                        "Filter: ",
+		OpenFuzzyFinder:                     "Find anything",
+		OpenFuzzyFinderTooltip:              "Fuzzy-find across branches, tags, remote branches, files and recent commits, and jump to the selected one in its panel.",
+		TogglePresenterMode:                 "Toggle presenter mode",
+		TogglePresenterModeTooltip:          "Show the keys you press and the actions they trigger in the bottom right corner, for screencasts and pairing sessions. Actions are delayed slightly so that viewers see the key first; see the gui.presenterMode config.",
+		PresenterModeEnabled:                "Presenter mode enabled",
+		PresenterModeDisabled:               "Presenter mode disabled",
+		NextConflictedFile:                  "Go to next conflicted file",
+		PrevConflictedFile:                  "Go to previous conflicted file",
+		ConflictedFileTooltip:               "Open the merge conflicts view for the next or previous file with conflicts, in the order they appear in the files panel. Works from any panel.",
+		NoConflictedFiles:                   "There are no files with merge conflicts",
+		ToggleDryRun:                        "Toggle dry run mode",
+		ToggleDryRunTooltip:                 "In dry run mode, force pushes, hard resets, branch deletions and cleans aren't run. Instead, lazygit shows the git commands it would have run and the refs and files they would have affected.",
+		DryRunEnabled:                       "Dry run mode enabled",
+		DryRunDisabled:                      "Dry run mode disabled",
+		DryRunModeStatus:                    "Dry run: destructive commands are only shown",
+		DryRunTitle:                         "Dry run",
+		DryRunCommands:                      "Nothing was changed. Outside of dry run mode, this would run:",
+		DryRunAffected:                      "Affected:",
+		DryRunNothingAffected:               "Nothing",
+		DryRunRefMoves:                      "{{.ref}} would move from {{.from}} to {{.to}}",
+		DryRunCommitsLeftBehind:             "{{.count}} commit(s) would no longer be on {{.ref}}",
+		DryRunRemoteRefSet:                  "{{.ref}} would be set to {{.hash}}",
+		DryRunCommitsOverwritten:            "{{.count}} commit(s) on {{.ref}} that aren't in your branch would be lost",
+		DryRunRefDeleted:                    "{{.ref}} ({{.hash}}) would be deleted",
+		DryRunRemoteBranchDeleted:           "{{.ref}} would be deleted from the remote",
+		DryRunChangesDiscarded:              "{{.path}}: uncommitted changes would be discarded",
+		DryRunFileRemoved:                   "{{.path}} would be removed",
+		DryRunSubmoduleReset:                "{{.path}}: the submodule's changes would be stashed and it would be reset to its recorded commit",
+		KeystrokesTitle:                     "Keys",
+		FuzzyFinderTitle:                    "Find anything",
+		FuzzyFinderBranch:                   "branch",
+		FuzzyFinderTag:                      "tag",
+		FuzzyFinderRemoteBranch:             "remote branch",
+		FuzzyFinderFile:                     "file",
+		FuzzyFinderCommit:                   "commit",
+		FilterCommitsByPath:                 "Show commits touching this path",
+		FilterCommitsByPathTooltip:          "Filter the commits panel down to the commits that touch the selected file, or any file in the selected directory. This enters the same filtering mode as the filtering menu; use that menu to leave it again.",
+		FileHistoryTitle:                    `File history`,
+		FileHistoryDynamicTitle:             "File history (%s)",
+		ViewFileHistory:                     `View file history`,
+		ViewFileHistoryTooltip:              "List the commits that changed the selected file, following it across renames, with the diff of each commit in the main view. From there you can checkout or copy an old version of the file.",
+		CheckoutFileVersion:                 `Checkout this version of the file`,
+		CheckoutFileVersionTooltip:          "Replace the file in the working tree with its content as of the selected commit, even if it had a different name back then. The change is left unstaged.",
+		CheckoutFileVersionPrompt:           "'{{.path}}' has uncommitted changes, which will be lost. Are you sure you want to replace it with this version?",
+		CopyFileVersionToClipboard:          `Copy this version of the file to clipboard`,
+		FileVersionCopiedToClipboard:        `File content copied to clipboard`,
 		WorktreesTitle:                      "Worktrees",
 		WorktreeTitle:                       "Worktree",
 		SwitchToWorktree:                    "Switch to worktree",
@@ -1458,6 +2442,27 @@ func EnglishTranslationSet() TranslationSet {
 		NoWorktreesThisRepo:                 "No worktrees",
 		MissingWorktree:                     "(missing)",
 		MainWorktree:                        "(main)",
+		LockedWorktree:                      "(locked)",
+		WorktreeLocked:                      "Locked",
+		WorktreeIsLocked:                    "Yes",
+		WorktreeNotLocked:                   "No",
+		WorktreeChanges:                     "Changes",
+		WorktreeDirty:                       "Uncommitted changes",
+		WorktreeClean:                       "Clean",
+		WorktreeDiskUsage:                   "Disk usage",
+		CalculatingWorktreeStats:            "Calculating...",
+		PruneWorktrees:                      "Prune stale worktrees",
+		PruneWorktreesTooltip:               "Forget about worktrees whose directories have been deleted or moved, so that their branches can be checked out elsewhere again.",
+		PruneWorktreesPrompt:                "The directories of these worktrees no longer exist. Are you sure you want to remove them from the list of worktrees?\n\n{{.worktrees}}",
+		PruningWorktrees:                    "Pruning worktrees",
+		NoWorktreesToPrune:                  "There are no worktrees with missing directories to prune",
+		RepairWorktree:                      "Repair worktree",
+		RepairWorktreeTooltip:               "Fix the links between the repo and its worktrees after either has been moved. For a worktree whose directory is missing, you'll be asked where it has been moved to.",
+		RepairWorktreeNewPath:               "Where has worktree '{{.worktreeName}}' been moved to?",
+		RepairingWorktree:                   "Repairing worktree",
+		OpenWorktreeInTerminal:              "Open in new terminal",
+		OpenWorktreeInTerminalTooltip:       "Open a new terminal window running lazygit in this worktree, using the command in the os.openInTerminal config.",
+		OpenInTerminalNotConfigured:         "There's no command for opening a terminal. Set os.openInTerminal in your config to use this.",
 		CreateWorktree:                      "Create worktree",
 		NewWorktreePath:                     "New worktree path",
 		NewWorktreeBase:                     "New worktree base ref",
@@ -1476,6 +2481,7 @@ func EnglishTranslationSet() TranslationSet {
 		MarkAsBaseCommit:                    "Mark commit as base commit for rebase",
 		MarkAsBaseCommitTooltip:             "Select a base commit for the next rebase; this will effectively perform a 'git rebase --onto'.",
 		MarkedCommitMarker:                  "↑↑↑ Will rebase from here ↑↑↑",
+		AppliedUpstreamMarker:               "= already upstream",
 		PleaseGoToURL:                       "Please go to {{.url}}",
 		DisabledMenuItemPrefix:              "Disabled: ",
 		NoCommitSelected:                    "No commit selected",
@@ -1483,6 +2489,7 @@ func EnglishTranslationSet() TranslationSet {
 		QuickStartInteractiveRebase:         "Start interactive rebase",
 		QuickStartInteractiveRebaseTooltip:  "Start an interactive rebase for the commits on your branch. This will include all commits from the HEAD commit down to the first merge commit or main branch commit.\nIf you would instead like to start an interactive rebase from the selected commit, press `{{.editKey}}`.",
 		CannotQuickStartInteractiveRebase:   "Cannot start interactive rebase: the HEAD commit is a merge commit or is present on the main branch, so there is no appropriate base commit to start the rebase from. You can start an interactive rebase from a specific commit by selecting the commit and pressing `{{.editKey}}`.",
+		ErrStageRangeWithMergeConflicts:     "Cannot stage/unstage a selection containing files with inline merge conflicts. Please fix up the merge conflicts first",
 		Actions: Actions{
 			// TODO: combine this with the original keybinding descriptions (those are all in lowercase atm)
 			CheckoutCommit:                    "Checkout commit",
@@ -1494,9 +2501,12 @@ func EnglishTranslationSet() TranslationSet {
 			Merge:                             "Merge",
 			RebaseBranch:                      "Rebase branch",
 			RenameBranch:                      "Rename branch",
+			RenameBranchOnRemote:              "Rename branch on remote",
 			CreateBranch:                      "Create branch",
 			CherryPick:                        "(Cherry-pick) paste commits",
 			CheckoutFile:                      "Checkout file",
+			CheckoutFileVersion:               "Checkout file version",
+			RecreateConflictMarkers:           "Recreate conflict markers",
 			DiscardOldFileChange:              "Discard old file change",
 			SquashCommitDown:                  "Squash commit down",
 			FixupCommit:                       "Fixup commit",
@@ -1507,14 +2517,21 @@ func EnglishTranslationSet() TranslationSet {
 			ResetCommitAuthor:                 "Reset commit author",
 			SetCommitAuthor:                   "Set commit author",
 			RevertCommit:                      "Revert commit",
+			RevertCommits:                     "Revert commits",
+			RevertCommitIntoWorkingTree:       "Revert commit into working tree",
 			CreateFixupCommit:                 "Create fixup commit",
 			SquashAllAboveFixupCommits:        "Squash all above fixup commits",
 			CreateLightweightTag:              "Create lightweight tag",
 			CreateAnnotatedTag:                "Create annotated tag",
+			MoveTag:                           "Move tag",
+			ForcePushTag:                      "Force push tag",
+			PushNotes:                         "Push notes",
+			FetchNotes:                        "Fetch notes",
 			CopyCommitMessageToClipboard:      "Copy commit message to clipboard",
 			CopyCommitSubjectToClipboard:      "Copy commit subject to clipboard",
 			CopyCommitDiffToClipboard:         "Copy commit diff to clipboard",
 			CopyCommitSHAToClipboard:          "Copy commit SHA to clipboard",
+			CopyCommitReferenceToClipboard:    "Copy commit reference to clipboard",
 			CopyCommitURLToClipboard:          "Copy commit URL to clipboard",
 			CopyCommitAuthorToClipboard:       "Copy commit author to clipboard",
 			CopyCommitAttributeToClipboard:    "Copy to clipboard",
@@ -1522,13 +2539,20 @@ func EnglishTranslationSet() TranslationSet {
 			MoveCommitUp:                      "Move commit up",
 			MoveCommitDown:                    "Move commit down",
 			CustomCommand:                     "Custom command",
+			RerunCommand:                      "Re-run command",
 			DiscardAllChangesInDirectory:      "Discard all changes in directory",
 			DiscardUnstagedChangesInDirectory: "Discard unstaged changes in directory",
 			DiscardAllChangesInFile:           "Discard all changes in file",
 			DiscardAllUnstagedChangesInFile:   "Discard all unstaged changes in file",
+			DiscardAllChangesInSelection:      "Discard all changes in selected files",
+			DiscardUnstagedChangesInSelection: "Discard unstaged changes in selected files",
+			StashSelectedFiles:                "Stash selected files",
+			DiscardHunk:                       "Discard hunk",
 			StageFile:                         "Stage file",
 			StageResolvedFiles:                "Stage files whose merge conflicts were resolved",
 			UnstageFile:                       "Unstage file",
+			IntentToAdd:                       "Mark file as intent-to-add",
+			RevertIntentToAdd:                 "Revert intent-to-add",
 			UnstageAllFiles:                   "Unstage all files",
 			StageAllFiles:                     "Stage all files",
 			IgnoreExcludeFile:                 "Ignore or exclude file",
@@ -1536,6 +2560,7 @@ func EnglishTranslationSet() TranslationSet {
 			ExcludeFile:                       "Exclude file",
 			ExcludeFileErr:                    "Cannot exclude .git/info/exclude",
 			ExcludeGitIgnoreErr:               "Cannot exclude .gitignore",
+			RemoveIgnoreRule:                  "Remove ignore rule",
 			Commit:                            "Commit",
 			EditFile:                          "Edit file",
 			Push:                              "Push",
@@ -1546,6 +2571,7 @@ func EnglishTranslationSet() TranslationSet {
 			StashStagedChanges:                "Stash staged changes",
 			StashUnstagedChanges:              "Stash unstaged changes",
 			StashIncludeUntrackedChanges:      "Stash all changes including untracked files",
+			StashIncludingIgnored:             "Stash all changes including untracked and ignored files",
 			GitFlowFinish:                     "git flow finish",
 			GitFlowStart:                      "git flow start",
 			CopyToClipboard:                   "Copy to clipboard",
@@ -1555,21 +2581,44 @@ func EnglishTranslationSet() TranslationSet {
 			MovePatchIntoIndex:                "Move patch into index",
 			MovePatchIntoNewCommit:            "Move patch into new commit",
 			DeleteRemoteBranch:                "Delete remote branch",
+			PruneRemotes:                      "Prune remotes",
+			CleanUpGoneBranches:               "Clean up branches with deleted upstream",
 			SetBranchUpstream:                 "Set branch upstream",
 			AddRemote:                         "Add remote",
 			RemoveRemote:                      "Remove remote",
 			UpdateRemote:                      "Update remote",
+			AddForkRemote:                     "Add fork remote",
+			SetDefaultPushRemote:              "Set default push remote",
+			UnsetDefaultPushRemote:            "Unset default push remote",
 			ApplyPatch:                        "Apply patch",
+			ParkChanges:                       "Park changes",
+			RestoreParkedChanges:              "Restore parked changes",
+			DropParkedChanges:                 "Drop parked changes",
+			RestoreTrashEntry:                 "Restore discarded files",
+			DropTrashEntry:                    "Drop discarded files",
+			SetAssumeUnchanged:                "Set assume-unchanged",
+			ClearAssumeUnchanged:              "Clear assume-unchanged",
+			SetSkipWorktree:                   "Set skip-worktree",
+			ClearSkipWorktree:                 "Clear skip-worktree",
+			SetExecutable:                     "Set executable bit",
+			ClearExecutable:                   "Clear executable bit",
 			Stash:                             "Stash",
 			RenameStash:                       "Rename stash",
+			BranchFromStash:                   "Create branch from stash",
 			RemoveSubmodule:                   "Remove submodule",
 			ResetSubmodule:                    "Reset submodule",
 			AddSubmodule:                      "Add submodule",
+			ConvertNestedRepoToSubmodule:      "Convert nested repo to submodule",
 			UpdateSubmoduleUrl:                "Update submodule URL",
 			InitialiseSubmodule:               "Initialise submodule",
 			BulkInitialiseSubmodules:          "Bulk initialise submodules",
 			BulkUpdateSubmodules:              "Bulk update submodules",
 			BulkDeinitialiseSubmodules:        "Bulk deinitialise submodules",
+			BulkUpdateSubmodulesFromRemote:    "Bulk update submodules from remote",
+			SetSubmoduleBranch:                "Set submodule branch",
+			SetSubmoduleUpdateStrategy:        "Set submodule update strategy",
+			UpdateSubmoduleFromRemote:         "Update submodule from remote",
+			RunCommandInSubmodules:            "Run command in submodules",
 			UpdateSubmodule:                   "Update submodule",
 			DeleteLocalTag:                    "Delete local tag",
 			DeleteRemoteTag:                   "Delete remote tag",
@@ -1588,19 +2637,35 @@ func EnglishTranslationSet() TranslationSet {
 			OpenDiffTool:                      "Open diff tool",
 			OpenMergeTool:                     "Open merge tool",
 			OpenCommitInBrowser:               "Open commit in browser",
+			OpenLink:                          "Open link",
+			GarbageCollect:                    "Garbage collect",
+			PruneObjects:                      "Prune objects",
 			OpenPullRequest:                   "Open pull request in browser",
 			StartBisect:                       "Start bisect",
 			ResetBisect:                       "Reset bisect",
+			ExportBisectLog:                   "Export bisect log",
+			ReplayBisectLog:                   "Replay bisect log",
 			BisectSkip:                        "Bisect skip",
 			BisectMark:                        "Bisect mark",
 			RemoveWorktree:                    "Remove worktree",
 			AddWorktree:                       "Add worktree",
+			PruneWorktrees:                    "Prune worktrees",
+			RepairWorktree:                    "Repair worktree",
+			OpenWorktreeInTerminal:            "Open worktree in terminal",
 		},
 		Bisect: Bisect{
 			Mark:                        "Mark current commit (%s) as %s",
 			MarkStart:                   "Mark %s as %s (start bisect)",
 			SkipCurrent:                 "Skip current commit (%s)",
 			SkipSelected:                "Skip selected commit (%s)",
+			SkipSelectedRange:           "Skip selected commits (%d)",
+			ExportLog:                   "Save bisect log to file",
+			ExportLogTooltip:            "Save the steps taken so far (the output of 'git bisect log') to a file, so that the bisect can be resumed later by replaying it.",
+			ExportLogPrompt:             "Save bisect log to:",
+			ExportedLogToast:            "Bisect log saved",
+			ReplayLog:                   "Replay bisect log from file",
+			ReplayLogTooltip:            "Start a bisect by replaying the steps recorded in a saved bisect log (using 'git bisect replay').",
+			ReplayLogPrompt:             "Bisect log to replay:",
 			ResetTitle:                  "Reset 'git bisect'",
 			ResetPrompt:                 "Are you sure you want to reset 'git bisect'?",
 			ResetOption:                 "Reset bisect",
@@ -1612,6 +2677,11 @@ func EnglishTranslationSet() TranslationSet {
 			CompletePrompt:              "Bisect complete! The following commit introduced the change:\n\n%s\n\nDo you want to reset 'git bisect' now?",
 			CompletePromptIndeterminate: "Bisect complete! Some commits were skipped, so any of the following commits may have introduced the change:\n\n%s\n\nDo you want to reset 'git bisect' now?",
 			Bisecting:                   "Bisecting",
+			BisectingWithTerms:          "Bisecting ({{.oldTerm}} → {{.newTerm}})",
+			StartedFrom:                 "Started from",
+			Terms:                       "Terms",
+			Current:                     "Current commit",
+			ResetHint:                   "Press {{.key}} to reset the bisect.",
 		},
 		Log: Log{
 			EditRebase:               "Beginning interactive rebase at '{{.ref}}'",
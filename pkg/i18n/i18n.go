@@ -15,21 +15,36 @@ type Localizer struct {
 	S   TranslationSet
 }
 
-func NewTranslationSetFromConfig(log *logrus.Entry, configLanguage string) (*TranslationSet, error) {
+// NewTranslationSetFromConfig returns the translation set for the given
+// language (or 'auto'), including any user-provided translations found in
+// translationsDir
+func NewTranslationSetFromConfig(log *logrus.Entry, configLanguage string, translationsDir string) (*TranslationSet, error) {
 	if configLanguage == "auto" {
 		language := detectLanguage(jibber_jabber.DetectIETF)
-		return NewTranslationSet(log, language), nil
+		return newTranslationSetWithUserTranslations(log, language, translationsDir)
 	}
 
-	for key := range GetTranslationSets() {
+	for _, key := range GetLanguages(translationsDir) {
 		if key == configLanguage {
-			return NewTranslationSet(log, configLanguage), nil
+			return newTranslationSetWithUserTranslations(log, configLanguage, translationsDir)
 		}
 	}
 
 	return NewTranslationSet(log, "en"), errors.New("Language not found: " + configLanguage)
 }
 
+// a broken or outdated translations file shouldn't stop lazygit from starting,
+// so in that case we log the error and use the built-in translations
+func newTranslationSetWithUserTranslations(log *logrus.Entry, language string, translationsDir string) (*TranslationSet, error) {
+	translationSet := NewTranslationSet(log, language)
+	if err := applyUserTranslations(translationSet, language, translationsDir); err != nil {
+		log.Errorf("Error loading user translations for %s: %v", language, err)
+		return NewTranslationSet(log, language), nil
+	}
+
+	return translationSet, nil
+}
+
 func NewTranslationSet(log *logrus.Entry, language string) *TranslationSet {
 	log.Info("language: " + language)
 
@@ -336,8 +336,7 @@ func japaneseTranslationSet() TranslationSet {
 		Keybindings:         "キーバインド",
 		RenameBranch:        "ブランチ名を変更",
 		NewBranchNamePrompt: "新しいブランチ名を入力",
-		// RenameBranchWarning:                 "This branch is tracking a remote. This action will only rename the local branch name, not the name of the remote branch. Continue?",
-		OpenMenu: "メニューを開く",
+		OpenMenu:            "メニューを開く",
 		// LcResetCherryPick:                   "Reset cherry-picked (copied) commits selection",
 		NextTab:               "次のタブ",
 		PrevTab:               "前のタブ",
@@ -332,7 +332,6 @@ func koreanTranslationSet() TranslationSet {
 		Keybindings:                "키 바인딩",
 		RenameBranch:               "브랜치 이름 변경",
 		NewBranchNamePrompt:        "새로운 브랜치 이름 입력",
-		RenameBranchWarning:        "This branch is tracking a remote. This action will only rename the local branch name, not the name of the remote branch. Continue?",
 		OpenMenu:                   "매뉴 열기",
 		ResetCherryPick:            "Reset cherry-picked (copied) commits selection",
 		NextTab:                    "이전 탭",
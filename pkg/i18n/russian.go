@@ -396,7 +396,6 @@ func RussianTranslationSet() TranslationSet {
 		KeybindingsLegend:                   "Связки клавиш",
 		RenameBranch:                        "Переименовать ветку",
 		NewBranchNamePrompt:                 "Введите новое название ветки",
-		RenameBranchWarning:                 "Эта ветвь отслеживает удалённый репозитории. Это действие переименует только имя локальной ветки, а не имя удалённой ветки. Продолжать?",
 		OpenMenu:                            "Открыть меню",
 		ResetCherryPick:                     "Сбросить отобранную (скопированную | cherry-picked) выборку коммитов",
 		NextTab:                             "Следующая вкладка",
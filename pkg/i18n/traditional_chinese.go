@@ -422,7 +422,6 @@ func traditionalChineseTranslationSet() TranslationSet {
 		KeybindingsLegend:                   "說明：`<c-b>` 表示 Ctrl+B、`<a-b>` 表示 Alt+B，`B`表示 Shift+B",
 		RenameBranch:                        "重新命名分支",
 		NewBranchNamePrompt:                 "為分支輸入新名稱",
-		RenameBranchWarning:                 "此分支正在追蹤遠端分支。此操作僅會重新命名本地分支名稱，而不是遠端分支的名稱。是否繼續？",
 		OpenMenu:                            "開啟選單",
 		ResetCherryPick:                     "重設選定的揀選 (複製) 提交",
 		NextTab:                             "下一個索引標籤",
@@ -0,0 +1,126 @@
+package i18n
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"reflect"
+	"sort"
+	"strings"
+
+	"github.com/go-errors/errors"
+	"github.com/samber/lo"
+	"gopkg.in/yaml.v3"
+)
+
+// Users can add their own translations (or tweak the wording of a built-in
+// one) by putting a file named after the language code, e.g. 'de.yml', into
+// the translations directory of their config dir. The keys are the names of
+// the fields of TranslationSet, e.g.
+//
+//	FilesTitle: Dateien
+//	Actions:
+//	  CheckoutBranch: Branch auschecken
+//
+// Anything not mentioned in the file falls back to the built-in translation of
+// the language, if there is one, or English otherwise.
+
+const userTranslationsFileExtension = ".yml"
+
+func UserTranslationsDir(configDir string) string {
+	return filepath.Join(configDir, "translations")
+}
+
+// GetLanguages returns the codes of the built-in languages along with those
+// of the user-provided translation files in the given directory, sorted
+func GetLanguages(translationsDir string) []string {
+	languages := append(lo.Keys(GetTranslationSets()), getUserLanguages(translationsDir)...)
+	languages = lo.Uniq(languages)
+	sort.Strings(languages)
+	return languages
+}
+
+func getUserLanguages(translationsDir string) []string {
+	entries, err := os.ReadDir(translationsDir)
+	if err != nil {
+		return nil
+	}
+
+	languages := []string{}
+	for _, entry := range entries {
+		name := entry.Name()
+		if entry.IsDir() || filepath.Ext(name) != userTranslationsFileExtension {
+			continue
+		}
+		languages = append(languages, strings.TrimSuffix(name, userTranslationsFileExtension))
+	}
+
+	return languages
+}
+
+// applyUserTranslations overrides the strings in the given translation set
+// with those of the user's translation files matching the language. As with
+// the built-in sets, a file applies to every language that starts with its
+// code, with more specific files taking precedence (e.g. 'de-AT.yml' over
+// 'de.yml').
+func applyUserTranslations(translationSet *TranslationSet, language string, translationsDir string) error {
+	languages := lo.Filter(getUserLanguages(translationsDir), func(languageCode string, _ int) bool {
+		return strings.HasPrefix(language, languageCode)
+	})
+	sort.Slice(languages, func(i, j int) bool {
+		return len(languages[i]) < len(languages[j])
+	})
+
+	for _, languageCode := range languages {
+		path := filepath.Join(translationsDir, languageCode+userTranslationsFileExtension)
+		content, err := os.ReadFile(path)
+		if err != nil {
+			return err
+		}
+
+		if err := applyTranslationsYaml(translationSet, content); err != nil {
+			return errors.Errorf("Invalid translations file %s: %v", path, err)
+		}
+	}
+
+	return nil
+}
+
+func applyTranslationsYaml(translationSet *TranslationSet, content []byte) error {
+	translations := map[string]interface{}{}
+	if err := yaml.Unmarshal(content, &translations); err != nil {
+		return err
+	}
+
+	return applyTranslations(reflect.ValueOf(translationSet).Elem(), translations, "")
+}
+
+func applyTranslations(value reflect.Value, translations map[string]interface{}, path string) error {
+	for key, translation := range translations {
+		field := value.FieldByName(key)
+		if !field.IsValid() || !field.CanSet() {
+			return fmt.Errorf("unknown key '%s%s'", path, key)
+		}
+
+		switch field.Kind() {
+		case reflect.String:
+			str, ok := translation.(string)
+			if !ok {
+				return fmt.Errorf("expected '%s%s' to be a string", path, key)
+			}
+			field.SetString(str)
+		case reflect.Struct:
+			nested, ok := translation.(map[string]interface{})
+			if !ok {
+				return fmt.Errorf("expected '%s%s' to be a map", path, key)
+			}
+			if err := applyTranslations(field, nested, path+key+"."); err != nil {
+				return err
+			}
+		default:
+			return fmt.Errorf("unknown key '%s%s'", path, key)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,147 @@
+package i18n
+
+import (
+	"io"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewTranslationSetFromConfigWithUserTranslations(t *testing.T) {
+	type scenario struct {
+		testName         string
+		files            map[string]string
+		language         string
+		expectedError    string
+		expectedFiles    string
+		expectedCommits  string
+		expectedCheckout string
+	}
+
+	scenarios := []scenario{
+		{
+			testName:         "no translation files",
+			files:            nil,
+			language:         "pl",
+			expectedFiles:    "Pliki",
+			expectedCommits:  "Commity",
+			expectedCheckout: EnglishTranslationSet().Actions.CheckoutBranch,
+		},
+		{
+			testName: "new language falls back to English",
+			files: map[string]string{
+				"de.yml": "FilesTitle: Dateien\nActions:\n  CheckoutBranch: Branch auschecken\n",
+			},
+			language:         "de",
+			expectedFiles:    "Dateien",
+			expectedCommits:  "Commits",
+			expectedCheckout: "Branch auschecken",
+		},
+		{
+			testName: "tweaking a built-in language",
+			files: map[string]string{
+				"pl.yml": "FilesTitle: Pliki robocze\n",
+			},
+			language:         "pl",
+			expectedFiles:    "Pliki robocze",
+			expectedCommits:  "Commity",
+			expectedCheckout: EnglishTranslationSet().Actions.CheckoutBranch,
+		},
+		{
+			testName: "more specific file takes precedence",
+			files: map[string]string{
+				"de.yml":    "FilesTitle: Dateien\nCommitsTitle: Commits (de)\n",
+				"de-AT.yml": "FilesTitle: Dateien (AT)\n",
+			},
+			language:         "de-AT",
+			expectedFiles:    "Dateien (AT)",
+			expectedCommits:  "Commits (de)",
+			expectedCheckout: EnglishTranslationSet().Actions.CheckoutBranch,
+		},
+		{
+			testName: "unknown language",
+			files: map[string]string{
+				"de.yml": "FilesTitle: Dateien\n",
+			},
+			language:      "fr",
+			expectedError: "Language not found: fr",
+		},
+		{
+			testName: "unknown key falls back to the built-in translations",
+			files: map[string]string{
+				"de.yml": "Actions:\n  NoSuchAction: foo\n",
+			},
+			language:         "de",
+			expectedFiles:    EnglishTranslationSet().FilesTitle,
+			expectedCommits:  EnglishTranslationSet().CommitsTitle,
+			expectedCheckout: EnglishTranslationSet().Actions.CheckoutBranch,
+		},
+		{
+			testName: "wrong type falls back to the built-in translations",
+			files: map[string]string{
+				"pl.yml": "FilesTitle: Pliki robocze\nActions: foo\n",
+			},
+			language:         "pl",
+			expectedFiles:    "Pliki",
+			expectedCommits:  "Commity",
+			expectedCheckout: EnglishTranslationSet().Actions.CheckoutBranch,
+		},
+	}
+
+	log := logrus.New()
+	log.Out = io.Discard
+
+	for _, s := range scenarios {
+		s := s
+		t.Run(s.testName, func(t *testing.T) {
+			dir := t.TempDir()
+			for name, content := range s.files {
+				assert.NoError(t, os.WriteFile(filepath.Join(dir, name), []byte(content), 0o644))
+			}
+
+			tr, err := NewTranslationSetFromConfig(logrus.NewEntry(log), s.language, dir)
+			if s.expectedError != "" {
+				assert.ErrorContains(t, err, s.expectedError)
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, s.expectedFiles, tr.FilesTitle)
+			assert.Equal(t, s.expectedCommits, tr.CommitsTitle)
+			assert.Equal(t, s.expectedCheckout, tr.Actions.CheckoutBranch)
+		})
+	}
+}
+
+func TestApplyUserTranslationsErrors(t *testing.T) {
+	scenarios := []struct {
+		content       string
+		expectedError string
+	}{
+		{content: "Actions:\n  NoSuchAction: foo\n", expectedError: "unknown key 'Actions.NoSuchAction'"},
+		{content: "Actions: foo\n", expectedError: "expected 'Actions' to be a map"},
+	}
+
+	for _, s := range scenarios {
+		dir := t.TempDir()
+		assert.NoError(t, os.WriteFile(filepath.Join(dir, "de.yml"), []byte(s.content), 0o644))
+
+		translationSet := EnglishTranslationSet()
+		assert.ErrorContains(t, applyUserTranslations(&translationSet, "de", dir), s.expectedError)
+	}
+}
+
+func TestGetLanguages(t *testing.T) {
+	dir := t.TempDir()
+	assert.NoError(t, os.WriteFile(filepath.Join(dir, "de.yml"), []byte{}, 0o644))
+	assert.NoError(t, os.WriteFile(filepath.Join(dir, "pl.yml"), []byte{}, 0o644))
+	assert.NoError(t, os.WriteFile(filepath.Join(dir, "notes.txt"), []byte{}, 0o644))
+
+	assert.Equal(t,
+		[]string{"de", "en", "ja", "ko", "nl", "pl", "ru", "zh-CN", "zh-TW"},
+		GetLanguages(dir),
+	)
+}
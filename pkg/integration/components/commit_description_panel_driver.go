@@ -31,6 +31,11 @@ func (self *CommitDescriptionPanelDriver) AddNewline() *CommitDescriptionPanelDr
 	return self
 }
 
+func (self *CommitDescriptionPanelDriver) EditTrailers() *CommitDescriptionPanelDriver {
+	self.getViewDriver().Press(self.t.keys.CommitMessage.EditTrailers)
+	return self
+}
+
 func (self *CommitDescriptionPanelDriver) Title(expected *TextMatcher) *CommitDescriptionPanelDriver {
 	self.getViewDriver().Title(expected)
 
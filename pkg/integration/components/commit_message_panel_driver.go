@@ -72,6 +72,11 @@ func (self *CommitMessagePanelDriver) SwitchToEditor() {
 	self.getViewDriver().Press(self.t.keys.CommitMessage.SwitchToEditor)
 }
 
+func (self *CommitMessagePanelDriver) EditTrailers() *CommitMessagePanelDriver {
+	self.getViewDriver().Press(self.t.keys.CommitMessage.EditTrailers)
+	return self
+}
+
 func (self *CommitMessagePanelDriver) SelectPreviousMessage() *CommitMessagePanelDriver {
 	self.getViewDriver().SelectPreviousItem()
 	return self
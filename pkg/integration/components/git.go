@@ -24,6 +24,24 @@ func (self *Git) RemoteTagDeleted(ref string, tagName string) *Git {
 	})
 }
 
+func (self *Git) RemoteRefExists(remote string, ref string) *Git {
+	return self.expect([]string{"git", "ls-remote", remote, ref}, func(s string) (bool, string) {
+		return len(s) > 0, fmt.Sprintf("Expected %s to exist on %s", ref, remote)
+	})
+}
+
+func (self *Git) RemoteTagPointsAt(remote string, tagName string, ref string) *Git {
+	expectedSha, err := self.shell.runCommandWithOutput([]string{"git", "rev-parse", ref})
+	if err != nil {
+		self.fail(fmt.Sprintf("Unexpected error resolving %s: %s", ref, err.Error()))
+	}
+	expectedSha = strings.TrimSpace(expectedSha)
+
+	return self.expect([]string{"git", "ls-remote", remote, fmt.Sprintf("refs/tags/%s*", tagName)}, func(s string) (bool, string) {
+		return strings.Contains(s, expectedSha), fmt.Sprintf("Expected tag %s on %s to point at %s, but got '%s'", tagName, remote, expectedSha, s)
+	})
+}
+
 func (self *Git) assert(cmdArgs []string, expected string) *Git {
 	self.expect(cmdArgs, func(output string) (bool, string) {
 		return output == expected, fmt.Sprintf("Expected current branch name to be '%s', but got '%s'", expected, output)
@@ -49,6 +49,18 @@ func (self *TestDriver) click(x, y int) {
 	self.Wait(self.inputDelay)
 }
 
+func (self *TestDriver) clickAndRelease(x, y int) {
+	self.SetCaption(fmt.Sprintf("Clicking and releasing %d, %d", x, y))
+	self.gui.ClickAndRelease(x, y)
+	self.Wait(self.inputDelay)
+}
+
+func (self *TestDriver) drag(fromX, fromY, toX, toY int) {
+	self.SetCaption(fmt.Sprintf("Dragging %d, %d to %d, %d", fromX, fromY, toX, toY))
+	self.gui.Drag(fromX, fromY, toX, toY)
+	self.Wait(self.inputDelay)
+}
+
 // Should only be used in specific cases where you're doing something weird!
 // E.g. invoking a global keybinding from within a popup.
 // You probably shouldn't use this function, and should instead go through a view like t.Views().Commit().Focus().Press(...)
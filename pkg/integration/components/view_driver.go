@@ -411,6 +411,25 @@ func (self *ViewDriver) Click(x, y int) *ViewDriver {
 	return self
 }
 
+// like Click, but releases the button again, for when the test clicks several
+// times in a row and the clicks mustn't be taken for a drag
+func (self *ViewDriver) ClickAndRelease(x, y int) *ViewDriver {
+	offsetX, offsetY, _, _ := self.getView().Dimensions()
+
+	self.t.clickAndRelease(offsetX+1+x, offsetY+1+y)
+
+	return self
+}
+
+// drags with the left mouse button from one position in the view to another
+func (self *ViewDriver) Drag(fromX, fromY, toX, toY int) *ViewDriver {
+	offsetX, offsetY, _, _ := self.getView().Dimensions()
+
+	self.t.drag(offsetX+1+fromX, offsetY+1+fromY, offsetX+1+toX, offsetY+1+toY)
+
+	return self
+}
+
 // i.e. pressing down arrow
 func (self *ViewDriver) SelectNextItem() *ViewDriver {
 	return self.PressFast(self.t.keys.Universal.NextItem)
@@ -145,6 +145,18 @@ func (self *Views) Information() *ViewDriver {
 	return self.regularView("information")
 }
 
+func (self *Views) Extras() *ViewDriver {
+	return self.regularView("extras")
+}
+
+func (self *Views) CommitsMinimap() *ViewDriver {
+	return self.regularView("commitsMinimap")
+}
+
+func (self *Views) RebaseProgress() *ViewDriver {
+	return self.regularView("rebaseProgress")
+}
+
 func (self *Views) AppStatus() *ViewDriver {
 	return self.regularView("appStatus")
 }
@@ -173,6 +185,10 @@ func (self *Views) SubCommits() *ViewDriver {
 	return self.regularView("subCommits")
 }
 
+func (self *Views) FileHistory() *ViewDriver {
+	return self.regularView("fileHistory")
+}
+
 func (self *Views) CommitFiles() *ViewDriver {
 	return self.regularView("commitFiles")
 }
@@ -226,3 +242,11 @@ func (self *Views) Search() *ViewDriver {
 func (self *Views) Tooltip() *ViewDriver {
 	return self.regularView("tooltip")
 }
+
+func (self *Views) CommitPeek() *ViewDriver {
+	return self.regularView("commitPeek")
+}
+
+func (self *Views) Keystrokes() *ViewDriver {
+	return self.regularView("keystrokes")
+}
@@ -38,6 +38,17 @@ var ChooseTerms = NewIntegrationTest(NewIntegrationTestArgs{
 				t.ExpectPopup().Menu().Title(Equals("Bisect")).Select(Contains("Choose bisect terms")).Confirm()
 				t.ExpectPopup().Prompt().Title(Equals("Term for old/good commit:")).Type("broken").Confirm()
 				t.ExpectPopup().Prompt().Title(Equals("Term for new/bad commit:")).Type("fixed").Confirm()
+
+				// After choosing the terms, we go straight on to marking the
+				// selected commit using them
+				t.ExpectPopup().Menu().Title(Equals("Bisect")).
+					TopLines(
+						MatchesRegexp(`b Mark current commit \(.*\) as fixed`),
+						MatchesRegexp(`g Mark current commit \(.*\) as broken`),
+					).
+					Cancel()
+
+				t.Views().Information().Content(Contains("Bisecting (broken → fixed)"))
 			}).
 			NavigateToLine(Contains("CI commit 09")).
 			Tap(markCommitAsFixed).
@@ -0,0 +1,89 @@
+package bisect
+
+import (
+	"github.com/jesseduffield/lazygit/pkg/config"
+	. "github.com/jesseduffield/lazygit/pkg/integration/components"
+)
+
+var ExportAndReplayLog = NewIntegrationTest(NewIntegrationTestArgs{
+	Description:  "Save the bisect log to a file, reset the bisect, and resume it by replaying the log",
+	ExtraCmdArgs: []string{},
+	Skip:         false,
+	SetupRepo: func(shell *Shell) {
+		shell.
+			CreateNCommits(10)
+	},
+	SetupConfig: func(cfg *config.AppConfig) {},
+	Run: func(t *TestDriver, keys config.KeybindingConfig) {
+		t.Views().Commits().
+			Focus().
+			SelectedLine(Contains("commit 10")).
+			Press(keys.Commits.ViewBisectOptions).
+			Tap(func() {
+				t.ExpectPopup().Menu().Title(Equals("Bisect")).Select(MatchesRegexp(`Mark .* as bad`)).Confirm()
+			}).
+			NavigateToLine(Contains("commit 01")).
+			Press(keys.Commits.ViewBisectOptions).
+			Tap(func() {
+				t.ExpectPopup().Menu().Title(Equals("Bisect")).Select(MatchesRegexp(`Mark .* as good`)).Confirm()
+			}).
+			SelectedLine(Contains("CI commit 05").Contains("<-- current")).
+			Press(keys.Commits.ViewBisectOptions).
+			Tap(func() {
+				t.ExpectPopup().Menu().Title(Equals("Bisect")).Select(Contains("Save bisect log to file")).Confirm()
+
+				t.ExpectPopup().Prompt().
+					Title(Equals("Save bisect log to:")).
+					Type("../bisect.log").
+					Confirm()
+
+				t.ExpectToast(Equals("Bisect log saved"))
+			}).
+			Press(keys.Commits.ViewBisectOptions).
+			Tap(func() {
+				t.ExpectPopup().Menu().Title(Equals("Bisect")).Select(Contains("Reset bisect")).Confirm()
+
+				t.ExpectPopup().Confirmation().
+					Title(Equals("Reset 'git bisect'")).
+					Content(Contains("Are you sure you want to reset 'git bisect'?")).
+					Confirm()
+
+				t.Views().Information().Content(DoesNotContain("Bisecting"))
+			}).
+			Lines(
+				Contains("CI commit 10").DoesNotContain("<--"),
+				Contains("CI commit 09"),
+				Contains("CI commit 08"),
+				Contains("CI commit 07"),
+				Contains("CI commit 06"),
+				Contains("CI commit 05").DoesNotContain("<--"),
+				Contains("CI commit 04"),
+				Contains("CI commit 03"),
+				Contains("CI commit 02"),
+				Contains("CI commit 01").DoesNotContain("<--"),
+			).
+			Press(keys.Commits.ViewBisectOptions).
+			Tap(func() {
+				t.ExpectPopup().Menu().Title(Equals("Bisect")).Select(Contains("Replay bisect log from file")).Confirm()
+
+				t.ExpectPopup().Prompt().
+					Title(Equals("Bisect log to replay:")).
+					Type("../bisect.log").
+					Confirm()
+
+				t.Views().Information().Content(Contains("Bisecting"))
+			}).
+			Lines(
+				Contains("CI commit 10").Contains("<-- bad"),
+				Contains("CI commit 09"),
+				Contains("CI commit 08"),
+				Contains("CI commit 07"),
+				Contains("CI commit 06"),
+				Contains("CI commit 05").Contains("<-- current").IsSelected(),
+				Contains("CI commit 04"),
+				Contains("CI commit 03"),
+				Contains("CI commit 02"),
+				Contains("CI commit 01").Contains("<-- good"),
+			)
+	},
+})
@@ -48,6 +48,7 @@ var Skip = NewIntegrationTest(NewIntegrationTestArgs{
 						Contains("b Mark current commit").Contains("as bad"),
 						Contains("g Mark current commit").Contains("as good"),
 						Contains("s Skip current commit"),
+						Contains("e Save bisect log to file"),
 						Contains("r Reset bisect"),
 						Contains("Cancel"),
 					).
@@ -76,6 +77,7 @@ var Skip = NewIntegrationTest(NewIntegrationTestArgs{
 						Contains("g Mark current commit").Contains("as good"),
 						Contains("s Skip current commit"),
 						Contains("S Skip selected commit"),
+						Contains("e Save bisect log to file"),
 						Contains("r Reset bisect"),
 						Contains("Cancel"),
 					).
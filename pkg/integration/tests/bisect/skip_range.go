@@ -0,0 +1,66 @@
+package bisect
+
+import (
+	"github.com/jesseduffield/lazygit/pkg/config"
+	. "github.com/jesseduffield/lazygit/pkg/integration/components"
+)
+
+var SkipRange = NewIntegrationTest(NewIntegrationTestArgs{
+	Description:  "Start a git bisect and skip a range of selected commits in one go",
+	ExtraCmdArgs: []string{},
+	Skip:         false,
+	SetupRepo: func(shell *Shell) {
+		shell.
+			CreateNCommits(10)
+	},
+	SetupConfig: func(cfg *config.AppConfig) {},
+	Run: func(t *TestDriver, keys config.KeybindingConfig) {
+		t.Views().Commits().
+			Focus().
+			SelectedLine(Contains("commit 10")).
+			Press(keys.Commits.ViewBisectOptions).
+			Tap(func() {
+				t.ExpectPopup().Menu().Title(Equals("Bisect")).Select(MatchesRegexp(`Mark .* as bad`)).Confirm()
+			}).
+			NavigateToLine(Contains("commit 01")).
+			Press(keys.Commits.ViewBisectOptions).
+			Tap(func() {
+				t.ExpectPopup().Menu().Title(Equals("Bisect")).Select(MatchesRegexp(`Mark .* as good`)).Confirm()
+			}).
+			// The bar in front of each commit shows the known-new part of the
+			// list, the part still being searched, and the known-old part
+			Lines(
+				Contains("▌").Contains("CI commit 10").Contains("<-- bad"),
+				Contains("▌").Contains("CI commit 09"),
+				Contains("▌").Contains("CI commit 08"),
+				Contains("▌").Contains("CI commit 07"),
+				Contains("▌").Contains("CI commit 06"),
+				Contains("▌").Contains("CI commit 05").Contains("<-- current").IsSelected(),
+				Contains("▌").Contains("CI commit 04"),
+				Contains("▌").Contains("CI commit 03"),
+				Contains("▌").Contains("CI commit 02"),
+				Contains("▌").Contains("CI commit 01").Contains("<-- good"),
+			).
+			NavigateToLine(Contains("commit 04")).
+			Press(keys.Universal.ToggleRangeSelect).
+			SelectNextItem().
+			SelectNextItem().
+			Press(keys.Commits.ViewBisectOptions).
+			Tap(func() {
+				t.ExpectPopup().Menu().Title(Equals("Bisect")).
+					Select(Contains("Skip selected commits (3)")).Confirm()
+			}).
+			Lines(
+				Contains("CI commit 10").Contains("<-- bad"),
+				Contains("CI commit 09").DoesNotContain("<--"),
+				Contains("CI commit 08").DoesNotContain("<--"),
+				Contains("CI commit 07").DoesNotContain("<--"),
+				Contains("CI commit 06").DoesNotContain("<--"),
+				Contains("CI commit 05").Contains("<-- current"),
+				Contains("CI commit 04").Contains("<-- skipped"),
+				Contains("CI commit 03").Contains("<-- skipped"),
+				Contains("CI commit 02").Contains("<-- skipped").IsSelected(),
+				Contains("CI commit 01").Contains("<-- good"),
+			)
+	},
+})
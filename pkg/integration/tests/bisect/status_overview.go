@@ -0,0 +1,52 @@
+package bisect
+
+import (
+	"github.com/jesseduffield/lazygit/pkg/config"
+	. "github.com/jesseduffield/lazygit/pkg/integration/components"
+)
+
+var StatusOverview = NewIntegrationTest(NewIntegrationTestArgs{
+	Description:  "Show the details of a bisect in the status panel, and reset it from there",
+	ExtraCmdArgs: []string{},
+	Skip:         false,
+	SetupRepo: func(shell *Shell) {
+		shell.
+			CreateNCommits(10).
+			StartBisect("HEAD~2", "HEAD~5")
+	},
+	SetupConfig: func(cfg *config.AppConfig) {},
+	Run: func(t *TestDriver, keys config.KeybindingConfig) {
+		t.Views().Status().
+			Focus()
+
+		t.Views().Main().
+			Title(Equals("Bisecting")).
+			Content(
+				Contains("Started from").Contains("master").
+					Contains("Terms").Contains("good → bad").
+					Contains("Current commit").
+					Contains("Steps").
+					Contains("bad: [").Contains("commit 08").
+					Contains("good: [").Contains("commit 05").
+					Contains("Press A to reset the bisect."),
+			)
+
+		t.Views().Status().
+			Press(keys.Status.ContinueOperation)
+
+		t.ExpectToast(Equals("Disabled: You are currently neither rebasing, merging, cherry-picking nor reverting"))
+
+		t.Views().Status().
+			Press(keys.Status.AbortOperation)
+
+		t.ExpectPopup().Confirmation().
+			Title(Equals("Reset 'git bisect'")).
+			Content(Equals("Are you sure you want to reset 'git bisect'?")).
+			Confirm()
+
+		t.Views().Information().Content(DoesNotContain("Bisecting"))
+
+		t.Views().Main().
+			Title(Equals("Status"))
+	},
+})
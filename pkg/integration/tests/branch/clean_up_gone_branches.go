@@ -0,0 +1,87 @@
+package branch
+
+import (
+	"github.com/jesseduffield/lazygit/pkg/config"
+	. "github.com/jesseduffield/lazygit/pkg/integration/components"
+)
+
+var CleanUpGoneBranches = NewIntegrationTest(NewIntegrationTestArgs{
+	Description:  "Prune remote branches that were deleted on the remote, and delete some of the local branches that tracked them, including the checked-out one",
+	ExtraCmdArgs: []string{},
+	Skip:         false,
+	SetupConfig:  func(config *config.AppConfig) {},
+	SetupRepo: func(shell *Shell) {
+		shell.
+			EmptyCommit("one").
+			CloneIntoRemote("origin").
+			SetBranchUpstream("master", "origin/master").
+			NewBranch("merged-one").
+			PushBranch("origin", "merged-one").
+			NewBranch("merged-two").
+			PushBranch("origin", "merged-two").
+			NewBranch("still-open").
+			PushBranch("origin", "still-open").
+			Checkout("merged-two").
+			// as happens after merging a pull request
+			RemoveRemoteBranch("origin", "merged-one").
+			RemoveRemoteBranch("origin", "merged-two")
+	},
+	Run: func(t *TestDriver, keys config.KeybindingConfig) {
+		t.Views().Branches().
+			Focus().
+			Lines(
+				Contains("merged-two").IsSelected(),
+				Contains("still-open"),
+				Contains("merged-one"),
+				Contains("master"),
+			).
+			Press(keys.Branches.CleanUpGoneBranches)
+
+		t.ExpectPopup().Menu().
+			Title(Equals("Clean up branches with deleted upstream")).
+			Lines(
+				Contains("[x]").Contains("merged-two").Contains("origin/merged-two").IsSelected(),
+				Contains("[x]").Contains("merged-one").Contains("origin/merged-one"),
+				Contains("Delete selected branches"),
+				Contains("Cancel"),
+			).
+			// keep merged-one
+			Select(Contains("merged-one")).
+			Confirm().
+			Lines(
+				Contains("[x]").Contains("merged-two"),
+				Contains("[ ]").Contains("merged-one").IsSelected(),
+				Contains("Delete selected branches"),
+				Contains("Cancel"),
+			).
+			Select(Contains("Delete selected branches")).
+			Confirm()
+
+		t.ExpectPopup().Confirmation().
+			Title(Equals("Delete selected branches")).
+			Content(
+				Contains("- merged-two").
+					DoesNotContain("merged-one").
+					Contains("'merged-two' is checked out, so 'master' will be checked out first."),
+			).
+			Confirm()
+
+		t.Views().Branches().
+			Lines(
+				Contains("master"),
+				Contains("still-open"),
+				Contains("merged-one").Contains("upstream gone"),
+			)
+
+		t.Views().Remotes().
+			Focus().
+			Lines(Contains("origin")).
+			PressEnter()
+
+		t.Views().RemoteBranches().
+			Lines(
+				Equals("master"),
+				Equals("still-open"),
+			)
+	},
+})
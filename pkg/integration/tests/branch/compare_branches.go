@@ -0,0 +1,123 @@
+package branch
+
+import (
+	"github.com/jesseduffield/lazygit/pkg/config"
+	. "github.com/jesseduffield/lazygit/pkg/integration/components"
+)
+
+var CompareBranches = NewIntegrationTest(NewIntegrationTestArgs{
+	Description:  "Compare a branch with the checked-out one, view the commits only on either side and merge it",
+	ExtraCmdArgs: []string{},
+	Skip:         false,
+	SetupConfig:  func(config *config.AppConfig) {},
+	SetupRepo: func(shell *Shell) {
+		shell.CreateFileAndAdd("shared", "base\n")
+		shell.Commit("base")
+
+		shell.NewBranch("feature")
+		shell.UpdateFileAndAdd("shared", "feature\n")
+		shell.Commit("feature change")
+		shell.CreateFileAndAdd("feature-only", "feature\n")
+		shell.Commit("feature addition")
+
+		shell.NewBranchFrom("clean", "master")
+		shell.CreateFileAndAdd("clean-only", "clean\n")
+		shell.Commit("clean addition")
+
+		shell.Checkout("master")
+		shell.UpdateFileAndAdd("shared", "master\n")
+		shell.Commit("master change")
+	},
+	Run: func(t *TestDriver, keys config.KeybindingConfig) {
+		t.Views().Branches().
+			Focus().
+			NavigateToLine(Contains("feature")).
+			Press(keys.Branches.CompareBranch)
+
+		t.ExpectPopup().Prompt().
+			Title(Equals("Compare 'feature' with:")).
+			InitialText(Equals("master")).
+			Confirm()
+
+		t.ExpectPopup().Menu().
+			Title(Equals("'feature' compared with 'master'")).
+			Lines(
+				Contains("Summary"),
+				MatchesRegexp(`Commits only on 'feature'\s+2$`).IsSelected(),
+				MatchesRegexp(`Commits only on 'master'\s+1$`),
+				MatchesRegexp(`Files changed on both sides\s+1$`),
+				MatchesRegexp(`Merge conflicts\s+1$`),
+				Contains(""),
+				Contains("Actions"),
+				Contains("View commits"),
+				Contains("Merge 'feature' into 'master'"),
+				Contains("Rebase 'master' onto 'feature'"),
+				Contains("Cancel"),
+			).
+			Tooltip(Contains("feature addition").Contains("feature change")).
+			Select(Contains("Merge conflicts")).
+			Tooltip(Contains("- shared")).
+			Select(Contains("View commits")).
+			Confirm()
+
+		t.Views().SubCommits().
+			IsFocused().
+			Title(Contains("Commits (feature <-> master)")).
+			Lines(
+				DoesNotContainAnyOf("↓", "↑").Contains("--- master ---"),
+				Contains("↓").Contains("master change"),
+				DoesNotContainAnyOf("↓", "↑").Contains("--- feature ---"),
+				Contains("↑").Contains("feature addition"),
+				Contains("↑").Contains("feature change"),
+			).
+			PressEscape()
+
+		t.Views().Branches().
+			IsFocused().
+			NavigateToLine(Contains("clean")).
+			Press(keys.Branches.CompareBranch)
+
+		t.ExpectPopup().Prompt().
+			Title(Equals("Compare 'clean' with:")).
+			Clear().
+			Type("feature").
+			Confirm()
+
+		t.ExpectPopup().Menu().
+			Title(Equals("'clean' compared with 'feature'")).
+			Select(Contains("Merge 'clean' into 'feature'")).
+			Tooltip(Contains("Disabled: Check out one of the two branches first.")).
+			Cancel()
+
+		t.Views().Branches().
+			IsFocused().
+			Press(keys.Branches.CompareBranch)
+
+		t.ExpectPopup().Prompt().
+			Title(Equals("Compare 'clean' with:")).
+			InitialText(Equals("master")).
+			Confirm()
+
+		t.ExpectPopup().Menu().
+			Title(Equals("'clean' compared with 'master'")).
+			TopLines(
+				Contains("Summary"),
+				MatchesRegexp(`Commits only on 'clean'\s+1$`),
+				MatchesRegexp(`Commits only on 'master'\s+1$`),
+				MatchesRegexp(`Files changed on both sides\s+0$`),
+				MatchesRegexp(`Merge conflicts\s+0$`),
+			).
+			Select(Contains("Merge 'clean' into 'master'")).
+			Confirm()
+
+		t.ExpectPopup().Confirmation().
+			Title(Equals("Merge")).
+			Content(Contains("Are you sure you want to merge 'clean' into 'master'?")).
+			Confirm()
+
+		t.Views().Commits().
+			TopLines(
+				Contains("Merge branch 'clean'"),
+			)
+	},
+})
@@ -0,0 +1,44 @@
+package branch
+
+import (
+	"github.com/jesseduffield/lazygit/pkg/config"
+	. "github.com/jesseduffield/lazygit/pkg/integration/components"
+)
+
+var CustomStatusGlyphs = NewIntegrationTest(NewIntegrationTestArgs{
+	Description:  "Show the push/pull status of branches and the merged status of commits with custom glyphs",
+	ExtraCmdArgs: []string{},
+	Skip:         false,
+	SetupConfig: func(config *config.AppConfig) {
+		config.UserConfig.Gui.StatusGlyphs.Ahead = "^"
+		config.UserConfig.Gui.StatusGlyphs.Behind = "v"
+		config.UserConfig.Gui.StatusGlyphs.InSync = "="
+		config.UserConfig.Gui.StatusGlyphs.Merged = "*"
+	},
+	SetupRepo: func(shell *Shell) {
+		shell.EmptyCommit("one")
+		shell.EmptyCommit("two")
+		shell.CloneIntoRemote("origin")
+		shell.SetBranchUpstream("master", "origin/master")
+
+		shell.NewBranch("in-sync")
+		shell.SetBranchUpstream("in-sync", "origin/master")
+
+		shell.Checkout("master")
+		shell.HardReset("HEAD^")
+		shell.EmptyCommit("three")
+	},
+	Run: func(t *TestDriver, keys config.KeybindingConfig) {
+		t.Views().Branches().
+			Lines(
+				Contains("master ^1v1"),
+				Contains("in-sync ="),
+			)
+
+		t.Views().Commits().
+			Lines(
+				DoesNotContain("*").Contains("three"),
+				Contains("*").Contains("one"),
+			)
+	},
+})
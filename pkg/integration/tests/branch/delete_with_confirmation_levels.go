@@ -0,0 +1,85 @@
+package branch
+
+import (
+	"github.com/jesseduffield/lazygit/pkg/config"
+	. "github.com/jesseduffield/lazygit/pkg/integration/components"
+)
+
+var DeleteWithConfirmationLevels = NewIntegrationTest(NewIntegrationTestArgs{
+	Description:  "Delete branches with their actions moved to other confirmation tiers",
+	ExtraCmdArgs: []string{},
+	Skip:         false,
+	SetupConfig: func(config *config.AppConfig) {
+		config.UserConfig.Confirmations.Actions = map[string]string{
+			"deleteBranch":         "medium",
+			"deleteUnmergedBranch": "high",
+		}
+	},
+	SetupRepo: func(shell *Shell) {
+		shell.
+			EmptyCommit("blah").
+			NewBranch("merged").
+			NewBranch("unmerged").
+			EmptyCommit("deletion blocker").
+			Checkout("master")
+	},
+	Run: func(t *TestDriver, keys config.KeybindingConfig) {
+		t.Views().Branches().
+			Focus().
+			Lines(
+				Contains("master").IsSelected(),
+				Contains("unmerged"),
+				Contains("merged"),
+			).
+			NavigateToLine(Contains("unmerged")).
+			Press(keys.Universal.Remove).
+			Tap(func() {
+				t.ExpectPopup().Menu().
+					Title(Equals("Delete branch 'unmerged'?")).
+					Select(Contains("Delete local branch")).
+					Confirm()
+
+				// the branch is merged as far as git knows until it tries deleting it
+				t.ExpectPopup().Confirmation().
+					Title(Equals("Delete branch 'unmerged'?")).
+					Content(Equals("Are you sure you want to delete the branch 'unmerged'?")).
+					Confirm()
+
+				t.ExpectPopup().Prompt().
+					Title(Equals("Force delete branch (type 'unmerged' to confirm)")).
+					Type("merged").
+					Confirm()
+
+				t.ExpectPopup().Alert().
+					Title(Equals("Error")).
+					Content(Equals("That's not 'unmerged', so nothing was done.")).
+					Confirm()
+			}).
+			Lines(
+				Contains("master"),
+				Contains("unmerged").IsSelected(),
+				Contains("merged"),
+			).
+			Press(keys.Universal.Remove).
+			Tap(func() {
+				t.ExpectPopup().Menu().
+					Title(Equals("Delete branch 'unmerged'?")).
+					Select(Contains("Delete local branch")).
+					Confirm()
+
+				t.ExpectPopup().Confirmation().
+					Title(Equals("Delete branch 'unmerged'?")).
+					Content(Equals("Are you sure you want to delete the branch 'unmerged'?")).
+					Confirm()
+
+				t.ExpectPopup().Prompt().
+					Title(Equals("Force delete branch (type 'unmerged' to confirm)")).
+					Type("unmerged").
+					Confirm()
+			}).
+			Lines(
+				Contains("master"),
+				Contains("merged").IsSelected(),
+			)
+	},
+})
@@ -0,0 +1,51 @@
+package branch
+
+import (
+	"github.com/jesseduffield/lazygit/pkg/config"
+	. "github.com/jesseduffield/lazygit/pkg/integration/components"
+	"github.com/jesseduffield/lazygit/pkg/integration/tests/shared"
+)
+
+var MergeStatusOverview = NewIntegrationTest(NewIntegrationTestArgs{
+	Description:  "Show the details of a merge with conflicts in the status panel, and continue it from there once the conflicts are resolved",
+	ExtraCmdArgs: []string{},
+	Skip:         false,
+	SetupConfig:  func(config *config.AppConfig) {},
+	SetupRepo: func(shell *Shell) {
+		shared.CreateMergeConflictFile(shell)
+	},
+	Run: func(t *TestDriver, keys config.KeybindingConfig) {
+		t.Views().Status().
+			Content(Contains("(merging)")).
+			Focus()
+
+		t.Views().Main().
+			Title(Equals("Merging")).
+			Content(
+				Contains("Merging in").Contains("second-change-branch unrelated change").
+					Contains("Message").Contains("Merge branch 'second-change-branch' into first-change-branch").
+					Contains("Press c to continue or A to abort."),
+			)
+
+		t.Views().Status().
+			Press(keys.Status.SkipOperationStep)
+
+		t.ExpectToast(Equals("Disabled: A merge can only be continued or aborted"))
+
+		t.Shell().UpdateFileAndAdd("file", shared.SecondChangeFileContent)
+
+		t.Views().Status().
+			Press(keys.Status.ContinueOperation)
+
+		t.Views().Status().
+			Content(DoesNotContain("(merging)"))
+
+		t.Views().Main().
+			Title(Equals("Status"))
+
+		t.Views().Commits().
+			TopLines(
+				Contains("Merge branch 'second-change-branch' into first-change-branch"),
+			)
+	},
+})
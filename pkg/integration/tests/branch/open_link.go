@@ -0,0 +1,34 @@
+package branch
+
+import (
+	"github.com/jesseduffield/lazygit/pkg/config"
+	. "github.com/jesseduffield/lazygit/pkg/integration/components"
+)
+
+var OpenLink = NewIntegrationTest(NewIntegrationTestArgs{
+	Description:  "Open an issue reference from the name of the selected branch, using a configured issue link pattern",
+	ExtraCmdArgs: []string{},
+	Skip:         false,
+	SetupConfig: func(cfg *config.AppConfig) {
+		cfg.UserConfig.OS.OpenLink = "printf '%s' {{link}} > opened-link"
+		cfg.UserConfig.Git.IssueLinks = []config.IssueLinkConfig{
+			{Pattern: `\bJIRA-(\d+)\b`, Url: "https://jira.example.com/browse/JIRA-$1"},
+		}
+	},
+	SetupRepo: func(shell *Shell) {
+		shell.EmptyCommit("initial commit")
+		shell.NewBranch("feature/JIRA-456-login")
+	},
+	Run: func(t *TestDriver, keys config.KeybindingConfig) {
+		t.Views().Branches().
+			Focus().
+			Lines(
+				Contains("feature/JIRA-456-login").IsSelected(),
+				Contains("master"),
+			).
+			Press(keys.Branches.OpenLink)
+
+		// with a single link there's no need for a menu
+		t.FileSystem().FileContent("opened-link", Equals("https://jira.example.com/browse/JIRA-456"))
+	},
+})
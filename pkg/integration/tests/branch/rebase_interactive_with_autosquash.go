@@ -0,0 +1,64 @@
+package branch
+
+import (
+	"github.com/jesseduffield/lazygit/pkg/config"
+	. "github.com/jesseduffield/lazygit/pkg/integration/components"
+)
+
+var RebaseInteractiveWithAutosquash = NewIntegrationTest(NewIntegrationTestArgs{
+	Description:  "Enable autosquash in the rebase menu and start an interactive rebase, which moves fixups next to their targets",
+	ExtraCmdArgs: []string{},
+	Skip:         false,
+	SetupConfig:  func(config *config.AppConfig) {},
+	SetupRepo: func(shell *Shell) {
+		shell.
+			EmptyCommit("base").
+			NewBranch("my-branch").
+			Checkout("master").
+			EmptyCommit("master commit").
+			Checkout("my-branch").
+			EmptyCommit("branch commit").
+			EmptyCommit("other commit").
+			EmptyCommit("fixup! branch commit")
+	},
+	Run: func(t *TestDriver, keys config.KeybindingConfig) {
+		t.Views().Branches().
+			Focus().
+			Lines(
+				Contains("my-branch").IsSelected(),
+				Contains("master"),
+			).
+			SelectNextItem().
+			Press(keys.Branches.RebaseBranch)
+
+		t.ExpectPopup().Menu().
+			Title(Equals("Rebase 'my-branch' onto 'master'")).
+			Select(Contains("Enable autosquash for interactive rebases")).
+			Confirm()
+
+		t.ExpectPopup().Menu().
+			Title(Equals("Rebase 'my-branch' onto 'master'")).
+			Select(Contains("Interactive rebase with autosquash")).
+			Confirm()
+
+		t.Views().Commits().
+			IsFocused().
+			Lines(
+				Contains("pick").Contains("other commit"),
+				Contains("fixup").Contains("↳").Contains("fixup! branch commit"),
+				Contains("pick").Contains("branch commit"),
+				Contains("<-- YOU ARE HERE --- master commit"),
+				Contains("base"),
+			)
+
+		t.Common().ContinueRebase()
+
+		t.Views().Commits().
+			Lines(
+				Contains("other commit"),
+				Contains("branch commit"),
+				Contains("master commit"),
+				Contains("base"),
+			)
+	},
+})
@@ -0,0 +1,62 @@
+package branch
+
+import (
+	"github.com/jesseduffield/lazygit/pkg/config"
+	. "github.com/jesseduffield/lazygit/pkg/integration/components"
+)
+
+var RebaseOntoCommit = NewIntegrationTest(NewIntegrationTestArgs{
+	Description:  "Rebase the checked-out branch onto a commit selected in another branch's commits",
+	ExtraCmdArgs: []string{},
+	Skip:         false,
+	SetupConfig:  func(config *config.AppConfig) {},
+	SetupRepo: func(shell *Shell) {
+		shell.
+			NewBranch("base-branch").
+			EmptyCommit("one").
+			EmptyCommit("two").
+			NewBranch("active-branch").
+			EmptyCommit("active one").
+			EmptyCommit("active two").
+			Checkout("base-branch").
+			NewBranch("target-branch").
+			EmptyCommit("target one").
+			EmptyCommit("target two").
+			Checkout("active-branch")
+	},
+	Run: func(t *TestDriver, keys config.KeybindingConfig) {
+		t.Views().Branches().
+			Focus().
+			Lines(
+				Contains("active-branch").IsSelected(),
+				Contains("target-branch"),
+				Contains("base-branch"),
+			).
+			SelectNextItem().
+			PressEnter()
+
+		t.Views().SubCommits().
+			IsFocused().
+			Lines(
+				Contains("target two").IsSelected(),
+				Contains("target one"),
+				Contains("two"),
+				Contains("one"),
+			).
+			SelectNextItem().
+			Press(keys.Commits.RebaseOntoCommit)
+
+		t.ExpectPopup().Menu().
+			Title(MatchesRegexp(`Rebase 'active-branch' onto '[0-9a-f]+'`)).
+			Select(Contains("Simple rebase")).
+			Confirm()
+
+		t.Views().Commits().Lines(
+			Contains("active two"),
+			Contains("active one"),
+			Contains("target one"),
+			Contains("two"),
+			Contains("one"),
+		)
+	},
+})
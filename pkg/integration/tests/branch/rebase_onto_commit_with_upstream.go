@@ -0,0 +1,56 @@
+package branch
+
+import (
+	"github.com/jesseduffield/lazygit/pkg/config"
+	. "github.com/jesseduffield/lazygit/pkg/integration/components"
+)
+
+var RebaseOntoCommitWithUpstream = NewIntegrationTest(NewIntegrationTestArgs{
+	Description:  "Rebase the checked-out branch onto a commit, only moving the commits after a given upstream",
+	ExtraCmdArgs: []string{},
+	Skip:         false,
+	SetupConfig:  func(config *config.AppConfig) {},
+	SetupRepo: func(shell *Shell) {
+		shell.
+			NewBranch("base-branch").
+			EmptyCommit("base one").
+			EmptyCommit("base two").
+			NewBranch("feature-branch").
+			EmptyCommit("feature one").
+			EmptyCommit("feature two").
+			NewBranch("active-branch").
+			EmptyCommit("active one").
+			EmptyCommit("active two")
+	},
+	Run: func(t *TestDriver, keys config.KeybindingConfig) {
+		t.Views().Commits().
+			Focus().
+			Lines(
+				Contains("active two").IsSelected(),
+				Contains("active one"),
+				Contains("feature two"),
+				Contains("feature one"),
+				Contains("base two"),
+				Contains("base one"),
+			).
+			NavigateToLine(Contains("base two")).
+			Press(keys.Commits.RebaseOntoCommit)
+
+		t.ExpectPopup().Menu().
+			Title(MatchesRegexp(`Rebase 'active-branch' onto '[0-9a-f]+'`)).
+			Select(Contains("Rebase onto, choosing which commits to move")).
+			Confirm()
+
+		t.ExpectPopup().Prompt().
+			Title(MatchesRegexp(`Move the commits after this ref onto '[0-9a-f]+':`)).
+			Type("feature-branch").
+			ConfirmFirstSuggestion()
+
+		t.Views().Commits().Lines(
+			Contains("active two"),
+			Contains("active one"),
+			Contains("base two"),
+			Contains("base one"),
+		)
+	},
+})
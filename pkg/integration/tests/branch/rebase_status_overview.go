@@ -0,0 +1,54 @@
+package branch
+
+import (
+	"github.com/jesseduffield/lazygit/pkg/config"
+	. "github.com/jesseduffield/lazygit/pkg/integration/components"
+	"github.com/jesseduffield/lazygit/pkg/integration/tests/shared"
+)
+
+var RebaseStatusOverview = NewIntegrationTest(NewIntegrationTestArgs{
+	Description:  "Show the details of a rebase that stopped at a conflict in the status panel, and abort it from there",
+	ExtraCmdArgs: []string{},
+	Skip:         false,
+	SetupConfig:  func(config *config.AppConfig) {},
+	SetupRepo: func(shell *Shell) {
+		shared.MergeConflictsSetup(shell)
+
+		shell.RunCommandExpectError([]string{"git", "rebase", "second-change-branch"})
+	},
+	Run: func(t *TestDriver, keys config.KeybindingConfig) {
+		t.Views().Status().
+			Content(Contains("(rebasing)")).
+			Focus()
+
+		t.Views().Main().
+			Title(Equals("Rebasing")).
+			Content(
+				Contains("Branch").Contains("first-change-branch").
+					Contains("Onto").Contains("second-change-branch unrelated change").
+					Contains("Progress").Contains("1/1").
+					Contains("Stopped at").Contains("first change").
+					Contains("Started by").Contains("rebase (start): checkout second-change-branch").
+					Contains("Press c to continue, s to skip the current commit or A to abort."),
+			)
+
+		t.Views().Status().
+			Press(keys.Status.AbortOperation)
+
+		t.ExpectPopup().Confirmation().
+			Title(Equals("Abort rebase")).
+			Content(Equals("Are you sure you want to abort the current rebase?")).
+			Confirm()
+
+		t.Views().Status().
+			Content(DoesNotContain("(rebasing)"))
+
+		t.Views().Main().
+			Title(Equals("Status"))
+
+		t.Views().Status().
+			Press(keys.Status.AbortOperation)
+
+		t.ExpectToast(Equals("Disabled: There is no rebase, merge, cherry-pick, revert or bisect in progress"))
+	},
+})
@@ -0,0 +1,71 @@
+package branch
+
+import (
+	"github.com/jesseduffield/lazygit/pkg/config"
+	. "github.com/jesseduffield/lazygit/pkg/integration/components"
+)
+
+var RenameOnRemote = NewIntegrationTest(NewIntegrationTestArgs{
+	Description:  "Rename a branch both locally and on its remote, and check that it tracks the renamed remote branch",
+	ExtraCmdArgs: []string{},
+	Skip:         false,
+	SetupConfig:  func(config *config.AppConfig) {},
+	SetupRepo: func(shell *Shell) {
+		shell.
+			EmptyCommit("one").
+			CloneIntoRemote("origin").
+			NewBranch("feature").
+			EmptyCommit("two").
+			PushBranch("origin", "feature").
+			Checkout("master")
+	},
+	Run: func(t *TestDriver, keys config.KeybindingConfig) {
+		t.Views().Branches().
+			Focus().
+			Lines(
+				Contains("master").IsSelected(),
+				Contains("feature"),
+			).
+			SelectNextItem().
+			Press(keys.Branches.RenameBranch).
+			Tap(func() {
+				t.ExpectPopup().Menu().
+					Title(Equals("Rename branch")).
+					Select(Contains("Rename local and remote branch")).
+					Confirm()
+
+				t.ExpectPopup().Prompt().
+					Title(Contains("Enter new branch name")).
+					InitialText(Equals("feature")).
+					Clear().
+					Type("renamed").
+					Confirm()
+
+				t.ExpectPopup().Confirmation().
+					Title(Equals("Rename local and remote branch")).
+					Content(
+						Contains("local: refs/heads/feature → refs/heads/renamed").
+							Contains("origin: refs/heads/feature → refs/heads/renamed").
+							Contains("upstream: origin/feature → origin/renamed"),
+					).
+					Confirm()
+			}).
+			Lines(
+				Contains("master"),
+				Contains("renamed ✓").IsSelected(),
+			)
+
+		t.Git().RemoteRefExists("origin", "refs/heads/renamed")
+
+		t.Views().Remotes().
+			Focus().
+			Lines(Contains("origin")).
+			PressEnter()
+
+		t.Views().RemoteBranches().
+			Lines(
+				Equals("master"),
+				Equals("renamed"),
+			)
+	},
+})
@@ -0,0 +1,62 @@
+package branch
+
+import (
+	"github.com/jesseduffield/lazygit/pkg/config"
+	. "github.com/jesseduffield/lazygit/pkg/integration/components"
+)
+
+var RenameOnRemoteLocalCollision = NewIntegrationTest(NewIntegrationTestArgs{
+	Description:  "Renaming a branch locally and on its remote to the name of another local branch fails before the remote branch is renamed",
+	ExtraCmdArgs: []string{},
+	Skip:         false,
+	SetupConfig:  func(config *config.AppConfig) {},
+	SetupRepo: func(shell *Shell) {
+		shell.
+			EmptyCommit("one").
+			CloneIntoRemote("origin").
+			NewBranch("feature").
+			EmptyCommit("two").
+			PushBranch("origin", "feature").
+			NewBranch("taken").
+			Checkout("master")
+	},
+	Run: func(t *TestDriver, keys config.KeybindingConfig) {
+		t.Views().Branches().
+			Focus().
+			Lines(
+				Contains("master").IsSelected(),
+				Contains("taken"),
+				Contains("feature"),
+			).
+			NavigateToLine(Contains("feature")).
+			Press(keys.Branches.RenameBranch).
+			Tap(func() {
+				t.ExpectPopup().Menu().
+					Title(Equals("Rename branch")).
+					Select(Contains("Rename local and remote branch")).
+					Confirm()
+
+				t.ExpectPopup().Prompt().
+					Title(Contains("Enter new branch name")).
+					Clear().
+					Type("taken").
+					Confirm()
+
+				t.ExpectPopup().Alert().
+					Title(Equals("Error")).
+					Content(Equals("Branch 'taken' already exists")).
+					Confirm()
+			})
+
+		t.Views().Remotes().
+			Focus().
+			Lines(Contains("origin")).
+			PressEnter()
+
+		t.Views().RemoteBranches().
+			Lines(
+				Equals("feature"),
+				Equals("master"),
+			)
+	},
+})
@@ -0,0 +1,41 @@
+package branch
+
+import (
+	"github.com/jesseduffield/lazygit/pkg/config"
+	. "github.com/jesseduffield/lazygit/pkg/integration/components"
+)
+
+var ToggleDateDisplay = NewIntegrationTest(NewIntegrationTestArgs{
+	Description:  "Toggle between relative and absolute dates in the branches view",
+	ExtraCmdArgs: []string{},
+	Skip:         false,
+	SetupConfig: func(config *config.AppConfig) {
+		config.GetAppState().LocalBranchSortOrder = "date"
+		config.UserConfig.Gui.TimeFormat = "2006-01-02"
+	},
+	SetupRepo: func(shell *Shell) {
+		shell.
+			EmptyCommit("commit").
+			NewBranch("first").
+			EmptyCommitWithDate("commit", "2023-04-07 10:00:00").
+			Checkout("master")
+	},
+	Run: func(t *TestDriver, keys config.KeybindingConfig) {
+		t.Views().Branches().
+			Focus().
+			Lines(
+				Contains("master").IsSelected(),
+				MatchesRegexp(`^\s*\d+[ywM]\s+first`),
+			).
+			Press(keys.Universal.ToggleDateDisplay).
+			Lines(
+				Contains("master").IsSelected(),
+				Contains("2023-04-07 first"),
+			).
+			Press(keys.Universal.ToggleDateDisplay).
+			Lines(
+				Contains("master").IsSelected(),
+				MatchesRegexp(`^\s*\d+[ywM]\s+first`),
+			)
+	},
+})
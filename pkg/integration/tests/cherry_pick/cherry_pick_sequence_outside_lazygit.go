@@ -0,0 +1,75 @@
+package cherry_pick
+
+import (
+	"github.com/jesseduffield/lazygit/pkg/config"
+	. "github.com/jesseduffield/lazygit/pkg/integration/components"
+)
+
+var CherryPickSequenceOutsideLazygit = NewIntegrationTest(NewIntegrationTestArgs{
+	Description:  "Show the progress of a cherry-pick of several commits that stopped at a conflict, and continue it once the conflict is resolved",
+	ExtraCmdArgs: []string{},
+	Skip:         false,
+	SetupConfig:  func(config *config.AppConfig) {},
+	SetupRepo: func(shell *Shell) {
+		shell.
+			CreateFileAndAdd("file", "original\n").
+			Commit("base").
+			NewBranch("other").
+			CreateFileAndAdd("file1", "one\n").
+			Commit("one").
+			UpdateFileAndAdd("file", "changed on other\n").
+			Commit("two").
+			CreateFileAndAdd("file3", "three\n").
+			Commit("three").
+			Checkout("master").
+			UpdateFileAndAdd("file", "changed on master\n").
+			Commit("master change").
+			RunCommandExpectError([]string{"git", "cherry-pick", "master..other"})
+	},
+	Run: func(t *TestDriver, keys config.KeybindingConfig) {
+		t.Views().Status().
+			Content(Contains("(cherry-picking)"))
+
+		t.Views().Status().
+			Focus()
+
+		t.Views().Main().
+			Title(Equals("Cherry-picking")).
+			Content(
+				Contains("Done").
+					Contains("✓").Contains("one").
+					Contains("Current").
+					Contains("✗").Contains("two").
+					Contains("Remaining").
+					Contains("three"),
+			)
+
+		t.Views().Files().
+			Focus().
+			Lines(
+				Contains("UU").Contains("file"),
+			).
+			PressEnter()
+
+		t.Views().MergeConflicts().
+			IsFocused().
+			SelectNextItem().
+			PressPrimaryAction()
+
+		t.Common().ContinueOnConflictsResolved()
+
+		t.Views().Status().
+			Content(DoesNotContain("(cherry-picking)"))
+
+		t.Views().Commits().
+			Lines(
+				Contains("three"),
+				Contains("two"),
+				Contains("one"),
+				Contains("master change"),
+				Contains("base"),
+			)
+
+		t.FileSystem().FileContent("file", Equals("changed on other\n"))
+	},
+})
@@ -0,0 +1,45 @@
+package commit
+
+import (
+	"github.com/jesseduffield/lazygit/pkg/config"
+	. "github.com/jesseduffield/lazygit/pkg/integration/components"
+)
+
+var ClickLinkInMainView = NewIntegrationTest(NewIntegrationTestArgs{
+	Description:  "Open a link by clicking it in the commit message shown in the main view",
+	ExtraCmdArgs: []string{},
+	Skip:         false,
+	SetupConfig: func(config *config.AppConfig) {
+		config.UserConfig.OS.OpenLink = "printf '%s' {{link}} > opened-link"
+	},
+	SetupRepo: func(shell *Shell) {
+		shell.RunCommand([]string{"git", "remote", "add", "origin", "https://github.com/owner/repo"})
+		shell.CreateFileAndAdd("file", "color: #123456;\n")
+		shell.Commit("Fix crash\n\n- see #12")
+	},
+	Run: func(t *TestDriver, keys config.KeybindingConfig) {
+		t.Views().Commits().
+			Focus().
+			Lines(
+				Contains("Fix crash").IsSelected(),
+			)
+
+		t.Views().Main().
+			Content(
+				Contains("Author: CI <CI@example.com>").
+					Contains("    Fix crash\n    \n    • see #12\n\n file | 1 +").
+					Contains("+color: #123456;"),
+			)
+
+		// clicking what looks like an issue reference in the patch does nothing
+		t.Views().Main().
+			ClickAndRelease(8, 17)
+
+		t.FileSystem().PathNotPresent("opened-link")
+
+		t.Views().Main().
+			ClickAndRelease(11, 6)
+
+		t.FileSystem().FileContent("opened-link", Equals("https://github.com/owner/repo/issues/12"))
+	},
+})
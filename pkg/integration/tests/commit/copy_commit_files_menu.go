@@ -0,0 +1,89 @@
+package commit
+
+import (
+	"github.com/jesseduffield/lazygit/pkg/config"
+	. "github.com/jesseduffield/lazygit/pkg/integration/components"
+)
+
+var CopyCommitFilesMenu = NewIntegrationTest(NewIntegrationTestArgs{
+	Description:  "Copy the paths and diffs of a commit's files from the copy menu",
+	ExtraCmdArgs: []string{},
+	Skip:         false,
+	SetupConfig: func(config *config.AppConfig) {
+		config.UserConfig.OS.CopyToClipboardCmd = "echo {{text}} > clipboard"
+	},
+	SetupRepo: func(shell *Shell) {
+		shell.CreateFileAndAdd("dir/file", "file content\n")
+		shell.CreateFileAndAdd("top", "top content\n")
+		shell.Commit("first commit")
+	},
+	Run: func(t *TestDriver, keys config.KeybindingConfig) {
+		expectClipboard := func(matcher *TextMatcher) {
+			t.FileSystem().FileContent("clipboard", matcher)
+			t.Shell().DeleteFile("clipboard")
+		}
+
+		t.Views().Commits().
+			Focus().
+			Lines(
+				Contains("first commit").IsSelected(),
+			).
+			PressEnter()
+
+		t.Views().CommitFiles().
+			IsFocused().
+			Lines(
+				Contains("▼ dir").IsSelected(),
+				Contains("file"),
+				Contains("top"),
+			).
+			NavigateToLine(Contains("file")).
+			Press(keys.Files.CopyFileInfoToClipboard).
+			Tap(func() {
+				t.ExpectPopup().Menu().
+					Title(Equals("Copy to clipboard")).
+					Select(Contains("Absolute path")).
+					Confirm()
+
+				t.ExpectToast(Equals("Absolute path copied to clipboard"))
+				expectClipboard(Contains("/repo/dir/file"))
+			}).
+			Press(keys.Files.CopyFileInfoToClipboard).
+			Tap(func() {
+				t.ExpectPopup().Menu().
+					Title(Equals("Copy to clipboard")).
+					Select(Contains("Directory")).
+					Confirm()
+
+				t.ExpectToast(Equals("Directory copied to clipboard"))
+				expectClipboard(Equals("dir\n"))
+			}).
+			Press(keys.Files.CopyFileInfoToClipboard).
+			Tap(func() {
+				t.ExpectPopup().Menu().
+					Title(Equals("Copy to clipboard")).
+					Select(Contains("Diff of selected file")).
+					Confirm()
+
+				t.ExpectToast(Equals("File diff copied to clipboard"))
+				expectClipboard(Contains("+file content").DoesNotContain("+top content"))
+			}).
+			NavigateToLine(Contains("top")).
+			Press(keys.Files.CopyFileInfoToClipboard).
+			Tap(func() {
+				t.ExpectPopup().Menu().
+					Title(Equals("Copy to clipboard")).
+					Select(Contains("Directory")).
+					Tooltip(Equals("Disabled: This is at the top level of the repository")).
+					Confirm().
+					Tap(func() {
+						t.ExpectToast(Equals("Disabled: This is at the top level of the repository"))
+					}).
+					Select(Contains("Diff of all files")).
+					Confirm()
+
+				t.ExpectToast(Equals("All files diff copied to clipboard"))
+				expectClipboard(Contains("+file content").Contains("+top content"))
+			})
+	},
+})
@@ -0,0 +1,51 @@
+package commit
+
+import (
+	"github.com/jesseduffield/lazygit/pkg/config"
+	. "github.com/jesseduffield/lazygit/pkg/integration/components"
+)
+
+var CopyToClipboard = NewIntegrationTest(NewIntegrationTestArgs{
+	Description:  "Copy the abbreviated SHA and a commit reference of a commit, honouring the configured hash length",
+	ExtraCmdArgs: []string{},
+	Skip:         false,
+	SetupConfig: func(config *config.AppConfig) {
+		// simulate the clipboard during CI
+		config.UserConfig.OS.CopyToClipboardCmd = "printf '%s' {{text}} > clipboard"
+		config.UserConfig.Gui.CommitHashLength = 12
+	},
+	SetupRepo: func(shell *Shell) {
+		shell.EmptyCommit("one")
+		shell.EmptyCommit("two")
+	},
+	Run: func(t *TestDriver, keys config.KeybindingConfig) {
+		t.Views().Commits().
+			Focus().
+			Lines(
+				MatchesRegexp(`^[0-9a-f]{12} CI two`).IsSelected(),
+				MatchesRegexp(`^[0-9a-f]{12} CI one`),
+			).
+			Press(keys.Commits.CopyCommitAttributeToClipboard).
+			Tap(func() {
+				t.ExpectPopup().Menu().
+					Title(Equals("Copy to clipboard")).
+					Select(Contains("Abbreviated commit SHA")).
+					Confirm()
+
+				t.ExpectToast(Equals("Commit SHA copied to clipboard"))
+				t.FileSystem().FileContent("clipboard", MatchesRegexp(`^[0-9a-f]{12}$`))
+				t.Shell().DeleteFile("clipboard")
+			}).
+			Press(keys.Commits.CopyCommitAttributeToClipboard).
+			Tap(func() {
+				t.ExpectPopup().Menu().
+					Title(Equals("Copy to clipboard")).
+					Select(Contains("Commit reference")).
+					Confirm()
+
+				t.ExpectToast(Equals("Commit reference copied to clipboard"))
+				t.FileSystem().FileContent("clipboard", MatchesRegexp(`^[0-9a-f]{12} \("two"\)$`))
+				t.Shell().DeleteFile("clipboard")
+			})
+	},
+})
@@ -0,0 +1,98 @@
+package commit
+
+import (
+	"github.com/jesseduffield/lazygit/pkg/config"
+	. "github.com/jesseduffield/lazygit/pkg/integration/components"
+)
+
+var EditTrailers = NewIntegrationTest(NewIntegrationTestArgs{
+	Description:  "Add and remove trailers from the commit message panel, picking their values from suggestions",
+	ExtraCmdArgs: []string{},
+	Skip:         false,
+	SetupConfig: func(cfg *config.AppConfig) {
+		cfg.UserConfig.Git.Commit.Trailers = []config.CommitTrailerConfig{
+			{Key: "Reviewed-by", SuggestAuthors: true},
+			{Key: "Ticket", Suggestions: []string{"ABC-1", "ABC-2"}},
+		}
+	},
+	SetupRepo: func(shell *Shell) {
+		shell.SetAuthor("Jane Doe", "jane@example.com")
+		shell.EmptyCommit("initial commit")
+		shell.CreateFile("myfile", "myfile content")
+	},
+	Run: func(t *TestDriver, keys config.KeybindingConfig) {
+		t.Views().Files().
+			IsFocused().
+			PressPrimaryAction().
+			Press(keys.Files.CommitChanges)
+
+		t.ExpectPopup().CommitMessagePanel().
+			Type("my commit").
+			SwitchToDescription().
+			Type("Some body").
+			EditTrailers()
+
+		t.ExpectPopup().Menu().
+			Title(Equals("Add/remove trailers")).
+			Lines(
+				Contains("Add Reviewed-by").IsSelected(),
+				Contains("Add Ticket"),
+				Contains("Cancel"),
+			).
+			Confirm()
+
+		t.ExpectPopup().Prompt().
+			Title(Equals("Reviewed-by")).
+			Type("Jane").
+			SuggestionLines(
+				Contains("Jane Doe <jane@example.com>"),
+			).
+			ConfirmFirstSuggestion()
+
+		t.ExpectPopup().CommitDescriptionPanel().
+			Content(Equals("Some body\n\nReviewed-by: Jane Doe <jane@example.com>")).
+			EditTrailers()
+
+		t.ExpectPopup().Menu().
+			Title(Equals("Add/remove trailers")).
+			Select(Contains("Add Ticket")).
+			Confirm()
+
+		t.ExpectPopup().Prompt().
+			Title(Equals("Ticket")).
+			SuggestionLines(
+				Contains("ABC-1"),
+				Contains("ABC-2"),
+			).
+			ConfirmSuggestion(Contains("ABC-2"))
+
+		t.ExpectPopup().CommitDescriptionPanel().
+			Content(Equals("Some body\n\nReviewed-by: Jane Doe <jane@example.com>\nTicket: ABC-2")).
+			EditTrailers()
+
+		t.ExpectPopup().Menu().
+			Title(Equals("Add/remove trailers")).
+			Select(Contains("Remove 'Reviewed-by: Jane Doe <jane@example.com>'")).
+			Confirm()
+
+		t.ExpectPopup().CommitDescriptionPanel().
+			Content(Equals("Some body\n\nTicket: ABC-2")).
+			SwitchToSummary().
+			Confirm()
+
+		t.Views().Commits().
+			Focus().
+			Lines(
+				Contains("my commit").IsSelected(),
+				Contains("initial commit"),
+			)
+
+		t.Views().Main().ContainsLines(
+			Contains("my commit"),
+			Contains(""),
+			Contains("Some body"),
+			Contains(""),
+			Contains("Ticket: ABC-2"),
+		)
+	},
+})
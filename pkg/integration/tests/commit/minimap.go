@@ -0,0 +1,44 @@
+package commit
+
+import (
+	"github.com/jesseduffield/lazygit/pkg/config"
+	. "github.com/jesseduffield/lazygit/pkg/integration/components"
+)
+
+var Minimap = NewIntegrationTest(NewIntegrationTestArgs{
+	Description:  "Show an overview of the commits list beside the commits panel which follows the scroll position",
+	ExtraCmdArgs: []string{},
+	Skip:         false,
+	SetupConfig: func(config *config.AppConfig) {
+		config.UserConfig.Gui.CommitsMinimap = "branch"
+	},
+	SetupRepo: func(shell *Shell) {
+		shell.CreateNCommits(60)
+	},
+	Run: func(t *TestDriver, keys config.KeybindingConfig) {
+		t.Views().Commits().
+			Focus().
+			SelectedLine(Contains("commit 60"))
+
+		t.Views().CommitsMinimap().
+			Content(MatchesRegexp(`(?s)^█.*▌\s*$`))
+
+		t.Views().Commits().
+			NavigateToLine(Contains("commit 01"))
+
+		t.Views().CommitsMinimap().
+			Content(MatchesRegexp(`(?s)^▌.*█\s*$`))
+
+		// the minimap follows the commits list when it's refreshed
+		t.Views().Commits().
+			NavigateToLine(Contains("commit 60"))
+
+		t.Shell().HardReset("HEAD~57")
+		t.Views().Files().
+			Focus().
+			Press(keys.Universal.Refresh)
+
+		t.Views().CommitsMinimap().
+			Content(Equals("█\n█\n█"))
+	},
+})
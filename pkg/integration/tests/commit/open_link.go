@@ -0,0 +1,49 @@
+package commit
+
+import (
+	"github.com/jesseduffield/lazygit/pkg/config"
+	. "github.com/jesseduffield/lazygit/pkg/integration/components"
+)
+
+var OpenLink = NewIntegrationTest(NewIntegrationTestArgs{
+	Description:  "Open an issue reference or URL from the message of the selected commit",
+	ExtraCmdArgs: []string{},
+	Skip:         false,
+	SetupConfig: func(config *config.AppConfig) {
+		config.UserConfig.OS.OpenLink = "printf '%s' {{link}} > opened-link"
+	},
+	SetupRepo: func(shell *Shell) {
+		shell.RunCommand([]string{"git", "remote", "add", "origin", "https://github.com/owner/repo"})
+		shell.EmptyCommit("first commit")
+		shell.EmptyCommit("Fix crash (#12)\n\nSee https://example.com/crash")
+	},
+	Run: func(t *TestDriver, keys config.KeybindingConfig) {
+		t.Views().Commits().
+			Focus().
+			Lines(
+				Contains("Fix crash (#12)").IsSelected(),
+				Contains("first commit"),
+			).
+			Press(keys.Commits.OpenLink)
+
+		t.ExpectPopup().Menu().
+			Title(Equals("Open link")).
+			Lines(
+				Contains("#12").Contains("https://github.com/owner/repo/issues/12").IsSelected(),
+				Contains("https://example.com/crash"),
+				Contains("Cancel"),
+			).
+			Confirm()
+
+		t.FileSystem().FileContent("opened-link", Equals("https://github.com/owner/repo/issues/12"))
+
+		t.Views().Commits().
+			NavigateToLine(Contains("first commit")).
+			Press(keys.Commits.OpenLink)
+
+		t.ExpectPopup().Alert().
+			Title(Equals("Error")).
+			Content(Equals("No URLs or issue references found")).
+			Confirm()
+	},
+})
@@ -0,0 +1,59 @@
+package commit
+
+import (
+	"github.com/jesseduffield/lazygit/pkg/config"
+	. "github.com/jesseduffield/lazygit/pkg/integration/components"
+)
+
+var Peek = NewIntegrationTest(NewIntegrationTestArgs{
+	Description:  "Peek at the message and diffstat of the selected commit without leaving the commits view",
+	ExtraCmdArgs: []string{},
+	Skip:         false,
+	SetupConfig:  func(config *config.AppConfig) {},
+	SetupRepo: func(shell *Shell) {
+		shell.CreateFileAndAdd("file-one", "one\n")
+		shell.Commit("first commit\n\nwith a description\n\n- a `markdown` list item")
+		shell.CreateFileAndAdd("file-two", "two\n")
+		shell.Commit("second commit")
+	},
+	Run: func(t *TestDriver, keys config.KeybindingConfig) {
+		t.Views().CommitPeek().IsInvisible()
+
+		t.Views().Commits().
+			Focus().
+			Lines(
+				Contains("second commit").IsSelected(),
+				Contains("first commit"),
+			).
+			Press(keys.Commits.PeekCommit)
+
+		t.Views().CommitPeek().
+			IsVisible().
+			Content(Contains("second commit").Contains("file-two | 1 +"))
+
+		// the peek follows the selection while focus stays in the commits view
+		t.Views().Commits().
+			IsFocused().
+			NavigateToLine(Contains("first commit"))
+
+		t.Views().CommitPeek().
+			IsVisible().
+			Content(Contains("first commit").Contains("with a description").Contains("• a markdown list item").Contains("file-one | 1 +"))
+
+		t.Views().Commits().
+			Press(keys.Commits.PeekCommit)
+
+		t.Views().CommitPeek().IsInvisible()
+
+		t.Views().Commits().
+			Press(keys.Commits.PeekCommit)
+
+		t.Views().CommitPeek().IsVisible()
+
+		// leaving the commits view hides the peek
+		t.Views().Files().
+			Focus()
+
+		t.Views().CommitPeek().IsInvisible()
+	},
+})
@@ -0,0 +1,51 @@
+package commit
+
+import (
+	"github.com/jesseduffield/lazygit/pkg/config"
+	. "github.com/jesseduffield/lazygit/pkg/integration/components"
+)
+
+var QuickFixup = NewIntegrationTest(NewIntegrationTestArgs{
+	Description:  "Create a fixup commit for a commit picked from a menu which shows whether the fixup would conflict",
+	ExtraCmdArgs: []string{},
+	Skip:         false,
+	SetupConfig:  func(config *config.AppConfig) {},
+	SetupRepo: func(shell *Shell) {
+		shell.NewBranch("mybranch").
+			EmptyCommit("1st commit").
+			CreateFileAndAdd("file", "one\ntwo\nthree\n").
+			Commit("2nd commit").
+			UpdateFileAndAdd("file", "one\ntwo changed\nthree\n").
+			Commit("3rd commit").
+			UpdateFile("file", "one\ntwo changed again\nthree\n")
+	},
+	Run: func(t *TestDriver, keys config.KeybindingConfig) {
+		t.Views().Files().
+			Focus().
+			Press(keys.Files.QuickFixup)
+
+		t.ExpectPopup().Menu().
+			Title(Equals("Create fixup commit for")).
+			Lines(
+				Contains("3rd commit").Contains("applies cleanly").IsSelected(),
+				Contains("2nd commit").Contains("may conflict"),
+				Contains("1st commit").Contains("may conflict"),
+				Contains("Cancel"),
+			).
+			Select(Contains("2nd commit")).
+			Tooltip(Contains("squashing the fixup into it is likely to cause conflicts")).
+			Select(Contains("3rd commit")).
+			Confirm()
+
+		t.Views().Files().
+			IsEmpty()
+
+		t.Views().Commits().
+			Lines(
+				Contains("fixup! 3rd commit"),
+				Contains("3rd commit"),
+				Contains("2nd commit"),
+				Contains("1st commit"),
+			)
+	},
+})
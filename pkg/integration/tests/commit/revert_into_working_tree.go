@@ -0,0 +1,58 @@
+package commit
+
+import (
+	"github.com/jesseduffield/lazygit/pkg/config"
+	. "github.com/jesseduffield/lazygit/pkg/integration/components"
+)
+
+var RevertIntoWorkingTree = NewIntegrationTest(NewIntegrationTestArgs{
+	Description:  "Reverts a commit into the working tree without creating a revert commit",
+	ExtraCmdArgs: []string{},
+	Skip:         false,
+	SetupConfig:  func(config *config.AppConfig) {},
+	SetupRepo: func(shell *Shell) {
+		shell.CreateFileAndAdd("myfile", "one\n")
+		shell.Commit("first commit")
+		shell.UpdateFileAndAdd("myfile", "one\ntwo\n")
+		shell.CreateFileAndAdd("otherfile", "other\n")
+		shell.Commit("second commit")
+		shell.EmptyCommit("third commit")
+	},
+	Run: func(t *TestDriver, keys config.KeybindingConfig) {
+		t.Views().Commits().
+			Focus().
+			Lines(
+				Contains("third commit").IsSelected(),
+				Contains("second commit"),
+				Contains("first commit"),
+			).
+			Press(keys.Commits.RevertCommitIntoWorkingTree).
+			Tap(func() {
+				t.ExpectPopup().Alert().
+					Title(Equals("Error")).
+					Content(Equals("This commit has no changes to revert")).
+					Confirm()
+			}).
+			NavigateToLine(Contains("second commit")).
+			Press(keys.Commits.RevertCommitIntoWorkingTree)
+
+		t.Views().Files().
+			IsFocused().
+			Lines(
+				Contains(" M").Contains("myfile").IsSelected(),
+				Contains(" D").Contains("otherfile"),
+			)
+
+		t.Views().Main().Content(Contains("-two"))
+
+		t.Views().Commits().
+			Lines(
+				Contains("third commit"),
+				Contains("second commit").IsSelected(),
+				Contains("first commit"),
+			)
+
+		t.FileSystem().FileContent("myfile", Equals("one\n"))
+		t.FileSystem().PathNotPresent("otherfile")
+	},
+})
@@ -0,0 +1,54 @@
+package commit
+
+import (
+	"github.com/jesseduffield/lazygit/pkg/config"
+	. "github.com/jesseduffield/lazygit/pkg/integration/components"
+)
+
+var RevertRange = NewIntegrationTest(NewIntegrationTestArgs{
+	Description:  "Reverts a range of commits, each with its own revert commit",
+	ExtraCmdArgs: []string{},
+	Skip:         false,
+	SetupConfig:  func(config *config.AppConfig) {},
+	SetupRepo: func(shell *Shell) {
+		shell.CreateFileAndAdd("file", "one\n")
+		shell.Commit("first commit")
+		shell.UpdateFileAndAdd("file", "one\ntwo\n")
+		shell.Commit("second commit")
+		shell.UpdateFileAndAdd("file", "one\ntwo\nthree\n")
+		shell.Commit("third commit")
+		shell.CreateFileAndAdd("other", "other\n")
+		shell.Commit("fourth commit")
+	},
+	Run: func(t *TestDriver, keys config.KeybindingConfig) {
+		t.Views().Commits().
+			Focus().
+			Lines(
+				Contains("fourth commit").IsSelected(),
+				Contains("third commit"),
+				Contains("second commit"),
+				Contains("first commit"),
+			).
+			NavigateToLine(Contains("third commit")).
+			Press(keys.Universal.ToggleRangeSelect).
+			SelectNextItem().
+			Press(keys.Commits.RevertCommit).
+			Tap(func() {
+				t.ExpectPopup().Menu().
+					Title(Equals("Revert 2 commits")).
+					Select(Contains("Revert each commit separately")).
+					Confirm()
+			}).
+			Lines(
+				Contains(`Revert "second commit"`),
+				Contains(`Revert "third commit"`),
+				Contains("fourth commit"),
+				Contains("third commit"),
+				Contains("second commit").IsSelected(),
+				Contains("first commit"),
+			)
+
+		t.FileSystem().FileContent("file", Equals("one\n"))
+		t.FileSystem().FileContent("other", Equals("other\n"))
+	},
+})
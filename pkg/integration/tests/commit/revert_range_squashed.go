@@ -0,0 +1,52 @@
+package commit
+
+import (
+	"github.com/jesseduffield/lazygit/pkg/config"
+	. "github.com/jesseduffield/lazygit/pkg/integration/components"
+)
+
+var RevertRangeSquashed = NewIntegrationTest(NewIntegrationTestArgs{
+	Description:  "Reverts a range of commits with a single revert commit",
+	ExtraCmdArgs: []string{},
+	Skip:         false,
+	SetupConfig:  func(config *config.AppConfig) {},
+	SetupRepo: func(shell *Shell) {
+		shell.CreateFileAndAdd("file", "one\n")
+		shell.Commit("first commit")
+		shell.UpdateFileAndAdd("file", "one\ntwo\n")
+		shell.Commit("second commit")
+		shell.UpdateFileAndAdd("file", "one\ntwo\nthree\n")
+		shell.Commit("third commit")
+	},
+	Run: func(t *TestDriver, keys config.KeybindingConfig) {
+		t.Views().Commits().
+			Focus().
+			Lines(
+				Contains("third commit").IsSelected(),
+				Contains("second commit"),
+				Contains("first commit"),
+			).
+			Press(keys.Universal.ToggleRangeSelect).
+			SelectNextItem().
+			Press(keys.Commits.RevertCommit).
+			Tap(func() {
+				t.ExpectPopup().Menu().
+					Title(Equals("Revert 2 commits")).
+					Select(Contains("Squash the reverts into a single commit")).
+					Confirm()
+			}).
+			Lines(
+				Contains("Revert 2 commits"),
+				Contains("third commit"),
+				Contains("second commit").IsSelected(),
+				Contains("first commit"),
+			).
+			NavigateToLine(Contains("Revert 2 commits"))
+
+		// the newest commit is reverted first, since the older ones' changes are
+		// built upon by it
+		t.Views().Main().
+			Content(MatchesRegexp(`(?s)This reverts commit \w+ \("third commit"\)\..*This reverts commit \w+ \("second commit"\)\.`))
+		t.FileSystem().FileContent("file", Equals("one\n"))
+	},
+})
@@ -0,0 +1,79 @@
+package commit
+
+import (
+	"github.com/jesseduffield/lazygit/pkg/config"
+	. "github.com/jesseduffield/lazygit/pkg/integration/components"
+)
+
+var commitMsgHook = `#!/bin/bash
+
+if grep -q WIP "$1"; then
+  echo "WIP commits are not allowed" >&2
+  exit 1
+fi
+
+echo "" >> "$1"
+echo "Checked-by: hook" >> "$1"
+`
+
+var RewordWithCommitMsgHook = NewIntegrationTest(NewIntegrationTestArgs{
+	Description:  "Reword a commit that isn't the head commit, running the commit-msg hook on the new message",
+	ExtraCmdArgs: []string{},
+	Skip:         false,
+	SetupConfig:  func(config *config.AppConfig) {},
+	SetupRepo: func(shell *Shell) {
+		shell.EmptyCommit("one")
+		shell.EmptyCommit("two")
+		shell.EmptyCommit("three")
+
+		shell.CreateFile(".git/hooks/commit-msg", commitMsgHook)
+		shell.MakeExecutable(".git/hooks/commit-msg")
+	},
+	Run: func(t *TestDriver, keys config.KeybindingConfig) {
+		t.Views().Commits().
+			Focus().
+			Lines(
+				Contains("three").IsSelected(),
+				Contains("two"),
+				Contains("one"),
+			).
+			NavigateToLine(Contains("two")).
+			Press(keys.Commits.RenameCommit).
+			Tap(func() {
+				t.ExpectPopup().CommitMessagePanel().
+					Clear().
+					Type("two reworded").
+					Confirm()
+			}).
+			Lines(
+				Contains("three"),
+				Contains("two reworded").IsSelected(),
+				Contains("one"),
+			)
+
+		// the hook added its trailer
+		t.Views().Main().Content(Contains("Checked-by: hook"))
+
+		t.Views().Commits().
+			Press(keys.Commits.RenameCommit).
+			Tap(func() {
+				t.ExpectPopup().CommitMessagePanel().
+					Clear().
+					Type("WIP two").
+					Confirm()
+
+				t.ExpectPopup().Alert().
+					Title(Equals("Error")).
+					Content(Contains("WIP commits are not allowed")).
+					Confirm()
+			}).
+			// the rejected reword leaves everything as it was, and we're not stuck in a rebase
+			Lines(
+				Contains("three"),
+				Contains("two reworded").IsSelected(),
+				Contains("one"),
+			)
+
+		t.Views().Information().Content(DoesNotContain("Rebasing"))
+	},
+})
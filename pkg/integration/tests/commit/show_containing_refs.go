@@ -0,0 +1,79 @@
+package commit
+
+import (
+	"github.com/jesseduffield/lazygit/pkg/config"
+	. "github.com/jesseduffield/lazygit/pkg/integration/components"
+)
+
+var ShowContainingRefs = NewIntegrationTest(NewIntegrationTestArgs{
+	Description:  "List the branches and tags containing a commit and jump to one of them",
+	ExtraCmdArgs: []string{},
+	Skip:         false,
+	SetupConfig:  func(config *config.AppConfig) {},
+	SetupRepo: func(shell *Shell) {
+		shell.EmptyCommit("one")
+		shell.CloneIntoRemote("origin")
+		shell.EmptyCommit("two")
+		shell.CreateLightweightTag("v1.0", "HEAD")
+		shell.NewBranch("feature")
+		shell.EmptyCommit("three")
+		shell.Checkout("master")
+		shell.NewBranch("other")
+	},
+	Run: func(t *TestDriver, keys config.KeybindingConfig) {
+		t.Views().Commits().
+			Focus().
+			Lines(
+				Contains("two").IsSelected(),
+				Contains("one"),
+			).
+			Press(keys.Commits.ShowContainingRefs)
+
+		t.ExpectPopup().Menu().
+			Title(Contains("Branches and tags containing")).
+			Lines(
+				Contains("--- Local branches ---"),
+				Contains("feature").IsSelected(),
+				Contains("master"),
+				Contains("other"),
+				Equals(""),
+				Contains("--- Tags ---"),
+				Contains("v1.0"),
+				Contains("Cancel"),
+			).
+			Select(Contains("master")).
+			Confirm()
+
+		t.Views().Branches().
+			IsFocused().
+			SelectedLine(Contains("master"))
+
+		t.Views().Commits().
+			Focus().
+			NavigateToLine(Contains("one")).
+			Press(keys.Commits.ShowContainingRefs)
+
+		t.ExpectPopup().Menu().
+			Title(Contains("Branches and tags containing")).
+			Select(Contains("origin/master")).
+			Confirm()
+
+		t.Views().RemoteBranches().
+			IsFocused().
+			SelectedLine(Contains("master"))
+
+		t.Views().Commits().
+			Focus().
+			SelectedLine(Contains("one")).
+			Press(keys.Commits.ShowContainingRefs)
+
+		t.ExpectPopup().Menu().
+			Title(Contains("Branches and tags containing")).
+			Select(Contains("v1.0")).
+			Confirm()
+
+		t.Views().Tags().
+			IsFocused().
+			SelectedLine(Contains("v1.0"))
+	},
+})
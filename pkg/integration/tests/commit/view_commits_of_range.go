@@ -0,0 +1,100 @@
+package commit
+
+import (
+	"github.com/jesseduffield/lazygit/pkg/config"
+	. "github.com/jesseduffield/lazygit/pkg/integration/components"
+)
+
+var ViewCommitsOfRange = NewIntegrationTest(NewIntegrationTestArgs{
+	Description:  "Browse the commits of a range from the log menu, cherry-picking one and viewing the files of another",
+	ExtraCmdArgs: []string{},
+	Skip:         false,
+	SetupConfig:  func(config *config.AppConfig) {},
+	SetupRepo: func(shell *Shell) {
+		shell.
+			EmptyCommit("base").
+			NewBranch("release").
+			EmptyCommit("release fix").
+			NewBranch("feature").
+			CreateFileAndAdd("feature-file", "content").
+			Commit("feature one").
+			EmptyCommit("feature two").
+			Checkout("master")
+	},
+	Run: func(t *TestDriver, keys config.KeybindingConfig) {
+		t.Views().Commits().
+			Focus().
+			Lines(
+				Contains("base").IsSelected(),
+			).
+			Press(keys.Commits.OpenLogMenu).
+			Tap(func() {
+				t.ExpectPopup().Menu().
+					Title(Equals("Commit Log Options")).
+					Select(Contains("View commits of ref or range")).
+					Confirm()
+
+				t.ExpectPopup().Prompt().
+					Title(Equals("Ref or range (e.g. 'main..feature'):")).
+					Type("nope..feature").
+					Confirm()
+
+				t.ExpectPopup().Alert().
+					Title(Equals("Error")).
+					Content(Contains("nope..feature")).
+					Confirm()
+			}).
+			Press(keys.Commits.OpenLogMenu).
+			Tap(func() {
+				t.ExpectPopup().Menu().
+					Title(Equals("Commit Log Options")).
+					Select(Contains("View commits of ref or range")).
+					Confirm()
+
+				t.ExpectPopup().Prompt().
+					Title(Equals("Ref or range (e.g. 'main..feature'):")).
+					Type("release..feat").
+					SuggestionLines(Equals("release..feature")).
+					ConfirmFirstSuggestion()
+			})
+
+		t.Views().SubCommits().
+			IsFocused().
+			Title(Contains("release..feature")).
+			Lines(
+				Contains("feature two").IsSelected(),
+				Contains("feature one"),
+			).
+			Press(keys.Commits.CherryPickCopy).
+			Tap(func() {
+				t.Views().Information().Content(Contains("1 commit copied"))
+			}).
+			SelectNextItem().
+			PressEnter()
+
+		t.Views().CommitFiles().
+			IsFocused().
+			Lines(
+				Contains("feature-file"),
+			).
+			PressEscape()
+
+		t.Views().SubCommits().
+			IsFocused().
+			PressEscape()
+
+		t.Views().Commits().
+			IsFocused().
+			Press(keys.Commits.PasteCommits).
+			Tap(func() {
+				t.ExpectPopup().Alert().
+					Title(Equals("Cherry-pick")).
+					Content(Contains("Are you sure you want to cherry-pick the copied commits onto this branch?")).
+					Confirm()
+			}).
+			Lines(
+				Contains("feature two"),
+				Contains("base"),
+			)
+	},
+})
@@ -0,0 +1,54 @@
+package config
+
+import (
+	"github.com/jesseduffield/lazygit/pkg/config"
+	. "github.com/jesseduffield/lazygit/pkg/integration/components"
+)
+
+var KeybindingConflicts = NewIntegrationTest(NewIntegrationTestArgs{
+	Description:  "Keys bound to several actions in the same view are mentioned in the command log on startup and can be listed from its menu",
+	ExtraCmdArgs: []string{},
+	Skip:         false,
+	SetupConfig: func(cfg *config.AppConfig) {
+		cfg.UserConfig.Keybinding.Files.CommitChanges = "a"
+		cfg.UserConfig.CustomCommands = []config.CustomCommand{
+			{
+				Key:         "n",
+				Context:     "localBranches",
+				Command:     "echo hello",
+				Description: "Say hello",
+			},
+		}
+	},
+	SetupRepo: func(shell *Shell) {},
+	Run: func(t *TestDriver, keys config.KeybindingConfig) {
+		t.Views().Files().
+			IsFocused()
+
+		t.Views().Extras().
+			Content(Contains("4 key(s) are bound to more than one action in the same view."))
+
+		t.GlobalPress(keys.Universal.ExtrasMenu)
+
+		t.ExpectPopup().Menu().
+			Title(Equals("Command log")).
+			Select(Contains("Show keybinding conflicts")).
+			Confirm()
+
+		t.ExpectPopup().Menu().
+			Title(Equals("Keybinding conflicts")).
+			Lines(
+				Contains("n").Contains("localBranches").Contains("Say hello / New branch"),
+				Contains("a").Contains("files").Contains("Commit changes / Stage/unstage all"),
+				// the staging view has the files view's commit binding too
+				Contains("a").Contains("stagingSecondary").Contains("Toggle select hunk / Commit changes"),
+				Contains("a").Contains("staging").Contains("Toggle select hunk / Commit changes"),
+				Contains("Cancel"),
+			).
+			Tooltip(Contains("Pressing n in localBranches runs 'Say hello'.")).
+			Tooltip(Contains("- keybinding.universal.new\n- customCommands[0].key")).
+			Select(Contains("files")).
+			Tooltip(Contains("- keybinding.files.commitChanges\n- keybinding.files.toggleStagedAll")).
+			Cancel()
+	},
+})
@@ -0,0 +1,46 @@
+package conflicts
+
+import (
+	"github.com/jesseduffield/lazygit/pkg/config"
+	. "github.com/jesseduffield/lazygit/pkg/integration/components"
+	"github.com/jesseduffield/lazygit/pkg/integration/tests/shared"
+)
+
+var AutoContinueOnConflictsResolved = NewIntegrationTest(NewIntegrationTestArgs{
+	Description:  "With onConflictsResolved set to continue, the merge continues without prompting once the last conflict is resolved",
+	ExtraCmdArgs: []string{},
+	Skip:         false,
+	SetupConfig: func(config *config.AppConfig) {
+		config.UserConfig.Git.Merging.OnConflictsResolved = "continue"
+	},
+	SetupRepo: func(shell *Shell) {
+		shared.CreateMergeConflictFile(shell)
+	},
+	Run: func(t *TestDriver, keys config.KeybindingConfig) {
+		t.Views().Files().
+			IsFocused().
+			Lines(
+				Contains("UU").Contains("file").IsSelected(),
+			).
+			PressEnter()
+
+		t.Views().MergeConflicts().
+			IsFocused().
+			SelectedLines(
+				Contains("<<<<<<< HEAD"),
+				Contains("First Change"),
+				Contains("======="),
+			).
+			PressPrimaryAction()
+
+		t.ExpectToast(Equals("All merge conflicts resolved. Continuing the merge"))
+
+		t.Views().Files().
+			IsEmpty()
+
+		t.Views().Commits().
+			TopLines(
+				Contains("Merge branch 'second-change-branch' into first-change-branch"),
+			)
+	},
+})
@@ -0,0 +1,48 @@
+package conflicts
+
+import (
+	"github.com/jesseduffield/lazygit/pkg/config"
+	. "github.com/jesseduffield/lazygit/pkg/integration/components"
+	"github.com/jesseduffield/lazygit/pkg/integration/tests/shared"
+)
+
+var ConflictCount = NewIntegrationTest(NewIntegrationTestArgs{
+	Description:  "Shows the number of remaining conflicts next to a conflicted file, and updates it while resolving them",
+	ExtraCmdArgs: []string{},
+	Skip:         false,
+	SetupConfig:  func(config *config.AppConfig) {},
+	SetupRepo: func(shell *Shell) {
+		shared.CreateMergeConflictFileMultiple(shell)
+	},
+	Run: func(t *TestDriver, keys config.KeybindingConfig) {
+		t.Views().Files().
+			IsFocused().
+			Lines(
+				Equals("UU file (2 conflicts)").IsSelected(),
+			).
+			PressEnter()
+
+		t.Views().MergeConflicts().
+			IsFocused().
+			SelectedLines(
+				Contains("<<<<<<< HEAD"),
+				Contains("First Change"),
+				Contains("======="),
+			).
+			PressPrimaryAction()
+
+		t.Views().Files().
+			Lines(
+				Equals("UU file (1 conflict)"),
+			)
+
+		t.Views().MergeConflicts().
+			IsFocused().
+			Press(keys.Universal.Undo)
+
+		t.Views().Files().
+			Lines(
+				Equals("UU file (2 conflicts)"),
+			)
+	},
+})
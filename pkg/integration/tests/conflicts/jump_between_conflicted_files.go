@@ -0,0 +1,116 @@
+package conflicts
+
+import (
+	"github.com/jesseduffield/lazygit/pkg/config"
+	. "github.com/jesseduffield/lazygit/pkg/integration/components"
+	"github.com/jesseduffield/lazygit/pkg/integration/tests/shared"
+)
+
+var JumpBetweenConflictedFiles = NewIntegrationTest(NewIntegrationTestArgs{
+	Description:  "Jump between conflicted files from any panel, opening the merge conflicts view for each",
+	ExtraCmdArgs: []string{},
+	Skip:         false,
+	SetupConfig:  func(config *config.AppConfig) {},
+	SetupRepo: func(shell *Shell) {
+		shared.CreateMergeConflictFiles(shell)
+	},
+	Run: func(t *TestDriver, keys config.KeybindingConfig) {
+		t.Views().Files().
+			IsFocused().
+			Lines(
+				Contains("UU").Contains("file1").IsSelected(),
+				Contains("UU").Contains("file2"),
+			)
+
+		t.Views().Commits().
+			Focus().
+			Press(keys.Universal.NextConflictedFile)
+
+		t.Views().MergeConflicts().
+			IsFocused().
+			SelectedLines(
+				Contains("<<<<<<< HEAD"),
+				Contains("First Change"),
+				Contains("======="),
+			)
+
+		t.Views().Files().
+			Lines(
+				Contains("file1").IsSelected(),
+				Contains("file2"),
+			)
+
+		t.Views().MergeConflicts().
+			Press(keys.Universal.NextConflictedFile)
+
+		t.Views().Files().
+			Lines(
+				Contains("file1"),
+				Contains("file2").IsSelected(),
+			)
+
+		// wraps around
+		t.Views().MergeConflicts().
+			IsFocused().
+			Press(keys.Universal.NextConflictedFile)
+
+		t.Views().Files().
+			Lines(
+				Contains("file1").IsSelected(),
+				Contains("file2"),
+			)
+
+		t.Views().MergeConflicts().
+			IsFocused().
+			Press(keys.Universal.PrevConflictedFile)
+
+		t.Views().Files().
+			Lines(
+				Contains("file1"),
+				Contains("file2").IsSelected(),
+			)
+
+		t.Views().MergeConflicts().
+			IsFocused().
+			PressEscape()
+
+		t.Views().Files().
+			IsFocused().
+			Lines(
+				Contains("file1"),
+				Contains("file2").IsSelected(),
+			)
+
+		// from a panel that isn't showing a conflicted file, going backwards
+		// starts from the last conflicted file
+		t.Views().Branches().
+			Focus().
+			Press(keys.Universal.PrevConflictedFile)
+
+		t.Views().MergeConflicts().
+			IsFocused().
+			PressPrimaryAction()
+
+		t.Views().Files().
+			IsFocused().
+			Lines(
+				Contains("UU").Contains("file1").IsSelected(),
+			)
+
+		t.Views().Branches().
+			Focus().
+			Press(keys.Universal.NextConflictedFile)
+
+		t.Views().MergeConflicts().
+			IsFocused().
+			PressPrimaryAction()
+
+		t.Common().ContinueOnConflictsResolved()
+
+		t.Views().Branches().
+			Focus().
+			Press(keys.Universal.NextConflictedFile)
+
+		t.ExpectToast(Equals("Disabled: There are no files with merge conflicts"))
+	},
+})
@@ -0,0 +1,47 @@
+package conflicts
+
+import (
+	"github.com/jesseduffield/lazygit/pkg/config"
+	. "github.com/jesseduffield/lazygit/pkg/integration/components"
+	"github.com/jesseduffield/lazygit/pkg/integration/tests/shared"
+)
+
+var PickBaseInDiff3Style = NewIntegrationTest(NewIntegrationTestArgs{
+	Description:  "Switches a conflict to diff3 style and resolves it by picking the base version",
+	ExtraCmdArgs: []string{},
+	Skip:         false,
+	SetupConfig:  func(config *config.AppConfig) {},
+	SetupRepo: func(shell *Shell) {
+		shared.CreateMergeConflictFile(shell)
+	},
+	Run: func(t *TestDriver, keys config.KeybindingConfig) {
+		t.Views().Files().
+			IsFocused().
+			Lines(
+				Contains("UU file").IsSelected(),
+			).
+			PressEnter()
+
+		t.Views().MergeConflicts().
+			IsFocused().
+			Content(DoesNotContain("|||||||")).
+			SelectedLines(
+				Contains("<<<<<<< HEAD"),
+				Contains("First Change"),
+				Contains("======="),
+			).
+			Press(keys.Main.ToggleConflictStyle).
+			Content(Contains("<<<<<<< ours\nFirst Change\n||||||| base\nOriginal\n=======\nSecond Change\n>>>>>>> theirs")).
+			SelectNextItem().
+			SelectedLines(
+				Contains("||||||| base"),
+				Contains("Original"),
+				Contains("======="),
+			).
+			PressPrimaryAction()
+
+		t.Common().ContinueOnConflictsResolved()
+
+		t.FileSystem().FileContent("file", Equals(shared.OriginalFileContent))
+	},
+})
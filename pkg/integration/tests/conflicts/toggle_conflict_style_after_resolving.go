@@ -0,0 +1,52 @@
+package conflicts
+
+import (
+	"github.com/jesseduffield/lazygit/pkg/config"
+	. "github.com/jesseduffield/lazygit/pkg/integration/components"
+	"github.com/jesseduffield/lazygit/pkg/integration/tests/shared"
+)
+
+var ToggleConflictStyleAfterResolving = NewIntegrationTest(NewIntegrationTestArgs{
+	Description:  "Switching the conflict style after resolving a conflict asks for confirmation, since it brings the conflict back",
+	ExtraCmdArgs: []string{},
+	Skip:         false,
+	SetupConfig:  func(config *config.AppConfig) {},
+	SetupRepo: func(shell *Shell) {
+		shared.CreateMergeConflictFileMultiple(shell)
+	},
+	Run: func(t *TestDriver, keys config.KeybindingConfig) {
+		t.Views().Files().
+			IsFocused().
+			Lines(
+				Contains("UU file").IsSelected(),
+			).
+			PressEnter()
+
+		t.Views().MergeConflicts().
+			IsFocused().
+			SelectedLines(
+				Contains("<<<<<<< HEAD"),
+				Contains("First Change"),
+				Contains("======="),
+			).
+			PressPrimaryAction().
+			Content(DoesNotContain("<<<<<<< HEAD\nFirst Change")).
+			Press(keys.Main.ToggleConflictStyle).
+			Tap(func() {
+				t.ExpectPopup().Confirmation().
+					Title(Equals("Cycle conflict marker style")).
+					Content(Contains("brings back the conflicts you've already resolved")).
+					Cancel()
+			}).
+			Content(DoesNotContain("<<<<<<< HEAD\nFirst Change")).
+			Content(DoesNotContain("|||||||")).
+			Press(keys.Main.ToggleConflictStyle).
+			Tap(func() {
+				t.ExpectPopup().Confirmation().
+					Title(Equals("Cycle conflict marker style")).
+					Content(Contains("brings back the conflicts you've already resolved")).
+					Confirm()
+			}).
+			Content(Contains("<<<<<<< ours\nFirst Change\n||||||| base"))
+	},
+})
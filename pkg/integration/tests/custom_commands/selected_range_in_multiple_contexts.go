@@ -0,0 +1,51 @@
+package custom_commands
+
+import (
+	"github.com/jesseduffield/lazygit/pkg/config"
+	. "github.com/jesseduffield/lazygit/pkg/integration/components"
+)
+
+var SelectedRangeInMultipleContexts = NewIntegrationTest(NewIntegrationTestArgs{
+	Description:  "Using a custom command bound in several contexts that acts on the selected range of commits",
+	ExtraCmdArgs: []string{},
+	Skip:         false,
+	SetupRepo: func(shell *Shell) {
+		shell.EmptyCommit("one")
+		shell.EmptyCommit("two")
+		shell.EmptyCommit("three")
+	},
+	SetupConfig: func(cfg *config.AppConfig) {
+		cfg.UserConfig.CustomCommands = []config.CustomCommand{
+			{
+				Key:     "X",
+				Context: "commits, reflogCommits",
+				Command: "printf '%s\\n' {{range .SelectedCommits}}{{.Name | quote}} {{end}} > selected",
+			},
+		}
+	},
+	Run: func(t *TestDriver, keys config.KeybindingConfig) {
+		t.Views().Commits().
+			Focus().
+			Lines(
+				Contains("three").IsSelected(),
+				Contains("two"),
+				Contains("one"),
+			).
+			Press(keys.Universal.ToggleRangeSelect).
+			SelectNextItem().
+			Press("X")
+
+		t.FileSystem().FileContent("selected", Equals("three\ntwo\n"))
+
+		t.Views().ReflogCommits().
+			Focus().
+			Lines(
+				Contains("commit: three").IsSelected(),
+				Contains("commit: two"),
+				Contains("commit (initial): one"),
+			).
+			Press("X")
+
+		t.FileSystem().FileContent("selected", Equals("commit: three\n"))
+	},
+})
@@ -0,0 +1,72 @@
+package custom_commands
+
+import (
+	"github.com/jesseduffield/lazygit/pkg/config"
+	. "github.com/jesseduffield/lazygit/pkg/integration/components"
+)
+
+var SuggestionsCommandWithFormat = NewIntegrationTest(NewIntegrationTestArgs{
+	Description:  "Using a suggestions command whose output is parsed with a filter and formats, and cached between prompts",
+	ExtraCmdArgs: []string{},
+	Skip:         false,
+	SetupRepo: func(shell *Shell) {
+		shell.EmptyCommit("initial commit")
+		shell.CreateFile("tickets", "PROJ-1 Fix the login page\nPROJ-2 Speed up the build\n")
+	},
+	SetupConfig: func(cfg *config.AppConfig) {
+		cfg.UserConfig.CustomCommands = []config.CustomCommand{
+			{
+				Key:     "a",
+				Context: "files",
+				Command: `git checkout -b {{.Form.Ticket}}`,
+				Prompts: []config.CustomCommandPrompt{
+					{
+						Key:   "Ticket",
+						Type:  "input",
+						Title: "Ticket",
+						Suggestions: config.CustomCommandSuggestions{
+							Command:      "cat tickets",
+							Filter:       `^(?P<key>[A-Z]+-[0-9]+) (?P<summary>.*)$`,
+							ValueFormat:  "{{ .key }}",
+							LabelFormat:  "{{ .key }}: {{ .summary }}",
+							CacheSeconds: 3600,
+						},
+					},
+				},
+			},
+		}
+	},
+	Run: func(t *TestDriver, keys config.KeybindingConfig) {
+		t.Views().Files().
+			Focus().
+			Press("a")
+
+		t.ExpectPopup().Prompt().
+			Title(Equals("Ticket")).
+			Type("build").
+			SuggestionLines(Equals("PROJ-2: Speed up the build")).
+			ConfirmFirstSuggestion()
+
+		t.Views().Branches().
+			Lines(
+				Contains("PROJ-2").IsSelected(),
+				Contains("master"),
+			)
+
+		// the output of the command has changed, but we're still using the
+		// cached suggestions
+		t.Shell().UpdateFile("tickets", "PROJ-3 Something new\n")
+
+		t.Views().Files().
+			Focus().
+			Press("a")
+
+		t.ExpectPopup().Prompt().
+			Title(Equals("Ticket")).
+			SuggestionLines(
+				Equals("PROJ-1: Fix the login page"),
+				Equals("PROJ-2: Speed up the build"),
+			).
+			Cancel()
+	},
+})
@@ -0,0 +1,44 @@
+package diff
+
+import (
+	"time"
+
+	"github.com/jesseduffield/lazygit/pkg/config"
+	. "github.com/jesseduffield/lazygit/pkg/integration/components"
+)
+
+var DiffAgainstEarlierState = NewIntegrationTest(NewIntegrationTestArgs{
+	Description:  "Diff the working tree against the checked-out branch as it was at an earlier time",
+	ExtraCmdArgs: []string{},
+	Skip:         false,
+	SetupConfig:  func(config *config.AppConfig) {},
+	SetupRepo: func(shell *Shell) {
+		threeDaysAgo := time.Now().Add(-72 * time.Hour).Format(time.RFC3339)
+
+		shell.CreateFileAndAdd("file1", "first line\n")
+		shell.RunCommandWithEnv(
+			[]string{"git", "commit", "-m", "old commit"},
+			[]string{"GIT_AUTHOR_DATE=" + threeDaysAgo, "GIT_COMMITTER_DATE=" + threeDaysAgo},
+		)
+		shell.UpdateFileAndAdd("file1", "first line\nsecond line\n")
+		shell.Commit("new commit")
+	},
+	Run: func(t *TestDriver, keys config.KeybindingConfig) {
+		t.Views().Files().
+			IsFocused().
+			Press(keys.Universal.DiffingMenu)
+
+		t.ExpectPopup().Menu().
+			Title(Equals("Diffing")).
+			Select(Contains("Compare against master as of an earlier time")).
+			Confirm()
+
+		t.ExpectPopup().Prompt().
+			Title(Contains("Enter time")).
+			Type("2.days.ago").
+			Confirm()
+
+		t.Views().Information().Content(Contains("Showing output for: git diff master@{2.days.ago}"))
+		t.Views().Main().Content(Contains("+second line"))
+	},
+})
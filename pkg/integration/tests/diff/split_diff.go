@@ -0,0 +1,66 @@
+package diff
+
+import (
+	"github.com/jesseduffield/lazygit/pkg/config"
+	. "github.com/jesseduffield/lazygit/pkg/integration/components"
+)
+
+var SplitDiff = NewIntegrationTest(NewIntegrationTestArgs{
+	Description:  "Toggle showing diffs side by side in the main view and the staging view",
+	ExtraCmdArgs: []string{},
+	Skip:         false,
+	SetupConfig:  func(config *config.AppConfig) {},
+	SetupRepo: func(shell *Shell) {
+		shell.CreateFileAndAdd("myfile", "first-line\nold-second-line\nthird-line\n")
+		shell.Commit("initial commit")
+		shell.UpdateFile("myfile", "first-line\nnew-second-line\nthird-line\nfourth-line\n")
+	},
+	Run: func(t *TestDriver, keys config.KeybindingConfig) {
+		t.Views().Main().ContainsLines(
+			Equals(" first-line"),
+			Equals("-old-second-line"),
+			Equals("+new-second-line"),
+			Equals(" third-line"),
+			Equals("+fourth-line"),
+		)
+
+		t.Views().Files().
+			IsFocused().
+			Press(keys.Universal.ToggleSplitDiffView)
+
+		t.Views().Main().ContainsLines(
+			MatchesRegexp(`^ first-line\s+│ first-line$`),
+			MatchesRegexp(`^-old-second-line\s+│\+new-second-line$`),
+			MatchesRegexp(`^ third-line\s+│ third-line$`),
+			MatchesRegexp(`^\s+│\+fourth-line$`),
+		)
+
+		t.Views().Files().
+			PressEnter()
+
+		// each line keeps its own row, so that lines can still be selected one
+		// at a time
+		t.Views().Staging().
+			IsFocused().
+			ContainsLines(
+				MatchesRegexp(`^ first-line\s+│ first-line$`),
+				MatchesRegexp(`^-old-second-line\s+│$`).IsSelected(),
+				MatchesRegexp(`^\s+│\+new-second-line$`),
+				MatchesRegexp(`^ third-line\s+│ third-line$`),
+				MatchesRegexp(`^\s+│\+fourth-line$`),
+			).
+			PressPrimaryAction().
+			Press(keys.Universal.ToggleSplitDiffView).
+			ContainsLines(
+				Equals(" first-line"),
+				Equals("+new-second-line").IsSelected(),
+				Equals(" third-line"),
+				Equals("+fourth-line"),
+			)
+
+		t.Views().StagingSecondary().
+			ContainsLines(
+				Equals("-old-second-line"),
+			)
+	},
+})
@@ -0,0 +1,76 @@
+package file
+
+import (
+	"github.com/jesseduffield/lazygit/pkg/config"
+	. "github.com/jesseduffield/lazygit/pkg/integration/components"
+)
+
+var CollapseAndExpandAll = NewIntegrationTest(NewIntegrationTestArgs{
+	Description:  "Collapse and expand all directories of the file tree at once, or collapse it to a given level",
+	ExtraCmdArgs: []string{},
+	Skip:         false,
+	SetupConfig:  func(config *config.AppConfig) {},
+	SetupRepo: func(shell *Shell) {
+		shell.CreateDir("a")
+		shell.CreateDir("a/b")
+		shell.CreateDir("a/c")
+		shell.CreateDir("d")
+		shell.CreateFile("a/b/file1", "one\n")
+		shell.CreateFile("a/c/file2", "two\n")
+		shell.CreateFile("a/file3", "three\n")
+		shell.CreateFile("d/file4", "four\n")
+	},
+	Run: func(t *TestDriver, keys config.KeybindingConfig) {
+		t.Views().Files().
+			IsFocused().
+			Lines(
+				Equals("▼ a").IsSelected(),
+				Equals("  ▼ b"),
+				Equals("    ?? file1"),
+				Equals("  ▼ c"),
+				Equals("    ?? file2"),
+				Equals("  ?? file3"),
+				Equals("▼ d"),
+				Equals("  ?? file4"),
+			).
+			NavigateToLine(Contains("file2")).
+			Press(keys.Files.CollapseAll).
+			// the selected file is hidden, so its top level directory is selected
+			Lines(
+				Equals("▶ a").IsSelected(),
+				Equals("▶ d"),
+			).
+			Press(keys.Files.ExpandAll).
+			Lines(
+				Equals("▼ a").IsSelected(),
+				Equals("  ▼ b"),
+				Equals("    ?? file1"),
+				Equals("  ▼ c"),
+				Equals("    ?? file2"),
+				Equals("  ?? file3"),
+				Equals("▼ d"),
+				Equals("  ?? file4"),
+			).
+			NavigateToLine(Contains("file4")).
+			Press(keys.Files.CollapseToLevel).
+			Tap(func() {
+				t.ExpectPopup().Menu().
+					Title(Equals("Collapse to level")).
+					Lines(
+						Contains("Show 1 level(s)"),
+						Contains("Show 2 level(s)"),
+						Contains("Cancel"),
+					).
+					Select(Contains("Show 2 level(s)")).
+					Confirm()
+			}).
+			Lines(
+				Equals("▼ a"),
+				Equals("  ▶ b"),
+				Equals("  ▶ c"),
+				Equals("  ?? file3"),
+				Equals("▼ d"),
+				Equals("  ?? file4").IsSelected(),
+			)
+	},
+})
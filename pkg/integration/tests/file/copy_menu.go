@@ -117,6 +117,34 @@ var CopyMenu = NewIntegrationTest(NewIntegrationTestArgs{
 				expectClipboard(t, Contains("dir/1-unstaged_file"))
 			})
 
+		// Copy absolute path
+		t.Views().Files().
+			Press(keys.Files.CopyFileInfoToClipboard).
+			Tap(func() {
+				t.ExpectPopup().Menu().
+					Title(Equals("Copy to clipboard")).
+					Select(Contains("Absolute path")).
+					Confirm()
+
+				t.ExpectToast(Equals("Absolute path copied to clipboard"))
+
+				expectClipboard(t, Contains("/repo/dir/1-unstaged_file"))
+			})
+
+		// Copy directory
+		t.Views().Files().
+			Press(keys.Files.CopyFileInfoToClipboard).
+			Tap(func() {
+				t.ExpectPopup().Menu().
+					Title(Equals("Copy to clipboard")).
+					Select(Contains("Directory")).
+					Confirm()
+
+				t.ExpectToast(Equals("Directory copied to clipboard"))
+
+				expectClipboard(t, Equals("dir\n"))
+			})
+
 		// Selected path diff on a single (unstaged) file
 		t.Views().Files().
 			Press(keys.Files.CopyFileInfoToClipboard).
@@ -0,0 +1,73 @@
+package file
+
+import (
+	"github.com/jesseduffield/lazygit/pkg/config"
+	. "github.com/jesseduffield/lazygit/pkg/integration/components"
+)
+
+var CycleSortOrder = NewIntegrationTest(NewIntegrationTestArgs{
+	Description:  "Cycle through the sort orders of the files panel",
+	ExtraCmdArgs: []string{},
+	Skip:         false,
+	SetupConfig:  func(config *config.AppConfig) {},
+	SetupRepo: func(shell *Shell) {
+		shell.CreateFileAndAdd("a", "1\n")
+		shell.CreateFileAndAdd("b", "1\n")
+		shell.CreateFileAndAdd("dir/d", "1\n")
+		shell.Commit("one")
+
+		shell.UpdateFileAndAdd("a", "1\n2\n")
+		shell.UpdateFile("b", "1\n2\n3\n4\n5\n6\n")
+		shell.UpdateFile("dir/d", "1\n2\n3\n")
+		shell.CreateFile("c", "1\n")
+	},
+	Run: func(t *TestDriver, keys config.KeybindingConfig) {
+		t.Views().Files().
+			IsFocused().
+			Lines(
+				Contains("dir").IsSelected(),
+				Contains(" M d"),
+				Contains("M  a"),
+				Contains(" M b"),
+				Contains("?? c"),
+			).
+			Press(keys.Files.CycleSortOrder).
+			Tap(func() {
+				t.ExpectToast(Equals("Sorting files by status"))
+			}).
+			// unstaged changes come before staged ones, and untracked files last
+			Lines(
+				Contains("dir").IsSelected(),
+				Contains(" M d"),
+				Contains(" M b"),
+				Contains("M  a"),
+				Contains("?? c"),
+			).
+			Press(keys.Files.CycleSortOrder).
+			Tap(func() {
+				t.ExpectToast(Equals("Sorting files by modification time"))
+			}).
+			Press(keys.Files.CycleSortOrder).
+			Tap(func() {
+				t.ExpectToast(Equals("Sorting files by number of changed lines"))
+			}).
+			Lines(
+				Contains(" M b +5 -0"),
+				Contains("dir +2 -0"),
+				Contains(" M d +2 -0"),
+				Contains("M  a +1 -0"),
+				Equals("?? c"),
+			).
+			Press(keys.Files.CycleSortOrder).
+			Tap(func() {
+				t.ExpectToast(Equals("Sorting files by name"))
+			}).
+			Lines(
+				Contains("dir"),
+				Contains(" M d"),
+				Contains("M  a"),
+				Contains(" M b"),
+				Equals("?? c"),
+			)
+	},
+})
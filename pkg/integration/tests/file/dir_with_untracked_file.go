@@ -6,8 +6,7 @@ import (
 )
 
 var DirWithUntrackedFile = NewIntegrationTest(NewIntegrationTestArgs{
-	// notably, we currently _don't_ actually see the untracked file in the diff. Not sure how to get around that.
-	Description:  "When selecting a directory that contains an untracked file, we should not get an error",
+	Description:  "When selecting a directory that contains an untracked file, we should see its diff along with that of the modified file",
 	ExtraCmdArgs: []string{},
 	Skip:         false,
 	SetupConfig:  func(config *config.AppConfig) {},
@@ -27,8 +26,15 @@ var DirWithUntrackedFile = NewIntegrationTest(NewIntegrationTestArgs{
 
 		t.Views().Main().
 			Content(DoesNotContain("error: Could not access")).
-			// we show baz because it's a modified file but we don't show bar because it's untracked
-			// (though it would be cool if we could show that too)
-			Content(Contains("baz"))
+			Content(Contains("+baz")).
+			Content(Contains("+bar"))
+
+		// the real index is left alone
+		t.Views().Files().
+			Lines(
+				Contains("dir"),
+				Equals("   M file"),
+				Equals("  ?? untracked"),
+			)
 	},
 })
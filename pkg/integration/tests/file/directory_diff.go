@@ -0,0 +1,86 @@
+package file
+
+import (
+	"github.com/jesseduffield/lazygit/pkg/config"
+	. "github.com/jesseduffield/lazygit/pkg/integration/components"
+)
+
+var DirectoryDiff = NewIntegrationTest(NewIntegrationTestArgs{
+	Description:  "Selecting a collapsed or compressed directory shows the combined diff of all files below it, in the files and commit files panels",
+	ExtraCmdArgs: []string{},
+	Skip:         false,
+	SetupConfig:  func(config *config.AppConfig) {},
+	SetupRepo: func(shell *Shell) {
+		shell.CreateFileAndAdd("pkg/a", "a\n")
+		shell.CreateFileAndAdd("pkg/sub/deep/b", "b\n")
+		shell.CreateFileAndAdd("other", "other\n")
+		shell.Commit("first commit")
+
+		shell.UpdateFile("pkg/a", "a changed\n")
+		shell.UpdateFileAndAdd("pkg/sub/deep/b", "b changed\n")
+		shell.CreateFile("pkg/sub/deep/c", "c new\n")
+		shell.UpdateFile("other", "other changed\n")
+	},
+	Run: func(t *TestDriver, keys config.KeybindingConfig) {
+		t.Views().Files().
+			IsFocused().
+			Lines(
+				Contains("▼ pkg").IsSelected(),
+				Contains("▼ sub/deep"),
+				Equals("    M  b"),
+				Equals("    ?? c"),
+				Equals("   M a"),
+				Equals(" M other"),
+			).
+			Tap(func() {
+				// b is staged while the rest isn't, so we get a split view
+				t.Views().Main().
+					Content(Contains("+a changed")).
+					Content(Contains("+c new")).
+					Content(DoesNotContain("other"))
+
+				t.Views().Secondary().
+					Content(Contains("+b changed")).
+					Content(DoesNotContain("a changed"))
+			}).
+			NavigateToLine(Contains("sub/deep")).
+			PressEnter().
+			Lines(
+				Contains("▼ pkg"),
+				Contains("▶ sub/deep").IsSelected(),
+				Equals("   M a"),
+				Equals(" M other"),
+			).
+			Tap(func() {
+				t.Views().Main().
+					Content(Contains("+c new")).
+					Content(DoesNotContain("a changed"))
+
+				t.Views().Secondary().
+					Content(Contains("+b changed"))
+			})
+
+		t.Views().Commits().
+			Focus().
+			Lines(
+				Contains("first commit").IsSelected(),
+			).
+			PressEnter()
+
+		t.Views().CommitFiles().
+			IsFocused().
+			Lines(
+				Contains("▼ pkg").IsSelected(),
+				Contains("▼ sub/deep"),
+				Contains("b"),
+				Contains("a"),
+				Contains("other"),
+			).
+			Tap(func() {
+				t.Views().Main().
+					Content(Contains("+a")).
+					Content(Contains("+b")).
+					Content(DoesNotContain("+other"))
+			})
+	},
+})
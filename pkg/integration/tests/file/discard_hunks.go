@@ -0,0 +1,58 @@
+package file
+
+import (
+	"github.com/jesseduffield/lazygit/pkg/config"
+	. "github.com/jesseduffield/lazygit/pkg/integration/components"
+)
+
+var DiscardHunks = NewIntegrationTest(NewIntegrationTestArgs{
+	Description:  "Discard individual hunks of the unstaged changes in a file",
+	ExtraCmdArgs: []string{},
+	Skip:         false,
+	SetupConfig:  func(config *config.AppConfig) {},
+	SetupRepo: func(shell *Shell) {
+		shell.CreateFileAndAdd("file-one", "1\n2\n3\n4\n5\n6\n7\n8\n9\n10\n11\n12\n")
+		shell.Commit("first commit")
+
+		shell.UpdateFile("file-one", "1a\n2\n3\n4\n5\n6\n7\n8\n9\n10\n11\n12b\n")
+	},
+	Run: func(t *TestDriver, keys config.KeybindingConfig) {
+		t.Views().Files().
+			IsFocused().
+			Lines(
+				Contains(" M").Contains("file-one").IsSelected(),
+			).
+			Press(keys.Universal.Remove)
+
+		t.ExpectPopup().Menu().
+			Title(Equals("file-one")).
+			Select(Contains("Discard individual hunks")).
+			Confirm()
+
+		t.ExpectPopup().Menu().
+			Title(Equals("Discard hunk in 'file-one'")).
+			Lines(
+				Contains("@@ -1,4 +1,4 @@").Contains("-1").IsSelected(),
+				Contains("@@ -9,4 +9,4 @@").Contains("-12"),
+				Contains("Cancel"),
+			).
+			Confirm()
+
+		t.FileSystem().FileContent("file-one", Equals("1\n2\n3\n4\n5\n6\n7\n8\n9\n10\n11\n12b\n"))
+
+		// the menu is shown again with the remaining hunk
+		t.ExpectPopup().Menu().
+			Title(Equals("Discard hunk in 'file-one'")).
+			Lines(
+				Contains("@@ -9,4 +9,4 @@").Contains("-12").IsSelected(),
+				Contains("Cancel"),
+			).
+			Confirm()
+
+		t.FileSystem().FileContent("file-one", Equals("1\n2\n3\n4\n5\n6\n7\n8\n9\n10\n11\n12\n"))
+
+		t.Views().Files().
+			IsFocused().
+			IsEmpty()
+	},
+})
@@ -0,0 +1,105 @@
+package file
+
+import (
+	"github.com/jesseduffield/lazygit/pkg/config"
+	. "github.com/jesseduffield/lazygit/pkg/integration/components"
+)
+
+var DiscardToTrash = NewIntegrationTest(NewIntegrationTestArgs{
+	Description:  "Discard changes with the trash enabled, then restore them from the trash",
+	ExtraCmdArgs: []string{},
+	Skip:         false,
+	SetupConfig: func(config *config.AppConfig) {
+		config.UserConfig.Gui.Files.TrashDiscardedChanges = true
+	},
+	SetupRepo: func(shell *Shell) {
+		shell.CreateFileAndAdd("changed", "original\n")
+		shell.Commit("first commit")
+
+		shell.UpdateFile("changed", "original\nchanged\n")
+		shell.CreateFile("untracked", "untracked\n")
+	},
+	Run: func(t *TestDriver, keys config.KeybindingConfig) {
+		t.Views().Files().
+			IsFocused().
+			Press(keys.Files.ViewTrash).
+			Tap(func() {
+				t.ExpectToast(Equals("The trash is empty"))
+			}).
+			Lines(
+				Equals(" M changed").IsSelected(),
+				Equals("?? untracked"),
+			).
+			Press(keys.Universal.Remove).
+			Tap(func() {
+				t.ExpectPopup().Menu().
+					Title(Equals("changed")).
+					Select(Contains("Discard all changes")).
+					Confirm()
+			}).
+			Lines(
+				Equals("?? untracked").IsSelected(),
+			).
+			Press(keys.Universal.Remove).
+			Tap(func() {
+				t.ExpectPopup().Menu().
+					Title(Equals("untracked")).
+					Select(Contains("Discard all changes")).
+					Confirm()
+			}).
+			IsEmpty()
+
+		t.FileSystem().FileContent("changed", Equals("original\n"))
+		t.FileSystem().PathNotPresent("untracked")
+
+		t.Views().Files().
+			Press(keys.Files.ViewTrash).
+			Tap(func() {
+				t.ExpectPopup().Menu().
+					Title(Equals("View trash")).
+					// the most recent discard comes first
+					Lines(
+						Contains("untracked").IsSelected(),
+						Contains("changed"),
+						Contains("Cancel"),
+					).
+					Select(Contains("changed")).
+					Confirm()
+
+				t.ExpectPopup().Menu().
+					Title(Equals("changed")).
+					Select(Contains("Restore")).
+					Confirm()
+			}).
+			Lines(
+				Equals(" M changed").IsSelected(),
+			).
+			Press(keys.Files.ViewTrash).
+			Tap(func() {
+				t.ExpectPopup().Menu().
+					Title(Equals("View trash")).
+					Lines(
+						Contains("untracked").IsSelected(),
+						Contains("Cancel"),
+					).
+					Confirm()
+
+				t.ExpectPopup().Menu().
+					Title(Equals("untracked")).
+					Select(Contains("Drop")).
+					Confirm()
+
+				t.ExpectPopup().Confirmation().
+					Title(Equals("Drop")).
+					Content(Contains("Are you sure you want to drop the discarded files from the trash?")).
+					Confirm()
+			}).
+			Press(keys.Files.ViewTrash).
+			Tap(func() {
+				t.ExpectToast(Equals("The trash is empty"))
+			})
+
+		t.FileSystem().FileContent("changed", Equals("original\nchanged\n"))
+		t.FileSystem().PathNotPresent("untracked")
+	},
+})
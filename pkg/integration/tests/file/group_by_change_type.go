@@ -0,0 +1,71 @@
+package file
+
+import (
+	"github.com/jesseduffield/lazygit/pkg/config"
+	. "github.com/jesseduffield/lazygit/pkg/integration/components"
+)
+
+var GroupByChangeType = NewIntegrationTest(NewIntegrationTestArgs{
+	Description:  "Group the files panel into sections by change type, and stage a whole section",
+	ExtraCmdArgs: []string{},
+	Skip:         false,
+	SetupConfig:  func(config *config.AppConfig) {},
+	SetupRepo: func(shell *Shell) {
+		shell.CreateFileAndAdd("a", "1\n")
+		shell.CreateFileAndAdd("b", "1\n")
+		shell.CreateFileAndAdd("dir/d", "1\n")
+		shell.Commit("one")
+
+		shell.UpdateFileAndAdd("a", "1\n2\n")
+		shell.UpdateFile("b", "1\n2\n")
+		shell.UpdateFile("dir/d", "1\n2\n")
+		shell.CreateFile("c", "1\n")
+	},
+	Run: func(t *TestDriver, keys config.KeybindingConfig) {
+		t.Views().Files().
+			IsFocused().
+			Lines(
+				Contains("dir").IsSelected(),
+				Contains(" M d"),
+				Contains("M  a"),
+				Contains(" M b"),
+				Contains("?? c"),
+			).
+			NavigateToLine(Contains(" M b")).
+			Press(keys.Files.ToggleGroupByChangeType).
+			// the selected file stays selected
+			Lines(
+				Contains("Staged (1)"),
+				Contains("M  a"),
+				Contains("Unstaged (2)"),
+				Contains("dir"),
+				Contains(" M d"),
+				Contains(" M b").IsSelected(),
+				Contains("Untracked (1)"),
+				Contains("?? c"),
+			).
+			NavigateToLine(Contains("Unstaged (2)")).
+			Press(keys.Universal.Edit).
+			Tap(func() {
+				t.ExpectToast(Equals("Disabled: Not available on a section header. Select a file or directory instead."))
+			}).
+			PressPrimaryAction().
+			Lines(
+				Contains("Staged (3)"),
+				Contains("dir"),
+				Contains("M  d"),
+				Contains("M  a"),
+				Contains("M  b"),
+				Contains("Untracked (1)"),
+				Contains("?? c"),
+			).
+			Press(keys.Files.ToggleGroupByChangeType).
+			Lines(
+				Contains("dir"),
+				Contains("M  d"),
+				Contains("M  a"),
+				Contains("M  b"),
+				Contains("?? c"),
+			)
+	},
+})
@@ -0,0 +1,81 @@
+package file
+
+import (
+	"github.com/jesseduffield/lazygit/pkg/config"
+	. "github.com/jesseduffield/lazygit/pkg/integration/components"
+)
+
+var IgnoreRules = NewIntegrationTest(NewIntegrationTestArgs{
+	Description:  "Ignore files by their extension, then find and remove the rule that matches a tracked file",
+	ExtraCmdArgs: []string{},
+	Skip:         false,
+	SetupConfig:  func(config *config.AppConfig) {},
+	SetupRepo: func(shell *Shell) {
+		shell.CreateFileAndAdd("dir/tracked.log", "one\n")
+		shell.Commit("one")
+		shell.UpdateFile("dir/tracked.log", "two\n")
+		shell.CreateFile("untracked.log", "")
+	},
+	Run: func(t *TestDriver, keys config.KeybindingConfig) {
+		t.Views().Files().
+			IsFocused().
+			Lines(
+				Contains("dir").IsSelected(),
+				Contains(" M tracked.log"),
+				Contains("?? untracked.log"),
+			).
+			NavigateToLine(Contains("untracked.log")).
+			Press(keys.Files.IgnoreFile).
+			Tap(func() {
+				t.ExpectPopup().Menu().
+					Title(Equals("Ignore or exclude file")).
+					Select(Contains("Add '*.log' to .gitignore")).
+					Confirm()
+
+				t.FileSystem().FileContent(".gitignore", Equals("*.log\n"))
+			}).
+			Lines(
+				Contains("dir"),
+				Contains(" M tracked.log"),
+				Contains("?? .gitignore").IsSelected(),
+			).
+			NavigateToLine(Contains("tracked.log")).
+			Press(keys.Files.IgnoreFile).
+			Tap(func() {
+				t.ExpectPopup().Menu().
+					Title(Equals("Ignore or exclude file")).
+					Select(Contains("Show ignore rules matching this path")).
+					Confirm()
+
+				t.ExpectPopup().Menu().
+					Title(Equals("Ignore rules matching 'dir/tracked.log'")).
+					Lines(
+						Contains("*.log").Contains(".gitignore:1").IsSelected(),
+						Contains("Cancel"),
+					).
+					Confirm()
+
+				t.ExpectPopup().Menu().
+					Title(Equals("*.log")).
+					Select(Contains("Remove rule")).
+					Confirm()
+
+				t.FileSystem().FileContent(".gitignore", Equals(""))
+			}).
+			Lines(
+				Contains("dir"),
+				Contains(" M tracked.log").IsSelected(),
+				Contains("?? .gitignore"),
+				Contains("?? untracked.log"),
+			).
+			Press(keys.Files.IgnoreFile).
+			Tap(func() {
+				t.ExpectPopup().Menu().
+					Title(Equals("Ignore or exclude file")).
+					Select(Contains("Show ignore rules matching this path")).
+					Confirm()
+
+				t.ExpectToast(Equals("No ignore rules match 'dir/tracked.log'"))
+			})
+	},
+})
@@ -0,0 +1,51 @@
+package file
+
+import (
+	"github.com/jesseduffield/lazygit/pkg/config"
+	. "github.com/jesseduffield/lazygit/pkg/integration/components"
+)
+
+var NukeWorkingTreeDryRun = NewIntegrationTest(NewIntegrationTestArgs{
+	Description:  "Nuke the working tree in dry run mode, which lists the affected files instead of discarding them",
+	ExtraCmdArgs: []string{},
+	Skip:         false,
+	SetupConfig:  func(config *config.AppConfig) {},
+	SetupRepo: func(shell *Shell) {
+		shell.CreateFileAndAdd("file1", "original content")
+		shell.CreateFileAndAdd("file2", "original content")
+		shell.Commit("first commit")
+
+		shell.UpdateFile("file1", "new content")
+		shell.UpdateFileAndAdd("file2", "new content")
+		shell.CreateFile("file3", "new content")
+	},
+	Run: func(t *TestDriver, keys config.KeybindingConfig) {
+		t.Views().Files().
+			IsFocused().
+			Press(keys.Universal.ToggleDryRun).
+			Tap(func() {
+				t.ExpectToast(Equals("Dry run mode enabled"))
+			}).
+			Press(keys.Files.ViewResetOptions)
+
+		t.ExpectPopup().Menu().Title(Equals("")).Select(Contains("Nuke working tree")).Confirm()
+
+		t.ExpectPopup().Confirmation().
+			Title(Equals("Dry run")).
+			Content(
+				Contains("git reset --hard HEAD").
+					Contains("git clean -fd").
+					Contains("file1: uncommitted changes would be discarded").
+					Contains("file2: uncommitted changes would be discarded").
+					Contains("file3 would be removed"),
+			).
+			Confirm()
+
+		t.Views().Files().
+			Lines(
+				Contains(" M file1"),
+				Contains("M  file2"),
+				Contains("?? file3"),
+			)
+	},
+})
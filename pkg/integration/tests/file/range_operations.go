@@ -0,0 +1,100 @@
+package file
+
+import (
+	"github.com/jesseduffield/lazygit/pkg/config"
+	. "github.com/jesseduffield/lazygit/pkg/integration/components"
+)
+
+var RangeOperations = NewIntegrationTest(NewIntegrationTestArgs{
+	Description:  "Stage, unstage, stash and discard a range of selected files spanning a directory",
+	ExtraCmdArgs: []string{},
+	Skip:         false,
+	SetupConfig:  func(config *config.AppConfig) {},
+	SetupRepo: func(shell *Shell) {
+		shell.CreateFileAndAdd("dir/a", "a\n")
+		shell.CreateFileAndAdd("c", "c\n")
+		shell.CreateFileAndAdd("e", "e\n")
+		shell.Commit("first commit")
+
+		shell.UpdateFile("dir/a", "a changed\n")
+		shell.CreateFile("dir/b", "b\n")
+		shell.UpdateFile("c", "c changed\n")
+		shell.UpdateFile("e", "e changed\n")
+		shell.CreateFile("f", "f\n")
+	},
+	Run: func(t *TestDriver, keys config.KeybindingConfig) {
+		t.Views().Files().
+			IsFocused().
+			Lines(
+				Contains("▼ dir").IsSelected(),
+				Equals("   M a"),
+				Equals("  ?? b"),
+				Equals(" M c"),
+				Equals(" M e"),
+				Equals("?? f"),
+			).
+			Press(keys.Universal.ToggleRangeSelect).
+			SelectNextItem().
+			SelectNextItem().
+			SelectNextItem().
+			// stages everything as some of it has unstaged changes
+			PressPrimaryAction().
+			Lines(
+				Contains("▼ dir"),
+				Equals("  M  a"),
+				Equals("  A  b"),
+				Equals("M  c").IsSelected(),
+				Equals(" M e"),
+				Equals("?? f"),
+			).
+			// unstages everything as all of it is staged now
+			PressPrimaryAction().
+			Lines(
+				Contains("▼ dir"),
+				Equals("   M a"),
+				Equals("  ?? b"),
+				Equals(" M c").IsSelected(),
+				Equals(" M e"),
+				Equals("?? f"),
+			).
+			Press(keys.Universal.ToggleRangeSelect).
+			NavigateToLine(Equals(" M e")).
+			Press(keys.Universal.ToggleRangeSelect).
+			SelectNextItem().
+			Press(keys.Files.ViewStashOptions).
+			Tap(func() {
+				t.ExpectPopup().Menu().Title(Equals("Stash options")).Select(Contains("Stash selected files")).Confirm()
+
+				t.ExpectPopup().Prompt().Title(Equals("Stash changes")).Type("e and f").Confirm()
+			}).
+			Lines(
+				Contains("▼ dir"),
+				Equals("   M a"),
+				Equals("  ?? b"),
+				Equals(" M c").IsSelected(),
+			).
+			Press(keys.Universal.ToggleRangeSelect).
+			NavigateToLine(Equals("   M a")).
+			Press(keys.Universal.ToggleRangeSelect).
+			SelectNextItem().
+			Press(keys.Universal.Remove).
+			Tap(func() {
+				t.ExpectPopup().Menu().
+					Title(Equals("2 selected files")).
+					Select(Contains("Discard all changes")).
+					Confirm()
+			}).
+			Lines(
+				Equals(" M c").IsSelected(),
+			)
+
+		t.Views().Stash().
+			Lines(
+				Contains("e and f"),
+			)
+
+		t.FileSystem().FileContent("dir/a", Equals("a\n"))
+		t.FileSystem().PathNotPresent("dir/b")
+		t.FileSystem().PathNotPresent("f")
+	},
+})
@@ -0,0 +1,50 @@
+package file
+
+import (
+	"github.com/jesseduffield/lazygit/pkg/config"
+	. "github.com/jesseduffield/lazygit/pkg/integration/components"
+)
+
+var ShowNumstat = NewIntegrationTest(NewIntegrationTestArgs{
+	Description:  "Show the number of added and deleted lines next to files and directories",
+	ExtraCmdArgs: []string{},
+	Skip:         false,
+	SetupConfig: func(config *config.AppConfig) {
+		config.UserConfig.Gui.Files.ShowNumstat = true
+	},
+	SetupRepo: func(shell *Shell) {
+		shell.CreateFileAndAdd("dir/a", "1\n2\n")
+		shell.CreateFileAndAdd("dir/b", "1\n")
+		shell.Commit("one")
+
+		shell.UpdateFile("dir/a", "1\n3\n4\n")
+		shell.UpdateFileAndAdd("dir/b", "1\n2\n")
+	},
+	Run: func(t *TestDriver, keys config.KeybindingConfig) {
+		t.Views().Files().
+			IsFocused().
+			Lines(
+				Equals("▼ dir +3 -1").IsSelected(),
+				Equals("   M a +2 -1"),
+				Equals("  M  b +1 -0"),
+			).
+			NavigateToLine(Contains("b")).
+			PressPrimaryAction().
+			// the counts are relative to HEAD, so unstaging doesn't change them
+			Lines(
+				Equals("▼ dir +3 -1"),
+				Equals("   M a +2 -1"),
+				Equals("   M b +1 -0").IsSelected(),
+			)
+
+		t.Shell().UpdateFile("dir/b", "1\n2\n3\n4\n")
+		t.GlobalPress(keys.Files.RefreshFiles)
+
+		t.Views().Files().
+			Lines(
+				Equals("▼ dir +5 -1"),
+				Equals("   M a +2 -1"),
+				Equals("   M b +3 -0").IsSelected(),
+			)
+	},
+})
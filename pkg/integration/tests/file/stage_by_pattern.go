@@ -0,0 +1,106 @@
+package file
+
+import (
+	"github.com/jesseduffield/lazygit/pkg/config"
+	. "github.com/jesseduffield/lazygit/pkg/integration/components"
+)
+
+var StageByPattern = NewIntegrationTest(NewIntegrationTestArgs{
+	Description:  "Stage and unstage files matching a glob or regex, including files in collapsed directories",
+	ExtraCmdArgs: []string{},
+	Skip:         false,
+	SetupConfig:  func(config *config.AppConfig) {},
+	SetupRepo: func(shell *Shell) {
+		shell.CreateDir("src")
+		shell.CreateFileAndAdd("src/app.go", "package src\n")
+		shell.CreateFileAndAdd("readme.md", "hello\n")
+		shell.Commit("one")
+		shell.UpdateFile("src/app.go", "package src\n\nfunc App() {}\n")
+		shell.CreateFile("src/app_test.go", "package src\n")
+		shell.CreateFile("src/util_test.go", "package src\n")
+		shell.UpdateFile("readme.md", "hello world\n")
+		shell.CreateFile("root_test.go", "package main\n")
+	},
+	Run: func(t *TestDriver, keys config.KeybindingConfig) {
+		stageByPattern := func(action string, pattern string) {
+			t.Views().Files().
+				Press(keys.Files.StageByPattern)
+
+			t.ExpectPopup().Menu().
+				Title(Equals("Stage/unstage files matching a pattern")).
+				Select(Contains(action)).
+				Confirm()
+
+			t.ExpectPopup().Prompt().
+				Title(Equals("Glob or /regex/ to match file paths against")).
+				Type(pattern).
+				Confirm()
+		}
+
+		t.Views().Files().
+			IsFocused().
+			Lines(
+				Equals("▼ src").IsSelected(),
+				Equals("   M app.go"),
+				Equals("  ?? app_test.go"),
+				Equals("  ?? util_test.go"),
+				Equals(" M readme.md"),
+				Equals("?? root_test.go"),
+			).
+			// collapse the directory so that its files aren't visible
+			PressEnter().
+			Lines(
+				Equals("▶ src").IsSelected(),
+				Equals(" M readme.md"),
+				Equals("?? root_test.go"),
+			)
+
+		stageByPattern("Stage matching files", "*_test.go")
+
+		t.ExpectToast(Equals("Staged 3 file(s) matching '*_test.go'"))
+
+		t.Views().Files().
+			Lines(
+				Equals("▶ src").IsSelected(),
+				Equals(" M readme.md"),
+				Equals("A  root_test.go"),
+			).
+			PressEnter().
+			Lines(
+				Equals("▼ src").IsSelected(),
+				Equals("   M app.go"),
+				Equals("  A  app_test.go"),
+				Equals("  A  util_test.go"),
+				Equals(" M readme.md"),
+				Equals("A  root_test.go"),
+			)
+
+		stageByPattern("Unstage matching files", "/^src//")
+
+		t.ExpectToast(Equals("Unstaged 2 file(s) matching '/^src//'"))
+
+		t.Views().Files().
+			Lines(
+				Equals("▼ src").IsSelected(),
+				Equals("   M app.go"),
+				Equals("  ?? app_test.go"),
+				Equals("  ?? util_test.go"),
+				Equals(" M readme.md"),
+				Equals("A  root_test.go"),
+			)
+
+		stageByPattern("Stage matching files", "*.rs")
+
+		t.ExpectPopup().Alert().
+			Title(Equals("Error")).
+			Content(Equals("No files with unstaged changes match '*.rs'")).
+			Confirm()
+
+		stageByPattern("Unstage matching files", "/[/")
+
+		t.ExpectPopup().Alert().
+			Title(Equals("Error")).
+			Content(Contains("error parsing regexp")).
+			Confirm()
+	},
+})
@@ -0,0 +1,87 @@
+package file
+
+import (
+	"strings"
+
+	"github.com/jesseduffield/lazygit/pkg/config"
+	. "github.com/jesseduffield/lazygit/pkg/integration/components"
+)
+
+var StageLargeFiles = NewIntegrationTest(NewIntegrationTestArgs{
+	Description:  "Show the sizes of large files and suggest git-lfs when staging them",
+	ExtraCmdArgs: []string{},
+	Skip:         false,
+	SetupConfig: func(cfg *config.AppConfig) {
+		cfg.UserConfig.Gui.Files.LargeFileThreshold = "1KB"
+		cfg.UserConfig.Gui.Files.HugeFileThreshold = "4KB"
+	},
+	SetupRepo: func(shell *Shell) {
+		shell.CreateFileAndAdd(".gitattributes", "lfs.bin filter=lfs\n")
+		shell.Commit("one")
+		shell.CreateFile("huge.bin", strings.Repeat("x", 8192))
+		shell.CreateFile("lfs.bin", strings.Repeat("x", 2048))
+		shell.CreateFile("medium.bin", strings.Repeat("x", 2048))
+		shell.CreateFile("small.txt", "small")
+	},
+	Run: func(t *TestDriver, keys config.KeybindingConfig) {
+		t.Views().Files().
+			IsFocused().
+			Lines(
+				Equals("?? huge.bin 8.0 KiB").IsSelected(),
+				Equals("?? lfs.bin 2.0 KiB"),
+				Equals("?? medium.bin 2.0 KiB"),
+				Equals("?? small.txt"),
+			).
+			NavigateToLine(Contains("medium.bin")).
+			PressPrimaryAction().
+			Tap(func() {
+				t.ExpectPopup().Confirmation().
+					Title(Equals("Stage large files")).
+					Content(Contains("These files are larger than 1.0 KiB:").
+						Contains("medium.bin (2.0 KiB)").
+						Contains("git lfs track")).
+					Cancel()
+			}).
+			Lines(
+				Equals("?? huge.bin 8.0 KiB"),
+				Equals("?? lfs.bin 2.0 KiB"),
+				Equals("?? medium.bin 2.0 KiB").IsSelected(),
+				Equals("?? small.txt"),
+			).
+			PressPrimaryAction().
+			Tap(func() {
+				t.ExpectPopup().Confirmation().
+					Title(Equals("Stage large files")).
+					Content(Contains("medium.bin (2.0 KiB)")).
+					Confirm()
+			}).
+			Lines(
+				Equals("?? huge.bin 8.0 KiB"),
+				Equals("?? lfs.bin 2.0 KiB"),
+				Equals("A  medium.bin 2.0 KiB").IsSelected(),
+				Equals("?? small.txt"),
+			).
+			// files tracked with git-lfs don't need confirming
+			NavigateToLine(Contains("lfs.bin")).
+			PressPrimaryAction().
+			Lines(
+				Equals("?? huge.bin 8.0 KiB"),
+				Equals("A  lfs.bin 2.0 KiB").IsSelected(),
+				Equals("A  medium.bin 2.0 KiB"),
+				Equals("?? small.txt"),
+			).
+			Press(keys.Files.ToggleStagedAll).
+			Tap(func() {
+				t.ExpectPopup().Confirmation().
+					Title(Equals("Stage large files")).
+					Content(Contains("huge.bin (8.0 KiB)").DoesNotContain("medium.bin")).
+					Confirm()
+			}).
+			Lines(
+				Equals("A  huge.bin 8.0 KiB"),
+				Equals("A  lfs.bin 2.0 KiB").IsSelected(),
+				Equals("A  medium.bin 2.0 KiB"),
+				Equals("A  small.txt"),
+			)
+	},
+})
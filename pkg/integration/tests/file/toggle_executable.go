@@ -0,0 +1,49 @@
+package file
+
+import (
+	"github.com/jesseduffield/lazygit/pkg/config"
+	. "github.com/jesseduffield/lazygit/pkg/integration/components"
+)
+
+var ToggleExecutable = NewIntegrationTest(NewIntegrationTestArgs{
+	Description:  "Show mode and symlink target changes, and toggle the executable bit of a file",
+	ExtraCmdArgs: []string{},
+	Skip:         false,
+	SetupConfig:  func(config *config.AppConfig) {},
+	SetupRepo: func(shell *Shell) {
+		shell.CreateFileAndAdd("script.sh", "echo hi\n")
+		shell.CreateFileAndAdd("target-a", "a\n")
+		shell.CreateFileAndAdd("target-b", "b\n")
+		shell.RunShellCommand("ln -s target-a link")
+		shell.GitAddAll()
+		shell.Commit("one")
+
+		shell.RunShellCommand("ln -sfn target-b link")
+		shell.RunShellCommand("chmod +x script.sh")
+	},
+	Run: func(t *TestDriver, keys config.KeybindingConfig) {
+		t.Views().Files().
+			IsFocused().
+			Lines(
+				Equals(" M link (symlink → target-b)").IsSelected(),
+				Equals(" M script.sh (mode 100644 → 100755)"),
+			).
+			NavigateToLine(Contains("script.sh"))
+
+		t.Views().Main().Content(Equals("mode 100644 → 100755"))
+
+		t.Views().Files().
+			// the worktree has the bit set already, so now the index has too
+			Press(keys.Files.ToggleExecutable).
+			Lines(
+				Equals(" M link (symlink → target-b)"),
+				Equals("M  script.sh (mode 100644 → 100755)").IsSelected(),
+			).
+			Press(keys.Files.ToggleExecutable).
+			Lines(
+				Equals(" M link (symlink → target-b)").IsSelected(),
+			)
+
+		t.FileSystem().FileContent("script.sh", Equals("echo hi\n"))
+	},
+})
@@ -0,0 +1,59 @@
+package file
+
+import (
+	"github.com/jesseduffield/lazygit/pkg/config"
+	. "github.com/jesseduffield/lazygit/pkg/integration/components"
+)
+
+var ToggleIndexFlags = NewIntegrationTest(NewIntegrationTestArgs{
+	Description:  "Set and clear the assume-unchanged and skip-worktree bits of a file",
+	ExtraCmdArgs: []string{},
+	Skip:         false,
+	SetupConfig: func(config *config.AppConfig) {
+		config.UserConfig.Gui.Files.ShowIndexFlags = true
+	},
+	SetupRepo: func(shell *Shell) {
+		shell.CreateFileAndAdd("config.txt", "original\n")
+		shell.Commit("one")
+		shell.UpdateFile("config.txt", "customised\n")
+		shell.CreateFile("untracked.txt", "")
+	},
+	Run: func(t *TestDriver, keys config.KeybindingConfig) {
+		t.Views().Files().
+			IsFocused().
+			Lines(
+				Equals(" M config.txt").IsSelected(),
+				Equals("?? untracked.txt"),
+			).
+			Press(keys.Files.ToggleSkipWorktree).
+			// git no longer sees the change, but we still show the file so
+			// that the bit can be cleared
+			Lines(
+				Equals("   config.txt (skip-worktree)").IsSelected(),
+				Equals("?? untracked.txt"),
+			).
+			Press(keys.Files.ToggleSkipWorktree).
+			Lines(
+				Equals(" M config.txt").IsSelected(),
+				Equals("?? untracked.txt"),
+			).
+			Press(keys.Files.ToggleAssumeUnchanged).
+			Lines(
+				Equals("   config.txt (assume-unchanged)").IsSelected(),
+				Equals("?? untracked.txt"),
+			).
+			Press(keys.Files.ToggleAssumeUnchanged).
+			Lines(
+				Equals(" M config.txt").IsSelected(),
+				Equals("?? untracked.txt"),
+			).
+			NavigateToLine(Contains("untracked.txt")).
+			Press(keys.Files.ToggleAssumeUnchanged).
+			Tap(func() {
+				t.ExpectPopup().Alert().
+					Title(Equals("Error")).
+					Content(Equals("This can only be done on tracked files")).
+					Confirm()
+			})
+	},
+})
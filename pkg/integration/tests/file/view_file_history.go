@@ -0,0 +1,99 @@
+package file
+
+import (
+	"github.com/jesseduffield/lazygit/pkg/config"
+	. "github.com/jesseduffield/lazygit/pkg/integration/components"
+)
+
+var ViewFileHistory = NewIntegrationTest(NewIntegrationTestArgs{
+	Description:  "View the history of a file across a rename, and checkout and copy an old version of it",
+	ExtraCmdArgs: []string{},
+	Skip:         false,
+	SetupConfig: func(config *config.AppConfig) {
+		// simulating the clipboard, as in the copy menu test
+		config.UserConfig.OS.CopyToClipboardCmd = "printf '%s' {{text}} > clipboard"
+	},
+	SetupRepo: func(shell *Shell) {
+		shell.CreateFileAndAdd("old.txt", "1\n")
+		shell.Commit("add file")
+		shell.CreateFileAndAdd("other.txt", "other\n")
+		shell.Commit("add other file")
+		shell.UpdateFileAndAdd("old.txt", "1\n2\n")
+		shell.Commit("change file")
+		shell.RunCommand([]string{"git", "mv", "old.txt", "new.txt"})
+		shell.Commit("rename file")
+		shell.UpdateFileAndAdd("new.txt", "1\n2\n3\n")
+		shell.Commit("change renamed file")
+
+		shell.UpdateFile("new.txt", "1\n2\n3\n4\n")
+	},
+	Run: func(t *TestDriver, keys config.KeybindingConfig) {
+		t.Views().Files().
+			IsFocused().
+			Lines(
+				Contains(" M new.txt").IsSelected(),
+			).
+			Press(keys.Files.ViewFileHistory)
+
+		t.Views().FileHistory().
+			IsFocused().
+			Title(Equals("File history (new.txt)")).
+			Lines(
+				Contains("change renamed file").IsSelected(),
+				Contains("rename file"),
+				Contains("change file"),
+				Contains("add file"),
+			).
+			NavigateToLine(Contains("change file")).
+			Tap(func() {
+				t.Views().Main().Content(Contains("+2").DoesNotContain("other"))
+			}).
+			Press(keys.Universal.CopyToClipboard).
+			Tap(func() {
+				t.ExpectToast(Equals("File content copied to clipboard"))
+				t.FileSystem().FileContent("clipboard", Equals("1\n2\n"))
+				t.Shell().DeleteFile("clipboard")
+			}).
+			NavigateToLine(Contains("add file")).
+			Press(keys.CommitFiles.CheckoutCommitFile).
+			Tap(func() {
+				t.ExpectPopup().Confirmation().
+					Title(Equals("Checkout this version of the file")).
+					Content(Equals("'new.txt' has uncommitted changes, which will be lost. Are you sure you want to replace it with this version?")).
+					Confirm()
+
+				t.FileSystem().FileContent("new.txt", Equals("1\n"))
+			}).
+			PressEscape()
+
+		t.Views().Files().
+			IsFocused().
+			Lines(
+				Contains(" M new.txt").IsSelected(),
+			)
+
+		// the history of a file in a commit starts from that commit
+		t.Views().Commits().
+			Focus().
+			NavigateToLine(Contains("change file")).
+			PressEnter()
+
+		t.Views().CommitFiles().
+			IsFocused().
+			Lines(
+				Contains("old.txt").IsSelected(),
+			).
+			Press(keys.Files.ViewFileHistory)
+
+		t.Views().FileHistory().
+			IsFocused().
+			Title(Equals("File history (old.txt)")).
+			Lines(
+				Contains("change file").IsSelected(),
+				Contains("add file"),
+			).
+			PressEscape()
+
+		t.Views().CommitFiles().IsFocused()
+	},
+})
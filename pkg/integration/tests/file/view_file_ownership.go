@@ -0,0 +1,58 @@
+package file
+
+import (
+	"github.com/jesseduffield/lazygit/pkg/config"
+	. "github.com/jesseduffield/lazygit/pkg/integration/components"
+)
+
+var ViewFileOwnership = NewIntegrationTest(NewIntegrationTestArgs{
+	Description:  "Show who committed to the files in the selected directory, and who touched each of them last",
+	ExtraCmdArgs: []string{},
+	Skip:         false,
+	SetupConfig:  func(config *config.AppConfig) {},
+	SetupRepo: func(shell *Shell) {
+		shell.CreateDir("dir")
+
+		shell.SetAuthor("Alice", "alice@example.com")
+		shell.CreateFileAndAdd("dir/one", "1")
+		shell.Commit("alice one")
+		shell.UpdateFileAndAdd("dir/one", "11")
+		shell.Commit("alice one again")
+
+		shell.SetAuthor("Bob", "bob@example.com")
+		shell.CreateFileAndAdd("dir/twö", "2")
+		shell.Commit("bob two")
+		shell.UpdateFileAndAdd("dir/one", "111")
+		shell.Commit("bob one")
+
+		shell.UpdateFile("dir/one", "1111")
+		shell.UpdateFile("dir/twö", "22")
+		shell.CreateFile("dir/three", "3")
+	},
+	Run: func(t *TestDriver, keys config.KeybindingConfig) {
+		t.Views().Files().
+			Focus().
+			Lines(
+				Contains("dir").IsSelected(),
+				Contains("one"),
+				Contains("three"),
+				Contains("twö"),
+			).
+			Press(keys.Files.ViewFileOwnership)
+
+		t.Views().Main().
+			Title(Equals("File ownership")).
+			ContainsLines(
+				Contains("Ownership of 'dir'"),
+				Contains(""),
+				Contains("Changes to these files per author"),
+				MatchesRegexp(`^Alice\s+█+ 2$`),
+				MatchesRegexp(`^Bob\s+█+ 2$`),
+				Contains(""),
+				Contains("Main author of each file"),
+				MatchesRegexp(`^dir/one\s+Alice\s+█+░+\s+2/3 last touched by Bob$`),
+				MatchesRegexp(`^dir/three\s+no commits yet$`),
+				MatchesRegexp(`^dir/twö\s+Bob\s+█+\s+1/1 last touched by Bob$`),
+			)
+	},
+})
@@ -8,7 +8,7 @@ import (
 var FilterFiles = NewIntegrationTest(NewIntegrationTestArgs{
 	Description:  "Basic file filtering by text",
 	ExtraCmdArgs: []string{},
-	Skip:         true, // Skipping until we have implemented file view filtering
+	Skip:         false,
 	SetupConfig:  func(config *config.AppConfig) {},
 	SetupRepo: func(shell *Shell) {
 		shell.CreateDir("folder1")
@@ -70,6 +70,8 @@ var FilterFiles = NewIntegrationTest(NewIntegrationTestArgs{
 			Lines(
 				// first item is always selected after filtering
 				Contains(`folder1`).IsSelected(),
+				// the match is fuzzy
+				Contains(`apple-grape`),
 				Contains(`grape-orange`),
 			)
 	},
@@ -0,0 +1,121 @@
+package filter_and_search
+
+import (
+	"github.com/jesseduffield/lazygit/pkg/config"
+	. "github.com/jesseduffield/lazygit/pkg/integration/components"
+)
+
+var FuzzyFinder = NewIntegrationTest(NewIntegrationTestArgs{
+	Description:  "Use the fuzzy finder to jump to a branch, a tag, a remote branch, a file and a commit",
+	ExtraCmdArgs: []string{},
+	Skip:         false,
+	SetupConfig:  func(config *config.AppConfig) {},
+	SetupRepo: func(shell *Shell) {
+		shell.EmptyCommit("initial commit")
+		shell.EmptyCommit("add the widget")
+		shell.CreateLightweightTag("v1.0.0", "HEAD")
+		shell.EmptyCommit("polish the gadget")
+		shell.CloneIntoRemote("origin")
+		shell.NewBranch("feature-apple")
+		shell.NewBranch("feature-banana")
+		shell.NewBranch("feature-cherry")
+		shell.PushBranch("origin", "feature-cherry")
+		shell.CreateFile("apple.txt", "apple")
+		shell.CreateFile("zebra.txt", "zebra")
+	},
+	Run: func(t *TestDriver, keys config.KeybindingConfig) {
+		t.GlobalPress(keys.Universal.OpenFuzzyFinder)
+
+		t.ExpectSearch().
+			Type("feature-app").
+			Confirm()
+
+		t.ExpectPopup().Menu().
+			Title(Equals("Find anything")).
+			Lines(
+				Contains("branch").Contains("feature-apple").IsSelected(),
+			).
+			Confirm()
+
+		t.Views().Branches().
+			IsFocused().
+			SelectedLine(Contains("feature-apple"))
+
+		t.GlobalPress(keys.Universal.OpenFuzzyFinder)
+
+		t.ExpectSearch().
+			Type("v1.0.0").
+			Confirm()
+
+		t.ExpectPopup().Menu().
+			Title(Equals("Find anything")).
+			Lines(
+				Contains("tag").Contains("v1.0.0").IsSelected(),
+			).
+			Confirm()
+
+		t.Views().Tags().
+			IsFocused().
+			SelectedLine(Contains("v1.0.0"))
+
+		t.GlobalPress(keys.Universal.OpenFuzzyFinder)
+
+		t.ExpectSearch().
+			Type("origin/feature-cherry").
+			Confirm()
+
+		t.ExpectPopup().Menu().
+			Title(Equals("Find anything")).
+			Lines(
+				Contains("remote branch").Contains("origin/feature-cherry").IsSelected(),
+			).
+			Confirm()
+
+		t.Views().RemoteBranches().
+			IsFocused().
+			SelectedLine(Contains("feature-cherry")).
+			PressEscape()
+
+		t.Views().Remotes().
+			IsFocused().
+			SelectedLine(Contains("origin"))
+
+		t.GlobalPress(keys.Universal.OpenFuzzyFinder)
+
+		t.ExpectSearch().
+			Type("zebra").
+			Confirm()
+
+		t.ExpectPopup().Menu().
+			Title(Equals("Find anything")).
+			Lines(
+				Contains("file").Contains("zebra.txt").IsSelected(),
+			).
+			Confirm()
+
+		t.Views().Files().
+			IsFocused().
+			SelectedLine(Contains("zebra.txt"))
+
+		t.GlobalPress(keys.Universal.OpenFuzzyFinder)
+
+		t.ExpectSearch().
+			Type("add the widget").
+			Confirm()
+
+		t.ExpectPopup().Menu().
+			Title(Equals("Find anything")).
+			Lines(
+				Contains("commit").Contains("add the widget").IsSelected(),
+			).
+			Confirm()
+
+		t.Views().Commits().
+			IsFocused().
+			Lines(
+				Contains("polish the gadget"),
+				Contains("add the widget").IsSelected(),
+				Contains("initial commit"),
+			)
+	},
+})
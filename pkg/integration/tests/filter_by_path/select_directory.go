@@ -0,0 +1,42 @@
+package filter_by_path
+
+import (
+	"github.com/jesseduffield/lazygit/pkg/config"
+	. "github.com/jesseduffield/lazygit/pkg/integration/components"
+)
+
+var SelectDirectory = NewIntegrationTest(NewIntegrationTestArgs{
+	Description:  "Filter commits by a directory selected in the files panel",
+	ExtraCmdArgs: []string{},
+	Skip:         false,
+	SetupConfig: func(config *config.AppConfig) {
+	},
+	SetupRepo: func(shell *Shell) {
+		shell.CreateFileAndAdd("dir/a", "a")
+		shell.Commit("add dir/a")
+		shell.CreateFileAndAdd("other", "other")
+		shell.Commit("add other")
+		shell.CreateFileAndAdd("dir/b", "b")
+		shell.Commit("add dir/b")
+
+		shell.UpdateFile("dir/a", "a changed")
+	},
+	Run: func(t *TestDriver, keys config.KeybindingConfig) {
+		t.Views().Files().
+			IsFocused().
+			Lines(
+				Contains("dir").IsSelected(),
+				Contains("a"),
+			).
+			Press(keys.Files.FilterCommitsByPath)
+
+		t.Views().Information().Content(Contains("Filtering by 'dir'"))
+
+		t.Views().Commits().
+			IsFocused().
+			Lines(
+				Contains("add dir/b").IsSelected(),
+				Contains("add dir/a"),
+			)
+	},
+})
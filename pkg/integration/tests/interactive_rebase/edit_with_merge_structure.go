@@ -0,0 +1,68 @@
+package interactive_rebase
+
+import (
+	"github.com/jesseduffield/lazygit/pkg/config"
+	. "github.com/jesseduffield/lazygit/pkg/integration/components"
+)
+
+var EditWithMergeStructure = NewIntegrationTest(NewIntegrationTestArgs{
+	Description:  "Shows the label, reset and merge entries of a rebase that rebuilds a merge",
+	ExtraCmdArgs: []string{},
+	Skip:         false,
+	GitVersion:   AtLeast("2.22.0"),
+	SetupConfig:  func(config *config.AppConfig) {},
+	SetupRepo: func(shell *Shell) {
+		shell.
+			EmptyCommit("base").
+			EmptyCommit("first").
+			NewBranch("side").
+			EmptyCommit("side one").
+			Checkout("master").
+			EmptyCommit("main one").
+			Merge("side").
+			EmptyCommit("after merge")
+	},
+	Run: func(t *TestDriver, keys config.KeybindingConfig) {
+		t.Views().Commits().
+			Focus().
+			NavigateToLine(Contains("first")).
+			Press(keys.Universal.Edit).
+			Lines(
+				Contains("pick").Contains("CI after merge"),
+				Contains("merge").Contains("CI Merge branch 'side'"),
+				Contains("pick").Contains("CI main one"),
+				Contains("reset").Contains("onto"),
+				Contains("label").Contains("side"),
+				Contains("pick").Contains("CI * side one"),
+				Contains("reset").Contains("onto"),
+				Contains("label").Contains("onto"),
+				Contains("<-- YOU ARE HERE --- first").IsSelected(),
+				Contains("CI base"),
+			).
+			NavigateToLine(Contains("label").Contains("side"))
+
+		t.Views().Main().
+			Content(Contains("Label the commit rebased up to here as 'side'"))
+
+		t.Views().Commits().
+			Press(keys.Commits.MoveDownCommit)
+
+		t.ExpectToast(Equals("Disabled: Label, reset and merge entries can't be moved"))
+
+		t.Views().Commits().
+			NavigateToLine(Contains("pick").Contains("CI main one")).
+			Press(keys.Universal.Remove)
+
+		t.Common().ContinueRebase()
+
+		t.Views().Commits().
+			IsFocused().
+			Lines(
+				Contains("CI after merge"),
+				Contains("CI Merge branch 'side'"),
+				Contains("CI side one"),
+				Contains("CI first"),
+				Contains("CI base"),
+			)
+	},
+})
@@ -0,0 +1,49 @@
+package interactive_rebase
+
+import (
+	"github.com/jesseduffield/lazygit/pkg/config"
+	. "github.com/jesseduffield/lazygit/pkg/integration/components"
+)
+
+var FailedExec = NewIntegrationTest(NewIntegrationTestArgs{
+	Description:  "Continue a rebase whose exec step fails, editing the failed command so that it passes",
+	ExtraCmdArgs: []string{},
+	Skip:         false,
+	SetupConfig:  func(config *config.AppConfig) {},
+	SetupRepo: func(shell *Shell) {
+		shell.
+			CreateNCommits(3).
+			RunCommandExpectError([]string{"git", "rebase", "--exec", "false", "HEAD~2"})
+	},
+	Run: func(t *TestDriver, keys config.KeybindingConfig) {
+		t.Views().Commits().
+			Lines(
+				Contains("pick").Contains("commit 03"),
+				Contains("<-- YOU ARE HERE --- commit 02"),
+				Contains("commit 01"),
+			)
+
+		t.Common().ContinueRebase()
+
+		t.ExpectPopup().Menu().
+			Title(Equals("Rebase stopped: 'false' failed")).
+			Select(Contains("Edit the command, run it, and continue")).
+			Confirm()
+
+		t.ExpectPopup().Prompt().
+			Title(Equals("Command to run:")).
+			InitialText(Equals("false")).
+			Clear().
+			Type("true").
+			Confirm()
+
+		t.Views().Commits().
+			Lines(
+				Contains("commit 03"),
+				Contains("commit 02"),
+				Contains("commit 01"),
+			)
+
+		t.Views().Information().Content(DoesNotContain("Rebasing"))
+	},
+})
@@ -0,0 +1,52 @@
+package interactive_rebase
+
+import (
+	"github.com/jesseduffield/lazygit/pkg/config"
+	. "github.com/jesseduffield/lazygit/pkg/integration/components"
+)
+
+var FailedExecRescheduled = NewIntegrationTest(NewIntegrationTestArgs{
+	Description:  "Skip and retry a failing exec step when git reschedules failed exec commands",
+	ExtraCmdArgs: []string{},
+	Skip:         false,
+	GitVersion:   AtLeast("2.22.0"),
+	SetupConfig:  func(config *config.AppConfig) {},
+	SetupRepo: func(shell *Shell) {
+		shell.
+			SetConfig("rebase.rescheduleFailedExec", "true").
+			CreateNCommits(3).
+			RunCommandExpectError([]string{"git", "rebase", "--exec", "false", "HEAD~2"})
+	},
+	Run: func(t *TestDriver, keys config.KeybindingConfig) {
+		t.Views().Commits().
+			Lines(
+				Contains("pick").Contains("commit 03"),
+				Contains("<-- YOU ARE HERE --- commit 02"),
+				Contains("commit 01"),
+			)
+
+		// git has put the failed command back into the todo list, so
+		// continuing runs it again
+		t.Common().ContinueRebase()
+
+		t.ExpectPopup().Menu().
+			Title(Equals("Rebase stopped: 'false' failed")).
+			Select(Contains("Skip the command and continue")).
+			Confirm()
+
+		// the exec after commit 03 fails too
+		t.ExpectPopup().Menu().
+			Title(Equals("Rebase stopped: 'false' failed")).
+			Select(Contains("Skip the command and continue")).
+			Confirm()
+
+		t.Views().Commits().
+			Lines(
+				Contains("commit 03"),
+				Contains("commit 02"),
+				Contains("commit 01"),
+			)
+
+		t.Views().Information().Content(DoesNotContain("Rebasing"))
+	},
+})
@@ -0,0 +1,90 @@
+package interactive_rebase
+
+import (
+	"github.com/jesseduffield/lazygit/pkg/config"
+	. "github.com/jesseduffield/lazygit/pkg/integration/components"
+)
+
+var MouseEditTodos = NewIntegrationTest(NewIntegrationTestArgs{
+	Description:  "Cycle the actions of rebase todos by clicking them, and reorder them by dragging",
+	ExtraCmdArgs: []string{},
+	Skip:         false,
+	SetupConfig:  func(config *config.AppConfig) {},
+	SetupRepo: func(shell *Shell) {
+		shell.CreateNCommits(4)
+	},
+	Run: func(t *TestDriver, keys config.KeybindingConfig) {
+		t.Views().Commits().
+			Focus().
+			NavigateToLine(Contains("commit 01")).
+			Press(keys.Universal.Edit).
+			Lines(
+				Contains("pick").Contains("commit 04"),
+				Contains("pick").Contains("commit 03"),
+				Contains("pick").Contains("commit 02"),
+				Contains("YOU ARE HERE").Contains("commit 01").IsSelected(),
+			).
+			// clicking the action cycles through pick, squash, fixup and drop
+			ClickAndRelease(10, 1).
+			Lines(
+				Contains("pick").Contains("commit 04"),
+				Contains("squash").Contains("commit 03").IsSelected(),
+				Contains("pick").Contains("commit 02"),
+				Contains("YOU ARE HERE").Contains("commit 01"),
+			).
+			ClickAndRelease(10, 1).
+			Lines(
+				Contains("pick").Contains("commit 04"),
+				Contains("fixup").Contains("commit 03").IsSelected(),
+				Contains("pick").Contains("commit 02"),
+				Contains("YOU ARE HERE").Contains("commit 01"),
+			).
+			ClickAndRelease(10, 1).
+			Lines(
+				Contains("pick").Contains("commit 04"),
+				Contains("drop").Contains("commit 03").IsSelected(),
+				Contains("pick").Contains("commit 02"),
+				Contains("YOU ARE HERE").Contains("commit 01"),
+			).
+			ClickAndRelease(10, 1).
+			Lines(
+				Contains("pick").Contains("commit 04"),
+				Contains("pick").Contains("commit 03").IsSelected(),
+				Contains("pick").Contains("commit 02"),
+				Contains("YOU ARE HERE").Contains("commit 01"),
+			).
+			// clicking elsewhere on the line only selects it
+			ClickAndRelease(30, 0).
+			Lines(
+				Contains("pick").Contains("commit 04").IsSelected(),
+				Contains("pick").Contains("commit 03"),
+				Contains("pick").Contains("commit 02"),
+				Contains("YOU ARE HERE").Contains("commit 01"),
+			).
+			// pressing on a line selects it, and dragging then moves it
+			Drag(30, 2, 30, 0).
+			Lines(
+				Contains("pick").Contains("commit 02").IsSelected(),
+				Contains("pick").Contains("commit 04"),
+				Contains("pick").Contains("commit 03"),
+				Contains("YOU ARE HERE").Contains("commit 01"),
+			).
+			// a todo can't be dragged past the commit being edited
+			Drag(30, 1, 30, 3).
+			Lines(
+				Contains("pick").Contains("commit 02"),
+				Contains("pick").Contains("commit 03"),
+				Contains("pick").Contains("commit 04").IsSelected(),
+				Contains("YOU ARE HERE").Contains("commit 01"),
+			).
+			Tap(func() {
+				t.Common().ContinueRebase()
+			}).
+			Lines(
+				Contains("commit 02"),
+				Contains("commit 03"),
+				Contains("commit 04"),
+				Contains("commit 01"),
+			)
+	},
+})
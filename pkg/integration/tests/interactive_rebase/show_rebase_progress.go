@@ -0,0 +1,60 @@
+package interactive_rebase
+
+import (
+	"github.com/jesseduffield/lazygit/pkg/config"
+	. "github.com/jesseduffield/lazygit/pkg/integration/components"
+)
+
+var ShowRebaseProgress = NewIntegrationTest(NewIntegrationTestArgs{
+	Description:  "Show the done, current and remaining todos in a panel during an interactive rebase",
+	ExtraCmdArgs: []string{},
+	Skip:         false,
+	SetupConfig:  func(config *config.AppConfig) {},
+	SetupRepo: func(shell *Shell) {
+		shell.CreateNCommits(4)
+	},
+	Run: func(t *TestDriver, keys config.KeybindingConfig) {
+		t.Views().RebaseProgress().IsInvisible()
+
+		t.Views().Commits().
+			Focus().
+			NavigateToLine(Contains("commit 02")).
+			Press(keys.Universal.Edit).
+			Lines(
+				Contains("pick").Contains("commit 04"),
+				Contains("pick").Contains("commit 03"),
+				Contains("<-- YOU ARE HERE --- commit 02").IsSelected(),
+				Contains("commit 01"),
+			)
+
+		t.Views().RebaseProgress().
+			IsVisible().
+			Title(Equals("Rebase progress (1/3)")).
+			Content(
+				// lazygit stops at a commit by starting the rebase with a break
+				Contains("▶ break").
+					Contains("  pick").Contains("commit 03").
+					Contains("  pick").Contains("commit 04").
+					DoesNotContain("onto"),
+			)
+
+		t.Views().Commits().
+			Focus().
+			NavigateToLine(Contains("commit 03")).
+			Press(keys.Universal.Edit)
+
+		t.Common().ContinueRebase()
+
+		t.Views().RebaseProgress().
+			Title(Equals("Rebase progress (2/3)")).
+			Content(
+				Contains("✓ break").
+					Contains("▶ edit").Contains("commit 03").
+					Contains("  pick").Contains("commit 04"),
+			)
+
+		t.Common().ContinueRebase()
+
+		t.Views().RebaseProgress().IsInvisible()
+	},
+})
@@ -0,0 +1,103 @@
+package misc
+
+import (
+	"time"
+
+	"github.com/jesseduffield/lazygit/pkg/config"
+	. "github.com/jesseduffield/lazygit/pkg/integration/components"
+)
+
+var AuthorStats = NewIntegrationTest(NewIntegrationTestArgs{
+	Description:  "Show the number of commits per author over a time range, optionally limited to a filtered path",
+	ExtraCmdArgs: []string{},
+	Skip:         false,
+	SetupConfig:  func(config *config.AppConfig) {},
+	SetupRepo: func(shell *Shell) {
+		// --since looks at the committer date, so we can't just pass --date
+		twoMonthsAgo := time.Now().AddDate(0, -2, 0).Format(time.RFC3339)
+
+		shell.SetAuthor("Alice", "alice@example.com")
+		shell.EmptyCommitWithDate("old one", twoMonthsAgo)
+		shell.EmptyCommitWithDate("old two", twoMonthsAgo)
+		shell.CreateFileAndAdd("alice.txt", "alice")
+		shell.Commit("recent alice")
+
+		shell.SetAuthor("Bob", "bob@example.com")
+		shell.CreateFileAndAdd("bob.txt", "bob")
+		shell.Commit("recent bob one")
+		shell.CreateFileAndAdd("alice.txt", "alice and bob")
+		shell.Commit("recent bob two")
+	},
+	Run: func(t *TestDriver, keys config.KeybindingConfig) {
+		t.Views().Status().
+			Focus().
+			Press(keys.Status.AuthorStats)
+
+		t.ExpectPopup().Menu().
+			Title(Equals("Show commits per author")).
+			Lines(
+				Contains("Last week"),
+				Contains("Last month"),
+				Contains("Last year"),
+				Contains("All time"),
+				Contains("Cancel"),
+			).
+			Select(Contains("Last month")).
+			Confirm()
+
+		t.Views().Main().
+			Title(Equals("Author statistics")).
+			ContainsLines(
+				Contains("Commits per author (Last month)"),
+				Contains(""),
+				MatchesRegexp(`^Bob\s+█+ 2$`),
+				MatchesRegexp(`^Alice\s+█+ 1$`),
+			)
+
+		t.Views().Status().
+			Press(keys.Status.AuthorStats)
+
+		t.ExpectPopup().Menu().
+			Title(Equals("Show commits per author")).
+			Select(Contains("All time")).
+			Confirm()
+
+		t.Views().Main().
+			ContainsLines(
+				Contains("Commits per author (All time)"),
+				Contains(""),
+				MatchesRegexp(`^Alice\s+█+ 3$`),
+				MatchesRegexp(`^Bob\s+█+ 2$`),
+			)
+
+		t.Views().Status().
+			Press(keys.Universal.FilteringMenu)
+
+		t.ExpectPopup().Menu().
+			Title(Equals("Filtering")).
+			Select(Contains("Enter path to filter by")).
+			Confirm()
+
+		t.ExpectPopup().Prompt().
+			Title(Equals("Enter path:")).
+			Type("bob.txt").
+			Confirm()
+
+		t.Views().Status().
+			Focus().
+			Press(keys.Status.AuthorStats)
+
+		t.ExpectPopup().Menu().
+			Title(Equals("Show commits per author")).
+			Select(Contains("All time")).
+			Confirm()
+
+		t.Views().Main().
+			ContainsLines(
+				Contains("Commits per author touching 'bob.txt' (All time)"),
+				Contains(""),
+				MatchesRegexp(`^Bob\s+█+ 1$`),
+			).
+			Content(DoesNotContain("Alice"))
+	},
+})
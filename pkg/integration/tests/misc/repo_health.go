@@ -0,0 +1,93 @@
+package misc
+
+import (
+	"strings"
+
+	"github.com/jesseduffield/lazygit/pkg/config"
+	. "github.com/jesseduffield/lazygit/pkg/integration/components"
+)
+
+var RepoHealth = NewIntegrationTest(NewIntegrationTestArgs{
+	Description:  "Show the repo health menu from the status panel, run garbage collection and get a hint for moving large files to git-lfs",
+	ExtraCmdArgs: []string{},
+	Skip:         false,
+	SetupConfig: func(cfg *config.AppConfig) {
+		cfg.UserConfig.Gui.Files.LargeFileThreshold = "1KB"
+	},
+	SetupRepo: func(shell *Shell) {
+		shell.CreateFileAndAdd("big.bin", strings.Repeat("x", 4096))
+		shell.Commit("add big file")
+		shell.DeleteFileAndAdd("big.bin")
+		shell.Commit("remove big file")
+		// a ref pointing at an object that doesn't exist. It isn't a branch, so that
+		// loading the branches panel doesn't fail on it
+		shell.CreateFile(".git/refs/backup/broken", "1234567890123456789012345678901234567890\n")
+	},
+	Run: func(t *TestDriver, keys config.KeybindingConfig) {
+		t.Views().Status().
+			Focus().
+			Press(keys.Status.RepoHealth)
+
+		t.ExpectPopup().Menu().
+			Title(Equals("Repo health")).
+			Lines(
+				Contains("Diagnostics"),
+				Contains("Repo size").IsSelected(),
+				Contains("Loose objects"),
+				MatchesRegexp(`Large files in history\s+1$`),
+				MatchesRegexp(`Stale branches\s+0$`),
+				MatchesRegexp(`Broken refs\s+1$`),
+				Contains(""),
+				Contains("Actions"),
+				Contains("Run garbage collection"),
+				Contains("Prune unreachable objects"),
+				Contains("Move large files to Git LFS"),
+				Contains("Cancel"),
+			).
+			Select(Contains("Large files in history")).
+			Tooltip(Contains("big.bin (4.0 KiB)")).
+			Select(Contains("Broken refs")).
+			Tooltip(Contains("refs/backup/broken")).
+			Select(Contains("Move large files to Git LFS")).
+			Confirm()
+
+		t.ExpectPopup().Alert().
+			Title(Equals("Move large files to Git LFS")).
+			Content(Contains(`git lfs migrate import --everything --include="big.bin"`)).
+			Confirm()
+
+		t.Views().Status().
+			Press(keys.Status.RepoHealth)
+
+		t.ExpectPopup().Menu().
+			Title(Equals("Repo health")).
+			Select(Contains("Run garbage collection")).
+			Tooltip(Contains("Disabled: Git can't do this while there are broken refs")).
+			Cancel()
+
+		t.Shell().DeleteFile(".git/refs/backup/broken")
+
+		t.Views().Status().
+			Press(keys.Status.RepoHealth)
+
+		t.ExpectPopup().Menu().
+			Title(Equals("Repo health")).
+			Select(Contains("Run garbage collection")).
+			Confirm()
+
+		t.ExpectToast(Equals("Garbage collection done"))
+
+		t.Views().Status().
+			Press(keys.Status.RepoHealth)
+
+		t.ExpectPopup().Menu().
+			Title(Equals("Repo health")).
+			Select(Contains("Loose objects")).
+			Tooltip(Contains("Objects that aren't in a pack yet")).
+			TopLines(
+				Contains("Diagnostics"),
+				Contains("Repo size"),
+				MatchesRegexp(`Loose objects\s+0$`),
+			)
+	},
+})
@@ -0,0 +1,92 @@
+package patch_building
+
+import (
+	"github.com/jesseduffield/lazygit/pkg/config"
+	. "github.com/jesseduffield/lazygit/pkg/integration/components"
+)
+
+var ApplySelection = NewIntegrationTest(NewIntegrationTestArgs{
+	Description:  "Apply selected lines of an old commit's diff to the index, and to the working tree and index, without building a custom patch",
+	ExtraCmdArgs: []string{},
+	Skip:         false,
+	SetupConfig:  func(config *config.AppConfig) {},
+	SetupRepo: func(shell *Shell) {
+		shell.CreateFileAndAdd("file1", "first line\nsecond line\nthird line\n")
+		shell.CreateFileAndAdd("file2", "first line\nsecond line\nthird line\n")
+		shell.Commit("first commit")
+
+		shell.UpdateFileAndAdd("file1", "first line2\nsecond line\nthird line2\n")
+		shell.UpdateFileAndAdd("file2", "first line2\nsecond line\nthird line2\n")
+		shell.Commit("second commit")
+
+		shell.UpdateFileAndAdd("file1", "first line\nsecond line\nthird line\n")
+		shell.UpdateFileAndAdd("file2", "first line\nsecond line\nthird line\n")
+		shell.Commit("third commit")
+	},
+	Run: func(t *TestDriver, keys config.KeybindingConfig) {
+		t.Views().Commits().
+			Focus().
+			Lines(
+				Contains("third commit").IsSelected(),
+				Contains("second commit"),
+				Contains("first commit"),
+			).
+			NavigateToLine(Contains("second commit")).
+			PressEnter()
+
+		t.Views().CommitFiles().
+			IsFocused().
+			Lines(
+				Contains("file1").IsSelected(),
+				Contains("file2"),
+			).
+			PressEnter()
+
+		t.Views().PatchBuilding().
+			IsFocused().
+			ContainsLines(
+				Contains(`-first line`).IsSelected(),
+				Contains(`+first line2`),
+				Contains(` second line`),
+				Contains(`-third line`),
+				Contains(`+third line2`),
+			).
+			Press(keys.Main.ToggleDragSelect).
+			SelectNextItem().
+			Press(keys.Main.ApplySelection).
+			Tap(func() {
+				t.ExpectPopup().Menu().
+					Title(Equals("Apply selected lines")).
+					Select(Contains("Apply to index only")).
+					Confirm()
+			}).
+			PressEscape()
+
+		t.Views().CommitFiles().
+			IsFocused().
+			NavigateToLine(Contains("file2")).
+			PressEnter()
+
+		t.Views().PatchBuilding().
+			IsFocused().
+			NavigateToLine(Contains(`-third line`)).
+			Press(keys.Main.ToggleDragSelect).
+			SelectNextItem().
+			Press(keys.Main.ApplySelection).
+			Tap(func() {
+				t.ExpectPopup().Menu().
+					Title(Equals("Apply selected lines")).
+					Select(Contains("Apply to working tree and index")).
+					Confirm()
+			})
+
+		t.Views().Files().
+			Lines(
+				Equals("MM file1"),
+				Equals("M  file2"),
+			)
+
+		t.FileSystem().FileContent("file1", Equals("first line\nsecond line\nthird line\n"))
+		t.FileSystem().FileContent("file2", Equals("first line\nsecond line\nthird line2\n"))
+	},
+})
@@ -0,0 +1,58 @@
+package patch_building
+
+import (
+	"github.com/jesseduffield/lazygit/pkg/config"
+	. "github.com/jesseduffield/lazygit/pkg/integration/components"
+)
+
+var ToggleRange = NewIntegrationTest(NewIntegrationTestArgs{
+	Description:  "Add a range of a commit's files to a custom patch, extending the range over files that are in the patch already",
+	ExtraCmdArgs: []string{},
+	Skip:         false,
+	SetupConfig:  func(config *config.AppConfig) {},
+	SetupRepo: func(shell *Shell) {
+		shell.CreateFileAndAdd("dir/file1", "file1 content\n")
+		shell.CreateFileAndAdd("dir/file2", "file2 content\n")
+		shell.CreateFileAndAdd("file3", "file3 content\n")
+		shell.CreateFileAndAdd("file4", "file4 content\n")
+		shell.Commit("first commit")
+	},
+	Run: func(t *TestDriver, keys config.KeybindingConfig) {
+		t.Views().Commits().
+			Focus().
+			Lines(
+				Contains("first commit").IsSelected(),
+			).
+			PressEnter()
+
+		t.Views().CommitFiles().
+			IsFocused().
+			Lines(
+				Contains("▼ dir").IsSelected(),
+				Contains("file1"),
+				Contains("file2"),
+				Contains("file3"),
+				Contains("file4"),
+			).
+			NavigateToLine(Contains("file2")).
+			Press(keys.Universal.ToggleRangeSelect).
+			SelectNextItem().
+			PressPrimaryAction()
+
+		t.Views().Information().Content(Contains("Building patch"))
+
+		t.Views().Secondary().Content(
+			Contains("file2").Contains("file3").DoesNotContain("file1").DoesNotContain("file4"),
+		)
+
+		t.Views().CommitFiles().
+			// file3 is in the patch already, but dir/file1 isn't, so everything
+			// in the range gets added
+			NavigateToLine(Contains("▼ dir")).
+			PressPrimaryAction()
+
+		t.Views().Secondary().Content(
+			Contains("file1").Contains("file2").Contains("file3").DoesNotContain("file4"),
+		)
+	},
+})
@@ -0,0 +1,79 @@
+package reflog
+
+import (
+	"github.com/jesseduffield/lazygit/pkg/config"
+	. "github.com/jesseduffield/lazygit/pkg/integration/components"
+)
+
+var FilterAndGroupByDate = NewIntegrationTest(NewIntegrationTestArgs{
+	Description:  "Group reflog entries by day, and only show the entries of a given day",
+	ExtraCmdArgs: []string{},
+	Skip:         false,
+	SetupConfig:  func(config *config.AppConfig) {},
+	SetupRepo: func(shell *Shell) {
+		shell.EmptyCommitWithDate("one", "2023-01-02 10:00:00")
+		shell.EmptyCommitWithDate("two", "2023-01-03 10:00:00")
+		shell.EmptyCommitWithDate("three", "2023-01-03 11:00:00")
+	},
+	Run: func(t *TestDriver, keys config.KeybindingConfig) {
+		t.Views().ReflogCommits().
+			Focus().
+			Lines(
+				Contains("commit: three").IsSelected(),
+				Contains("commit: two"),
+				Contains("commit (initial): one"),
+			).
+			Press(keys.Commits.ViewReflogDateOptions).
+			Tap(func() {
+				t.ExpectPopup().Menu().
+					Title(Equals("View date options")).
+					Select(Contains("Group by day")).
+					Confirm()
+			}).
+			Lines(
+				Equals("--- Tuesday, 2023-01-03 ---"),
+				Contains("commit: three").IsSelected(),
+				Contains("commit: two"),
+				Equals("--- Monday, 2023-01-02 ---"),
+				Contains("commit (initial): one"),
+			).
+			Press(keys.Commits.ViewReflogDateOptions).
+			Tap(func() {
+				t.ExpectPopup().Menu().
+					Title(Equals("View date options")).
+					Select(Contains("Filter by date")).
+					Confirm()
+
+				t.ExpectPopup().Prompt().
+					Title(Equals("Show reflog entries from:")).
+					Type("2023-01-02").
+					Confirm()
+			}).
+			Lines(
+				Equals("--- Monday, 2023-01-02 ---"),
+				Contains("commit (initial): one").IsSelected(),
+			).
+			Press(keys.Commits.ViewReflogDateOptions).
+			Tap(func() {
+				t.ExpectPopup().Menu().
+					Title(Equals("View date options")).
+					Select(Contains("Don't group by day")).
+					Confirm()
+			}).
+			Lines(
+				Contains("commit (initial): one").IsSelected(),
+			).
+			Press(keys.Commits.ViewReflogDateOptions).
+			Tap(func() {
+				t.ExpectPopup().Menu().
+					Title(Equals("View date options")).
+					Select(Contains("Clear date filter")).
+					Confirm()
+			}).
+			Lines(
+				Contains("commit: three").IsSelected(),
+				Contains("commit: two"),
+				Contains("commit (initial): one"),
+			)
+	},
+})
@@ -0,0 +1,52 @@
+package staging
+
+import (
+	"github.com/jesseduffield/lazygit/pkg/config"
+	. "github.com/jesseduffield/lazygit/pkg/integration/components"
+)
+
+var JumpViaFileOutline = NewIntegrationTest(NewIntegrationTestArgs{
+	Description:  "When the staging panel is maximized, click a file in the outline next to it to jump to that file",
+	ExtraCmdArgs: []string{},
+	Skip:         false,
+	SetupConfig: func(config *config.AppConfig) {
+		config.UserConfig.Gui.ShowFileOutline = true
+	},
+	SetupRepo: func(shell *Shell) {
+		shell.CreateFileAndAdd("file1", "one\n")
+		shell.CreateFileAndAdd("file2", "two\n")
+		shell.Commit("one")
+
+		shell.UpdateFile("file1", "one\nfile1 change\n")
+		shell.UpdateFile("file2", "two\nfile2 change\n")
+	},
+	Run: func(t *TestDriver, keys config.KeybindingConfig) {
+		t.Views().Files().
+			IsFocused().
+			Lines(
+				Contains("file1").IsSelected(),
+				Contains("file2"),
+			).
+			PressEnter()
+
+		t.Views().Staging().
+			IsFocused().
+			Content(Contains("+file1 change")).
+			Press(keys.Universal.NextScreenMode).
+			Press(keys.Universal.NextScreenMode)
+
+		t.Views().Files().
+			IsVisible().
+			Click(0, 1)
+
+		t.Views().Staging().
+			IsFocused().
+			Content(Contains("+file2 change"))
+
+		t.Views().Files().
+			Lines(
+				Contains("file1"),
+				Contains("file2").IsSelected(),
+			)
+	},
+})
@@ -0,0 +1,79 @@
+package staging
+
+import (
+	"github.com/jesseduffield/lazygit/pkg/config"
+	. "github.com/jesseduffield/lazygit/pkg/integration/components"
+)
+
+var ParkSelection = NewIntegrationTest(NewIntegrationTestArgs{
+	Description:  "Park a hunk to get it out of the working tree, then restore it from the files panel",
+	ExtraCmdArgs: []string{},
+	Skip:         false,
+	SetupConfig:  func(config *config.AppConfig) {},
+	SetupRepo: func(shell *Shell) {
+		shell.CreateFileAndAdd("file1", "1a\n2a\n3a\n4a\n5a\n6a\n7a\n8a\n9a\n10a\n11a\n12a\n")
+		shell.Commit("one")
+
+		shell.UpdateFile("file1", "1a\n2b\n3a\n4a\n5a\n6a\n7a\n8a\n9a\n10a\n11b\n12a\n")
+	},
+	Run: func(t *TestDriver, keys config.KeybindingConfig) {
+		t.Views().Files().
+			IsFocused().
+			Lines(
+				Contains("file1").IsSelected(),
+			).
+			PressEnter()
+
+		t.Views().Staging().
+			IsFocused().
+			Press(keys.Main.ToggleSelectHunk).
+			SelectedLines(
+				Contains("@@ -1,5 +1,5 @@"),
+				Contains(" 1a"),
+				Contains("-2a"),
+				Contains("+2b"),
+				Contains(" 3a"),
+				Contains(" 4a"),
+				Contains(" 5a"),
+			).
+			Press(keys.Main.ParkSelection).
+			Tap(func() {
+				t.ExpectPopup().Prompt().
+					Title(Equals("Name for the parked changes")).
+					InitialText(Equals("file1")).
+					Clear().
+					Type("first change").
+					Confirm()
+			}).
+			Content(DoesNotContain("2b").Contains("-11a").Contains("+11b")).
+			PressEscape()
+
+		t.FileSystem().FileContent("file1", Equals("1a\n2a\n3a\n4a\n5a\n6a\n7a\n8a\n9a\n10a\n11b\n12a\n"))
+
+		t.Views().Files().
+			IsFocused().
+			Press(keys.Files.ViewParkedChanges).
+			Tap(func() {
+				t.ExpectPopup().Menu().
+					Title(Equals("View parked changes")).
+					Lines(
+						Contains("first change").Contains("file1").IsSelected(),
+						Contains("Cancel"),
+					).
+					Confirm()
+
+				t.ExpectPopup().Menu().
+					Title(Equals("first change (file1)")).
+					Select(Contains("Restore")).
+					Confirm()
+			})
+
+		t.FileSystem().FileContent("file1", Equals("1a\n2b\n3a\n4a\n5a\n6a\n7a\n8a\n9a\n10a\n11b\n12a\n"))
+
+		t.Views().Files().
+			Press(keys.Files.ViewParkedChanges).
+			Tap(func() {
+				t.ExpectToast(Equals("There are no parked changes"))
+			})
+	},
+})
@@ -0,0 +1,70 @@
+package staging
+
+import (
+	"github.com/jesseduffield/lazygit/pkg/config"
+	. "github.com/jesseduffield/lazygit/pkg/integration/components"
+)
+
+var SearchPersists = NewIntegrationTest(NewIntegrationTestArgs{
+	Description:  "A search in the staging panel keeps an up-to-date match count and survives switching to the other panel and back",
+	ExtraCmdArgs: []string{},
+	Skip:         false,
+	SetupConfig:  func(config *config.AppConfig) {},
+	SetupRepo: func(shell *Shell) {
+		filler := "1\n2\n3\n4\n5\n6\n7\n8\n9\n10\n"
+		shell.CreateFileAndAdd("file1", filler)
+		shell.Commit("one")
+
+		// the matches are far enough apart that staging the first one takes it
+		// out of the diff entirely. They're parenthesised so that git doesn't
+		// show them in hunk headers.
+		shell.UpdateFile("file1", "(first match)\n"+filler+"(second match)\n(third match)\n")
+	},
+	Run: func(t *TestDriver, keys config.KeybindingConfig) {
+		t.Views().Files().
+			IsFocused().
+			Lines(
+				Contains("file1").IsSelected(),
+			).
+			PressEnter()
+
+		t.Views().Staging().
+			IsFocused().
+			Press(keys.Universal.StartSearch).
+			Tap(func() {
+				t.ExpectSearch().
+					Type("match").
+					Confirm()
+
+				t.Views().Search().Content(Contains("matches for 'match' (1 of 3)"))
+			}).
+			SelectedLine(Contains("+(first match)")).
+			PressPrimaryAction().
+			Content(DoesNotContain("+(first match)")).
+			Tap(func() {
+				// the count follows the content
+				t.Views().Search().Content(Contains("matches for 'match' (1 of 2)"))
+			}).
+			Press(keys.Universal.TogglePanel)
+
+		t.Views().StagingSecondary().
+			IsFocused().
+			Press(keys.Universal.TogglePanel)
+
+		t.Views().Staging().
+			IsFocused().
+			Tap(func() {
+				t.Views().Search().Content(Contains("matches for 'match'"))
+			}).
+			Press(keys.Universal.NextMatch).
+			SelectedLine(Contains("+(third match)")).
+			Tap(func() {
+				t.Views().Search().Content(Contains("matches for 'match' (2 of 2)"))
+			}).
+			// escape clears the search
+			PressEscape().
+			Tap(func() {
+				t.Views().Search().IsInvisible()
+			})
+	},
+})
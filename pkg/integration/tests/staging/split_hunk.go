@@ -0,0 +1,68 @@
+package staging
+
+import (
+	"github.com/jesseduffield/lazygit/pkg/config"
+	. "github.com/jesseduffield/lazygit/pkg/integration/components"
+)
+
+var SplitHunk = NewIntegrationTest(NewIntegrationTestArgs{
+	Description:  "Split a hunk at the selected line and stage only the second part of it",
+	ExtraCmdArgs: []string{},
+	Skip:         false,
+	SetupConfig:  func(config *config.AppConfig) {},
+	SetupRepo: func(shell *Shell) {
+		shell.CreateFileAndAdd("file1", "1a\n2a\n3a\n4a\n5a\n6a\n7a\n8a\n")
+		shell.Commit("one")
+
+		// the changes are close enough together for git to put them into a
+		// single hunk
+		shell.UpdateFile("file1", "1a\n2a\n3b\n4a\n5a\n6b\n7a\n8a\n")
+	},
+	Run: func(t *TestDriver, keys config.KeybindingConfig) {
+		t.Views().Files().
+			IsFocused().
+			Lines(
+				Contains("file1").IsSelected(),
+			).
+			PressEnter()
+
+		t.Views().Staging().
+			IsFocused().
+			SelectedLines(
+				Contains("-3a"),
+			).
+			// there are no changes above this line, so there's nothing to split off
+			Press(keys.Main.SplitHunk).
+			Tap(func() {
+				t.ExpectToast(Equals("A hunk can only be split between two changes"))
+			}).
+			SelectNextItem().
+			SelectNextItem().
+			SelectedLines(
+				Contains(" 4a"),
+			).
+			Press(keys.Main.SplitHunk).
+			SelectedLines(
+				Contains(" 4a"),
+				Contains(" 5a"),
+				Contains("-6a"),
+				Contains("+6b"),
+				Contains(" 7a"),
+				Contains(" 8a"),
+			).
+			SelectPreviousItem().
+			SelectedLines(
+				Contains("@@ -1,8 +1,8 @@"),
+				Contains(" 1a"),
+				Contains(" 2a"),
+				Contains("-3a"),
+				Contains("+3b"),
+			).
+			SelectNextItem().
+			PressPrimaryAction().
+			Content(Contains("-3a").Contains("+3b").DoesNotContain("-6a").DoesNotContain("+6b"))
+
+		t.Views().StagingSecondary().
+			Content(Contains("-6a").Contains("+6b").DoesNotContain("-3a").DoesNotContain("+3b"))
+	},
+})
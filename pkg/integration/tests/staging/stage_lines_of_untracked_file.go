@@ -0,0 +1,98 @@
+package staging
+
+import (
+	"github.com/jesseduffield/lazygit/pkg/config"
+	. "github.com/jesseduffield/lazygit/pkg/integration/components"
+)
+
+var StageLinesOfUntrackedFile = NewIntegrationTest(NewIntegrationTestArgs{
+	Description:  "Stage some lines of an untracked file, which is made untracked again when nothing of it ends up staged",
+	ExtraCmdArgs: []string{},
+	Skip:         false,
+	SetupConfig:  func(config *config.AppConfig) {},
+	SetupRepo: func(shell *Shell) {
+		shell.EmptyCommit("initial commit")
+		shell.CreateFile("file1", "one\ntwo\nthree\n")
+		shell.CreateFile("file2", "four\n")
+	},
+	Run: func(t *TestDriver, keys config.KeybindingConfig) {
+		t.Views().Files().
+			IsFocused().
+			Lines(
+				Equals("?? file1").IsSelected(),
+				Equals("?? file2"),
+			).
+			PressEnter()
+
+		// the file is marked as intent-to-add so that we can stage its lines
+		t.Views().Files().
+			Lines(
+				Equals(" A file1").IsSelected(),
+				Equals("?? file2"),
+			)
+
+		t.Views().Staging().
+			IsFocused().
+			SelectedLines(Contains("+one")).
+			NavigateToLine(Contains("+two")).
+			PressPrimaryAction().
+			ContainsLines(
+				Contains("+one"),
+				Contains(" two"),
+				Contains("+three"),
+			)
+
+		t.Views().StagingSecondary().
+			ContainsLines(
+				Contains("+two"),
+			)
+
+		t.Views().Staging().
+			PressEscape()
+
+		t.Views().Files().
+			IsFocused().
+			Lines(
+				Equals("AM file1").IsSelected(),
+				Equals("?? file2"),
+			).
+			NavigateToLine(Contains("file2")).
+			PressEnter()
+
+		t.Views().Staging().
+			IsFocused().
+			SelectedLines(Contains("+four")).
+			PressEscape()
+
+		// nothing of file2 was staged, so it's untracked again
+		t.Views().Files().
+			IsFocused().
+			Lines(
+				Equals("AM file1"),
+				Equals("?? file2").IsSelected(),
+			).
+			NavigateToLine(Contains("file1")).
+			PressEnter()
+
+		t.Views().Staging().
+			IsFocused().
+			Press(keys.Universal.TogglePanel)
+
+		t.Views().StagingSecondary().
+			IsFocused().
+			SelectedLines(Contains("+two")).
+			PressPrimaryAction()
+
+		// unstaging everything makes the file untracked again once we're done
+		t.Views().Staging().
+			IsFocused().
+			PressEscape()
+
+		t.Views().Files().
+			IsFocused().
+			Lines(
+				Equals("?? file1").IsSelected(),
+				Equals("?? file2"),
+			)
+	},
+})
@@ -0,0 +1,46 @@
+package stash
+
+import (
+	"github.com/jesseduffield/lazygit/pkg/config"
+	. "github.com/jesseduffield/lazygit/pkg/integration/components"
+)
+
+var ApplyWithIndex = NewIntegrationTest(NewIntegrationTestArgs{
+	Description:  "Apply a stash entry, restoring which of its changes were staged",
+	ExtraCmdArgs: []string{},
+	Skip:         false,
+	SetupConfig:  func(config *config.AppConfig) {},
+	SetupRepo: func(shell *Shell) {
+		shell.CreateFileAndAdd("staged", "one\n")
+		shell.CreateFileAndAdd("unstaged", "one\n")
+		shell.Commit("initial commit")
+		shell.UpdateFileAndAdd("staged", "two\n")
+		shell.UpdateFile("unstaged", "two\n")
+		shell.Stash("stash one")
+	},
+	Run: func(t *TestDriver, keys config.KeybindingConfig) {
+		t.Views().Files().IsEmpty()
+
+		t.Views().Stash().
+			Focus().
+			Lines(
+				Contains("stash one").IsSelected(),
+			).
+			Press(keys.Stash.ApplyStashWithIndex).
+			Tap(func() {
+				t.ExpectPopup().Confirmation().
+					Title(Equals("Stash apply")).
+					Content(Contains("restoring its staged changes to the index")).
+					Confirm()
+			}).
+			Lines(
+				Contains("stash one").IsSelected(),
+			)
+
+		t.Views().Files().
+			Lines(
+				Contains("M  staged"),
+				Contains(" M unstaged"),
+			)
+	},
+})
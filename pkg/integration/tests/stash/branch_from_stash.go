@@ -0,0 +1,55 @@
+package stash
+
+import (
+	"github.com/jesseduffield/lazygit/pkg/config"
+	. "github.com/jesseduffield/lazygit/pkg/integration/components"
+)
+
+var BranchFromStash = NewIntegrationTest(NewIntegrationTestArgs{
+	Description:  "Create a branch at the base of a stash entry and apply the entry there",
+	ExtraCmdArgs: []string{},
+	Skip:         false,
+	SetupConfig:  func(config *config.AppConfig) {},
+	SetupRepo: func(shell *Shell) {
+		shell.CreateFileAndAdd("file", "one\n")
+		shell.Commit("initial commit")
+		shell.UpdateFile("file", "one\nstashed\n")
+		shell.Stash("stash one")
+		shell.UpdateFileAndAdd("file", "one\ntwo\n")
+		shell.Commit("second commit")
+	},
+	Run: func(t *TestDriver, keys config.KeybindingConfig) {
+		t.Views().Stash().
+			Focus().
+			Lines(
+				Contains("stash one").IsSelected(),
+			).
+			Press(keys.Stash.BranchFromStash).
+			Tap(func() {
+				t.ExpectPopup().Prompt().
+					Title(Equals("New branch name (branch is off of the base of 'stash@{0}: On master: stash one')")).
+					Type("stashed branch").
+					Confirm()
+			}).
+			IsEmpty()
+
+		t.Views().Files().
+			IsFocused().
+			Lines(
+				Contains(" M file"),
+			)
+
+		t.Views().Branches().
+			Lines(
+				Contains("stashed-branch").IsSelected(),
+				Contains("master"),
+			)
+
+		t.Views().Commits().
+			Lines(
+				Contains("initial commit"),
+			)
+
+		t.FileSystem().FileContent("file", Equals("one\nstashed\n"))
+	},
+})
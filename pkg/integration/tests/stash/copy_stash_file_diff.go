@@ -0,0 +1,54 @@
+package stash
+
+import (
+	"github.com/jesseduffield/lazygit/pkg/config"
+	. "github.com/jesseduffield/lazygit/pkg/integration/components"
+)
+
+var CopyStashFileDiff = NewIntegrationTest(NewIntegrationTestArgs{
+	Description:  "Copy the name and diff of a file in a stash entry from the copy menu",
+	ExtraCmdArgs: []string{},
+	Skip:         false,
+	SetupConfig: func(config *config.AppConfig) {
+		config.UserConfig.OS.CopyToClipboardCmd = "echo {{text}} > clipboard"
+	},
+	SetupRepo: func(shell *Shell) {
+		shell.EmptyCommit("initial commit")
+		shell.CreateFileAndAdd("file", "stashed content\n")
+		shell.Stash("stash one")
+	},
+	Run: func(t *TestDriver, keys config.KeybindingConfig) {
+		t.Views().Stash().
+			Focus().
+			Lines(
+				Contains("stash one").IsSelected(),
+			).
+			PressEnter()
+
+		t.Views().CommitFiles().
+			IsFocused().
+			Lines(
+				Contains("file").IsSelected(),
+			).
+			Press(keys.Files.CopyFileInfoToClipboard).
+			Tap(func() {
+				t.ExpectPopup().Menu().
+					Title(Equals("Copy to clipboard")).
+					Select(Contains("File name")).
+					Confirm()
+
+				t.ExpectToast(Equals("File name copied to clipboard"))
+				t.FileSystem().FileContent("clipboard", Equals("file\n"))
+			}).
+			Press(keys.Files.CopyFileInfoToClipboard).
+			Tap(func() {
+				t.ExpectPopup().Menu().
+					Title(Equals("Copy to clipboard")).
+					Select(Contains("Diff of selected file")).
+					Confirm()
+
+				t.ExpectToast(Equals("File diff copied to clipboard"))
+				t.FileSystem().FileContent("clipboard", Contains("+stashed content"))
+			})
+	},
+})
@@ -0,0 +1,51 @@
+package stash
+
+import (
+	"github.com/jesseduffield/lazygit/pkg/config"
+	. "github.com/jesseduffield/lazygit/pkg/integration/components"
+)
+
+var DropMultiple = NewIntegrationTest(NewIntegrationTestArgs{
+	Description:  "Drop multiple stash entries at once, previewing their diffs first",
+	ExtraCmdArgs: []string{},
+	Skip:         false,
+	SetupConfig:  func(config *config.AppConfig) {},
+	SetupRepo: func(shell *Shell) {
+		shell.EmptyCommit("initial commit")
+		shell.CreateFileAndAdd("file1", "content1")
+		shell.Stash("stash one")
+		shell.CreateFileAndAdd("file2", "content2")
+		shell.Stash("stash two")
+		shell.CreateFileAndAdd("file3", "content3")
+		shell.Stash("stash three")
+	},
+	Run: func(t *TestDriver, keys config.KeybindingConfig) {
+		t.Views().Stash().
+			Focus().
+			Lines(
+				Contains("stash three").IsSelected(),
+				Contains("stash two"),
+				Contains("stash one"),
+			).
+			Press(keys.Universal.ToggleRangeSelect).
+			SelectNextItem().
+			Tap(func() {
+				t.Views().Main().
+					Content(Contains("+content3")).
+					Content(Contains("+content2")).
+					Content(DoesNotContain("+content1"))
+			}).
+			Press(keys.Universal.Remove).
+			Tap(func() {
+				t.ExpectPopup().Confirmation().
+					Title(Equals("Stash drop")).
+					Content(Contains("Are you sure you want to drop these 2 stash entries?")).
+					Content(Contains("stash@{0}: On master: stash three")).
+					Content(Contains("stash@{1}: On master: stash two")).
+					Confirm()
+			}).
+			Lines(
+				Contains("stash one").IsSelected(),
+			)
+	},
+})
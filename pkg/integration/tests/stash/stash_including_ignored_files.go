@@ -0,0 +1,49 @@
+package stash
+
+import (
+	"github.com/jesseduffield/lazygit/pkg/config"
+	. "github.com/jesseduffield/lazygit/pkg/integration/components"
+)
+
+var StashIncludingIgnoredFiles = NewIntegrationTest(NewIntegrationTestArgs{
+	Description:  "Stashing all files including untracked and ignored ones, after a warning",
+	ExtraCmdArgs: []string{},
+	Skip:         false,
+	SetupConfig:  func(config *config.AppConfig) {},
+	SetupRepo: func(shell *Shell) {
+		shell.CreateFileAndAdd(".gitignore", "ignored\n")
+		shell.Commit("initial commit")
+		shell.CreateFile("untracked", "content")
+		shell.CreateFile("ignored", "content")
+	},
+	Run: func(t *TestDriver, keys config.KeybindingConfig) {
+		t.Views().Stash().
+			IsEmpty()
+
+		t.Views().Files().
+			Lines(
+				Contains("untracked"),
+			).
+			Press(keys.Files.ViewStashOptions)
+
+		t.ExpectPopup().Menu().Title(Equals("Stash options")).Select(Contains("Stash all changes including untracked and ignored files")).Confirm()
+
+		t.ExpectPopup().Confirmation().
+			Title(Equals("Stash all changes including untracked and ignored files")).
+			Content(Contains("This also stashes ignored files")).
+			Confirm()
+
+		t.ExpectPopup().Prompt().Title(Equals("Stash changes")).Type("my stashed files").Confirm()
+
+		t.Views().Stash().
+			Lines(
+				Contains("my stashed files"),
+			)
+
+		t.Views().Files().
+			IsEmpty()
+
+		t.FileSystem().PathNotPresent("untracked")
+		t.FileSystem().PathNotPresent("ignored")
+	},
+})
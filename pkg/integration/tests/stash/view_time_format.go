@@ -0,0 +1,33 @@
+package stash
+
+import (
+	"github.com/jesseduffield/lazygit/pkg/config"
+	. "github.com/jesseduffield/lazygit/pkg/integration/components"
+)
+
+var ViewTimeFormat = NewIntegrationTest(NewIntegrationTestArgs{
+	Description:  "Show absolute dates in the stash view using the time formats configured for it",
+	ExtraCmdArgs: []string{},
+	Skip:         false,
+	SetupConfig: func(config *config.AppConfig) {
+		config.UserConfig.Gui.DateDisplay.Stash = "absolute"
+		config.UserConfig.Gui.ViewTimeFormats.Stash.TimeFormat = "2006-01-02"
+		config.UserConfig.Gui.ViewTimeFormats.Stash.ShortTimeFormat = "2006-01-02"
+	},
+	SetupRepo: func(shell *Shell) {
+		shell.EmptyCommit("initial commit")
+		shell.CreateFileAndAdd("file", "content")
+		shell.Stash("stash one")
+	},
+	Run: func(t *TestDriver, keys config.KeybindingConfig) {
+		t.Views().Stash().
+			Focus().
+			Lines(
+				MatchesRegexp(`^\d{4}-\d{2}-\d{2} .*stash one`).IsSelected(),
+			).
+			Press(keys.Universal.ToggleDateDisplay).
+			Lines(
+				MatchesRegexp(`^\s*\d+[smhdwMy] .*stash one`).IsSelected(),
+			)
+	},
+})
@@ -37,9 +37,9 @@ var Add = NewIntegrationTest(NewIntegrationTestArgs{
 			)
 
 		t.Views().Main().TopLines(
-			Contains("Name: my_submodule"),
-			Contains("Path: my_submodule_path"),
-			Contains("Url:  ../other_repo"),
+			Contains("Name:   my_submodule"),
+			Contains("Path:   my_submodule_path"),
+			Contains("Url:    ../other_repo"),
 		)
 
 		t.Views().Files().Focus().
@@ -0,0 +1,67 @@
+package submodule
+
+import (
+	"github.com/jesseduffield/lazygit/pkg/config"
+	. "github.com/jesseduffield/lazygit/pkg/integration/components"
+)
+
+var NestedBreadcrumbs = NewIntegrationTest(NewIntegrationTestArgs{
+	Description:  "Enter a nested submodule, see the chain of repos in the status panel, and jump straight back to the top-level repo",
+	ExtraCmdArgs: []string{},
+	Skip:         false,
+	SetupConfig:  func(config *config.AppConfig) {},
+	SetupRepo: func(shell *Shell) {
+		shell.EmptyCommit("first commit")
+
+		shell.RunShellCommand("git init ../nested_repo && git -C ../nested_repo commit --allow-empty -m 'nested commit'")
+		shell.RunShellCommand("git init ../child_repo && git -C ../child_repo commit --allow-empty -m 'child commit'")
+		shell.RunShellCommand("git -C ../child_repo submodule add ../nested_repo nested && git -C ../child_repo commit -m 'add nested'")
+
+		shell.RunCommand([]string{"git", "submodule", "add", "../child_repo", "child"})
+		shell.RunCommand([]string{"git", "submodule", "update", "--init", "--recursive"})
+		shell.GitAddAll()
+		shell.Commit("add child")
+	},
+	Run: func(t *TestDriver, keys config.KeybindingConfig) {
+		t.Views().Status().Content(Contains("repo →"))
+
+		t.Views().Submodules().Focus().
+			Lines(
+				Contains("child").IsSelected(),
+			).
+			PressEnter()
+
+		t.Views().Status().Content(Contains("repo > child"))
+
+		t.Views().Submodules().Focus().
+			Lines(
+				Contains("nested").IsSelected(),
+			).
+			PressEnter()
+
+		t.Views().Status().Content(Contains("repo > child > nested"))
+
+		t.GlobalPress(keys.Universal.OpenRecentRepos)
+
+		t.ExpectPopup().Menu().
+			Title(Equals("Recent repositories")).
+			TopLines(
+				Contains("--- Parent repositories ---"),
+				Contains("child"),
+				Contains("repo"),
+			).
+			Select(MatchesRegexp(`^repo\s`)).
+			Confirm()
+
+		t.Views().Status().Content(Contains("repo → master"))
+
+		// we've left the submodules behind, so escape doesn't take us anywhere
+		t.Views().Submodules().Focus().
+			Lines(
+				Contains("child").IsSelected(),
+			).
+			PressEscape()
+
+		t.Views().Status().Content(Contains("repo → master"))
+	},
+})
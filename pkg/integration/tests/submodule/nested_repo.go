@@ -0,0 +1,68 @@
+package submodule
+
+import (
+	"github.com/jesseduffield/lazygit/pkg/config"
+	. "github.com/jesseduffield/lazygit/pkg/integration/components"
+)
+
+var NestedRepo = NewIntegrationTest(NewIntegrationTestArgs{
+	Description:  "Open a nested repo from the files panel, then convert it to a submodule",
+	ExtraCmdArgs: []string{},
+	Skip:         false,
+	SetupConfig:  func(config *config.AppConfig) {},
+	SetupRepo: func(shell *Shell) {
+		shell.EmptyCommit("first commit")
+		shell.Clone("other_repo")
+		shell.RunCommand([]string{"git", "clone", "../other_repo", "nested"})
+		shell.CreateDir("plain")
+		shell.CreateFile("plain/file", "content")
+	},
+	Run: func(t *TestDriver, keys config.KeybindingConfig) {
+		t.Views().Files().Focus().
+			Lines(
+				Contains("plain").IsSelected(),
+				Contains("file"),
+				Contains("nested (nested repo)"),
+			).
+			NavigateToLine(Contains("nested")).
+			PressEnter().
+			Tap(func() {
+				t.ExpectPopup().Menu().
+					Title(Equals("Nested repository 'nested'")).
+					Select(Contains("Open repository")).
+					Confirm()
+
+				t.Views().Status().Content(Contains("nested → master"))
+			}).
+			PressEscape().
+			Tap(func() {
+				t.Views().Status().Content(Contains("repo → master"))
+			})
+
+		t.Views().Files().
+			IsFocused().
+			PressEnter().
+			Tap(func() {
+				t.ExpectPopup().Menu().
+					Title(Equals("Nested repository 'nested'")).
+					Select(Contains("Convert to submodule")).
+					Confirm()
+
+				t.ExpectPopup().Prompt().
+					Title(Equals("New submodule URL:")).
+					InitialText(Contains("other_repo")).
+					Confirm()
+			}).
+			Lines(
+				Contains("plain"),
+				Contains("file"),
+				Contains(".gitmodules"),
+				Contains("nested (submodule)"),
+			)
+
+		t.Views().Submodules().Focus().
+			Lines(
+				Contains("nested").IsSelected(),
+			)
+	},
+})
@@ -0,0 +1,41 @@
+package submodule
+
+import (
+	"github.com/jesseduffield/lazygit/pkg/config"
+	. "github.com/jesseduffield/lazygit/pkg/integration/components"
+)
+
+var RunCommandInSubmodules = NewIntegrationTest(NewIntegrationTestArgs{
+	Description:  "Run a command in each submodule from the bulk submodule menu",
+	ExtraCmdArgs: []string{},
+	Skip:         false,
+	SetupConfig: func(cfg *config.AppConfig) {
+		cfg.UserConfig.Git.SubmoduleForeachCommands = []string{"echo hello from $name > greeting"}
+	},
+	SetupRepo: func(shell *Shell) {
+		shell.EmptyCommit("first commit")
+		shell.CloneIntoSubmodule("my_submodule")
+		shell.GitAddAll()
+		shell.Commit("add submodule")
+	},
+	Run: func(t *TestDriver, keys config.KeybindingConfig) {
+		t.Views().Submodules().Focus().
+			Press(keys.Submodules.BulkMenu)
+
+		t.ExpectPopup().Menu().
+			Title(Equals("Bulk submodule options")).
+			Select(Contains("Run command in each submodule")).
+			Confirm()
+
+		t.ExpectPopup().Prompt().
+			Title(Equals("Command to run in each submodule")).
+			SuggestionLines(Contains("echo hello from $name > greeting")).
+			ConfirmFirstSuggestion()
+
+		t.Views().Extras().
+			Content(Contains(`git submodule foreach --recursive "echo hello from $name > greeting"`))
+
+		// the command runs in the submodule's directory
+		t.FileSystem().FileContent("my_submodule/greeting", Equals("hello from my_submodule\n"))
+	},
+})
@@ -0,0 +1,84 @@
+package submodule
+
+import (
+	"github.com/jesseduffield/lazygit/pkg/config"
+	. "github.com/jesseduffield/lazygit/pkg/integration/components"
+)
+
+var UpdateStrategy = NewIntegrationTest(NewIntegrationTestArgs{
+	Description:  "Set the branch a submodule tracks and its update strategy, then update it from the remote",
+	ExtraCmdArgs: []string{},
+	Skip:         false,
+	SetupConfig:  func(config *config.AppConfig) {},
+	SetupRepo: func(shell *Shell) {
+		shell.EmptyCommit("first commit")
+		shell.CloneIntoSubmodule("my_submodule")
+		shell.GitAddAll()
+		shell.Commit("add submodule")
+
+		// add a commit on a develop branch in the submodule's remote
+		shell.RunShellCommand("git -C my_submodule commit --allow-empty -m 'develop commit'")
+		shell.RunShellCommand("git -C my_submodule push origin HEAD:refs/heads/develop")
+		shell.RunShellCommand("git -C my_submodule reset --hard HEAD^")
+	},
+	Run: func(t *TestDriver, keys config.KeybindingConfig) {
+		t.Views().Submodules().Focus().
+			Lines(
+				Contains("my_submodule").IsSelected(),
+			).
+			Tap(func() {
+				t.Views().Main().Content(
+					Contains("Branch: (remote default)").
+						Contains("Update: checkout"),
+				)
+			}).
+			Press(keys.Submodules.UpdateOptions).
+			Tap(func() {
+				t.ExpectPopup().Menu().
+					Title(Equals("Submodule update options")).
+					Select(Contains("Set tracked branch")).
+					Confirm()
+
+				t.ExpectPopup().Prompt().
+					Title(Equals("Branch for submodule 'my_submodule' to track (leave empty for the remote's default branch):")).
+					Type("develop").
+					Confirm()
+
+				t.Views().Main().Content(Contains("Branch: develop"))
+			}).
+			Press(keys.Submodules.UpdateOptions).
+			Tap(func() {
+				t.ExpectPopup().Menu().
+					Title(Equals("Submodule update options")).
+					Select(Contains("Set update strategy")).
+					Confirm()
+
+				t.ExpectPopup().Menu().
+					Title(Equals("Set update strategy")).
+					Select(Contains("rebase")).
+					Confirm()
+
+				t.Views().Main().Content(Contains("Update: rebase"))
+
+				t.FileSystem().FileContent(".gitmodules",
+					Contains("branch = develop").
+						Contains("update = rebase"),
+				)
+			}).
+			Press(keys.Submodules.UpdateOptions).
+			Tap(func() {
+				t.ExpectPopup().Menu().
+					Title(Equals("Submodule update options")).
+					Select(Contains("Update from remote")).
+					Confirm()
+			}).
+			PressEnter()
+
+		t.Views().Status().Content(Contains("my_submodule"))
+
+		t.Views().Commits().
+			TopLines(
+				Contains("develop commit"),
+			)
+	},
+})
@@ -0,0 +1,58 @@
+package sync
+
+import (
+	"github.com/jesseduffield/lazygit/pkg/config"
+	. "github.com/jesseduffield/lazygit/pkg/integration/components"
+)
+
+var AddForkRemote = NewIntegrationTest(NewIntegrationTestArgs{
+	Description:  "Add a remote for a fork of the origin repo, which becomes the default push remote",
+	ExtraCmdArgs: []string{},
+	Skip:         false,
+	SetupConfig:  func(config *config.AppConfig) {},
+	SetupRepo: func(shell *Shell) {
+		shell.EmptyCommit("one")
+		shell.Clone("fork")
+		shell.RunCommand([]string{"git", "remote", "add", "origin", "git@github.com:upstream/repo.git"})
+		// fetching the fork fetches our local clone instead
+		shell.SetConfig("url.../fork.insteadOf", "git@github.com:me/repo.git")
+		shell.SetConfig("branch.master.remote", "me")
+		shell.SetConfig("branch.master.merge", "refs/heads/master")
+	},
+	Run: func(t *TestDriver, keys config.KeybindingConfig) {
+		t.Views().Remotes().
+			Focus().
+			Lines(
+				Contains("origin").IsSelected(),
+			).
+			Press(keys.Branches.AddForkRemote).
+			Tap(func() {
+				t.ExpectPopup().Prompt().
+					Title(Equals("Username of the fork owner:")).
+					Type("me").
+					Confirm()
+			}).
+			Lines(
+				Equals("origin 0 branches").IsSelected(),
+				Equals("me     1 branches (push default)"),
+			).
+			NavigateToLine(Contains("me")).
+			Tap(func() {
+				t.Views().Main().Content(
+					Contains("git@github.com:me/repo.git").
+						Contains("Tracked by:\nmaster → master"),
+				)
+			}).
+			NavigateToLine(Contains("origin")).
+			Press(keys.Branches.ToggleDefaultPushRemote).
+			Lines(
+				Equals("origin 0 branches (push default)").IsSelected(),
+				Equals("me     1 branches"),
+			).
+			Press(keys.Branches.ToggleDefaultPushRemote).
+			Lines(
+				Equals("origin 0 branches").IsSelected(),
+				Equals("me     1 branches"),
+			)
+	},
+})
@@ -0,0 +1,47 @@
+package sync
+
+import (
+	"github.com/jesseduffield/lazygit/pkg/config"
+	. "github.com/jesseduffield/lazygit/pkg/integration/components"
+)
+
+var FetchNotes = NewIntegrationTest(NewIntegrationTestArgs{
+	Description:  "Fetch git notes from a remote from the remotes panel and see them on the commit",
+	ExtraCmdArgs: []string{},
+	Skip:         false,
+	SetupConfig:  func(config *config.AppConfig) {},
+	SetupRepo: func(shell *Shell) {
+		shell.EmptyCommit("one")
+		shell.CloneIntoRemote("origin")
+		shell.RunCommand([]string{"git", "-C", "../origin", "notes", "add", "-m", "note from a teammate", "HEAD"})
+	},
+	Run: func(t *TestDriver, keys config.KeybindingConfig) {
+		t.Views().Commits().
+			Focus().
+			Lines(
+				Contains("one").IsSelected(),
+			)
+
+		t.Views().Main().Content(DoesNotContain("note from a teammate"))
+
+		t.Views().Remotes().
+			Focus().
+			Lines(
+				Contains("origin").IsSelected(),
+			).
+			Press(keys.Branches.SyncNotes)
+
+		t.ExpectPopup().Menu().
+			Title(Equals("Push/fetch notes")).
+			Select(Contains("Fetch notes from 'origin'")).
+			Confirm()
+
+		t.Views().Commits().
+			Focus().
+			Lines(
+				Contains("one").IsSelected(),
+			)
+
+		t.Views().Main().Content(Contains("Notes:").Contains("note from a teammate"))
+	},
+})
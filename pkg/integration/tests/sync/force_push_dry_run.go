@@ -0,0 +1,57 @@
+package sync
+
+import (
+	"github.com/jesseduffield/lazygit/pkg/config"
+	. "github.com/jesseduffield/lazygit/pkg/integration/components"
+)
+
+var ForcePushDryRun = NewIntegrationTest(NewIntegrationTestArgs{
+	Description:  "Force push in dry run mode, which shows the command and the overwritten commits instead of pushing",
+	ExtraCmdArgs: []string{},
+	Skip:         false,
+	SetupConfig:  func(config *config.AppConfig) {},
+	SetupRepo: func(shell *Shell) {
+		shell.EmptyCommit("one")
+		shell.EmptyCommit("two")
+
+		shell.CloneIntoRemote("origin")
+		shell.SetBranchUpstream("master", "origin/master")
+
+		// remove the 'two' commit so that we have something to pull from the remote
+		shell.HardReset("HEAD^")
+	},
+	Run: func(t *TestDriver, keys config.KeybindingConfig) {
+		t.Views().Status().Content(Contains("↓1 repo → master"))
+
+		t.Views().Files().IsFocused().Press(keys.Universal.ToggleDryRun)
+
+		t.ExpectToast(Equals("Dry run mode enabled"))
+
+		t.Views().Information().Content(Contains("Dry run: destructive commands are only shown"))
+
+		t.Views().Files().Press(keys.Universal.Push)
+
+		t.ExpectPopup().Confirmation().
+			Title(Equals("Force push")).
+			Content(Equals("Your branch has diverged from the remote branch. Press <esc> to cancel, or <enter> to force push.")).
+			Confirm()
+
+		t.ExpectPopup().Confirmation().
+			Title(Equals("Dry run")).
+			Content(
+				Contains("git push --force-with-lease").
+					Contains("origin/master would be set to").
+					Contains("1 commit(s) on origin/master that aren't in your branch would be lost"),
+			).
+			Confirm()
+
+		// nothing was pushed
+		t.Views().Status().Content(Contains("↓1 repo → master"))
+
+		t.Views().Files().Press(keys.Universal.ToggleDryRun)
+
+		t.ExpectToast(Equals("Dry run mode disabled"))
+
+		t.Views().Information().Content(DoesNotContain("Dry run"))
+	},
+})
@@ -0,0 +1,48 @@
+package sync
+
+import (
+	"github.com/jesseduffield/lazygit/pkg/config"
+	. "github.com/jesseduffield/lazygit/pkg/integration/components"
+)
+
+var ForcePushSharedBranch = NewIntegrationTest(NewIntegrationTestArgs{
+	Description:  "Force push a branch that someone else pushed commits to, which requires typing the branch name",
+	ExtraCmdArgs: []string{},
+	Skip:         false,
+	SetupConfig:  func(config *config.AppConfig) {},
+	SetupRepo: func(shell *Shell) {
+		shell.SetAuthor("Me", "me@example.com")
+		shell.EmptyCommit("one")
+		shell.SetAuthor("Joe", "joe@example.com")
+		shell.EmptyCommit("two")
+
+		shell.CloneIntoRemote("origin")
+		shell.SetBranchUpstream("master", "origin/master")
+
+		shell.SetAuthor("Me", "me@example.com")
+		// remove Joe's commit so that force pushing would drop it from the remote
+		shell.HardReset("HEAD^")
+	},
+	Run: func(t *TestDriver, keys config.KeybindingConfig) {
+		t.Views().Status().Content(Contains("↓1 repo → master"))
+
+		t.Views().Files().IsFocused().Press(keys.Universal.Push)
+
+		t.ExpectPopup().Confirmation().
+			Title(Equals("Force push")).
+			Content(
+				Contains("'master' looks like it's shared with others:").
+					Contains("- Joe pushed commits to it").
+					Contains("Your branch is 0 commits ahead of and 1 commits behind 'origin/master'").
+					Contains("two (Joe)"),
+			).
+			Confirm()
+
+		t.ExpectPopup().Prompt().
+			Title(Equals("Force push (type 'master' to confirm)")).
+			Type("master").
+			Confirm()
+
+		t.Views().Status().Content(Contains("✓ repo → master"))
+	},
+})
@@ -0,0 +1,51 @@
+package sync
+
+import (
+	"github.com/jesseduffield/lazygit/pkg/config"
+	. "github.com/jesseduffield/lazygit/pkg/integration/components"
+)
+
+var MarkCommitsAppliedUpstream = NewIntegrationTest(NewIntegrationTestArgs{
+	Description:  "Unpushed commits whose changes already exist upstream are marked, and are dropped when pulling with a rebase",
+	ExtraCmdArgs: []string{},
+	Skip:         false,
+	SetupConfig:  func(config *config.AppConfig) {},
+	SetupRepo: func(shell *Shell) {
+		shell.CreateFileAndAdd("file1", "content1")
+		shell.Commit("one")
+		shell.CreateFileAndAdd("file2", "content2")
+		shell.Commit("two")
+
+		shell.CloneIntoRemote("origin")
+
+		shell.SetBranchUpstream("master", "origin/master")
+
+		shell.HardReset("HEAD^")
+		shell.CreateFileAndAdd("file3", "content3")
+		shell.Commit("three")
+		shell.RunCommand([]string{"git", "cherry-pick", "origin/master"})
+
+		shell.SetConfig("pull.rebase", "true")
+	},
+	Run: func(t *TestDriver, keys config.KeybindingConfig) {
+		t.Views().Commits().
+			Lines(
+				Contains("= already upstream two"),
+				Contains("three").DoesNotContain("already upstream"),
+				Contains("one").DoesNotContain("already upstream"),
+			)
+
+		t.Views().Files().
+			IsFocused().
+			Press(keys.Universal.Pull)
+
+		t.Views().Status().Content(Contains("↑1 repo → master"))
+
+		t.Views().Commits().
+			Lines(
+				Contains("three").DoesNotContain("already upstream"),
+				Contains("two").DoesNotContain("already upstream"),
+				Contains("one"),
+			)
+	},
+})
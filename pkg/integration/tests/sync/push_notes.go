@@ -0,0 +1,33 @@
+package sync
+
+import (
+	"github.com/jesseduffield/lazygit/pkg/config"
+	. "github.com/jesseduffield/lazygit/pkg/integration/components"
+)
+
+var PushNotes = NewIntegrationTest(NewIntegrationTestArgs{
+	Description:  "Push git notes to a remote from the remotes panel",
+	ExtraCmdArgs: []string{},
+	Skip:         false,
+	SetupConfig:  func(config *config.AppConfig) {},
+	SetupRepo: func(shell *Shell) {
+		shell.EmptyCommit("one")
+		shell.CloneIntoRemote("origin")
+		shell.RunCommand([]string{"git", "notes", "add", "-m", "my note", "HEAD"})
+	},
+	Run: func(t *TestDriver, keys config.KeybindingConfig) {
+		t.Views().Remotes().
+			Focus().
+			Lines(
+				Contains("origin").IsSelected(),
+			).
+			Press(keys.Branches.SyncNotes)
+
+		t.ExpectPopup().Menu().
+			Title(Equals("Push/fetch notes")).
+			Select(Contains("Push notes to 'origin'")).
+			Confirm()
+
+		t.Git().RemoteRefExists("origin", "refs/notes/commits")
+	},
+})
@@ -33,9 +33,9 @@ var RenameBranchAndPull = NewIntegrationTest(NewIntegrationTestArgs{
 			).
 			Press(keys.Branches.RenameBranch).
 			Tap(func() {
-				t.ExpectPopup().Confirmation().
+				t.ExpectPopup().Menu().
 					Title(Equals("Rename branch")).
-					Content(Equals("This branch is tracking a remote. This action will only rename the local branch name, not the name of the remote branch. Continue?")).
+					Select(Contains("Rename local branch only")).
 					Confirm()
 
 				t.ExpectPopup().Prompt().
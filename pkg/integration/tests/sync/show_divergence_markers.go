@@ -0,0 +1,60 @@
+package sync
+
+import (
+	"github.com/jesseduffield/lazygit/pkg/config"
+	. "github.com/jesseduffield/lazygit/pkg/integration/components"
+)
+
+var ShowDivergenceMarkers = NewIntegrationTest(NewIntegrationTestArgs{
+	Description:  "Show markers in the commits panel separating incoming, unpushed and pushed commits, and update them after a fetch",
+	ExtraCmdArgs: []string{},
+	Skip:         false,
+	SetupConfig: func(config *config.AppConfig) {
+		config.UserConfig.Gui.ShowDivergenceMarkers = true
+	},
+	SetupRepo: func(shell *Shell) {
+		shell.EmptyCommit("one")
+		shell.EmptyCommit("two")
+		shell.EmptyCommit("three")
+
+		shell.CloneIntoRemote("origin")
+
+		shell.SetBranchUpstream("master", "origin/master")
+
+		// a commit that we'll later push to the remote from elsewhere
+		shell.NewBranch("other")
+		shell.EmptyCommit("six")
+		shell.Checkout("master")
+
+		shell.HardReset("HEAD^^")
+		shell.EmptyCommit("four")
+		shell.EmptyCommit("five")
+	},
+	Run: func(t *TestDriver, keys config.KeybindingConfig) {
+		t.Views().Commits().
+			Lines(
+				Equals("--- ↓2 incoming from origin/master ---"),
+				Equals("--- ↑2 to push ---"),
+				Contains("five"),
+				Contains("four"),
+				Equals("--- Pushed ---"),
+				Contains("one"),
+			)
+
+		t.Shell().RunCommand([]string{"git", "push", "origin", "other:master"})
+
+		t.Views().Files().
+			IsFocused().
+			Press(keys.Files.Fetch)
+
+		t.Views().Commits().
+			Lines(
+				Equals("--- ↓3 incoming from origin/master ---"),
+				Equals("--- ↑2 to push ---"),
+				Contains("five"),
+				Contains("four"),
+				Equals("--- Pushed ---"),
+				Contains("one"),
+			)
+	},
+})
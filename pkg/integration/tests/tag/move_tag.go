@@ -0,0 +1,66 @@
+package tag
+
+import (
+	"github.com/jesseduffield/lazygit/pkg/config"
+	. "github.com/jesseduffield/lazygit/pkg/integration/components"
+)
+
+var MoveTag = NewIntegrationTest(NewIntegrationTestArgs{
+	Description:  "Move an annotated tag that has already been pushed to the selected commit, and force-push it",
+	ExtraCmdArgs: []string{},
+	Skip:         false,
+	SetupConfig:  func(config *config.AppConfig) {},
+	SetupRepo: func(shell *Shell) {
+		shell.EmptyCommit("one")
+		shell.CreateAnnotatedTag("v1.0", "release 1.0", "HEAD")
+		shell.EmptyCommit("two")
+		shell.CloneIntoRemote("origin")
+		shell.RunCommand([]string{"git", "push", "origin", "v1.0"})
+	},
+	Run: func(t *TestDriver, keys config.KeybindingConfig) {
+		t.Views().Commits().
+			Focus().
+			Lines(
+				Contains("two").IsSelected(),
+				Contains("one"),
+			).
+			Press(keys.Commits.MoveTag)
+
+		t.ExpectPopup().Prompt().
+			Title(Equals("Tag to move to this commit:")).
+			SuggestionLines(Equals("v1.0")).
+			ConfirmFirstSuggestion()
+
+		t.ExpectPopup().Menu().
+			Title(Equals("Move tag 'v1.0'")).
+			Select(Contains("Move tag and force-push it to a remote")).
+			Confirm()
+
+		t.ExpectPopup().Prompt().
+			Title(Equals("Remote to push tag 'v1.0' to:")).
+			InitialText(Equals("origin")).
+			Confirm()
+
+		t.ExpectPopup().Confirmation().
+			Title(Equals("Rewrite published tag")).
+			Content(Contains("you are about to replace tag 'v1.0' on 'origin'")).
+			Confirm()
+
+		t.Git().
+			TagNamesAt("HEAD", []string{"v1.0"}).
+			TagNamesAt("HEAD^", []string{}).
+			RemoteTagPointsAt("origin", "v1.0", "HEAD")
+
+		t.Views().Commits().
+			Lines(
+				Contains("v1.0").Contains("two").IsSelected(),
+				DoesNotContain("v1.0").Contains("one"),
+			)
+
+		t.Views().Tags().
+			Focus().
+			Lines(
+				Contains("v1.0").Contains("release 1.0").IsSelected(),
+			)
+	},
+})
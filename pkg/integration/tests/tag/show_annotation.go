@@ -0,0 +1,45 @@
+package tag
+
+import (
+	"github.com/jesseduffield/lazygit/pkg/config"
+	. "github.com/jesseduffield/lazygit/pkg/integration/components"
+)
+
+var ShowAnnotation = NewIntegrationTest(NewIntegrationTestArgs{
+	Description:  "Show the tag object of an annotated tag above the commit it points to",
+	ExtraCmdArgs: []string{},
+	Skip:         false,
+	SetupConfig:  func(config *config.AppConfig) {},
+	SetupRepo: func(shell *Shell) {
+		shell.EmptyCommit("initial commit")
+		shell.CreateLightweightTag("lightweight-tag", "HEAD")
+		shell.EmptyCommit("second commit")
+		shell.CreateAnnotatedTag("annotated-tag", "my tag message", "HEAD")
+	},
+	Run: func(t *TestDriver, keys config.KeybindingConfig) {
+		t.Views().Tags().
+			Focus().
+			Lines(
+				Contains("annotated-tag").IsSelected(),
+				Contains("lightweight-tag"),
+			).
+			Tap(func() {
+				t.Views().Main().
+					ContainsLines(
+						Equals("tag annotated-tag"),
+						Contains("Tagger:").Contains("CI <CI@example.com>"),
+						Contains("Date:"),
+						Equals("Signature: unsigned"),
+						Equals(""),
+						Equals("    my tag message"),
+					).
+					Content(Contains("second commit"))
+			}).
+			NavigateToLine(Contains("lightweight-tag")).
+			Tap(func() {
+				t.Views().Main().
+					Content(DoesNotContain("Tagger:")).
+					Content(Contains("initial commit"))
+			})
+	},
+})
@@ -0,0 +1,59 @@
+package tag
+
+import (
+	"github.com/jesseduffield/lazygit/pkg/config"
+	. "github.com/jesseduffield/lazygit/pkg/integration/components"
+)
+
+var VerifySignature = NewIntegrationTest(NewIntegrationTestArgs{
+	Description:  "Show whether the selected tag's signature is good or bad, and verify a signature to see which key made it",
+	ExtraCmdArgs: []string{},
+	Skip:         false,
+	SetupConfig:  func(config *config.AppConfig) {},
+	SetupRepo: func(shell *Shell) {
+		shell.RunShellCommand("ssh-keygen -q -t ed25519 -N '' -C good -f ../good_key")
+		shell.RunShellCommand("ssh-keygen -q -t ed25519 -N '' -C bad -f ../bad_key")
+		shell.RunShellCommand(`echo "CI@example.com $(cat ../good_key.pub)" > ../allowed_signers`)
+		shell.SetConfig("gpg.format", "ssh")
+		shell.SetConfig("gpg.ssh.allowedSignersFile", "../allowed_signers")
+
+		shell.EmptyCommit("one")
+		shell.CreateAnnotatedTag("unsigned-tag", "unsigned", "HEAD")
+		shell.RunShellCommand("git -c user.signingkey=../good_key tag -s good-tag -m good")
+		shell.RunShellCommand("git -c user.signingkey=../bad_key tag -s bad-tag -m bad")
+	},
+	Run: func(t *TestDriver, keys config.KeybindingConfig) {
+		t.Views().Tags().
+			Focus().
+			// signatures are only verified once a tag gets selected
+			Lines(
+				Contains("bad-tag").IsSelected(),
+				Contains("good-tag").DoesNotContain("✓"),
+				Contains("unsigned-tag"),
+			).
+			Tap(func() {
+				t.Views().Main().Content(Contains("Signature: bad or unverifiable"))
+			}).
+			Content(MatchesRegexp(`bad-tag\s+✗`)).
+			NavigateToLine(Contains("good-tag")).
+			Tap(func() {
+				t.Views().Main().Content(Contains("Signature: verified"))
+			}).
+			Content(MatchesRegexp(`good-tag\s+✓`)).
+			Press(keys.Branches.VerifyTag).
+			Tap(func() {
+				t.ExpectPopup().Alert().
+					Title(Equals("Signature of tag 'good-tag'")).
+					Content(Contains("Good \"git\" signature for CI@example.com with ED25519 key SHA256:")).
+					Confirm()
+			}).
+			NavigateToLine(Contains("unsigned-tag")).
+			Tap(func() {
+				t.Views().Main().Content(Contains("Signature: unsigned"))
+			}).
+			Press(keys.Branches.VerifyTag).
+			Tap(func() {
+				t.ExpectToast(Equals("Disabled: This tag is not signed."))
+			})
+	},
+})
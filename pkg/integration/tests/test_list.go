@@ -33,13 +33,22 @@ import (
 var tests = []*components.IntegrationTest{
 	bisect.Basic,
 	bisect.ChooseTerms,
+	bisect.ExportAndReplayLog,
 	bisect.FromOtherBranch,
 	bisect.Skip,
+	bisect.SkipRange,
+	bisect.StatusOverview,
 	branch.CheckoutByName,
+	branch.CleanUpGoneBranches,
+	branch.CompareBranches,
 	branch.CreateTag,
+	branch.CustomStatusGlyphs,
 	branch.Delete,
 	branch.DeleteRemoteBranchWithCredentialPrompt,
+	branch.DeleteWithConfirmationLevels,
 	branch.DetachedHead,
+	branch.MergeStatusOverview,
+	branch.OpenLink,
 	branch.OpenPullRequestNoUpstream,
 	branch.OpenWithCliArg,
 	branch.Rebase,
@@ -48,8 +57,14 @@ var tests = []*components.IntegrationTest{
 	branch.RebaseCancelOnConflict,
 	branch.RebaseDoesNotAutosquash,
 	branch.RebaseFromMarkedBase,
+	branch.RebaseInteractiveWithAutosquash,
+	branch.RebaseOntoCommit,
+	branch.RebaseOntoCommitWithUpstream,
+	branch.RebaseStatusOverview,
 	branch.RebaseToUpstream,
 	branch.Rename,
+	branch.RenameOnRemote,
+	branch.RenameOnRemoteLocalCollision,
 	branch.Reset,
 	branch.ResetToUpstream,
 	branch.SetUpstream,
@@ -57,40 +72,62 @@ var tests = []*components.IntegrationTest{
 	branch.SortLocalBranches,
 	branch.SortRemoteBranches,
 	branch.Suggestions,
+	branch.ToggleDateDisplay,
 	branch.UnsetUpstream,
 	cherry_pick.CherryPick,
 	cherry_pick.CherryPickConflicts,
 	cherry_pick.CherryPickDuringRebase,
+	cherry_pick.CherryPickSequenceOutsideLazygit,
 	commit.AddCoAuthor,
 	commit.Amend,
+	commit.ClickLinkInMainView,
 	commit.Commit,
 	commit.CommitMultiline,
 	commit.CommitSwitchToEditor,
 	commit.CommitWipWithPrefix,
 	commit.CommitWithPrefix,
+	commit.CopyCommitFilesMenu,
+	commit.CopyToClipboard,
 	commit.CreateTag,
 	commit.DiscardOldFileChange,
+	commit.EditTrailers,
 	commit.FindBaseCommitForFixup,
 	commit.FindBaseCommitForFixupWarningForAddedLines,
 	commit.Highlight,
 	commit.History,
 	commit.HistoryComplex,
+	commit.Minimap,
 	commit.NewBranch,
+	commit.OpenLink,
+	commit.Peek,
 	commit.PreserveCommitMessage,
+	commit.QuickFixup,
 	commit.ResetAuthor,
 	commit.Revert,
+	commit.RevertIntoWorkingTree,
 	commit.RevertMerge,
+	commit.RevertRange,
+	commit.RevertRangeSquashed,
 	commit.Reword,
+	commit.RewordWithCommitMsgHook,
 	commit.Search,
 	commit.SetAuthor,
+	commit.ShowContainingRefs,
 	commit.StageRangeOfLines,
 	commit.Staged,
 	commit.StagedWithoutHooks,
 	commit.Unstaged,
+	commit.ViewCommitsOfRange,
+	config.KeybindingConflicts,
 	config.RemoteNamedStar,
+	conflicts.AutoContinueOnConflictsResolved,
+	conflicts.ConflictCount,
 	conflicts.Filter,
+	conflicts.JumpBetweenConflictedFiles,
+	conflicts.PickBaseInDiff3Style,
 	conflicts.ResolveExternally,
 	conflicts.ResolveMultipleFiles,
+	conflicts.ToggleConflictStyleAfterResolving,
 	conflicts.UndoChooseHunk,
 	custom_commands.BasicCmdAtRuntime,
 	custom_commands.BasicCmdFromConfig,
@@ -101,7 +138,9 @@ var tests = []*components.IntegrationTest{
 	custom_commands.MenuFromCommandsOutput,
 	custom_commands.MultiplePrompts,
 	custom_commands.OmitFromHistory,
+	custom_commands.SelectedRangeInMultipleContexts,
 	custom_commands.SuggestionsCommand,
+	custom_commands.SuggestionsCommandWithFormat,
 	custom_commands.SuggestionsPreset,
 	demo.AmendOldCommit,
 	demo.Bisect,
@@ -119,18 +158,36 @@ var tests = []*components.IntegrationTest{
 	demo.Undo,
 	demo.WorktreeCreateFromBranches,
 	diff.Diff,
+	diff.DiffAgainstEarlierState,
 	diff.DiffAndApplyPatch,
 	diff.DiffCommits,
 	diff.IgnoreWhitespace,
+	diff.SplitDiff,
+	file.CollapseAndExpandAll,
 	file.CopyMenu,
+	file.CycleSortOrder,
 	file.DirWithUntrackedFile,
+	file.DirectoryDiff,
 	file.DiscardAllDirChanges,
 	file.DiscardChanges,
+	file.DiscardHunks,
 	file.DiscardStagedChanges,
+	file.DiscardToTrash,
 	file.DiscardUnstagedDirChanges,
 	file.DiscardUnstagedFileChanges,
 	file.Gitignore,
+	file.GroupByChangeType,
+	file.IgnoreRules,
+	file.NukeWorkingTreeDryRun,
+	file.RangeOperations,
 	file.RememberCommitMessageAfterFail,
+	file.ShowNumstat,
+	file.StageByPattern,
+	file.StageLargeFiles,
+	file.ToggleExecutable,
+	file.ToggleIndexFlags,
+	file.ViewFileHistory,
+	file.ViewFileOwnership,
 	filter_and_search.FilterCommitFiles,
 	filter_and_search.FilterFiles,
 	filter_and_search.FilterFuzzy,
@@ -140,10 +197,12 @@ var tests = []*components.IntegrationTest{
 	filter_and_search.FilterRemotes,
 	filter_and_search.FilterSearchHistory,
 	filter_and_search.FilterUpdatesWhenModelChanges,
+	filter_and_search.FuzzyFinder,
 	filter_and_search.NestedFilter,
 	filter_and_search.NestedFilterTransient,
 	filter_and_search.NewSearch,
 	filter_by_path.CliArg,
+	filter_by_path.SelectDirectory,
 	filter_by_path.SelectFile,
 	filter_by_path.TypeFile,
 	interactive_rebase.AdvancedInteractiveRebase,
@@ -158,8 +217,12 @@ var tests = []*components.IntegrationTest{
 	interactive_rebase.EditFirstCommit,
 	interactive_rebase.EditNonTodoCommitDuringRebase,
 	interactive_rebase.EditTheConflCommit,
+	interactive_rebase.EditWithMergeStructure,
+	interactive_rebase.FailedExec,
+	interactive_rebase.FailedExecRescheduled,
 	interactive_rebase.FixupFirstCommit,
 	interactive_rebase.FixupSecondCommit,
+	interactive_rebase.MouseEditTodos,
 	interactive_rebase.Move,
 	interactive_rebase.MoveInRebase,
 	interactive_rebase.MoveWithCustomCommentChar,
@@ -171,20 +234,24 @@ var tests = []*components.IntegrationTest{
 	interactive_rebase.RewordLastCommit,
 	interactive_rebase.RewordYouAreHereCommit,
 	interactive_rebase.RewordYouAreHereCommitWithEditor,
+	interactive_rebase.ShowRebaseProgress,
 	interactive_rebase.SquashDownFirstCommit,
 	interactive_rebase.SquashDownSecondCommit,
 	interactive_rebase.SquashFixupsAboveFirstCommit,
 	interactive_rebase.SwapInRebaseWithConflict,
 	interactive_rebase.SwapInRebaseWithConflictAndEdit,
 	interactive_rebase.SwapWithConflict,
+	misc.AuthorStats,
 	misc.ConfirmOnQuit,
 	misc.CopyToClipboard,
 	misc.DisabledKeybindings,
 	misc.InitialOpen,
 	misc.RecentReposOnLaunch,
+	misc.RepoHealth,
 	patch_building.Apply,
 	patch_building.ApplyInReverse,
 	patch_building.ApplyInReverseWithConflict,
+	patch_building.ApplySelection,
 	patch_building.MoveToEarlierCommit,
 	patch_building.MoveToEarlierCommitNoKeepEmpty,
 	patch_building.MoveToIndex,
@@ -200,38 +267,60 @@ var tests = []*components.IntegrationTest{
 	patch_building.SelectAllFiles,
 	patch_building.SpecificSelection,
 	patch_building.StartNewPatch,
+	patch_building.ToggleRange,
 	reflog.Checkout,
 	reflog.CherryPick,
 	reflog.DoNotShowBranchMarkersInReflogSubcommits,
+	reflog.FilterAndGroupByDate,
 	reflog.Patch,
 	reflog.Reset,
 	staging.DiffContextChange,
 	staging.DiscardAllChanges,
+	staging.JumpViaFileOutline,
+	staging.ParkSelection,
 	staging.Search,
+	staging.SearchPersists,
+	staging.SplitHunk,
 	staging.StageHunks,
 	staging.StageLines,
+	staging.StageLinesOfUntrackedFile,
 	staging.StageRanges,
 	stash.Apply,
 	stash.ApplyPatch,
+	stash.ApplyWithIndex,
+	stash.BranchFromStash,
+	stash.CopyStashFileDiff,
 	stash.CreateBranch,
 	stash.Drop,
+	stash.DropMultiple,
 	stash.Pop,
 	stash.PreventDiscardingFileChanges,
 	stash.Rename,
 	stash.Stash,
 	stash.StashAll,
 	stash.StashAndKeepIndex,
+	stash.StashIncludingIgnoredFiles,
 	stash.StashIncludingUntrackedFiles,
 	stash.StashStaged,
 	stash.StashUnstaged,
+	stash.ViewTimeFormat,
 	submodule.Add,
 	submodule.Enter,
+	submodule.NestedBreadcrumbs,
+	submodule.NestedRepo,
 	submodule.Remove,
 	submodule.Reset,
+	submodule.RunCommandInSubmodules,
+	submodule.UpdateStrategy,
+	sync.AddForkRemote,
+	sync.FetchNotes,
 	sync.FetchPrune,
 	sync.ForcePush,
+	sync.ForcePushDryRun,
 	sync.ForcePushMultipleMatching,
 	sync.ForcePushMultipleUpstream,
+	sync.ForcePushSharedBranch,
+	sync.MarkCommitsAppliedUpstream,
 	sync.Pull,
 	sync.PullAndSetUpstream,
 	sync.PullMerge,
@@ -245,9 +334,11 @@ var tests = []*components.IntegrationTest{
 	sync.PushAndSetUpstream,
 	sync.PushFollowTags,
 	sync.PushNoFollowTags,
+	sync.PushNotes,
 	sync.PushTag,
 	sync.PushWithCredentialPrompt,
 	sync.RenameBranchAndPull,
+	sync.ShowDivergenceMarkers,
 	tag.Checkout,
 	tag.CheckoutWhenBranchWithSameNameExists,
 	tag.CreateWhileCommitting,
@@ -255,11 +346,21 @@ var tests = []*components.IntegrationTest{
 	tag.CrudLightweight,
 	tag.ForceTagAnnotated,
 	tag.ForceTagLightweight,
+	tag.MoveTag,
 	tag.Reset,
+	tag.ShowAnnotation,
+	tag.VerifySignature,
 	ui.Accordion,
+	ui.CommandLogActions,
+	ui.CommandLogRerunExactArgs,
 	ui.DoublePopup,
 	ui.EmptyMenu,
+	ui.ExportAndImportUiState,
 	ui.OpenLinkFailure,
+	ui.PresenterMode,
+	ui.PresenterModeActionDelay,
+	ui.RestoreSessionState,
+	ui.SwitchLanguage,
 	ui.SwitchTabFromMenu,
 	undo.UndoCheckoutAndDrop,
 	undo.UndoDrop,
@@ -275,7 +376,13 @@ var tests = []*components.IntegrationTest{
 	worktree.DotfileBareRepo,
 	worktree.FastForwardWorktreeBranch,
 	worktree.ForceRemoveWorktree,
+	worktree.OpenInTerminal,
+	worktree.OpenInTerminalNotConfigured,
+	worktree.PruneWorktrees,
 	worktree.RemoveWorktreeFromBranch,
+	worktree.RepairMovedWorktree,
 	worktree.ResetWindowTabs,
 	worktree.WorktreeInRepo,
+	worktree.WorktreeMetadata,
+	worktree.WorktreeMetadataError,
 }
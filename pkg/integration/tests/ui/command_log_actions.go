@@ -0,0 +1,99 @@
+package ui
+
+import (
+	"github.com/jesseduffield/lazygit/pkg/config"
+	. "github.com/jesseduffield/lazygit/pkg/integration/components"
+)
+
+var CommandLogActions = NewIntegrationTest(NewIntegrationTestArgs{
+	Description:  "Select commands in the command log to copy, re-run and filter them",
+	ExtraCmdArgs: []string{},
+	Skip:         false,
+	SetupConfig: func(config *config.AppConfig) {
+		config.UserConfig.OS.CopyToClipboardCmd = "echo {{text}} > clipboard"
+	},
+	SetupRepo: func(shell *Shell) {
+		shell.CreateFile("file1", "content")
+	},
+	Run: func(t *TestDriver, keys config.KeybindingConfig) {
+		t.Views().Files().
+			IsFocused().
+			Lines(
+				Contains("?? file1").IsSelected(),
+			).
+			PressPrimaryAction().
+			Lines(
+				Contains("A  file1").IsSelected(),
+			).
+			PressPrimaryAction().
+			Lines(
+				Contains("?? file1").IsSelected(),
+			)
+
+		t.GlobalPress(keys.Universal.ExtrasMenu)
+
+		t.ExpectPopup().Menu().
+			Title(Equals("Command log")).
+			Select(Contains("Focus command log")).
+			Confirm()
+
+		t.Views().Extras().
+			IsFocused().
+			Lines(
+				Contains("Stage file").Contains("git add -- file1"),
+				Contains("Unstage file").Contains("git rm --cached --force -- file1").IsSelected(),
+			).
+			SelectPreviousItem().
+			Press(keys.Universal.CopyToClipboard)
+
+		t.ExpectToast(Equals("Command copied to clipboard"))
+		t.FileSystem().FileContent("clipboard", Equals("git add -- file1\n"))
+		t.Shell().DeleteFile("clipboard")
+
+		t.Views().Extras().
+			Press(keys.Universal.FilteringMenu)
+
+		t.ExpectPopup().Menu().
+			Title(Equals("Filter by subsystem")).
+			Lines(
+				Equals("All"),
+				Equals("add"),
+				Equals("rm"),
+				// the clipboard command we ran in this test
+				Equals("bash"),
+				Equals("Cancel"),
+			).
+			Select(Equals("add")).
+			Confirm()
+
+		t.Views().Extras().
+			IsFocused().
+			Title(Equals("Command log (add)")).
+			Lines(
+				Contains("Stage file").Contains("git add -- file1").IsSelected(),
+			).
+			PressPrimaryAction()
+
+		t.ExpectPopup().Confirmation().
+			Title(Equals("Re-run command")).
+			Content(Equals("Are you sure you want to re-run 'git add -- file1'?")).
+			Confirm()
+
+		t.Views().Files().
+			Lines(
+				Contains("A  file1"),
+			)
+
+		t.Views().Extras().
+			IsFocused().
+			PressEscape()
+
+		t.Views().Files().
+			IsFocused()
+
+		t.Views().Extras().
+			Title(Equals("Command log")).
+			Content(Contains("Unstage file")).
+			Content(Contains("Re-run command"))
+	},
+})
@@ -0,0 +1,56 @@
+package ui
+
+import (
+	"github.com/jesseduffield/lazygit/pkg/config"
+	. "github.com/jesseduffield/lazygit/pkg/integration/components"
+)
+
+var CommandLogRerunExactArgs = NewIntegrationTest(NewIntegrationTestArgs{
+	Description:  "Re-running a command from the command log runs its original args rather than passing its text to the shell",
+	ExtraCmdArgs: []string{},
+	Skip:         false,
+	SetupConfig:  func(config *config.AppConfig) {},
+	SetupRepo: func(shell *Shell) {
+		shell.CreateFile("$(touch injected)", "content")
+	},
+	Run: func(t *TestDriver, keys config.KeybindingConfig) {
+		t.Views().Files().
+			IsFocused().
+			Lines(
+				Contains("?? $(touch injected)").IsSelected(),
+			).
+			PressPrimaryAction().
+			PressPrimaryAction().
+			Lines(
+				Contains("?? $(touch injected)").IsSelected(),
+			)
+
+		t.GlobalPress(keys.Universal.ExtrasMenu)
+
+		t.ExpectPopup().Menu().
+			Title(Equals("Command log")).
+			Select(Contains("Focus command log")).
+			Confirm()
+
+		t.Views().Extras().
+			IsFocused().
+			Lines(
+				Contains("Stage file").Contains(`git add -- "$(touch injected)"`),
+				Contains("Unstage file").IsSelected(),
+			).
+			SelectPreviousItem().
+			PressPrimaryAction()
+
+		t.ExpectPopup().Confirmation().
+			Title(Equals("Re-run command")).
+			Content(Contains(`git add -- "$(touch injected)"`)).
+			Confirm()
+
+		t.Views().Files().
+			Lines(
+				Contains("A  $(touch injected)"),
+			)
+
+		t.FileSystem().PathNotPresent("injected")
+	},
+})
@@ -0,0 +1,102 @@
+package ui
+
+import (
+	"github.com/jesseduffield/lazygit/pkg/config"
+	. "github.com/jesseduffield/lazygit/pkg/integration/components"
+)
+
+var ExportAndImportUiState = NewIntegrationTest(NewIntegrationTestArgs{
+	Description:  "Export the UI state of a repo to a file and import it again",
+	ExtraCmdArgs: []string{},
+	Skip:         false,
+	SetupConfig:  func(config *config.AppConfig) {},
+	SetupRepo: func(shell *Shell) {
+		shell.EmptyCommit("initial commit")
+		shell.NewBranch("alpha")
+		shell.NewBranch("beta")
+		shell.Checkout("master")
+		shell.CreateDir("dir")
+		shell.CreateFile("dir/a", "a")
+		shell.CreateFile("dir/b", "b")
+		shell.CreateFile("other", "other")
+	},
+	Run: func(t *TestDriver, keys config.KeybindingConfig) {
+		t.Views().Files().
+			Focus().
+			Lines(
+				Contains("▼ dir").IsSelected(),
+				Contains("?? a"),
+				Contains("?? b"),
+				Contains("?? other"),
+			).
+			PressEnter().
+			Lines(
+				Contains("▶ dir").IsSelected(),
+				Contains("?? other"),
+			)
+
+		t.Views().Branches().
+			Focus().
+			NavigateToLine(Contains("beta"))
+
+		t.Views().Status().
+			Focus().
+			Press(keys.Status.UIState)
+
+		t.ExpectPopup().Menu().
+			Title(Equals("Export/import UI state")).
+			Select(Contains("Export UI state to file")).
+			Confirm()
+
+		t.ExpectPopup().Prompt().
+			Title(Equals("Path to UI state file:")).
+			Clear().
+			Type("state.yml").
+			Confirm()
+
+		t.ExpectToast(Equals("Exported UI state to state.yml"))
+
+		t.Views().Files().
+			Focus().
+			NavigateToLine(Contains("▶ dir")).
+			PressEnter().
+			Lines(
+				Contains("▼ dir").IsSelected(),
+				Contains("?? a"),
+				Contains("?? b"),
+				Contains("?? other"),
+			)
+
+		t.Views().Branches().
+			Focus().
+			NavigateToLine(Contains("master"))
+
+		t.Views().Status().
+			Focus().
+			Press(keys.Status.UIState)
+
+		t.ExpectPopup().Menu().
+			Title(Equals("Export/import UI state")).
+			Select(Contains("Import UI state from file")).
+			Confirm()
+
+		t.ExpectPopup().Prompt().
+			Title(Equals("Path to UI state file:")).
+			Clear().
+			Type("state.yml").
+			Confirm()
+
+		t.ExpectToast(Equals("Imported UI state from state.yml"))
+
+		t.Views().Files().
+			Lines(
+				Contains("▶ dir"),
+				Contains("?? other"),
+				Contains("?? state.yml"),
+			)
+
+		t.Views().Branches().
+			Focus().
+			SelectedLine(Contains("beta"))
+	},
+})
@@ -0,0 +1,52 @@
+package ui
+
+import (
+	"github.com/jesseduffield/lazygit/pkg/config"
+	. "github.com/jesseduffield/lazygit/pkg/integration/components"
+)
+
+var PresenterMode = NewIntegrationTest(NewIntegrationTestArgs{
+	Description:  "Toggle presenter mode and check that pressed keys are shown along with their actions",
+	ExtraCmdArgs: []string{},
+	Skip:         false,
+	SetupConfig: func(config *config.AppConfig) {
+		// not delaying actions, to keep the test quick
+		config.UserConfig.Gui.PresenterMode.ActionDelay = 0
+	},
+	SetupRepo: func(shell *Shell) {
+		shell.CreateFileAndAdd("file", "content")
+		shell.CreateFile("other", "content")
+	},
+	Run: func(t *TestDriver, keys config.KeybindingConfig) {
+		t.Views().Keystrokes().IsInvisible()
+
+		t.Views().Files().
+			Focus().
+			Press(keys.Universal.TogglePresenterMode)
+
+		t.ExpectToast(Equals("Presenter mode enabled"))
+
+		t.Views().Files().
+			Lines(
+				Contains("A  file").IsSelected(),
+				Contains("?? other"),
+			).
+			PressPrimaryAction().
+			Lines(
+				Contains("?? file").IsSelected(),
+				Contains("?? other"),
+			).
+			SelectNextItem()
+
+		t.Views().Keystrokes().
+			IsVisible().
+			Content(Equals("<space> Toggle staged\n<down>"))
+
+		t.Views().Files().
+			Press(keys.Universal.TogglePresenterMode)
+
+		t.ExpectToast(Equals("Presenter mode disabled"))
+
+		t.Views().Keystrokes().IsInvisible()
+	},
+})
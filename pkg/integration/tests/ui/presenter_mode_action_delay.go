@@ -0,0 +1,49 @@
+package ui
+
+import (
+	"github.com/jesseduffield/lazygit/pkg/config"
+	. "github.com/jesseduffield/lazygit/pkg/integration/components"
+)
+
+var PresenterModeActionDelay = NewIntegrationTest(NewIntegrationTestArgs{
+	Description:  "In presenter mode, delayed actions run in the order their keys were pressed, each against the state left by the previous one, and still report errors",
+	ExtraCmdArgs: []string{},
+	Skip:         false,
+	SetupConfig: func(config *config.AppConfig) {
+		config.UserConfig.Gui.PresenterMode.Enabled = true
+		config.UserConfig.Gui.PresenterMode.ActionDelay = 50
+	},
+	SetupRepo: func(shell *Shell) {
+		shell.CreateFileAndAdd("file", "content")
+	},
+	Run: func(t *TestDriver, keys config.KeybindingConfig) {
+		t.Views().Files().
+			IsFocused().
+			Lines(
+				Contains("A  file").IsSelected(),
+			).
+			// unstaging and then discarding the file, so that committing
+			// fails because there's nothing left to commit
+			PressPrimaryAction().
+			Press(keys.Universal.Remove)
+
+		t.ExpectPopup().Menu().
+			Title(Equals("file")).
+			Select(Contains("Discard all changes")).
+			Confirm()
+
+		t.Views().Files().
+			IsEmpty().
+			Press(keys.Files.CommitChanges)
+
+		t.ExpectPopup().Alert().
+			Title(Equals("Error")).
+			Content(Equals("No files staged")).
+			Confirm()
+
+		t.Views().Keystrokes().
+			IsVisible().
+			Content(Contains("<space> Toggle staged")).
+			Content(Contains("c Commit"))
+	},
+})
@@ -0,0 +1,47 @@
+package ui
+
+import (
+	"os"
+
+	"github.com/jesseduffield/lazygit/pkg/config"
+	. "github.com/jesseduffield/lazygit/pkg/integration/components"
+)
+
+var RestoreSessionState = NewIntegrationTest(NewIntegrationTestArgs{
+	Description:  "Restore the UI state that a previous session left the repo in",
+	ExtraCmdArgs: []string{},
+	Skip:         false,
+	SetupConfig: func(appConfig *config.AppConfig) {
+		appConfig.UserConfig.Gui.PersistSessionState = true
+
+		// lazygit is started in the repo, so this is the path that a previous
+		// session would have stored the repo's state under
+		repoPath, err := os.Getwd()
+		if err != nil {
+			panic(err)
+		}
+		appConfig.AppState.SessionStates = map[string]*config.SessionState{
+			repoPath: {
+				CurrentContext:   "localBranches",
+				SelectedItemIds:  map[string]string{"localBranches": "beta"},
+				SelectedLineIdxs: map[string]int{"localBranches": 0, "commits": 1},
+			},
+		}
+	},
+	SetupRepo: func(shell *Shell) {
+		shell.EmptyCommit("first commit")
+		shell.EmptyCommit("second commit")
+		shell.NewBranch("alpha")
+		shell.NewBranch("beta")
+		shell.Checkout("master")
+	},
+	Run: func(t *TestDriver, keys config.KeybindingConfig) {
+		t.Views().Branches().
+			IsFocused().
+			SelectedLine(Contains("beta"))
+
+		t.Views().Commits().
+			Focus().
+			SelectedLine(Contains("first commit"))
+	},
+})
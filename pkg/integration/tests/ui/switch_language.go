@@ -0,0 +1,59 @@
+package ui
+
+import (
+	"os"
+	"path/filepath"
+
+	"github.com/jesseduffield/lazygit/pkg/config"
+	. "github.com/jesseduffield/lazygit/pkg/integration/components"
+)
+
+var SwitchLanguage = NewIntegrationTest(NewIntegrationTestArgs{
+	Description:  "Switch to a user-provided language from the status panel and back again, without restarting",
+	ExtraCmdArgs: []string{},
+	Skip:         false,
+	SetupConfig: func(config *config.AppConfig) {
+		translationsDir := filepath.Join(config.GetUserConfigDir(), "translations")
+		if err := os.MkdirAll(translationsDir, 0o755); err != nil {
+			panic(err)
+		}
+
+		translations := "FilesTitle: Dateien\nChangeLanguage: Sprache wechseln\n"
+		if err := os.WriteFile(filepath.Join(translationsDir, "de.yml"), []byte(translations), 0o644); err != nil {
+			panic(err)
+		}
+	},
+	SetupRepo: func(shell *Shell) {},
+	Run: func(t *TestDriver, keys config.KeybindingConfig) {
+		t.Views().Files().Title(Equals("Files"))
+
+		t.Views().Status().
+			Focus().
+			Press(keys.Status.ChangeLanguage)
+
+		t.ExpectPopup().Menu().
+			Title(Equals("Change language")).
+			Select(MatchesRegexp(`^de\b`)).
+			Tap(func() {
+				t.Views().Menu().SelectedLine(Contains("from translations directory"))
+			}).
+			Confirm()
+
+		t.Views().Files().Title(Equals("Dateien"))
+
+		t.Views().Status().
+			IsFocused().
+			Press(keys.Status.ChangeLanguage)
+
+		t.ExpectPopup().Menu().
+			Title(Equals("Sprache wechseln")).
+			Select(MatchesRegexp(`^de\b`)).
+			Tap(func() {
+				t.Views().Menu().SelectedLine(Contains("current"))
+			}).
+			Select(MatchesRegexp(`^en\b`)).
+			Confirm()
+
+		t.Views().Files().Title(Equals("Files"))
+	},
+})
@@ -0,0 +1,49 @@
+package worktree
+
+import (
+	"github.com/jesseduffield/lazygit/pkg/config"
+	. "github.com/jesseduffield/lazygit/pkg/integration/components"
+)
+
+var PruneWorktrees = NewIntegrationTest(NewIntegrationTestArgs{
+	Description:  "Prune worktrees whose directories have been deleted",
+	ExtraCmdArgs: []string{},
+	Skip:         false,
+	SetupConfig:  func(config *config.AppConfig) {},
+	SetupRepo: func(shell *Shell) {
+		shell.NewBranch("mybranch")
+		shell.CreateFileAndAdd("README.md", "hello world")
+		shell.Commit("initial commit")
+		shell.AddWorktree("mybranch", "../linked-worktree", "newbranch")
+		shell.AddWorktree("mybranch", "../deleted-worktree", "deletedbranch")
+		shell.RunShellCommand("rm -rf ../deleted-worktree")
+	},
+	Run: func(t *TestDriver, keys config.KeybindingConfig) {
+		t.Views().Worktrees().
+			Focus().
+			Lines(
+				Contains("repo (main)").IsSelected(),
+				Contains("deleted-worktree"),
+				Contains("linked-worktree"),
+			).
+			Press(keys.Worktrees.PruneWorktrees).
+			Tap(func() {
+				t.ExpectPopup().Confirmation().
+					Title(Equals("Prune stale worktrees")).
+					Content(Contains("deleted-worktree")).
+					Content(DoesNotContain("linked-worktree")).
+					Confirm()
+			}).
+			Lines(
+				Contains("repo (main)").IsSelected(),
+				Contains("linked-worktree"),
+			).
+			Press(keys.Worktrees.PruneWorktrees).
+			Tap(func() {
+				t.ExpectPopup().Alert().
+					Title(Equals("Error")).
+					Content(Equals("There are no worktrees with missing directories to prune")).
+					Confirm()
+			})
+	},
+})
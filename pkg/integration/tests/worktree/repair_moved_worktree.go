@@ -0,0 +1,41 @@
+package worktree
+
+import (
+	"github.com/jesseduffield/lazygit/pkg/config"
+	. "github.com/jesseduffield/lazygit/pkg/integration/components"
+)
+
+var RepairMovedWorktree = NewIntegrationTest(NewIntegrationTestArgs{
+	Description:  "Repair a worktree whose directory was moved outside of git",
+	ExtraCmdArgs: []string{},
+	Skip:         false,
+	SetupConfig:  func(config *config.AppConfig) {},
+	SetupRepo: func(shell *Shell) {
+		shell.NewBranch("mybranch")
+		shell.CreateFileAndAdd("README.md", "hello world")
+		shell.Commit("initial commit")
+		shell.AddWorktree("mybranch", "../linked-worktree", "newbranch")
+		shell.RunShellCommand("mv ../linked-worktree ../moved-worktree")
+	},
+	Run: func(t *TestDriver, keys config.KeybindingConfig) {
+		t.Views().Worktrees().
+			Focus().
+			Lines(
+				Contains("repo (main)").IsSelected(),
+				Contains("linked-worktree"),
+			).
+			NavigateToLine(Contains("linked-worktree")).
+			Press(keys.Worktrees.RepairWorktree).
+			Tap(func() {
+				t.ExpectPopup().Prompt().
+					Title(Equals("Where has worktree 'linked-worktree' been moved to?")).
+					Clear().
+					Type("../moved-worktree").
+					Confirm()
+			}).
+			Lines(
+				Contains("repo (main)"),
+				Contains("moved-worktree").DoesNotContain("missing"),
+			)
+	},
+})
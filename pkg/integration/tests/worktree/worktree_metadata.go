@@ -0,0 +1,43 @@
+package worktree
+
+import (
+	"github.com/jesseduffield/lazygit/pkg/config"
+	. "github.com/jesseduffield/lazygit/pkg/integration/components"
+)
+
+var WorktreeMetadata = NewIntegrationTest(NewIntegrationTestArgs{
+	Description:  "Show whether a worktree is locked or has changes, and how much disk space it takes up",
+	ExtraCmdArgs: []string{},
+	Skip:         false,
+	SetupConfig:  func(config *config.AppConfig) {},
+	SetupRepo: func(shell *Shell) {
+		shell.NewBranch("mybranch")
+		shell.CreateFileAndAdd("README.md", "hello world")
+		shell.Commit("initial commit")
+		shell.AddWorktree("mybranch", "../linked-worktree", "newbranch")
+		shell.AddFileInWorktree("../linked-worktree")
+		shell.RunCommand([]string{"git", "worktree", "lock", "--reason", "on a usb stick", "../linked-worktree"})
+	},
+	Run: func(t *TestDriver, keys config.KeybindingConfig) {
+		t.Views().Worktrees().
+			Focus().
+			Lines(
+				Contains("repo (main)").IsSelected(),
+				Contains("linked-worktree (locked)"),
+			)
+
+		t.Views().Main().
+			Content(Contains("Locked:      No")).
+			Content(Contains("Changes:     Clean")).
+			Content(Contains("Disk usage:  ~"))
+
+		t.Views().Worktrees().
+			NavigateToLine(Contains("linked-worktree"))
+
+		t.Views().Main().
+			Content(Contains("Branch:      newbranch")).
+			Content(Contains("Locked:      Yes (on a usb stick)")).
+			Content(Contains("Changes:     Uncommitted changes")).
+			Content(Contains("Disk usage:  ~"))
+	},
+})
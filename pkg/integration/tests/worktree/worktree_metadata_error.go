@@ -0,0 +1,34 @@
+package worktree
+
+import (
+	"github.com/jesseduffield/lazygit/pkg/config"
+	. "github.com/jesseduffield/lazygit/pkg/integration/components"
+)
+
+var WorktreeMetadataError = NewIntegrationTest(NewIntegrationTestArgs{
+	Description:  "Show the error instead of the changes of a worktree when git can't tell what they are",
+	ExtraCmdArgs: []string{},
+	Skip:         false,
+	SetupConfig:  func(config *config.AppConfig) {},
+	SetupRepo: func(shell *Shell) {
+		shell.NewBranch("mybranch")
+		shell.CreateFileAndAdd("README.md", "hello world")
+		shell.Commit("initial commit")
+		shell.AddWorktree("mybranch", "../linked-worktree", "newbranch")
+		// the worktree's directory is still there, but git can't make sense of it
+		shell.UpdateFile("../linked-worktree/.git", "gitdir: /nonexistent\n")
+	},
+	Run: func(t *TestDriver, keys config.KeybindingConfig) {
+		t.Views().Worktrees().
+			Focus().
+			Lines(
+				Contains("repo (main)").IsSelected(),
+				Contains("linked-worktree"),
+			).
+			NavigateToLine(Contains("linked-worktree"))
+
+		t.Views().Main().
+			Content(Contains("Changes:     fatal: not a git repository: /nonexistent")).
+			Content(Contains("Disk usage:  ~"))
+	},
+})
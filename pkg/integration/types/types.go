@@ -24,6 +24,8 @@ type IntegrationTest interface {
 type GuiDriver interface {
 	PressKey(string)
 	Click(int, int)
+	ClickAndRelease(int, int)
+	Drag(fromX, fromY, toX, toY int)
 	Keys() config.KeybindingConfig
 	CurrentContext() types.Context
 	ContextForView(viewName string) types.Context
@@ -336,19 +336,22 @@ func (self *ViewBufferManager) NewTask(f func(TaskOpts) error, key string) error
 		})
 	}
 
-	go utils.Safe(func() {
-		defer completeGocuiTask()
+	// the ID is handed out before starting the goroutine so that tasks created
+	// in quick succession can't overtake each other, which would leave the view
+	// showing the content of the older one
+	self.taskIDMutex.Lock()
+	self.newTaskID++
+	taskID := self.newTaskID
+
+	if self.GetTaskKey() != key && self.onNewKey != nil {
+		self.onNewKey()
+	}
+	self.taskKey = key
 
-		self.taskIDMutex.Lock()
-		self.newTaskID++
-		taskID := self.newTaskID
+	self.taskIDMutex.Unlock()
 
-		if self.GetTaskKey() != key && self.onNewKey != nil {
-			self.onNewKey()
-		}
-		self.taskKey = key
-
-		self.taskIDMutex.Unlock()
+	go utils.Safe(func() {
+		defer completeGocuiTask()
 
 		self.waitingMutex.Lock()
 
@@ -268,3 +268,53 @@ func TestNewCmdTaskRefresh(t *testing.T) {
 		}
 	}
 }
+
+type doneCountingTask struct {
+	*gocui.FakeTask
+	wg *sync.WaitGroup
+}
+
+func (self doneCountingTask) Done() {
+	self.FakeTask.Done()
+	self.wg.Done()
+}
+
+func TestNewTaskKeepsOrder(t *testing.T) {
+	// of two tasks created one after the other, the second one must be the
+	// one that ends up running last, however their goroutines get scheduled.
+	// We try many times since it depends on the scheduling
+	for i := 0; i < 1000; i++ {
+		var wg sync.WaitGroup
+		manager := NewViewBufferManager(
+			utils.NewDummyLog(),
+			bytes.NewBuffer(nil),
+			func() {},
+			func() {},
+			func() {},
+			func() {},
+			func() gocui.Task {
+				wg.Add(1)
+				return doneCountingTask{FakeTask: gocui.NewFakeTask(), wg: &wg}
+			},
+		)
+
+		var mutex sync.Mutex
+		lastRun := ""
+		newTask := func(key string) {
+			_ = manager.NewTask(func(TaskOpts) error {
+				mutex.Lock()
+				lastRun = key
+				mutex.Unlock()
+				return nil
+			}, key)
+		}
+
+		newTask("first")
+		newTask("second")
+		wg.Wait()
+
+		if lastRun != "second" {
+			t.Fatalf("expected the second task to run last, but %q did (attempt %d)", lastRun, i)
+		}
+	}
+}
@@ -44,6 +44,9 @@ var (
 	DiffTerminalColor = style.FgMagenta
 
 	UnstagedChangesColor = style.New()
+
+	// OwnCommitAuthorTextStyle is the text style of the author of commits made by the current user
+	OwnCommitAuthorTextStyle = style.New()
 )
 
 // UpdateTheme updates all theme variables
@@ -65,6 +68,8 @@ func UpdateTheme(themeConfig config.ThemeConfig) {
 	unstagedChangesTextStyle := GetTextStyle(themeConfig.UnstagedChangesColor, false)
 	UnstagedChangesColor = unstagedChangesTextStyle
 
+	OwnCommitAuthorTextStyle = GetTextStyle(themeConfig.OwnCommitAuthorColor, false)
+
 	GocuiSelectedLineBgColor = GetGocuiStyle(themeConfig.SelectedLineBgColor)
 	OptionsColor = GetGocuiStyle(themeConfig.OptionsTextColor)
 	OptionsFgColor = GetTextStyle(themeConfig.OptionsTextColor, false)
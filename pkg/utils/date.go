@@ -1,7 +1,9 @@
 package utils
 
 import (
+	"errors"
 	"fmt"
+	"strings"
 	"time"
 )
 
@@ -10,6 +12,16 @@ func UnixToTimeAgo(timestamp int64) string {
 	return formatSecondsAgo(now - timestamp)
 }
 
+// UnixToDate formats the date either relative to now (e.g. '2d') or, if
+// relative is false, in the same way as UnixToDateSmart
+func UnixToDate(now time.Time, timestamp int64, relative bool, longTimeFormat string, shortTimeFormat string) string {
+	if relative {
+		return formatSecondsAgo(now.Unix() - timestamp)
+	}
+
+	return UnixToDateSmart(now, timestamp, longTimeFormat, shortTimeFormat)
+}
+
 const (
 	SECONDS_IN_SECOND = 1
 	SECONDS_IN_MINUTE = 60
@@ -65,3 +77,70 @@ func UnixToDateSmart(now time.Time, timestamp int64, longTimeFormat string, shor
 
 	return date.Format(longTimeFormat)
 }
+
+// StartOfDay returns midnight at the beginning of the given time's day, in the
+// time's location
+func StartOfDay(t time.Time) time.Time {
+	year, month, day := t.Date()
+	return time.Date(year, month, day, 0, 0, 0, 0, t.Location())
+}
+
+// ParseDayRange parses a user-entered day or range of days, relative to now.
+// Each side of a range (separated by '..') can be 'today', 'yesterday', a
+// weekday name (optionally prefixed with 'last'), or a date in YYYY-MM-DD
+// format. The returned range starts at the beginning of the first day and ends
+// (exclusively) at the beginning of the day after the last one.
+func ParseDayRange(now time.Time, input string) (time.Time, time.Time, error) {
+	fromStr, toStr, isRange := strings.Cut(input, "..")
+	if !isRange {
+		toStr = fromStr
+	}
+
+	from, err := parseDay(now, fromStr)
+	if err != nil {
+		return time.Time{}, time.Time{}, err
+	}
+	to, err := parseDay(now, toStr)
+	if err != nil {
+		return time.Time{}, time.Time{}, err
+	}
+	if to.Before(from) {
+		from, to = to, from
+	}
+
+	return from, to.AddDate(0, 0, 1), nil
+}
+
+func parseDay(now time.Time, input string) (time.Time, error) {
+	input = strings.ToLower(strings.TrimSpace(input))
+	today := StartOfDay(now)
+
+	switch input {
+	case "":
+		return time.Time{}, errors.New("no date given")
+	case "today":
+		return today, nil
+	case "yesterday":
+		return today.AddDate(0, 0, -1), nil
+	}
+
+	if date, err := time.ParseInLocation("2006-01-02", input, now.Location()); err == nil {
+		return date, nil
+	}
+
+	name, last := strings.CutPrefix(input, "last ")
+	for weekday := time.Sunday; weekday <= time.Saturday; weekday++ {
+		weekdayName := strings.ToLower(weekday.String())
+		if name != weekdayName && name != weekdayName[:3] {
+			continue
+		}
+
+		daysAgo := (int(today.Weekday()) - int(weekday) + 7) % 7
+		if last && daysAgo == 0 {
+			daysAgo = 7
+		}
+		return today.AddDate(0, 0, -daysAgo), nil
+	}
+
+	return time.Time{}, fmt.Errorf("could not parse date '%s'", input)
+}
@@ -2,6 +2,9 @@ package utils
 
 import (
 	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
 )
 
 func TestFormatSecondsAgo(t *testing.T) {
@@ -95,3 +98,79 @@ func TestFormatSecondsAgo(t *testing.T) {
 		})
 	}
 }
+
+func TestUnixToDate(t *testing.T) {
+	now := time.Date(2020, 1, 1, 5, 3, 4, 0, time.Local)
+
+	tests := []struct {
+		name      string
+		timestamp int64
+		relative  bool
+		want      string
+	}{
+		{
+			name:      "relative",
+			timestamp: now.Add(-3 * time.Hour).Unix(),
+			relative:  true,
+			want:      "3h",
+		},
+		{
+			name:      "absolute, same day",
+			timestamp: now.Add(-3 * time.Hour).Unix(),
+			relative:  false,
+			want:      "2:03AM",
+		},
+		{
+			name:      "absolute, different day",
+			timestamp: now.Add(-48 * time.Hour).Unix(),
+			relative:  false,
+			want:      "2019-12-30",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := UnixToDate(now, tt.timestamp, tt.relative, "2006-01-02", "3:04PM"); got != tt.want {
+				t.Errorf("UnixToDate(%d, %v) = %v, want %v", tt.timestamp, tt.relative, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestParseDayRange(t *testing.T) {
+	// a Wednesday
+	now := time.Date(2020, 1, 8, 15, 30, 0, 0, time.Local)
+	day := func(d int) time.Time { return time.Date(2020, 1, d, 0, 0, 0, 0, time.Local) }
+
+	tests := []struct {
+		input        string
+		expectedFrom time.Time
+		expectedTo   time.Time
+		expectedErr  bool
+	}{
+		{input: "today", expectedFrom: day(8), expectedTo: day(9)},
+		{input: "Yesterday", expectedFrom: day(7), expectedTo: day(8)},
+		{input: "monday", expectedFrom: day(6), expectedTo: day(7)},
+		{input: "tue", expectedFrom: day(7), expectedTo: day(8)},
+		{input: "wednesday", expectedFrom: day(8), expectedTo: day(9)},
+		{input: "last wednesday", expectedFrom: day(1), expectedTo: day(2)},
+		{input: "2020-01-03", expectedFrom: day(3), expectedTo: day(4)},
+		{input: "2020-01-03..yesterday", expectedFrom: day(3), expectedTo: day(8)},
+		{input: "today..2020-01-05", expectedFrom: day(5), expectedTo: day(9)},
+		{input: "someday", expectedErr: true},
+		{input: "", expectedErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			from, to, err := ParseDayRange(now, tt.input)
+			if tt.expectedErr {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expectedFrom, from)
+			assert.Equal(t, tt.expectedTo, to)
+		})
+	}
+}
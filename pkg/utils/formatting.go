@@ -1,6 +1,10 @@
 package utils
 
 import (
+	"fmt"
+	"math"
+	"regexp"
+	"strconv"
 	"strings"
 
 	"github.com/mattn/go-runewidth"
@@ -177,8 +181,55 @@ func SafeTruncate(str string, limit int) string {
 const COMMIT_HASH_SHORT_SIZE = 8
 
 func ShortSha(sha string) string {
-	if len(sha) < COMMIT_HASH_SHORT_SIZE {
+	return ShortShaOfLength(sha, COMMIT_HASH_SHORT_SIZE)
+}
+
+// ShortShaOfLength abbreviates the sha to the given number of characters
+func ShortShaOfLength(sha string, length int) string {
+	if length <= 0 || len(sha) < length {
 		return sha
 	}
-	return sha[:COMMIT_HASH_SHORT_SIZE]
+	return sha[:length]
+}
+
+// FormatByteSize renders a number of bytes in the largest binary unit that
+// keeps it at one or more, e.g. 1536 becomes "1.5 KiB"
+func FormatByteSize(bytes int64) string {
+	if bytes < 1024 {
+		return fmt.Sprintf("%d B", bytes)
+	}
+
+	size := float64(bytes)
+	units := []string{"KiB", "MiB", "GiB", "TiB"}
+	unit := ""
+	for _, unit = range units {
+		size /= 1024
+		if size < 1024 {
+			break
+		}
+	}
+
+	return fmt.Sprintf("%.1f %s", size, unit)
+}
+
+var byteSizeRegexp = regexp.MustCompile(`^(\d+(?:\.\d+)?)\s*([KMGT]?)(?:I?B)?$`)
+
+// ParseByteSize parses sizes like "512", "1.5KB" or "5 MiB". Units are binary,
+// as in FormatByteSize, whether or not they're written with the 'i'.
+func ParseByteSize(str string) (int64, error) {
+	match := byteSizeRegexp.FindStringSubmatch(strings.ToUpper(strings.TrimSpace(str)))
+	if match == nil {
+		return 0, fmt.Errorf("invalid size '%s', expected something like '5MB'", str)
+	}
+
+	size, err := strconv.ParseFloat(match[1], 64)
+	if err != nil {
+		return 0, err
+	}
+
+	if unit := match[2]; unit != "" {
+		size *= math.Pow(1024, float64(strings.Index("KMGT", unit)+1))
+	}
+
+	return int64(size), nil
 }
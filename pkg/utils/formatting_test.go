@@ -245,3 +245,75 @@ func TestRenderDisplayStrings(t *testing.T) {
 		assert.EqualValues(t, test.expectedColumnPositions, columnPositions)
 	}
 }
+
+func TestShortShaOfLength(t *testing.T) {
+	type scenario struct {
+		sha      string
+		length   int
+		expected string
+	}
+
+	scenarios := []scenario{
+		{"0123456789abcdef", 8, "01234567"},
+		{"0123456789abcdef", 12, "0123456789ab"},
+		{"0123", 8, "0123"},
+		{"0123456789abcdef", 0, "0123456789abcdef"},
+	}
+
+	for _, s := range scenarios {
+		assert.EqualValues(t, s.expected, ShortShaOfLength(s.sha, s.length))
+	}
+}
+
+func TestFormatByteSize(t *testing.T) {
+	type scenario struct {
+		bytes    int64
+		expected string
+	}
+
+	scenarios := []scenario{
+		{0, "0 B"},
+		{1023, "1023 B"},
+		{1024, "1.0 KiB"},
+		{1536, "1.5 KiB"},
+		{5 * 1024 * 1024, "5.0 MiB"},
+		{3 * 1024 * 1024 * 1024 * 1024 * 1024, "3072.0 TiB"},
+	}
+
+	for _, s := range scenarios {
+		assert.EqualValues(t, s.expected, FormatByteSize(s.bytes))
+	}
+}
+
+func TestParseByteSize(t *testing.T) {
+	type scenario struct {
+		str           string
+		expected      int64
+		expectedError bool
+	}
+
+	scenarios := []scenario{
+		{"0", 0, false},
+		{"512", 512, false},
+		{"512B", 512, false},
+		{"1.5KB", 1536, false},
+		{"5MB", 5 * 1024 * 1024, false},
+		{"5 MiB", 5 * 1024 * 1024, false},
+		{"5m", 5 * 1024 * 1024, false},
+		{"2GB", 2 * 1024 * 1024 * 1024, false},
+		{"", 0, true},
+		{"MB", 0, true},
+		{"5XB", 0, true},
+		{"-5MB", 0, true},
+	}
+
+	for _, s := range scenarios {
+		size, err := ParseByteSize(s.str)
+		if s.expectedError {
+			assert.Error(t, err, s.str)
+		} else {
+			assert.NoError(t, err, s.str)
+			assert.EqualValues(t, s.expected, size, s.str)
+		}
+	}
+}
@@ -0,0 +1,37 @@
+package utils
+
+import (
+	"path"
+	"regexp"
+	"strings"
+
+	"github.com/gobwas/glob"
+)
+
+// NewPathMatcher returns a function that tells whether a path matches the
+// given pattern. A pattern wrapped in slashes, like /_test\.go$/, is a regex
+// matched against the whole path. Anything else is a glob, where * doesn't
+// cross directories but ** does. As in .gitignore files, a glob without a
+// slash is matched against the file's base name, so *_test.go matches test
+// files in any directory.
+func NewPathMatcher(pattern string) (func(string) bool, error) {
+	if len(pattern) > 2 && strings.HasPrefix(pattern, "/") && strings.HasSuffix(pattern, "/") {
+		re, err := regexp.Compile(pattern[1 : len(pattern)-1])
+		if err != nil {
+			return nil, err
+		}
+
+		return re.MatchString, nil
+	}
+
+	g, err := glob.Compile(pattern, '/')
+	if err != nil {
+		return nil, err
+	}
+
+	if !strings.Contains(pattern, "/") {
+		return func(p string) bool { return g.Match(path.Base(p)) }, nil
+	}
+
+	return func(p string) bool { return g.Match(strings.TrimPrefix(p, "/")) }, nil
+}
@@ -0,0 +1,65 @@
+package utils
+
+import (
+	"testing"
+
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewPathMatcher(t *testing.T) {
+	paths := []string{
+		"main.go",
+		"main_test.go",
+		"pkg/foo/foo.go",
+		"pkg/foo/foo_test.go",
+		"pkg/foo/bar/bar_test.go",
+		"docs/README.md",
+	}
+
+	scenarios := []struct {
+		pattern       string
+		expected      []string
+		expectedError string
+	}{
+		{
+			// without a slash, a glob is matched against the base name
+			pattern:  "*_test.go",
+			expected: []string{"main_test.go", "pkg/foo/foo_test.go", "pkg/foo/bar/bar_test.go"},
+		},
+		{
+			pattern:  "pkg/foo/*.go",
+			expected: []string{"pkg/foo/foo.go", "pkg/foo/foo_test.go"},
+		},
+		{
+			pattern:  "pkg/**_test.go",
+			expected: []string{"pkg/foo/foo_test.go", "pkg/foo/bar/bar_test.go"},
+		},
+		{
+			pattern:  "/^pkg/.*/bar/",
+			expected: []string{"pkg/foo/bar/bar_test.go"},
+		},
+		{
+			pattern:  `/(main|README)\./`,
+			expected: []string{"main.go", "docs/README.md"},
+		},
+		{
+			pattern:       "/(/",
+			expectedError: "error parsing regexp: missing closing ): `(`",
+		},
+	}
+
+	for _, s := range scenarios {
+		s := s
+		t.Run(s.pattern, func(t *testing.T) {
+			matches, err := NewPathMatcher(s.pattern)
+			if s.expectedError != "" {
+				assert.EqualError(t, err, s.expectedError)
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, s.expected, lo.Filter(paths, func(path string, _ int) bool { return matches(path) }))
+		})
+	}
+}
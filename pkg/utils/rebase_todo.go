@@ -120,7 +120,10 @@ func moveTodoUp(todos []todo.Todo, sha string, action todo.TodoCommand) ([]todo.
 	// the end of the slice)
 
 	// Find the next todo that we show in lazygit's commits view (skipping the rest)
-	_, skip, ok := lo.FindIndexOf(todos[sourceIdx+1:], isRenderedTodo)
+	hasMerges := HasMergeTodos(todos)
+	_, skip, ok := lo.FindIndexOf(todos[sourceIdx+1:], func(t todo.Todo) bool {
+		return IsRenderedTodo(t, hasMerges)
+	})
 
 	if !ok {
 		// We expect callers to guard against this
@@ -177,8 +180,74 @@ func moveFixupCommitDown(todos []todo.Todo, originalSha string, fixupSha string)
 	return newTodos, nil
 }
 
-// We render a todo in the commits view if it's a commit or if it's an
-// update-ref. We don't render label, reset, or comment lines.
-func isRenderedTodo(t todo.Todo) bool {
-	return t.Commit != "" || t.Command == todo.UpdateRef
+// We render a todo in the commits view if it's a commit, an update-ref, or a
+// merge. Label and reset lines are only rendered if there are merges in the
+// todo list, because that's when they tell you something about the structure
+// being rebuilt; git emits a 'label onto'/'reset onto' pair even for a linear
+// rebase. We never render comment lines.
+func IsRenderedTodo(t todo.Todo, hasMerges bool) bool {
+	if t.Command == todo.Label || t.Command == todo.Reset {
+		return hasMerges
+	}
+
+	return t.Commit != "" || t.Command == todo.UpdateRef || t.Command == todo.Merge
+}
+
+func HasMergeTodos(todos []todo.Todo) bool {
+	return lo.ContainsBy(todos, func(t todo.Todo) bool {
+		return t.Command == todo.Merge
+	})
+}
+
+// RescheduleExecTodo makes newCommand the next todo to be executed, as a
+// replacement for failedCommand. If git has already rescheduled the failed
+// command (because rebase.rescheduleFailedExec is on), that todo is replaced;
+// otherwise a new one is added.
+func RescheduleExecTodo(fileName string, failedCommand string, newCommand string, commentChar byte) error {
+	todos, err := ReadRebaseTodoFile(fileName, commentChar)
+	if err != nil {
+		return err
+	}
+
+	return WriteRebaseTodoFile(fileName, rescheduleExecTodo(todos, failedCommand, newCommand), commentChar)
+}
+
+func rescheduleExecTodo(todos []todo.Todo, failedCommand string, newCommand string) []todo.Todo {
+	if index, ok := rescheduledExecIndex(todos, failedCommand); ok {
+		todos[index].ExecCommand = newCommand
+		return todos
+	}
+
+	return Prepend(todos, todo.Todo{Command: todo.Exec, ExecCommand: newCommand})
+}
+
+// RemoveRescheduledExecTodo removes failedCommand from the todos if git has
+// rescheduled it, so that continuing the rebase skips it
+func RemoveRescheduledExecTodo(fileName string, failedCommand string, commentChar byte) error {
+	todos, err := ReadRebaseTodoFile(fileName, commentChar)
+	if err != nil {
+		return err
+	}
+
+	return WriteRebaseTodoFile(fileName, removeRescheduledExecTodo(todos, failedCommand), commentChar)
+}
+
+func removeRescheduledExecTodo(todos []todo.Todo, failedCommand string) []todo.Todo {
+	if index, ok := rescheduledExecIndex(todos, failedCommand); ok {
+		return Remove(todos, index)
+	}
+
+	return todos
+}
+
+// git puts a rescheduled exec back at the start of the todo list
+func rescheduledExecIndex(todos []todo.Todo, failedCommand string) (int, bool) {
+	_, index, ok := lo.FindIndexOf(todos, func(t todo.Todo) bool {
+		return t.Command != todo.Comment
+	})
+	if !ok || todos[index].Command != todo.Exec || todos[index].ExecCommand != failedCommand {
+		return -1, false
+	}
+
+	return index, true
 }
@@ -67,6 +67,23 @@ func TestRebaseCommands_moveTodoDown(t *testing.T) {
 				{Command: todo.Pick, Commit: "def0"},
 			},
 		},
+		{
+			testName: "don't skip a label when there are merges",
+			todos: []todo.Todo{
+				{Command: todo.Pick, Commit: "1234"},
+				{Command: todo.Label, Label: "myLabel"},
+				{Command: todo.Pick, Commit: "5678"},
+				{Command: todo.Merge, Commit: "abcd", Label: "myLabel"},
+			},
+			shaToMoveDown: "5678",
+			expectedErr:   "",
+			expectedTodos: []todo.Todo{
+				{Command: todo.Pick, Commit: "1234"},
+				{Command: todo.Pick, Commit: "5678"},
+				{Command: todo.Label, Label: "myLabel"},
+				{Command: todo.Merge, Commit: "abcd", Label: "myLabel"},
+			},
+		},
 
 		// Error cases
 		{
@@ -330,3 +347,90 @@ func TestRebaseCommands_moveFixupCommitDown(t *testing.T) {
 		})
 	}
 }
+
+func TestRescheduleExecTodo(t *testing.T) {
+	scenarios := []struct {
+		name          string
+		todos         []todo.Todo
+		expectedTodos []todo.Todo
+	}{
+		{
+			name: "failed command was rescheduled by git",
+			todos: []todo.Todo{
+				{Command: todo.Exec, ExecCommand: "make test"},
+				{Command: todo.Pick, Commit: "1234"},
+			},
+			expectedTodos: []todo.Todo{
+				{Command: todo.Exec, ExecCommand: "make lint"},
+				{Command: todo.Pick, Commit: "1234"},
+			},
+		},
+		{
+			name: "failed command was not rescheduled",
+			todos: []todo.Todo{
+				{Command: todo.Pick, Commit: "1234"},
+				{Command: todo.Exec, ExecCommand: "make test"},
+			},
+			expectedTodos: []todo.Todo{
+				{Command: todo.Exec, ExecCommand: "make lint"},
+				{Command: todo.Pick, Commit: "1234"},
+				{Command: todo.Exec, ExecCommand: "make test"},
+			},
+		},
+		{
+			name:  "no todos left",
+			todos: []todo.Todo{},
+			expectedTodos: []todo.Todo{
+				{Command: todo.Exec, ExecCommand: "make lint"},
+			},
+		},
+	}
+
+	for _, scenario := range scenarios {
+		t.Run(scenario.name, func(t *testing.T) {
+			actualTodos := rescheduleExecTodo(scenario.todos, "make test", "make lint")
+
+			assert.EqualValues(t, scenario.expectedTodos, actualTodos)
+		})
+	}
+}
+
+func TestRemoveRescheduledExecTodo(t *testing.T) {
+	scenarios := []struct {
+		name          string
+		todos         []todo.Todo
+		expectedTodos []todo.Todo
+	}{
+		{
+			name: "failed command was rescheduled by git",
+			todos: []todo.Todo{
+				{Command: todo.Exec, ExecCommand: "make test"},
+				{Command: todo.Pick, Commit: "1234"},
+				{Command: todo.Exec, ExecCommand: "make test"},
+			},
+			expectedTodos: []todo.Todo{
+				{Command: todo.Pick, Commit: "1234"},
+				{Command: todo.Exec, ExecCommand: "make test"},
+			},
+		},
+		{
+			name: "failed command was not rescheduled",
+			todos: []todo.Todo{
+				{Command: todo.Pick, Commit: "1234"},
+				{Command: todo.Exec, ExecCommand: "make test"},
+			},
+			expectedTodos: []todo.Todo{
+				{Command: todo.Pick, Commit: "1234"},
+				{Command: todo.Exec, ExecCommand: "make test"},
+			},
+		},
+	}
+
+	for _, scenario := range scenarios {
+		t.Run(scenario.name, func(t *testing.T) {
+			actualTodos := removeRescheduledExecTodo(scenario.todos, "make test")
+
+			assert.EqualValues(t, scenario.expectedTodos, actualTodos)
+		})
+	}
+}
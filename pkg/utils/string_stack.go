@@ -18,6 +18,11 @@ func (self *StringStack) Pop() string {
 	return last
 }
 
+// Items returns the stack's contents, from bottom to top
+func (self *StringStack) Items() []string {
+	return append([]string{}, self.stack...)
+}
+
 func (self *StringStack) IsEmpty() bool {
 	return len(self.stack) == 0
 }